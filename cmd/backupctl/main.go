@@ -27,6 +27,10 @@ func main() {
 		if err := createFull(); err != nil {
 			log.Fatal(err)
 		}
+	case "export":
+		if err := exportData(); err != nil {
+			log.Fatal(err)
+		}
 	case "restore-full":
 		if err := restoreFull(os.Args[2:]); err != nil {
 			log.Fatal(err)
@@ -75,6 +79,22 @@ func createFull() error {
 	return nil
 }
 
+func exportData() error {
+	cfg := appruntime.LoadConfig(appruntime.UserHome())
+	dirs, err := appruntime.ResolveDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	archivePath, err := appruntime.DataExportNow(filepath.Join(dirs.Data, "app.sqlite"), dirs.Exports)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(archivePath)
+	return nil
+}
+
 func restoreFull(args []string) error {
 	fs := flag.NewFlagSet("restore-full", flag.ContinueOnError)
 	fs.SetOutput(os.Stderr)
@@ -110,5 +130,6 @@ func usage() {
 	fmt.Fprintln(os.Stderr, "Usage:")
 	fmt.Fprintln(os.Stderr, "  langschool-backupctl create-db")
 	fmt.Fprintln(os.Stderr, "  langschool-backupctl create-full")
+	fmt.Fprintln(os.Stderr, "  langschool-backupctl export")
 	fmt.Fprintln(os.Stderr, "  langschool-backupctl restore-full --archive /path/to/full-YYYYMMDD-HHMMSS.tar.gz")
 }
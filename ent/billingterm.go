@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/billingterm"
+	"langschool/ent/course"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BillingTerm is the model entity for the BillingTerm schema.
+type BillingTerm struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// StartYear holds the value of the "start_year" field.
+	StartYear int `json:"start_year,omitempty"`
+	// StartMonth holds the value of the "start_month" field.
+	StartMonth int `json:"start_month,omitempty"`
+	// EndYear holds the value of the "end_year" field.
+	EndYear int `json:"end_year,omitempty"`
+	// EndMonth holds the value of the "end_month" field.
+	EndMonth int `json:"end_month,omitempty"`
+	// Lessons holds the value of the "lessons" field.
+	Lessons float64 `json:"lessons,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID *int `json:"course_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BillingTermQuery when eager-loading is set.
+	Edges        BillingTermEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BillingTermEdges holds the relations/edges for other nodes in the graph.
+type BillingTermEdges struct {
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BillingTermEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e BillingTermEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[1] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BillingTerm) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case billingterm.FieldLessons:
+			values[i] = new(sql.NullFloat64)
+		case billingterm.FieldID, billingterm.FieldStartYear, billingterm.FieldStartMonth, billingterm.FieldEndYear, billingterm.FieldEndMonth, billingterm.FieldCourseID:
+			values[i] = new(sql.NullInt64)
+		case billingterm.FieldName, billingterm.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case billingterm.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BillingTerm fields.
+func (_m *BillingTerm) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case billingterm.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case billingterm.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case billingterm.FieldStartYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_year", values[i])
+			} else if value.Valid {
+				_m.StartYear = int(value.Int64)
+			}
+		case billingterm.FieldStartMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_month", values[i])
+			} else if value.Valid {
+				_m.StartMonth = int(value.Int64)
+			}
+		case billingterm.FieldEndYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_year", values[i])
+			} else if value.Valid {
+				_m.EndYear = int(value.Int64)
+			}
+		case billingterm.FieldEndMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_month", values[i])
+			} else if value.Valid {
+				_m.EndMonth = int(value.Int64)
+			}
+		case billingterm.FieldLessons:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field lessons", values[i])
+			} else if value.Valid {
+				_m.Lessons = value.Float64
+			}
+		case billingterm.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = new(int)
+				*_m.CourseID = int(value.Int64)
+			}
+		case billingterm.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case billingterm.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BillingTerm.
+// This includes values selected through modifiers, order, etc.
+func (_m *BillingTerm) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCourse queries the "course" edge of the BillingTerm entity.
+func (_m *BillingTerm) QueryCourse() *CourseQuery {
+	return NewBillingTermClient(_m.config).QueryCourse(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the BillingTerm entity.
+func (_m *BillingTerm) QueryInvoices() *InvoiceQuery {
+	return NewBillingTermClient(_m.config).QueryInvoices(_m)
+}
+
+// Update returns a builder for updating this BillingTerm.
+// Note that you need to call BillingTerm.Unwrap() before calling this method if this BillingTerm
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BillingTerm) Update() *BillingTermUpdateOne {
+	return NewBillingTermClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BillingTerm entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BillingTerm) Unwrap() *BillingTerm {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BillingTerm is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BillingTerm) String() string {
+	var builder strings.Builder
+	builder.WriteString("BillingTerm(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("start_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartYear))
+	builder.WriteString(", ")
+	builder.WriteString("start_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartMonth))
+	builder.WriteString(", ")
+	builder.WriteString("end_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndYear))
+	builder.WriteString(", ")
+	builder.WriteString("end_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndMonth))
+	builder.WriteString(", ")
+	builder.WriteString("lessons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lessons))
+	builder.WriteString(", ")
+	if v := _m.CourseID; v != nil {
+		builder.WriteString("course_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BillingTerms is a parsable slice of BillingTerm.
+type BillingTerms []*BillingTerm
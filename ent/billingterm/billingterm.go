@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingterm
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the billingterm type in the database.
+	Label = "billing_term"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartYear holds the string denoting the start_year field in the database.
+	FieldStartYear = "start_year"
+	// FieldStartMonth holds the string denoting the start_month field in the database.
+	FieldStartMonth = "start_month"
+	// FieldEndYear holds the string denoting the end_year field in the database.
+	FieldEndYear = "end_year"
+	// FieldEndMonth holds the string denoting the end_month field in the database.
+	FieldEndMonth = "end_month"
+	// FieldLessons holds the string denoting the lessons field in the database.
+	FieldLessons = "lessons"
+	// FieldCourseID holds the string denoting the course_id field in the database.
+	FieldCourseID = "course_id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// Table holds the table name of the billingterm in the database.
+	Table = "billing_terms"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "billing_terms"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "course_id"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "billing_term_id"
+)
+
+// Columns holds all SQL columns for billingterm fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldStartYear,
+	FieldStartMonth,
+	FieldEndYear,
+	FieldEndMonth,
+	FieldLessons,
+	FieldCourseID,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// StartMonthValidator is a validator for the "start_month" field. It is called by the builders before save.
+	StartMonthValidator func(int) error
+	// EndMonthValidator is a validator for the "end_month" field. It is called by the builders before save.
+	EndMonthValidator func(int) error
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BillingTerm queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartYear orders the results by the start_year field.
+func ByStartYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartYear, opts...).ToFunc()
+}
+
+// ByStartMonth orders the results by the start_month field.
+func ByStartMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartMonth, opts...).ToFunc()
+}
+
+// ByEndYear orders the results by the end_year field.
+func ByEndYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndYear, opts...).ToFunc()
+}
+
+// ByEndMonth orders the results by the end_month field.
+func ByEndMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndMonth, opts...).ToFunc()
+}
+
+// ByLessons orders the results by the lessons field.
+func ByLessons(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLessons, opts...).ToFunc()
+}
+
+// ByCourseID orders the results by the course_id field.
+func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoicesCount orders the results by invoices count.
+func ByInvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoicesStep(), opts...)
+	}
+}
+
+// ByInvoices orders the results by invoices terms.
+func ByInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+	)
+}
+func newInvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+	)
+}
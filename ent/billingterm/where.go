@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingterm
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldName, v))
+}
+
+// StartYear applies equality check predicate on the "start_year" field. It's identical to StartYearEQ.
+func StartYear(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldStartYear, v))
+}
+
+// StartMonth applies equality check predicate on the "start_month" field. It's identical to StartMonthEQ.
+func StartMonth(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// EndYear applies equality check predicate on the "end_year" field. It's identical to EndYearEQ.
+func EndYear(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldEndYear, v))
+}
+
+// EndMonth applies equality check predicate on the "end_month" field. It's identical to EndMonthEQ.
+func EndMonth(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldEndMonth, v))
+}
+
+// Lessons applies equality check predicate on the "lessons" field. It's identical to LessonsEQ.
+func Lessons(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldLessons, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldContainsFold(FieldName, v))
+}
+
+// StartYearEQ applies the EQ predicate on the "start_year" field.
+func StartYearEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldStartYear, v))
+}
+
+// StartYearNEQ applies the NEQ predicate on the "start_year" field.
+func StartYearNEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldStartYear, v))
+}
+
+// StartYearIn applies the In predicate on the "start_year" field.
+func StartYearIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldStartYear, vs...))
+}
+
+// StartYearNotIn applies the NotIn predicate on the "start_year" field.
+func StartYearNotIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldStartYear, vs...))
+}
+
+// StartYearGT applies the GT predicate on the "start_year" field.
+func StartYearGT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldStartYear, v))
+}
+
+// StartYearGTE applies the GTE predicate on the "start_year" field.
+func StartYearGTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldStartYear, v))
+}
+
+// StartYearLT applies the LT predicate on the "start_year" field.
+func StartYearLT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldStartYear, v))
+}
+
+// StartYearLTE applies the LTE predicate on the "start_year" field.
+func StartYearLTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldStartYear, v))
+}
+
+// StartMonthEQ applies the EQ predicate on the "start_month" field.
+func StartMonthEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// StartMonthNEQ applies the NEQ predicate on the "start_month" field.
+func StartMonthNEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldStartMonth, v))
+}
+
+// StartMonthIn applies the In predicate on the "start_month" field.
+func StartMonthIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldStartMonth, vs...))
+}
+
+// StartMonthNotIn applies the NotIn predicate on the "start_month" field.
+func StartMonthNotIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldStartMonth, vs...))
+}
+
+// StartMonthGT applies the GT predicate on the "start_month" field.
+func StartMonthGT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldStartMonth, v))
+}
+
+// StartMonthGTE applies the GTE predicate on the "start_month" field.
+func StartMonthGTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldStartMonth, v))
+}
+
+// StartMonthLT applies the LT predicate on the "start_month" field.
+func StartMonthLT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldStartMonth, v))
+}
+
+// StartMonthLTE applies the LTE predicate on the "start_month" field.
+func StartMonthLTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldStartMonth, v))
+}
+
+// EndYearEQ applies the EQ predicate on the "end_year" field.
+func EndYearEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldEndYear, v))
+}
+
+// EndYearNEQ applies the NEQ predicate on the "end_year" field.
+func EndYearNEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldEndYear, v))
+}
+
+// EndYearIn applies the In predicate on the "end_year" field.
+func EndYearIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldEndYear, vs...))
+}
+
+// EndYearNotIn applies the NotIn predicate on the "end_year" field.
+func EndYearNotIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldEndYear, vs...))
+}
+
+// EndYearGT applies the GT predicate on the "end_year" field.
+func EndYearGT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldEndYear, v))
+}
+
+// EndYearGTE applies the GTE predicate on the "end_year" field.
+func EndYearGTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldEndYear, v))
+}
+
+// EndYearLT applies the LT predicate on the "end_year" field.
+func EndYearLT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldEndYear, v))
+}
+
+// EndYearLTE applies the LTE predicate on the "end_year" field.
+func EndYearLTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldEndYear, v))
+}
+
+// EndMonthEQ applies the EQ predicate on the "end_month" field.
+func EndMonthEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldEndMonth, v))
+}
+
+// EndMonthNEQ applies the NEQ predicate on the "end_month" field.
+func EndMonthNEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldEndMonth, v))
+}
+
+// EndMonthIn applies the In predicate on the "end_month" field.
+func EndMonthIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldEndMonth, vs...))
+}
+
+// EndMonthNotIn applies the NotIn predicate on the "end_month" field.
+func EndMonthNotIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldEndMonth, vs...))
+}
+
+// EndMonthGT applies the GT predicate on the "end_month" field.
+func EndMonthGT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldEndMonth, v))
+}
+
+// EndMonthGTE applies the GTE predicate on the "end_month" field.
+func EndMonthGTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldEndMonth, v))
+}
+
+// EndMonthLT applies the LT predicate on the "end_month" field.
+func EndMonthLT(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldEndMonth, v))
+}
+
+// EndMonthLTE applies the LTE predicate on the "end_month" field.
+func EndMonthLTE(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldEndMonth, v))
+}
+
+// LessonsEQ applies the EQ predicate on the "lessons" field.
+func LessonsEQ(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldLessons, v))
+}
+
+// LessonsNEQ applies the NEQ predicate on the "lessons" field.
+func LessonsNEQ(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldLessons, v))
+}
+
+// LessonsIn applies the In predicate on the "lessons" field.
+func LessonsIn(vs ...float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldLessons, vs...))
+}
+
+// LessonsNotIn applies the NotIn predicate on the "lessons" field.
+func LessonsNotIn(vs ...float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldLessons, vs...))
+}
+
+// LessonsGT applies the GT predicate on the "lessons" field.
+func LessonsGT(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldLessons, v))
+}
+
+// LessonsGTE applies the GTE predicate on the "lessons" field.
+func LessonsGTE(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldLessons, v))
+}
+
+// LessonsLT applies the LT predicate on the "lessons" field.
+func LessonsLT(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldLessons, v))
+}
+
+// LessonsLTE applies the LTE predicate on the "lessons" field.
+func LessonsLTE(v float64) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldLessons, v))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// CourseIDIsNil applies the IsNil predicate on the "course_id" field.
+func CourseIDIsNil() predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIsNull(FieldCourseID))
+}
+
+// CourseIDNotNil applies the NotNil predicate on the "course_id" field.
+func CourseIDNotNil() predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotNull(FieldCourseID))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.BillingTerm {
+	return predicate.BillingTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.BillingTerm {
+	return predicate.BillingTerm(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.BillingTerm {
+	return predicate.BillingTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.BillingTerm {
+	return predicate.BillingTerm(func(s *sql.Selector) {
+		step := newInvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingTerm) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingTerm) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingTerm) predicate.BillingTerm {
+	return predicate.BillingTerm(sql.NotPredicates(p))
+}
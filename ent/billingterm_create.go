@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/billingterm"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BillingTermCreate is the builder for creating a BillingTerm entity.
+type BillingTermCreate struct {
+	config
+	mutation *BillingTermMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *BillingTermCreate) SetName(v string) *BillingTermCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStartYear sets the "start_year" field.
+func (_c *BillingTermCreate) SetStartYear(v int) *BillingTermCreate {
+	_c.mutation.SetStartYear(v)
+	return _c
+}
+
+// SetStartMonth sets the "start_month" field.
+func (_c *BillingTermCreate) SetStartMonth(v int) *BillingTermCreate {
+	_c.mutation.SetStartMonth(v)
+	return _c
+}
+
+// SetEndYear sets the "end_year" field.
+func (_c *BillingTermCreate) SetEndYear(v int) *BillingTermCreate {
+	_c.mutation.SetEndYear(v)
+	return _c
+}
+
+// SetEndMonth sets the "end_month" field.
+func (_c *BillingTermCreate) SetEndMonth(v int) *BillingTermCreate {
+	_c.mutation.SetEndMonth(v)
+	return _c
+}
+
+// SetLessons sets the "lessons" field.
+func (_c *BillingTermCreate) SetLessons(v float64) *BillingTermCreate {
+	_c.mutation.SetLessons(v)
+	return _c
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *BillingTermCreate) SetCourseID(v int) *BillingTermCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_c *BillingTermCreate) SetNillableCourseID(v *int) *BillingTermCreate {
+	if v != nil {
+		_c.SetCourseID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *BillingTermCreate) SetCreatedBy(v string) *BillingTermCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *BillingTermCreate) SetNillableCreatedBy(v *string) *BillingTermCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BillingTermCreate) SetCreatedAt(v time.Time) *BillingTermCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BillingTermCreate) SetNillableCreatedAt(v *time.Time) *BillingTermCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *BillingTermCreate) SetCourse(v *Course) *BillingTermCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_c *BillingTermCreate) AddInvoiceIDs(ids ...int) *BillingTermCreate {
+	_c.mutation.AddInvoiceIDs(ids...)
+	return _c
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_c *BillingTermCreate) AddInvoices(v ...*Invoice) *BillingTermCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceIDs(ids...)
+}
+
+// Mutation returns the BillingTermMutation object of the builder.
+func (_c *BillingTermCreate) Mutation() *BillingTermMutation {
+	return _c.mutation
+}
+
+// Save creates the BillingTerm in the database.
+func (_c *BillingTermCreate) Save(ctx context.Context) (*BillingTerm, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BillingTermCreate) SaveX(ctx context.Context) *BillingTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingTermCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingTermCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BillingTermCreate) defaults() {
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := billingterm.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := billingterm.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BillingTermCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BillingTerm.name"`)}
+	}
+	if _, ok := _c.mutation.StartYear(); !ok {
+		return &ValidationError{Name: "start_year", err: errors.New(`ent: missing required field "BillingTerm.start_year"`)}
+	}
+	if _, ok := _c.mutation.StartMonth(); !ok {
+		return &ValidationError{Name: "start_month", err: errors.New(`ent: missing required field "BillingTerm.start_month"`)}
+	}
+	if v, ok := _c.mutation.StartMonth(); ok {
+		if err := billingterm.StartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "start_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.start_month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndYear(); !ok {
+		return &ValidationError{Name: "end_year", err: errors.New(`ent: missing required field "BillingTerm.end_year"`)}
+	}
+	if _, ok := _c.mutation.EndMonth(); !ok {
+		return &ValidationError{Name: "end_month", err: errors.New(`ent: missing required field "BillingTerm.end_month"`)}
+	}
+	if v, ok := _c.mutation.EndMonth(); ok {
+		if err := billingterm.EndMonthValidator(v); err != nil {
+			return &ValidationError{Name: "end_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.end_month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Lessons(); !ok {
+		return &ValidationError{Name: "lessons", err: errors.New(`ent: missing required field "BillingTerm.lessons"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "BillingTerm.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingTerm.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BillingTermCreate) sqlSave(ctx context.Context) (*BillingTerm, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BillingTermCreate) createSpec() (*BillingTerm, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingTerm{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(billingterm.Table, sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(billingterm.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.StartYear(); ok {
+		_spec.SetField(billingterm.FieldStartYear, field.TypeInt, value)
+		_node.StartYear = value
+	}
+	if value, ok := _c.mutation.StartMonth(); ok {
+		_spec.SetField(billingterm.FieldStartMonth, field.TypeInt, value)
+		_node.StartMonth = value
+	}
+	if value, ok := _c.mutation.EndYear(); ok {
+		_spec.SetField(billingterm.FieldEndYear, field.TypeInt, value)
+		_node.EndYear = value
+	}
+	if value, ok := _c.mutation.EndMonth(); ok {
+		_spec.SetField(billingterm.FieldEndMonth, field.TypeInt, value)
+		_node.EndMonth = value
+	}
+	if value, ok := _c.mutation.Lessons(); ok {
+		_spec.SetField(billingterm.FieldLessons, field.TypeFloat64, value)
+		_node.Lessons = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(billingterm.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(billingterm.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingterm.CourseTable,
+			Columns: []string{billingterm.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BillingTermCreateBulk is the builder for creating many BillingTerm entities in bulk.
+type BillingTermCreateBulk struct {
+	config
+	err      error
+	builders []*BillingTermCreate
+}
+
+// Save creates the BillingTerm entities in the database.
+func (_c *BillingTermCreateBulk) Save(ctx context.Context) ([]*BillingTerm, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BillingTerm, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingTermMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BillingTermCreateBulk) SaveX(ctx context.Context) []*BillingTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingTermCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingTermCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/billingterm"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BillingTermUpdate is the builder for updating BillingTerm entities.
+type BillingTermUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingTermMutation
+}
+
+// Where appends a list predicates to the BillingTermUpdate builder.
+func (_u *BillingTermUpdate) Where(ps ...predicate.BillingTerm) *BillingTermUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BillingTermUpdate) SetName(v string) *BillingTermUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableName(v *string) *BillingTermUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStartYear sets the "start_year" field.
+func (_u *BillingTermUpdate) SetStartYear(v int) *BillingTermUpdate {
+	_u.mutation.ResetStartYear()
+	_u.mutation.SetStartYear(v)
+	return _u
+}
+
+// SetNillableStartYear sets the "start_year" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableStartYear(v *int) *BillingTermUpdate {
+	if v != nil {
+		_u.SetStartYear(*v)
+	}
+	return _u
+}
+
+// AddStartYear adds value to the "start_year" field.
+func (_u *BillingTermUpdate) AddStartYear(v int) *BillingTermUpdate {
+	_u.mutation.AddStartYear(v)
+	return _u
+}
+
+// SetStartMonth sets the "start_month" field.
+func (_u *BillingTermUpdate) SetStartMonth(v int) *BillingTermUpdate {
+	_u.mutation.ResetStartMonth()
+	_u.mutation.SetStartMonth(v)
+	return _u
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableStartMonth(v *int) *BillingTermUpdate {
+	if v != nil {
+		_u.SetStartMonth(*v)
+	}
+	return _u
+}
+
+// AddStartMonth adds value to the "start_month" field.
+func (_u *BillingTermUpdate) AddStartMonth(v int) *BillingTermUpdate {
+	_u.mutation.AddStartMonth(v)
+	return _u
+}
+
+// SetEndYear sets the "end_year" field.
+func (_u *BillingTermUpdate) SetEndYear(v int) *BillingTermUpdate {
+	_u.mutation.ResetEndYear()
+	_u.mutation.SetEndYear(v)
+	return _u
+}
+
+// SetNillableEndYear sets the "end_year" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableEndYear(v *int) *BillingTermUpdate {
+	if v != nil {
+		_u.SetEndYear(*v)
+	}
+	return _u
+}
+
+// AddEndYear adds value to the "end_year" field.
+func (_u *BillingTermUpdate) AddEndYear(v int) *BillingTermUpdate {
+	_u.mutation.AddEndYear(v)
+	return _u
+}
+
+// SetEndMonth sets the "end_month" field.
+func (_u *BillingTermUpdate) SetEndMonth(v int) *BillingTermUpdate {
+	_u.mutation.ResetEndMonth()
+	_u.mutation.SetEndMonth(v)
+	return _u
+}
+
+// SetNillableEndMonth sets the "end_month" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableEndMonth(v *int) *BillingTermUpdate {
+	if v != nil {
+		_u.SetEndMonth(*v)
+	}
+	return _u
+}
+
+// AddEndMonth adds value to the "end_month" field.
+func (_u *BillingTermUpdate) AddEndMonth(v int) *BillingTermUpdate {
+	_u.mutation.AddEndMonth(v)
+	return _u
+}
+
+// SetLessons sets the "lessons" field.
+func (_u *BillingTermUpdate) SetLessons(v float64) *BillingTermUpdate {
+	_u.mutation.ResetLessons()
+	_u.mutation.SetLessons(v)
+	return _u
+}
+
+// SetNillableLessons sets the "lessons" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableLessons(v *float64) *BillingTermUpdate {
+	if v != nil {
+		_u.SetLessons(*v)
+	}
+	return _u
+}
+
+// AddLessons adds value to the "lessons" field.
+func (_u *BillingTermUpdate) AddLessons(v float64) *BillingTermUpdate {
+	_u.mutation.AddLessons(v)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *BillingTermUpdate) SetCourseID(v int) *BillingTermUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableCourseID(v *int) *BillingTermUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (_u *BillingTermUpdate) ClearCourseID() *BillingTermUpdate {
+	_u.mutation.ClearCourseID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BillingTermUpdate) SetCreatedBy(v string) *BillingTermUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableCreatedBy(v *string) *BillingTermUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BillingTermUpdate) SetCreatedAt(v time.Time) *BillingTermUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BillingTermUpdate) SetNillableCreatedAt(v *time.Time) *BillingTermUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *BillingTermUpdate) SetCourse(v *Course) *BillingTermUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *BillingTermUpdate) AddInvoiceIDs(ids ...int) *BillingTermUpdate {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *BillingTermUpdate) AddInvoices(v ...*Invoice) *BillingTermUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// Mutation returns the BillingTermMutation object of the builder.
+func (_u *BillingTermUpdate) Mutation() *BillingTermMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *BillingTermUpdate) ClearCourse() *BillingTermUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *BillingTermUpdate) ClearInvoices() *BillingTermUpdate {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *BillingTermUpdate) RemoveInvoiceIDs(ids ...int) *BillingTermUpdate {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *BillingTermUpdate) RemoveInvoices(v ...*Invoice) *BillingTermUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BillingTermUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingTermUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BillingTermUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingTermUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingTermUpdate) check() error {
+	if v, ok := _u.mutation.StartMonth(); ok {
+		if err := billingterm.StartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "start_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.start_month": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndMonth(); ok {
+		if err := billingterm.EndMonthValidator(v); err != nil {
+			return &ValidationError{Name: "end_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.end_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingTermUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingterm.Table, billingterm.Columns, sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(billingterm.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartYear(); ok {
+		_spec.SetField(billingterm.FieldStartYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartYear(); ok {
+		_spec.AddField(billingterm.FieldStartYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartMonth(); ok {
+		_spec.SetField(billingterm.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartMonth(); ok {
+		_spec.AddField(billingterm.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndYear(); ok {
+		_spec.SetField(billingterm.FieldEndYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndYear(); ok {
+		_spec.AddField(billingterm.FieldEndYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndMonth(); ok {
+		_spec.SetField(billingterm.FieldEndMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndMonth(); ok {
+		_spec.AddField(billingterm.FieldEndMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Lessons(); ok {
+		_spec.SetField(billingterm.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLessons(); ok {
+		_spec.AddField(billingterm.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(billingterm.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(billingterm.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingterm.CourseTable,
+			Columns: []string{billingterm.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingterm.CourseTable,
+			Columns: []string{billingterm.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BillingTermUpdateOne is the builder for updating a single BillingTerm entity.
+type BillingTermUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingTermMutation
+}
+
+// SetName sets the "name" field.
+func (_u *BillingTermUpdateOne) SetName(v string) *BillingTermUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableName(v *string) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStartYear sets the "start_year" field.
+func (_u *BillingTermUpdateOne) SetStartYear(v int) *BillingTermUpdateOne {
+	_u.mutation.ResetStartYear()
+	_u.mutation.SetStartYear(v)
+	return _u
+}
+
+// SetNillableStartYear sets the "start_year" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableStartYear(v *int) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetStartYear(*v)
+	}
+	return _u
+}
+
+// AddStartYear adds value to the "start_year" field.
+func (_u *BillingTermUpdateOne) AddStartYear(v int) *BillingTermUpdateOne {
+	_u.mutation.AddStartYear(v)
+	return _u
+}
+
+// SetStartMonth sets the "start_month" field.
+func (_u *BillingTermUpdateOne) SetStartMonth(v int) *BillingTermUpdateOne {
+	_u.mutation.ResetStartMonth()
+	_u.mutation.SetStartMonth(v)
+	return _u
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableStartMonth(v *int) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetStartMonth(*v)
+	}
+	return _u
+}
+
+// AddStartMonth adds value to the "start_month" field.
+func (_u *BillingTermUpdateOne) AddStartMonth(v int) *BillingTermUpdateOne {
+	_u.mutation.AddStartMonth(v)
+	return _u
+}
+
+// SetEndYear sets the "end_year" field.
+func (_u *BillingTermUpdateOne) SetEndYear(v int) *BillingTermUpdateOne {
+	_u.mutation.ResetEndYear()
+	_u.mutation.SetEndYear(v)
+	return _u
+}
+
+// SetNillableEndYear sets the "end_year" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableEndYear(v *int) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetEndYear(*v)
+	}
+	return _u
+}
+
+// AddEndYear adds value to the "end_year" field.
+func (_u *BillingTermUpdateOne) AddEndYear(v int) *BillingTermUpdateOne {
+	_u.mutation.AddEndYear(v)
+	return _u
+}
+
+// SetEndMonth sets the "end_month" field.
+func (_u *BillingTermUpdateOne) SetEndMonth(v int) *BillingTermUpdateOne {
+	_u.mutation.ResetEndMonth()
+	_u.mutation.SetEndMonth(v)
+	return _u
+}
+
+// SetNillableEndMonth sets the "end_month" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableEndMonth(v *int) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetEndMonth(*v)
+	}
+	return _u
+}
+
+// AddEndMonth adds value to the "end_month" field.
+func (_u *BillingTermUpdateOne) AddEndMonth(v int) *BillingTermUpdateOne {
+	_u.mutation.AddEndMonth(v)
+	return _u
+}
+
+// SetLessons sets the "lessons" field.
+func (_u *BillingTermUpdateOne) SetLessons(v float64) *BillingTermUpdateOne {
+	_u.mutation.ResetLessons()
+	_u.mutation.SetLessons(v)
+	return _u
+}
+
+// SetNillableLessons sets the "lessons" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableLessons(v *float64) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetLessons(*v)
+	}
+	return _u
+}
+
+// AddLessons adds value to the "lessons" field.
+func (_u *BillingTermUpdateOne) AddLessons(v float64) *BillingTermUpdateOne {
+	_u.mutation.AddLessons(v)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *BillingTermUpdateOne) SetCourseID(v int) *BillingTermUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableCourseID(v *int) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (_u *BillingTermUpdateOne) ClearCourseID() *BillingTermUpdateOne {
+	_u.mutation.ClearCourseID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BillingTermUpdateOne) SetCreatedBy(v string) *BillingTermUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableCreatedBy(v *string) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BillingTermUpdateOne) SetCreatedAt(v time.Time) *BillingTermUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BillingTermUpdateOne) SetNillableCreatedAt(v *time.Time) *BillingTermUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *BillingTermUpdateOne) SetCourse(v *Course) *BillingTermUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *BillingTermUpdateOne) AddInvoiceIDs(ids ...int) *BillingTermUpdateOne {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *BillingTermUpdateOne) AddInvoices(v ...*Invoice) *BillingTermUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// Mutation returns the BillingTermMutation object of the builder.
+func (_u *BillingTermUpdateOne) Mutation() *BillingTermMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *BillingTermUpdateOne) ClearCourse() *BillingTermUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *BillingTermUpdateOne) ClearInvoices() *BillingTermUpdateOne {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *BillingTermUpdateOne) RemoveInvoiceIDs(ids ...int) *BillingTermUpdateOne {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *BillingTermUpdateOne) RemoveInvoices(v ...*Invoice) *BillingTermUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// Where appends a list predicates to the BillingTermUpdate builder.
+func (_u *BillingTermUpdateOne) Where(ps ...predicate.BillingTerm) *BillingTermUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BillingTermUpdateOne) Select(field string, fields ...string) *BillingTermUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BillingTerm entity.
+func (_u *BillingTermUpdateOne) Save(ctx context.Context) (*BillingTerm, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingTermUpdateOne) SaveX(ctx context.Context) *BillingTerm {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BillingTermUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingTermUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingTermUpdateOne) check() error {
+	if v, ok := _u.mutation.StartMonth(); ok {
+		if err := billingterm.StartMonthValidator(v); err != nil {
+			return &ValidationError{Name: "start_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.start_month": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndMonth(); ok {
+		if err := billingterm.EndMonthValidator(v); err != nil {
+			return &ValidationError{Name: "end_month", err: fmt.Errorf(`ent: validator failed for field "BillingTerm.end_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingTermUpdateOne) sqlSave(ctx context.Context) (_node *BillingTerm, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingterm.Table, billingterm.Columns, sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingTerm.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingterm.FieldID)
+		for _, f := range fields {
+			if !billingterm.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingterm.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(billingterm.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartYear(); ok {
+		_spec.SetField(billingterm.FieldStartYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartYear(); ok {
+		_spec.AddField(billingterm.FieldStartYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartMonth(); ok {
+		_spec.SetField(billingterm.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartMonth(); ok {
+		_spec.AddField(billingterm.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndYear(); ok {
+		_spec.SetField(billingterm.FieldEndYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndYear(); ok {
+		_spec.AddField(billingterm.FieldEndYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndMonth(); ok {
+		_spec.SetField(billingterm.FieldEndMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndMonth(); ok {
+		_spec.AddField(billingterm.FieldEndMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Lessons(); ok {
+		_spec.SetField(billingterm.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLessons(); ok {
+		_spec.AddField(billingterm.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(billingterm.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(billingterm.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingterm.CourseTable,
+			Columns: []string{billingterm.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingterm.CourseTable,
+			Columns: []string{billingterm.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   billingterm.InvoicesTable,
+			Columns: []string{billingterm.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BillingTerm{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashsession"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CashMovementUpdate is the builder for updating CashMovement entities.
+type CashMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashMovementMutation
+}
+
+// Where appends a list predicates to the CashMovementUpdate builder.
+func (_u *CashMovementUpdate) Where(ps ...predicate.CashMovement) *CashMovementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *CashMovementUpdate) SetSessionID(v int) *CashMovementUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableSessionID(v *int) *CashMovementUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CashMovementUpdate) SetKind(v cashmovement.Kind) *CashMovementUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableKind(v *cashmovement.Kind) *CashMovementUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *CashMovementUpdate) SetAmountCents(v int64) *CashMovementUpdate {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableAmountCents(v *int64) *CashMovementUpdate {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *CashMovementUpdate) AddAmountCents(v int64) *CashMovementUpdate {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashMovementUpdate) SetNote(v string) *CashMovementUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableNote(v *string) *CashMovementUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedByUserID sets the "created_by_user_id" field.
+func (_u *CashMovementUpdate) SetCreatedByUserID(v int) *CashMovementUpdate {
+	_u.mutation.ResetCreatedByUserID()
+	_u.mutation.SetCreatedByUserID(v)
+	return _u
+}
+
+// SetNillableCreatedByUserID sets the "created_by_user_id" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableCreatedByUserID(v *int) *CashMovementUpdate {
+	if v != nil {
+		_u.SetCreatedByUserID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByUserID adds value to the "created_by_user_id" field.
+func (_u *CashMovementUpdate) AddCreatedByUserID(v int) *CashMovementUpdate {
+	_u.mutation.AddCreatedByUserID(v)
+	return _u
+}
+
+// ClearCreatedByUserID clears the value of the "created_by_user_id" field.
+func (_u *CashMovementUpdate) ClearCreatedByUserID() *CashMovementUpdate {
+	_u.mutation.ClearCreatedByUserID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CashMovementUpdate) SetCreatedBy(v string) *CashMovementUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableCreatedBy(v *string) *CashMovementUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CashMovementUpdate) SetCreatedAt(v time.Time) *CashMovementUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillableCreatedAt(v *time.Time) *CashMovementUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_u *CashMovementUpdate) SetPaymentID(v int) *CashMovementUpdate {
+	_u.mutation.ResetPaymentID()
+	_u.mutation.SetPaymentID(v)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (_u *CashMovementUpdate) SetNillablePaymentID(v *int) *CashMovementUpdate {
+	if v != nil {
+		_u.SetPaymentID(*v)
+	}
+	return _u
+}
+
+// AddPaymentID adds value to the "payment_id" field.
+func (_u *CashMovementUpdate) AddPaymentID(v int) *CashMovementUpdate {
+	_u.mutation.AddPaymentID(v)
+	return _u
+}
+
+// ClearPaymentID clears the value of the "payment_id" field.
+func (_u *CashMovementUpdate) ClearPaymentID() *CashMovementUpdate {
+	_u.mutation.ClearPaymentID()
+	return _u
+}
+
+// SetSession sets the "session" edge to the CashSession entity.
+func (_u *CashMovementUpdate) SetSession(v *CashSession) *CashMovementUpdate {
+	return _u.SetSessionID(v.ID)
+}
+
+// Mutation returns the CashMovementMutation object of the builder.
+func (_u *CashMovementUpdate) Mutation() *CashMovementMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the CashSession entity.
+func (_u *CashMovementUpdate) ClearSession() *CashMovementUpdate {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashMovementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashMovementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashMovementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashMovementUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := cashmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CashMovement.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CashMovement.session"`)
+	}
+	return nil
+}
+
+func (_u *CashMovementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashmovement.Table, cashmovement.Columns, sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(cashmovement.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(cashmovement.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(cashmovement.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashmovement.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedByUserID(); ok {
+		_spec.SetField(cashmovement.FieldCreatedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByUserID(); ok {
+		_spec.AddField(cashmovement.FieldCreatedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByUserIDCleared() {
+		_spec.ClearField(cashmovement.FieldCreatedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(cashmovement.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(cashmovement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentID(); ok {
+		_spec.SetField(cashmovement.FieldPaymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentID(); ok {
+		_spec.AddField(cashmovement.FieldPaymentID, field.TypeInt, value)
+	}
+	if _u.mutation.PaymentIDCleared() {
+		_spec.ClearField(cashmovement.FieldPaymentID, field.TypeInt)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashmovement.SessionTable,
+			Columns: []string{cashmovement.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashmovement.SessionTable,
+			Columns: []string{cashmovement.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashMovementUpdateOne is the builder for updating a single CashMovement entity.
+type CashMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashMovementMutation
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *CashMovementUpdateOne) SetSessionID(v int) *CashMovementUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableSessionID(v *int) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CashMovementUpdateOne) SetKind(v cashmovement.Kind) *CashMovementUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableKind(v *cashmovement.Kind) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *CashMovementUpdateOne) SetAmountCents(v int64) *CashMovementUpdateOne {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableAmountCents(v *int64) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *CashMovementUpdateOne) AddAmountCents(v int64) *CashMovementUpdateOne {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashMovementUpdateOne) SetNote(v string) *CashMovementUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableNote(v *string) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedByUserID sets the "created_by_user_id" field.
+func (_u *CashMovementUpdateOne) SetCreatedByUserID(v int) *CashMovementUpdateOne {
+	_u.mutation.ResetCreatedByUserID()
+	_u.mutation.SetCreatedByUserID(v)
+	return _u
+}
+
+// SetNillableCreatedByUserID sets the "created_by_user_id" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableCreatedByUserID(v *int) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedByUserID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByUserID adds value to the "created_by_user_id" field.
+func (_u *CashMovementUpdateOne) AddCreatedByUserID(v int) *CashMovementUpdateOne {
+	_u.mutation.AddCreatedByUserID(v)
+	return _u
+}
+
+// ClearCreatedByUserID clears the value of the "created_by_user_id" field.
+func (_u *CashMovementUpdateOne) ClearCreatedByUserID() *CashMovementUpdateOne {
+	_u.mutation.ClearCreatedByUserID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CashMovementUpdateOne) SetCreatedBy(v string) *CashMovementUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableCreatedBy(v *string) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CashMovementUpdateOne) SetCreatedAt(v time.Time) *CashMovementUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillableCreatedAt(v *time.Time) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_u *CashMovementUpdateOne) SetPaymentID(v int) *CashMovementUpdateOne {
+	_u.mutation.ResetPaymentID()
+	_u.mutation.SetPaymentID(v)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (_u *CashMovementUpdateOne) SetNillablePaymentID(v *int) *CashMovementUpdateOne {
+	if v != nil {
+		_u.SetPaymentID(*v)
+	}
+	return _u
+}
+
+// AddPaymentID adds value to the "payment_id" field.
+func (_u *CashMovementUpdateOne) AddPaymentID(v int) *CashMovementUpdateOne {
+	_u.mutation.AddPaymentID(v)
+	return _u
+}
+
+// ClearPaymentID clears the value of the "payment_id" field.
+func (_u *CashMovementUpdateOne) ClearPaymentID() *CashMovementUpdateOne {
+	_u.mutation.ClearPaymentID()
+	return _u
+}
+
+// SetSession sets the "session" edge to the CashSession entity.
+func (_u *CashMovementUpdateOne) SetSession(v *CashSession) *CashMovementUpdateOne {
+	return _u.SetSessionID(v.ID)
+}
+
+// Mutation returns the CashMovementMutation object of the builder.
+func (_u *CashMovementUpdateOne) Mutation() *CashMovementMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the CashSession entity.
+func (_u *CashMovementUpdateOne) ClearSession() *CashMovementUpdateOne {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// Where appends a list predicates to the CashMovementUpdate builder.
+func (_u *CashMovementUpdateOne) Where(ps ...predicate.CashMovement) *CashMovementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashMovementUpdateOne) Select(field string, fields ...string) *CashMovementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashMovement entity.
+func (_u *CashMovementUpdateOne) Save(ctx context.Context) (*CashMovement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashMovementUpdateOne) SaveX(ctx context.Context) *CashMovement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashMovementUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := cashmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CashMovement.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CashMovement.session"`)
+	}
+	return nil
+}
+
+func (_u *CashMovementUpdateOne) sqlSave(ctx context.Context) (_node *CashMovement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashmovement.Table, cashmovement.Columns, sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashmovement.FieldID)
+		for _, f := range fields {
+			if !cashmovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(cashmovement.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(cashmovement.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(cashmovement.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashmovement.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedByUserID(); ok {
+		_spec.SetField(cashmovement.FieldCreatedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByUserID(); ok {
+		_spec.AddField(cashmovement.FieldCreatedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByUserIDCleared() {
+		_spec.ClearField(cashmovement.FieldCreatedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(cashmovement.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(cashmovement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentID(); ok {
+		_spec.SetField(cashmovement.FieldPaymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentID(); ok {
+		_spec.AddField(cashmovement.FieldPaymentID, field.TypeInt, value)
+	}
+	if _u.mutation.PaymentIDCleared() {
+		_spec.ClearField(cashmovement.FieldPaymentID, field.TypeInt)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashmovement.SessionTable,
+			Columns: []string{cashmovement.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashmovement.SessionTable,
+			Columns: []string{cashmovement.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CashMovement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CashReceipt is the model entity for the CashReceipt schema.
+type CashReceipt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// AmountCents holds the value of the "amount_cents" field.
+	AmountCents int64 `json:"amount_cents,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt time.Time `json:"paid_at,omitempty"`
+	// ReceivedByUserID holds the value of the "received_by_user_id" field.
+	ReceivedByUserID *int `json:"received_by_user_id,omitempty"`
+	// ReceivedBy holds the value of the "received_by" field.
+	ReceivedBy string `json:"received_by,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Status holds the value of the "status" field.
+	Status cashreceipt.Status `json:"status,omitempty"`
+	// VoidedAt holds the value of the "voided_at" field.
+	VoidedAt *time.Time `json:"voided_at,omitempty"`
+	// VoidedBy holds the value of the "voided_by" field.
+	VoidedBy string `json:"voided_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CashSessionID holds the value of the "cash_session_id" field.
+	CashSessionID *int `json:"cash_session_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CashReceiptQuery when eager-loading is set.
+	Edges        CashReceiptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CashReceiptEdges holds the relations/edges for other nodes in the graph.
+type CashReceiptEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// CashSession holds the value of the cash_session edge.
+	CashSession *CashSession `json:"cash_session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CashReceiptEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e CashReceiptEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[1] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// CashSessionOrErr returns the CashSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CashReceiptEdges) CashSessionOrErr() (*CashSession, error) {
+	if e.CashSession != nil {
+		return e.CashSession, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: cashsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "cash_session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashReceipt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashreceipt.FieldID, cashreceipt.FieldSeq, cashreceipt.FieldStudentID, cashreceipt.FieldAmountCents, cashreceipt.FieldReceivedByUserID, cashreceipt.FieldCashSessionID:
+			values[i] = new(sql.NullInt64)
+		case cashreceipt.FieldNumber, cashreceipt.FieldReceivedBy, cashreceipt.FieldNote, cashreceipt.FieldStatus, cashreceipt.FieldVoidedBy:
+			values[i] = new(sql.NullString)
+		case cashreceipt.FieldPaidAt, cashreceipt.FieldVoidedAt, cashreceipt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashReceipt fields.
+func (_m *CashReceipt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashreceipt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case cashreceipt.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = int(value.Int64)
+			}
+		case cashreceipt.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case cashreceipt.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case cashreceipt.FieldAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_cents", values[i])
+			} else if value.Valid {
+				_m.AmountCents = value.Int64
+			}
+		case cashreceipt.FieldPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[i])
+			} else if value.Valid {
+				_m.PaidAt = value.Time
+			}
+		case cashreceipt.FieldReceivedByUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field received_by_user_id", values[i])
+			} else if value.Valid {
+				_m.ReceivedByUserID = new(int)
+				*_m.ReceivedByUserID = int(value.Int64)
+			}
+		case cashreceipt.FieldReceivedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field received_by", values[i])
+			} else if value.Valid {
+				_m.ReceivedBy = value.String
+			}
+		case cashreceipt.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case cashreceipt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = cashreceipt.Status(value.String)
+			}
+		case cashreceipt.FieldVoidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field voided_at", values[i])
+			} else if value.Valid {
+				_m.VoidedAt = new(time.Time)
+				*_m.VoidedAt = value.Time
+			}
+		case cashreceipt.FieldVoidedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voided_by", values[i])
+			} else if value.Valid {
+				_m.VoidedBy = value.String
+			}
+		case cashreceipt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cashreceipt.FieldCashSessionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cash_session_id", values[i])
+			} else if value.Valid {
+				_m.CashSessionID = new(int)
+				*_m.CashSessionID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashReceipt.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashReceipt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the CashReceipt entity.
+func (_m *CashReceipt) QueryStudent() *StudentQuery {
+	return NewCashReceiptClient(_m.config).QueryStudent(_m)
+}
+
+// QueryPayments queries the "payments" edge of the CashReceipt entity.
+func (_m *CashReceipt) QueryPayments() *PaymentQuery {
+	return NewCashReceiptClient(_m.config).QueryPayments(_m)
+}
+
+// QueryCashSession queries the "cash_session" edge of the CashReceipt entity.
+func (_m *CashReceipt) QueryCashSession() *CashSessionQuery {
+	return NewCashReceiptClient(_m.config).QueryCashSession(_m)
+}
+
+// Update returns a builder for updating this CashReceipt.
+// Note that you need to call CashReceipt.Unwrap() before calling this method if this CashReceipt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashReceipt) Update() *CashReceiptUpdateOne {
+	return NewCashReceiptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashReceipt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashReceipt) Unwrap() *CashReceipt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CashReceipt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashReceipt) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashReceipt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
+	builder.WriteString(", ")
+	builder.WriteString("paid_at=")
+	builder.WriteString(_m.PaidAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReceivedByUserID; v != nil {
+		builder.WriteString("received_by_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("received_by=")
+	builder.WriteString(_m.ReceivedBy)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.VoidedAt; v != nil {
+		builder.WriteString("voided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("voided_by=")
+	builder.WriteString(_m.VoidedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CashSessionID; v != nil {
+		builder.WriteString("cash_session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashReceipts is a parsable slice of CashReceipt.
+type CashReceipts []*CashReceipt
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashreceipt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the cashreceipt type in the database.
+	Label = "cash_receipt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldAmountCents holds the string denoting the amount_cents field in the database.
+	FieldAmountCents = "amount_cents"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+	// FieldReceivedByUserID holds the string denoting the received_by_user_id field in the database.
+	FieldReceivedByUserID = "received_by_user_id"
+	// FieldReceivedBy holds the string denoting the received_by field in the database.
+	FieldReceivedBy = "received_by"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVoidedAt holds the string denoting the voided_at field in the database.
+	FieldVoidedAt = "voided_at"
+	// FieldVoidedBy holds the string denoting the voided_by field in the database.
+	FieldVoidedBy = "voided_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCashSessionID holds the string denoting the cash_session_id field in the database.
+	FieldCashSessionID = "cash_session_id"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgeCashSession holds the string denoting the cash_session edge name in mutations.
+	EdgeCashSession = "cash_session"
+	// Table holds the table name of the cashreceipt in the database.
+	Table = "cash_receipts"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "cash_receipts"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "cash_receipt_id"
+	// CashSessionTable is the table that holds the cash_session relation/edge.
+	CashSessionTable = "cash_receipts"
+	// CashSessionInverseTable is the table name for the CashSession entity.
+	// It exists in this package in order to avoid circular dependency with the "cashsession" package.
+	CashSessionInverseTable = "cash_sessions"
+	// CashSessionColumn is the table column denoting the cash_session relation/edge.
+	CashSessionColumn = "cash_session_id"
+)
+
+// Columns holds all SQL columns for cashreceipt fields.
+var Columns = []string{
+	FieldID,
+	FieldSeq,
+	FieldNumber,
+	FieldStudentID,
+	FieldAmountCents,
+	FieldPaidAt,
+	FieldReceivedByUserID,
+	FieldReceivedBy,
+	FieldNote,
+	FieldStatus,
+	FieldVoidedAt,
+	FieldVoidedBy,
+	FieldCreatedAt,
+	FieldCashSessionID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReceivedBy holds the default value on creation for the "received_by" field.
+	DefaultReceivedBy string
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultVoidedBy holds the default value on creation for the "voided_by" field.
+	DefaultVoidedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusIssued is the default value of the Status enum.
+const DefaultStatus = StatusIssued
+
+// Status values.
+const (
+	StatusIssued Status = "issued"
+	StatusVoided Status = "voided"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusIssued, StatusVoided:
+		return nil
+	default:
+		return fmt.Errorf("cashreceipt: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the CashReceipt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByAmountCents orders the results by the amount_cents field.
+func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
+}
+
+// ByPaidAt orders the results by the paid_at field.
+func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
+}
+
+// ByReceivedByUserID orders the results by the received_by_user_id field.
+func ByReceivedByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedByUserID, opts...).ToFunc()
+}
+
+// ByReceivedBy orders the results by the received_by field.
+func ByReceivedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedBy, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByVoidedAt orders the results by the voided_at field.
+func ByVoidedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoidedAt, opts...).ToFunc()
+}
+
+// ByVoidedBy orders the results by the voided_by field.
+func ByVoidedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoidedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCashSessionID orders the results by the cash_session_id field.
+func ByCashSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashSessionID, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCashSessionField orders the results by cash_session field.
+func ByCashSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCashSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
+func newCashSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CashSessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CashSessionTable, CashSessionColumn),
+	)
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashreceipt
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldID, id))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldSeq, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldNumber, v))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldStudentID, v))
+}
+
+// AmountCents applies equality check predicate on the "amount_cents" field. It's identical to AmountCentsEQ.
+func AmountCents(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// ReceivedByUserID applies equality check predicate on the "received_by_user_id" field. It's identical to ReceivedByUserIDEQ.
+func ReceivedByUserID(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldReceivedByUserID, v))
+}
+
+// ReceivedBy applies equality check predicate on the "received_by" field. It's identical to ReceivedByEQ.
+func ReceivedBy(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldReceivedBy, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldNote, v))
+}
+
+// VoidedAt applies equality check predicate on the "voided_at" field. It's identical to VoidedAtEQ.
+func VoidedAt(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldVoidedAt, v))
+}
+
+// VoidedBy applies equality check predicate on the "voided_by" field. It's identical to VoidedByEQ.
+func VoidedBy(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldVoidedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CashSessionID applies equality check predicate on the "cash_session_id" field. It's identical to CashSessionIDEQ.
+func CashSessionID(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldCashSessionID, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldSeq, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// AmountCentsEQ applies the EQ predicate on the "amount_cents" field.
+func AmountCentsEQ(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// AmountCentsNEQ applies the NEQ predicate on the "amount_cents" field.
+func AmountCentsNEQ(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldAmountCents, v))
+}
+
+// AmountCentsIn applies the In predicate on the "amount_cents" field.
+func AmountCentsIn(vs ...int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsNotIn applies the NotIn predicate on the "amount_cents" field.
+func AmountCentsNotIn(vs ...int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsGT applies the GT predicate on the "amount_cents" field.
+func AmountCentsGT(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldAmountCents, v))
+}
+
+// AmountCentsGTE applies the GTE predicate on the "amount_cents" field.
+func AmountCentsGTE(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldAmountCents, v))
+}
+
+// AmountCentsLT applies the LT predicate on the "amount_cents" field.
+func AmountCentsLT(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldAmountCents, v))
+}
+
+// AmountCentsLTE applies the LTE predicate on the "amount_cents" field.
+func AmountCentsLTE(v int64) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldAmountCents, v))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// ReceivedByUserIDEQ applies the EQ predicate on the "received_by_user_id" field.
+func ReceivedByUserIDEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDNEQ applies the NEQ predicate on the "received_by_user_id" field.
+func ReceivedByUserIDNEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDIn applies the In predicate on the "received_by_user_id" field.
+func ReceivedByUserIDIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldReceivedByUserID, vs...))
+}
+
+// ReceivedByUserIDNotIn applies the NotIn predicate on the "received_by_user_id" field.
+func ReceivedByUserIDNotIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldReceivedByUserID, vs...))
+}
+
+// ReceivedByUserIDGT applies the GT predicate on the "received_by_user_id" field.
+func ReceivedByUserIDGT(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDGTE applies the GTE predicate on the "received_by_user_id" field.
+func ReceivedByUserIDGTE(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDLT applies the LT predicate on the "received_by_user_id" field.
+func ReceivedByUserIDLT(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDLTE applies the LTE predicate on the "received_by_user_id" field.
+func ReceivedByUserIDLTE(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldReceivedByUserID, v))
+}
+
+// ReceivedByUserIDIsNil applies the IsNil predicate on the "received_by_user_id" field.
+func ReceivedByUserIDIsNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIsNull(FieldReceivedByUserID))
+}
+
+// ReceivedByUserIDNotNil applies the NotNil predicate on the "received_by_user_id" field.
+func ReceivedByUserIDNotNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotNull(FieldReceivedByUserID))
+}
+
+// ReceivedByEQ applies the EQ predicate on the "received_by" field.
+func ReceivedByEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldReceivedBy, v))
+}
+
+// ReceivedByNEQ applies the NEQ predicate on the "received_by" field.
+func ReceivedByNEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldReceivedBy, v))
+}
+
+// ReceivedByIn applies the In predicate on the "received_by" field.
+func ReceivedByIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldReceivedBy, vs...))
+}
+
+// ReceivedByNotIn applies the NotIn predicate on the "received_by" field.
+func ReceivedByNotIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldReceivedBy, vs...))
+}
+
+// ReceivedByGT applies the GT predicate on the "received_by" field.
+func ReceivedByGT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldReceivedBy, v))
+}
+
+// ReceivedByGTE applies the GTE predicate on the "received_by" field.
+func ReceivedByGTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldReceivedBy, v))
+}
+
+// ReceivedByLT applies the LT predicate on the "received_by" field.
+func ReceivedByLT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldReceivedBy, v))
+}
+
+// ReceivedByLTE applies the LTE predicate on the "received_by" field.
+func ReceivedByLTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldReceivedBy, v))
+}
+
+// ReceivedByContains applies the Contains predicate on the "received_by" field.
+func ReceivedByContains(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContains(FieldReceivedBy, v))
+}
+
+// ReceivedByHasPrefix applies the HasPrefix predicate on the "received_by" field.
+func ReceivedByHasPrefix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasPrefix(FieldReceivedBy, v))
+}
+
+// ReceivedByHasSuffix applies the HasSuffix predicate on the "received_by" field.
+func ReceivedByHasSuffix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasSuffix(FieldReceivedBy, v))
+}
+
+// ReceivedByEqualFold applies the EqualFold predicate on the "received_by" field.
+func ReceivedByEqualFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEqualFold(FieldReceivedBy, v))
+}
+
+// ReceivedByContainsFold applies the ContainsFold predicate on the "received_by" field.
+func ReceivedByContainsFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContainsFold(FieldReceivedBy, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContainsFold(FieldNote, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// VoidedAtEQ applies the EQ predicate on the "voided_at" field.
+func VoidedAtEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldVoidedAt, v))
+}
+
+// VoidedAtNEQ applies the NEQ predicate on the "voided_at" field.
+func VoidedAtNEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldVoidedAt, v))
+}
+
+// VoidedAtIn applies the In predicate on the "voided_at" field.
+func VoidedAtIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldVoidedAt, vs...))
+}
+
+// VoidedAtNotIn applies the NotIn predicate on the "voided_at" field.
+func VoidedAtNotIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldVoidedAt, vs...))
+}
+
+// VoidedAtGT applies the GT predicate on the "voided_at" field.
+func VoidedAtGT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldVoidedAt, v))
+}
+
+// VoidedAtGTE applies the GTE predicate on the "voided_at" field.
+func VoidedAtGTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldVoidedAt, v))
+}
+
+// VoidedAtLT applies the LT predicate on the "voided_at" field.
+func VoidedAtLT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldVoidedAt, v))
+}
+
+// VoidedAtLTE applies the LTE predicate on the "voided_at" field.
+func VoidedAtLTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldVoidedAt, v))
+}
+
+// VoidedAtIsNil applies the IsNil predicate on the "voided_at" field.
+func VoidedAtIsNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIsNull(FieldVoidedAt))
+}
+
+// VoidedAtNotNil applies the NotNil predicate on the "voided_at" field.
+func VoidedAtNotNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotNull(FieldVoidedAt))
+}
+
+// VoidedByEQ applies the EQ predicate on the "voided_by" field.
+func VoidedByEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldVoidedBy, v))
+}
+
+// VoidedByNEQ applies the NEQ predicate on the "voided_by" field.
+func VoidedByNEQ(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldVoidedBy, v))
+}
+
+// VoidedByIn applies the In predicate on the "voided_by" field.
+func VoidedByIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldVoidedBy, vs...))
+}
+
+// VoidedByNotIn applies the NotIn predicate on the "voided_by" field.
+func VoidedByNotIn(vs ...string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldVoidedBy, vs...))
+}
+
+// VoidedByGT applies the GT predicate on the "voided_by" field.
+func VoidedByGT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldVoidedBy, v))
+}
+
+// VoidedByGTE applies the GTE predicate on the "voided_by" field.
+func VoidedByGTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldVoidedBy, v))
+}
+
+// VoidedByLT applies the LT predicate on the "voided_by" field.
+func VoidedByLT(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldVoidedBy, v))
+}
+
+// VoidedByLTE applies the LTE predicate on the "voided_by" field.
+func VoidedByLTE(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldVoidedBy, v))
+}
+
+// VoidedByContains applies the Contains predicate on the "voided_by" field.
+func VoidedByContains(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContains(FieldVoidedBy, v))
+}
+
+// VoidedByHasPrefix applies the HasPrefix predicate on the "voided_by" field.
+func VoidedByHasPrefix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasPrefix(FieldVoidedBy, v))
+}
+
+// VoidedByHasSuffix applies the HasSuffix predicate on the "voided_by" field.
+func VoidedByHasSuffix(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldHasSuffix(FieldVoidedBy, v))
+}
+
+// VoidedByEqualFold applies the EqualFold predicate on the "voided_by" field.
+func VoidedByEqualFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEqualFold(FieldVoidedBy, v))
+}
+
+// VoidedByContainsFold applies the ContainsFold predicate on the "voided_by" field.
+func VoidedByContainsFold(v string) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldContainsFold(FieldVoidedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CashSessionIDEQ applies the EQ predicate on the "cash_session_id" field.
+func CashSessionIDEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldEQ(FieldCashSessionID, v))
+}
+
+// CashSessionIDNEQ applies the NEQ predicate on the "cash_session_id" field.
+func CashSessionIDNEQ(v int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNEQ(FieldCashSessionID, v))
+}
+
+// CashSessionIDIn applies the In predicate on the "cash_session_id" field.
+func CashSessionIDIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIn(FieldCashSessionID, vs...))
+}
+
+// CashSessionIDNotIn applies the NotIn predicate on the "cash_session_id" field.
+func CashSessionIDNotIn(vs ...int) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotIn(FieldCashSessionID, vs...))
+}
+
+// CashSessionIDIsNil applies the IsNil predicate on the "cash_session_id" field.
+func CashSessionIDIsNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldIsNull(FieldCashSessionID))
+}
+
+// CashSessionIDNotNil applies the NotNil predicate on the "cash_session_id" field.
+func CashSessionIDNotNil() predicate.CashReceipt {
+	return predicate.CashReceipt(sql.FieldNotNull(FieldCashSessionID))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.Payment) predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := newPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCashSession applies the HasEdge predicate on the "cash_session" edge.
+func HasCashSession() predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CashSessionTable, CashSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCashSessionWith applies the HasEdge predicate on the "cash_session" edge with a given conditions (other predicates).
+func HasCashSessionWith(preds ...predicate.CashSession) predicate.CashReceipt {
+	return predicate.CashReceipt(func(s *sql.Selector) {
+		step := newCashSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashReceipt) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashReceipt) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashReceipt) predicate.CashReceipt {
+	return predicate.CashReceipt(sql.NotPredicates(p))
+}
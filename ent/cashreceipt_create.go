@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CashReceiptCreate is the builder for creating a CashReceipt entity.
+type CashReceiptCreate struct {
+	config
+	mutation *CashReceiptMutation
+	hooks    []Hook
+}
+
+// SetSeq sets the "seq" field.
+func (_c *CashReceiptCreate) SetSeq(v int) *CashReceiptCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *CashReceiptCreate) SetNumber(v string) *CashReceiptCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *CashReceiptCreate) SetStudentID(v int) *CashReceiptCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_c *CashReceiptCreate) SetAmountCents(v int64) *CashReceiptCreate {
+	_c.mutation.SetAmountCents(v)
+	return _c
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_c *CashReceiptCreate) SetPaidAt(v time.Time) *CashReceiptCreate {
+	_c.mutation.SetPaidAt(v)
+	return _c
+}
+
+// SetReceivedByUserID sets the "received_by_user_id" field.
+func (_c *CashReceiptCreate) SetReceivedByUserID(v int) *CashReceiptCreate {
+	_c.mutation.SetReceivedByUserID(v)
+	return _c
+}
+
+// SetNillableReceivedByUserID sets the "received_by_user_id" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableReceivedByUserID(v *int) *CashReceiptCreate {
+	if v != nil {
+		_c.SetReceivedByUserID(*v)
+	}
+	return _c
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_c *CashReceiptCreate) SetReceivedBy(v string) *CashReceiptCreate {
+	_c.mutation.SetReceivedBy(v)
+	return _c
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableReceivedBy(v *string) *CashReceiptCreate {
+	if v != nil {
+		_c.SetReceivedBy(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *CashReceiptCreate) SetNote(v string) *CashReceiptCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableNote(v *string) *CashReceiptCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CashReceiptCreate) SetStatus(v cashreceipt.Status) *CashReceiptCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableStatus(v *cashreceipt.Status) *CashReceiptCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_c *CashReceiptCreate) SetVoidedAt(v time.Time) *CashReceiptCreate {
+	_c.mutation.SetVoidedAt(v)
+	return _c
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableVoidedAt(v *time.Time) *CashReceiptCreate {
+	if v != nil {
+		_c.SetVoidedAt(*v)
+	}
+	return _c
+}
+
+// SetVoidedBy sets the "voided_by" field.
+func (_c *CashReceiptCreate) SetVoidedBy(v string) *CashReceiptCreate {
+	_c.mutation.SetVoidedBy(v)
+	return _c
+}
+
+// SetNillableVoidedBy sets the "voided_by" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableVoidedBy(v *string) *CashReceiptCreate {
+	if v != nil {
+		_c.SetVoidedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CashReceiptCreate) SetCreatedAt(v time.Time) *CashReceiptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableCreatedAt(v *time.Time) *CashReceiptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCashSessionID sets the "cash_session_id" field.
+func (_c *CashReceiptCreate) SetCashSessionID(v int) *CashReceiptCreate {
+	_c.mutation.SetCashSessionID(v)
+	return _c
+}
+
+// SetNillableCashSessionID sets the "cash_session_id" field if the given value is not nil.
+func (_c *CashReceiptCreate) SetNillableCashSessionID(v *int) *CashReceiptCreate {
+	if v != nil {
+		_c.SetCashSessionID(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *CashReceiptCreate) SetStudent(v *Student) *CashReceiptCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_c *CashReceiptCreate) AddPaymentIDs(ids ...int) *CashReceiptCreate {
+	_c.mutation.AddPaymentIDs(ids...)
+	return _c
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_c *CashReceiptCreate) AddPayments(v ...*Payment) *CashReceiptCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentIDs(ids...)
+}
+
+// SetCashSession sets the "cash_session" edge to the CashSession entity.
+func (_c *CashReceiptCreate) SetCashSession(v *CashSession) *CashReceiptCreate {
+	return _c.SetCashSessionID(v.ID)
+}
+
+// Mutation returns the CashReceiptMutation object of the builder.
+func (_c *CashReceiptCreate) Mutation() *CashReceiptMutation {
+	return _c.mutation
+}
+
+// Save creates the CashReceipt in the database.
+func (_c *CashReceiptCreate) Save(ctx context.Context) (*CashReceipt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashReceiptCreate) SaveX(ctx context.Context) *CashReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashReceiptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashReceiptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashReceiptCreate) defaults() {
+	if _, ok := _c.mutation.ReceivedBy(); !ok {
+		v := cashreceipt.DefaultReceivedBy
+		_c.mutation.SetReceivedBy(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := cashreceipt.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := cashreceipt.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.VoidedBy(); !ok {
+		v := cashreceipt.DefaultVoidedBy
+		_c.mutation.SetVoidedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cashreceipt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashReceiptCreate) check() error {
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "CashReceipt.seq"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "CashReceipt.number"`)}
+	}
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "CashReceipt.student_id"`)}
+	}
+	if _, ok := _c.mutation.AmountCents(); !ok {
+		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "CashReceipt.amount_cents"`)}
+	}
+	if _, ok := _c.mutation.PaidAt(); !ok {
+		return &ValidationError{Name: "paid_at", err: errors.New(`ent: missing required field "CashReceipt.paid_at"`)}
+	}
+	if _, ok := _c.mutation.ReceivedBy(); !ok {
+		return &ValidationError{Name: "received_by", err: errors.New(`ent: missing required field "CashReceipt.received_by"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "CashReceipt.note"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CashReceipt.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := cashreceipt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashReceipt.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VoidedBy(); !ok {
+		return &ValidationError{Name: "voided_by", err: errors.New(`ent: missing required field "CashReceipt.voided_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CashReceipt.created_at"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "CashReceipt.student"`)}
+	}
+	return nil
+}
+
+func (_c *CashReceiptCreate) sqlSave(ctx context.Context) (*CashReceipt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashReceiptCreate) createSpec() (*CashReceipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashReceipt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashreceipt.Table, sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(cashreceipt.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(cashreceipt.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.AmountCents(); ok {
+		_spec.SetField(cashreceipt.FieldAmountCents, field.TypeInt64, value)
+		_node.AmountCents = value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(cashreceipt.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = value
+	}
+	if value, ok := _c.mutation.ReceivedByUserID(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedByUserID, field.TypeInt, value)
+		_node.ReceivedByUserID = &value
+	}
+	if value, ok := _c.mutation.ReceivedBy(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedBy, field.TypeString, value)
+		_node.ReceivedBy = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(cashreceipt.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(cashreceipt.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.VoidedAt(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedAt, field.TypeTime, value)
+		_node.VoidedAt = &value
+	}
+	if value, ok := _c.mutation.VoidedBy(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedBy, field.TypeString, value)
+		_node.VoidedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cashreceipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.StudentTable,
+			Columns: []string{cashreceipt.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CashSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.CashSessionTable,
+			Columns: []string{cashreceipt.CashSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CashSessionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CashReceiptCreateBulk is the builder for creating many CashReceipt entities in bulk.
+type CashReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*CashReceiptCreate
+}
+
+// Save creates the CashReceipt entities in the database.
+func (_c *CashReceiptCreateBulk) Save(ctx context.Context) ([]*CashReceipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashReceipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashReceiptCreateBulk) SaveX(ctx context.Context) []*CashReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1071 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"langschool/ent/payment"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CashReceiptUpdate is the builder for updating CashReceipt entities.
+type CashReceiptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashReceiptMutation
+}
+
+// Where appends a list predicates to the CashReceiptUpdate builder.
+func (_u *CashReceiptUpdate) Where(ps ...predicate.CashReceipt) *CashReceiptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *CashReceiptUpdate) SetSeq(v int) *CashReceiptUpdate {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableSeq(v *int) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *CashReceiptUpdate) AddSeq(v int) *CashReceiptUpdate {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *CashReceiptUpdate) SetNumber(v string) *CashReceiptUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableNumber(v *string) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *CashReceiptUpdate) SetStudentID(v int) *CashReceiptUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableStudentID(v *int) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *CashReceiptUpdate) SetAmountCents(v int64) *CashReceiptUpdate {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableAmountCents(v *int64) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *CashReceiptUpdate) AddAmountCents(v int64) *CashReceiptUpdate {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *CashReceiptUpdate) SetPaidAt(v time.Time) *CashReceiptUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillablePaidAt(v *time.Time) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// SetReceivedByUserID sets the "received_by_user_id" field.
+func (_u *CashReceiptUpdate) SetReceivedByUserID(v int) *CashReceiptUpdate {
+	_u.mutation.ResetReceivedByUserID()
+	_u.mutation.SetReceivedByUserID(v)
+	return _u
+}
+
+// SetNillableReceivedByUserID sets the "received_by_user_id" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableReceivedByUserID(v *int) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetReceivedByUserID(*v)
+	}
+	return _u
+}
+
+// AddReceivedByUserID adds value to the "received_by_user_id" field.
+func (_u *CashReceiptUpdate) AddReceivedByUserID(v int) *CashReceiptUpdate {
+	_u.mutation.AddReceivedByUserID(v)
+	return _u
+}
+
+// ClearReceivedByUserID clears the value of the "received_by_user_id" field.
+func (_u *CashReceiptUpdate) ClearReceivedByUserID() *CashReceiptUpdate {
+	_u.mutation.ClearReceivedByUserID()
+	return _u
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_u *CashReceiptUpdate) SetReceivedBy(v string) *CashReceiptUpdate {
+	_u.mutation.SetReceivedBy(v)
+	return _u
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableReceivedBy(v *string) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetReceivedBy(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashReceiptUpdate) SetNote(v string) *CashReceiptUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableNote(v *string) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashReceiptUpdate) SetStatus(v cashreceipt.Status) *CashReceiptUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableStatus(v *cashreceipt.Status) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *CashReceiptUpdate) SetVoidedAt(v time.Time) *CashReceiptUpdate {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableVoidedAt(v *time.Time) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *CashReceiptUpdate) ClearVoidedAt() *CashReceiptUpdate {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetVoidedBy sets the "voided_by" field.
+func (_u *CashReceiptUpdate) SetVoidedBy(v string) *CashReceiptUpdate {
+	_u.mutation.SetVoidedBy(v)
+	return _u
+}
+
+// SetNillableVoidedBy sets the "voided_by" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableVoidedBy(v *string) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetVoidedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CashReceiptUpdate) SetCreatedAt(v time.Time) *CashReceiptUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableCreatedAt(v *time.Time) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCashSessionID sets the "cash_session_id" field.
+func (_u *CashReceiptUpdate) SetCashSessionID(v int) *CashReceiptUpdate {
+	_u.mutation.SetCashSessionID(v)
+	return _u
+}
+
+// SetNillableCashSessionID sets the "cash_session_id" field if the given value is not nil.
+func (_u *CashReceiptUpdate) SetNillableCashSessionID(v *int) *CashReceiptUpdate {
+	if v != nil {
+		_u.SetCashSessionID(*v)
+	}
+	return _u
+}
+
+// ClearCashSessionID clears the value of the "cash_session_id" field.
+func (_u *CashReceiptUpdate) ClearCashSessionID() *CashReceiptUpdate {
+	_u.mutation.ClearCashSessionID()
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *CashReceiptUpdate) SetStudent(v *Student) *CashReceiptUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *CashReceiptUpdate) AddPaymentIDs(ids ...int) *CashReceiptUpdate {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *CashReceiptUpdate) AddPayments(v ...*Payment) *CashReceiptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// SetCashSession sets the "cash_session" edge to the CashSession entity.
+func (_u *CashReceiptUpdate) SetCashSession(v *CashSession) *CashReceiptUpdate {
+	return _u.SetCashSessionID(v.ID)
+}
+
+// Mutation returns the CashReceiptMutation object of the builder.
+func (_u *CashReceiptUpdate) Mutation() *CashReceiptMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *CashReceiptUpdate) ClearStudent() *CashReceiptUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *CashReceiptUpdate) ClearPayments() *CashReceiptUpdate {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *CashReceiptUpdate) RemovePaymentIDs(ids ...int) *CashReceiptUpdate {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *CashReceiptUpdate) RemovePayments(v ...*Payment) *CashReceiptUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearCashSession clears the "cash_session" edge to the CashSession entity.
+func (_u *CashReceiptUpdate) ClearCashSession() *CashReceiptUpdate {
+	_u.mutation.ClearCashSession()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashReceiptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashReceiptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashReceiptUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cashreceipt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashReceipt.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CashReceipt.student"`)
+	}
+	return nil
+}
+
+func (_u *CashReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashreceipt.Table, cashreceipt.Columns, sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(cashreceipt.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(cashreceipt.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(cashreceipt.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(cashreceipt.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(cashreceipt.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(cashreceipt.FieldPaidAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceivedByUserID(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedByUserID(); ok {
+		_spec.AddField(cashreceipt.FieldReceivedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.ReceivedByUserIDCleared() {
+		_spec.ClearField(cashreceipt.FieldReceivedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReceivedBy(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashreceipt.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashreceipt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(cashreceipt.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedBy(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(cashreceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.StudentTable,
+			Columns: []string{cashreceipt.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.StudentTable,
+			Columns: []string{cashreceipt.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CashSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.CashSessionTable,
+			Columns: []string{cashreceipt.CashSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CashSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.CashSessionTable,
+			Columns: []string{cashreceipt.CashSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashReceiptUpdateOne is the builder for updating a single CashReceipt entity.
+type CashReceiptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashReceiptMutation
+}
+
+// SetSeq sets the "seq" field.
+func (_u *CashReceiptUpdateOne) SetSeq(v int) *CashReceiptUpdateOne {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableSeq(v *int) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *CashReceiptUpdateOne) AddSeq(v int) *CashReceiptUpdateOne {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *CashReceiptUpdateOne) SetNumber(v string) *CashReceiptUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableNumber(v *string) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *CashReceiptUpdateOne) SetStudentID(v int) *CashReceiptUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableStudentID(v *int) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *CashReceiptUpdateOne) SetAmountCents(v int64) *CashReceiptUpdateOne {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableAmountCents(v *int64) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *CashReceiptUpdateOne) AddAmountCents(v int64) *CashReceiptUpdateOne {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *CashReceiptUpdateOne) SetPaidAt(v time.Time) *CashReceiptUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillablePaidAt(v *time.Time) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// SetReceivedByUserID sets the "received_by_user_id" field.
+func (_u *CashReceiptUpdateOne) SetReceivedByUserID(v int) *CashReceiptUpdateOne {
+	_u.mutation.ResetReceivedByUserID()
+	_u.mutation.SetReceivedByUserID(v)
+	return _u
+}
+
+// SetNillableReceivedByUserID sets the "received_by_user_id" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableReceivedByUserID(v *int) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceivedByUserID(*v)
+	}
+	return _u
+}
+
+// AddReceivedByUserID adds value to the "received_by_user_id" field.
+func (_u *CashReceiptUpdateOne) AddReceivedByUserID(v int) *CashReceiptUpdateOne {
+	_u.mutation.AddReceivedByUserID(v)
+	return _u
+}
+
+// ClearReceivedByUserID clears the value of the "received_by_user_id" field.
+func (_u *CashReceiptUpdateOne) ClearReceivedByUserID() *CashReceiptUpdateOne {
+	_u.mutation.ClearReceivedByUserID()
+	return _u
+}
+
+// SetReceivedBy sets the "received_by" field.
+func (_u *CashReceiptUpdateOne) SetReceivedBy(v string) *CashReceiptUpdateOne {
+	_u.mutation.SetReceivedBy(v)
+	return _u
+}
+
+// SetNillableReceivedBy sets the "received_by" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableReceivedBy(v *string) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceivedBy(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashReceiptUpdateOne) SetNote(v string) *CashReceiptUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableNote(v *string) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashReceiptUpdateOne) SetStatus(v cashreceipt.Status) *CashReceiptUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableStatus(v *cashreceipt.Status) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *CashReceiptUpdateOne) SetVoidedAt(v time.Time) *CashReceiptUpdateOne {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableVoidedAt(v *time.Time) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *CashReceiptUpdateOne) ClearVoidedAt() *CashReceiptUpdateOne {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetVoidedBy sets the "voided_by" field.
+func (_u *CashReceiptUpdateOne) SetVoidedBy(v string) *CashReceiptUpdateOne {
+	_u.mutation.SetVoidedBy(v)
+	return _u
+}
+
+// SetNillableVoidedBy sets the "voided_by" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableVoidedBy(v *string) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetVoidedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CashReceiptUpdateOne) SetCreatedAt(v time.Time) *CashReceiptUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableCreatedAt(v *time.Time) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCashSessionID sets the "cash_session_id" field.
+func (_u *CashReceiptUpdateOne) SetCashSessionID(v int) *CashReceiptUpdateOne {
+	_u.mutation.SetCashSessionID(v)
+	return _u
+}
+
+// SetNillableCashSessionID sets the "cash_session_id" field if the given value is not nil.
+func (_u *CashReceiptUpdateOne) SetNillableCashSessionID(v *int) *CashReceiptUpdateOne {
+	if v != nil {
+		_u.SetCashSessionID(*v)
+	}
+	return _u
+}
+
+// ClearCashSessionID clears the value of the "cash_session_id" field.
+func (_u *CashReceiptUpdateOne) ClearCashSessionID() *CashReceiptUpdateOne {
+	_u.mutation.ClearCashSessionID()
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *CashReceiptUpdateOne) SetStudent(v *Student) *CashReceiptUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *CashReceiptUpdateOne) AddPaymentIDs(ids ...int) *CashReceiptUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
+	return _u
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *CashReceiptUpdateOne) AddPayments(v ...*Payment) *CashReceiptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// SetCashSession sets the "cash_session" edge to the CashSession entity.
+func (_u *CashReceiptUpdateOne) SetCashSession(v *CashSession) *CashReceiptUpdateOne {
+	return _u.SetCashSessionID(v.ID)
+}
+
+// Mutation returns the CashReceiptMutation object of the builder.
+func (_u *CashReceiptUpdateOne) Mutation() *CashReceiptMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *CashReceiptUpdateOne) ClearStudent() *CashReceiptUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *CashReceiptUpdateOne) ClearPayments() *CashReceiptUpdateOne {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *CashReceiptUpdateOne) RemovePaymentIDs(ids ...int) *CashReceiptUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *CashReceiptUpdateOne) RemovePayments(v ...*Payment) *CashReceiptUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearCashSession clears the "cash_session" edge to the CashSession entity.
+func (_u *CashReceiptUpdateOne) ClearCashSession() *CashReceiptUpdateOne {
+	_u.mutation.ClearCashSession()
+	return _u
+}
+
+// Where appends a list predicates to the CashReceiptUpdate builder.
+func (_u *CashReceiptUpdateOne) Where(ps ...predicate.CashReceipt) *CashReceiptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashReceiptUpdateOne) Select(field string, fields ...string) *CashReceiptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashReceipt entity.
+func (_u *CashReceiptUpdateOne) Save(ctx context.Context) (*CashReceipt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashReceiptUpdateOne) SaveX(ctx context.Context) *CashReceipt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashReceiptUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cashreceipt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashReceipt.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CashReceipt.student"`)
+	}
+	return nil
+}
+
+func (_u *CashReceiptUpdateOne) sqlSave(ctx context.Context) (_node *CashReceipt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashreceipt.Table, cashreceipt.Columns, sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashReceipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashreceipt.FieldID)
+		for _, f := range fields {
+			if !cashreceipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashreceipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(cashreceipt.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(cashreceipt.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(cashreceipt.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(cashreceipt.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(cashreceipt.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(cashreceipt.FieldPaidAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceivedByUserID(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedByUserID(); ok {
+		_spec.AddField(cashreceipt.FieldReceivedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.ReceivedByUserIDCleared() {
+		_spec.ClearField(cashreceipt.FieldReceivedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReceivedBy(); ok {
+		_spec.SetField(cashreceipt.FieldReceivedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashreceipt.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashreceipt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(cashreceipt.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedBy(); ok {
+		_spec.SetField(cashreceipt.FieldVoidedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(cashreceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.StudentTable,
+			Columns: []string{cashreceipt.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.StudentTable,
+			Columns: []string{cashreceipt.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashreceipt.PaymentsTable,
+			Columns: []string{cashreceipt.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CashSessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.CashSessionTable,
+			Columns: []string{cashreceipt.CashSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CashSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cashreceipt.CashSessionTable,
+			Columns: []string{cashreceipt.CashSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CashReceipt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
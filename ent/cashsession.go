@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/cashsession"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CashSession is the model entity for the CashSession schema.
+type CashSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+	// OpenedByUserID holds the value of the "opened_by_user_id" field.
+	OpenedByUserID *int `json:"opened_by_user_id,omitempty"`
+	// OpenedBy holds the value of the "opened_by" field.
+	OpenedBy string `json:"opened_by,omitempty"`
+	// OpeningFloatCents holds the value of the "opening_float_cents" field.
+	OpeningFloatCents int64 `json:"opening_float_cents,omitempty"`
+	// Status holds the value of the "status" field.
+	Status cashsession.Status `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// ClosedBy holds the value of the "closed_by" field.
+	ClosedBy string `json:"closed_by,omitempty"`
+	// CountedCents holds the value of the "counted_cents" field.
+	CountedCents *int64 `json:"counted_cents,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// ReopenedAt holds the value of the "reopened_at" field.
+	ReopenedAt *time.Time `json:"reopened_at,omitempty"`
+	// ReopenedBy holds the value of the "reopened_by" field.
+	ReopenedBy string `json:"reopened_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CashSessionQuery when eager-loading is set.
+	Edges        CashSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CashSessionEdges holds the relations/edges for other nodes in the graph.
+type CashSessionEdges struct {
+	// Movements holds the value of the movements edge.
+	Movements []*CashMovement `json:"movements,omitempty"`
+	// Receipts holds the value of the receipts edge.
+	Receipts []*CashReceipt `json:"receipts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e CashSessionEdges) MovementsOrErr() ([]*CashMovement, error) {
+	if e.loadedTypes[0] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// ReceiptsOrErr returns the Receipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e CashSessionEdges) ReceiptsOrErr() ([]*CashReceipt, error) {
+	if e.loadedTypes[1] {
+		return e.Receipts, nil
+	}
+	return nil, &NotLoadedError{edge: "receipts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashsession.FieldID, cashsession.FieldOpenedByUserID, cashsession.FieldOpeningFloatCents, cashsession.FieldCountedCents:
+			values[i] = new(sql.NullInt64)
+		case cashsession.FieldOpenedBy, cashsession.FieldStatus, cashsession.FieldClosedBy, cashsession.FieldNote, cashsession.FieldReopenedBy:
+			values[i] = new(sql.NullString)
+		case cashsession.FieldOpenedAt, cashsession.FieldClosedAt, cashsession.FieldReopenedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashSession fields.
+func (_m *CashSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case cashsession.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				_m.OpenedAt = value.Time
+			}
+		case cashsession.FieldOpenedByUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_by_user_id", values[i])
+			} else if value.Valid {
+				_m.OpenedByUserID = new(int)
+				*_m.OpenedByUserID = int(value.Int64)
+			}
+		case cashsession.FieldOpenedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_by", values[i])
+			} else if value.Valid {
+				_m.OpenedBy = value.String
+			}
+		case cashsession.FieldOpeningFloatCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_float_cents", values[i])
+			} else if value.Valid {
+				_m.OpeningFloatCents = value.Int64
+			}
+		case cashsession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = cashsession.Status(value.String)
+			}
+		case cashsession.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case cashsession.FieldClosedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_by", values[i])
+			} else if value.Valid {
+				_m.ClosedBy = value.String
+			}
+		case cashsession.FieldCountedCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field counted_cents", values[i])
+			} else if value.Valid {
+				_m.CountedCents = new(int64)
+				*_m.CountedCents = value.Int64
+			}
+		case cashsession.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case cashsession.FieldReopenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reopened_at", values[i])
+			} else if value.Valid {
+				_m.ReopenedAt = new(time.Time)
+				*_m.ReopenedAt = value.Time
+			}
+		case cashsession.FieldReopenedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reopened_by", values[i])
+			} else if value.Valid {
+				_m.ReopenedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMovements queries the "movements" edge of the CashSession entity.
+func (_m *CashSession) QueryMovements() *CashMovementQuery {
+	return NewCashSessionClient(_m.config).QueryMovements(_m)
+}
+
+// QueryReceipts queries the "receipts" edge of the CashSession entity.
+func (_m *CashSession) QueryReceipts() *CashReceiptQuery {
+	return NewCashSessionClient(_m.config).QueryReceipts(_m)
+}
+
+// Update returns a builder for updating this CashSession.
+// Note that you need to call CashSession.Unwrap() before calling this method if this CashSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashSession) Update() *CashSessionUpdateOne {
+	return NewCashSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashSession) Unwrap() *CashSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CashSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("opened_at=")
+	builder.WriteString(_m.OpenedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.OpenedByUserID; v != nil {
+		builder.WriteString("opened_by_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("opened_by=")
+	builder.WriteString(_m.OpenedBy)
+	builder.WriteString(", ")
+	builder.WriteString("opening_float_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningFloatCents))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("closed_by=")
+	builder.WriteString(_m.ClosedBy)
+	builder.WriteString(", ")
+	if v := _m.CountedCents; v != nil {
+		builder.WriteString("counted_cents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.ReopenedAt; v != nil {
+		builder.WriteString("reopened_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reopened_by=")
+	builder.WriteString(_m.ReopenedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashSessions is a parsable slice of CashSession.
+type CashSessions []*CashSession
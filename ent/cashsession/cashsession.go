@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashsession
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the cashsession type in the database.
+	Label = "cash_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldOpenedByUserID holds the string denoting the opened_by_user_id field in the database.
+	FieldOpenedByUserID = "opened_by_user_id"
+	// FieldOpenedBy holds the string denoting the opened_by field in the database.
+	FieldOpenedBy = "opened_by"
+	// FieldOpeningFloatCents holds the string denoting the opening_float_cents field in the database.
+	FieldOpeningFloatCents = "opening_float_cents"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldClosedBy holds the string denoting the closed_by field in the database.
+	FieldClosedBy = "closed_by"
+	// FieldCountedCents holds the string denoting the counted_cents field in the database.
+	FieldCountedCents = "counted_cents"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldReopenedAt holds the string denoting the reopened_at field in the database.
+	FieldReopenedAt = "reopened_at"
+	// FieldReopenedBy holds the string denoting the reopened_by field in the database.
+	FieldReopenedBy = "reopened_by"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// EdgeReceipts holds the string denoting the receipts edge name in mutations.
+	EdgeReceipts = "receipts"
+	// Table holds the table name of the cashsession in the database.
+	Table = "cash_sessions"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "cash_movements"
+	// MovementsInverseTable is the table name for the CashMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "cashmovement" package.
+	MovementsInverseTable = "cash_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "session_id"
+	// ReceiptsTable is the table that holds the receipts relation/edge.
+	ReceiptsTable = "cash_receipts"
+	// ReceiptsInverseTable is the table name for the CashReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "cashreceipt" package.
+	ReceiptsInverseTable = "cash_receipts"
+	// ReceiptsColumn is the table column denoting the receipts relation/edge.
+	ReceiptsColumn = "cash_session_id"
+)
+
+// Columns holds all SQL columns for cashsession fields.
+var Columns = []string{
+	FieldID,
+	FieldOpenedAt,
+	FieldOpenedByUserID,
+	FieldOpenedBy,
+	FieldOpeningFloatCents,
+	FieldStatus,
+	FieldClosedAt,
+	FieldClosedBy,
+	FieldCountedCents,
+	FieldNote,
+	FieldReopenedAt,
+	FieldReopenedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOpenedAt holds the default value on creation for the "opened_at" field.
+	DefaultOpenedAt func() time.Time
+	// DefaultOpenedBy holds the default value on creation for the "opened_by" field.
+	DefaultOpenedBy string
+	// DefaultOpeningFloatCents holds the default value on creation for the "opening_float_cents" field.
+	DefaultOpeningFloatCents int64
+	// DefaultClosedBy holds the default value on creation for the "closed_by" field.
+	DefaultClosedBy string
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultReopenedBy holds the default value on creation for the "reopened_by" field.
+	DefaultReopenedBy string
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen   Status = "open"
+	StatusClosed Status = "closed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("cashsession: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the CashSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByOpenedByUserID orders the results by the opened_by_user_id field.
+func ByOpenedByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedByUserID, opts...).ToFunc()
+}
+
+// ByOpenedBy orders the results by the opened_by field.
+func ByOpenedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedBy, opts...).ToFunc()
+}
+
+// ByOpeningFloatCents orders the results by the opening_float_cents field.
+func ByOpeningFloatCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningFloatCents, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByClosedBy orders the results by the closed_by field.
+func ByClosedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedBy, opts...).ToFunc()
+}
+
+// ByCountedCents orders the results by the counted_cents field.
+func ByCountedCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountedCents, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByReopenedAt orders the results by the reopened_at field.
+func ByReopenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReopenedAt, opts...).ToFunc()
+}
+
+// ByReopenedBy orders the results by the reopened_by field.
+func ByReopenedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReopenedBy, opts...).ToFunc()
+}
+
+// ByMovementsCount orders the results by movements count.
+func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMovementsStep(), opts...)
+	}
+}
+
+// ByMovements orders the results by movements terms.
+func ByMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceiptsCount orders the results by receipts count.
+func ByReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceiptsStep(), opts...)
+	}
+}
+
+// ByReceipts orders the results by receipts terms.
+func ByReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+	)
+}
+func newReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+	)
+}
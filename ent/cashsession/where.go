@@ -0,0 +1,727 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashsession
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldID, id))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedByUserID applies equality check predicate on the "opened_by_user_id" field. It's identical to OpenedByUserIDEQ.
+func OpenedByUserID(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedByUserID, v))
+}
+
+// OpenedBy applies equality check predicate on the "opened_by" field. It's identical to OpenedByEQ.
+func OpenedBy(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedBy, v))
+}
+
+// OpeningFloatCents applies equality check predicate on the "opening_float_cents" field. It's identical to OpeningFloatCentsEQ.
+func OpeningFloatCents(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpeningFloatCents, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedBy applies equality check predicate on the "closed_by" field. It's identical to ClosedByEQ.
+func ClosedBy(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// CountedCents applies equality check predicate on the "counted_cents" field. It's identical to CountedCentsEQ.
+func CountedCents(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldCountedCents, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldNote, v))
+}
+
+// ReopenedAt applies equality check predicate on the "reopened_at" field. It's identical to ReopenedAtEQ.
+func ReopenedAt(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldReopenedAt, v))
+}
+
+// ReopenedBy applies equality check predicate on the "reopened_by" field. It's identical to ReopenedByEQ.
+func ReopenedBy(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldReopenedBy, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// OpenedByUserIDEQ applies the EQ predicate on the "opened_by_user_id" field.
+func OpenedByUserIDEQ(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDNEQ applies the NEQ predicate on the "opened_by_user_id" field.
+func OpenedByUserIDNEQ(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDIn applies the In predicate on the "opened_by_user_id" field.
+func OpenedByUserIDIn(vs ...int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldOpenedByUserID, vs...))
+}
+
+// OpenedByUserIDNotIn applies the NotIn predicate on the "opened_by_user_id" field.
+func OpenedByUserIDNotIn(vs ...int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldOpenedByUserID, vs...))
+}
+
+// OpenedByUserIDGT applies the GT predicate on the "opened_by_user_id" field.
+func OpenedByUserIDGT(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDGTE applies the GTE predicate on the "opened_by_user_id" field.
+func OpenedByUserIDGTE(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDLT applies the LT predicate on the "opened_by_user_id" field.
+func OpenedByUserIDLT(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDLTE applies the LTE predicate on the "opened_by_user_id" field.
+func OpenedByUserIDLTE(v int) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldOpenedByUserID, v))
+}
+
+// OpenedByUserIDIsNil applies the IsNil predicate on the "opened_by_user_id" field.
+func OpenedByUserIDIsNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldIsNull(FieldOpenedByUserID))
+}
+
+// OpenedByUserIDNotNil applies the NotNil predicate on the "opened_by_user_id" field.
+func OpenedByUserIDNotNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotNull(FieldOpenedByUserID))
+}
+
+// OpenedByEQ applies the EQ predicate on the "opened_by" field.
+func OpenedByEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpenedBy, v))
+}
+
+// OpenedByNEQ applies the NEQ predicate on the "opened_by" field.
+func OpenedByNEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldOpenedBy, v))
+}
+
+// OpenedByIn applies the In predicate on the "opened_by" field.
+func OpenedByIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldOpenedBy, vs...))
+}
+
+// OpenedByNotIn applies the NotIn predicate on the "opened_by" field.
+func OpenedByNotIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldOpenedBy, vs...))
+}
+
+// OpenedByGT applies the GT predicate on the "opened_by" field.
+func OpenedByGT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldOpenedBy, v))
+}
+
+// OpenedByGTE applies the GTE predicate on the "opened_by" field.
+func OpenedByGTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldOpenedBy, v))
+}
+
+// OpenedByLT applies the LT predicate on the "opened_by" field.
+func OpenedByLT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldOpenedBy, v))
+}
+
+// OpenedByLTE applies the LTE predicate on the "opened_by" field.
+func OpenedByLTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldOpenedBy, v))
+}
+
+// OpenedByContains applies the Contains predicate on the "opened_by" field.
+func OpenedByContains(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContains(FieldOpenedBy, v))
+}
+
+// OpenedByHasPrefix applies the HasPrefix predicate on the "opened_by" field.
+func OpenedByHasPrefix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasPrefix(FieldOpenedBy, v))
+}
+
+// OpenedByHasSuffix applies the HasSuffix predicate on the "opened_by" field.
+func OpenedByHasSuffix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasSuffix(FieldOpenedBy, v))
+}
+
+// OpenedByEqualFold applies the EqualFold predicate on the "opened_by" field.
+func OpenedByEqualFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEqualFold(FieldOpenedBy, v))
+}
+
+// OpenedByContainsFold applies the ContainsFold predicate on the "opened_by" field.
+func OpenedByContainsFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContainsFold(FieldOpenedBy, v))
+}
+
+// OpeningFloatCentsEQ applies the EQ predicate on the "opening_float_cents" field.
+func OpeningFloatCentsEQ(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldOpeningFloatCents, v))
+}
+
+// OpeningFloatCentsNEQ applies the NEQ predicate on the "opening_float_cents" field.
+func OpeningFloatCentsNEQ(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldOpeningFloatCents, v))
+}
+
+// OpeningFloatCentsIn applies the In predicate on the "opening_float_cents" field.
+func OpeningFloatCentsIn(vs ...int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldOpeningFloatCents, vs...))
+}
+
+// OpeningFloatCentsNotIn applies the NotIn predicate on the "opening_float_cents" field.
+func OpeningFloatCentsNotIn(vs ...int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldOpeningFloatCents, vs...))
+}
+
+// OpeningFloatCentsGT applies the GT predicate on the "opening_float_cents" field.
+func OpeningFloatCentsGT(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldOpeningFloatCents, v))
+}
+
+// OpeningFloatCentsGTE applies the GTE predicate on the "opening_float_cents" field.
+func OpeningFloatCentsGTE(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldOpeningFloatCents, v))
+}
+
+// OpeningFloatCentsLT applies the LT predicate on the "opening_float_cents" field.
+func OpeningFloatCentsLT(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldOpeningFloatCents, v))
+}
+
+// OpeningFloatCentsLTE applies the LTE predicate on the "opening_float_cents" field.
+func OpeningFloatCentsLTE(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldOpeningFloatCents, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotNull(FieldClosedAt))
+}
+
+// ClosedByEQ applies the EQ predicate on the "closed_by" field.
+func ClosedByEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// ClosedByNEQ applies the NEQ predicate on the "closed_by" field.
+func ClosedByNEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldClosedBy, v))
+}
+
+// ClosedByIn applies the In predicate on the "closed_by" field.
+func ClosedByIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldClosedBy, vs...))
+}
+
+// ClosedByNotIn applies the NotIn predicate on the "closed_by" field.
+func ClosedByNotIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldClosedBy, vs...))
+}
+
+// ClosedByGT applies the GT predicate on the "closed_by" field.
+func ClosedByGT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldClosedBy, v))
+}
+
+// ClosedByGTE applies the GTE predicate on the "closed_by" field.
+func ClosedByGTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldClosedBy, v))
+}
+
+// ClosedByLT applies the LT predicate on the "closed_by" field.
+func ClosedByLT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldClosedBy, v))
+}
+
+// ClosedByLTE applies the LTE predicate on the "closed_by" field.
+func ClosedByLTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldClosedBy, v))
+}
+
+// ClosedByContains applies the Contains predicate on the "closed_by" field.
+func ClosedByContains(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContains(FieldClosedBy, v))
+}
+
+// ClosedByHasPrefix applies the HasPrefix predicate on the "closed_by" field.
+func ClosedByHasPrefix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasPrefix(FieldClosedBy, v))
+}
+
+// ClosedByHasSuffix applies the HasSuffix predicate on the "closed_by" field.
+func ClosedByHasSuffix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasSuffix(FieldClosedBy, v))
+}
+
+// ClosedByEqualFold applies the EqualFold predicate on the "closed_by" field.
+func ClosedByEqualFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEqualFold(FieldClosedBy, v))
+}
+
+// ClosedByContainsFold applies the ContainsFold predicate on the "closed_by" field.
+func ClosedByContainsFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContainsFold(FieldClosedBy, v))
+}
+
+// CountedCentsEQ applies the EQ predicate on the "counted_cents" field.
+func CountedCentsEQ(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldCountedCents, v))
+}
+
+// CountedCentsNEQ applies the NEQ predicate on the "counted_cents" field.
+func CountedCentsNEQ(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldCountedCents, v))
+}
+
+// CountedCentsIn applies the In predicate on the "counted_cents" field.
+func CountedCentsIn(vs ...int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldCountedCents, vs...))
+}
+
+// CountedCentsNotIn applies the NotIn predicate on the "counted_cents" field.
+func CountedCentsNotIn(vs ...int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldCountedCents, vs...))
+}
+
+// CountedCentsGT applies the GT predicate on the "counted_cents" field.
+func CountedCentsGT(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldCountedCents, v))
+}
+
+// CountedCentsGTE applies the GTE predicate on the "counted_cents" field.
+func CountedCentsGTE(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldCountedCents, v))
+}
+
+// CountedCentsLT applies the LT predicate on the "counted_cents" field.
+func CountedCentsLT(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldCountedCents, v))
+}
+
+// CountedCentsLTE applies the LTE predicate on the "counted_cents" field.
+func CountedCentsLTE(v int64) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldCountedCents, v))
+}
+
+// CountedCentsIsNil applies the IsNil predicate on the "counted_cents" field.
+func CountedCentsIsNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldIsNull(FieldCountedCents))
+}
+
+// CountedCentsNotNil applies the NotNil predicate on the "counted_cents" field.
+func CountedCentsNotNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotNull(FieldCountedCents))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContainsFold(FieldNote, v))
+}
+
+// ReopenedAtEQ applies the EQ predicate on the "reopened_at" field.
+func ReopenedAtEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldReopenedAt, v))
+}
+
+// ReopenedAtNEQ applies the NEQ predicate on the "reopened_at" field.
+func ReopenedAtNEQ(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldReopenedAt, v))
+}
+
+// ReopenedAtIn applies the In predicate on the "reopened_at" field.
+func ReopenedAtIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldReopenedAt, vs...))
+}
+
+// ReopenedAtNotIn applies the NotIn predicate on the "reopened_at" field.
+func ReopenedAtNotIn(vs ...time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldReopenedAt, vs...))
+}
+
+// ReopenedAtGT applies the GT predicate on the "reopened_at" field.
+func ReopenedAtGT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldReopenedAt, v))
+}
+
+// ReopenedAtGTE applies the GTE predicate on the "reopened_at" field.
+func ReopenedAtGTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldReopenedAt, v))
+}
+
+// ReopenedAtLT applies the LT predicate on the "reopened_at" field.
+func ReopenedAtLT(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldReopenedAt, v))
+}
+
+// ReopenedAtLTE applies the LTE predicate on the "reopened_at" field.
+func ReopenedAtLTE(v time.Time) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldReopenedAt, v))
+}
+
+// ReopenedAtIsNil applies the IsNil predicate on the "reopened_at" field.
+func ReopenedAtIsNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldIsNull(FieldReopenedAt))
+}
+
+// ReopenedAtNotNil applies the NotNil predicate on the "reopened_at" field.
+func ReopenedAtNotNil() predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotNull(FieldReopenedAt))
+}
+
+// ReopenedByEQ applies the EQ predicate on the "reopened_by" field.
+func ReopenedByEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEQ(FieldReopenedBy, v))
+}
+
+// ReopenedByNEQ applies the NEQ predicate on the "reopened_by" field.
+func ReopenedByNEQ(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNEQ(FieldReopenedBy, v))
+}
+
+// ReopenedByIn applies the In predicate on the "reopened_by" field.
+func ReopenedByIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldIn(FieldReopenedBy, vs...))
+}
+
+// ReopenedByNotIn applies the NotIn predicate on the "reopened_by" field.
+func ReopenedByNotIn(vs ...string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldNotIn(FieldReopenedBy, vs...))
+}
+
+// ReopenedByGT applies the GT predicate on the "reopened_by" field.
+func ReopenedByGT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGT(FieldReopenedBy, v))
+}
+
+// ReopenedByGTE applies the GTE predicate on the "reopened_by" field.
+func ReopenedByGTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldGTE(FieldReopenedBy, v))
+}
+
+// ReopenedByLT applies the LT predicate on the "reopened_by" field.
+func ReopenedByLT(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLT(FieldReopenedBy, v))
+}
+
+// ReopenedByLTE applies the LTE predicate on the "reopened_by" field.
+func ReopenedByLTE(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldLTE(FieldReopenedBy, v))
+}
+
+// ReopenedByContains applies the Contains predicate on the "reopened_by" field.
+func ReopenedByContains(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContains(FieldReopenedBy, v))
+}
+
+// ReopenedByHasPrefix applies the HasPrefix predicate on the "reopened_by" field.
+func ReopenedByHasPrefix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasPrefix(FieldReopenedBy, v))
+}
+
+// ReopenedByHasSuffix applies the HasSuffix predicate on the "reopened_by" field.
+func ReopenedByHasSuffix(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldHasSuffix(FieldReopenedBy, v))
+}
+
+// ReopenedByEqualFold applies the EqualFold predicate on the "reopened_by" field.
+func ReopenedByEqualFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldEqualFold(FieldReopenedBy, v))
+}
+
+// ReopenedByContainsFold applies the ContainsFold predicate on the "reopened_by" field.
+func ReopenedByContainsFold(v string) predicate.CashSession {
+	return predicate.CashSession(sql.FieldContainsFold(FieldReopenedBy, v))
+}
+
+// HasMovements applies the HasEdge predicate on the "movements" edge.
+func HasMovements() predicate.CashSession {
+	return predicate.CashSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MovementsTable, MovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovementsWith applies the HasEdge predicate on the "movements" edge with a given conditions (other predicates).
+func HasMovementsWith(preds ...predicate.CashMovement) predicate.CashSession {
+	return predicate.CashSession(func(s *sql.Selector) {
+		step := newMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceipts applies the HasEdge predicate on the "receipts" edge.
+func HasReceipts() predicate.CashSession {
+	return predicate.CashSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptsWith applies the HasEdge predicate on the "receipts" edge with a given conditions (other predicates).
+func HasReceiptsWith(preds ...predicate.CashReceipt) predicate.CashSession {
+	return predicate.CashSession(func(s *sql.Selector) {
+		step := newReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashSession) predicate.CashSession {
+	return predicate.CashSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashSession) predicate.CashSession {
+	return predicate.CashSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashSession) predicate.CashSession {
+	return predicate.CashSession(sql.NotPredicates(p))
+}
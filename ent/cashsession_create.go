@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CashSessionCreate is the builder for creating a CashSession entity.
+type CashSessionCreate struct {
+	config
+	mutation *CashSessionMutation
+	hooks    []Hook
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_c *CashSessionCreate) SetOpenedAt(v time.Time) *CashSessionCreate {
+	_c.mutation.SetOpenedAt(v)
+	return _c
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableOpenedAt(v *time.Time) *CashSessionCreate {
+	if v != nil {
+		_c.SetOpenedAt(*v)
+	}
+	return _c
+}
+
+// SetOpenedByUserID sets the "opened_by_user_id" field.
+func (_c *CashSessionCreate) SetOpenedByUserID(v int) *CashSessionCreate {
+	_c.mutation.SetOpenedByUserID(v)
+	return _c
+}
+
+// SetNillableOpenedByUserID sets the "opened_by_user_id" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableOpenedByUserID(v *int) *CashSessionCreate {
+	if v != nil {
+		_c.SetOpenedByUserID(*v)
+	}
+	return _c
+}
+
+// SetOpenedBy sets the "opened_by" field.
+func (_c *CashSessionCreate) SetOpenedBy(v string) *CashSessionCreate {
+	_c.mutation.SetOpenedBy(v)
+	return _c
+}
+
+// SetNillableOpenedBy sets the "opened_by" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableOpenedBy(v *string) *CashSessionCreate {
+	if v != nil {
+		_c.SetOpenedBy(*v)
+	}
+	return _c
+}
+
+// SetOpeningFloatCents sets the "opening_float_cents" field.
+func (_c *CashSessionCreate) SetOpeningFloatCents(v int64) *CashSessionCreate {
+	_c.mutation.SetOpeningFloatCents(v)
+	return _c
+}
+
+// SetNillableOpeningFloatCents sets the "opening_float_cents" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableOpeningFloatCents(v *int64) *CashSessionCreate {
+	if v != nil {
+		_c.SetOpeningFloatCents(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CashSessionCreate) SetStatus(v cashsession.Status) *CashSessionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableStatus(v *cashsession.Status) *CashSessionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *CashSessionCreate) SetClosedAt(v time.Time) *CashSessionCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableClosedAt(v *time.Time) *CashSessionCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_c *CashSessionCreate) SetClosedBy(v string) *CashSessionCreate {
+	_c.mutation.SetClosedBy(v)
+	return _c
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableClosedBy(v *string) *CashSessionCreate {
+	if v != nil {
+		_c.SetClosedBy(*v)
+	}
+	return _c
+}
+
+// SetCountedCents sets the "counted_cents" field.
+func (_c *CashSessionCreate) SetCountedCents(v int64) *CashSessionCreate {
+	_c.mutation.SetCountedCents(v)
+	return _c
+}
+
+// SetNillableCountedCents sets the "counted_cents" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableCountedCents(v *int64) *CashSessionCreate {
+	if v != nil {
+		_c.SetCountedCents(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *CashSessionCreate) SetNote(v string) *CashSessionCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableNote(v *string) *CashSessionCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetReopenedAt sets the "reopened_at" field.
+func (_c *CashSessionCreate) SetReopenedAt(v time.Time) *CashSessionCreate {
+	_c.mutation.SetReopenedAt(v)
+	return _c
+}
+
+// SetNillableReopenedAt sets the "reopened_at" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableReopenedAt(v *time.Time) *CashSessionCreate {
+	if v != nil {
+		_c.SetReopenedAt(*v)
+	}
+	return _c
+}
+
+// SetReopenedBy sets the "reopened_by" field.
+func (_c *CashSessionCreate) SetReopenedBy(v string) *CashSessionCreate {
+	_c.mutation.SetReopenedBy(v)
+	return _c
+}
+
+// SetNillableReopenedBy sets the "reopened_by" field if the given value is not nil.
+func (_c *CashSessionCreate) SetNillableReopenedBy(v *string) *CashSessionCreate {
+	if v != nil {
+		_c.SetReopenedBy(*v)
+	}
+	return _c
+}
+
+// AddMovementIDs adds the "movements" edge to the CashMovement entity by IDs.
+func (_c *CashSessionCreate) AddMovementIDs(ids ...int) *CashSessionCreate {
+	_c.mutation.AddMovementIDs(ids...)
+	return _c
+}
+
+// AddMovements adds the "movements" edges to the CashMovement entity.
+func (_c *CashSessionCreate) AddMovements(v ...*CashMovement) *CashSessionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMovementIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the CashReceipt entity by IDs.
+func (_c *CashSessionCreate) AddReceiptIDs(ids ...int) *CashSessionCreate {
+	_c.mutation.AddReceiptIDs(ids...)
+	return _c
+}
+
+// AddReceipts adds the "receipts" edges to the CashReceipt entity.
+func (_c *CashSessionCreate) AddReceipts(v ...*CashReceipt) *CashSessionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the CashSessionMutation object of the builder.
+func (_c *CashSessionCreate) Mutation() *CashSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the CashSession in the database.
+func (_c *CashSessionCreate) Save(ctx context.Context) (*CashSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashSessionCreate) SaveX(ctx context.Context) *CashSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashSessionCreate) defaults() {
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		v := cashsession.DefaultOpenedAt()
+		_c.mutation.SetOpenedAt(v)
+	}
+	if _, ok := _c.mutation.OpenedBy(); !ok {
+		v := cashsession.DefaultOpenedBy
+		_c.mutation.SetOpenedBy(v)
+	}
+	if _, ok := _c.mutation.OpeningFloatCents(); !ok {
+		v := cashsession.DefaultOpeningFloatCents
+		_c.mutation.SetOpeningFloatCents(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := cashsession.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ClosedBy(); !ok {
+		v := cashsession.DefaultClosedBy
+		_c.mutation.SetClosedBy(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := cashsession.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.ReopenedBy(); !ok {
+		v := cashsession.DefaultReopenedBy
+		_c.mutation.SetReopenedBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashSessionCreate) check() error {
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		return &ValidationError{Name: "opened_at", err: errors.New(`ent: missing required field "CashSession.opened_at"`)}
+	}
+	if _, ok := _c.mutation.OpenedBy(); !ok {
+		return &ValidationError{Name: "opened_by", err: errors.New(`ent: missing required field "CashSession.opened_by"`)}
+	}
+	if _, ok := _c.mutation.OpeningFloatCents(); !ok {
+		return &ValidationError{Name: "opening_float_cents", err: errors.New(`ent: missing required field "CashSession.opening_float_cents"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CashSession.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := cashsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashSession.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClosedBy(); !ok {
+		return &ValidationError{Name: "closed_by", err: errors.New(`ent: missing required field "CashSession.closed_by"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "CashSession.note"`)}
+	}
+	if _, ok := _c.mutation.ReopenedBy(); !ok {
+		return &ValidationError{Name: "reopened_by", err: errors.New(`ent: missing required field "CashSession.reopened_by"`)}
+	}
+	return nil
+}
+
+func (_c *CashSessionCreate) sqlSave(ctx context.Context) (*CashSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashSessionCreate) createSpec() (*CashSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashsession.Table, sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.OpenedAt(); ok {
+		_spec.SetField(cashsession.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = value
+	}
+	if value, ok := _c.mutation.OpenedByUserID(); ok {
+		_spec.SetField(cashsession.FieldOpenedByUserID, field.TypeInt, value)
+		_node.OpenedByUserID = &value
+	}
+	if value, ok := _c.mutation.OpenedBy(); ok {
+		_spec.SetField(cashsession.FieldOpenedBy, field.TypeString, value)
+		_node.OpenedBy = value
+	}
+	if value, ok := _c.mutation.OpeningFloatCents(); ok {
+		_spec.SetField(cashsession.FieldOpeningFloatCents, field.TypeInt64, value)
+		_node.OpeningFloatCents = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(cashsession.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(cashsession.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.ClosedBy(); ok {
+		_spec.SetField(cashsession.FieldClosedBy, field.TypeString, value)
+		_node.ClosedBy = value
+	}
+	if value, ok := _c.mutation.CountedCents(); ok {
+		_spec.SetField(cashsession.FieldCountedCents, field.TypeInt64, value)
+		_node.CountedCents = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(cashsession.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.ReopenedAt(); ok {
+		_spec.SetField(cashsession.FieldReopenedAt, field.TypeTime, value)
+		_node.ReopenedAt = &value
+	}
+	if value, ok := _c.mutation.ReopenedBy(); ok {
+		_spec.SetField(cashsession.FieldReopenedBy, field.TypeString, value)
+		_node.ReopenedBy = value
+	}
+	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CashSessionCreateBulk is the builder for creating many CashSession entities in bulk.
+type CashSessionCreateBulk struct {
+	config
+	err      error
+	builders []*CashSessionCreate
+}
+
+// Save creates the CashSession entities in the database.
+func (_c *CashSessionCreateBulk) Save(ctx context.Context) ([]*CashSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashSessionCreateBulk) SaveX(ctx context.Context) []*CashSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1034 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CashSessionUpdate is the builder for updating CashSession entities.
+type CashSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashSessionMutation
+}
+
+// Where appends a list predicates to the CashSessionUpdate builder.
+func (_u *CashSessionUpdate) Where(ps ...predicate.CashSession) *CashSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *CashSessionUpdate) SetOpenedAt(v time.Time) *CashSessionUpdate {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableOpenedAt(v *time.Time) *CashSessionUpdate {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// SetOpenedByUserID sets the "opened_by_user_id" field.
+func (_u *CashSessionUpdate) SetOpenedByUserID(v int) *CashSessionUpdate {
+	_u.mutation.ResetOpenedByUserID()
+	_u.mutation.SetOpenedByUserID(v)
+	return _u
+}
+
+// SetNillableOpenedByUserID sets the "opened_by_user_id" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableOpenedByUserID(v *int) *CashSessionUpdate {
+	if v != nil {
+		_u.SetOpenedByUserID(*v)
+	}
+	return _u
+}
+
+// AddOpenedByUserID adds value to the "opened_by_user_id" field.
+func (_u *CashSessionUpdate) AddOpenedByUserID(v int) *CashSessionUpdate {
+	_u.mutation.AddOpenedByUserID(v)
+	return _u
+}
+
+// ClearOpenedByUserID clears the value of the "opened_by_user_id" field.
+func (_u *CashSessionUpdate) ClearOpenedByUserID() *CashSessionUpdate {
+	_u.mutation.ClearOpenedByUserID()
+	return _u
+}
+
+// SetOpenedBy sets the "opened_by" field.
+func (_u *CashSessionUpdate) SetOpenedBy(v string) *CashSessionUpdate {
+	_u.mutation.SetOpenedBy(v)
+	return _u
+}
+
+// SetNillableOpenedBy sets the "opened_by" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableOpenedBy(v *string) *CashSessionUpdate {
+	if v != nil {
+		_u.SetOpenedBy(*v)
+	}
+	return _u
+}
+
+// SetOpeningFloatCents sets the "opening_float_cents" field.
+func (_u *CashSessionUpdate) SetOpeningFloatCents(v int64) *CashSessionUpdate {
+	_u.mutation.ResetOpeningFloatCents()
+	_u.mutation.SetOpeningFloatCents(v)
+	return _u
+}
+
+// SetNillableOpeningFloatCents sets the "opening_float_cents" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableOpeningFloatCents(v *int64) *CashSessionUpdate {
+	if v != nil {
+		_u.SetOpeningFloatCents(*v)
+	}
+	return _u
+}
+
+// AddOpeningFloatCents adds value to the "opening_float_cents" field.
+func (_u *CashSessionUpdate) AddOpeningFloatCents(v int64) *CashSessionUpdate {
+	_u.mutation.AddOpeningFloatCents(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashSessionUpdate) SetStatus(v cashsession.Status) *CashSessionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableStatus(v *cashsession.Status) *CashSessionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *CashSessionUpdate) SetClosedAt(v time.Time) *CashSessionUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableClosedAt(v *time.Time) *CashSessionUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *CashSessionUpdate) ClearClosedAt() *CashSessionUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *CashSessionUpdate) SetClosedBy(v string) *CashSessionUpdate {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableClosedBy(v *string) *CashSessionUpdate {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// SetCountedCents sets the "counted_cents" field.
+func (_u *CashSessionUpdate) SetCountedCents(v int64) *CashSessionUpdate {
+	_u.mutation.ResetCountedCents()
+	_u.mutation.SetCountedCents(v)
+	return _u
+}
+
+// SetNillableCountedCents sets the "counted_cents" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableCountedCents(v *int64) *CashSessionUpdate {
+	if v != nil {
+		_u.SetCountedCents(*v)
+	}
+	return _u
+}
+
+// AddCountedCents adds value to the "counted_cents" field.
+func (_u *CashSessionUpdate) AddCountedCents(v int64) *CashSessionUpdate {
+	_u.mutation.AddCountedCents(v)
+	return _u
+}
+
+// ClearCountedCents clears the value of the "counted_cents" field.
+func (_u *CashSessionUpdate) ClearCountedCents() *CashSessionUpdate {
+	_u.mutation.ClearCountedCents()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashSessionUpdate) SetNote(v string) *CashSessionUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableNote(v *string) *CashSessionUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetReopenedAt sets the "reopened_at" field.
+func (_u *CashSessionUpdate) SetReopenedAt(v time.Time) *CashSessionUpdate {
+	_u.mutation.SetReopenedAt(v)
+	return _u
+}
+
+// SetNillableReopenedAt sets the "reopened_at" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableReopenedAt(v *time.Time) *CashSessionUpdate {
+	if v != nil {
+		_u.SetReopenedAt(*v)
+	}
+	return _u
+}
+
+// ClearReopenedAt clears the value of the "reopened_at" field.
+func (_u *CashSessionUpdate) ClearReopenedAt() *CashSessionUpdate {
+	_u.mutation.ClearReopenedAt()
+	return _u
+}
+
+// SetReopenedBy sets the "reopened_by" field.
+func (_u *CashSessionUpdate) SetReopenedBy(v string) *CashSessionUpdate {
+	_u.mutation.SetReopenedBy(v)
+	return _u
+}
+
+// SetNillableReopenedBy sets the "reopened_by" field if the given value is not nil.
+func (_u *CashSessionUpdate) SetNillableReopenedBy(v *string) *CashSessionUpdate {
+	if v != nil {
+		_u.SetReopenedBy(*v)
+	}
+	return _u
+}
+
+// AddMovementIDs adds the "movements" edge to the CashMovement entity by IDs.
+func (_u *CashSessionUpdate) AddMovementIDs(ids ...int) *CashSessionUpdate {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the CashMovement entity.
+func (_u *CashSessionUpdate) AddMovements(v ...*CashMovement) *CashSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the CashReceipt entity by IDs.
+func (_u *CashSessionUpdate) AddReceiptIDs(ids ...int) *CashSessionUpdate {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the CashReceipt entity.
+func (_u *CashSessionUpdate) AddReceipts(v ...*CashReceipt) *CashSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the CashSessionMutation object of the builder.
+func (_u *CashSessionUpdate) Mutation() *CashSessionMutation {
+	return _u.mutation
+}
+
+// ClearMovements clears all "movements" edges to the CashMovement entity.
+func (_u *CashSessionUpdate) ClearMovements() *CashSessionUpdate {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to CashMovement entities by IDs.
+func (_u *CashSessionUpdate) RemoveMovementIDs(ids ...int) *CashSessionUpdate {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to CashMovement entities.
+func (_u *CashSessionUpdate) RemoveMovements(v ...*CashMovement) *CashSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the CashReceipt entity.
+func (_u *CashSessionUpdate) ClearReceipts() *CashSessionUpdate {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to CashReceipt entities by IDs.
+func (_u *CashSessionUpdate) RemoveReceiptIDs(ids ...int) *CashSessionUpdate {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to CashReceipt entities.
+func (_u *CashSessionUpdate) RemoveReceipts(v ...*CashReceipt) *CashSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashSessionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cashsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CashSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashsession.Table, cashsession.Columns, sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(cashsession.FieldOpenedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpenedByUserID(); ok {
+		_spec.SetField(cashsession.FieldOpenedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOpenedByUserID(); ok {
+		_spec.AddField(cashsession.FieldOpenedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.OpenedByUserIDCleared() {
+		_spec.ClearField(cashsession.FieldOpenedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OpenedBy(); ok {
+		_spec.SetField(cashsession.FieldOpenedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpeningFloatCents(); ok {
+		_spec.SetField(cashsession.FieldOpeningFloatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningFloatCents(); ok {
+		_spec.AddField(cashsession.FieldOpeningFloatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(cashsession.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(cashsession.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(cashsession.FieldClosedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CountedCents(); ok {
+		_spec.SetField(cashsession.FieldCountedCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCents(); ok {
+		_spec.AddField(cashsession.FieldCountedCents, field.TypeInt64, value)
+	}
+	if _u.mutation.CountedCentsCleared() {
+		_spec.ClearField(cashsession.FieldCountedCents, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashsession.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReopenedAt(); ok {
+		_spec.SetField(cashsession.FieldReopenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReopenedAtCleared() {
+		_spec.ClearField(cashsession.FieldReopenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReopenedBy(); ok {
+		_spec.SetField(cashsession.FieldReopenedBy, field.TypeString, value)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashSessionUpdateOne is the builder for updating a single CashSession entity.
+type CashSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashSessionMutation
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *CashSessionUpdateOne) SetOpenedAt(v time.Time) *CashSessionUpdateOne {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableOpenedAt(v *time.Time) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// SetOpenedByUserID sets the "opened_by_user_id" field.
+func (_u *CashSessionUpdateOne) SetOpenedByUserID(v int) *CashSessionUpdateOne {
+	_u.mutation.ResetOpenedByUserID()
+	_u.mutation.SetOpenedByUserID(v)
+	return _u
+}
+
+// SetNillableOpenedByUserID sets the "opened_by_user_id" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableOpenedByUserID(v *int) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetOpenedByUserID(*v)
+	}
+	return _u
+}
+
+// AddOpenedByUserID adds value to the "opened_by_user_id" field.
+func (_u *CashSessionUpdateOne) AddOpenedByUserID(v int) *CashSessionUpdateOne {
+	_u.mutation.AddOpenedByUserID(v)
+	return _u
+}
+
+// ClearOpenedByUserID clears the value of the "opened_by_user_id" field.
+func (_u *CashSessionUpdateOne) ClearOpenedByUserID() *CashSessionUpdateOne {
+	_u.mutation.ClearOpenedByUserID()
+	return _u
+}
+
+// SetOpenedBy sets the "opened_by" field.
+func (_u *CashSessionUpdateOne) SetOpenedBy(v string) *CashSessionUpdateOne {
+	_u.mutation.SetOpenedBy(v)
+	return _u
+}
+
+// SetNillableOpenedBy sets the "opened_by" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableOpenedBy(v *string) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetOpenedBy(*v)
+	}
+	return _u
+}
+
+// SetOpeningFloatCents sets the "opening_float_cents" field.
+func (_u *CashSessionUpdateOne) SetOpeningFloatCents(v int64) *CashSessionUpdateOne {
+	_u.mutation.ResetOpeningFloatCents()
+	_u.mutation.SetOpeningFloatCents(v)
+	return _u
+}
+
+// SetNillableOpeningFloatCents sets the "opening_float_cents" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableOpeningFloatCents(v *int64) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetOpeningFloatCents(*v)
+	}
+	return _u
+}
+
+// AddOpeningFloatCents adds value to the "opening_float_cents" field.
+func (_u *CashSessionUpdateOne) AddOpeningFloatCents(v int64) *CashSessionUpdateOne {
+	_u.mutation.AddOpeningFloatCents(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashSessionUpdateOne) SetStatus(v cashsession.Status) *CashSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableStatus(v *cashsession.Status) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *CashSessionUpdateOne) SetClosedAt(v time.Time) *CashSessionUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableClosedAt(v *time.Time) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *CashSessionUpdateOne) ClearClosedAt() *CashSessionUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *CashSessionUpdateOne) SetClosedBy(v string) *CashSessionUpdateOne {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableClosedBy(v *string) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// SetCountedCents sets the "counted_cents" field.
+func (_u *CashSessionUpdateOne) SetCountedCents(v int64) *CashSessionUpdateOne {
+	_u.mutation.ResetCountedCents()
+	_u.mutation.SetCountedCents(v)
+	return _u
+}
+
+// SetNillableCountedCents sets the "counted_cents" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableCountedCents(v *int64) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetCountedCents(*v)
+	}
+	return _u
+}
+
+// AddCountedCents adds value to the "counted_cents" field.
+func (_u *CashSessionUpdateOne) AddCountedCents(v int64) *CashSessionUpdateOne {
+	_u.mutation.AddCountedCents(v)
+	return _u
+}
+
+// ClearCountedCents clears the value of the "counted_cents" field.
+func (_u *CashSessionUpdateOne) ClearCountedCents() *CashSessionUpdateOne {
+	_u.mutation.ClearCountedCents()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CashSessionUpdateOne) SetNote(v string) *CashSessionUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableNote(v *string) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetReopenedAt sets the "reopened_at" field.
+func (_u *CashSessionUpdateOne) SetReopenedAt(v time.Time) *CashSessionUpdateOne {
+	_u.mutation.SetReopenedAt(v)
+	return _u
+}
+
+// SetNillableReopenedAt sets the "reopened_at" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableReopenedAt(v *time.Time) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetReopenedAt(*v)
+	}
+	return _u
+}
+
+// ClearReopenedAt clears the value of the "reopened_at" field.
+func (_u *CashSessionUpdateOne) ClearReopenedAt() *CashSessionUpdateOne {
+	_u.mutation.ClearReopenedAt()
+	return _u
+}
+
+// SetReopenedBy sets the "reopened_by" field.
+func (_u *CashSessionUpdateOne) SetReopenedBy(v string) *CashSessionUpdateOne {
+	_u.mutation.SetReopenedBy(v)
+	return _u
+}
+
+// SetNillableReopenedBy sets the "reopened_by" field if the given value is not nil.
+func (_u *CashSessionUpdateOne) SetNillableReopenedBy(v *string) *CashSessionUpdateOne {
+	if v != nil {
+		_u.SetReopenedBy(*v)
+	}
+	return _u
+}
+
+// AddMovementIDs adds the "movements" edge to the CashMovement entity by IDs.
+func (_u *CashSessionUpdateOne) AddMovementIDs(ids ...int) *CashSessionUpdateOne {
+	_u.mutation.AddMovementIDs(ids...)
+	return _u
+}
+
+// AddMovements adds the "movements" edges to the CashMovement entity.
+func (_u *CashSessionUpdateOne) AddMovements(v ...*CashMovement) *CashSessionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMovementIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the CashReceipt entity by IDs.
+func (_u *CashSessionUpdateOne) AddReceiptIDs(ids ...int) *CashSessionUpdateOne {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the CashReceipt entity.
+func (_u *CashSessionUpdateOne) AddReceipts(v ...*CashReceipt) *CashSessionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the CashSessionMutation object of the builder.
+func (_u *CashSessionUpdateOne) Mutation() *CashSessionMutation {
+	return _u.mutation
+}
+
+// ClearMovements clears all "movements" edges to the CashMovement entity.
+func (_u *CashSessionUpdateOne) ClearMovements() *CashSessionUpdateOne {
+	_u.mutation.ClearMovements()
+	return _u
+}
+
+// RemoveMovementIDs removes the "movements" edge to CashMovement entities by IDs.
+func (_u *CashSessionUpdateOne) RemoveMovementIDs(ids ...int) *CashSessionUpdateOne {
+	_u.mutation.RemoveMovementIDs(ids...)
+	return _u
+}
+
+// RemoveMovements removes "movements" edges to CashMovement entities.
+func (_u *CashSessionUpdateOne) RemoveMovements(v ...*CashMovement) *CashSessionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMovementIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the CashReceipt entity.
+func (_u *CashSessionUpdateOne) ClearReceipts() *CashSessionUpdateOne {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to CashReceipt entities by IDs.
+func (_u *CashSessionUpdateOne) RemoveReceiptIDs(ids ...int) *CashSessionUpdateOne {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to CashReceipt entities.
+func (_u *CashSessionUpdateOne) RemoveReceipts(v ...*CashReceipt) *CashSessionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
+// Where appends a list predicates to the CashSessionUpdate builder.
+func (_u *CashSessionUpdateOne) Where(ps ...predicate.CashSession) *CashSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashSessionUpdateOne) Select(field string, fields ...string) *CashSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashSession entity.
+func (_u *CashSessionUpdateOne) Save(ctx context.Context) (*CashSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashSessionUpdateOne) SaveX(ctx context.Context) *CashSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cashsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CashSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CashSessionUpdateOne) sqlSave(ctx context.Context) (_node *CashSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashsession.Table, cashsession.Columns, sqlgraph.NewFieldSpec(cashsession.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashsession.FieldID)
+		for _, f := range fields {
+			if !cashsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(cashsession.FieldOpenedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpenedByUserID(); ok {
+		_spec.SetField(cashsession.FieldOpenedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOpenedByUserID(); ok {
+		_spec.AddField(cashsession.FieldOpenedByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.OpenedByUserIDCleared() {
+		_spec.ClearField(cashsession.FieldOpenedByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OpenedBy(); ok {
+		_spec.SetField(cashsession.FieldOpenedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpeningFloatCents(); ok {
+		_spec.SetField(cashsession.FieldOpeningFloatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningFloatCents(); ok {
+		_spec.AddField(cashsession.FieldOpeningFloatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(cashsession.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(cashsession.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(cashsession.FieldClosedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CountedCents(); ok {
+		_spec.SetField(cashsession.FieldCountedCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCents(); ok {
+		_spec.AddField(cashsession.FieldCountedCents, field.TypeInt64, value)
+	}
+	if _u.mutation.CountedCentsCleared() {
+		_spec.ClearField(cashsession.FieldCountedCents, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(cashsession.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReopenedAt(); ok {
+		_spec.SetField(cashsession.FieldReopenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReopenedAtCleared() {
+		_spec.ClearField(cashsession.FieldReopenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReopenedBy(); ok {
+		_spec.SetField(cashsession.FieldReopenedBy, field.TypeString, value)
+	}
+	if _u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !_u.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.MovementsTable,
+			Columns: []string{cashsession.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cashsession.ReceiptsTable,
+			Columns: []string{cashsession.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CashSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,16 +13,34 @@ import (
 
 	"langschool/ent/attendancemonth"
 	"langschool/ent/auditlog"
+	"langschool/ent/billingterm"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/idempotencykey"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/settings"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
 	"langschool/ent/teacher"
 	"langschool/ent/user"
+	"langschool/ent/waitlistentry"
 	"langschool/ent/websession"
 
 	"entgo.io/ent"
@@ -40,26 +58,62 @@ type Client struct {
 	AttendanceMonth *AttendanceMonthClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BillingTerm is the client for interacting with the BillingTerm builders.
+	BillingTerm *BillingTermClient
+	// CashMovement is the client for interacting with the CashMovement builders.
+	CashMovement *CashMovementClient
+	// CashReceipt is the client for interacting with the CashReceipt builders.
+	CashReceipt *CashReceiptClient
+	// CashSession is the client for interacting with the CashSession builders.
+	CashSession *CashSessionClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
 	// CourseMonthStat is the client for interacting with the CourseMonthStat builders.
 	CourseMonthStat *CourseMonthStatClient
+	// CoursePrice is the client for interacting with the CoursePrice builders.
+	CoursePrice *CoursePriceClient
 	// Enrollment is the client for interacting with the Enrollment builders.
 	Enrollment *EnrollmentClient
+	// EnrollmentPause is the client for interacting with the EnrollmentPause builders.
+	EnrollmentPause *EnrollmentPauseClient
+	// EnrollmentPrice is the client for interacting with the EnrollmentPrice builders.
+	EnrollmentPrice *EnrollmentPriceClient
+	// ExcusedAbsence is the client for interacting with the ExcusedAbsence builders.
+	ExcusedAbsence *ExcusedAbsenceClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// InvoiceLine is the client for interacting with the InvoiceLine builders.
 	InvoiceLine *InvoiceLineClient
+	// LateFee is the client for interacting with the LateFee builders.
+	LateFee *LateFeeClient
+	// Lead is the client for interacting with the Lead builders.
+	Lead *LeadClient
+	// LessonPackage is the client for interacting with the LessonPackage builders.
+	LessonPackage *LessonPackageClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// PaymentPlan is the client for interacting with the PaymentPlan builders.
+	PaymentPlan *PaymentPlanClient
+	// PaymentPlanInstalment is the client for interacting with the PaymentPlanInstalment builders.
+	PaymentPlanInstalment *PaymentPlanInstalmentClient
+	// Room is the client for interacting with the Room builders.
+	Room *RoomClient
+	// ScheduleSlot is the client for interacting with the ScheduleSlot builders.
+	ScheduleSlot *ScheduleSlotClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
 	// Student is the client for interacting with the Student builders.
 	Student *StudentClient
+	// StudentCharge is the client for interacting with the StudentCharge builders.
+	StudentCharge *StudentChargeClient
 	// Teacher is the client for interacting with the Teacher builders.
 	Teacher *TeacherClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 	// WebSession is the client for interacting with the WebSession builders.
 	WebSession *WebSessionClient
 }
@@ -75,16 +129,34 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AttendanceMonth = NewAttendanceMonthClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.BillingTerm = NewBillingTermClient(c.config)
+	c.CashMovement = NewCashMovementClient(c.config)
+	c.CashReceipt = NewCashReceiptClient(c.config)
+	c.CashSession = NewCashSessionClient(c.config)
 	c.Course = NewCourseClient(c.config)
 	c.CourseMonthStat = NewCourseMonthStatClient(c.config)
+	c.CoursePrice = NewCoursePriceClient(c.config)
 	c.Enrollment = NewEnrollmentClient(c.config)
+	c.EnrollmentPause = NewEnrollmentPauseClient(c.config)
+	c.EnrollmentPrice = NewEnrollmentPriceClient(c.config)
+	c.ExcusedAbsence = NewExcusedAbsenceClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
 	c.InvoiceLine = NewInvoiceLineClient(c.config)
+	c.LateFee = NewLateFeeClient(c.config)
+	c.Lead = NewLeadClient(c.config)
+	c.LessonPackage = NewLessonPackageClient(c.config)
 	c.Payment = NewPaymentClient(c.config)
+	c.PaymentPlan = NewPaymentPlanClient(c.config)
+	c.PaymentPlanInstalment = NewPaymentPlanInstalmentClient(c.config)
+	c.Room = NewRoomClient(c.config)
+	c.ScheduleSlot = NewScheduleSlotClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
 	c.Student = NewStudentClient(c.config)
+	c.StudentCharge = NewStudentChargeClient(c.config)
 	c.Teacher = NewTeacherClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WaitlistEntry = NewWaitlistEntryClient(c.config)
 	c.WebSession = NewWebSessionClient(c.config)
 }
 
@@ -176,21 +248,39 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AttendanceMonth: NewAttendanceMonthClient(cfg),
-		AuditLog:        NewAuditLogClient(cfg),
-		Course:          NewCourseClient(cfg),
-		CourseMonthStat: NewCourseMonthStatClient(cfg),
-		Enrollment:      NewEnrollmentClient(cfg),
-		Invoice:         NewInvoiceClient(cfg),
-		InvoiceLine:     NewInvoiceLineClient(cfg),
-		Payment:         NewPaymentClient(cfg),
-		Settings:        NewSettingsClient(cfg),
-		Student:         NewStudentClient(cfg),
-		Teacher:         NewTeacherClient(cfg),
-		User:            NewUserClient(cfg),
-		WebSession:      NewWebSessionClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AttendanceMonth:       NewAttendanceMonthClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		BillingTerm:           NewBillingTermClient(cfg),
+		CashMovement:          NewCashMovementClient(cfg),
+		CashReceipt:           NewCashReceiptClient(cfg),
+		CashSession:           NewCashSessionClient(cfg),
+		Course:                NewCourseClient(cfg),
+		CourseMonthStat:       NewCourseMonthStatClient(cfg),
+		CoursePrice:           NewCoursePriceClient(cfg),
+		Enrollment:            NewEnrollmentClient(cfg),
+		EnrollmentPause:       NewEnrollmentPauseClient(cfg),
+		EnrollmentPrice:       NewEnrollmentPriceClient(cfg),
+		ExcusedAbsence:        NewExcusedAbsenceClient(cfg),
+		IdempotencyKey:        NewIdempotencyKeyClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
+		InvoiceLine:           NewInvoiceLineClient(cfg),
+		LateFee:               NewLateFeeClient(cfg),
+		Lead:                  NewLeadClient(cfg),
+		LessonPackage:         NewLessonPackageClient(cfg),
+		Payment:               NewPaymentClient(cfg),
+		PaymentPlan:           NewPaymentPlanClient(cfg),
+		PaymentPlanInstalment: NewPaymentPlanInstalmentClient(cfg),
+		Room:                  NewRoomClient(cfg),
+		ScheduleSlot:          NewScheduleSlotClient(cfg),
+		Settings:              NewSettingsClient(cfg),
+		Student:               NewStudentClient(cfg),
+		StudentCharge:         NewStudentChargeClient(cfg),
+		Teacher:               NewTeacherClient(cfg),
+		User:                  NewUserClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WebSession:            NewWebSessionClient(cfg),
 	}, nil
 }
 
@@ -208,21 +298,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AttendanceMonth: NewAttendanceMonthClient(cfg),
-		AuditLog:        NewAuditLogClient(cfg),
-		Course:          NewCourseClient(cfg),
-		CourseMonthStat: NewCourseMonthStatClient(cfg),
-		Enrollment:      NewEnrollmentClient(cfg),
-		Invoice:         NewInvoiceClient(cfg),
-		InvoiceLine:     NewInvoiceLineClient(cfg),
-		Payment:         NewPaymentClient(cfg),
-		Settings:        NewSettingsClient(cfg),
-		Student:         NewStudentClient(cfg),
-		Teacher:         NewTeacherClient(cfg),
-		User:            NewUserClient(cfg),
-		WebSession:      NewWebSessionClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AttendanceMonth:       NewAttendanceMonthClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		BillingTerm:           NewBillingTermClient(cfg),
+		CashMovement:          NewCashMovementClient(cfg),
+		CashReceipt:           NewCashReceiptClient(cfg),
+		CashSession:           NewCashSessionClient(cfg),
+		Course:                NewCourseClient(cfg),
+		CourseMonthStat:       NewCourseMonthStatClient(cfg),
+		CoursePrice:           NewCoursePriceClient(cfg),
+		Enrollment:            NewEnrollmentClient(cfg),
+		EnrollmentPause:       NewEnrollmentPauseClient(cfg),
+		EnrollmentPrice:       NewEnrollmentPriceClient(cfg),
+		ExcusedAbsence:        NewExcusedAbsenceClient(cfg),
+		IdempotencyKey:        NewIdempotencyKeyClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
+		InvoiceLine:           NewInvoiceLineClient(cfg),
+		LateFee:               NewLateFeeClient(cfg),
+		Lead:                  NewLeadClient(cfg),
+		LessonPackage:         NewLessonPackageClient(cfg),
+		Payment:               NewPaymentClient(cfg),
+		PaymentPlan:           NewPaymentPlanClient(cfg),
+		PaymentPlanInstalment: NewPaymentPlanInstalmentClient(cfg),
+		Room:                  NewRoomClient(cfg),
+		ScheduleSlot:          NewScheduleSlotClient(cfg),
+		Settings:              NewSettingsClient(cfg),
+		Student:               NewStudentClient(cfg),
+		StudentCharge:         NewStudentChargeClient(cfg),
+		Teacher:               NewTeacherClient(cfg),
+		User:                  NewUserClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WebSession:            NewWebSessionClient(cfg),
 	}, nil
 }
 
@@ -252,9 +360,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AttendanceMonth, c.AuditLog, c.Course, c.CourseMonthStat, c.Enrollment,
-		c.Invoice, c.InvoiceLine, c.Payment, c.Settings, c.Student, c.Teacher, c.User,
-		c.WebSession,
+		c.AttendanceMonth, c.AuditLog, c.BillingTerm, c.CashMovement, c.CashReceipt,
+		c.CashSession, c.Course, c.CourseMonthStat, c.CoursePrice, c.Enrollment,
+		c.EnrollmentPause, c.EnrollmentPrice, c.ExcusedAbsence, c.IdempotencyKey,
+		c.Invoice, c.InvoiceLine, c.LateFee, c.Lead, c.LessonPackage, c.Payment,
+		c.PaymentPlan, c.PaymentPlanInstalment, c.Room, c.ScheduleSlot, c.Settings,
+		c.Student, c.StudentCharge, c.Teacher, c.User, c.WaitlistEntry, c.WebSession,
 	} {
 		n.Use(hooks...)
 	}
@@ -264,9 +375,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AttendanceMonth, c.AuditLog, c.Course, c.CourseMonthStat, c.Enrollment,
-		c.Invoice, c.InvoiceLine, c.Payment, c.Settings, c.Student, c.Teacher, c.User,
-		c.WebSession,
+		c.AttendanceMonth, c.AuditLog, c.BillingTerm, c.CashMovement, c.CashReceipt,
+		c.CashSession, c.Course, c.CourseMonthStat, c.CoursePrice, c.Enrollment,
+		c.EnrollmentPause, c.EnrollmentPrice, c.ExcusedAbsence, c.IdempotencyKey,
+		c.Invoice, c.InvoiceLine, c.LateFee, c.Lead, c.LessonPackage, c.Payment,
+		c.PaymentPlan, c.PaymentPlanInstalment, c.Room, c.ScheduleSlot, c.Settings,
+		c.Student, c.StudentCharge, c.Teacher, c.User, c.WaitlistEntry, c.WebSession,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -279,26 +393,62 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AttendanceMonth.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *BillingTermMutation:
+		return c.BillingTerm.mutate(ctx, m)
+	case *CashMovementMutation:
+		return c.CashMovement.mutate(ctx, m)
+	case *CashReceiptMutation:
+		return c.CashReceipt.mutate(ctx, m)
+	case *CashSessionMutation:
+		return c.CashSession.mutate(ctx, m)
 	case *CourseMutation:
 		return c.Course.mutate(ctx, m)
 	case *CourseMonthStatMutation:
 		return c.CourseMonthStat.mutate(ctx, m)
+	case *CoursePriceMutation:
+		return c.CoursePrice.mutate(ctx, m)
 	case *EnrollmentMutation:
 		return c.Enrollment.mutate(ctx, m)
+	case *EnrollmentPauseMutation:
+		return c.EnrollmentPause.mutate(ctx, m)
+	case *EnrollmentPriceMutation:
+		return c.EnrollmentPrice.mutate(ctx, m)
+	case *ExcusedAbsenceMutation:
+		return c.ExcusedAbsence.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
 	case *InvoiceMutation:
 		return c.Invoice.mutate(ctx, m)
 	case *InvoiceLineMutation:
 		return c.InvoiceLine.mutate(ctx, m)
+	case *LateFeeMutation:
+		return c.LateFee.mutate(ctx, m)
+	case *LeadMutation:
+		return c.Lead.mutate(ctx, m)
+	case *LessonPackageMutation:
+		return c.LessonPackage.mutate(ctx, m)
 	case *PaymentMutation:
 		return c.Payment.mutate(ctx, m)
+	case *PaymentPlanMutation:
+		return c.PaymentPlan.mutate(ctx, m)
+	case *PaymentPlanInstalmentMutation:
+		return c.PaymentPlanInstalment.mutate(ctx, m)
+	case *RoomMutation:
+		return c.Room.mutate(ctx, m)
+	case *ScheduleSlotMutation:
+		return c.ScheduleSlot.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
 	case *StudentMutation:
 		return c.Student.mutate(ctx, m)
+	case *StudentChargeMutation:
+		return c.StudentCharge.mutate(ctx, m)
 	case *TeacherMutation:
 		return c.Teacher.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WaitlistEntryMutation:
+		return c.WaitlistEntry.mutate(ctx, m)
 	case *WebSessionMutation:
 		return c.WebSession.mutate(ctx, m)
 	default:
@@ -588,107 +738,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// CourseClient is a client for the Course schema.
-type CourseClient struct {
+// BillingTermClient is a client for the BillingTerm schema.
+type BillingTermClient struct {
 	config
 }
 
-// NewCourseClient returns a client for the Course from the given config.
-func NewCourseClient(c config) *CourseClient {
-	return &CourseClient{config: c}
+// NewBillingTermClient returns a client for the BillingTerm from the given config.
+func NewBillingTermClient(c config) *BillingTermClient {
+	return &BillingTermClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `course.Hooks(f(g(h())))`.
-func (c *CourseClient) Use(hooks ...Hook) {
-	c.hooks.Course = append(c.hooks.Course, hooks...)
+// A call to `Use(f, g, h)` equals to `billingterm.Hooks(f(g(h())))`.
+func (c *BillingTermClient) Use(hooks ...Hook) {
+	c.hooks.BillingTerm = append(c.hooks.BillingTerm, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `course.Intercept(f(g(h())))`.
-func (c *CourseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Course = append(c.inters.Course, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `billingterm.Intercept(f(g(h())))`.
+func (c *BillingTermClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BillingTerm = append(c.inters.BillingTerm, interceptors...)
 }
 
-// Create returns a builder for creating a Course entity.
-func (c *CourseClient) Create() *CourseCreate {
-	mutation := newCourseMutation(c.config, OpCreate)
-	return &CourseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BillingTerm entity.
+func (c *BillingTermClient) Create() *BillingTermCreate {
+	mutation := newBillingTermMutation(c.config, OpCreate)
+	return &BillingTermCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Course entities.
-func (c *CourseClient) CreateBulk(builders ...*CourseCreate) *CourseCreateBulk {
-	return &CourseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BillingTerm entities.
+func (c *BillingTermClient) CreateBulk(builders ...*BillingTermCreate) *BillingTermCreateBulk {
+	return &BillingTermCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CourseClient) MapCreateBulk(slice any, setFunc func(*CourseCreate, int)) *CourseCreateBulk {
+func (c *BillingTermClient) MapCreateBulk(slice any, setFunc func(*BillingTermCreate, int)) *BillingTermCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CourseCreateBulk{err: fmt.Errorf("calling to CourseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BillingTermCreateBulk{err: fmt.Errorf("calling to BillingTermClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CourseCreate, rv.Len())
+	builders := make([]*BillingTermCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CourseCreateBulk{config: c.config, builders: builders}
+	return &BillingTermCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Course.
-func (c *CourseClient) Update() *CourseUpdate {
-	mutation := newCourseMutation(c.config, OpUpdate)
-	return &CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BillingTerm.
+func (c *BillingTermClient) Update() *BillingTermUpdate {
+	mutation := newBillingTermMutation(c.config, OpUpdate)
+	return &BillingTermUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CourseClient) UpdateOne(_m *Course) *CourseUpdateOne {
-	mutation := newCourseMutation(c.config, OpUpdateOne, withCourse(_m))
-	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BillingTermClient) UpdateOne(_m *BillingTerm) *BillingTermUpdateOne {
+	mutation := newBillingTermMutation(c.config, OpUpdateOne, withBillingTerm(_m))
+	return &BillingTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CourseClient) UpdateOneID(id int) *CourseUpdateOne {
-	mutation := newCourseMutation(c.config, OpUpdateOne, withCourseID(id))
-	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BillingTermClient) UpdateOneID(id int) *BillingTermUpdateOne {
+	mutation := newBillingTermMutation(c.config, OpUpdateOne, withBillingTermID(id))
+	return &BillingTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Course.
-func (c *CourseClient) Delete() *CourseDelete {
-	mutation := newCourseMutation(c.config, OpDelete)
-	return &CourseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BillingTerm.
+func (c *BillingTermClient) Delete() *BillingTermDelete {
+	mutation := newBillingTermMutation(c.config, OpDelete)
+	return &BillingTermDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CourseClient) DeleteOne(_m *Course) *CourseDeleteOne {
+func (c *BillingTermClient) DeleteOne(_m *BillingTerm) *BillingTermDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CourseClient) DeleteOneID(id int) *CourseDeleteOne {
-	builder := c.Delete().Where(course.ID(id))
+func (c *BillingTermClient) DeleteOneID(id int) *BillingTermDeleteOne {
+	builder := c.Delete().Where(billingterm.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CourseDeleteOne{builder}
+	return &BillingTermDeleteOne{builder}
 }
 
-// Query returns a query builder for Course.
-func (c *CourseClient) Query() *CourseQuery {
-	return &CourseQuery{
+// Query returns a query builder for BillingTerm.
+func (c *BillingTermClient) Query() *BillingTermQuery {
+	return &BillingTermQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCourse},
+		ctx:    &QueryContext{Type: TypeBillingTerm},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Course entity by its id.
-func (c *CourseClient) Get(ctx context.Context, id int) (*Course, error) {
-	return c.Query().Where(course.ID(id)).Only(ctx)
+// Get returns a BillingTerm entity by its id.
+func (c *BillingTermClient) Get(ctx context.Context, id int) (*BillingTerm, error) {
+	return c.Query().Where(billingterm.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CourseClient) GetX(ctx context.Context, id int) *Course {
+func (c *BillingTermClient) GetX(ctx context.Context, id int) *BillingTerm {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -696,31 +846,15 @@ func (c *CourseClient) GetX(ctx context.Context, id int) *Course {
 	return obj
 }
 
-// QueryTeacher queries the teacher edge of a Course.
-func (c *CourseClient) QueryTeacher(_m *Course) *TeacherQuery {
-	query := (&TeacherClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(teacher.Table, teacher.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, course.TeacherTable, course.TeacherColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnrollments queries the enrollments edge of a Course.
-func (c *CourseClient) QueryEnrollments(_m *Course) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
+// QueryCourse queries the course edge of a BillingTerm.
+func (c *BillingTermClient) QueryCourse(_m *BillingTerm) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
+			sqlgraph.From(billingterm.Table, billingterm.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, billingterm.CourseTable, billingterm.CourseColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -728,15 +862,15 @@ func (c *CourseClient) QueryEnrollments(_m *Course) *EnrollmentQuery {
 	return query
 }
 
-// QueryMonthStats queries the month_stats edge of a Course.
-func (c *CourseClient) QueryMonthStats(_m *Course) *CourseMonthStatQuery {
-	query := (&CourseMonthStatClient{config: c.config}).Query()
+// QueryInvoices queries the invoices edge of a BillingTerm.
+func (c *BillingTermClient) QueryInvoices(_m *BillingTerm) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(course.Table, course.FieldID, id),
-			sqlgraph.To(coursemonthstat.Table, coursemonthstat.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, course.MonthStatsTable, course.MonthStatsColumn),
+			sqlgraph.From(billingterm.Table, billingterm.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, billingterm.InvoicesTable, billingterm.InvoicesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -745,131 +879,131 @@ func (c *CourseClient) QueryMonthStats(_m *Course) *CourseMonthStatQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CourseClient) Hooks() []Hook {
-	return c.hooks.Course
+func (c *BillingTermClient) Hooks() []Hook {
+	return c.hooks.BillingTerm
 }
 
 // Interceptors returns the client interceptors.
-func (c *CourseClient) Interceptors() []Interceptor {
-	return c.inters.Course
+func (c *BillingTermClient) Interceptors() []Interceptor {
+	return c.inters.BillingTerm
 }
 
-func (c *CourseClient) mutate(ctx context.Context, m *CourseMutation) (Value, error) {
+func (c *BillingTermClient) mutate(ctx context.Context, m *BillingTermMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CourseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingTermCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingTermUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CourseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BillingTermDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Course mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BillingTerm mutation op: %q", m.Op())
 	}
 }
 
-// CourseMonthStatClient is a client for the CourseMonthStat schema.
-type CourseMonthStatClient struct {
+// CashMovementClient is a client for the CashMovement schema.
+type CashMovementClient struct {
 	config
 }
 
-// NewCourseMonthStatClient returns a client for the CourseMonthStat from the given config.
-func NewCourseMonthStatClient(c config) *CourseMonthStatClient {
-	return &CourseMonthStatClient{config: c}
+// NewCashMovementClient returns a client for the CashMovement from the given config.
+func NewCashMovementClient(c config) *CashMovementClient {
+	return &CashMovementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `coursemonthstat.Hooks(f(g(h())))`.
-func (c *CourseMonthStatClient) Use(hooks ...Hook) {
-	c.hooks.CourseMonthStat = append(c.hooks.CourseMonthStat, hooks...)
+// A call to `Use(f, g, h)` equals to `cashmovement.Hooks(f(g(h())))`.
+func (c *CashMovementClient) Use(hooks ...Hook) {
+	c.hooks.CashMovement = append(c.hooks.CashMovement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `coursemonthstat.Intercept(f(g(h())))`.
-func (c *CourseMonthStatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CourseMonthStat = append(c.inters.CourseMonthStat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashmovement.Intercept(f(g(h())))`.
+func (c *CashMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashMovement = append(c.inters.CashMovement, interceptors...)
 }
 
-// Create returns a builder for creating a CourseMonthStat entity.
-func (c *CourseMonthStatClient) Create() *CourseMonthStatCreate {
-	mutation := newCourseMonthStatMutation(c.config, OpCreate)
-	return &CourseMonthStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashMovement entity.
+func (c *CashMovementClient) Create() *CashMovementCreate {
+	mutation := newCashMovementMutation(c.config, OpCreate)
+	return &CashMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CourseMonthStat entities.
-func (c *CourseMonthStatClient) CreateBulk(builders ...*CourseMonthStatCreate) *CourseMonthStatCreateBulk {
-	return &CourseMonthStatCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashMovement entities.
+func (c *CashMovementClient) CreateBulk(builders ...*CashMovementCreate) *CashMovementCreateBulk {
+	return &CashMovementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CourseMonthStatClient) MapCreateBulk(slice any, setFunc func(*CourseMonthStatCreate, int)) *CourseMonthStatCreateBulk {
+func (c *CashMovementClient) MapCreateBulk(slice any, setFunc func(*CashMovementCreate, int)) *CashMovementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CourseMonthStatCreateBulk{err: fmt.Errorf("calling to CourseMonthStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashMovementCreateBulk{err: fmt.Errorf("calling to CashMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CourseMonthStatCreate, rv.Len())
+	builders := make([]*CashMovementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CourseMonthStatCreateBulk{config: c.config, builders: builders}
+	return &CashMovementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CourseMonthStat.
-func (c *CourseMonthStatClient) Update() *CourseMonthStatUpdate {
-	mutation := newCourseMonthStatMutation(c.config, OpUpdate)
-	return &CourseMonthStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashMovement.
+func (c *CashMovementClient) Update() *CashMovementUpdate {
+	mutation := newCashMovementMutation(c.config, OpUpdate)
+	return &CashMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CourseMonthStatClient) UpdateOne(_m *CourseMonthStat) *CourseMonthStatUpdateOne {
-	mutation := newCourseMonthStatMutation(c.config, OpUpdateOne, withCourseMonthStat(_m))
-	return &CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashMovementClient) UpdateOne(_m *CashMovement) *CashMovementUpdateOne {
+	mutation := newCashMovementMutation(c.config, OpUpdateOne, withCashMovement(_m))
+	return &CashMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CourseMonthStatClient) UpdateOneID(id int) *CourseMonthStatUpdateOne {
-	mutation := newCourseMonthStatMutation(c.config, OpUpdateOne, withCourseMonthStatID(id))
-	return &CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashMovementClient) UpdateOneID(id int) *CashMovementUpdateOne {
+	mutation := newCashMovementMutation(c.config, OpUpdateOne, withCashMovementID(id))
+	return &CashMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CourseMonthStat.
-func (c *CourseMonthStatClient) Delete() *CourseMonthStatDelete {
-	mutation := newCourseMonthStatMutation(c.config, OpDelete)
-	return &CourseMonthStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashMovement.
+func (c *CashMovementClient) Delete() *CashMovementDelete {
+	mutation := newCashMovementMutation(c.config, OpDelete)
+	return &CashMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CourseMonthStatClient) DeleteOne(_m *CourseMonthStat) *CourseMonthStatDeleteOne {
+func (c *CashMovementClient) DeleteOne(_m *CashMovement) *CashMovementDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CourseMonthStatClient) DeleteOneID(id int) *CourseMonthStatDeleteOne {
-	builder := c.Delete().Where(coursemonthstat.ID(id))
+func (c *CashMovementClient) DeleteOneID(id int) *CashMovementDeleteOne {
+	builder := c.Delete().Where(cashmovement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CourseMonthStatDeleteOne{builder}
+	return &CashMovementDeleteOne{builder}
 }
 
-// Query returns a query builder for CourseMonthStat.
-func (c *CourseMonthStatClient) Query() *CourseMonthStatQuery {
-	return &CourseMonthStatQuery{
+// Query returns a query builder for CashMovement.
+func (c *CashMovementClient) Query() *CashMovementQuery {
+	return &CashMovementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCourseMonthStat},
+		ctx:    &QueryContext{Type: TypeCashMovement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CourseMonthStat entity by its id.
-func (c *CourseMonthStatClient) Get(ctx context.Context, id int) (*CourseMonthStat, error) {
-	return c.Query().Where(coursemonthstat.ID(id)).Only(ctx)
+// Get returns a CashMovement entity by its id.
+func (c *CashMovementClient) Get(ctx context.Context, id int) (*CashMovement, error) {
+	return c.Query().Where(cashmovement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CourseMonthStatClient) GetX(ctx context.Context, id int) *CourseMonthStat {
+func (c *CashMovementClient) GetX(ctx context.Context, id int) *CashMovement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -877,15 +1011,15 @@ func (c *CourseMonthStatClient) GetX(ctx context.Context, id int) *CourseMonthSt
 	return obj
 }
 
-// QueryCourse queries the course edge of a CourseMonthStat.
-func (c *CourseMonthStatClient) QueryCourse(_m *CourseMonthStat) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
+// QuerySession queries the session edge of a CashMovement.
+func (c *CashMovementClient) QuerySession(_m *CashMovement) *CashSessionQuery {
+	query := (&CashSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(coursemonthstat.Table, coursemonthstat.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, coursemonthstat.CourseTable, coursemonthstat.CourseColumn),
+			sqlgraph.From(cashmovement.Table, cashmovement.FieldID, id),
+			sqlgraph.To(cashsession.Table, cashsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cashmovement.SessionTable, cashmovement.SessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -894,131 +1028,131 @@ func (c *CourseMonthStatClient) QueryCourse(_m *CourseMonthStat) *CourseQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CourseMonthStatClient) Hooks() []Hook {
-	return c.hooks.CourseMonthStat
+func (c *CashMovementClient) Hooks() []Hook {
+	return c.hooks.CashMovement
 }
 
 // Interceptors returns the client interceptors.
-func (c *CourseMonthStatClient) Interceptors() []Interceptor {
-	return c.inters.CourseMonthStat
+func (c *CashMovementClient) Interceptors() []Interceptor {
+	return c.inters.CashMovement
 }
 
-func (c *CourseMonthStatClient) mutate(ctx context.Context, m *CourseMonthStatMutation) (Value, error) {
+func (c *CashMovementClient) mutate(ctx context.Context, m *CashMovementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CourseMonthStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CourseMonthStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CourseMonthStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CashMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CourseMonthStat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CashMovement mutation op: %q", m.Op())
 	}
 }
 
-// EnrollmentClient is a client for the Enrollment schema.
-type EnrollmentClient struct {
+// CashReceiptClient is a client for the CashReceipt schema.
+type CashReceiptClient struct {
 	config
 }
 
-// NewEnrollmentClient returns a client for the Enrollment from the given config.
-func NewEnrollmentClient(c config) *EnrollmentClient {
-	return &EnrollmentClient{config: c}
+// NewCashReceiptClient returns a client for the CashReceipt from the given config.
+func NewCashReceiptClient(c config) *CashReceiptClient {
+	return &CashReceiptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `enrollment.Hooks(f(g(h())))`.
-func (c *EnrollmentClient) Use(hooks ...Hook) {
-	c.hooks.Enrollment = append(c.hooks.Enrollment, hooks...)
+// A call to `Use(f, g, h)` equals to `cashreceipt.Hooks(f(g(h())))`.
+func (c *CashReceiptClient) Use(hooks ...Hook) {
+	c.hooks.CashReceipt = append(c.hooks.CashReceipt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `enrollment.Intercept(f(g(h())))`.
-func (c *EnrollmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Enrollment = append(c.inters.Enrollment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashreceipt.Intercept(f(g(h())))`.
+func (c *CashReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashReceipt = append(c.inters.CashReceipt, interceptors...)
 }
 
-// Create returns a builder for creating a Enrollment entity.
-func (c *EnrollmentClient) Create() *EnrollmentCreate {
-	mutation := newEnrollmentMutation(c.config, OpCreate)
-	return &EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashReceipt entity.
+func (c *CashReceiptClient) Create() *CashReceiptCreate {
+	mutation := newCashReceiptMutation(c.config, OpCreate)
+	return &CashReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Enrollment entities.
-func (c *EnrollmentClient) CreateBulk(builders ...*EnrollmentCreate) *EnrollmentCreateBulk {
-	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashReceipt entities.
+func (c *CashReceiptClient) CreateBulk(builders ...*CashReceiptCreate) *CashReceiptCreateBulk {
+	return &CashReceiptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EnrollmentClient) MapCreateBulk(slice any, setFunc func(*EnrollmentCreate, int)) *EnrollmentCreateBulk {
+func (c *CashReceiptClient) MapCreateBulk(slice any, setFunc func(*CashReceiptCreate, int)) *CashReceiptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EnrollmentCreateBulk{err: fmt.Errorf("calling to EnrollmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashReceiptCreateBulk{err: fmt.Errorf("calling to CashReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EnrollmentCreate, rv.Len())
+	builders := make([]*CashReceiptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+	return &CashReceiptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Enrollment.
-func (c *EnrollmentClient) Update() *EnrollmentUpdate {
-	mutation := newEnrollmentMutation(c.config, OpUpdate)
-	return &EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashReceipt.
+func (c *CashReceiptClient) Update() *CashReceiptUpdate {
+	mutation := newCashReceiptMutation(c.config, OpUpdate)
+	return &CashReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnrollmentClient) UpdateOne(_m *Enrollment) *EnrollmentUpdateOne {
-	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollment(_m))
-	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashReceiptClient) UpdateOne(_m *CashReceipt) *CashReceiptUpdateOne {
+	mutation := newCashReceiptMutation(c.config, OpUpdateOne, withCashReceipt(_m))
+	return &CashReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EnrollmentClient) UpdateOneID(id int) *EnrollmentUpdateOne {
-	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollmentID(id))
-	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashReceiptClient) UpdateOneID(id int) *CashReceiptUpdateOne {
+	mutation := newCashReceiptMutation(c.config, OpUpdateOne, withCashReceiptID(id))
+	return &CashReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Enrollment.
-func (c *EnrollmentClient) Delete() *EnrollmentDelete {
-	mutation := newEnrollmentMutation(c.config, OpDelete)
-	return &EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashReceipt.
+func (c *CashReceiptClient) Delete() *CashReceiptDelete {
+	mutation := newCashReceiptMutation(c.config, OpDelete)
+	return &CashReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnrollmentClient) DeleteOne(_m *Enrollment) *EnrollmentDeleteOne {
+func (c *CashReceiptClient) DeleteOne(_m *CashReceipt) *CashReceiptDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EnrollmentClient) DeleteOneID(id int) *EnrollmentDeleteOne {
-	builder := c.Delete().Where(enrollment.ID(id))
+func (c *CashReceiptClient) DeleteOneID(id int) *CashReceiptDeleteOne {
+	builder := c.Delete().Where(cashreceipt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EnrollmentDeleteOne{builder}
+	return &CashReceiptDeleteOne{builder}
 }
 
-// Query returns a query builder for Enrollment.
-func (c *EnrollmentClient) Query() *EnrollmentQuery {
-	return &EnrollmentQuery{
+// Query returns a query builder for CashReceipt.
+func (c *CashReceiptClient) Query() *CashReceiptQuery {
+	return &CashReceiptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEnrollment},
+		ctx:    &QueryContext{Type: TypeCashReceipt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Enrollment entity by its id.
-func (c *EnrollmentClient) Get(ctx context.Context, id int) (*Enrollment, error) {
-	return c.Query().Where(enrollment.ID(id)).Only(ctx)
+// Get returns a CashReceipt entity by its id.
+func (c *CashReceiptClient) Get(ctx context.Context, id int) (*CashReceipt, error) {
+	return c.Query().Where(cashreceipt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EnrollmentClient) GetX(ctx context.Context, id int) *Enrollment {
+func (c *CashReceiptClient) GetX(ctx context.Context, id int) *CashReceipt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1026,15 +1160,15 @@ func (c *EnrollmentClient) GetX(ctx context.Context, id int) *Enrollment {
 	return obj
 }
 
-// QueryStudent queries the student edge of a Enrollment.
-func (c *EnrollmentClient) QueryStudent(_m *Enrollment) *StudentQuery {
+// QueryStudent queries the student edge of a CashReceipt.
+func (c *CashReceiptClient) QueryStudent(_m *CashReceipt) *StudentQuery {
 	query := (&StudentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.From(cashreceipt.Table, cashreceipt.FieldID, id),
 			sqlgraph.To(student.Table, student.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.StudentTable, enrollment.StudentColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, cashreceipt.StudentTable, cashreceipt.StudentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1042,15 +1176,15 @@ func (c *EnrollmentClient) QueryStudent(_m *Enrollment) *StudentQuery {
 	return query
 }
 
-// QueryCourse queries the course edge of a Enrollment.
-func (c *EnrollmentClient) QueryCourse(_m *Enrollment) *CourseQuery {
-	query := (&CourseClient{config: c.config}).Query()
+// QueryPayments queries the payments edge of a CashReceipt.
+func (c *CashReceiptClient) QueryPayments(_m *CashReceipt) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(course.Table, course.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.CourseTable, enrollment.CourseColumn),
+			sqlgraph.From(cashreceipt.Table, cashreceipt.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cashreceipt.PaymentsTable, cashreceipt.PaymentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1058,15 +1192,15 @@ func (c *EnrollmentClient) QueryCourse(_m *Enrollment) *CourseQuery {
 	return query
 }
 
-// QueryInvoiceLines queries the invoice_lines edge of a Enrollment.
-func (c *EnrollmentClient) QueryInvoiceLines(_m *Enrollment) *InvoiceLineQuery {
-	query := (&InvoiceLineClient{config: c.config}).Query()
+// QueryCashSession queries the cash_session edge of a CashReceipt.
+func (c *CashReceiptClient) QueryCashSession(_m *CashReceipt) *CashSessionQuery {
+	query := (&CashSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
-			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.InvoiceLinesTable, enrollment.InvoiceLinesColumn),
+			sqlgraph.From(cashreceipt.Table, cashreceipt.FieldID, id),
+			sqlgraph.To(cashsession.Table, cashsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cashreceipt.CashSessionTable, cashreceipt.CashSessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1075,131 +1209,131 @@ func (c *EnrollmentClient) QueryInvoiceLines(_m *Enrollment) *InvoiceLineQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EnrollmentClient) Hooks() []Hook {
-	return c.hooks.Enrollment
+func (c *CashReceiptClient) Hooks() []Hook {
+	return c.hooks.CashReceipt
 }
 
 // Interceptors returns the client interceptors.
-func (c *EnrollmentClient) Interceptors() []Interceptor {
-	return c.inters.Enrollment
+func (c *CashReceiptClient) Interceptors() []Interceptor {
+	return c.inters.CashReceipt
 }
 
-func (c *EnrollmentClient) mutate(ctx context.Context, m *EnrollmentMutation) (Value, error) {
+func (c *CashReceiptClient) mutate(ctx context.Context, m *CashReceiptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CashReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Enrollment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CashReceipt mutation op: %q", m.Op())
 	}
 }
 
-// InvoiceClient is a client for the Invoice schema.
-type InvoiceClient struct {
+// CashSessionClient is a client for the CashSession schema.
+type CashSessionClient struct {
 	config
 }
 
-// NewInvoiceClient returns a client for the Invoice from the given config.
-func NewInvoiceClient(c config) *InvoiceClient {
-	return &InvoiceClient{config: c}
+// NewCashSessionClient returns a client for the CashSession from the given config.
+func NewCashSessionClient(c config) *CashSessionClient {
+	return &CashSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
-func (c *InvoiceClient) Use(hooks ...Hook) {
-	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+// A call to `Use(f, g, h)` equals to `cashsession.Hooks(f(g(h())))`.
+func (c *CashSessionClient) Use(hooks ...Hook) {
+	c.hooks.CashSession = append(c.hooks.CashSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
-func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashsession.Intercept(f(g(h())))`.
+func (c *CashSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashSession = append(c.inters.CashSession, interceptors...)
 }
 
-// Create returns a builder for creating a Invoice entity.
-func (c *InvoiceClient) Create() *InvoiceCreate {
-	mutation := newInvoiceMutation(c.config, OpCreate)
-	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashSession entity.
+func (c *CashSessionClient) Create() *CashSessionCreate {
+	mutation := newCashSessionMutation(c.config, OpCreate)
+	return &CashSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invoice entities.
-func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
-	return &InvoiceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashSession entities.
+func (c *CashSessionClient) CreateBulk(builders ...*CashSessionCreate) *CashSessionCreateBulk {
+	return &CashSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+func (c *CashSessionClient) MapCreateBulk(slice any, setFunc func(*CashSessionCreate, int)) *CashSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashSessionCreateBulk{err: fmt.Errorf("calling to CashSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvoiceCreate, rv.Len())
+	builders := make([]*CashSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvoiceCreateBulk{config: c.config, builders: builders}
+	return &CashSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invoice.
-func (c *InvoiceClient) Update() *InvoiceUpdate {
-	mutation := newInvoiceMutation(c.config, OpUpdate)
-	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashSession.
+func (c *CashSessionClient) Update() *CashSessionUpdate {
+	mutation := newCashSessionMutation(c.config, OpUpdate)
+	return &CashSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashSessionClient) UpdateOne(_m *CashSession) *CashSessionUpdateOne {
+	mutation := newCashSessionMutation(c.config, OpUpdateOne, withCashSession(_m))
+	return &CashSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashSessionClient) UpdateOneID(id int) *CashSessionUpdateOne {
+	mutation := newCashSessionMutation(c.config, OpUpdateOne, withCashSessionID(id))
+	return &CashSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invoice.
-func (c *InvoiceClient) Delete() *InvoiceDelete {
-	mutation := newInvoiceMutation(c.config, OpDelete)
-	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashSession.
+func (c *CashSessionClient) Delete() *CashSessionDelete {
+	mutation := newCashSessionMutation(c.config, OpDelete)
+	return &CashSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+func (c *CashSessionClient) DeleteOne(_m *CashSession) *CashSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
-	builder := c.Delete().Where(invoice.ID(id))
+func (c *CashSessionClient) DeleteOneID(id int) *CashSessionDeleteOne {
+	builder := c.Delete().Where(cashsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvoiceDeleteOne{builder}
+	return &CashSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Invoice.
-func (c *InvoiceClient) Query() *InvoiceQuery {
-	return &InvoiceQuery{
+// Query returns a query builder for CashSession.
+func (c *CashSessionClient) Query() *CashSessionQuery {
+	return &CashSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvoice},
+		ctx:    &QueryContext{Type: TypeCashSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invoice entity by its id.
-func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
-	return c.Query().Where(invoice.ID(id)).Only(ctx)
+// Get returns a CashSession entity by its id.
+func (c *CashSessionClient) Get(ctx context.Context, id int) (*CashSession, error) {
+	return c.Query().Where(cashsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+func (c *CashSessionClient) GetX(ctx context.Context, id int) *CashSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1207,31 +1341,15 @@ func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
 	return obj
 }
 
-// QueryStudent queries the student edge of a Invoice.
-func (c *InvoiceClient) QueryStudent(_m *Invoice) *StudentQuery {
-	query := (&StudentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(invoice.Table, invoice.FieldID, id),
-			sqlgraph.To(student.Table, student.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invoice.StudentTable, invoice.StudentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLines queries the lines edge of a Invoice.
-func (c *InvoiceClient) QueryLines(_m *Invoice) *InvoiceLineQuery {
-	query := (&InvoiceLineClient{config: c.config}).Query()
+// QueryMovements queries the movements edge of a CashSession.
+func (c *CashSessionClient) QueryMovements(_m *CashSession) *CashMovementQuery {
+	query := (&CashMovementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invoice.Table, invoice.FieldID, id),
-			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LinesTable, invoice.LinesColumn),
+			sqlgraph.From(cashsession.Table, cashsession.FieldID, id),
+			sqlgraph.To(cashmovement.Table, cashmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cashsession.MovementsTable, cashsession.MovementsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1239,15 +1357,15 @@ func (c *InvoiceClient) QueryLines(_m *Invoice) *InvoiceLineQuery {
 	return query
 }
 
-// QueryPayments queries the payments edge of a Invoice.
-func (c *InvoiceClient) QueryPayments(_m *Invoice) *PaymentQuery {
-	query := (&PaymentClient{config: c.config}).Query()
+// QueryReceipts queries the receipts edge of a CashSession.
+func (c *CashSessionClient) QueryReceipts(_m *CashSession) *CashReceiptQuery {
+	query := (&CashReceiptClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invoice.Table, invoice.FieldID, id),
-			sqlgraph.To(payment.Table, payment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, invoice.PaymentsTable, invoice.PaymentsColumn),
+			sqlgraph.From(cashsession.Table, cashsession.FieldID, id),
+			sqlgraph.To(cashreceipt.Table, cashreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cashsession.ReceiptsTable, cashsession.ReceiptsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1256,131 +1374,131 @@ func (c *InvoiceClient) QueryPayments(_m *Invoice) *PaymentQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *InvoiceClient) Hooks() []Hook {
-	return c.hooks.Invoice
+func (c *CashSessionClient) Hooks() []Hook {
+	return c.hooks.CashSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvoiceClient) Interceptors() []Interceptor {
-	return c.inters.Invoice
+func (c *CashSessionClient) Interceptors() []Interceptor {
+	return c.inters.CashSession
 }
 
-func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+func (c *CashSessionClient) mutate(ctx context.Context, m *CashSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CashSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CashSession mutation op: %q", m.Op())
 	}
 }
 
-// InvoiceLineClient is a client for the InvoiceLine schema.
-type InvoiceLineClient struct {
+// CourseClient is a client for the Course schema.
+type CourseClient struct {
 	config
 }
 
-// NewInvoiceLineClient returns a client for the InvoiceLine from the given config.
-func NewInvoiceLineClient(c config) *InvoiceLineClient {
-	return &InvoiceLineClient{config: c}
+// NewCourseClient returns a client for the Course from the given config.
+func NewCourseClient(c config) *CourseClient {
+	return &CourseClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invoiceline.Hooks(f(g(h())))`.
-func (c *InvoiceLineClient) Use(hooks ...Hook) {
-	c.hooks.InvoiceLine = append(c.hooks.InvoiceLine, hooks...)
+// A call to `Use(f, g, h)` equals to `course.Hooks(f(g(h())))`.
+func (c *CourseClient) Use(hooks ...Hook) {
+	c.hooks.Course = append(c.hooks.Course, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invoiceline.Intercept(f(g(h())))`.
-func (c *InvoiceLineClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InvoiceLine = append(c.inters.InvoiceLine, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `course.Intercept(f(g(h())))`.
+func (c *CourseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Course = append(c.inters.Course, interceptors...)
 }
 
-// Create returns a builder for creating a InvoiceLine entity.
-func (c *InvoiceLineClient) Create() *InvoiceLineCreate {
-	mutation := newInvoiceLineMutation(c.config, OpCreate)
-	return &InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Course entity.
+func (c *CourseClient) Create() *CourseCreate {
+	mutation := newCourseMutation(c.config, OpCreate)
+	return &CourseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InvoiceLine entities.
-func (c *InvoiceLineClient) CreateBulk(builders ...*InvoiceLineCreate) *InvoiceLineCreateBulk {
-	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Course entities.
+func (c *CourseClient) CreateBulk(builders ...*CourseCreate) *CourseCreateBulk {
+	return &CourseCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvoiceLineClient) MapCreateBulk(slice any, setFunc func(*InvoiceLineCreate, int)) *InvoiceLineCreateBulk {
+func (c *CourseClient) MapCreateBulk(slice any, setFunc func(*CourseCreate, int)) *CourseCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvoiceLineCreateBulk{err: fmt.Errorf("calling to InvoiceLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CourseCreateBulk{err: fmt.Errorf("calling to CourseClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvoiceLineCreate, rv.Len())
+	builders := make([]*CourseCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+	return &CourseCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InvoiceLine.
-func (c *InvoiceLineClient) Update() *InvoiceLineUpdate {
-	mutation := newInvoiceLineMutation(c.config, OpUpdate)
-	return &InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Course.
+func (c *CourseClient) Update() *CourseUpdate {
+	mutation := newCourseMutation(c.config, OpUpdate)
+	return &CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvoiceLineClient) UpdateOne(_m *InvoiceLine) *InvoiceLineUpdateOne {
-	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLine(_m))
-	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseClient) UpdateOne(_m *Course) *CourseUpdateOne {
+	mutation := newCourseMutation(c.config, OpUpdateOne, withCourse(_m))
+	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvoiceLineClient) UpdateOneID(id int) *InvoiceLineUpdateOne {
-	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLineID(id))
-	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseClient) UpdateOneID(id int) *CourseUpdateOne {
+	mutation := newCourseMutation(c.config, OpUpdateOne, withCourseID(id))
+	return &CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InvoiceLine.
-func (c *InvoiceLineClient) Delete() *InvoiceLineDelete {
-	mutation := newInvoiceLineMutation(c.config, OpDelete)
-	return &InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Course.
+func (c *CourseClient) Delete() *CourseDelete {
+	mutation := newCourseMutation(c.config, OpDelete)
+	return &CourseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvoiceLineClient) DeleteOne(_m *InvoiceLine) *InvoiceLineDeleteOne {
+func (c *CourseClient) DeleteOne(_m *Course) *CourseDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvoiceLineClient) DeleteOneID(id int) *InvoiceLineDeleteOne {
-	builder := c.Delete().Where(invoiceline.ID(id))
+func (c *CourseClient) DeleteOneID(id int) *CourseDeleteOne {
+	builder := c.Delete().Where(course.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvoiceLineDeleteOne{builder}
+	return &CourseDeleteOne{builder}
 }
 
-// Query returns a query builder for InvoiceLine.
-func (c *InvoiceLineClient) Query() *InvoiceLineQuery {
-	return &InvoiceLineQuery{
+// Query returns a query builder for Course.
+func (c *CourseClient) Query() *CourseQuery {
+	return &CourseQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvoiceLine},
+		ctx:    &QueryContext{Type: TypeCourse},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InvoiceLine entity by its id.
-func (c *InvoiceLineClient) Get(ctx context.Context, id int) (*InvoiceLine, error) {
-	return c.Query().Where(invoiceline.ID(id)).Only(ctx)
+// Get returns a Course entity by its id.
+func (c *CourseClient) Get(ctx context.Context, id int) (*Course, error) {
+	return c.Query().Where(course.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvoiceLineClient) GetX(ctx context.Context, id int) *InvoiceLine {
+func (c *CourseClient) GetX(ctx context.Context, id int) *Course {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1388,15 +1506,15 @@ func (c *InvoiceLineClient) GetX(ctx context.Context, id int) *InvoiceLine {
 	return obj
 }
 
-// QueryInvoice queries the invoice edge of a InvoiceLine.
-func (c *InvoiceLineClient) QueryInvoice(_m *InvoiceLine) *InvoiceQuery {
-	query := (&InvoiceClient{config: c.config}).Query()
+// QueryTeacher queries the teacher edge of a Course.
+func (c *CourseClient) QueryTeacher(_m *Course) *TeacherQuery {
+	query := (&TeacherClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
-			sqlgraph.To(invoice.Table, invoice.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.InvoiceTable, invoiceline.InvoiceColumn),
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(teacher.Table, teacher.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, course.TeacherTable, course.TeacherColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1404,15 +1522,15 @@ func (c *InvoiceLineClient) QueryInvoice(_m *InvoiceLine) *InvoiceQuery {
 	return query
 }
 
-// QueryEnrollment queries the enrollment edge of a InvoiceLine.
-func (c *InvoiceLineClient) QueryEnrollment(_m *InvoiceLine) *EnrollmentQuery {
+// QueryEnrollments queries the enrollments edge of a Course.
+func (c *CourseClient) QueryEnrollments(_m *Course) *EnrollmentQuery {
 	query := (&EnrollmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.From(course.Table, course.FieldID, id),
 			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.EnrollmentTable, invoiceline.EnrollmentColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.EnrollmentsTable, course.EnrollmentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1420,132 +1538,260 @@ func (c *InvoiceLineClient) QueryEnrollment(_m *InvoiceLine) *EnrollmentQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *InvoiceLineClient) Hooks() []Hook {
-	return c.hooks.InvoiceLine
-}
-
-// Interceptors returns the client interceptors.
-func (c *InvoiceLineClient) Interceptors() []Interceptor {
-	return c.inters.InvoiceLine
+// QueryMonthStats queries the month_stats edge of a Course.
+func (c *CourseClient) QueryMonthStats(_m *Course) *CourseMonthStatQuery {
+	query := (&CourseMonthStatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(coursemonthstat.Table, coursemonthstat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.MonthStatsTable, course.MonthStatsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *InvoiceLineClient) mutate(ctx context.Context, m *InvoiceLineMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown InvoiceLine mutation op: %q", m.Op())
+// QueryLessonPackages queries the lesson_packages edge of a Course.
+func (c *CourseClient) QueryLessonPackages(_m *Course) *LessonPackageQuery {
+	query := (&LessonPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.LessonPackagesTable, course.LessonPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// PaymentClient is a client for the Payment schema.
-type PaymentClient struct {
-	config
+// QueryPrices queries the prices edge of a Course.
+func (c *CourseClient) QueryPrices(_m *Course) *CoursePriceQuery {
+	query := (&CoursePriceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(courseprice.Table, courseprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.PricesTable, course.PricesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// NewPaymentClient returns a client for the Payment from the given config.
-func NewPaymentClient(c config) *PaymentClient {
-	return &PaymentClient{config: c}
+// QueryBillingTerms queries the billing_terms edge of a Course.
+func (c *CourseClient) QueryBillingTerms(_m *Course) *BillingTermQuery {
+	query := (&BillingTermClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(billingterm.Table, billingterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.BillingTermsTable, course.BillingTermsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
-func (c *PaymentClient) Use(hooks ...Hook) {
-	c.hooks.Payment = append(c.hooks.Payment, hooks...)
+// QueryExcusedAbsences queries the excused_absences edge of a Course.
+func (c *CourseClient) QueryExcusedAbsences(_m *Course) *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.ExcusedAbsencesTable, course.ExcusedAbsencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `payment.Intercept(f(g(h())))`.
-func (c *PaymentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Payment = append(c.inters.Payment, interceptors...)
+// QueryWaitlistEntries queries the waitlist_entries edge of a Course.
+func (c *CourseClient) QueryWaitlistEntries(_m *Course) *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.WaitlistEntriesTable, course.WaitlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Create returns a builder for creating a Payment entity.
-func (c *PaymentClient) Create() *PaymentCreate {
-	mutation := newPaymentMutation(c.config, OpCreate)
-	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// QueryLeads queries the leads edge of a Course.
+func (c *CourseClient) QueryLeads(_m *Course) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.LeadsTable, course.LeadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// CreateBulk returns a builder for creating a bulk of Payment entities.
-func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
-	return &PaymentCreateBulk{config: c.config, builders: builders}
+// QueryScheduleSlots queries the schedule_slots edge of a Course.
+func (c *CourseClient) QueryScheduleSlots(_m *Course) *ScheduleSlotQuery {
+	query := (&ScheduleSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, id),
+			sqlgraph.To(scheduleslot.Table, scheduleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.ScheduleSlotsTable, course.ScheduleSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CourseClient) Hooks() []Hook {
+	return c.hooks.Course
+}
+
+// Interceptors returns the client interceptors.
+func (c *CourseClient) Interceptors() []Interceptor {
+	return c.inters.Course
+}
+
+func (c *CourseClient) mutate(ctx context.Context, m *CourseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CourseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CourseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CourseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CourseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Course mutation op: %q", m.Op())
+	}
+}
+
+// CourseMonthStatClient is a client for the CourseMonthStat schema.
+type CourseMonthStatClient struct {
+	config
+}
+
+// NewCourseMonthStatClient returns a client for the CourseMonthStat from the given config.
+func NewCourseMonthStatClient(c config) *CourseMonthStatClient {
+	return &CourseMonthStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coursemonthstat.Hooks(f(g(h())))`.
+func (c *CourseMonthStatClient) Use(hooks ...Hook) {
+	c.hooks.CourseMonthStat = append(c.hooks.CourseMonthStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coursemonthstat.Intercept(f(g(h())))`.
+func (c *CourseMonthStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CourseMonthStat = append(c.inters.CourseMonthStat, interceptors...)
+}
+
+// Create returns a builder for creating a CourseMonthStat entity.
+func (c *CourseMonthStatClient) Create() *CourseMonthStatCreate {
+	mutation := newCourseMonthStatMutation(c.config, OpCreate)
+	return &CourseMonthStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CourseMonthStat entities.
+func (c *CourseMonthStatClient) CreateBulk(builders ...*CourseMonthStatCreate) *CourseMonthStatCreateBulk {
+	return &CourseMonthStatCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentClient) MapCreateBulk(slice any, setFunc func(*PaymentCreate, int)) *PaymentCreateBulk {
+func (c *CourseMonthStatClient) MapCreateBulk(slice any, setFunc func(*CourseMonthStatCreate, int)) *CourseMonthStatCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentCreateBulk{err: fmt.Errorf("calling to PaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CourseMonthStatCreateBulk{err: fmt.Errorf("calling to CourseMonthStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentCreate, rv.Len())
+	builders := make([]*CourseMonthStatCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentCreateBulk{config: c.config, builders: builders}
+	return &CourseMonthStatCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Payment.
-func (c *PaymentClient) Update() *PaymentUpdate {
-	mutation := newPaymentMutation(c.config, OpUpdate)
-	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CourseMonthStat.
+func (c *CourseMonthStatClient) Update() *CourseMonthStatUpdate {
+	mutation := newCourseMonthStatMutation(c.config, OpUpdate)
+	return &CourseMonthStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentClient) UpdateOne(_m *Payment) *PaymentUpdateOne {
-	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(_m))
-	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseMonthStatClient) UpdateOne(_m *CourseMonthStat) *CourseMonthStatUpdateOne {
+	mutation := newCourseMonthStatMutation(c.config, OpUpdateOne, withCourseMonthStat(_m))
+	return &CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentClient) UpdateOneID(id int) *PaymentUpdateOne {
-	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
-	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CourseMonthStatClient) UpdateOneID(id int) *CourseMonthStatUpdateOne {
+	mutation := newCourseMonthStatMutation(c.config, OpUpdateOne, withCourseMonthStatID(id))
+	return &CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Payment.
-func (c *PaymentClient) Delete() *PaymentDelete {
-	mutation := newPaymentMutation(c.config, OpDelete)
-	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CourseMonthStat.
+func (c *CourseMonthStatClient) Delete() *CourseMonthStatDelete {
+	mutation := newCourseMonthStatMutation(c.config, OpDelete)
+	return &CourseMonthStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentClient) DeleteOne(_m *Payment) *PaymentDeleteOne {
+func (c *CourseMonthStatClient) DeleteOne(_m *CourseMonthStat) *CourseMonthStatDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentClient) DeleteOneID(id int) *PaymentDeleteOne {
-	builder := c.Delete().Where(payment.ID(id))
+func (c *CourseMonthStatClient) DeleteOneID(id int) *CourseMonthStatDeleteOne {
+	builder := c.Delete().Where(coursemonthstat.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentDeleteOne{builder}
+	return &CourseMonthStatDeleteOne{builder}
 }
 
-// Query returns a query builder for Payment.
-func (c *PaymentClient) Query() *PaymentQuery {
-	return &PaymentQuery{
+// Query returns a query builder for CourseMonthStat.
+func (c *CourseMonthStatClient) Query() *CourseMonthStatQuery {
+	return &CourseMonthStatQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePayment},
+		ctx:    &QueryContext{Type: TypeCourseMonthStat},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Payment entity by its id.
-func (c *PaymentClient) Get(ctx context.Context, id int) (*Payment, error) {
-	return c.Query().Where(payment.ID(id)).Only(ctx)
+// Get returns a CourseMonthStat entity by its id.
+func (c *CourseMonthStatClient) Get(ctx context.Context, id int) (*CourseMonthStat, error) {
+	return c.Query().Where(coursemonthstat.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
+func (c *CourseMonthStatClient) GetX(ctx context.Context, id int) *CourseMonthStat {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1553,31 +1799,15 @@ func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
 	return obj
 }
 
-// QueryStudent queries the student edge of a Payment.
-func (c *PaymentClient) QueryStudent(_m *Payment) *StudentQuery {
-	query := (&StudentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(payment.Table, payment.FieldID, id),
-			sqlgraph.To(student.Table, student.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, payment.StudentTable, payment.StudentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInvoice queries the invoice edge of a Payment.
-func (c *PaymentClient) QueryInvoice(_m *Payment) *InvoiceQuery {
-	query := (&InvoiceClient{config: c.config}).Query()
+// QueryCourse queries the course edge of a CourseMonthStat.
+func (c *CourseMonthStatClient) QueryCourse(_m *CourseMonthStat) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(payment.Table, payment.FieldID, id),
-			sqlgraph.To(invoice.Table, invoice.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, payment.InvoiceTable, payment.InvoiceColumn),
+			sqlgraph.From(coursemonthstat.Table, coursemonthstat.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coursemonthstat.CourseTable, coursemonthstat.CourseColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1586,264 +1816,3341 @@ func (c *PaymentClient) QueryInvoice(_m *Payment) *InvoiceQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentClient) Hooks() []Hook {
-	return c.hooks.Payment
+func (c *CourseMonthStatClient) Hooks() []Hook {
+	return c.hooks.CourseMonthStat
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentClient) Interceptors() []Interceptor {
-	return c.inters.Payment
+func (c *CourseMonthStatClient) Interceptors() []Interceptor {
+	return c.inters.CourseMonthStat
 }
 
-func (c *PaymentClient) mutate(ctx context.Context, m *PaymentMutation) (Value, error) {
+func (c *CourseMonthStatClient) mutate(ctx context.Context, m *CourseMonthStatMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseMonthStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseMonthStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CourseMonthStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CourseMonthStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Payment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CourseMonthStat mutation op: %q", m.Op())
 	}
 }
 
-// SettingsClient is a client for the Settings schema.
-type SettingsClient struct {
+// CoursePriceClient is a client for the CoursePrice schema.
+type CoursePriceClient struct {
 	config
 }
 
-// NewSettingsClient returns a client for the Settings from the given config.
-func NewSettingsClient(c config) *SettingsClient {
-	return &SettingsClient{config: c}
+// NewCoursePriceClient returns a client for the CoursePrice from the given config.
+func NewCoursePriceClient(c config) *CoursePriceClient {
+	return &CoursePriceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
-func (c *SettingsClient) Use(hooks ...Hook) {
-	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+// A call to `Use(f, g, h)` equals to `courseprice.Hooks(f(g(h())))`.
+func (c *CoursePriceClient) Use(hooks ...Hook) {
+	c.hooks.CoursePrice = append(c.hooks.CoursePrice, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
-func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Settings = append(c.inters.Settings, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `courseprice.Intercept(f(g(h())))`.
+func (c *CoursePriceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoursePrice = append(c.inters.CoursePrice, interceptors...)
 }
 
-// Create returns a builder for creating a Settings entity.
-func (c *SettingsClient) Create() *SettingsCreate {
-	mutation := newSettingsMutation(c.config, OpCreate)
-	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CoursePrice entity.
+func (c *CoursePriceClient) Create() *CoursePriceCreate {
+	mutation := newCoursePriceMutation(c.config, OpCreate)
+	return &CoursePriceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Settings entities.
-func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CoursePrice entities.
+func (c *CoursePriceClient) CreateBulk(builders ...*CoursePriceCreate) *CoursePriceCreateBulk {
+	return &CoursePriceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+func (c *CoursePriceClient) MapCreateBulk(slice any, setFunc func(*CoursePriceCreate, int)) *CoursePriceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CoursePriceCreateBulk{err: fmt.Errorf("calling to CoursePriceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettingsCreate, rv.Len())
+	builders := make([]*CoursePriceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+	return &CoursePriceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Settings.
-func (c *SettingsClient) Update() *SettingsUpdate {
-	mutation := newSettingsMutation(c.config, OpUpdate)
-	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CoursePrice.
+func (c *CoursePriceClient) Update() *CoursePriceUpdate {
+	mutation := newCoursePriceMutation(c.config, OpUpdate)
+	return &CoursePriceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettingsClient) UpdateOne(_m *Settings) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(_m))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CoursePriceClient) UpdateOne(_m *CoursePrice) *CoursePriceUpdateOne {
+	mutation := newCoursePriceMutation(c.config, OpUpdateOne, withCoursePrice(_m))
+	return &CoursePriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CoursePriceClient) UpdateOneID(id int) *CoursePriceUpdateOne {
+	mutation := newCoursePriceMutation(c.config, OpUpdateOne, withCoursePriceID(id))
+	return &CoursePriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Settings.
-func (c *SettingsClient) Delete() *SettingsDelete {
-	mutation := newSettingsMutation(c.config, OpDelete)
-	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CoursePrice.
+func (c *CoursePriceClient) Delete() *CoursePriceDelete {
+	mutation := newCoursePriceMutation(c.config, OpDelete)
+	return &CoursePriceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettingsClient) DeleteOne(_m *Settings) *SettingsDeleteOne {
+func (c *CoursePriceClient) DeleteOne(_m *CoursePrice) *CoursePriceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
-	builder := c.Delete().Where(settings.ID(id))
+func (c *CoursePriceClient) DeleteOneID(id int) *CoursePriceDeleteOne {
+	builder := c.Delete().Where(courseprice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettingsDeleteOne{builder}
+	return &CoursePriceDeleteOne{builder}
 }
 
-// Query returns a query builder for Settings.
-func (c *SettingsClient) Query() *SettingsQuery {
-	return &SettingsQuery{
+// Query returns a query builder for CoursePrice.
+func (c *CoursePriceClient) Query() *CoursePriceQuery {
+	return &CoursePriceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSettings},
+		ctx:    &QueryContext{Type: TypeCoursePrice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Settings entity by its id.
-func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
-	return c.Query().Where(settings.ID(id)).Only(ctx)
+// Get returns a CoursePrice entity by its id.
+func (c *CoursePriceClient) Get(ctx context.Context, id int) (*CoursePrice, error) {
+	return c.Query().Where(courseprice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoursePriceClient) GetX(ctx context.Context, id int) *CoursePrice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a CoursePrice.
+func (c *CoursePriceClient) QueryCourse(_m *CoursePrice) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(courseprice.Table, courseprice.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, courseprice.CourseTable, courseprice.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CoursePriceClient) Hooks() []Hook {
+	return c.hooks.CoursePrice
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoursePriceClient) Interceptors() []Interceptor {
+	return c.inters.CoursePrice
+}
+
+func (c *CoursePriceClient) mutate(ctx context.Context, m *CoursePriceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoursePriceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoursePriceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoursePriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoursePriceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoursePrice mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentClient is a client for the Enrollment schema.
+type EnrollmentClient struct {
+	config
+}
+
+// NewEnrollmentClient returns a client for the Enrollment from the given config.
+func NewEnrollmentClient(c config) *EnrollmentClient {
+	return &EnrollmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollment.Hooks(f(g(h())))`.
+func (c *EnrollmentClient) Use(hooks ...Hook) {
+	c.hooks.Enrollment = append(c.hooks.Enrollment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollment.Intercept(f(g(h())))`.
+func (c *EnrollmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Enrollment = append(c.inters.Enrollment, interceptors...)
+}
+
+// Create returns a builder for creating a Enrollment entity.
+func (c *EnrollmentClient) Create() *EnrollmentCreate {
+	mutation := newEnrollmentMutation(c.config, OpCreate)
+	return &EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Enrollment entities.
+func (c *EnrollmentClient) CreateBulk(builders ...*EnrollmentCreate) *EnrollmentCreateBulk {
+	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentClient) MapCreateBulk(slice any, setFunc func(*EnrollmentCreate, int)) *EnrollmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentCreateBulk{err: fmt.Errorf("calling to EnrollmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Enrollment.
+func (c *EnrollmentClient) Update() *EnrollmentUpdate {
+	mutation := newEnrollmentMutation(c.config, OpUpdate)
+	return &EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentClient) UpdateOne(_m *Enrollment) *EnrollmentUpdateOne {
+	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollment(_m))
+	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentClient) UpdateOneID(id int) *EnrollmentUpdateOne {
+	mutation := newEnrollmentMutation(c.config, OpUpdateOne, withEnrollmentID(id))
+	return &EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Enrollment.
+func (c *EnrollmentClient) Delete() *EnrollmentDelete {
+	mutation := newEnrollmentMutation(c.config, OpDelete)
+	return &EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnrollmentClient) DeleteOne(_m *Enrollment) *EnrollmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentClient) DeleteOneID(id int) *EnrollmentDeleteOne {
+	builder := c.Delete().Where(enrollment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Enrollment.
+func (c *EnrollmentClient) Query() *EnrollmentQuery {
+	return &EnrollmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Enrollment entity by its id.
+func (c *EnrollmentClient) Get(ctx context.Context, id int) (*Enrollment, error) {
+	return c.Query().Where(enrollment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentClient) GetX(ctx context.Context, id int) *Enrollment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a Enrollment.
+func (c *EnrollmentClient) QueryStudent(_m *Enrollment) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.StudentTable, enrollment.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a Enrollment.
+func (c *EnrollmentClient) QueryCourse(_m *Enrollment) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollment.CourseTable, enrollment.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoiceLines queries the invoice_lines edge of a Enrollment.
+func (c *EnrollmentClient) QueryInvoiceLines(_m *Enrollment) *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.InvoiceLinesTable, enrollment.InvoiceLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrices queries the prices edge of a Enrollment.
+func (c *EnrollmentClient) QueryPrices(_m *Enrollment) *EnrollmentPriceQuery {
+	query := (&EnrollmentPriceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(enrollmentprice.Table, enrollmentprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.PricesTable, enrollment.PricesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPauses queries the pauses edge of a Enrollment.
+func (c *EnrollmentClient) QueryPauses(_m *Enrollment) *EnrollmentPauseQuery {
+	query := (&EnrollmentPauseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, id),
+			sqlgraph.To(enrollmentpause.Table, enrollmentpause.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.PausesTable, enrollment.PausesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentClient) Hooks() []Hook {
+	return c.hooks.Enrollment
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentClient) Interceptors() []Interceptor {
+	return c.inters.Enrollment
+}
+
+func (c *EnrollmentClient) mutate(ctx context.Context, m *EnrollmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Enrollment mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentPauseClient is a client for the EnrollmentPause schema.
+type EnrollmentPauseClient struct {
+	config
+}
+
+// NewEnrollmentPauseClient returns a client for the EnrollmentPause from the given config.
+func NewEnrollmentPauseClient(c config) *EnrollmentPauseClient {
+	return &EnrollmentPauseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollmentpause.Hooks(f(g(h())))`.
+func (c *EnrollmentPauseClient) Use(hooks ...Hook) {
+	c.hooks.EnrollmentPause = append(c.hooks.EnrollmentPause, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollmentpause.Intercept(f(g(h())))`.
+func (c *EnrollmentPauseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrollmentPause = append(c.inters.EnrollmentPause, interceptors...)
+}
+
+// Create returns a builder for creating a EnrollmentPause entity.
+func (c *EnrollmentPauseClient) Create() *EnrollmentPauseCreate {
+	mutation := newEnrollmentPauseMutation(c.config, OpCreate)
+	return &EnrollmentPauseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnrollmentPause entities.
+func (c *EnrollmentPauseClient) CreateBulk(builders ...*EnrollmentPauseCreate) *EnrollmentPauseCreateBulk {
+	return &EnrollmentPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentPauseClient) MapCreateBulk(slice any, setFunc func(*EnrollmentPauseCreate, int)) *EnrollmentPauseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentPauseCreateBulk{err: fmt.Errorf("calling to EnrollmentPauseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentPauseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnrollmentPause.
+func (c *EnrollmentPauseClient) Update() *EnrollmentPauseUpdate {
+	mutation := newEnrollmentPauseMutation(c.config, OpUpdate)
+	return &EnrollmentPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentPauseClient) UpdateOne(_m *EnrollmentPause) *EnrollmentPauseUpdateOne {
+	mutation := newEnrollmentPauseMutation(c.config, OpUpdateOne, withEnrollmentPause(_m))
+	return &EnrollmentPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentPauseClient) UpdateOneID(id int) *EnrollmentPauseUpdateOne {
+	mutation := newEnrollmentPauseMutation(c.config, OpUpdateOne, withEnrollmentPauseID(id))
+	return &EnrollmentPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnrollmentPause.
+func (c *EnrollmentPauseClient) Delete() *EnrollmentPauseDelete {
+	mutation := newEnrollmentPauseMutation(c.config, OpDelete)
+	return &EnrollmentPauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnrollmentPauseClient) DeleteOne(_m *EnrollmentPause) *EnrollmentPauseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentPauseClient) DeleteOneID(id int) *EnrollmentPauseDeleteOne {
+	builder := c.Delete().Where(enrollmentpause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentPauseDeleteOne{builder}
+}
+
+// Query returns a query builder for EnrollmentPause.
+func (c *EnrollmentPauseClient) Query() *EnrollmentPauseQuery {
+	return &EnrollmentPauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollmentPause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnrollmentPause entity by its id.
+func (c *EnrollmentPauseClient) Get(ctx context.Context, id int) (*EnrollmentPause, error) {
+	return c.Query().Where(enrollmentpause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentPauseClient) GetX(ctx context.Context, id int) *EnrollmentPause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnrollment queries the enrollment edge of a EnrollmentPause.
+func (c *EnrollmentPauseClient) QueryEnrollment(_m *EnrollmentPause) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollmentpause.Table, enrollmentpause.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollmentpause.EnrollmentTable, enrollmentpause.EnrollmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentPauseClient) Hooks() []Hook {
+	return c.hooks.EnrollmentPause
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentPauseClient) Interceptors() []Interceptor {
+	return c.inters.EnrollmentPause
+}
+
+func (c *EnrollmentPauseClient) mutate(ctx context.Context, m *EnrollmentPauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentPauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentPauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnrollmentPause mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentPriceClient is a client for the EnrollmentPrice schema.
+type EnrollmentPriceClient struct {
+	config
+}
+
+// NewEnrollmentPriceClient returns a client for the EnrollmentPrice from the given config.
+func NewEnrollmentPriceClient(c config) *EnrollmentPriceClient {
+	return &EnrollmentPriceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollmentprice.Hooks(f(g(h())))`.
+func (c *EnrollmentPriceClient) Use(hooks ...Hook) {
+	c.hooks.EnrollmentPrice = append(c.hooks.EnrollmentPrice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollmentprice.Intercept(f(g(h())))`.
+func (c *EnrollmentPriceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrollmentPrice = append(c.inters.EnrollmentPrice, interceptors...)
+}
+
+// Create returns a builder for creating a EnrollmentPrice entity.
+func (c *EnrollmentPriceClient) Create() *EnrollmentPriceCreate {
+	mutation := newEnrollmentPriceMutation(c.config, OpCreate)
+	return &EnrollmentPriceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnrollmentPrice entities.
+func (c *EnrollmentPriceClient) CreateBulk(builders ...*EnrollmentPriceCreate) *EnrollmentPriceCreateBulk {
+	return &EnrollmentPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentPriceClient) MapCreateBulk(slice any, setFunc func(*EnrollmentPriceCreate, int)) *EnrollmentPriceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentPriceCreateBulk{err: fmt.Errorf("calling to EnrollmentPriceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentPriceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnrollmentPrice.
+func (c *EnrollmentPriceClient) Update() *EnrollmentPriceUpdate {
+	mutation := newEnrollmentPriceMutation(c.config, OpUpdate)
+	return &EnrollmentPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentPriceClient) UpdateOne(_m *EnrollmentPrice) *EnrollmentPriceUpdateOne {
+	mutation := newEnrollmentPriceMutation(c.config, OpUpdateOne, withEnrollmentPrice(_m))
+	return &EnrollmentPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentPriceClient) UpdateOneID(id int) *EnrollmentPriceUpdateOne {
+	mutation := newEnrollmentPriceMutation(c.config, OpUpdateOne, withEnrollmentPriceID(id))
+	return &EnrollmentPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnrollmentPrice.
+func (c *EnrollmentPriceClient) Delete() *EnrollmentPriceDelete {
+	mutation := newEnrollmentPriceMutation(c.config, OpDelete)
+	return &EnrollmentPriceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnrollmentPriceClient) DeleteOne(_m *EnrollmentPrice) *EnrollmentPriceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentPriceClient) DeleteOneID(id int) *EnrollmentPriceDeleteOne {
+	builder := c.Delete().Where(enrollmentprice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentPriceDeleteOne{builder}
+}
+
+// Query returns a query builder for EnrollmentPrice.
+func (c *EnrollmentPriceClient) Query() *EnrollmentPriceQuery {
+	return &EnrollmentPriceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollmentPrice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnrollmentPrice entity by its id.
+func (c *EnrollmentPriceClient) Get(ctx context.Context, id int) (*EnrollmentPrice, error) {
+	return c.Query().Where(enrollmentprice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentPriceClient) GetX(ctx context.Context, id int) *EnrollmentPrice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnrollment queries the enrollment edge of a EnrollmentPrice.
+func (c *EnrollmentPriceClient) QueryEnrollment(_m *EnrollmentPrice) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollmentprice.Table, enrollmentprice.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrollmentprice.EnrollmentTable, enrollmentprice.EnrollmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentPriceClient) Hooks() []Hook {
+	return c.hooks.EnrollmentPrice
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentPriceClient) Interceptors() []Interceptor {
+	return c.inters.EnrollmentPrice
+}
+
+func (c *EnrollmentPriceClient) mutate(ctx context.Context, m *EnrollmentPriceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentPriceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentPriceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnrollmentPrice mutation op: %q", m.Op())
+	}
+}
+
+// ExcusedAbsenceClient is a client for the ExcusedAbsence schema.
+type ExcusedAbsenceClient struct {
+	config
+}
+
+// NewExcusedAbsenceClient returns a client for the ExcusedAbsence from the given config.
+func NewExcusedAbsenceClient(c config) *ExcusedAbsenceClient {
+	return &ExcusedAbsenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `excusedabsence.Hooks(f(g(h())))`.
+func (c *ExcusedAbsenceClient) Use(hooks ...Hook) {
+	c.hooks.ExcusedAbsence = append(c.hooks.ExcusedAbsence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `excusedabsence.Intercept(f(g(h())))`.
+func (c *ExcusedAbsenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExcusedAbsence = append(c.inters.ExcusedAbsence, interceptors...)
+}
+
+// Create returns a builder for creating a ExcusedAbsence entity.
+func (c *ExcusedAbsenceClient) Create() *ExcusedAbsenceCreate {
+	mutation := newExcusedAbsenceMutation(c.config, OpCreate)
+	return &ExcusedAbsenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExcusedAbsence entities.
+func (c *ExcusedAbsenceClient) CreateBulk(builders ...*ExcusedAbsenceCreate) *ExcusedAbsenceCreateBulk {
+	return &ExcusedAbsenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExcusedAbsenceClient) MapCreateBulk(slice any, setFunc func(*ExcusedAbsenceCreate, int)) *ExcusedAbsenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExcusedAbsenceCreateBulk{err: fmt.Errorf("calling to ExcusedAbsenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExcusedAbsenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExcusedAbsenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExcusedAbsence.
+func (c *ExcusedAbsenceClient) Update() *ExcusedAbsenceUpdate {
+	mutation := newExcusedAbsenceMutation(c.config, OpUpdate)
+	return &ExcusedAbsenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExcusedAbsenceClient) UpdateOne(_m *ExcusedAbsence) *ExcusedAbsenceUpdateOne {
+	mutation := newExcusedAbsenceMutation(c.config, OpUpdateOne, withExcusedAbsence(_m))
+	return &ExcusedAbsenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExcusedAbsenceClient) UpdateOneID(id int) *ExcusedAbsenceUpdateOne {
+	mutation := newExcusedAbsenceMutation(c.config, OpUpdateOne, withExcusedAbsenceID(id))
+	return &ExcusedAbsenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExcusedAbsence.
+func (c *ExcusedAbsenceClient) Delete() *ExcusedAbsenceDelete {
+	mutation := newExcusedAbsenceMutation(c.config, OpDelete)
+	return &ExcusedAbsenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExcusedAbsenceClient) DeleteOne(_m *ExcusedAbsence) *ExcusedAbsenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExcusedAbsenceClient) DeleteOneID(id int) *ExcusedAbsenceDeleteOne {
+	builder := c.Delete().Where(excusedabsence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExcusedAbsenceDeleteOne{builder}
+}
+
+// Query returns a query builder for ExcusedAbsence.
+func (c *ExcusedAbsenceClient) Query() *ExcusedAbsenceQuery {
+	return &ExcusedAbsenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExcusedAbsence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExcusedAbsence entity by its id.
+func (c *ExcusedAbsenceClient) Get(ctx context.Context, id int) (*ExcusedAbsence, error) {
+	return c.Query().Where(excusedabsence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExcusedAbsenceClient) GetX(ctx context.Context, id int) *ExcusedAbsence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a ExcusedAbsence.
+func (c *ExcusedAbsenceClient) QueryStudent(_m *ExcusedAbsence) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, excusedabsence.StudentTable, excusedabsence.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a ExcusedAbsence.
+func (c *ExcusedAbsenceClient) QueryCourse(_m *ExcusedAbsence) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, excusedabsence.CourseTable, excusedabsence.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoiceLines queries the invoice_lines edge of a ExcusedAbsence.
+func (c *ExcusedAbsenceClient) QueryInvoiceLines(_m *ExcusedAbsence) *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, id),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, excusedabsence.InvoiceLinesTable, excusedabsence.InvoiceLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExcusedAbsenceClient) Hooks() []Hook {
+	return c.hooks.ExcusedAbsence
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExcusedAbsenceClient) Interceptors() []Interceptor {
+	return c.inters.ExcusedAbsence
+}
+
+func (c *ExcusedAbsenceClient) mutate(ctx context.Context, m *ExcusedAbsenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExcusedAbsenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExcusedAbsenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExcusedAbsenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExcusedAbsenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExcusedAbsence mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id int) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id int) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id int) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id int) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a Invoice.
+func (c *InvoiceClient) QueryStudent(_m *Invoice) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.StudentTable, invoice.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLines queries the lines edge of a Invoice.
+func (c *InvoiceClient) QueryLines(_m *Invoice) *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LinesTable, invoice.LinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a Invoice.
+func (c *InvoiceClient) QueryPayments(_m *Invoice) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.PaymentsTable, invoice.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentPlans queries the payment_plans edge of a Invoice.
+func (c *InvoiceClient) QueryPaymentPlans(_m *Invoice) *PaymentPlanQuery {
+	query := (&PaymentPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(paymentplan.Table, paymentplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, invoice.PaymentPlansTable, invoice.PaymentPlansPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLateFees queries the late_fees edge of a Invoice.
+func (c *InvoiceClient) QueryLateFees(_m *Invoice) *LateFeeQuery {
+	query := (&LateFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(latefee.Table, latefee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LateFeesTable, invoice.LateFeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLessonPackages queries the lesson_packages edge of a Invoice.
+func (c *InvoiceClient) QueryLessonPackages(_m *Invoice) *LessonPackageQuery {
+	query := (&LessonPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LessonPackagesTable, invoice.LessonPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBillingTerm queries the billing_term edge of a Invoice.
+func (c *InvoiceClient) QueryBillingTerm(_m *Invoice) *BillingTermQuery {
+	query := (&BillingTermClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(billingterm.Table, billingterm.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.BillingTermTable, invoice.BillingTermColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceLineClient is a client for the InvoiceLine schema.
+type InvoiceLineClient struct {
+	config
+}
+
+// NewInvoiceLineClient returns a client for the InvoiceLine from the given config.
+func NewInvoiceLineClient(c config) *InvoiceLineClient {
+	return &InvoiceLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoiceline.Hooks(f(g(h())))`.
+func (c *InvoiceLineClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceLine = append(c.hooks.InvoiceLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoiceline.Intercept(f(g(h())))`.
+func (c *InvoiceLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceLine = append(c.inters.InvoiceLine, interceptors...)
+}
+
+// Create returns a builder for creating a InvoiceLine entity.
+func (c *InvoiceLineClient) Create() *InvoiceLineCreate {
+	mutation := newInvoiceLineMutation(c.config, OpCreate)
+	return &InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceLine entities.
+func (c *InvoiceLineClient) CreateBulk(builders ...*InvoiceLineCreate) *InvoiceLineCreateBulk {
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceLineClient) MapCreateBulk(slice any, setFunc func(*InvoiceLineCreate, int)) *InvoiceLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceLineCreateBulk{err: fmt.Errorf("calling to InvoiceLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceLine.
+func (c *InvoiceLineClient) Update() *InvoiceLineUpdate {
+	mutation := newInvoiceLineMutation(c.config, OpUpdate)
+	return &InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceLineClient) UpdateOne(_m *InvoiceLine) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLine(_m))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceLineClient) UpdateOneID(id int) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLineID(id))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceLine.
+func (c *InvoiceLineClient) Delete() *InvoiceLineDelete {
+	mutation := newInvoiceLineMutation(c.config, OpDelete)
+	return &InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceLineClient) DeleteOne(_m *InvoiceLine) *InvoiceLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceLineClient) DeleteOneID(id int) *InvoiceLineDeleteOne {
+	builder := c.Delete().Where(invoiceline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceLineDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceLine.
+func (c *InvoiceLineClient) Query() *InvoiceLineQuery {
+	return &InvoiceLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoiceLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoiceLine entity by its id.
+func (c *InvoiceLineClient) Get(ctx context.Context, id int) (*InvoiceLine, error) {
+	return c.Query().Where(invoiceline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceLineClient) GetX(ctx context.Context, id int) *InvoiceLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInvoice queries the invoice edge of a InvoiceLine.
+func (c *InvoiceLineClient) QueryInvoice(_m *InvoiceLine) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.InvoiceTable, invoiceline.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnrollment queries the enrollment edge of a InvoiceLine.
+func (c *InvoiceLineClient) QueryEnrollment(_m *InvoiceLine) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.EnrollmentTable, invoiceline.EnrollmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCharge queries the charge edge of a InvoiceLine.
+func (c *InvoiceLineClient) QueryCharge(_m *InvoiceLine) *StudentChargeQuery {
+	query := (&StudentChargeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.To(studentcharge.Table, studentcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.ChargeTable, invoiceline.ChargeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAbsence queries the absence edge of a InvoiceLine.
+func (c *InvoiceLineClient) QueryAbsence(_m *InvoiceLine) *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.AbsenceTable, invoiceline.AbsenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceLineClient) Hooks() []Hook {
+	return c.hooks.InvoiceLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceLineClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceLine
+}
+
+func (c *InvoiceLineClient) mutate(ctx context.Context, m *InvoiceLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvoiceLine mutation op: %q", m.Op())
+	}
+}
+
+// LateFeeClient is a client for the LateFee schema.
+type LateFeeClient struct {
+	config
+}
+
+// NewLateFeeClient returns a client for the LateFee from the given config.
+func NewLateFeeClient(c config) *LateFeeClient {
+	return &LateFeeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `latefee.Hooks(f(g(h())))`.
+func (c *LateFeeClient) Use(hooks ...Hook) {
+	c.hooks.LateFee = append(c.hooks.LateFee, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `latefee.Intercept(f(g(h())))`.
+func (c *LateFeeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LateFee = append(c.inters.LateFee, interceptors...)
+}
+
+// Create returns a builder for creating a LateFee entity.
+func (c *LateFeeClient) Create() *LateFeeCreate {
+	mutation := newLateFeeMutation(c.config, OpCreate)
+	return &LateFeeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LateFee entities.
+func (c *LateFeeClient) CreateBulk(builders ...*LateFeeCreate) *LateFeeCreateBulk {
+	return &LateFeeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LateFeeClient) MapCreateBulk(slice any, setFunc func(*LateFeeCreate, int)) *LateFeeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LateFeeCreateBulk{err: fmt.Errorf("calling to LateFeeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LateFeeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LateFeeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LateFee.
+func (c *LateFeeClient) Update() *LateFeeUpdate {
+	mutation := newLateFeeMutation(c.config, OpUpdate)
+	return &LateFeeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LateFeeClient) UpdateOne(_m *LateFee) *LateFeeUpdateOne {
+	mutation := newLateFeeMutation(c.config, OpUpdateOne, withLateFee(_m))
+	return &LateFeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LateFeeClient) UpdateOneID(id int) *LateFeeUpdateOne {
+	mutation := newLateFeeMutation(c.config, OpUpdateOne, withLateFeeID(id))
+	return &LateFeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LateFee.
+func (c *LateFeeClient) Delete() *LateFeeDelete {
+	mutation := newLateFeeMutation(c.config, OpDelete)
+	return &LateFeeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LateFeeClient) DeleteOne(_m *LateFee) *LateFeeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LateFeeClient) DeleteOneID(id int) *LateFeeDeleteOne {
+	builder := c.Delete().Where(latefee.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LateFeeDeleteOne{builder}
+}
+
+// Query returns a query builder for LateFee.
+func (c *LateFeeClient) Query() *LateFeeQuery {
+	return &LateFeeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLateFee},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LateFee entity by its id.
+func (c *LateFeeClient) Get(ctx context.Context, id int) (*LateFee, error) {
+	return c.Query().Where(latefee.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LateFeeClient) GetX(ctx context.Context, id int) *LateFee {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a LateFee.
+func (c *LateFeeClient) QueryStudent(_m *LateFee) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(latefee.Table, latefee.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, latefee.StudentTable, latefee.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoice queries the invoice edge of a LateFee.
+func (c *LateFeeClient) QueryInvoice(_m *LateFee) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(latefee.Table, latefee.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, latefee.InvoiceTable, latefee.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LateFeeClient) Hooks() []Hook {
+	return c.hooks.LateFee
+}
+
+// Interceptors returns the client interceptors.
+func (c *LateFeeClient) Interceptors() []Interceptor {
+	return c.inters.LateFee
+}
+
+func (c *LateFeeClient) mutate(ctx context.Context, m *LateFeeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LateFeeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LateFeeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LateFeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LateFeeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LateFee mutation op: %q", m.Op())
+	}
+}
+
+// LeadClient is a client for the Lead schema.
+type LeadClient struct {
+	config
+}
+
+// NewLeadClient returns a client for the Lead from the given config.
+func NewLeadClient(c config) *LeadClient {
+	return &LeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lead.Hooks(f(g(h())))`.
+func (c *LeadClient) Use(hooks ...Hook) {
+	c.hooks.Lead = append(c.hooks.Lead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lead.Intercept(f(g(h())))`.
+func (c *LeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lead = append(c.inters.Lead, interceptors...)
+}
+
+// Create returns a builder for creating a Lead entity.
+func (c *LeadClient) Create() *LeadCreate {
+	mutation := newLeadMutation(c.config, OpCreate)
+	return &LeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lead entities.
+func (c *LeadClient) CreateBulk(builders ...*LeadCreate) *LeadCreateBulk {
+	return &LeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadClient) MapCreateBulk(slice any, setFunc func(*LeadCreate, int)) *LeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadCreateBulk{err: fmt.Errorf("calling to LeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lead.
+func (c *LeadClient) Update() *LeadUpdate {
+	mutation := newLeadMutation(c.config, OpUpdate)
+	return &LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadClient) UpdateOne(_m *Lead) *LeadUpdateOne {
+	mutation := newLeadMutation(c.config, OpUpdateOne, withLead(_m))
+	return &LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadClient) UpdateOneID(id int) *LeadUpdateOne {
+	mutation := newLeadMutation(c.config, OpUpdateOne, withLeadID(id))
+	return &LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Lead.
+func (c *LeadClient) Delete() *LeadDelete {
+	mutation := newLeadMutation(c.config, OpDelete)
+	return &LeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadClient) DeleteOne(_m *Lead) *LeadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadClient) DeleteOneID(id int) *LeadDeleteOne {
+	builder := c.Delete().Where(lead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadDeleteOne{builder}
+}
+
+// Query returns a query builder for Lead.
+func (c *LeadClient) Query() *LeadQuery {
+	return &LeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Lead entity by its id.
+func (c *LeadClient) Get(ctx context.Context, id int) (*Lead, error) {
+	return c.Query().Where(lead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadClient) GetX(ctx context.Context, id int) *Lead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a Lead.
+func (c *LeadClient) QueryCourse(_m *Lead) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lead.CourseTable, lead.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudent queries the student edge of a Lead.
+func (c *LeadClient) QueryStudent(_m *Lead) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lead.StudentTable, lead.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadClient) Hooks() []Hook {
+	return c.hooks.Lead
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadClient) Interceptors() []Interceptor {
+	return c.inters.Lead
+}
+
+func (c *LeadClient) mutate(ctx context.Context, m *LeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lead mutation op: %q", m.Op())
+	}
+}
+
+// LessonPackageClient is a client for the LessonPackage schema.
+type LessonPackageClient struct {
+	config
+}
+
+// NewLessonPackageClient returns a client for the LessonPackage from the given config.
+func NewLessonPackageClient(c config) *LessonPackageClient {
+	return &LessonPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lessonpackage.Hooks(f(g(h())))`.
+func (c *LessonPackageClient) Use(hooks ...Hook) {
+	c.hooks.LessonPackage = append(c.hooks.LessonPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lessonpackage.Intercept(f(g(h())))`.
+func (c *LessonPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LessonPackage = append(c.inters.LessonPackage, interceptors...)
+}
+
+// Create returns a builder for creating a LessonPackage entity.
+func (c *LessonPackageClient) Create() *LessonPackageCreate {
+	mutation := newLessonPackageMutation(c.config, OpCreate)
+	return &LessonPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LessonPackage entities.
+func (c *LessonPackageClient) CreateBulk(builders ...*LessonPackageCreate) *LessonPackageCreateBulk {
+	return &LessonPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LessonPackageClient) MapCreateBulk(slice any, setFunc func(*LessonPackageCreate, int)) *LessonPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LessonPackageCreateBulk{err: fmt.Errorf("calling to LessonPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LessonPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LessonPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LessonPackage.
+func (c *LessonPackageClient) Update() *LessonPackageUpdate {
+	mutation := newLessonPackageMutation(c.config, OpUpdate)
+	return &LessonPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LessonPackageClient) UpdateOne(_m *LessonPackage) *LessonPackageUpdateOne {
+	mutation := newLessonPackageMutation(c.config, OpUpdateOne, withLessonPackage(_m))
+	return &LessonPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LessonPackageClient) UpdateOneID(id int) *LessonPackageUpdateOne {
+	mutation := newLessonPackageMutation(c.config, OpUpdateOne, withLessonPackageID(id))
+	return &LessonPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LessonPackage.
+func (c *LessonPackageClient) Delete() *LessonPackageDelete {
+	mutation := newLessonPackageMutation(c.config, OpDelete)
+	return &LessonPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LessonPackageClient) DeleteOne(_m *LessonPackage) *LessonPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LessonPackageClient) DeleteOneID(id int) *LessonPackageDeleteOne {
+	builder := c.Delete().Where(lessonpackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LessonPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for LessonPackage.
+func (c *LessonPackageClient) Query() *LessonPackageQuery {
+	return &LessonPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLessonPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LessonPackage entity by its id.
+func (c *LessonPackageClient) Get(ctx context.Context, id int) (*LessonPackage, error) {
+	return c.Query().Where(lessonpackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LessonPackageClient) GetX(ctx context.Context, id int) *LessonPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a LessonPackage.
+func (c *LessonPackageClient) QueryStudent(_m *LessonPackage) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lessonpackage.Table, lessonpackage.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lessonpackage.StudentTable, lessonpackage.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCourse queries the course edge of a LessonPackage.
+func (c *LessonPackageClient) QueryCourse(_m *LessonPackage) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lessonpackage.Table, lessonpackage.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lessonpackage.CourseTable, lessonpackage.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoice queries the invoice edge of a LessonPackage.
+func (c *LessonPackageClient) QueryInvoice(_m *LessonPackage) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lessonpackage.Table, lessonpackage.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lessonpackage.InvoiceTable, lessonpackage.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LessonPackageClient) Hooks() []Hook {
+	return c.hooks.LessonPackage
+}
+
+// Interceptors returns the client interceptors.
+func (c *LessonPackageClient) Interceptors() []Interceptor {
+	return c.inters.LessonPackage
+}
+
+func (c *LessonPackageClient) mutate(ctx context.Context, m *LessonPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LessonPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LessonPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LessonPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LessonPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LessonPackage mutation op: %q", m.Op())
+	}
+}
+
+// PaymentClient is a client for the Payment schema.
+type PaymentClient struct {
+	config
+}
+
+// NewPaymentClient returns a client for the Payment from the given config.
+func NewPaymentClient(c config) *PaymentClient {
+	return &PaymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
+func (c *PaymentClient) Use(hooks ...Hook) {
+	c.hooks.Payment = append(c.hooks.Payment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payment.Intercept(f(g(h())))`.
+func (c *PaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Payment = append(c.inters.Payment, interceptors...)
+}
+
+// Create returns a builder for creating a Payment entity.
+func (c *PaymentClient) Create() *PaymentCreate {
+	mutation := newPaymentMutation(c.config, OpCreate)
+	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Payment entities.
+func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
+	return &PaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentClient) MapCreateBulk(slice any, setFunc func(*PaymentCreate, int)) *PaymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentCreateBulk{err: fmt.Errorf("calling to PaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Payment.
+func (c *PaymentClient) Update() *PaymentUpdate {
+	mutation := newPaymentMutation(c.config, OpUpdate)
+	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentClient) UpdateOne(_m *Payment) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(_m))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentClient) UpdateOneID(id int) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Payment.
+func (c *PaymentClient) Delete() *PaymentDelete {
+	mutation := newPaymentMutation(c.config, OpDelete)
+	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentClient) DeleteOne(_m *Payment) *PaymentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentClient) DeleteOneID(id int) *PaymentDeleteOne {
+	builder := c.Delete().Where(payment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentDeleteOne{builder}
+}
+
+// Query returns a query builder for Payment.
+func (c *PaymentClient) Query() *PaymentQuery {
+	return &PaymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Payment entity by its id.
+func (c *PaymentClient) Get(ctx context.Context, id int) (*Payment, error) {
+	return c.Query().Where(payment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a Payment.
+func (c *PaymentClient) QueryStudent(_m *Payment) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.StudentTable, payment.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoice queries the invoice edge of a Payment.
+func (c *PaymentClient) QueryInvoice(_m *Payment) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.InvoiceTable, payment.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCashReceipt queries the cash_receipt edge of a Payment.
+func (c *PaymentClient) QueryCashReceipt(_m *Payment) *CashReceiptQuery {
+	query := (&CashReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(cashreceipt.Table, cashreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.CashReceiptTable, payment.CashReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentClient) Hooks() []Hook {
+	return c.hooks.Payment
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentClient) Interceptors() []Interceptor {
+	return c.inters.Payment
+}
+
+func (c *PaymentClient) mutate(ctx context.Context, m *PaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Payment mutation op: %q", m.Op())
+	}
+}
+
+// PaymentPlanClient is a client for the PaymentPlan schema.
+type PaymentPlanClient struct {
+	config
+}
+
+// NewPaymentPlanClient returns a client for the PaymentPlan from the given config.
+func NewPaymentPlanClient(c config) *PaymentPlanClient {
+	return &PaymentPlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentplan.Hooks(f(g(h())))`.
+func (c *PaymentPlanClient) Use(hooks ...Hook) {
+	c.hooks.PaymentPlan = append(c.hooks.PaymentPlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentplan.Intercept(f(g(h())))`.
+func (c *PaymentPlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentPlan = append(c.inters.PaymentPlan, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentPlan entity.
+func (c *PaymentPlanClient) Create() *PaymentPlanCreate {
+	mutation := newPaymentPlanMutation(c.config, OpCreate)
+	return &PaymentPlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentPlan entities.
+func (c *PaymentPlanClient) CreateBulk(builders ...*PaymentPlanCreate) *PaymentPlanCreateBulk {
+	return &PaymentPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentPlanClient) MapCreateBulk(slice any, setFunc func(*PaymentPlanCreate, int)) *PaymentPlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentPlanCreateBulk{err: fmt.Errorf("calling to PaymentPlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentPlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentPlan.
+func (c *PaymentPlanClient) Update() *PaymentPlanUpdate {
+	mutation := newPaymentPlanMutation(c.config, OpUpdate)
+	return &PaymentPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentPlanClient) UpdateOne(_m *PaymentPlan) *PaymentPlanUpdateOne {
+	mutation := newPaymentPlanMutation(c.config, OpUpdateOne, withPaymentPlan(_m))
+	return &PaymentPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentPlanClient) UpdateOneID(id int) *PaymentPlanUpdateOne {
+	mutation := newPaymentPlanMutation(c.config, OpUpdateOne, withPaymentPlanID(id))
+	return &PaymentPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentPlan.
+func (c *PaymentPlanClient) Delete() *PaymentPlanDelete {
+	mutation := newPaymentPlanMutation(c.config, OpDelete)
+	return &PaymentPlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentPlanClient) DeleteOne(_m *PaymentPlan) *PaymentPlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentPlanClient) DeleteOneID(id int) *PaymentPlanDeleteOne {
+	builder := c.Delete().Where(paymentplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentPlanDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentPlan.
+func (c *PaymentPlanClient) Query() *PaymentPlanQuery {
+	return &PaymentPlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentPlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentPlan entity by its id.
+func (c *PaymentPlanClient) Get(ctx context.Context, id int) (*PaymentPlan, error) {
+	return c.Query().Where(paymentplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentPlanClient) GetX(ctx context.Context, id int) *PaymentPlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStudent queries the student edge of a PaymentPlan.
+func (c *PaymentPlanClient) QueryStudent(_m *PaymentPlan) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentplan.Table, paymentplan.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentplan.StudentTable, paymentplan.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoices queries the invoices edge of a PaymentPlan.
+func (c *PaymentPlanClient) QueryInvoices(_m *PaymentPlan) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentplan.Table, paymentplan.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, paymentplan.InvoicesTable, paymentplan.InvoicesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInstalments queries the instalments edge of a PaymentPlan.
+func (c *PaymentPlanClient) QueryInstalments(_m *PaymentPlan) *PaymentPlanInstalmentQuery {
+	query := (&PaymentPlanInstalmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentplan.Table, paymentplan.FieldID, id),
+			sqlgraph.To(paymentplaninstalment.Table, paymentplaninstalment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentplan.InstalmentsTable, paymentplan.InstalmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentPlanClient) Hooks() []Hook {
+	return c.hooks.PaymentPlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentPlanClient) Interceptors() []Interceptor {
+	return c.inters.PaymentPlan
+}
+
+func (c *PaymentPlanClient) mutate(ctx context.Context, m *PaymentPlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentPlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentPlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentPlan mutation op: %q", m.Op())
+	}
+}
+
+// PaymentPlanInstalmentClient is a client for the PaymentPlanInstalment schema.
+type PaymentPlanInstalmentClient struct {
+	config
+}
+
+// NewPaymentPlanInstalmentClient returns a client for the PaymentPlanInstalment from the given config.
+func NewPaymentPlanInstalmentClient(c config) *PaymentPlanInstalmentClient {
+	return &PaymentPlanInstalmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentplaninstalment.Hooks(f(g(h())))`.
+func (c *PaymentPlanInstalmentClient) Use(hooks ...Hook) {
+	c.hooks.PaymentPlanInstalment = append(c.hooks.PaymentPlanInstalment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentplaninstalment.Intercept(f(g(h())))`.
+func (c *PaymentPlanInstalmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentPlanInstalment = append(c.inters.PaymentPlanInstalment, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentPlanInstalment entity.
+func (c *PaymentPlanInstalmentClient) Create() *PaymentPlanInstalmentCreate {
+	mutation := newPaymentPlanInstalmentMutation(c.config, OpCreate)
+	return &PaymentPlanInstalmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentPlanInstalment entities.
+func (c *PaymentPlanInstalmentClient) CreateBulk(builders ...*PaymentPlanInstalmentCreate) *PaymentPlanInstalmentCreateBulk {
+	return &PaymentPlanInstalmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentPlanInstalmentClient) MapCreateBulk(slice any, setFunc func(*PaymentPlanInstalmentCreate, int)) *PaymentPlanInstalmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentPlanInstalmentCreateBulk{err: fmt.Errorf("calling to PaymentPlanInstalmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentPlanInstalmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentPlanInstalmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentPlanInstalment.
+func (c *PaymentPlanInstalmentClient) Update() *PaymentPlanInstalmentUpdate {
+	mutation := newPaymentPlanInstalmentMutation(c.config, OpUpdate)
+	return &PaymentPlanInstalmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentPlanInstalmentClient) UpdateOne(_m *PaymentPlanInstalment) *PaymentPlanInstalmentUpdateOne {
+	mutation := newPaymentPlanInstalmentMutation(c.config, OpUpdateOne, withPaymentPlanInstalment(_m))
+	return &PaymentPlanInstalmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentPlanInstalmentClient) UpdateOneID(id int) *PaymentPlanInstalmentUpdateOne {
+	mutation := newPaymentPlanInstalmentMutation(c.config, OpUpdateOne, withPaymentPlanInstalmentID(id))
+	return &PaymentPlanInstalmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentPlanInstalment.
+func (c *PaymentPlanInstalmentClient) Delete() *PaymentPlanInstalmentDelete {
+	mutation := newPaymentPlanInstalmentMutation(c.config, OpDelete)
+	return &PaymentPlanInstalmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentPlanInstalmentClient) DeleteOne(_m *PaymentPlanInstalment) *PaymentPlanInstalmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentPlanInstalmentClient) DeleteOneID(id int) *PaymentPlanInstalmentDeleteOne {
+	builder := c.Delete().Where(paymentplaninstalment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentPlanInstalmentDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentPlanInstalment.
+func (c *PaymentPlanInstalmentClient) Query() *PaymentPlanInstalmentQuery {
+	return &PaymentPlanInstalmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentPlanInstalment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentPlanInstalment entity by its id.
+func (c *PaymentPlanInstalmentClient) Get(ctx context.Context, id int) (*PaymentPlanInstalment, error) {
+	return c.Query().Where(paymentplaninstalment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentPlanInstalmentClient) GetX(ctx context.Context, id int) *PaymentPlanInstalment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a PaymentPlanInstalment.
+func (c *PaymentPlanInstalmentClient) QueryPlan(_m *PaymentPlanInstalment) *PaymentPlanQuery {
+	query := (&PaymentPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentplaninstalment.Table, paymentplaninstalment.FieldID, id),
+			sqlgraph.To(paymentplan.Table, paymentplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentplaninstalment.PlanTable, paymentplaninstalment.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentPlanInstalmentClient) Hooks() []Hook {
+	return c.hooks.PaymentPlanInstalment
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentPlanInstalmentClient) Interceptors() []Interceptor {
+	return c.inters.PaymentPlanInstalment
+}
+
+func (c *PaymentPlanInstalmentClient) mutate(ctx context.Context, m *PaymentPlanInstalmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentPlanInstalmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentPlanInstalmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentPlanInstalmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentPlanInstalmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentPlanInstalment mutation op: %q", m.Op())
+	}
+}
+
+// RoomClient is a client for the Room schema.
+type RoomClient struct {
+	config
+}
+
+// NewRoomClient returns a client for the Room from the given config.
+func NewRoomClient(c config) *RoomClient {
+	return &RoomClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
+func (c *RoomClient) Use(hooks ...Hook) {
+	c.hooks.Room = append(c.hooks.Room, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
+func (c *RoomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Room = append(c.inters.Room, interceptors...)
+}
+
+// Create returns a builder for creating a Room entity.
+func (c *RoomClient) Create() *RoomCreate {
+	mutation := newRoomMutation(c.config, OpCreate)
+	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Room entities.
+func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomClient) MapCreateBulk(slice any, setFunc func(*RoomCreate, int)) *RoomCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomCreateBulk{err: fmt.Errorf("calling to RoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Room.
+func (c *RoomClient) Update() *RoomUpdate {
+	mutation := newRoomMutation(c.config, OpUpdate)
+	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomClient) UpdateOne(_m *Room) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(_m))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Room.
+func (c *RoomClient) Delete() *RoomDelete {
+	mutation := newRoomMutation(c.config, OpDelete)
+	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomClient) DeleteOne(_m *Room) *RoomDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
+	builder := c.Delete().Where(room.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomDeleteOne{builder}
+}
+
+// Query returns a query builder for Room.
+func (c *RoomClient) Query() *RoomQuery {
+	return &RoomQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoom},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Room entity by its id.
+func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
+	return c.Query().Where(room.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryScheduleSlots queries the schedule_slots edge of a Room.
+func (c *RoomClient) QueryScheduleSlots(_m *Room) *ScheduleSlotQuery {
+	query := (&ScheduleSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(scheduleslot.Table, scheduleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, room.ScheduleSlotsTable, room.ScheduleSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoomClient) Hooks() []Hook {
+	return c.hooks.Room
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomClient) Interceptors() []Interceptor {
+	return c.inters.Room
+}
+
+func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+	}
+}
+
+// ScheduleSlotClient is a client for the ScheduleSlot schema.
+type ScheduleSlotClient struct {
+	config
+}
+
+// NewScheduleSlotClient returns a client for the ScheduleSlot from the given config.
+func NewScheduleSlotClient(c config) *ScheduleSlotClient {
+	return &ScheduleSlotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduleslot.Hooks(f(g(h())))`.
+func (c *ScheduleSlotClient) Use(hooks ...Hook) {
+	c.hooks.ScheduleSlot = append(c.hooks.ScheduleSlot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduleslot.Intercept(f(g(h())))`.
+func (c *ScheduleSlotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduleSlot = append(c.inters.ScheduleSlot, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduleSlot entity.
+func (c *ScheduleSlotClient) Create() *ScheduleSlotCreate {
+	mutation := newScheduleSlotMutation(c.config, OpCreate)
+	return &ScheduleSlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduleSlot entities.
+func (c *ScheduleSlotClient) CreateBulk(builders ...*ScheduleSlotCreate) *ScheduleSlotCreateBulk {
+	return &ScheduleSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduleSlotClient) MapCreateBulk(slice any, setFunc func(*ScheduleSlotCreate, int)) *ScheduleSlotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduleSlotCreateBulk{err: fmt.Errorf("calling to ScheduleSlotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduleSlotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduleSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduleSlot.
+func (c *ScheduleSlotClient) Update() *ScheduleSlotUpdate {
+	mutation := newScheduleSlotMutation(c.config, OpUpdate)
+	return &ScheduleSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduleSlotClient) UpdateOne(_m *ScheduleSlot) *ScheduleSlotUpdateOne {
+	mutation := newScheduleSlotMutation(c.config, OpUpdateOne, withScheduleSlot(_m))
+	return &ScheduleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduleSlotClient) UpdateOneID(id int) *ScheduleSlotUpdateOne {
+	mutation := newScheduleSlotMutation(c.config, OpUpdateOne, withScheduleSlotID(id))
+	return &ScheduleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduleSlot.
+func (c *ScheduleSlotClient) Delete() *ScheduleSlotDelete {
+	mutation := newScheduleSlotMutation(c.config, OpDelete)
+	return &ScheduleSlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduleSlotClient) DeleteOne(_m *ScheduleSlot) *ScheduleSlotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduleSlotClient) DeleteOneID(id int) *ScheduleSlotDeleteOne {
+	builder := c.Delete().Where(scheduleslot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduleSlotDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduleSlot.
+func (c *ScheduleSlotClient) Query() *ScheduleSlotQuery {
+	return &ScheduleSlotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduleSlot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduleSlot entity by its id.
+func (c *ScheduleSlotClient) Get(ctx context.Context, id int) (*ScheduleSlot, error) {
+	return c.Query().Where(scheduleslot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduleSlotClient) GetX(ctx context.Context, id int) *ScheduleSlot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a ScheduleSlot.
+func (c *ScheduleSlotClient) QueryCourse(_m *ScheduleSlot) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduleslot.Table, scheduleslot.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduleslot.CourseTable, scheduleslot.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a ScheduleSlot.
+func (c *ScheduleSlotClient) QueryRoom(_m *ScheduleSlot) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduleslot.Table, scheduleslot.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduleslot.RoomTable, scheduleslot.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeacher queries the teacher edge of a ScheduleSlot.
+func (c *ScheduleSlotClient) QueryTeacher(_m *ScheduleSlot) *TeacherQuery {
+	query := (&TeacherClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduleslot.Table, scheduleslot.FieldID, id),
+			sqlgraph.To(teacher.Table, teacher.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduleslot.TeacherTable, scheduleslot.TeacherColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduleSlotClient) Hooks() []Hook {
+	return c.hooks.ScheduleSlot
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduleSlotClient) Interceptors() []Interceptor {
+	return c.inters.ScheduleSlot
+}
+
+func (c *ScheduleSlotClient) mutate(ctx context.Context, m *ScheduleSlotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduleSlotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduleSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduleSlotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScheduleSlot mutation op: %q", m.Op())
+	}
+}
+
+// SettingsClient is a client for the Settings schema.
+type SettingsClient struct {
+	config
+}
+
+// NewSettingsClient returns a client for the Settings from the given config.
+func NewSettingsClient(c config) *SettingsClient {
+	return &SettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
+func (c *SettingsClient) Use(hooks ...Hook) {
+	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
+func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settings = append(c.inters.Settings, interceptors...)
+}
+
+// Create returns a builder for creating a Settings entity.
+func (c *SettingsClient) Create() *SettingsCreate {
+	mutation := newSettingsMutation(c.config, OpCreate)
+	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settings entities.
+func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settings.
+func (c *SettingsClient) Update() *SettingsUpdate {
+	mutation := newSettingsMutation(c.config, OpUpdate)
+	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingsClient) UpdateOne(_m *Settings) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(_m))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settings.
+func (c *SettingsClient) Delete() *SettingsDelete {
+	mutation := newSettingsMutation(c.config, OpDelete)
+	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingsClient) DeleteOne(_m *Settings) *SettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
+	builder := c.Delete().Where(settings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for Settings.
+func (c *SettingsClient) Query() *SettingsQuery {
+	return &SettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Settings entity by its id.
+func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
+	return c.Query().Where(settings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingsClient) Hooks() []Hook {
+	return c.hooks.Settings
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingsClient) Interceptors() []Interceptor {
+	return c.inters.Settings
+}
+
+func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+	}
+}
+
+// StudentClient is a client for the Student schema.
+type StudentClient struct {
+	config
+}
+
+// NewStudentClient returns a client for the Student from the given config.
+func NewStudentClient(c config) *StudentClient {
+	return &StudentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `student.Hooks(f(g(h())))`.
+func (c *StudentClient) Use(hooks ...Hook) {
+	c.hooks.Student = append(c.hooks.Student, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `student.Intercept(f(g(h())))`.
+func (c *StudentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Student = append(c.inters.Student, interceptors...)
+}
+
+// Create returns a builder for creating a Student entity.
+func (c *StudentClient) Create() *StudentCreate {
+	mutation := newStudentMutation(c.config, OpCreate)
+	return &StudentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Student entities.
+func (c *StudentClient) CreateBulk(builders ...*StudentCreate) *StudentCreateBulk {
+	return &StudentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StudentClient) MapCreateBulk(slice any, setFunc func(*StudentCreate, int)) *StudentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StudentCreateBulk{err: fmt.Errorf("calling to StudentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StudentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StudentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Student.
+func (c *StudentClient) Update() *StudentUpdate {
+	mutation := newStudentMutation(c.config, OpUpdate)
+	return &StudentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StudentClient) UpdateOne(_m *Student) *StudentUpdateOne {
+	mutation := newStudentMutation(c.config, OpUpdateOne, withStudent(_m))
+	return &StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StudentClient) UpdateOneID(id int) *StudentUpdateOne {
+	mutation := newStudentMutation(c.config, OpUpdateOne, withStudentID(id))
+	return &StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Student.
+func (c *StudentClient) Delete() *StudentDelete {
+	mutation := newStudentMutation(c.config, OpDelete)
+	return &StudentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StudentClient) DeleteOne(_m *Student) *StudentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StudentClient) DeleteOneID(id int) *StudentDeleteOne {
+	builder := c.Delete().Where(student.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StudentDeleteOne{builder}
+}
+
+// Query returns a query builder for Student.
+func (c *StudentClient) Query() *StudentQuery {
+	return &StudentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStudent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Student entity by its id.
+func (c *StudentClient) Get(ctx context.Context, id int) (*Student, error) {
+	return c.Query().Where(student.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StudentClient) GetX(ctx context.Context, id int) *Student {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnrollments queries the enrollments edge of a Student.
+func (c *StudentClient) QueryEnrollments(_m *Student) *EnrollmentQuery {
+	query := (&EnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(enrollment.Table, enrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.EnrollmentsTable, student.EnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoices queries the invoices edge of a Student.
+func (c *StudentClient) QueryInvoices(_m *Student) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.InvoicesTable, student.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a Student.
+func (c *StudentClient) QueryPayments(_m *Student) *PaymentQuery {
+	query := (&PaymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.PaymentsTable, student.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCashReceipts queries the cash_receipts edge of a Student.
+func (c *StudentClient) QueryCashReceipts(_m *Student) *CashReceiptQuery {
+	query := (&CashReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(cashreceipt.Table, cashreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.CashReceiptsTable, student.CashReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentPlans queries the payment_plans edge of a Student.
+func (c *StudentClient) QueryPaymentPlans(_m *Student) *PaymentPlanQuery {
+	query := (&PaymentPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(paymentplan.Table, paymentplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.PaymentPlansTable, student.PaymentPlansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLateFees queries the late_fees edge of a Student.
+func (c *StudentClient) QueryLateFees(_m *Student) *LateFeeQuery {
+	query := (&LateFeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(latefee.Table, latefee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LateFeesTable, student.LateFeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCharges queries the charges edge of a Student.
+func (c *StudentClient) QueryCharges(_m *Student) *StudentChargeQuery {
+	query := (&StudentChargeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(studentcharge.Table, studentcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.ChargesTable, student.ChargesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLessonPackages queries the lesson_packages edge of a Student.
+func (c *StudentClient) QueryLessonPackages(_m *Student) *LessonPackageQuery {
+	query := (&LessonPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LessonPackagesTable, student.LessonPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExcusedAbsences queries the excused_absences edge of a Student.
+func (c *StudentClient) QueryExcusedAbsences(_m *Student) *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.ExcusedAbsencesTable, student.ExcusedAbsencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries queries the waitlist_entries edge of a Student.
+func (c *StudentClient) QueryWaitlistEntries(_m *Student) *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.WaitlistEntriesTable, student.WaitlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryLeads queries the leads edge of a Student.
+func (c *StudentClient) QueryLeads(_m *Student) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LeadsTable, student.LeadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SettingsClient) Hooks() []Hook {
-	return c.hooks.Settings
+func (c *StudentClient) Hooks() []Hook {
+	return c.hooks.Student
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettingsClient) Interceptors() []Interceptor {
-	return c.inters.Settings
+func (c *StudentClient) Interceptors() []Interceptor {
+	return c.inters.Student
 }
 
-func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+func (c *StudentClient) mutate(ctx context.Context, m *StudentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StudentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Student mutation op: %q", m.Op())
 	}
 }
 
-// StudentClient is a client for the Student schema.
-type StudentClient struct {
+// StudentChargeClient is a client for the StudentCharge schema.
+type StudentChargeClient struct {
 	config
 }
 
-// NewStudentClient returns a client for the Student from the given config.
-func NewStudentClient(c config) *StudentClient {
-	return &StudentClient{config: c}
+// NewStudentChargeClient returns a client for the StudentCharge from the given config.
+func NewStudentChargeClient(c config) *StudentChargeClient {
+	return &StudentChargeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `student.Hooks(f(g(h())))`.
-func (c *StudentClient) Use(hooks ...Hook) {
-	c.hooks.Student = append(c.hooks.Student, hooks...)
+// A call to `Use(f, g, h)` equals to `studentcharge.Hooks(f(g(h())))`.
+func (c *StudentChargeClient) Use(hooks ...Hook) {
+	c.hooks.StudentCharge = append(c.hooks.StudentCharge, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `student.Intercept(f(g(h())))`.
-func (c *StudentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Student = append(c.inters.Student, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `studentcharge.Intercept(f(g(h())))`.
+func (c *StudentChargeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StudentCharge = append(c.inters.StudentCharge, interceptors...)
 }
 
-// Create returns a builder for creating a Student entity.
-func (c *StudentClient) Create() *StudentCreate {
-	mutation := newStudentMutation(c.config, OpCreate)
-	return &StudentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StudentCharge entity.
+func (c *StudentChargeClient) Create() *StudentChargeCreate {
+	mutation := newStudentChargeMutation(c.config, OpCreate)
+	return &StudentChargeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Student entities.
-func (c *StudentClient) CreateBulk(builders ...*StudentCreate) *StudentCreateBulk {
-	return &StudentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StudentCharge entities.
+func (c *StudentChargeClient) CreateBulk(builders ...*StudentChargeCreate) *StudentChargeCreateBulk {
+	return &StudentChargeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *StudentClient) MapCreateBulk(slice any, setFunc func(*StudentCreate, int)) *StudentCreateBulk {
+func (c *StudentChargeClient) MapCreateBulk(slice any, setFunc func(*StudentChargeCreate, int)) *StudentChargeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &StudentCreateBulk{err: fmt.Errorf("calling to StudentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StudentChargeCreateBulk{err: fmt.Errorf("calling to StudentChargeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*StudentCreate, rv.Len())
+	builders := make([]*StudentChargeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &StudentCreateBulk{config: c.config, builders: builders}
+	return &StudentChargeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Student.
-func (c *StudentClient) Update() *StudentUpdate {
-	mutation := newStudentMutation(c.config, OpUpdate)
-	return &StudentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StudentCharge.
+func (c *StudentChargeClient) Update() *StudentChargeUpdate {
+	mutation := newStudentChargeMutation(c.config, OpUpdate)
+	return &StudentChargeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *StudentClient) UpdateOne(_m *Student) *StudentUpdateOne {
-	mutation := newStudentMutation(c.config, OpUpdateOne, withStudent(_m))
-	return &StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StudentChargeClient) UpdateOne(_m *StudentCharge) *StudentChargeUpdateOne {
+	mutation := newStudentChargeMutation(c.config, OpUpdateOne, withStudentCharge(_m))
+	return &StudentChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StudentClient) UpdateOneID(id int) *StudentUpdateOne {
-	mutation := newStudentMutation(c.config, OpUpdateOne, withStudentID(id))
-	return &StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StudentChargeClient) UpdateOneID(id int) *StudentChargeUpdateOne {
+	mutation := newStudentChargeMutation(c.config, OpUpdateOne, withStudentChargeID(id))
+	return &StudentChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Student.
-func (c *StudentClient) Delete() *StudentDelete {
-	mutation := newStudentMutation(c.config, OpDelete)
-	return &StudentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StudentCharge.
+func (c *StudentChargeClient) Delete() *StudentChargeDelete {
+	mutation := newStudentChargeMutation(c.config, OpDelete)
+	return &StudentChargeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StudentClient) DeleteOne(_m *Student) *StudentDeleteOne {
+func (c *StudentChargeClient) DeleteOne(_m *StudentCharge) *StudentChargeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StudentClient) DeleteOneID(id int) *StudentDeleteOne {
-	builder := c.Delete().Where(student.ID(id))
+func (c *StudentChargeClient) DeleteOneID(id int) *StudentChargeDeleteOne {
+	builder := c.Delete().Where(studentcharge.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StudentDeleteOne{builder}
+	return &StudentChargeDeleteOne{builder}
 }
 
-// Query returns a query builder for Student.
-func (c *StudentClient) Query() *StudentQuery {
-	return &StudentQuery{
+// Query returns a query builder for StudentCharge.
+func (c *StudentChargeClient) Query() *StudentChargeQuery {
+	return &StudentChargeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStudent},
+		ctx:    &QueryContext{Type: TypeStudentCharge},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Student entity by its id.
-func (c *StudentClient) Get(ctx context.Context, id int) (*Student, error) {
-	return c.Query().Where(student.ID(id)).Only(ctx)
+// Get returns a StudentCharge entity by its id.
+func (c *StudentChargeClient) Get(ctx context.Context, id int) (*StudentCharge, error) {
+	return c.Query().Where(studentcharge.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StudentClient) GetX(ctx context.Context, id int) *Student {
+func (c *StudentChargeClient) GetX(ctx context.Context, id int) *StudentCharge {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1851,31 +5158,15 @@ func (c *StudentClient) GetX(ctx context.Context, id int) *Student {
 	return obj
 }
 
-// QueryEnrollments queries the enrollments edge of a Student.
-func (c *StudentClient) QueryEnrollments(_m *Student) *EnrollmentQuery {
-	query := (&EnrollmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(student.Table, student.FieldID, id),
-			sqlgraph.To(enrollment.Table, enrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, student.EnrollmentsTable, student.EnrollmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInvoices queries the invoices edge of a Student.
-func (c *StudentClient) QueryInvoices(_m *Student) *InvoiceQuery {
-	query := (&InvoiceClient{config: c.config}).Query()
+// QueryStudent queries the student edge of a StudentCharge.
+func (c *StudentChargeClient) QueryStudent(_m *StudentCharge) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(student.Table, student.FieldID, id),
-			sqlgraph.To(invoice.Table, invoice.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, student.InvoicesTable, student.InvoicesColumn),
+			sqlgraph.From(studentcharge.Table, studentcharge.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studentcharge.StudentTable, studentcharge.StudentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1883,15 +5174,15 @@ func (c *StudentClient) QueryInvoices(_m *Student) *InvoiceQuery {
 	return query
 }
 
-// QueryPayments queries the payments edge of a Student.
-func (c *StudentClient) QueryPayments(_m *Student) *PaymentQuery {
-	query := (&PaymentClient{config: c.config}).Query()
+// QueryInvoiceLines queries the invoice_lines edge of a StudentCharge.
+func (c *StudentChargeClient) QueryInvoiceLines(_m *StudentCharge) *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(student.Table, student.FieldID, id),
-			sqlgraph.To(payment.Table, payment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, student.PaymentsTable, student.PaymentsColumn),
+			sqlgraph.From(studentcharge.Table, studentcharge.FieldID, id),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studentcharge.InvoiceLinesTable, studentcharge.InvoiceLinesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1900,27 +5191,27 @@ func (c *StudentClient) QueryPayments(_m *Student) *PaymentQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *StudentClient) Hooks() []Hook {
-	return c.hooks.Student
+func (c *StudentChargeClient) Hooks() []Hook {
+	return c.hooks.StudentCharge
 }
 
 // Interceptors returns the client interceptors.
-func (c *StudentClient) Interceptors() []Interceptor {
-	return c.inters.Student
+func (c *StudentChargeClient) Interceptors() []Interceptor {
+	return c.inters.StudentCharge
 }
 
-func (c *StudentClient) mutate(ctx context.Context, m *StudentMutation) (Value, error) {
+func (c *StudentChargeClient) mutate(ctx context.Context, m *StudentChargeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&StudentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentChargeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StudentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentChargeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudentChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StudentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StudentChargeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Student mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StudentCharge mutation op: %q", m.Op())
 	}
 }
 
@@ -2048,6 +5339,22 @@ func (c *TeacherClient) QueryCourses(_m *Teacher) *CourseQuery {
 	return query
 }
 
+// QueryScheduleSlots queries the schedule_slots edge of a Teacher.
+func (c *TeacherClient) QueryScheduleSlots(_m *Teacher) *ScheduleSlotQuery {
+	query := (&ScheduleSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teacher.Table, teacher.FieldID, id),
+			sqlgraph.To(scheduleslot.Table, scheduleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, teacher.ScheduleSlotsTable, teacher.ScheduleSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TeacherClient) Hooks() []Hook {
 	return c.hooks.Teacher
@@ -2238,6 +5545,171 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WaitlistEntryClient is a client for the WaitlistEntry schema.
+type WaitlistEntryClient struct {
+	config
+}
+
+// NewWaitlistEntryClient returns a client for the WaitlistEntry from the given config.
+func NewWaitlistEntryClient(c config) *WaitlistEntryClient {
+	return &WaitlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistentry.Hooks(f(g(h())))`.
+func (c *WaitlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistEntry = append(c.hooks.WaitlistEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistentry.Intercept(f(g(h())))`.
+func (c *WaitlistEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistEntry = append(c.inters.WaitlistEntry, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistEntry entity.
+func (c *WaitlistEntryClient) Create() *WaitlistEntryCreate {
+	mutation := newWaitlistEntryMutation(c.config, OpCreate)
+	return &WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistEntry entities.
+func (c *WaitlistEntryClient) CreateBulk(builders ...*WaitlistEntryCreate) *WaitlistEntryCreateBulk {
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistEntryClient) MapCreateBulk(slice any, setFunc func(*WaitlistEntryCreate, int)) *WaitlistEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistEntryCreateBulk{err: fmt.Errorf("calling to WaitlistEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Update() *WaitlistEntryUpdate {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdate)
+	return &WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistEntryClient) UpdateOne(_m *WaitlistEntry) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntry(_m))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistEntryClient) UpdateOneID(id int) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntryID(id))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Delete() *WaitlistEntryDelete {
+	mutation := newWaitlistEntryMutation(c.config, OpDelete)
+	return &WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistEntryClient) DeleteOne(_m *WaitlistEntry) *WaitlistEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistEntryClient) DeleteOneID(id int) *WaitlistEntryDeleteOne {
+	builder := c.Delete().Where(waitlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Query() *WaitlistEntryQuery {
+	return &WaitlistEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistEntry entity by its id.
+func (c *WaitlistEntryClient) Get(ctx context.Context, id int) (*WaitlistEntry, error) {
+	return c.Query().Where(waitlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistEntryClient) GetX(ctx context.Context, id int) *WaitlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCourse queries the course edge of a WaitlistEntry.
+func (c *WaitlistEntryClient) QueryCourse(_m *WaitlistEntry) *CourseQuery {
+	query := (&CourseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, id),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.CourseTable, waitlistentry.CourseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudent queries the student edge of a WaitlistEntry.
+func (c *WaitlistEntryClient) QueryStudent(_m *WaitlistEntry) *StudentQuery {
+	query := (&StudentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, id),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.StudentTable, waitlistentry.StudentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistEntryClient) Hooks() []Hook {
+	return c.hooks.WaitlistEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistEntryClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistEntry
+}
+
+func (c *WaitlistEntryClient) mutate(ctx context.Context, m *WaitlistEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WaitlistEntry mutation op: %q", m.Op())
+	}
+}
+
 // WebSessionClient is a client for the WebSession schema.
 type WebSessionClient struct {
 	config
@@ -2390,12 +5862,19 @@ func (c *WebSessionClient) mutate(ctx context.Context, m *WebSessionMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AttendanceMonth, AuditLog, Course, CourseMonthStat, Enrollment, Invoice,
-		InvoiceLine, Payment, Settings, Student, Teacher, User, WebSession []ent.Hook
+		AttendanceMonth, AuditLog, BillingTerm, CashMovement, CashReceipt, CashSession,
+		Course, CourseMonthStat, CoursePrice, Enrollment, EnrollmentPause,
+		EnrollmentPrice, ExcusedAbsence, IdempotencyKey, Invoice, InvoiceLine, LateFee,
+		Lead, LessonPackage, Payment, PaymentPlan, PaymentPlanInstalment, Room,
+		ScheduleSlot, Settings, Student, StudentCharge, Teacher, User, WaitlistEntry,
+		WebSession []ent.Hook
 	}
 	inters struct {
-		AttendanceMonth, AuditLog, Course, CourseMonthStat, Enrollment, Invoice,
-		InvoiceLine, Payment, Settings, Student, Teacher, User,
+		AttendanceMonth, AuditLog, BillingTerm, CashMovement, CashReceipt, CashSession,
+		Course, CourseMonthStat, CoursePrice, Enrollment, EnrollmentPause,
+		EnrollmentPrice, ExcusedAbsence, IdempotencyKey, Invoice, InvoiceLine, LateFee,
+		Lead, LessonPackage, Payment, PaymentPlan, PaymentPlanInstalment, Room,
+		ScheduleSlot, Settings, Student, StudentCharge, Teacher, User, WaitlistEntry,
 		WebSession []ent.Interceptor
 	}
 )
@@ -35,8 +35,16 @@ type Course struct {
 	LessonPriceCents int64 `json:"lesson_price_cents,omitempty"`
 	// SubscriptionPriceCents holds the value of the "subscription_price_cents" field.
 	SubscriptionPriceCents int64 `json:"subscription_price_cents,omitempty"`
+	// VatRatePct holds the value of the "vat_rate_pct" field.
+	VatRatePct float64 `json:"vat_rate_pct,omitempty"`
+	// VatExemptNote holds the value of the "vat_exempt_note" field.
+	VatExemptNote string `json:"vat_exempt_note,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// BillingPeriod holds the value of the "billing_period" field.
+	BillingPeriod course.BillingPeriod `json:"billing_period,omitempty"`
+	// MaxStudents holds the value of the "max_students" field.
+	MaxStudents int `json:"max_students,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CourseQuery when eager-loading is set.
 	Edges        CourseEdges `json:"edges"`
@@ -51,9 +59,23 @@ type CourseEdges struct {
 	Enrollments []*Enrollment `json:"enrollments,omitempty"`
 	// MonthStats holds the value of the month_stats edge.
 	MonthStats []*CourseMonthStat `json:"month_stats,omitempty"`
+	// LessonPackages holds the value of the lesson_packages edge.
+	LessonPackages []*LessonPackage `json:"lesson_packages,omitempty"`
+	// Prices holds the value of the prices edge.
+	Prices []*CoursePrice `json:"prices,omitempty"`
+	// BillingTerms holds the value of the billing_terms edge.
+	BillingTerms []*BillingTerm `json:"billing_terms,omitempty"`
+	// ExcusedAbsences holds the value of the excused_absences edge.
+	ExcusedAbsences []*ExcusedAbsence `json:"excused_absences,omitempty"`
+	// WaitlistEntries holds the value of the waitlist_entries edge.
+	WaitlistEntries []*WaitlistEntry `json:"waitlist_entries,omitempty"`
+	// Leads holds the value of the leads edge.
+	Leads []*Lead `json:"leads,omitempty"`
+	// ScheduleSlots holds the value of the schedule_slots edge.
+	ScheduleSlots []*ScheduleSlot `json:"schedule_slots,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // TeacherOrErr returns the Teacher value or an error if the edge
@@ -85,6 +107,69 @@ func (e CourseEdges) MonthStatsOrErr() ([]*CourseMonthStat, error) {
 	return nil, &NotLoadedError{edge: "month_stats"}
 }
 
+// LessonPackagesOrErr returns the LessonPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) LessonPackagesOrErr() ([]*LessonPackage, error) {
+	if e.loadedTypes[3] {
+		return e.LessonPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "lesson_packages"}
+}
+
+// PricesOrErr returns the Prices value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) PricesOrErr() ([]*CoursePrice, error) {
+	if e.loadedTypes[4] {
+		return e.Prices, nil
+	}
+	return nil, &NotLoadedError{edge: "prices"}
+}
+
+// BillingTermsOrErr returns the BillingTerms value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) BillingTermsOrErr() ([]*BillingTerm, error) {
+	if e.loadedTypes[5] {
+		return e.BillingTerms, nil
+	}
+	return nil, &NotLoadedError{edge: "billing_terms"}
+}
+
+// ExcusedAbsencesOrErr returns the ExcusedAbsences value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) ExcusedAbsencesOrErr() ([]*ExcusedAbsence, error) {
+	if e.loadedTypes[6] {
+		return e.ExcusedAbsences, nil
+	}
+	return nil, &NotLoadedError{edge: "excused_absences"}
+}
+
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[7] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist_entries"}
+}
+
+// LeadsOrErr returns the Leads value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) LeadsOrErr() ([]*Lead, error) {
+	if e.loadedTypes[8] {
+		return e.Leads, nil
+	}
+	return nil, &NotLoadedError{edge: "leads"}
+}
+
+// ScheduleSlotsOrErr returns the ScheduleSlots value or an error if the edge
+// was not loaded in eager-loading.
+func (e CourseEdges) ScheduleSlotsOrErr() ([]*ScheduleSlot, error) {
+	if e.loadedTypes[9] {
+		return e.ScheduleSlots, nil
+	}
+	return nil, &NotLoadedError{edge: "schedule_slots"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Course) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -92,11 +177,11 @@ func (*Course) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case course.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case course.FieldLegacyLessonPrice, course.FieldLegacySubscriptionPrice:
+		case course.FieldLegacyLessonPrice, course.FieldLegacySubscriptionPrice, course.FieldVatRatePct:
 			values[i] = new(sql.NullFloat64)
-		case course.FieldID, course.FieldVersion, course.FieldTeacherID, course.FieldLessonPriceCents, course.FieldSubscriptionPriceCents:
+		case course.FieldID, course.FieldVersion, course.FieldTeacherID, course.FieldLessonPriceCents, course.FieldSubscriptionPriceCents, course.FieldMaxStudents:
 			values[i] = new(sql.NullInt64)
-		case course.FieldName, course.FieldTeacherName, course.FieldType:
+		case course.FieldName, course.FieldTeacherName, course.FieldType, course.FieldVatExemptNote, course.FieldBillingPeriod:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -174,12 +259,36 @@ func (_m *Course) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SubscriptionPriceCents = value.Int64
 			}
+		case course.FieldVatRatePct:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_rate_pct", values[i])
+			} else if value.Valid {
+				_m.VatRatePct = value.Float64
+			}
+		case course.FieldVatExemptNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_exempt_note", values[i])
+			} else if value.Valid {
+				_m.VatExemptNote = value.String
+			}
 		case course.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case course.FieldBillingPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_period", values[i])
+			} else if value.Valid {
+				_m.BillingPeriod = course.BillingPeriod(value.String)
+			}
+		case course.FieldMaxStudents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_students", values[i])
+			} else if value.Valid {
+				_m.MaxStudents = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -208,6 +317,41 @@ func (_m *Course) QueryMonthStats() *CourseMonthStatQuery {
 	return NewCourseClient(_m.config).QueryMonthStats(_m)
 }
 
+// QueryLessonPackages queries the "lesson_packages" edge of the Course entity.
+func (_m *Course) QueryLessonPackages() *LessonPackageQuery {
+	return NewCourseClient(_m.config).QueryLessonPackages(_m)
+}
+
+// QueryPrices queries the "prices" edge of the Course entity.
+func (_m *Course) QueryPrices() *CoursePriceQuery {
+	return NewCourseClient(_m.config).QueryPrices(_m)
+}
+
+// QueryBillingTerms queries the "billing_terms" edge of the Course entity.
+func (_m *Course) QueryBillingTerms() *BillingTermQuery {
+	return NewCourseClient(_m.config).QueryBillingTerms(_m)
+}
+
+// QueryExcusedAbsences queries the "excused_absences" edge of the Course entity.
+func (_m *Course) QueryExcusedAbsences() *ExcusedAbsenceQuery {
+	return NewCourseClient(_m.config).QueryExcusedAbsences(_m)
+}
+
+// QueryWaitlistEntries queries the "waitlist_entries" edge of the Course entity.
+func (_m *Course) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return NewCourseClient(_m.config).QueryWaitlistEntries(_m)
+}
+
+// QueryLeads queries the "leads" edge of the Course entity.
+func (_m *Course) QueryLeads() *LeadQuery {
+	return NewCourseClient(_m.config).QueryLeads(_m)
+}
+
+// QueryScheduleSlots queries the "schedule_slots" edge of the Course entity.
+func (_m *Course) QueryScheduleSlots() *ScheduleSlotQuery {
+	return NewCourseClient(_m.config).QueryScheduleSlots(_m)
+}
+
 // Update returns a builder for updating this Course.
 // Note that you need to call Course.Unwrap() before calling this method if this Course
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -260,8 +404,20 @@ func (_m *Course) String() string {
 	builder.WriteString("subscription_price_cents=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionPriceCents))
 	builder.WriteString(", ")
+	builder.WriteString("vat_rate_pct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatRatePct))
+	builder.WriteString(", ")
+	builder.WriteString("vat_exempt_note=")
+	builder.WriteString(_m.VatExemptNote)
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("billing_period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("max_students=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxStudents))
 	builder.WriteByte(')')
 	return builder.String()
 }
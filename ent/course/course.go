@@ -32,14 +32,36 @@ const (
 	FieldLessonPriceCents = "lesson_price_cents"
 	// FieldSubscriptionPriceCents holds the string denoting the subscription_price_cents field in the database.
 	FieldSubscriptionPriceCents = "subscription_price_cents"
+	// FieldVatRatePct holds the string denoting the vat_rate_pct field in the database.
+	FieldVatRatePct = "vat_rate_pct"
+	// FieldVatExemptNote holds the string denoting the vat_exempt_note field in the database.
+	FieldVatExemptNote = "vat_exempt_note"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldBillingPeriod holds the string denoting the billing_period field in the database.
+	FieldBillingPeriod = "billing_period"
+	// FieldMaxStudents holds the string denoting the max_students field in the database.
+	FieldMaxStudents = "max_students"
 	// EdgeTeacher holds the string denoting the teacher edge name in mutations.
 	EdgeTeacher = "teacher"
 	// EdgeEnrollments holds the string denoting the enrollments edge name in mutations.
 	EdgeEnrollments = "enrollments"
 	// EdgeMonthStats holds the string denoting the month_stats edge name in mutations.
 	EdgeMonthStats = "month_stats"
+	// EdgeLessonPackages holds the string denoting the lesson_packages edge name in mutations.
+	EdgeLessonPackages = "lesson_packages"
+	// EdgePrices holds the string denoting the prices edge name in mutations.
+	EdgePrices = "prices"
+	// EdgeBillingTerms holds the string denoting the billing_terms edge name in mutations.
+	EdgeBillingTerms = "billing_terms"
+	// EdgeExcusedAbsences holds the string denoting the excused_absences edge name in mutations.
+	EdgeExcusedAbsences = "excused_absences"
+	// EdgeWaitlistEntries holds the string denoting the waitlist_entries edge name in mutations.
+	EdgeWaitlistEntries = "waitlist_entries"
+	// EdgeLeads holds the string denoting the leads edge name in mutations.
+	EdgeLeads = "leads"
+	// EdgeScheduleSlots holds the string denoting the schedule_slots edge name in mutations.
+	EdgeScheduleSlots = "schedule_slots"
 	// Table holds the table name of the course in the database.
 	Table = "courses"
 	// TeacherTable is the table that holds the teacher relation/edge.
@@ -63,6 +85,55 @@ const (
 	MonthStatsInverseTable = "course_month_stats"
 	// MonthStatsColumn is the table column denoting the month_stats relation/edge.
 	MonthStatsColumn = "course_id"
+	// LessonPackagesTable is the table that holds the lesson_packages relation/edge.
+	LessonPackagesTable = "lesson_packages"
+	// LessonPackagesInverseTable is the table name for the LessonPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "lessonpackage" package.
+	LessonPackagesInverseTable = "lesson_packages"
+	// LessonPackagesColumn is the table column denoting the lesson_packages relation/edge.
+	LessonPackagesColumn = "course_id"
+	// PricesTable is the table that holds the prices relation/edge.
+	PricesTable = "course_prices"
+	// PricesInverseTable is the table name for the CoursePrice entity.
+	// It exists in this package in order to avoid circular dependency with the "courseprice" package.
+	PricesInverseTable = "course_prices"
+	// PricesColumn is the table column denoting the prices relation/edge.
+	PricesColumn = "course_id"
+	// BillingTermsTable is the table that holds the billing_terms relation/edge.
+	BillingTermsTable = "billing_terms"
+	// BillingTermsInverseTable is the table name for the BillingTerm entity.
+	// It exists in this package in order to avoid circular dependency with the "billingterm" package.
+	BillingTermsInverseTable = "billing_terms"
+	// BillingTermsColumn is the table column denoting the billing_terms relation/edge.
+	BillingTermsColumn = "course_id"
+	// ExcusedAbsencesTable is the table that holds the excused_absences relation/edge.
+	ExcusedAbsencesTable = "excused_absences"
+	// ExcusedAbsencesInverseTable is the table name for the ExcusedAbsence entity.
+	// It exists in this package in order to avoid circular dependency with the "excusedabsence" package.
+	ExcusedAbsencesInverseTable = "excused_absences"
+	// ExcusedAbsencesColumn is the table column denoting the excused_absences relation/edge.
+	ExcusedAbsencesColumn = "course_id"
+	// WaitlistEntriesTable is the table that holds the waitlist_entries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlist_entries relation/edge.
+	WaitlistEntriesColumn = "course_id"
+	// LeadsTable is the table that holds the leads relation/edge.
+	LeadsTable = "leads"
+	// LeadsInverseTable is the table name for the Lead entity.
+	// It exists in this package in order to avoid circular dependency with the "lead" package.
+	LeadsInverseTable = "leads"
+	// LeadsColumn is the table column denoting the leads relation/edge.
+	LeadsColumn = "course_id"
+	// ScheduleSlotsTable is the table that holds the schedule_slots relation/edge.
+	ScheduleSlotsTable = "schedule_slots"
+	// ScheduleSlotsInverseTable is the table name for the ScheduleSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduleslot" package.
+	ScheduleSlotsInverseTable = "schedule_slots"
+	// ScheduleSlotsColumn is the table column denoting the schedule_slots relation/edge.
+	ScheduleSlotsColumn = "course_id"
 )
 
 // Columns holds all SQL columns for course fields.
@@ -77,7 +148,11 @@ var Columns = []string{
 	FieldLegacySubscriptionPrice,
 	FieldLessonPriceCents,
 	FieldSubscriptionPriceCents,
+	FieldVatRatePct,
+	FieldVatExemptNote,
 	FieldIsActive,
+	FieldBillingPeriod,
+	FieldMaxStudents,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -103,8 +178,16 @@ var (
 	DefaultLessonPriceCents int64
 	// DefaultSubscriptionPriceCents holds the default value on creation for the "subscription_price_cents" field.
 	DefaultSubscriptionPriceCents int64
+	// DefaultVatRatePct holds the default value on creation for the "vat_rate_pct" field.
+	DefaultVatRatePct float64
+	// DefaultVatExemptNote holds the default value on creation for the "vat_exempt_note" field.
+	DefaultVatExemptNote string
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultMaxStudents holds the default value on creation for the "max_students" field.
+	DefaultMaxStudents int
+	// MaxStudentsValidator is a validator for the "max_students" field. It is called by the builders before save.
+	MaxStudentsValidator func(int) error
 )
 
 // Type defines the type for the "type" enum field.
@@ -130,6 +213,33 @@ func TypeValidator(_type Type) error {
 	}
 }
 
+// BillingPeriod defines the type for the "billing_period" enum field.
+type BillingPeriod string
+
+// BillingPeriodMonthly is the default value of the BillingPeriod enum.
+const DefaultBillingPeriod = BillingPeriodMonthly
+
+// BillingPeriod values.
+const (
+	BillingPeriodMonthly BillingPeriod = "monthly"
+	BillingPeriodTerm    BillingPeriod = "term"
+	BillingPeriodCustom  BillingPeriod = "custom"
+)
+
+func (bp BillingPeriod) String() string {
+	return string(bp)
+}
+
+// BillingPeriodValidator is a validator for the "billing_period" field enum values. It is called by the builders before save.
+func BillingPeriodValidator(bp BillingPeriod) error {
+	switch bp {
+	case BillingPeriodMonthly, BillingPeriodTerm, BillingPeriodCustom:
+		return nil
+	default:
+		return fmt.Errorf("course: invalid enum value for billing_period field: %q", bp)
+	}
+}
+
 // OrderOption defines the ordering options for the Course queries.
 type OrderOption func(*sql.Selector)
 
@@ -183,11 +293,31 @@ func BySubscriptionPriceCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubscriptionPriceCents, opts...).ToFunc()
 }
 
+// ByVatRatePct orders the results by the vat_rate_pct field.
+func ByVatRatePct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatRatePct, opts...).ToFunc()
+}
+
+// ByVatExemptNote orders the results by the vat_exempt_note field.
+func ByVatExemptNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatExemptNote, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByBillingPeriod orders the results by the billing_period field.
+func ByBillingPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingPeriod, opts...).ToFunc()
+}
+
+// ByMaxStudents orders the results by the max_students field.
+func ByMaxStudents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxStudents, opts...).ToFunc()
+}
+
 // ByTeacherField orders the results by teacher field.
 func ByTeacherField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -222,6 +352,104 @@ func ByMonthStats(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMonthStatsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLessonPackagesCount orders the results by lesson_packages count.
+func ByLessonPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLessonPackagesStep(), opts...)
+	}
+}
+
+// ByLessonPackages orders the results by lesson_packages terms.
+func ByLessonPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLessonPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPricesCount orders the results by prices count.
+func ByPricesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPricesStep(), opts...)
+	}
+}
+
+// ByPrices orders the results by prices terms.
+func ByPrices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPricesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBillingTermsCount orders the results by billing_terms count.
+func ByBillingTermsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBillingTermsStep(), opts...)
+	}
+}
+
+// ByBillingTerms orders the results by billing_terms terms.
+func ByBillingTerms(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillingTermsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExcusedAbsencesCount orders the results by excused_absences count.
+func ByExcusedAbsencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExcusedAbsencesStep(), opts...)
+	}
+}
+
+// ByExcusedAbsences orders the results by excused_absences terms.
+func ByExcusedAbsences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExcusedAbsencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistEntriesCount orders the results by waitlist_entries count.
+func ByWaitlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistEntriesStep(), opts...)
+	}
+}
+
+// ByWaitlistEntries orders the results by waitlist_entries terms.
+func ByWaitlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeadsCount orders the results by leads count.
+func ByLeadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadsStep(), opts...)
+	}
+}
+
+// ByLeads orders the results by leads terms.
+func ByLeads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduleSlotsCount orders the results by schedule_slots count.
+func ByScheduleSlotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduleSlotsStep(), opts...)
+	}
+}
+
+// ByScheduleSlots orders the results by schedule_slots terms.
+func ByScheduleSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduleSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeacherStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -243,3 +471,52 @@ func newMonthStatsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MonthStatsTable, MonthStatsColumn),
 	)
 }
+func newLessonPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LessonPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+	)
+}
+func newPricesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PricesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PricesTable, PricesColumn),
+	)
+}
+func newBillingTermsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillingTermsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BillingTermsTable, BillingTermsColumn),
+	)
+}
+func newExcusedAbsencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExcusedAbsencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExcusedAbsencesTable, ExcusedAbsencesColumn),
+	)
+}
+func newWaitlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+	)
+}
+func newLeadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadsTable, LeadsColumn),
+	)
+}
+func newScheduleSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduleSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduleSlotsTable, ScheduleSlotsColumn),
+	)
+}
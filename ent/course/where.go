@@ -94,11 +94,26 @@ func SubscriptionPriceCents(v int64) predicate.Course {
 	return predicate.Course(sql.FieldEQ(FieldSubscriptionPriceCents, v))
 }
 
+// VatRatePct applies equality check predicate on the "vat_rate_pct" field. It's identical to VatRatePctEQ.
+func VatRatePct(v float64) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// VatExemptNote applies equality check predicate on the "vat_exempt_note" field. It's identical to VatExemptNoteEQ.
+func VatExemptNote(v string) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldVatExemptNote, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.Course {
 	return predicate.Course(sql.FieldEQ(FieldIsActive, v))
 }
 
+// MaxStudents applies equality check predicate on the "max_students" field. It's identical to MaxStudentsEQ.
+func MaxStudents(v int) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldMaxStudents, v))
+}
+
 // VersionEQ applies the EQ predicate on the "version" field.
 func VersionEQ(v int) predicate.Course {
 	return predicate.Course(sql.FieldEQ(FieldVersion, v))
@@ -479,6 +494,111 @@ func SubscriptionPriceCentsLTE(v int64) predicate.Course {
 	return predicate.Course(sql.FieldLTE(FieldSubscriptionPriceCents, v))
 }
 
+// VatRatePctEQ applies the EQ predicate on the "vat_rate_pct" field.
+func VatRatePctEQ(v float64) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctNEQ applies the NEQ predicate on the "vat_rate_pct" field.
+func VatRatePctNEQ(v float64) predicate.Course {
+	return predicate.Course(sql.FieldNEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctIn applies the In predicate on the "vat_rate_pct" field.
+func VatRatePctIn(vs ...float64) predicate.Course {
+	return predicate.Course(sql.FieldIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctNotIn applies the NotIn predicate on the "vat_rate_pct" field.
+func VatRatePctNotIn(vs ...float64) predicate.Course {
+	return predicate.Course(sql.FieldNotIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctGT applies the GT predicate on the "vat_rate_pct" field.
+func VatRatePctGT(v float64) predicate.Course {
+	return predicate.Course(sql.FieldGT(FieldVatRatePct, v))
+}
+
+// VatRatePctGTE applies the GTE predicate on the "vat_rate_pct" field.
+func VatRatePctGTE(v float64) predicate.Course {
+	return predicate.Course(sql.FieldGTE(FieldVatRatePct, v))
+}
+
+// VatRatePctLT applies the LT predicate on the "vat_rate_pct" field.
+func VatRatePctLT(v float64) predicate.Course {
+	return predicate.Course(sql.FieldLT(FieldVatRatePct, v))
+}
+
+// VatRatePctLTE applies the LTE predicate on the "vat_rate_pct" field.
+func VatRatePctLTE(v float64) predicate.Course {
+	return predicate.Course(sql.FieldLTE(FieldVatRatePct, v))
+}
+
+// VatExemptNoteEQ applies the EQ predicate on the "vat_exempt_note" field.
+func VatExemptNoteEQ(v string) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteNEQ applies the NEQ predicate on the "vat_exempt_note" field.
+func VatExemptNoteNEQ(v string) predicate.Course {
+	return predicate.Course(sql.FieldNEQ(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteIn applies the In predicate on the "vat_exempt_note" field.
+func VatExemptNoteIn(vs ...string) predicate.Course {
+	return predicate.Course(sql.FieldIn(FieldVatExemptNote, vs...))
+}
+
+// VatExemptNoteNotIn applies the NotIn predicate on the "vat_exempt_note" field.
+func VatExemptNoteNotIn(vs ...string) predicate.Course {
+	return predicate.Course(sql.FieldNotIn(FieldVatExemptNote, vs...))
+}
+
+// VatExemptNoteGT applies the GT predicate on the "vat_exempt_note" field.
+func VatExemptNoteGT(v string) predicate.Course {
+	return predicate.Course(sql.FieldGT(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteGTE applies the GTE predicate on the "vat_exempt_note" field.
+func VatExemptNoteGTE(v string) predicate.Course {
+	return predicate.Course(sql.FieldGTE(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteLT applies the LT predicate on the "vat_exempt_note" field.
+func VatExemptNoteLT(v string) predicate.Course {
+	return predicate.Course(sql.FieldLT(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteLTE applies the LTE predicate on the "vat_exempt_note" field.
+func VatExemptNoteLTE(v string) predicate.Course {
+	return predicate.Course(sql.FieldLTE(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteContains applies the Contains predicate on the "vat_exempt_note" field.
+func VatExemptNoteContains(v string) predicate.Course {
+	return predicate.Course(sql.FieldContains(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteHasPrefix applies the HasPrefix predicate on the "vat_exempt_note" field.
+func VatExemptNoteHasPrefix(v string) predicate.Course {
+	return predicate.Course(sql.FieldHasPrefix(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteHasSuffix applies the HasSuffix predicate on the "vat_exempt_note" field.
+func VatExemptNoteHasSuffix(v string) predicate.Course {
+	return predicate.Course(sql.FieldHasSuffix(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteEqualFold applies the EqualFold predicate on the "vat_exempt_note" field.
+func VatExemptNoteEqualFold(v string) predicate.Course {
+	return predicate.Course(sql.FieldEqualFold(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteContainsFold applies the ContainsFold predicate on the "vat_exempt_note" field.
+func VatExemptNoteContainsFold(v string) predicate.Course {
+	return predicate.Course(sql.FieldContainsFold(FieldVatExemptNote, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.Course {
 	return predicate.Course(sql.FieldEQ(FieldIsActive, v))
@@ -489,6 +609,66 @@ func IsActiveNEQ(v bool) predicate.Course {
 	return predicate.Course(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// BillingPeriodEQ applies the EQ predicate on the "billing_period" field.
+func BillingPeriodEQ(v BillingPeriod) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldBillingPeriod, v))
+}
+
+// BillingPeriodNEQ applies the NEQ predicate on the "billing_period" field.
+func BillingPeriodNEQ(v BillingPeriod) predicate.Course {
+	return predicate.Course(sql.FieldNEQ(FieldBillingPeriod, v))
+}
+
+// BillingPeriodIn applies the In predicate on the "billing_period" field.
+func BillingPeriodIn(vs ...BillingPeriod) predicate.Course {
+	return predicate.Course(sql.FieldIn(FieldBillingPeriod, vs...))
+}
+
+// BillingPeriodNotIn applies the NotIn predicate on the "billing_period" field.
+func BillingPeriodNotIn(vs ...BillingPeriod) predicate.Course {
+	return predicate.Course(sql.FieldNotIn(FieldBillingPeriod, vs...))
+}
+
+// MaxStudentsEQ applies the EQ predicate on the "max_students" field.
+func MaxStudentsEQ(v int) predicate.Course {
+	return predicate.Course(sql.FieldEQ(FieldMaxStudents, v))
+}
+
+// MaxStudentsNEQ applies the NEQ predicate on the "max_students" field.
+func MaxStudentsNEQ(v int) predicate.Course {
+	return predicate.Course(sql.FieldNEQ(FieldMaxStudents, v))
+}
+
+// MaxStudentsIn applies the In predicate on the "max_students" field.
+func MaxStudentsIn(vs ...int) predicate.Course {
+	return predicate.Course(sql.FieldIn(FieldMaxStudents, vs...))
+}
+
+// MaxStudentsNotIn applies the NotIn predicate on the "max_students" field.
+func MaxStudentsNotIn(vs ...int) predicate.Course {
+	return predicate.Course(sql.FieldNotIn(FieldMaxStudents, vs...))
+}
+
+// MaxStudentsGT applies the GT predicate on the "max_students" field.
+func MaxStudentsGT(v int) predicate.Course {
+	return predicate.Course(sql.FieldGT(FieldMaxStudents, v))
+}
+
+// MaxStudentsGTE applies the GTE predicate on the "max_students" field.
+func MaxStudentsGTE(v int) predicate.Course {
+	return predicate.Course(sql.FieldGTE(FieldMaxStudents, v))
+}
+
+// MaxStudentsLT applies the LT predicate on the "max_students" field.
+func MaxStudentsLT(v int) predicate.Course {
+	return predicate.Course(sql.FieldLT(FieldMaxStudents, v))
+}
+
+// MaxStudentsLTE applies the LTE predicate on the "max_students" field.
+func MaxStudentsLTE(v int) predicate.Course {
+	return predicate.Course(sql.FieldLTE(FieldMaxStudents, v))
+}
+
 // HasTeacher applies the HasEdge predicate on the "teacher" edge.
 func HasTeacher() predicate.Course {
 	return predicate.Course(func(s *sql.Selector) {
@@ -558,6 +738,167 @@ func HasMonthStatsWith(preds ...predicate.CourseMonthStat) predicate.Course {
 	})
 }
 
+// HasLessonPackages applies the HasEdge predicate on the "lesson_packages" edge.
+func HasLessonPackages() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLessonPackagesWith applies the HasEdge predicate on the "lesson_packages" edge with a given conditions (other predicates).
+func HasLessonPackagesWith(preds ...predicate.LessonPackage) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newLessonPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrices applies the HasEdge predicate on the "prices" edge.
+func HasPrices() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PricesTable, PricesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPricesWith applies the HasEdge predicate on the "prices" edge with a given conditions (other predicates).
+func HasPricesWith(preds ...predicate.CoursePrice) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newPricesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBillingTerms applies the HasEdge predicate on the "billing_terms" edge.
+func HasBillingTerms() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BillingTermsTable, BillingTermsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillingTermsWith applies the HasEdge predicate on the "billing_terms" edge with a given conditions (other predicates).
+func HasBillingTermsWith(preds ...predicate.BillingTerm) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newBillingTermsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExcusedAbsences applies the HasEdge predicate on the "excused_absences" edge.
+func HasExcusedAbsences() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExcusedAbsencesTable, ExcusedAbsencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExcusedAbsencesWith applies the HasEdge predicate on the "excused_absences" edge with a given conditions (other predicates).
+func HasExcusedAbsencesWith(preds ...predicate.ExcusedAbsence) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newExcusedAbsencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlistEntries applies the HasEdge predicate on the "waitlist_entries" edge.
+func HasWaitlistEntries() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistEntriesWith applies the HasEdge predicate on the "waitlist_entries" edge with a given conditions (other predicates).
+func HasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newWaitlistEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeads applies the HasEdge predicate on the "leads" edge.
+func HasLeads() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadsTable, LeadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadsWith applies the HasEdge predicate on the "leads" edge with a given conditions (other predicates).
+func HasLeadsWith(preds ...predicate.Lead) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newLeadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduleSlots applies the HasEdge predicate on the "schedule_slots" edge.
+func HasScheduleSlots() predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduleSlotsTable, ScheduleSlotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleSlotsWith applies the HasEdge predicate on the "schedule_slots" edge with a given conditions (other predicates).
+func HasScheduleSlotsWith(preds ...predicate.ScheduleSlot) predicate.Course {
+	return predicate.Course(func(s *sql.Selector) {
+		step := newScheduleSlotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Course) predicate.Course {
 	return predicate.Course(sql.AndPredicates(predicates...))
@@ -6,10 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
+	"langschool/ent/waitlistentry"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -132,6 +139,34 @@ func (_c *CourseCreate) SetNillableSubscriptionPriceCents(v *int64) *CourseCreat
 	return _c
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_c *CourseCreate) SetVatRatePct(v float64) *CourseCreate {
+	_c.mutation.SetVatRatePct(v)
+	return _c
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_c *CourseCreate) SetNillableVatRatePct(v *float64) *CourseCreate {
+	if v != nil {
+		_c.SetVatRatePct(*v)
+	}
+	return _c
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_c *CourseCreate) SetVatExemptNote(v string) *CourseCreate {
+	_c.mutation.SetVatExemptNote(v)
+	return _c
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_c *CourseCreate) SetNillableVatExemptNote(v *string) *CourseCreate {
+	if v != nil {
+		_c.SetVatExemptNote(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *CourseCreate) SetIsActive(v bool) *CourseCreate {
 	_c.mutation.SetIsActive(v)
@@ -146,6 +181,34 @@ func (_c *CourseCreate) SetNillableIsActive(v *bool) *CourseCreate {
 	return _c
 }
 
+// SetBillingPeriod sets the "billing_period" field.
+func (_c *CourseCreate) SetBillingPeriod(v course.BillingPeriod) *CourseCreate {
+	_c.mutation.SetBillingPeriod(v)
+	return _c
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (_c *CourseCreate) SetNillableBillingPeriod(v *course.BillingPeriod) *CourseCreate {
+	if v != nil {
+		_c.SetBillingPeriod(*v)
+	}
+	return _c
+}
+
+// SetMaxStudents sets the "max_students" field.
+func (_c *CourseCreate) SetMaxStudents(v int) *CourseCreate {
+	_c.mutation.SetMaxStudents(v)
+	return _c
+}
+
+// SetNillableMaxStudents sets the "max_students" field if the given value is not nil.
+func (_c *CourseCreate) SetNillableMaxStudents(v *int) *CourseCreate {
+	if v != nil {
+		_c.SetMaxStudents(*v)
+	}
+	return _c
+}
+
 // SetTeacher sets the "teacher" edge to the Teacher entity.
 func (_c *CourseCreate) SetTeacher(v *Teacher) *CourseCreate {
 	return _c.SetTeacherID(v.ID)
@@ -181,6 +244,111 @@ func (_c *CourseCreate) AddMonthStats(v ...*CourseMonthStat) *CourseCreate {
 	return _c.AddMonthStatIDs(ids...)
 }
 
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_c *CourseCreate) AddLessonPackageIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddLessonPackageIDs(ids...)
+	return _c
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_c *CourseCreate) AddLessonPackages(v ...*LessonPackage) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLessonPackageIDs(ids...)
+}
+
+// AddPriceIDs adds the "prices" edge to the CoursePrice entity by IDs.
+func (_c *CourseCreate) AddPriceIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddPriceIDs(ids...)
+	return _c
+}
+
+// AddPrices adds the "prices" edges to the CoursePrice entity.
+func (_c *CourseCreate) AddPrices(v ...*CoursePrice) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPriceIDs(ids...)
+}
+
+// AddBillingTermIDs adds the "billing_terms" edge to the BillingTerm entity by IDs.
+func (_c *CourseCreate) AddBillingTermIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddBillingTermIDs(ids...)
+	return _c
+}
+
+// AddBillingTerms adds the "billing_terms" edges to the BillingTerm entity.
+func (_c *CourseCreate) AddBillingTerms(v ...*BillingTerm) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBillingTermIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_c *CourseCreate) AddExcusedAbsenceIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddExcusedAbsenceIDs(ids...)
+	return _c
+}
+
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_c *CourseCreate) AddExcusedAbsences(v ...*ExcusedAbsence) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_c *CourseCreate) AddWaitlistEntryIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddWaitlistEntryIDs(ids...)
+	return _c
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_c *CourseCreate) AddWaitlistEntries(v ...*WaitlistEntry) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_c *CourseCreate) AddLeadIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddLeadIDs(ids...)
+	return _c
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_c *CourseCreate) AddLeads(v ...*Lead) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadIDs(ids...)
+}
+
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_c *CourseCreate) AddScheduleSlotIDs(ids ...int) *CourseCreate {
+	_c.mutation.AddScheduleSlotIDs(ids...)
+	return _c
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_c *CourseCreate) AddScheduleSlots(v ...*ScheduleSlot) *CourseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (_c *CourseCreate) Mutation() *CourseMutation {
 	return _c.mutation
@@ -240,10 +408,26 @@ func (_c *CourseCreate) defaults() {
 		v := course.DefaultSubscriptionPriceCents
 		_c.mutation.SetSubscriptionPriceCents(v)
 	}
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		v := course.DefaultVatRatePct
+		_c.mutation.SetVatRatePct(v)
+	}
+	if _, ok := _c.mutation.VatExemptNote(); !ok {
+		v := course.DefaultVatExemptNote
+		_c.mutation.SetVatExemptNote(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := course.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.BillingPeriod(); !ok {
+		v := course.DefaultBillingPeriod
+		_c.mutation.SetBillingPeriod(v)
+	}
+	if _, ok := _c.mutation.MaxStudents(); !ok {
+		v := course.DefaultMaxStudents
+		_c.mutation.SetMaxStudents(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -277,9 +461,31 @@ func (_c *CourseCreate) check() error {
 	if _, ok := _c.mutation.SubscriptionPriceCents(); !ok {
 		return &ValidationError{Name: "subscription_price_cents", err: errors.New(`ent: missing required field "Course.subscription_price_cents"`)}
 	}
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		return &ValidationError{Name: "vat_rate_pct", err: errors.New(`ent: missing required field "Course.vat_rate_pct"`)}
+	}
+	if _, ok := _c.mutation.VatExemptNote(); !ok {
+		return &ValidationError{Name: "vat_exempt_note", err: errors.New(`ent: missing required field "Course.vat_exempt_note"`)}
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Course.is_active"`)}
 	}
+	if _, ok := _c.mutation.BillingPeriod(); !ok {
+		return &ValidationError{Name: "billing_period", err: errors.New(`ent: missing required field "Course.billing_period"`)}
+	}
+	if v, ok := _c.mutation.BillingPeriod(); ok {
+		if err := course.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "Course.billing_period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxStudents(); !ok {
+		return &ValidationError{Name: "max_students", err: errors.New(`ent: missing required field "Course.max_students"`)}
+	}
+	if v, ok := _c.mutation.MaxStudents(); ok {
+		if err := course.MaxStudentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_students", err: fmt.Errorf(`ent: validator failed for field "Course.max_students": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -338,10 +544,26 @@ func (_c *CourseCreate) createSpec() (*Course, *sqlgraph.CreateSpec) {
 		_spec.SetField(course.FieldSubscriptionPriceCents, field.TypeInt64, value)
 		_node.SubscriptionPriceCents = value
 	}
+	if value, ok := _c.mutation.VatRatePct(); ok {
+		_spec.SetField(course.FieldVatRatePct, field.TypeFloat64, value)
+		_node.VatRatePct = value
+	}
+	if value, ok := _c.mutation.VatExemptNote(); ok {
+		_spec.SetField(course.FieldVatExemptNote, field.TypeString, value)
+		_node.VatExemptNote = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(course.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.BillingPeriod(); ok {
+		_spec.SetField(course.FieldBillingPeriod, field.TypeEnum, value)
+		_node.BillingPeriod = value
+	}
+	if value, ok := _c.mutation.MaxStudents(); ok {
+		_spec.SetField(course.FieldMaxStudents, field.TypeInt, value)
+		_node.MaxStudents = value
+	}
 	if nodes := _c.mutation.TeacherIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -391,6 +613,118 @@ func (_c *CourseCreate) createSpec() (*Course, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BillingTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,11 +6,18 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/predicate"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
+	"langschool/ent/waitlistentry"
 	"math"
 
 	"entgo.io/ent"
@@ -22,13 +29,20 @@ import (
 // CourseQuery is the builder for querying Course entities.
 type CourseQuery struct {
 	config
-	ctx             *QueryContext
-	order           []course.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Course
-	withTeacher     *TeacherQuery
-	withEnrollments *EnrollmentQuery
-	withMonthStats  *CourseMonthStatQuery
+	ctx                 *QueryContext
+	order               []course.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Course
+	withTeacher         *TeacherQuery
+	withEnrollments     *EnrollmentQuery
+	withMonthStats      *CourseMonthStatQuery
+	withLessonPackages  *LessonPackageQuery
+	withPrices          *CoursePriceQuery
+	withBillingTerms    *BillingTermQuery
+	withExcusedAbsences *ExcusedAbsenceQuery
+	withWaitlistEntries *WaitlistEntryQuery
+	withLeads           *LeadQuery
+	withScheduleSlots   *ScheduleSlotQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +145,160 @@ func (_q *CourseQuery) QueryMonthStats() *CourseMonthStatQuery {
 	return query
 }
 
+// QueryLessonPackages chains the current query on the "lesson_packages" edge.
+func (_q *CourseQuery) QueryLessonPackages() *LessonPackageQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.LessonPackagesTable, course.LessonPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrices chains the current query on the "prices" edge.
+func (_q *CourseQuery) QueryPrices() *CoursePriceQuery {
+	query := (&CoursePriceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(courseprice.Table, courseprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.PricesTable, course.PricesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBillingTerms chains the current query on the "billing_terms" edge.
+func (_q *CourseQuery) QueryBillingTerms() *BillingTermQuery {
+	query := (&BillingTermClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(billingterm.Table, billingterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.BillingTermsTable, course.BillingTermsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExcusedAbsences chains the current query on the "excused_absences" edge.
+func (_q *CourseQuery) QueryExcusedAbsences() *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.ExcusedAbsencesTable, course.ExcusedAbsencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries chains the current query on the "waitlist_entries" edge.
+func (_q *CourseQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.WaitlistEntriesTable, course.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeads chains the current query on the "leads" edge.
+func (_q *CourseQuery) QueryLeads() *LeadQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.LeadsTable, course.LeadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduleSlots chains the current query on the "schedule_slots" edge.
+func (_q *CourseQuery) QueryScheduleSlots() *ScheduleSlotQuery {
+	query := (&ScheduleSlotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(course.Table, course.FieldID, selector),
+			sqlgraph.To(scheduleslot.Table, scheduleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, course.ScheduleSlotsTable, course.ScheduleSlotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Course entity from the query.
 // Returns a *NotFoundError when no Course was found.
 func (_q *CourseQuery) First(ctx context.Context) (*Course, error) {
@@ -318,14 +486,21 @@ func (_q *CourseQuery) Clone() *CourseQuery {
 		return nil
 	}
 	return &CourseQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]course.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Course{}, _q.predicates...),
-		withTeacher:     _q.withTeacher.Clone(),
-		withEnrollments: _q.withEnrollments.Clone(),
-		withMonthStats:  _q.withMonthStats.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]course.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Course{}, _q.predicates...),
+		withTeacher:         _q.withTeacher.Clone(),
+		withEnrollments:     _q.withEnrollments.Clone(),
+		withMonthStats:      _q.withMonthStats.Clone(),
+		withLessonPackages:  _q.withLessonPackages.Clone(),
+		withPrices:          _q.withPrices.Clone(),
+		withBillingTerms:    _q.withBillingTerms.Clone(),
+		withExcusedAbsences: _q.withExcusedAbsences.Clone(),
+		withWaitlistEntries: _q.withWaitlistEntries.Clone(),
+		withLeads:           _q.withLeads.Clone(),
+		withScheduleSlots:   _q.withScheduleSlots.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +540,83 @@ func (_q *CourseQuery) WithMonthStats(opts ...func(*CourseMonthStatQuery)) *Cour
 	return _q
 }
 
+// WithLessonPackages tells the query-builder to eager-load the nodes that are connected to
+// the "lesson_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithLessonPackages(opts ...func(*LessonPackageQuery)) *CourseQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLessonPackages = query
+	return _q
+}
+
+// WithPrices tells the query-builder to eager-load the nodes that are connected to
+// the "prices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithPrices(opts ...func(*CoursePriceQuery)) *CourseQuery {
+	query := (&CoursePriceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrices = query
+	return _q
+}
+
+// WithBillingTerms tells the query-builder to eager-load the nodes that are connected to
+// the "billing_terms" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithBillingTerms(opts ...func(*BillingTermQuery)) *CourseQuery {
+	query := (&BillingTermClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBillingTerms = query
+	return _q
+}
+
+// WithExcusedAbsences tells the query-builder to eager-load the nodes that are connected to
+// the "excused_absences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithExcusedAbsences(opts ...func(*ExcusedAbsenceQuery)) *CourseQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExcusedAbsences = query
+	return _q
+}
+
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *CourseQuery {
+	query := (&WaitlistEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWaitlistEntries = query
+	return _q
+}
+
+// WithLeads tells the query-builder to eager-load the nodes that are connected to
+// the "leads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithLeads(opts ...func(*LeadQuery)) *CourseQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeads = query
+	return _q
+}
+
+// WithScheduleSlots tells the query-builder to eager-load the nodes that are connected to
+// the "schedule_slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CourseQuery) WithScheduleSlots(opts ...func(*ScheduleSlotQuery)) *CourseQuery {
+	query := (&ScheduleSlotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduleSlots = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +695,17 @@ func (_q *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 	var (
 		nodes       = []*Course{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [10]bool{
 			_q.withTeacher != nil,
 			_q.withEnrollments != nil,
 			_q.withMonthStats != nil,
+			_q.withLessonPackages != nil,
+			_q.withPrices != nil,
+			_q.withBillingTerms != nil,
+			_q.withExcusedAbsences != nil,
+			_q.withWaitlistEntries != nil,
+			_q.withLeads != nil,
+			_q.withScheduleSlots != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +746,55 @@ func (_q *CourseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cours
 			return nil, err
 		}
 	}
+	if query := _q.withLessonPackages; query != nil {
+		if err := _q.loadLessonPackages(ctx, query, nodes,
+			func(n *Course) { n.Edges.LessonPackages = []*LessonPackage{} },
+			func(n *Course, e *LessonPackage) { n.Edges.LessonPackages = append(n.Edges.LessonPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPrices; query != nil {
+		if err := _q.loadPrices(ctx, query, nodes,
+			func(n *Course) { n.Edges.Prices = []*CoursePrice{} },
+			func(n *Course, e *CoursePrice) { n.Edges.Prices = append(n.Edges.Prices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBillingTerms; query != nil {
+		if err := _q.loadBillingTerms(ctx, query, nodes,
+			func(n *Course) { n.Edges.BillingTerms = []*BillingTerm{} },
+			func(n *Course, e *BillingTerm) { n.Edges.BillingTerms = append(n.Edges.BillingTerms, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExcusedAbsences; query != nil {
+		if err := _q.loadExcusedAbsences(ctx, query, nodes,
+			func(n *Course) { n.Edges.ExcusedAbsences = []*ExcusedAbsence{} },
+			func(n *Course, e *ExcusedAbsence) { n.Edges.ExcusedAbsences = append(n.Edges.ExcusedAbsences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWaitlistEntries; query != nil {
+		if err := _q.loadWaitlistEntries(ctx, query, nodes,
+			func(n *Course) { n.Edges.WaitlistEntries = []*WaitlistEntry{} },
+			func(n *Course, e *WaitlistEntry) { n.Edges.WaitlistEntries = append(n.Edges.WaitlistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeads; query != nil {
+		if err := _q.loadLeads(ctx, query, nodes,
+			func(n *Course) { n.Edges.Leads = []*Lead{} },
+			func(n *Course, e *Lead) { n.Edges.Leads = append(n.Edges.Leads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduleSlots; query != nil {
+		if err := _q.loadScheduleSlots(ctx, query, nodes,
+			func(n *Course) { n.Edges.ScheduleSlots = []*ScheduleSlot{} },
+			func(n *Course, e *ScheduleSlot) { n.Edges.ScheduleSlots = append(n.Edges.ScheduleSlots, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -582,6 +890,222 @@ func (_q *CourseQuery) loadMonthStats(ctx context.Context, query *CourseMonthSta
 	}
 	return nil
 }
+func (_q *CourseQuery) loadLessonPackages(ctx context.Context, query *LessonPackageQuery, nodes []*Course, init func(*Course), assign func(*Course, *LessonPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lessonpackage.FieldCourseID)
+	}
+	query.Where(predicate.LessonPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.LessonPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadPrices(ctx context.Context, query *CoursePriceQuery, nodes []*Course, init func(*Course), assign func(*Course, *CoursePrice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(courseprice.FieldCourseID)
+	}
+	query.Where(predicate.CoursePrice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.PricesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadBillingTerms(ctx context.Context, query *BillingTermQuery, nodes []*Course, init func(*Course), assign func(*Course, *BillingTerm)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(billingterm.FieldCourseID)
+	}
+	query.Where(predicate.BillingTerm(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.BillingTermsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "course_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadExcusedAbsences(ctx context.Context, query *ExcusedAbsenceQuery, nodes []*Course, init func(*Course), assign func(*Course, *ExcusedAbsence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(excusedabsence.FieldCourseID)
+	}
+	query.Where(predicate.ExcusedAbsence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.ExcusedAbsencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadWaitlistEntries(ctx context.Context, query *WaitlistEntryQuery, nodes []*Course, init func(*Course), assign func(*Course, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldCourseID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.WaitlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadLeads(ctx context.Context, query *LeadQuery, nodes []*Course, init func(*Course), assign func(*Course, *Lead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lead.FieldCourseID)
+	}
+	query.Where(predicate.Lead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.LeadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "course_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CourseQuery) loadScheduleSlots(ctx context.Context, query *ScheduleSlotQuery, nodes []*Course, init func(*Course), assign func(*Course, *ScheduleSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Course)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduleslot.FieldCourseID)
+	}
+	query.Where(predicate.ScheduleSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(course.ScheduleSlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CourseID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "course_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CourseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
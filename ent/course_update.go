@@ -6,11 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/predicate"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
+	"langschool/ent/waitlistentry"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -197,6 +204,41 @@ func (_u *CourseUpdate) AddSubscriptionPriceCents(v int64) *CourseUpdate {
 	return _u
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *CourseUpdate) SetVatRatePct(v float64) *CourseUpdate {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *CourseUpdate) SetNillableVatRatePct(v *float64) *CourseUpdate {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *CourseUpdate) AddVatRatePct(v float64) *CourseUpdate {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_u *CourseUpdate) SetVatExemptNote(v string) *CourseUpdate {
+	_u.mutation.SetVatExemptNote(v)
+	return _u
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_u *CourseUpdate) SetNillableVatExemptNote(v *string) *CourseUpdate {
+	if v != nil {
+		_u.SetVatExemptNote(*v)
+	}
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *CourseUpdate) SetIsActive(v bool) *CourseUpdate {
 	_u.mutation.SetIsActive(v)
@@ -211,6 +253,41 @@ func (_u *CourseUpdate) SetNillableIsActive(v *bool) *CourseUpdate {
 	return _u
 }
 
+// SetBillingPeriod sets the "billing_period" field.
+func (_u *CourseUpdate) SetBillingPeriod(v course.BillingPeriod) *CourseUpdate {
+	_u.mutation.SetBillingPeriod(v)
+	return _u
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (_u *CourseUpdate) SetNillableBillingPeriod(v *course.BillingPeriod) *CourseUpdate {
+	if v != nil {
+		_u.SetBillingPeriod(*v)
+	}
+	return _u
+}
+
+// SetMaxStudents sets the "max_students" field.
+func (_u *CourseUpdate) SetMaxStudents(v int) *CourseUpdate {
+	_u.mutation.ResetMaxStudents()
+	_u.mutation.SetMaxStudents(v)
+	return _u
+}
+
+// SetNillableMaxStudents sets the "max_students" field if the given value is not nil.
+func (_u *CourseUpdate) SetNillableMaxStudents(v *int) *CourseUpdate {
+	if v != nil {
+		_u.SetMaxStudents(*v)
+	}
+	return _u
+}
+
+// AddMaxStudents adds value to the "max_students" field.
+func (_u *CourseUpdate) AddMaxStudents(v int) *CourseUpdate {
+	_u.mutation.AddMaxStudents(v)
+	return _u
+}
+
 // SetTeacher sets the "teacher" edge to the Teacher entity.
 func (_u *CourseUpdate) SetTeacher(v *Teacher) *CourseUpdate {
 	return _u.SetTeacherID(v.ID)
@@ -246,6 +323,111 @@ func (_u *CourseUpdate) AddMonthStats(v ...*CourseMonthStat) *CourseUpdate {
 	return _u.AddMonthStatIDs(ids...)
 }
 
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *CourseUpdate) AddLessonPackageIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddLessonPackageIDs(ids...)
+	return _u
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *CourseUpdate) AddLessonPackages(v ...*LessonPackage) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// AddPriceIDs adds the "prices" edge to the CoursePrice entity by IDs.
+func (_u *CourseUpdate) AddPriceIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddPriceIDs(ids...)
+	return _u
+}
+
+// AddPrices adds the "prices" edges to the CoursePrice entity.
+func (_u *CourseUpdate) AddPrices(v ...*CoursePrice) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceIDs(ids...)
+}
+
+// AddBillingTermIDs adds the "billing_terms" edge to the BillingTerm entity by IDs.
+func (_u *CourseUpdate) AddBillingTermIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddBillingTermIDs(ids...)
+	return _u
+}
+
+// AddBillingTerms adds the "billing_terms" edges to the BillingTerm entity.
+func (_u *CourseUpdate) AddBillingTerms(v ...*BillingTerm) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillingTermIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_u *CourseUpdate) AddExcusedAbsenceIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *CourseUpdate) AddExcusedAbsences(v ...*ExcusedAbsence) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_u *CourseUpdate) AddWaitlistEntryIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *CourseUpdate) AddWaitlistEntries(v ...*WaitlistEntry) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_u *CourseUpdate) AddLeadIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddLeadIDs(ids...)
+	return _u
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_u *CourseUpdate) AddLeads(v ...*Lead) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadIDs(ids...)
+}
+
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *CourseUpdate) AddScheduleSlotIDs(ids ...int) *CourseUpdate {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *CourseUpdate) AddScheduleSlots(v ...*ScheduleSlot) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (_u *CourseUpdate) Mutation() *CourseMutation {
 	return _u.mutation
@@ -299,6 +481,153 @@ func (_u *CourseUpdate) RemoveMonthStats(v ...*CourseMonthStat) *CourseUpdate {
 	return _u.RemoveMonthStatIDs(ids...)
 }
 
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *CourseUpdate) ClearLessonPackages() *CourseUpdate {
+	_u.mutation.ClearLessonPackages()
+	return _u
+}
+
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *CourseUpdate) RemoveLessonPackageIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *CourseUpdate) RemoveLessonPackages(v ...*LessonPackage) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLessonPackageIDs(ids...)
+}
+
+// ClearPrices clears all "prices" edges to the CoursePrice entity.
+func (_u *CourseUpdate) ClearPrices() *CourseUpdate {
+	_u.mutation.ClearPrices()
+	return _u
+}
+
+// RemovePriceIDs removes the "prices" edge to CoursePrice entities by IDs.
+func (_u *CourseUpdate) RemovePriceIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemovePriceIDs(ids...)
+	return _u
+}
+
+// RemovePrices removes "prices" edges to CoursePrice entities.
+func (_u *CourseUpdate) RemovePrices(v ...*CoursePrice) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceIDs(ids...)
+}
+
+// ClearBillingTerms clears all "billing_terms" edges to the BillingTerm entity.
+func (_u *CourseUpdate) ClearBillingTerms() *CourseUpdate {
+	_u.mutation.ClearBillingTerms()
+	return _u
+}
+
+// RemoveBillingTermIDs removes the "billing_terms" edge to BillingTerm entities by IDs.
+func (_u *CourseUpdate) RemoveBillingTermIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveBillingTermIDs(ids...)
+	return _u
+}
+
+// RemoveBillingTerms removes "billing_terms" edges to BillingTerm entities.
+func (_u *CourseUpdate) RemoveBillingTerms(v ...*BillingTerm) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillingTermIDs(ids...)
+}
+
+// ClearExcusedAbsences clears all "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *CourseUpdate) ClearExcusedAbsences() *CourseUpdate {
+	_u.mutation.ClearExcusedAbsences()
+	return _u
+}
+
+// RemoveExcusedAbsenceIDs removes the "excused_absences" edge to ExcusedAbsence entities by IDs.
+func (_u *CourseUpdate) RemoveExcusedAbsenceIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// RemoveExcusedAbsences removes "excused_absences" edges to ExcusedAbsence entities.
+func (_u *CourseUpdate) RemoveExcusedAbsences(v ...*ExcusedAbsence) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcusedAbsenceIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *CourseUpdate) ClearWaitlistEntries() *CourseUpdate {
+	_u.mutation.ClearWaitlistEntries()
+	return _u
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (_u *CourseUpdate) RemoveWaitlistEntryIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (_u *CourseUpdate) RemoveWaitlistEntries(v ...*WaitlistEntry) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearLeads clears all "leads" edges to the Lead entity.
+func (_u *CourseUpdate) ClearLeads() *CourseUpdate {
+	_u.mutation.ClearLeads()
+	return _u
+}
+
+// RemoveLeadIDs removes the "leads" edge to Lead entities by IDs.
+func (_u *CourseUpdate) RemoveLeadIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveLeadIDs(ids...)
+	return _u
+}
+
+// RemoveLeads removes "leads" edges to Lead entities.
+func (_u *CourseUpdate) RemoveLeads(v ...*Lead) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadIDs(ids...)
+}
+
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *CourseUpdate) ClearScheduleSlots() *CourseUpdate {
+	_u.mutation.ClearScheduleSlots()
+	return _u
+}
+
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *CourseUpdate) RemoveScheduleSlotIDs(ids ...int) *CourseUpdate {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *CourseUpdate) RemoveScheduleSlots(v ...*ScheduleSlot) *CourseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduleSlotIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CourseUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -333,6 +662,16 @@ func (_u *CourseUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Course.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BillingPeriod(); ok {
+		if err := course.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "Course.billing_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxStudents(); ok {
+		if err := course.MaxStudentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_students", err: fmt.Errorf(`ent: validator failed for field "Course.max_students": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -387,9 +726,27 @@ func (_u *CourseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSubscriptionPriceCents(); ok {
 		_spec.AddField(course.FieldSubscriptionPriceCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(course.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(course.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.VatExemptNote(); ok {
+		_spec.SetField(course.FieldVatExemptNote, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(course.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.BillingPeriod(); ok {
+		_spec.SetField(course.FieldBillingPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxStudents(); ok {
+		_spec.SetField(course.FieldMaxStudents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStudents(); ok {
+		_spec.AddField(course.FieldMaxStudents, field.TypeInt, value)
+	}
 	if _u.mutation.TeacherCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -509,52 +866,367 @@ func (_u *CourseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{course.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// CourseUpdateOne is the builder for updating a single Course entity.
-type CourseUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *CourseMutation
-}
-
-// SetVersion sets the "version" field.
-func (_u *CourseUpdateOne) SetVersion(v int) *CourseUpdateOne {
-	_u.mutation.ResetVersion()
-	_u.mutation.SetVersion(v)
-	return _u
-}
-
-// SetNillableVersion sets the "version" field if the given value is not nil.
-func (_u *CourseUpdateOne) SetNillableVersion(v *int) *CourseUpdateOne {
-	if v != nil {
-		_u.SetVersion(*v)
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddVersion adds value to the "version" field.
-func (_u *CourseUpdateOne) AddVersion(v int) *CourseUpdateOne {
-	_u.mutation.AddVersion(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *CourseUpdateOne) SetName(v string) *CourseUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPricesIDs(); len(nodes) > 0 && !_u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillingTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillingTermsIDs(); len(nodes) > 0 && !_u.mutation.BillingTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcusedAbsencesIDs(); len(nodes) > 0 && !_u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !_u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadsIDs(); len(nodes) > 0 && !_u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{course.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CourseUpdateOne is the builder for updating a single Course entity.
+type CourseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CourseMutation
+}
+
+// SetVersion sets the "version" field.
+func (_u *CourseUpdateOne) SetVersion(v int) *CourseUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *CourseUpdateOne) SetNillableVersion(v *int) *CourseUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *CourseUpdateOne) AddVersion(v int) *CourseUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CourseUpdateOne) SetName(v string) *CourseUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
 
 // SetNillableName sets the "name" field if the given value is not nil.
 func (_u *CourseUpdateOne) SetNillableName(v *string) *CourseUpdateOne {
@@ -696,6 +1368,41 @@ func (_u *CourseUpdateOne) AddSubscriptionPriceCents(v int64) *CourseUpdateOne {
 	return _u
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *CourseUpdateOne) SetVatRatePct(v float64) *CourseUpdateOne {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *CourseUpdateOne) SetNillableVatRatePct(v *float64) *CourseUpdateOne {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *CourseUpdateOne) AddVatRatePct(v float64) *CourseUpdateOne {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_u *CourseUpdateOne) SetVatExemptNote(v string) *CourseUpdateOne {
+	_u.mutation.SetVatExemptNote(v)
+	return _u
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_u *CourseUpdateOne) SetNillableVatExemptNote(v *string) *CourseUpdateOne {
+	if v != nil {
+		_u.SetVatExemptNote(*v)
+	}
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *CourseUpdateOne) SetIsActive(v bool) *CourseUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -710,6 +1417,41 @@ func (_u *CourseUpdateOne) SetNillableIsActive(v *bool) *CourseUpdateOne {
 	return _u
 }
 
+// SetBillingPeriod sets the "billing_period" field.
+func (_u *CourseUpdateOne) SetBillingPeriod(v course.BillingPeriod) *CourseUpdateOne {
+	_u.mutation.SetBillingPeriod(v)
+	return _u
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (_u *CourseUpdateOne) SetNillableBillingPeriod(v *course.BillingPeriod) *CourseUpdateOne {
+	if v != nil {
+		_u.SetBillingPeriod(*v)
+	}
+	return _u
+}
+
+// SetMaxStudents sets the "max_students" field.
+func (_u *CourseUpdateOne) SetMaxStudents(v int) *CourseUpdateOne {
+	_u.mutation.ResetMaxStudents()
+	_u.mutation.SetMaxStudents(v)
+	return _u
+}
+
+// SetNillableMaxStudents sets the "max_students" field if the given value is not nil.
+func (_u *CourseUpdateOne) SetNillableMaxStudents(v *int) *CourseUpdateOne {
+	if v != nil {
+		_u.SetMaxStudents(*v)
+	}
+	return _u
+}
+
+// AddMaxStudents adds value to the "max_students" field.
+func (_u *CourseUpdateOne) AddMaxStudents(v int) *CourseUpdateOne {
+	_u.mutation.AddMaxStudents(v)
+	return _u
+}
+
 // SetTeacher sets the "teacher" edge to the Teacher entity.
 func (_u *CourseUpdateOne) SetTeacher(v *Teacher) *CourseUpdateOne {
 	return _u.SetTeacherID(v.ID)
@@ -745,6 +1487,111 @@ func (_u *CourseUpdateOne) AddMonthStats(v ...*CourseMonthStat) *CourseUpdateOne
 	return _u.AddMonthStatIDs(ids...)
 }
 
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *CourseUpdateOne) AddLessonPackageIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddLessonPackageIDs(ids...)
+	return _u
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *CourseUpdateOne) AddLessonPackages(v ...*LessonPackage) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// AddPriceIDs adds the "prices" edge to the CoursePrice entity by IDs.
+func (_u *CourseUpdateOne) AddPriceIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddPriceIDs(ids...)
+	return _u
+}
+
+// AddPrices adds the "prices" edges to the CoursePrice entity.
+func (_u *CourseUpdateOne) AddPrices(v ...*CoursePrice) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceIDs(ids...)
+}
+
+// AddBillingTermIDs adds the "billing_terms" edge to the BillingTerm entity by IDs.
+func (_u *CourseUpdateOne) AddBillingTermIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddBillingTermIDs(ids...)
+	return _u
+}
+
+// AddBillingTerms adds the "billing_terms" edges to the BillingTerm entity.
+func (_u *CourseUpdateOne) AddBillingTerms(v ...*BillingTerm) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillingTermIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_u *CourseUpdateOne) AddExcusedAbsenceIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *CourseUpdateOne) AddExcusedAbsences(v ...*ExcusedAbsence) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_u *CourseUpdateOne) AddWaitlistEntryIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *CourseUpdateOne) AddWaitlistEntries(v ...*WaitlistEntry) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_u *CourseUpdateOne) AddLeadIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddLeadIDs(ids...)
+	return _u
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_u *CourseUpdateOne) AddLeads(v ...*Lead) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadIDs(ids...)
+}
+
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *CourseUpdateOne) AddScheduleSlotIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *CourseUpdateOne) AddScheduleSlots(v ...*ScheduleSlot) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the CourseMutation object of the builder.
 func (_u *CourseUpdateOne) Mutation() *CourseMutation {
 	return _u.mutation
@@ -762,40 +1609,187 @@ func (_u *CourseUpdateOne) ClearEnrollments() *CourseUpdateOne {
 	return _u
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to Enrollment entities by IDs.
-func (_u *CourseUpdateOne) RemoveEnrollmentIDs(ids ...int) *CourseUpdateOne {
-	_u.mutation.RemoveEnrollmentIDs(ids...)
+// RemoveEnrollmentIDs removes the "enrollments" edge to Enrollment entities by IDs.
+func (_u *CourseUpdateOne) RemoveEnrollmentIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveEnrollmentIDs(ids...)
+	return _u
+}
+
+// RemoveEnrollments removes "enrollments" edges to Enrollment entities.
+func (_u *CourseUpdateOne) RemoveEnrollments(v ...*Enrollment) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEnrollmentIDs(ids...)
+}
+
+// ClearMonthStats clears all "month_stats" edges to the CourseMonthStat entity.
+func (_u *CourseUpdateOne) ClearMonthStats() *CourseUpdateOne {
+	_u.mutation.ClearMonthStats()
+	return _u
+}
+
+// RemoveMonthStatIDs removes the "month_stats" edge to CourseMonthStat entities by IDs.
+func (_u *CourseUpdateOne) RemoveMonthStatIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveMonthStatIDs(ids...)
+	return _u
+}
+
+// RemoveMonthStats removes "month_stats" edges to CourseMonthStat entities.
+func (_u *CourseUpdateOne) RemoveMonthStats(v ...*CourseMonthStat) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonthStatIDs(ids...)
+}
+
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *CourseUpdateOne) ClearLessonPackages() *CourseUpdateOne {
+	_u.mutation.ClearLessonPackages()
+	return _u
+}
+
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *CourseUpdateOne) RemoveLessonPackageIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *CourseUpdateOne) RemoveLessonPackages(v ...*LessonPackage) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLessonPackageIDs(ids...)
+}
+
+// ClearPrices clears all "prices" edges to the CoursePrice entity.
+func (_u *CourseUpdateOne) ClearPrices() *CourseUpdateOne {
+	_u.mutation.ClearPrices()
+	return _u
+}
+
+// RemovePriceIDs removes the "prices" edge to CoursePrice entities by IDs.
+func (_u *CourseUpdateOne) RemovePriceIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemovePriceIDs(ids...)
+	return _u
+}
+
+// RemovePrices removes "prices" edges to CoursePrice entities.
+func (_u *CourseUpdateOne) RemovePrices(v ...*CoursePrice) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceIDs(ids...)
+}
+
+// ClearBillingTerms clears all "billing_terms" edges to the BillingTerm entity.
+func (_u *CourseUpdateOne) ClearBillingTerms() *CourseUpdateOne {
+	_u.mutation.ClearBillingTerms()
+	return _u
+}
+
+// RemoveBillingTermIDs removes the "billing_terms" edge to BillingTerm entities by IDs.
+func (_u *CourseUpdateOne) RemoveBillingTermIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveBillingTermIDs(ids...)
+	return _u
+}
+
+// RemoveBillingTerms removes "billing_terms" edges to BillingTerm entities.
+func (_u *CourseUpdateOne) RemoveBillingTerms(v ...*BillingTerm) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillingTermIDs(ids...)
+}
+
+// ClearExcusedAbsences clears all "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *CourseUpdateOne) ClearExcusedAbsences() *CourseUpdateOne {
+	_u.mutation.ClearExcusedAbsences()
+	return _u
+}
+
+// RemoveExcusedAbsenceIDs removes the "excused_absences" edge to ExcusedAbsence entities by IDs.
+func (_u *CourseUpdateOne) RemoveExcusedAbsenceIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// RemoveExcusedAbsences removes "excused_absences" edges to ExcusedAbsence entities.
+func (_u *CourseUpdateOne) RemoveExcusedAbsences(v ...*ExcusedAbsence) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcusedAbsenceIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *CourseUpdateOne) ClearWaitlistEntries() *CourseUpdateOne {
+	_u.mutation.ClearWaitlistEntries()
+	return _u
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (_u *CourseUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (_u *CourseUpdateOne) RemoveWaitlistEntries(v ...*WaitlistEntry) *CourseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearLeads clears all "leads" edges to the Lead entity.
+func (_u *CourseUpdateOne) ClearLeads() *CourseUpdateOne {
+	_u.mutation.ClearLeads()
+	return _u
+}
+
+// RemoveLeadIDs removes the "leads" edge to Lead entities by IDs.
+func (_u *CourseUpdateOne) RemoveLeadIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveLeadIDs(ids...)
 	return _u
 }
 
-// RemoveEnrollments removes "enrollments" edges to Enrollment entities.
-func (_u *CourseUpdateOne) RemoveEnrollments(v ...*Enrollment) *CourseUpdateOne {
+// RemoveLeads removes "leads" edges to Lead entities.
+func (_u *CourseUpdateOne) RemoveLeads(v ...*Lead) *CourseUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEnrollmentIDs(ids...)
+	return _u.RemoveLeadIDs(ids...)
 }
 
-// ClearMonthStats clears all "month_stats" edges to the CourseMonthStat entity.
-func (_u *CourseUpdateOne) ClearMonthStats() *CourseUpdateOne {
-	_u.mutation.ClearMonthStats()
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *CourseUpdateOne) ClearScheduleSlots() *CourseUpdateOne {
+	_u.mutation.ClearScheduleSlots()
 	return _u
 }
 
-// RemoveMonthStatIDs removes the "month_stats" edge to CourseMonthStat entities by IDs.
-func (_u *CourseUpdateOne) RemoveMonthStatIDs(ids ...int) *CourseUpdateOne {
-	_u.mutation.RemoveMonthStatIDs(ids...)
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *CourseUpdateOne) RemoveScheduleSlotIDs(ids ...int) *CourseUpdateOne {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
 	return _u
 }
 
-// RemoveMonthStats removes "month_stats" edges to CourseMonthStat entities.
-func (_u *CourseUpdateOne) RemoveMonthStats(v ...*CourseMonthStat) *CourseUpdateOne {
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *CourseUpdateOne) RemoveScheduleSlots(v ...*ScheduleSlot) *CourseUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMonthStatIDs(ids...)
+	return _u.RemoveScheduleSlotIDs(ids...)
 }
 
 // Where appends a list predicates to the CourseUpdate builder.
@@ -845,6 +1839,16 @@ func (_u *CourseUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Course.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BillingPeriod(); ok {
+		if err := course.BillingPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "Course.billing_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxStudents(); ok {
+		if err := course.MaxStudentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_students", err: fmt.Errorf(`ent: validator failed for field "Course.max_students": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -916,9 +1920,27 @@ func (_u *CourseUpdateOne) sqlSave(ctx context.Context) (_node *Course, err erro
 	if value, ok := _u.mutation.AddedSubscriptionPriceCents(); ok {
 		_spec.AddField(course.FieldSubscriptionPriceCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(course.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(course.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.VatExemptNote(); ok {
+		_spec.SetField(course.FieldVatExemptNote, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(course.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.BillingPeriod(); ok {
+		_spec.SetField(course.FieldBillingPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxStudents(); ok {
+		_spec.SetField(course.FieldMaxStudents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStudents(); ok {
+		_spec.AddField(course.FieldMaxStudents, field.TypeInt, value)
+	}
 	if _u.mutation.TeacherCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1038,6 +2060,321 @@ func (_u *CourseUpdateOne) sqlSave(ctx context.Context) (_node *Course, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LessonPackagesTable,
+			Columns: []string{course.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPricesIDs(); len(nodes) > 0 && !_u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.PricesTable,
+			Columns: []string{course.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillingTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillingTermsIDs(); len(nodes) > 0 && !_u.mutation.BillingTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.BillingTermsTable,
+			Columns: []string{course.BillingTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcusedAbsencesIDs(); len(nodes) > 0 && !_u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ExcusedAbsencesTable,
+			Columns: []string{course.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !_u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.WaitlistEntriesTable,
+			Columns: []string{course.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadsIDs(); len(nodes) > 0 && !_u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.LeadsTable,
+			Columns: []string{course.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   course.ScheduleSlotsTable,
+			Columns: []string{course.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Course{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/courseprice"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CoursePrice is the model entity for the CoursePrice schema.
+type CoursePrice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID int `json:"course_id,omitempty"`
+	// EffectiveYear holds the value of the "effective_year" field.
+	EffectiveYear *int `json:"effective_year,omitempty"`
+	// EffectiveMonth holds the value of the "effective_month" field.
+	EffectiveMonth *int `json:"effective_month,omitempty"`
+	// LessonPriceCents holds the value of the "lesson_price_cents" field.
+	LessonPriceCents int64 `json:"lesson_price_cents,omitempty"`
+	// SubscriptionPriceCents holds the value of the "subscription_price_cents" field.
+	SubscriptionPriceCents int64 `json:"subscription_price_cents,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoursePriceQuery when eager-loading is set.
+	Edges        CoursePriceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CoursePriceEdges holds the relations/edges for other nodes in the graph.
+type CoursePriceEdges struct {
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoursePriceEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoursePrice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case courseprice.FieldID, courseprice.FieldCourseID, courseprice.FieldEffectiveYear, courseprice.FieldEffectiveMonth, courseprice.FieldLessonPriceCents, courseprice.FieldSubscriptionPriceCents:
+			values[i] = new(sql.NullInt64)
+		case courseprice.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case courseprice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoursePrice fields.
+func (_m *CoursePrice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case courseprice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case courseprice.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = int(value.Int64)
+			}
+		case courseprice.FieldEffectiveYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_year", values[i])
+			} else if value.Valid {
+				_m.EffectiveYear = new(int)
+				*_m.EffectiveYear = int(value.Int64)
+			}
+		case courseprice.FieldEffectiveMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_month", values[i])
+			} else if value.Valid {
+				_m.EffectiveMonth = new(int)
+				*_m.EffectiveMonth = int(value.Int64)
+			}
+		case courseprice.FieldLessonPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lesson_price_cents", values[i])
+			} else if value.Valid {
+				_m.LessonPriceCents = value.Int64
+			}
+		case courseprice.FieldSubscriptionPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_price_cents", values[i])
+			} else if value.Valid {
+				_m.SubscriptionPriceCents = value.Int64
+			}
+		case courseprice.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case courseprice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoursePrice.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoursePrice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCourse queries the "course" edge of the CoursePrice entity.
+func (_m *CoursePrice) QueryCourse() *CourseQuery {
+	return NewCoursePriceClient(_m.config).QueryCourse(_m)
+}
+
+// Update returns a builder for updating this CoursePrice.
+// Note that you need to call CoursePrice.Unwrap() before calling this method if this CoursePrice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoursePrice) Update() *CoursePriceUpdateOne {
+	return NewCoursePriceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoursePrice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoursePrice) Unwrap() *CoursePrice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoursePrice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoursePrice) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoursePrice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("course_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CourseID))
+	builder.WriteString(", ")
+	if v := _m.EffectiveYear; v != nil {
+		builder.WriteString("effective_year=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.EffectiveMonth; v != nil {
+		builder.WriteString("effective_month=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lesson_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LessonPriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionPriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoursePrices is a parsable slice of CoursePrice.
+type CoursePrices []*CoursePrice
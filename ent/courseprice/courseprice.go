@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package courseprice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the courseprice type in the database.
+	Label = "course_price"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCourseID holds the string denoting the course_id field in the database.
+	FieldCourseID = "course_id"
+	// FieldEffectiveYear holds the string denoting the effective_year field in the database.
+	FieldEffectiveYear = "effective_year"
+	// FieldEffectiveMonth holds the string denoting the effective_month field in the database.
+	FieldEffectiveMonth = "effective_month"
+	// FieldLessonPriceCents holds the string denoting the lesson_price_cents field in the database.
+	FieldLessonPriceCents = "lesson_price_cents"
+	// FieldSubscriptionPriceCents holds the string denoting the subscription_price_cents field in the database.
+	FieldSubscriptionPriceCents = "subscription_price_cents"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// Table holds the table name of the courseprice in the database.
+	Table = "course_prices"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "course_prices"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "course_id"
+)
+
+// Columns holds all SQL columns for courseprice fields.
+var Columns = []string{
+	FieldID,
+	FieldCourseID,
+	FieldEffectiveYear,
+	FieldEffectiveMonth,
+	FieldLessonPriceCents,
+	FieldSubscriptionPriceCents,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EffectiveMonthValidator is a validator for the "effective_month" field. It is called by the builders before save.
+	EffectiveMonthValidator func(int) error
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CoursePrice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCourseID orders the results by the course_id field.
+func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
+}
+
+// ByEffectiveYear orders the results by the effective_year field.
+func ByEffectiveYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveYear, opts...).ToFunc()
+}
+
+// ByEffectiveMonth orders the results by the effective_month field.
+func ByEffectiveMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveMonth, opts...).ToFunc()
+}
+
+// ByLessonPriceCents orders the results by the lesson_price_cents field.
+func ByLessonPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLessonPriceCents, opts...).ToFunc()
+}
+
+// BySubscriptionPriceCents orders the results by the subscription_price_cents field.
+func BySubscriptionPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionPriceCents, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+	)
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package courseprice
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldID, id))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCourseID, v))
+}
+
+// EffectiveYear applies equality check predicate on the "effective_year" field. It's identical to EffectiveYearEQ.
+func EffectiveYear(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveMonth applies equality check predicate on the "effective_month" field. It's identical to EffectiveMonthEQ.
+func EffectiveMonth(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// LessonPriceCents applies equality check predicate on the "lesson_price_cents" field. It's identical to LessonPriceCentsEQ.
+func LessonPriceCents(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldLessonPriceCents, v))
+}
+
+// SubscriptionPriceCents applies equality check predicate on the "subscription_price_cents" field. It's identical to SubscriptionPriceCentsEQ.
+func SubscriptionPriceCents(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldSubscriptionPriceCents, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// EffectiveYearEQ applies the EQ predicate on the "effective_year" field.
+func EffectiveYearEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveYearNEQ applies the NEQ predicate on the "effective_year" field.
+func EffectiveYearNEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveYearIn applies the In predicate on the "effective_year" field.
+func EffectiveYearIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldEffectiveYear, vs...))
+}
+
+// EffectiveYearNotIn applies the NotIn predicate on the "effective_year" field.
+func EffectiveYearNotIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldEffectiveYear, vs...))
+}
+
+// EffectiveYearGT applies the GT predicate on the "effective_year" field.
+func EffectiveYearGT(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldEffectiveYear, v))
+}
+
+// EffectiveYearGTE applies the GTE predicate on the "effective_year" field.
+func EffectiveYearGTE(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldEffectiveYear, v))
+}
+
+// EffectiveYearLT applies the LT predicate on the "effective_year" field.
+func EffectiveYearLT(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldEffectiveYear, v))
+}
+
+// EffectiveYearLTE applies the LTE predicate on the "effective_year" field.
+func EffectiveYearLTE(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldEffectiveYear, v))
+}
+
+// EffectiveYearIsNil applies the IsNil predicate on the "effective_year" field.
+func EffectiveYearIsNil() predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIsNull(FieldEffectiveYear))
+}
+
+// EffectiveYearNotNil applies the NotNil predicate on the "effective_year" field.
+func EffectiveYearNotNil() predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotNull(FieldEffectiveYear))
+}
+
+// EffectiveMonthEQ applies the EQ predicate on the "effective_month" field.
+func EffectiveMonthEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthNEQ applies the NEQ predicate on the "effective_month" field.
+func EffectiveMonthNEQ(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthIn applies the In predicate on the "effective_month" field.
+func EffectiveMonthIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthNotIn applies the NotIn predicate on the "effective_month" field.
+func EffectiveMonthNotIn(vs ...int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthGT applies the GT predicate on the "effective_month" field.
+func EffectiveMonthGT(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthGTE applies the GTE predicate on the "effective_month" field.
+func EffectiveMonthGTE(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLT applies the LT predicate on the "effective_month" field.
+func EffectiveMonthLT(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLTE applies the LTE predicate on the "effective_month" field.
+func EffectiveMonthLTE(v int) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthIsNil applies the IsNil predicate on the "effective_month" field.
+func EffectiveMonthIsNil() predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIsNull(FieldEffectiveMonth))
+}
+
+// EffectiveMonthNotNil applies the NotNil predicate on the "effective_month" field.
+func EffectiveMonthNotNil() predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotNull(FieldEffectiveMonth))
+}
+
+// LessonPriceCentsEQ applies the EQ predicate on the "lesson_price_cents" field.
+func LessonPriceCentsEQ(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldLessonPriceCents, v))
+}
+
+// LessonPriceCentsNEQ applies the NEQ predicate on the "lesson_price_cents" field.
+func LessonPriceCentsNEQ(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldLessonPriceCents, v))
+}
+
+// LessonPriceCentsIn applies the In predicate on the "lesson_price_cents" field.
+func LessonPriceCentsIn(vs ...int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldLessonPriceCents, vs...))
+}
+
+// LessonPriceCentsNotIn applies the NotIn predicate on the "lesson_price_cents" field.
+func LessonPriceCentsNotIn(vs ...int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldLessonPriceCents, vs...))
+}
+
+// LessonPriceCentsGT applies the GT predicate on the "lesson_price_cents" field.
+func LessonPriceCentsGT(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldLessonPriceCents, v))
+}
+
+// LessonPriceCentsGTE applies the GTE predicate on the "lesson_price_cents" field.
+func LessonPriceCentsGTE(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldLessonPriceCents, v))
+}
+
+// LessonPriceCentsLT applies the LT predicate on the "lesson_price_cents" field.
+func LessonPriceCentsLT(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldLessonPriceCents, v))
+}
+
+// LessonPriceCentsLTE applies the LTE predicate on the "lesson_price_cents" field.
+func LessonPriceCentsLTE(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldLessonPriceCents, v))
+}
+
+// SubscriptionPriceCentsEQ applies the EQ predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsEQ(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldSubscriptionPriceCents, v))
+}
+
+// SubscriptionPriceCentsNEQ applies the NEQ predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsNEQ(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldSubscriptionPriceCents, v))
+}
+
+// SubscriptionPriceCentsIn applies the In predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsIn(vs ...int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldSubscriptionPriceCents, vs...))
+}
+
+// SubscriptionPriceCentsNotIn applies the NotIn predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsNotIn(vs ...int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldSubscriptionPriceCents, vs...))
+}
+
+// SubscriptionPriceCentsGT applies the GT predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsGT(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldSubscriptionPriceCents, v))
+}
+
+// SubscriptionPriceCentsGTE applies the GTE predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsGTE(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldSubscriptionPriceCents, v))
+}
+
+// SubscriptionPriceCentsLT applies the LT predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsLT(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldSubscriptionPriceCents, v))
+}
+
+// SubscriptionPriceCentsLTE applies the LTE predicate on the "subscription_price_cents" field.
+func SubscriptionPriceCentsLTE(v int64) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldSubscriptionPriceCents, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.CoursePrice {
+	return predicate.CoursePrice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.CoursePrice {
+	return predicate.CoursePrice(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoursePrice) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoursePrice) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoursePrice) predicate.CoursePrice {
+	return predicate.CoursePrice(sql.NotPredicates(p))
+}
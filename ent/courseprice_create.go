@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/courseprice"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CoursePriceCreate is the builder for creating a CoursePrice entity.
+type CoursePriceCreate struct {
+	config
+	mutation *CoursePriceMutation
+	hooks    []Hook
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *CoursePriceCreate) SetCourseID(v int) *CoursePriceCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_c *CoursePriceCreate) SetEffectiveYear(v int) *CoursePriceCreate {
+	_c.mutation.SetEffectiveYear(v)
+	return _c
+}
+
+// SetNillableEffectiveYear sets the "effective_year" field if the given value is not nil.
+func (_c *CoursePriceCreate) SetNillableEffectiveYear(v *int) *CoursePriceCreate {
+	if v != nil {
+		_c.SetEffectiveYear(*v)
+	}
+	return _c
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_c *CoursePriceCreate) SetEffectiveMonth(v int) *CoursePriceCreate {
+	_c.mutation.SetEffectiveMonth(v)
+	return _c
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (_c *CoursePriceCreate) SetNillableEffectiveMonth(v *int) *CoursePriceCreate {
+	if v != nil {
+		_c.SetEffectiveMonth(*v)
+	}
+	return _c
+}
+
+// SetLessonPriceCents sets the "lesson_price_cents" field.
+func (_c *CoursePriceCreate) SetLessonPriceCents(v int64) *CoursePriceCreate {
+	_c.mutation.SetLessonPriceCents(v)
+	return _c
+}
+
+// SetSubscriptionPriceCents sets the "subscription_price_cents" field.
+func (_c *CoursePriceCreate) SetSubscriptionPriceCents(v int64) *CoursePriceCreate {
+	_c.mutation.SetSubscriptionPriceCents(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *CoursePriceCreate) SetCreatedBy(v string) *CoursePriceCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *CoursePriceCreate) SetNillableCreatedBy(v *string) *CoursePriceCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoursePriceCreate) SetCreatedAt(v time.Time) *CoursePriceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoursePriceCreate) SetNillableCreatedAt(v *time.Time) *CoursePriceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *CoursePriceCreate) SetCourse(v *Course) *CoursePriceCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// Mutation returns the CoursePriceMutation object of the builder.
+func (_c *CoursePriceCreate) Mutation() *CoursePriceMutation {
+	return _c.mutation
+}
+
+// Save creates the CoursePrice in the database.
+func (_c *CoursePriceCreate) Save(ctx context.Context) (*CoursePrice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoursePriceCreate) SaveX(ctx context.Context) *CoursePrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoursePriceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoursePriceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoursePriceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := courseprice.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := courseprice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoursePriceCreate) check() error {
+	if _, ok := _c.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "CoursePrice.course_id"`)}
+	}
+	if v, ok := _c.mutation.EffectiveMonth(); ok {
+		if err := courseprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "CoursePrice.effective_month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LessonPriceCents(); !ok {
+		return &ValidationError{Name: "lesson_price_cents", err: errors.New(`ent: missing required field "CoursePrice.lesson_price_cents"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionPriceCents(); !ok {
+		return &ValidationError{Name: "subscription_price_cents", err: errors.New(`ent: missing required field "CoursePrice.subscription_price_cents"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "CoursePrice.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoursePrice.created_at"`)}
+	}
+	if len(_c.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "CoursePrice.course"`)}
+	}
+	return nil
+}
+
+func (_c *CoursePriceCreate) sqlSave(ctx context.Context) (*CoursePrice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoursePriceCreate) createSpec() (*CoursePrice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoursePrice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(courseprice.Table, sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EffectiveYear(); ok {
+		_spec.SetField(courseprice.FieldEffectiveYear, field.TypeInt, value)
+		_node.EffectiveYear = &value
+	}
+	if value, ok := _c.mutation.EffectiveMonth(); ok {
+		_spec.SetField(courseprice.FieldEffectiveMonth, field.TypeInt, value)
+		_node.EffectiveMonth = &value
+	}
+	if value, ok := _c.mutation.LessonPriceCents(); ok {
+		_spec.SetField(courseprice.FieldLessonPriceCents, field.TypeInt64, value)
+		_node.LessonPriceCents = value
+	}
+	if value, ok := _c.mutation.SubscriptionPriceCents(); ok {
+		_spec.SetField(courseprice.FieldSubscriptionPriceCents, field.TypeInt64, value)
+		_node.SubscriptionPriceCents = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(courseprice.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(courseprice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseprice.CourseTable,
+			Columns: []string{courseprice.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CoursePriceCreateBulk is the builder for creating many CoursePrice entities in bulk.
+type CoursePriceCreateBulk struct {
+	config
+	err      error
+	builders []*CoursePriceCreate
+}
+
+// Save creates the CoursePrice entities in the database.
+func (_c *CoursePriceCreateBulk) Save(ctx context.Context) ([]*CoursePrice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoursePrice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoursePriceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoursePriceCreateBulk) SaveX(ctx context.Context) []*CoursePrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoursePriceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoursePriceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
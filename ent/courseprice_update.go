@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/courseprice"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CoursePriceUpdate is the builder for updating CoursePrice entities.
+type CoursePriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CoursePriceMutation
+}
+
+// Where appends a list predicates to the CoursePriceUpdate builder.
+func (_u *CoursePriceUpdate) Where(ps ...predicate.CoursePrice) *CoursePriceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *CoursePriceUpdate) SetCourseID(v int) *CoursePriceUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableCourseID(v *int) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_u *CoursePriceUpdate) SetEffectiveYear(v int) *CoursePriceUpdate {
+	_u.mutation.ResetEffectiveYear()
+	_u.mutation.SetEffectiveYear(v)
+	return _u
+}
+
+// SetNillableEffectiveYear sets the "effective_year" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableEffectiveYear(v *int) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetEffectiveYear(*v)
+	}
+	return _u
+}
+
+// AddEffectiveYear adds value to the "effective_year" field.
+func (_u *CoursePriceUpdate) AddEffectiveYear(v int) *CoursePriceUpdate {
+	_u.mutation.AddEffectiveYear(v)
+	return _u
+}
+
+// ClearEffectiveYear clears the value of the "effective_year" field.
+func (_u *CoursePriceUpdate) ClearEffectiveYear() *CoursePriceUpdate {
+	_u.mutation.ClearEffectiveYear()
+	return _u
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_u *CoursePriceUpdate) SetEffectiveMonth(v int) *CoursePriceUpdate {
+	_u.mutation.ResetEffectiveMonth()
+	_u.mutation.SetEffectiveMonth(v)
+	return _u
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableEffectiveMonth(v *int) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetEffectiveMonth(*v)
+	}
+	return _u
+}
+
+// AddEffectiveMonth adds value to the "effective_month" field.
+func (_u *CoursePriceUpdate) AddEffectiveMonth(v int) *CoursePriceUpdate {
+	_u.mutation.AddEffectiveMonth(v)
+	return _u
+}
+
+// ClearEffectiveMonth clears the value of the "effective_month" field.
+func (_u *CoursePriceUpdate) ClearEffectiveMonth() *CoursePriceUpdate {
+	_u.mutation.ClearEffectiveMonth()
+	return _u
+}
+
+// SetLessonPriceCents sets the "lesson_price_cents" field.
+func (_u *CoursePriceUpdate) SetLessonPriceCents(v int64) *CoursePriceUpdate {
+	_u.mutation.ResetLessonPriceCents()
+	_u.mutation.SetLessonPriceCents(v)
+	return _u
+}
+
+// SetNillableLessonPriceCents sets the "lesson_price_cents" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableLessonPriceCents(v *int64) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetLessonPriceCents(*v)
+	}
+	return _u
+}
+
+// AddLessonPriceCents adds value to the "lesson_price_cents" field.
+func (_u *CoursePriceUpdate) AddLessonPriceCents(v int64) *CoursePriceUpdate {
+	_u.mutation.AddLessonPriceCents(v)
+	return _u
+}
+
+// SetSubscriptionPriceCents sets the "subscription_price_cents" field.
+func (_u *CoursePriceUpdate) SetSubscriptionPriceCents(v int64) *CoursePriceUpdate {
+	_u.mutation.ResetSubscriptionPriceCents()
+	_u.mutation.SetSubscriptionPriceCents(v)
+	return _u
+}
+
+// SetNillableSubscriptionPriceCents sets the "subscription_price_cents" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableSubscriptionPriceCents(v *int64) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetSubscriptionPriceCents(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionPriceCents adds value to the "subscription_price_cents" field.
+func (_u *CoursePriceUpdate) AddSubscriptionPriceCents(v int64) *CoursePriceUpdate {
+	_u.mutation.AddSubscriptionPriceCents(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CoursePriceUpdate) SetCreatedBy(v string) *CoursePriceUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableCreatedBy(v *string) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CoursePriceUpdate) SetCreatedAt(v time.Time) *CoursePriceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CoursePriceUpdate) SetNillableCreatedAt(v *time.Time) *CoursePriceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *CoursePriceUpdate) SetCourse(v *Course) *CoursePriceUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// Mutation returns the CoursePriceMutation object of the builder.
+func (_u *CoursePriceUpdate) Mutation() *CoursePriceMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *CoursePriceUpdate) ClearCourse() *CoursePriceUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoursePriceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoursePriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoursePriceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoursePriceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CoursePriceUpdate) check() error {
+	if v, ok := _u.mutation.EffectiveMonth(); ok {
+		if err := courseprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "CoursePrice.effective_month": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CoursePrice.course"`)
+	}
+	return nil
+}
+
+func (_u *CoursePriceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(courseprice.Table, courseprice.Columns, sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EffectiveYear(); ok {
+		_spec.SetField(courseprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveYear(); ok {
+		_spec.AddField(courseprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if _u.mutation.EffectiveYearCleared() {
+		_spec.ClearField(courseprice.FieldEffectiveYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EffectiveMonth(); ok {
+		_spec.SetField(courseprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(courseprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if _u.mutation.EffectiveMonthCleared() {
+		_spec.ClearField(courseprice.FieldEffectiveMonth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LessonPriceCents(); ok {
+		_spec.SetField(courseprice.FieldLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLessonPriceCents(); ok {
+		_spec.AddField(courseprice.FieldLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionPriceCents(); ok {
+		_spec.SetField(courseprice.FieldSubscriptionPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionPriceCents(); ok {
+		_spec.AddField(courseprice.FieldSubscriptionPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(courseprice.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(courseprice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseprice.CourseTable,
+			Columns: []string{courseprice.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseprice.CourseTable,
+			Columns: []string{courseprice.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{courseprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoursePriceUpdateOne is the builder for updating a single CoursePrice entity.
+type CoursePriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CoursePriceMutation
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *CoursePriceUpdateOne) SetCourseID(v int) *CoursePriceUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableCourseID(v *int) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_u *CoursePriceUpdateOne) SetEffectiveYear(v int) *CoursePriceUpdateOne {
+	_u.mutation.ResetEffectiveYear()
+	_u.mutation.SetEffectiveYear(v)
+	return _u
+}
+
+// SetNillableEffectiveYear sets the "effective_year" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableEffectiveYear(v *int) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveYear(*v)
+	}
+	return _u
+}
+
+// AddEffectiveYear adds value to the "effective_year" field.
+func (_u *CoursePriceUpdateOne) AddEffectiveYear(v int) *CoursePriceUpdateOne {
+	_u.mutation.AddEffectiveYear(v)
+	return _u
+}
+
+// ClearEffectiveYear clears the value of the "effective_year" field.
+func (_u *CoursePriceUpdateOne) ClearEffectiveYear() *CoursePriceUpdateOne {
+	_u.mutation.ClearEffectiveYear()
+	return _u
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_u *CoursePriceUpdateOne) SetEffectiveMonth(v int) *CoursePriceUpdateOne {
+	_u.mutation.ResetEffectiveMonth()
+	_u.mutation.SetEffectiveMonth(v)
+	return _u
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableEffectiveMonth(v *int) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveMonth(*v)
+	}
+	return _u
+}
+
+// AddEffectiveMonth adds value to the "effective_month" field.
+func (_u *CoursePriceUpdateOne) AddEffectiveMonth(v int) *CoursePriceUpdateOne {
+	_u.mutation.AddEffectiveMonth(v)
+	return _u
+}
+
+// ClearEffectiveMonth clears the value of the "effective_month" field.
+func (_u *CoursePriceUpdateOne) ClearEffectiveMonth() *CoursePriceUpdateOne {
+	_u.mutation.ClearEffectiveMonth()
+	return _u
+}
+
+// SetLessonPriceCents sets the "lesson_price_cents" field.
+func (_u *CoursePriceUpdateOne) SetLessonPriceCents(v int64) *CoursePriceUpdateOne {
+	_u.mutation.ResetLessonPriceCents()
+	_u.mutation.SetLessonPriceCents(v)
+	return _u
+}
+
+// SetNillableLessonPriceCents sets the "lesson_price_cents" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableLessonPriceCents(v *int64) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetLessonPriceCents(*v)
+	}
+	return _u
+}
+
+// AddLessonPriceCents adds value to the "lesson_price_cents" field.
+func (_u *CoursePriceUpdateOne) AddLessonPriceCents(v int64) *CoursePriceUpdateOne {
+	_u.mutation.AddLessonPriceCents(v)
+	return _u
+}
+
+// SetSubscriptionPriceCents sets the "subscription_price_cents" field.
+func (_u *CoursePriceUpdateOne) SetSubscriptionPriceCents(v int64) *CoursePriceUpdateOne {
+	_u.mutation.ResetSubscriptionPriceCents()
+	_u.mutation.SetSubscriptionPriceCents(v)
+	return _u
+}
+
+// SetNillableSubscriptionPriceCents sets the "subscription_price_cents" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableSubscriptionPriceCents(v *int64) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionPriceCents(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionPriceCents adds value to the "subscription_price_cents" field.
+func (_u *CoursePriceUpdateOne) AddSubscriptionPriceCents(v int64) *CoursePriceUpdateOne {
+	_u.mutation.AddSubscriptionPriceCents(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CoursePriceUpdateOne) SetCreatedBy(v string) *CoursePriceUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableCreatedBy(v *string) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CoursePriceUpdateOne) SetCreatedAt(v time.Time) *CoursePriceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CoursePriceUpdateOne) SetNillableCreatedAt(v *time.Time) *CoursePriceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *CoursePriceUpdateOne) SetCourse(v *Course) *CoursePriceUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// Mutation returns the CoursePriceMutation object of the builder.
+func (_u *CoursePriceUpdateOne) Mutation() *CoursePriceMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *CoursePriceUpdateOne) ClearCourse() *CoursePriceUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// Where appends a list predicates to the CoursePriceUpdate builder.
+func (_u *CoursePriceUpdateOne) Where(ps ...predicate.CoursePrice) *CoursePriceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoursePriceUpdateOne) Select(field string, fields ...string) *CoursePriceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoursePrice entity.
+func (_u *CoursePriceUpdateOne) Save(ctx context.Context) (*CoursePrice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoursePriceUpdateOne) SaveX(ctx context.Context) *CoursePrice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoursePriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoursePriceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CoursePriceUpdateOne) check() error {
+	if v, ok := _u.mutation.EffectiveMonth(); ok {
+		if err := courseprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "CoursePrice.effective_month": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CoursePrice.course"`)
+	}
+	return nil
+}
+
+func (_u *CoursePriceUpdateOne) sqlSave(ctx context.Context) (_node *CoursePrice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(courseprice.Table, courseprice.Columns, sqlgraph.NewFieldSpec(courseprice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoursePrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, courseprice.FieldID)
+		for _, f := range fields {
+			if !courseprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != courseprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EffectiveYear(); ok {
+		_spec.SetField(courseprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveYear(); ok {
+		_spec.AddField(courseprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if _u.mutation.EffectiveYearCleared() {
+		_spec.ClearField(courseprice.FieldEffectiveYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EffectiveMonth(); ok {
+		_spec.SetField(courseprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(courseprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if _u.mutation.EffectiveMonthCleared() {
+		_spec.ClearField(courseprice.FieldEffectiveMonth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LessonPriceCents(); ok {
+		_spec.SetField(courseprice.FieldLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLessonPriceCents(); ok {
+		_spec.AddField(courseprice.FieldLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionPriceCents(); ok {
+		_spec.SetField(courseprice.FieldSubscriptionPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionPriceCents(); ok {
+		_spec.AddField(courseprice.FieldSubscriptionPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(courseprice.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(courseprice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseprice.CourseTable,
+			Columns: []string{courseprice.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   courseprice.CourseTable,
+			Columns: []string{courseprice.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CoursePrice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{courseprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
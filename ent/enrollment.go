@@ -37,6 +37,10 @@ type Enrollment struct {
 	SubscriptionLessonPriceCents int64 `json:"subscription_lesson_price_cents,omitempty"`
 	// Note holds the value of the "note" field.
 	Note string `json:"note,omitempty"`
+	// StartsOn holds the value of the "starts_on" field.
+	StartsOn *time.Time `json:"starts_on,omitempty"`
+	// EndsOn holds the value of the "ends_on" field.
+	EndsOn *time.Time `json:"ends_on,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -55,9 +59,13 @@ type EnrollmentEdges struct {
 	Course *Course `json:"course,omitempty"`
 	// InvoiceLines holds the value of the invoice_lines edge.
 	InvoiceLines []*InvoiceLine `json:"invoice_lines,omitempty"`
+	// Prices holds the value of the prices edge.
+	Prices []*EnrollmentPrice `json:"prices,omitempty"`
+	// Pauses holds the value of the pauses edge.
+	Pauses []*EnrollmentPause `json:"pauses,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // StudentOrErr returns the Student value or an error if the edge
@@ -91,6 +99,24 @@ func (e EnrollmentEdges) InvoiceLinesOrErr() ([]*InvoiceLine, error) {
 	return nil, &NotLoadedError{edge: "invoice_lines"}
 }
 
+// PricesOrErr returns the Prices value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnrollmentEdges) PricesOrErr() ([]*EnrollmentPrice, error) {
+	if e.loadedTypes[3] {
+		return e.Prices, nil
+	}
+	return nil, &NotLoadedError{edge: "prices"}
+}
+
+// PausesOrErr returns the Pauses value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnrollmentEdges) PausesOrErr() ([]*EnrollmentPause, error) {
+	if e.loadedTypes[4] {
+		return e.Pauses, nil
+	}
+	return nil, &NotLoadedError{edge: "pauses"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Enrollment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -104,7 +130,7 @@ func (*Enrollment) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case enrollment.FieldBillingMode, enrollment.FieldNote:
 			values[i] = new(sql.NullString)
-		case enrollment.FieldCreatedAt, enrollment.FieldUpdatedAt:
+		case enrollment.FieldStartsOn, enrollment.FieldEndsOn, enrollment.FieldCreatedAt, enrollment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -181,6 +207,20 @@ func (_m *Enrollment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Note = value.String
 			}
+		case enrollment.FieldStartsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_on", values[i])
+			} else if value.Valid {
+				_m.StartsOn = new(time.Time)
+				*_m.StartsOn = value.Time
+			}
+		case enrollment.FieldEndsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_on", values[i])
+			} else if value.Valid {
+				_m.EndsOn = new(time.Time)
+				*_m.EndsOn = value.Time
+			}
 		case enrollment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -223,6 +263,16 @@ func (_m *Enrollment) QueryInvoiceLines() *InvoiceLineQuery {
 	return NewEnrollmentClient(_m.config).QueryInvoiceLines(_m)
 }
 
+// QueryPrices queries the "prices" edge of the Enrollment entity.
+func (_m *Enrollment) QueryPrices() *EnrollmentPriceQuery {
+	return NewEnrollmentClient(_m.config).QueryPrices(_m)
+}
+
+// QueryPauses queries the "pauses" edge of the Enrollment entity.
+func (_m *Enrollment) QueryPauses() *EnrollmentPauseQuery {
+	return NewEnrollmentClient(_m.config).QueryPauses(_m)
+}
+
 // Update returns a builder for updating this Enrollment.
 // Note that you need to call Enrollment.Unwrap() before calling this method if this Enrollment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -273,6 +323,16 @@ func (_m *Enrollment) String() string {
 	builder.WriteString("note=")
 	builder.WriteString(_m.Note)
 	builder.WriteString(", ")
+	if v := _m.StartsOn; v != nil {
+		builder.WriteString("starts_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndsOn; v != nil {
+		builder.WriteString("ends_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
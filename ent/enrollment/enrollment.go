@@ -33,6 +33,10 @@ const (
 	FieldSubscriptionLessonPriceCents = "subscription_lesson_price_cents"
 	// FieldNote holds the string denoting the note field in the database.
 	FieldNote = "note"
+	// FieldStartsOn holds the string denoting the starts_on field in the database.
+	FieldStartsOn = "starts_on"
+	// FieldEndsOn holds the string denoting the ends_on field in the database.
+	FieldEndsOn = "ends_on"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -43,6 +47,10 @@ const (
 	EdgeCourse = "course"
 	// EdgeInvoiceLines holds the string denoting the invoice_lines edge name in mutations.
 	EdgeInvoiceLines = "invoice_lines"
+	// EdgePrices holds the string denoting the prices edge name in mutations.
+	EdgePrices = "prices"
+	// EdgePauses holds the string denoting the pauses edge name in mutations.
+	EdgePauses = "pauses"
 	// Table holds the table name of the enrollment in the database.
 	Table = "enrollments"
 	// StudentTable is the table that holds the student relation/edge.
@@ -66,6 +74,20 @@ const (
 	InvoiceLinesInverseTable = "invoice_lines"
 	// InvoiceLinesColumn is the table column denoting the invoice_lines relation/edge.
 	InvoiceLinesColumn = "enrollment_id"
+	// PricesTable is the table that holds the prices relation/edge.
+	PricesTable = "enrollment_prices"
+	// PricesInverseTable is the table name for the EnrollmentPrice entity.
+	// It exists in this package in order to avoid circular dependency with the "enrollmentprice" package.
+	PricesInverseTable = "enrollment_prices"
+	// PricesColumn is the table column denoting the prices relation/edge.
+	PricesColumn = "enrollment_id"
+	// PausesTable is the table that holds the pauses relation/edge.
+	PausesTable = "enrollment_pauses"
+	// PausesInverseTable is the table name for the EnrollmentPause entity.
+	// It exists in this package in order to avoid circular dependency with the "enrollmentpause" package.
+	PausesInverseTable = "enrollment_pauses"
+	// PausesColumn is the table column denoting the pauses relation/edge.
+	PausesColumn = "enrollment_id"
 )
 
 // Columns holds all SQL columns for enrollment fields.
@@ -80,6 +102,8 @@ var Columns = []string{
 	FieldLessonPriceOverrideCents,
 	FieldSubscriptionLessonPriceCents,
 	FieldNote,
+	FieldStartsOn,
+	FieldEndsOn,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -122,6 +146,7 @@ type BillingMode string
 const (
 	BillingModeSubscription BillingMode = "subscription"
 	BillingModePerLesson    BillingMode = "per_lesson"
+	BillingModePackage      BillingMode = "package"
 )
 
 func (bm BillingMode) String() string {
@@ -131,7 +156,7 @@ func (bm BillingMode) String() string {
 // BillingModeValidator is a validator for the "billing_mode" field enum values. It is called by the builders before save.
 func BillingModeValidator(bm BillingMode) error {
 	switch bm {
-	case BillingModeSubscription, BillingModePerLesson:
+	case BillingModeSubscription, BillingModePerLesson, BillingModePackage:
 		return nil
 	default:
 		return fmt.Errorf("enrollment: invalid enum value for billing_mode field: %q", bm)
@@ -191,6 +216,16 @@ func ByNote(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNote, opts...).ToFunc()
 }
 
+// ByStartsOn orders the results by the starts_on field.
+func ByStartsOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsOn, opts...).ToFunc()
+}
+
+// ByEndsOn orders the results by the ends_on field.
+func ByEndsOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsOn, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -228,6 +263,34 @@ func ByInvoiceLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInvoiceLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPricesCount orders the results by prices count.
+func ByPricesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPricesStep(), opts...)
+	}
+}
+
+// ByPrices orders the results by prices terms.
+func ByPrices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPricesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPausesCount orders the results by pauses count.
+func ByPausesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPausesStep(), opts...)
+	}
+}
+
+// ByPauses orders the results by pauses terms.
+func ByPauses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPausesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newStudentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -249,3 +312,17 @@ func newInvoiceLinesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InvoiceLinesTable, InvoiceLinesColumn),
 	)
 }
+func newPricesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PricesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PricesTable, PricesColumn),
+	)
+}
+func newPausesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PausesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PausesTable, PausesColumn),
+	)
+}
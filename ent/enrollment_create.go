@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"langschool/ent/course"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/student"
 	"time"
@@ -125,6 +127,34 @@ func (_c *EnrollmentCreate) SetNillableNote(v *string) *EnrollmentCreate {
 	return _c
 }
 
+// SetStartsOn sets the "starts_on" field.
+func (_c *EnrollmentCreate) SetStartsOn(v time.Time) *EnrollmentCreate {
+	_c.mutation.SetStartsOn(v)
+	return _c
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_c *EnrollmentCreate) SetNillableStartsOn(v *time.Time) *EnrollmentCreate {
+	if v != nil {
+		_c.SetStartsOn(*v)
+	}
+	return _c
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_c *EnrollmentCreate) SetEndsOn(v time.Time) *EnrollmentCreate {
+	_c.mutation.SetEndsOn(v)
+	return _c
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_c *EnrollmentCreate) SetNillableEndsOn(v *time.Time) *EnrollmentCreate {
+	if v != nil {
+		_c.SetEndsOn(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EnrollmentCreate) SetCreatedAt(v time.Time) *EnrollmentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -178,6 +208,36 @@ func (_c *EnrollmentCreate) AddInvoiceLines(v ...*InvoiceLine) *EnrollmentCreate
 	return _c.AddInvoiceLineIDs(ids...)
 }
 
+// AddPriceIDs adds the "prices" edge to the EnrollmentPrice entity by IDs.
+func (_c *EnrollmentCreate) AddPriceIDs(ids ...int) *EnrollmentCreate {
+	_c.mutation.AddPriceIDs(ids...)
+	return _c
+}
+
+// AddPrices adds the "prices" edges to the EnrollmentPrice entity.
+func (_c *EnrollmentCreate) AddPrices(v ...*EnrollmentPrice) *EnrollmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPriceIDs(ids...)
+}
+
+// AddPauseIDs adds the "pauses" edge to the EnrollmentPause entity by IDs.
+func (_c *EnrollmentCreate) AddPauseIDs(ids ...int) *EnrollmentCreate {
+	_c.mutation.AddPauseIDs(ids...)
+	return _c
+}
+
+// AddPauses adds the "pauses" edges to the EnrollmentPause entity.
+func (_c *EnrollmentCreate) AddPauses(v ...*EnrollmentPause) *EnrollmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPauseIDs(ids...)
+}
+
 // Mutation returns the EnrollmentMutation object of the builder.
 func (_c *EnrollmentCreate) Mutation() *EnrollmentMutation {
 	return _c.mutation
@@ -341,6 +401,14 @@ func (_c *EnrollmentCreate) createSpec() (*Enrollment, *sqlgraph.CreateSpec) {
 		_spec.SetField(enrollment.FieldNote, field.TypeString, value)
 		_node.Note = value
 	}
+	if value, ok := _c.mutation.StartsOn(); ok {
+		_spec.SetField(enrollment.FieldStartsOn, field.TypeTime, value)
+		_node.StartsOn = &value
+	}
+	if value, ok := _c.mutation.EndsOn(); ok {
+		_spec.SetField(enrollment.FieldEndsOn, field.TypeTime, value)
+		_node.EndsOn = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(enrollment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -399,6 +467,38 @@ func (_c *EnrollmentCreate) createSpec() (*Enrollment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
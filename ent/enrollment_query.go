@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"langschool/ent/course"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
@@ -29,6 +31,8 @@ type EnrollmentQuery struct {
 	withStudent      *StudentQuery
 	withCourse       *CourseQuery
 	withInvoiceLines *InvoiceLineQuery
+	withPrices       *EnrollmentPriceQuery
+	withPauses       *EnrollmentPauseQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +135,50 @@ func (_q *EnrollmentQuery) QueryInvoiceLines() *InvoiceLineQuery {
 	return query
 }
 
+// QueryPrices chains the current query on the "prices" edge.
+func (_q *EnrollmentQuery) QueryPrices() *EnrollmentPriceQuery {
+	query := (&EnrollmentPriceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, selector),
+			sqlgraph.To(enrollmentprice.Table, enrollmentprice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.PricesTable, enrollment.PricesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPauses chains the current query on the "pauses" edge.
+func (_q *EnrollmentQuery) QueryPauses() *EnrollmentPauseQuery {
+	query := (&EnrollmentPauseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(enrollment.Table, enrollment.FieldID, selector),
+			sqlgraph.To(enrollmentpause.Table, enrollmentpause.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, enrollment.PausesTable, enrollment.PausesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Enrollment entity from the query.
 // Returns a *NotFoundError when no Enrollment was found.
 func (_q *EnrollmentQuery) First(ctx context.Context) (*Enrollment, error) {
@@ -326,6 +374,8 @@ func (_q *EnrollmentQuery) Clone() *EnrollmentQuery {
 		withStudent:      _q.withStudent.Clone(),
 		withCourse:       _q.withCourse.Clone(),
 		withInvoiceLines: _q.withInvoiceLines.Clone(),
+		withPrices:       _q.withPrices.Clone(),
+		withPauses:       _q.withPauses.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +415,28 @@ func (_q *EnrollmentQuery) WithInvoiceLines(opts ...func(*InvoiceLineQuery)) *En
 	return _q
 }
 
+// WithPrices tells the query-builder to eager-load the nodes that are connected to
+// the "prices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnrollmentQuery) WithPrices(opts ...func(*EnrollmentPriceQuery)) *EnrollmentQuery {
+	query := (&EnrollmentPriceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrices = query
+	return _q
+}
+
+// WithPauses tells the query-builder to eager-load the nodes that are connected to
+// the "pauses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnrollmentQuery) WithPauses(opts ...func(*EnrollmentPauseQuery)) *EnrollmentQuery {
+	query := (&EnrollmentPauseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPauses = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +515,12 @@ func (_q *EnrollmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 	var (
 		nodes       = []*Enrollment{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withStudent != nil,
 			_q.withCourse != nil,
 			_q.withInvoiceLines != nil,
+			_q.withPrices != nil,
+			_q.withPauses != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -486,6 +560,20 @@ func (_q *EnrollmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 			return nil, err
 		}
 	}
+	if query := _q.withPrices; query != nil {
+		if err := _q.loadPrices(ctx, query, nodes,
+			func(n *Enrollment) { n.Edges.Prices = []*EnrollmentPrice{} },
+			func(n *Enrollment, e *EnrollmentPrice) { n.Edges.Prices = append(n.Edges.Prices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPauses; query != nil {
+		if err := _q.loadPauses(ctx, query, nodes,
+			func(n *Enrollment) { n.Edges.Pauses = []*EnrollmentPause{} },
+			func(n *Enrollment, e *EnrollmentPause) { n.Edges.Pauses = append(n.Edges.Pauses, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -567,6 +655,69 @@ func (_q *EnrollmentQuery) loadInvoiceLines(ctx context.Context, query *InvoiceL
 	if err != nil {
 		return err
 	}
+	for _, n := range neighbors {
+		fk := n.EnrollmentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "enrollment_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "enrollment_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EnrollmentQuery) loadPrices(ctx context.Context, query *EnrollmentPriceQuery, nodes []*Enrollment, init func(*Enrollment), assign func(*Enrollment, *EnrollmentPrice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Enrollment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(enrollmentprice.FieldEnrollmentID)
+	}
+	query.Where(predicate.EnrollmentPrice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(enrollment.PricesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnrollmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "enrollment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EnrollmentQuery) loadPauses(ctx context.Context, query *EnrollmentPauseQuery, nodes []*Enrollment, init func(*Enrollment), assign func(*Enrollment, *EnrollmentPause)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Enrollment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(enrollmentpause.FieldEnrollmentID)
+	}
+	query.Where(predicate.EnrollmentPause(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(enrollment.PausesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
 	for _, n := range neighbors {
 		fk := n.EnrollmentID
 		node, ok := nodeids[fk]
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"langschool/ent/course"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
@@ -185,6 +187,46 @@ func (_u *EnrollmentUpdate) SetNillableNote(v *string) *EnrollmentUpdate {
 	return _u
 }
 
+// SetStartsOn sets the "starts_on" field.
+func (_u *EnrollmentUpdate) SetStartsOn(v time.Time) *EnrollmentUpdate {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *EnrollmentUpdate) SetNillableStartsOn(v *time.Time) *EnrollmentUpdate {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// ClearStartsOn clears the value of the "starts_on" field.
+func (_u *EnrollmentUpdate) ClearStartsOn() *EnrollmentUpdate {
+	_u.mutation.ClearStartsOn()
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *EnrollmentUpdate) SetEndsOn(v time.Time) *EnrollmentUpdate {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *EnrollmentUpdate) SetNillableEndsOn(v *time.Time) *EnrollmentUpdate {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// ClearEndsOn clears the value of the "ends_on" field.
+func (_u *EnrollmentUpdate) ClearEndsOn() *EnrollmentUpdate {
+	_u.mutation.ClearEndsOn()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *EnrollmentUpdate) SetCreatedAt(v time.Time) *EnrollmentUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -242,6 +284,36 @@ func (_u *EnrollmentUpdate) AddInvoiceLines(v ...*InvoiceLine) *EnrollmentUpdate
 	return _u.AddInvoiceLineIDs(ids...)
 }
 
+// AddPriceIDs adds the "prices" edge to the EnrollmentPrice entity by IDs.
+func (_u *EnrollmentUpdate) AddPriceIDs(ids ...int) *EnrollmentUpdate {
+	_u.mutation.AddPriceIDs(ids...)
+	return _u
+}
+
+// AddPrices adds the "prices" edges to the EnrollmentPrice entity.
+func (_u *EnrollmentUpdate) AddPrices(v ...*EnrollmentPrice) *EnrollmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceIDs(ids...)
+}
+
+// AddPauseIDs adds the "pauses" edge to the EnrollmentPause entity by IDs.
+func (_u *EnrollmentUpdate) AddPauseIDs(ids ...int) *EnrollmentUpdate {
+	_u.mutation.AddPauseIDs(ids...)
+	return _u
+}
+
+// AddPauses adds the "pauses" edges to the EnrollmentPause entity.
+func (_u *EnrollmentUpdate) AddPauses(v ...*EnrollmentPause) *EnrollmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPauseIDs(ids...)
+}
+
 // Mutation returns the EnrollmentMutation object of the builder.
 func (_u *EnrollmentUpdate) Mutation() *EnrollmentMutation {
 	return _u.mutation
@@ -280,6 +352,48 @@ func (_u *EnrollmentUpdate) RemoveInvoiceLines(v ...*InvoiceLine) *EnrollmentUpd
 	return _u.RemoveInvoiceLineIDs(ids...)
 }
 
+// ClearPrices clears all "prices" edges to the EnrollmentPrice entity.
+func (_u *EnrollmentUpdate) ClearPrices() *EnrollmentUpdate {
+	_u.mutation.ClearPrices()
+	return _u
+}
+
+// RemovePriceIDs removes the "prices" edge to EnrollmentPrice entities by IDs.
+func (_u *EnrollmentUpdate) RemovePriceIDs(ids ...int) *EnrollmentUpdate {
+	_u.mutation.RemovePriceIDs(ids...)
+	return _u
+}
+
+// RemovePrices removes "prices" edges to EnrollmentPrice entities.
+func (_u *EnrollmentUpdate) RemovePrices(v ...*EnrollmentPrice) *EnrollmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceIDs(ids...)
+}
+
+// ClearPauses clears all "pauses" edges to the EnrollmentPause entity.
+func (_u *EnrollmentUpdate) ClearPauses() *EnrollmentUpdate {
+	_u.mutation.ClearPauses()
+	return _u
+}
+
+// RemovePauseIDs removes the "pauses" edge to EnrollmentPause entities by IDs.
+func (_u *EnrollmentUpdate) RemovePauseIDs(ids ...int) *EnrollmentUpdate {
+	_u.mutation.RemovePauseIDs(ids...)
+	return _u
+}
+
+// RemovePauses removes "pauses" edges to EnrollmentPause entities.
+func (_u *EnrollmentUpdate) RemovePauses(v ...*EnrollmentPause) *EnrollmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePauseIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EnrollmentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -377,6 +491,18 @@ func (_u *EnrollmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Note(); ok {
 		_spec.SetField(enrollment.FieldNote, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(enrollment.FieldStartsOn, field.TypeTime, value)
+	}
+	if _u.mutation.StartsOnCleared() {
+		_spec.ClearField(enrollment.FieldStartsOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(enrollment.FieldEndsOn, field.TypeTime, value)
+	}
+	if _u.mutation.EndsOnCleared() {
+		_spec.ClearField(enrollment.FieldEndsOn, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(enrollment.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -492,6 +618,96 @@ func (_u *EnrollmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPricesIDs(); len(nodes) > 0 && !_u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPausesIDs(); len(nodes) > 0 && !_u.mutation.PausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{enrollment.Label}
@@ -666,6 +882,46 @@ func (_u *EnrollmentUpdateOne) SetNillableNote(v *string) *EnrollmentUpdateOne {
 	return _u
 }
 
+// SetStartsOn sets the "starts_on" field.
+func (_u *EnrollmentUpdateOne) SetStartsOn(v time.Time) *EnrollmentUpdateOne {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *EnrollmentUpdateOne) SetNillableStartsOn(v *time.Time) *EnrollmentUpdateOne {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// ClearStartsOn clears the value of the "starts_on" field.
+func (_u *EnrollmentUpdateOne) ClearStartsOn() *EnrollmentUpdateOne {
+	_u.mutation.ClearStartsOn()
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *EnrollmentUpdateOne) SetEndsOn(v time.Time) *EnrollmentUpdateOne {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *EnrollmentUpdateOne) SetNillableEndsOn(v *time.Time) *EnrollmentUpdateOne {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// ClearEndsOn clears the value of the "ends_on" field.
+func (_u *EnrollmentUpdateOne) ClearEndsOn() *EnrollmentUpdateOne {
+	_u.mutation.ClearEndsOn()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *EnrollmentUpdateOne) SetCreatedAt(v time.Time) *EnrollmentUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -723,6 +979,36 @@ func (_u *EnrollmentUpdateOne) AddInvoiceLines(v ...*InvoiceLine) *EnrollmentUpd
 	return _u.AddInvoiceLineIDs(ids...)
 }
 
+// AddPriceIDs adds the "prices" edge to the EnrollmentPrice entity by IDs.
+func (_u *EnrollmentUpdateOne) AddPriceIDs(ids ...int) *EnrollmentUpdateOne {
+	_u.mutation.AddPriceIDs(ids...)
+	return _u
+}
+
+// AddPrices adds the "prices" edges to the EnrollmentPrice entity.
+func (_u *EnrollmentUpdateOne) AddPrices(v ...*EnrollmentPrice) *EnrollmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceIDs(ids...)
+}
+
+// AddPauseIDs adds the "pauses" edge to the EnrollmentPause entity by IDs.
+func (_u *EnrollmentUpdateOne) AddPauseIDs(ids ...int) *EnrollmentUpdateOne {
+	_u.mutation.AddPauseIDs(ids...)
+	return _u
+}
+
+// AddPauses adds the "pauses" edges to the EnrollmentPause entity.
+func (_u *EnrollmentUpdateOne) AddPauses(v ...*EnrollmentPause) *EnrollmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPauseIDs(ids...)
+}
+
 // Mutation returns the EnrollmentMutation object of the builder.
 func (_u *EnrollmentUpdateOne) Mutation() *EnrollmentMutation {
 	return _u.mutation
@@ -761,6 +1047,48 @@ func (_u *EnrollmentUpdateOne) RemoveInvoiceLines(v ...*InvoiceLine) *Enrollment
 	return _u.RemoveInvoiceLineIDs(ids...)
 }
 
+// ClearPrices clears all "prices" edges to the EnrollmentPrice entity.
+func (_u *EnrollmentUpdateOne) ClearPrices() *EnrollmentUpdateOne {
+	_u.mutation.ClearPrices()
+	return _u
+}
+
+// RemovePriceIDs removes the "prices" edge to EnrollmentPrice entities by IDs.
+func (_u *EnrollmentUpdateOne) RemovePriceIDs(ids ...int) *EnrollmentUpdateOne {
+	_u.mutation.RemovePriceIDs(ids...)
+	return _u
+}
+
+// RemovePrices removes "prices" edges to EnrollmentPrice entities.
+func (_u *EnrollmentUpdateOne) RemovePrices(v ...*EnrollmentPrice) *EnrollmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceIDs(ids...)
+}
+
+// ClearPauses clears all "pauses" edges to the EnrollmentPause entity.
+func (_u *EnrollmentUpdateOne) ClearPauses() *EnrollmentUpdateOne {
+	_u.mutation.ClearPauses()
+	return _u
+}
+
+// RemovePauseIDs removes the "pauses" edge to EnrollmentPause entities by IDs.
+func (_u *EnrollmentUpdateOne) RemovePauseIDs(ids ...int) *EnrollmentUpdateOne {
+	_u.mutation.RemovePauseIDs(ids...)
+	return _u
+}
+
+// RemovePauses removes "pauses" edges to EnrollmentPause entities.
+func (_u *EnrollmentUpdateOne) RemovePauses(v ...*EnrollmentPause) *EnrollmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePauseIDs(ids...)
+}
+
 // Where appends a list predicates to the EnrollmentUpdate builder.
 func (_u *EnrollmentUpdateOne) Where(ps ...predicate.Enrollment) *EnrollmentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -888,6 +1216,18 @@ func (_u *EnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *Enrollment,
 	if value, ok := _u.mutation.Note(); ok {
 		_spec.SetField(enrollment.FieldNote, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(enrollment.FieldStartsOn, field.TypeTime, value)
+	}
+	if _u.mutation.StartsOnCleared() {
+		_spec.ClearField(enrollment.FieldStartsOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(enrollment.FieldEndsOn, field.TypeTime, value)
+	}
+	if _u.mutation.EndsOnCleared() {
+		_spec.ClearField(enrollment.FieldEndsOn, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(enrollment.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -1003,6 +1343,96 @@ func (_u *EnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *Enrollment,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPricesIDs(); len(nodes) > 0 && !_u.mutation.PricesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PricesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PricesTable,
+			Columns: []string{enrollment.PricesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPausesIDs(); len(nodes) > 0 && !_u.mutation.PausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   enrollment.PausesTable,
+			Columns: []string{enrollment.PausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Enrollment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
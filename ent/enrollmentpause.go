@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EnrollmentPause is the model entity for the EnrollmentPause schema.
+type EnrollmentPause struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID int `json:"enrollment_id,omitempty"`
+	// StartsOn holds the value of the "starts_on" field.
+	StartsOn time.Time `json:"starts_on,omitempty"`
+	// EndsOn holds the value of the "ends_on" field.
+	EndsOn time.Time `json:"ends_on,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnrollmentPauseQuery when eager-loading is set.
+	Edges        EnrollmentPauseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnrollmentPauseEdges holds the relations/edges for other nodes in the graph.
+type EnrollmentPauseEdges struct {
+	// Enrollment holds the value of the enrollment edge.
+	Enrollment *Enrollment `json:"enrollment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnrollmentOrErr returns the Enrollment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrollmentPauseEdges) EnrollmentOrErr() (*Enrollment, error) {
+	if e.Enrollment != nil {
+		return e.Enrollment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: enrollment.Label}
+	}
+	return nil, &NotLoadedError{edge: "enrollment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnrollmentPause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case enrollmentpause.FieldID, enrollmentpause.FieldEnrollmentID:
+			values[i] = new(sql.NullInt64)
+		case enrollmentpause.FieldReason, enrollmentpause.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case enrollmentpause.FieldStartsOn, enrollmentpause.FieldEndsOn, enrollmentpause.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnrollmentPause fields.
+func (_m *EnrollmentPause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case enrollmentpause.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case enrollmentpause.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				_m.EnrollmentID = int(value.Int64)
+			}
+		case enrollmentpause.FieldStartsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_on", values[i])
+			} else if value.Valid {
+				_m.StartsOn = value.Time
+			}
+		case enrollmentpause.FieldEndsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_on", values[i])
+			} else if value.Valid {
+				_m.EndsOn = value.Time
+			}
+		case enrollmentpause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case enrollmentpause.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case enrollmentpause.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnrollmentPause.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnrollmentPause) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEnrollment queries the "enrollment" edge of the EnrollmentPause entity.
+func (_m *EnrollmentPause) QueryEnrollment() *EnrollmentQuery {
+	return NewEnrollmentPauseClient(_m.config).QueryEnrollment(_m)
+}
+
+// Update returns a builder for updating this EnrollmentPause.
+// Note that you need to call EnrollmentPause.Unwrap() before calling this method if this EnrollmentPause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnrollmentPause) Update() *EnrollmentPauseUpdateOne {
+	return NewEnrollmentPauseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnrollmentPause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnrollmentPause) Unwrap() *EnrollmentPause {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnrollmentPause is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnrollmentPause) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnrollmentPause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnrollmentID))
+	builder.WriteString(", ")
+	builder.WriteString("starts_on=")
+	builder.WriteString(_m.StartsOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_on=")
+	builder.WriteString(_m.EndsOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnrollmentPauses is a parsable slice of EnrollmentPause.
+type EnrollmentPauses []*EnrollmentPause
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmentpause
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldID, id))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// StartsOn applies equality check predicate on the "starts_on" field. It's identical to StartsOnEQ.
+func StartsOn(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldStartsOn, v))
+}
+
+// EndsOn applies equality check predicate on the "ends_on" field. It's identical to EndsOnEQ.
+func EndsOn(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldEndsOn, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...int) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// StartsOnEQ applies the EQ predicate on the "starts_on" field.
+func StartsOnEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldStartsOn, v))
+}
+
+// StartsOnNEQ applies the NEQ predicate on the "starts_on" field.
+func StartsOnNEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldStartsOn, v))
+}
+
+// StartsOnIn applies the In predicate on the "starts_on" field.
+func StartsOnIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldStartsOn, vs...))
+}
+
+// StartsOnNotIn applies the NotIn predicate on the "starts_on" field.
+func StartsOnNotIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldStartsOn, vs...))
+}
+
+// StartsOnGT applies the GT predicate on the "starts_on" field.
+func StartsOnGT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldStartsOn, v))
+}
+
+// StartsOnGTE applies the GTE predicate on the "starts_on" field.
+func StartsOnGTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldStartsOn, v))
+}
+
+// StartsOnLT applies the LT predicate on the "starts_on" field.
+func StartsOnLT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldStartsOn, v))
+}
+
+// StartsOnLTE applies the LTE predicate on the "starts_on" field.
+func StartsOnLTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldStartsOn, v))
+}
+
+// EndsOnEQ applies the EQ predicate on the "ends_on" field.
+func EndsOnEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldEndsOn, v))
+}
+
+// EndsOnNEQ applies the NEQ predicate on the "ends_on" field.
+func EndsOnNEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldEndsOn, v))
+}
+
+// EndsOnIn applies the In predicate on the "ends_on" field.
+func EndsOnIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldEndsOn, vs...))
+}
+
+// EndsOnNotIn applies the NotIn predicate on the "ends_on" field.
+func EndsOnNotIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldEndsOn, vs...))
+}
+
+// EndsOnGT applies the GT predicate on the "ends_on" field.
+func EndsOnGT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldEndsOn, v))
+}
+
+// EndsOnGTE applies the GTE predicate on the "ends_on" field.
+func EndsOnGTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldEndsOn, v))
+}
+
+// EndsOnLT applies the LT predicate on the "ends_on" field.
+func EndsOnLT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldEndsOn, v))
+}
+
+// EndsOnLTE applies the LTE predicate on the "ends_on" field.
+func EndsOnLTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldEndsOn, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasEnrollment applies the HasEdge predicate on the "enrollment" edge.
+func HasEnrollment() predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnrollmentTable, EnrollmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrollmentWith applies the HasEdge predicate on the "enrollment" edge with a given conditions (other predicates).
+func HasEnrollmentWith(preds ...predicate.Enrollment) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(func(s *sql.Selector) {
+		step := newEnrollmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrollmentPause) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrollmentPause) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrollmentPause) predicate.EnrollmentPause {
+	return predicate.EnrollmentPause(sql.NotPredicates(p))
+}
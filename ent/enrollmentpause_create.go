@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrollmentPauseCreate is the builder for creating a EnrollmentPause entity.
+type EnrollmentPauseCreate struct {
+	config
+	mutation *EnrollmentPauseMutation
+	hooks    []Hook
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_c *EnrollmentPauseCreate) SetEnrollmentID(v int) *EnrollmentPauseCreate {
+	_c.mutation.SetEnrollmentID(v)
+	return _c
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_c *EnrollmentPauseCreate) SetStartsOn(v time.Time) *EnrollmentPauseCreate {
+	_c.mutation.SetStartsOn(v)
+	return _c
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_c *EnrollmentPauseCreate) SetEndsOn(v time.Time) *EnrollmentPauseCreate {
+	_c.mutation.SetEndsOn(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *EnrollmentPauseCreate) SetReason(v string) *EnrollmentPauseCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *EnrollmentPauseCreate) SetNillableReason(v *string) *EnrollmentPauseCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *EnrollmentPauseCreate) SetCreatedBy(v string) *EnrollmentPauseCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *EnrollmentPauseCreate) SetNillableCreatedBy(v *string) *EnrollmentPauseCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnrollmentPauseCreate) SetCreatedAt(v time.Time) *EnrollmentPauseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnrollmentPauseCreate) SetNillableCreatedAt(v *time.Time) *EnrollmentPauseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_c *EnrollmentPauseCreate) SetEnrollment(v *Enrollment) *EnrollmentPauseCreate {
+	return _c.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPauseMutation object of the builder.
+func (_c *EnrollmentPauseCreate) Mutation() *EnrollmentPauseMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrollmentPause in the database.
+func (_c *EnrollmentPauseCreate) Save(ctx context.Context) (*EnrollmentPause, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrollmentPauseCreate) SaveX(ctx context.Context) *EnrollmentPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentPauseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentPauseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrollmentPauseCreate) defaults() {
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := enrollmentpause.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := enrollmentpause.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := enrollmentpause.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrollmentPauseCreate) check() error {
+	if _, ok := _c.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "EnrollmentPause.enrollment_id"`)}
+	}
+	if _, ok := _c.mutation.StartsOn(); !ok {
+		return &ValidationError{Name: "starts_on", err: errors.New(`ent: missing required field "EnrollmentPause.starts_on"`)}
+	}
+	if _, ok := _c.mutation.EndsOn(); !ok {
+		return &ValidationError{Name: "ends_on", err: errors.New(`ent: missing required field "EnrollmentPause.ends_on"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "EnrollmentPause.reason"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "EnrollmentPause.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnrollmentPause.created_at"`)}
+	}
+	if len(_c.mutation.EnrollmentIDs()) == 0 {
+		return &ValidationError{Name: "enrollment", err: errors.New(`ent: missing required edge "EnrollmentPause.enrollment"`)}
+	}
+	return nil
+}
+
+func (_c *EnrollmentPauseCreate) sqlSave(ctx context.Context) (*EnrollmentPause, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrollmentPauseCreate) createSpec() (*EnrollmentPause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrollmentPause{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrollmentpause.Table, sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StartsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldStartsOn, field.TypeTime, value)
+		_node.StartsOn = value
+	}
+	if value, ok := _c.mutation.EndsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldEndsOn, field.TypeTime, value)
+		_node.EndsOn = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(enrollmentpause.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentpause.EnrollmentTable,
+			Columns: []string{enrollmentpause.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnrollmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnrollmentPauseCreateBulk is the builder for creating many EnrollmentPause entities in bulk.
+type EnrollmentPauseCreateBulk struct {
+	config
+	err      error
+	builders []*EnrollmentPauseCreate
+}
+
+// Save creates the EnrollmentPause entities in the database.
+func (_c *EnrollmentPauseCreateBulk) Save(ctx context.Context) ([]*EnrollmentPause, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrollmentPause, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrollmentPauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrollmentPauseCreateBulk) SaveX(ctx context.Context) []*EnrollmentPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentPauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentPauseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
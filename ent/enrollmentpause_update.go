@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrollmentPauseUpdate is the builder for updating EnrollmentPause entities.
+type EnrollmentPauseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnrollmentPauseMutation
+}
+
+// Where appends a list predicates to the EnrollmentPauseUpdate builder.
+func (_u *EnrollmentPauseUpdate) Where(ps ...predicate.EnrollmentPause) *EnrollmentPauseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *EnrollmentPauseUpdate) SetEnrollmentID(v int) *EnrollmentPauseUpdate {
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableEnrollmentID(v *int) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_u *EnrollmentPauseUpdate) SetStartsOn(v time.Time) *EnrollmentPauseUpdate {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableStartsOn(v *time.Time) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *EnrollmentPauseUpdate) SetEndsOn(v time.Time) *EnrollmentPauseUpdate {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableEndsOn(v *time.Time) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EnrollmentPauseUpdate) SetReason(v string) *EnrollmentPauseUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableReason(v *string) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EnrollmentPauseUpdate) SetCreatedBy(v string) *EnrollmentPauseUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableCreatedBy(v *string) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EnrollmentPauseUpdate) SetCreatedAt(v time.Time) *EnrollmentPauseUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdate) SetNillableCreatedAt(v *time.Time) *EnrollmentPauseUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPauseUpdate) SetEnrollment(v *Enrollment) *EnrollmentPauseUpdate {
+	return _u.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPauseMutation object of the builder.
+func (_u *EnrollmentPauseUpdate) Mutation() *EnrollmentPauseMutation {
+	return _u.mutation
+}
+
+// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPauseUpdate) ClearEnrollment() *EnrollmentPauseUpdate {
+	_u.mutation.ClearEnrollment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrollmentPauseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentPauseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrollmentPauseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentPauseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentPauseUpdate) check() error {
+	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrollmentPause.enrollment"`)
+	}
+	return nil
+}
+
+func (_u *EnrollmentPauseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentpause.Table, enrollmentpause.Columns, sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldStartsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldEndsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(enrollmentpause.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrollmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentpause.EnrollmentTable,
+			Columns: []string{enrollmentpause.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentpause.EnrollmentTable,
+			Columns: []string{enrollmentpause.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrollmentPauseUpdateOne is the builder for updating a single EnrollmentPause entity.
+type EnrollmentPauseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnrollmentPauseMutation
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *EnrollmentPauseUpdateOne) SetEnrollmentID(v int) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableEnrollmentID(v *int) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_u *EnrollmentPauseUpdateOne) SetStartsOn(v time.Time) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableStartsOn(v *time.Time) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *EnrollmentPauseUpdateOne) SetEndsOn(v time.Time) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableEndsOn(v *time.Time) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EnrollmentPauseUpdateOne) SetReason(v string) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableReason(v *string) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EnrollmentPauseUpdateOne) SetCreatedBy(v string) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableCreatedBy(v *string) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EnrollmentPauseUpdateOne) SetCreatedAt(v time.Time) *EnrollmentPauseUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EnrollmentPauseUpdateOne) SetNillableCreatedAt(v *time.Time) *EnrollmentPauseUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPauseUpdateOne) SetEnrollment(v *Enrollment) *EnrollmentPauseUpdateOne {
+	return _u.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPauseMutation object of the builder.
+func (_u *EnrollmentPauseUpdateOne) Mutation() *EnrollmentPauseMutation {
+	return _u.mutation
+}
+
+// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPauseUpdateOne) ClearEnrollment() *EnrollmentPauseUpdateOne {
+	_u.mutation.ClearEnrollment()
+	return _u
+}
+
+// Where appends a list predicates to the EnrollmentPauseUpdate builder.
+func (_u *EnrollmentPauseUpdateOne) Where(ps ...predicate.EnrollmentPause) *EnrollmentPauseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrollmentPauseUpdateOne) Select(field string, fields ...string) *EnrollmentPauseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrollmentPause entity.
+func (_u *EnrollmentPauseUpdateOne) Save(ctx context.Context) (*EnrollmentPause, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentPauseUpdateOne) SaveX(ctx context.Context) *EnrollmentPause {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrollmentPauseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentPauseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentPauseUpdateOne) check() error {
+	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrollmentPause.enrollment"`)
+	}
+	return nil
+}
+
+func (_u *EnrollmentPauseUpdateOne) sqlSave(ctx context.Context) (_node *EnrollmentPause, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentpause.Table, enrollmentpause.Columns, sqlgraph.NewFieldSpec(enrollmentpause.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrollmentPause.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrollmentpause.FieldID)
+		for _, f := range fields {
+			if !enrollmentpause.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrollmentpause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldStartsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(enrollmentpause.FieldEndsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(enrollmentpause.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentpause.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrollmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentpause.EnrollmentTable,
+			Columns: []string{enrollmentpause.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentpause.EnrollmentTable,
+			Columns: []string{enrollmentpause.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnrollmentPause{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentprice"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EnrollmentPrice is the model entity for the EnrollmentPrice schema.
+type EnrollmentPrice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID int `json:"enrollment_id,omitempty"`
+	// EffectiveYear holds the value of the "effective_year" field.
+	EffectiveYear int `json:"effective_year,omitempty"`
+	// EffectiveMonth holds the value of the "effective_month" field.
+	EffectiveMonth int `json:"effective_month,omitempty"`
+	// LessonPriceOverrideCents holds the value of the "lesson_price_override_cents" field.
+	LessonPriceOverrideCents int64 `json:"lesson_price_override_cents,omitempty"`
+	// SubscriptionLessonPriceCents holds the value of the "subscription_lesson_price_cents" field.
+	SubscriptionLessonPriceCents int64 `json:"subscription_lesson_price_cents,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnrollmentPriceQuery when eager-loading is set.
+	Edges        EnrollmentPriceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnrollmentPriceEdges holds the relations/edges for other nodes in the graph.
+type EnrollmentPriceEdges struct {
+	// Enrollment holds the value of the enrollment edge.
+	Enrollment *Enrollment `json:"enrollment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnrollmentOrErr returns the Enrollment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrollmentPriceEdges) EnrollmentOrErr() (*Enrollment, error) {
+	if e.Enrollment != nil {
+		return e.Enrollment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: enrollment.Label}
+	}
+	return nil, &NotLoadedError{edge: "enrollment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnrollmentPrice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case enrollmentprice.FieldID, enrollmentprice.FieldEnrollmentID, enrollmentprice.FieldEffectiveYear, enrollmentprice.FieldEffectiveMonth, enrollmentprice.FieldLessonPriceOverrideCents, enrollmentprice.FieldSubscriptionLessonPriceCents:
+			values[i] = new(sql.NullInt64)
+		case enrollmentprice.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case enrollmentprice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnrollmentPrice fields.
+func (_m *EnrollmentPrice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case enrollmentprice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case enrollmentprice.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				_m.EnrollmentID = int(value.Int64)
+			}
+		case enrollmentprice.FieldEffectiveYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_year", values[i])
+			} else if value.Valid {
+				_m.EffectiveYear = int(value.Int64)
+			}
+		case enrollmentprice.FieldEffectiveMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_month", values[i])
+			} else if value.Valid {
+				_m.EffectiveMonth = int(value.Int64)
+			}
+		case enrollmentprice.FieldLessonPriceOverrideCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lesson_price_override_cents", values[i])
+			} else if value.Valid {
+				_m.LessonPriceOverrideCents = value.Int64
+			}
+		case enrollmentprice.FieldSubscriptionLessonPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_lesson_price_cents", values[i])
+			} else if value.Valid {
+				_m.SubscriptionLessonPriceCents = value.Int64
+			}
+		case enrollmentprice.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case enrollmentprice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnrollmentPrice.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnrollmentPrice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEnrollment queries the "enrollment" edge of the EnrollmentPrice entity.
+func (_m *EnrollmentPrice) QueryEnrollment() *EnrollmentQuery {
+	return NewEnrollmentPriceClient(_m.config).QueryEnrollment(_m)
+}
+
+// Update returns a builder for updating this EnrollmentPrice.
+// Note that you need to call EnrollmentPrice.Unwrap() before calling this method if this EnrollmentPrice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnrollmentPrice) Update() *EnrollmentPriceUpdateOne {
+	return NewEnrollmentPriceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnrollmentPrice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnrollmentPrice) Unwrap() *EnrollmentPrice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnrollmentPrice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnrollmentPrice) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnrollmentPrice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnrollmentID))
+	builder.WriteString(", ")
+	builder.WriteString("effective_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EffectiveYear))
+	builder.WriteString(", ")
+	builder.WriteString("effective_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EffectiveMonth))
+	builder.WriteString(", ")
+	builder.WriteString("lesson_price_override_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LessonPriceOverrideCents))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_lesson_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionLessonPriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnrollmentPrices is a parsable slice of EnrollmentPrice.
+type EnrollmentPrices []*EnrollmentPrice
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmentprice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the enrollmentprice type in the database.
+	Label = "enrollment_price"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
+	FieldEnrollmentID = "enrollment_id"
+	// FieldEffectiveYear holds the string denoting the effective_year field in the database.
+	FieldEffectiveYear = "effective_year"
+	// FieldEffectiveMonth holds the string denoting the effective_month field in the database.
+	FieldEffectiveMonth = "effective_month"
+	// FieldLessonPriceOverrideCents holds the string denoting the lesson_price_override_cents field in the database.
+	FieldLessonPriceOverrideCents = "lesson_price_override_cents"
+	// FieldSubscriptionLessonPriceCents holds the string denoting the subscription_lesson_price_cents field in the database.
+	FieldSubscriptionLessonPriceCents = "subscription_lesson_price_cents"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeEnrollment holds the string denoting the enrollment edge name in mutations.
+	EdgeEnrollment = "enrollment"
+	// Table holds the table name of the enrollmentprice in the database.
+	Table = "enrollment_prices"
+	// EnrollmentTable is the table that holds the enrollment relation/edge.
+	EnrollmentTable = "enrollment_prices"
+	// EnrollmentInverseTable is the table name for the Enrollment entity.
+	// It exists in this package in order to avoid circular dependency with the "enrollment" package.
+	EnrollmentInverseTable = "enrollments"
+	// EnrollmentColumn is the table column denoting the enrollment relation/edge.
+	EnrollmentColumn = "enrollment_id"
+)
+
+// Columns holds all SQL columns for enrollmentprice fields.
+var Columns = []string{
+	FieldID,
+	FieldEnrollmentID,
+	FieldEffectiveYear,
+	FieldEffectiveMonth,
+	FieldLessonPriceOverrideCents,
+	FieldSubscriptionLessonPriceCents,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EffectiveMonthValidator is a validator for the "effective_month" field. It is called by the builders before save.
+	EffectiveMonthValidator func(int) error
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EnrollmentPrice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEnrollmentID orders the results by the enrollment_id field.
+func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
+}
+
+// ByEffectiveYear orders the results by the effective_year field.
+func ByEffectiveYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveYear, opts...).ToFunc()
+}
+
+// ByEffectiveMonth orders the results by the effective_month field.
+func ByEffectiveMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveMonth, opts...).ToFunc()
+}
+
+// ByLessonPriceOverrideCents orders the results by the lesson_price_override_cents field.
+func ByLessonPriceOverrideCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLessonPriceOverrideCents, opts...).ToFunc()
+}
+
+// BySubscriptionLessonPriceCents orders the results by the subscription_lesson_price_cents field.
+func BySubscriptionLessonPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionLessonPriceCents, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEnrollmentField orders the results by enrollment field.
+func ByEnrollmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrollmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEnrollmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrollmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EnrollmentTable, EnrollmentColumn),
+	)
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmentprice
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldID, id))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EffectiveYear applies equality check predicate on the "effective_year" field. It's identical to EffectiveYearEQ.
+func EffectiveYear(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveMonth applies equality check predicate on the "effective_month" field. It's identical to EffectiveMonthEQ.
+func EffectiveMonth(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// LessonPriceOverrideCents applies equality check predicate on the "lesson_price_override_cents" field. It's identical to LessonPriceOverrideCentsEQ.
+func LessonPriceOverrideCents(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldLessonPriceOverrideCents, v))
+}
+
+// SubscriptionLessonPriceCents applies equality check predicate on the "subscription_lesson_price_cents" field. It's identical to SubscriptionLessonPriceCentsEQ.
+func SubscriptionLessonPriceCents(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldSubscriptionLessonPriceCents, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EffectiveYearEQ applies the EQ predicate on the "effective_year" field.
+func EffectiveYearEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveYearNEQ applies the NEQ predicate on the "effective_year" field.
+func EffectiveYearNEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldEffectiveYear, v))
+}
+
+// EffectiveYearIn applies the In predicate on the "effective_year" field.
+func EffectiveYearIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldEffectiveYear, vs...))
+}
+
+// EffectiveYearNotIn applies the NotIn predicate on the "effective_year" field.
+func EffectiveYearNotIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldEffectiveYear, vs...))
+}
+
+// EffectiveYearGT applies the GT predicate on the "effective_year" field.
+func EffectiveYearGT(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldEffectiveYear, v))
+}
+
+// EffectiveYearGTE applies the GTE predicate on the "effective_year" field.
+func EffectiveYearGTE(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldEffectiveYear, v))
+}
+
+// EffectiveYearLT applies the LT predicate on the "effective_year" field.
+func EffectiveYearLT(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldEffectiveYear, v))
+}
+
+// EffectiveYearLTE applies the LTE predicate on the "effective_year" field.
+func EffectiveYearLTE(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldEffectiveYear, v))
+}
+
+// EffectiveMonthEQ applies the EQ predicate on the "effective_month" field.
+func EffectiveMonthEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthNEQ applies the NEQ predicate on the "effective_month" field.
+func EffectiveMonthNEQ(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthIn applies the In predicate on the "effective_month" field.
+func EffectiveMonthIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthNotIn applies the NotIn predicate on the "effective_month" field.
+func EffectiveMonthNotIn(vs ...int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthGT applies the GT predicate on the "effective_month" field.
+func EffectiveMonthGT(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthGTE applies the GTE predicate on the "effective_month" field.
+func EffectiveMonthGTE(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLT applies the LT predicate on the "effective_month" field.
+func EffectiveMonthLT(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLTE applies the LTE predicate on the "effective_month" field.
+func EffectiveMonthLTE(v int) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldEffectiveMonth, v))
+}
+
+// LessonPriceOverrideCentsEQ applies the EQ predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsEQ(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldLessonPriceOverrideCents, v))
+}
+
+// LessonPriceOverrideCentsNEQ applies the NEQ predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsNEQ(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldLessonPriceOverrideCents, v))
+}
+
+// LessonPriceOverrideCentsIn applies the In predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsIn(vs ...int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldLessonPriceOverrideCents, vs...))
+}
+
+// LessonPriceOverrideCentsNotIn applies the NotIn predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsNotIn(vs ...int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldLessonPriceOverrideCents, vs...))
+}
+
+// LessonPriceOverrideCentsGT applies the GT predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsGT(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldLessonPriceOverrideCents, v))
+}
+
+// LessonPriceOverrideCentsGTE applies the GTE predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsGTE(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldLessonPriceOverrideCents, v))
+}
+
+// LessonPriceOverrideCentsLT applies the LT predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsLT(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldLessonPriceOverrideCents, v))
+}
+
+// LessonPriceOverrideCentsLTE applies the LTE predicate on the "lesson_price_override_cents" field.
+func LessonPriceOverrideCentsLTE(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldLessonPriceOverrideCents, v))
+}
+
+// SubscriptionLessonPriceCentsEQ applies the EQ predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsEQ(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldSubscriptionLessonPriceCents, v))
+}
+
+// SubscriptionLessonPriceCentsNEQ applies the NEQ predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsNEQ(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldSubscriptionLessonPriceCents, v))
+}
+
+// SubscriptionLessonPriceCentsIn applies the In predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsIn(vs ...int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldSubscriptionLessonPriceCents, vs...))
+}
+
+// SubscriptionLessonPriceCentsNotIn applies the NotIn predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsNotIn(vs ...int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldSubscriptionLessonPriceCents, vs...))
+}
+
+// SubscriptionLessonPriceCentsGT applies the GT predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsGT(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldSubscriptionLessonPriceCents, v))
+}
+
+// SubscriptionLessonPriceCentsGTE applies the GTE predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsGTE(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldSubscriptionLessonPriceCents, v))
+}
+
+// SubscriptionLessonPriceCentsLT applies the LT predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsLT(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldSubscriptionLessonPriceCents, v))
+}
+
+// SubscriptionLessonPriceCentsLTE applies the LTE predicate on the "subscription_lesson_price_cents" field.
+func SubscriptionLessonPriceCentsLTE(v int64) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldSubscriptionLessonPriceCents, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasEnrollment applies the HasEdge predicate on the "enrollment" edge.
+func HasEnrollment() predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnrollmentTable, EnrollmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrollmentWith applies the HasEdge predicate on the "enrollment" edge with a given conditions (other predicates).
+func HasEnrollmentWith(preds ...predicate.Enrollment) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(func(s *sql.Selector) {
+		step := newEnrollmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrollmentPrice) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrollmentPrice) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrollmentPrice) predicate.EnrollmentPrice {
+	return predicate.EnrollmentPrice(sql.NotPredicates(p))
+}
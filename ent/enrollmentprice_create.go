@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentprice"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrollmentPriceCreate is the builder for creating a EnrollmentPrice entity.
+type EnrollmentPriceCreate struct {
+	config
+	mutation *EnrollmentPriceMutation
+	hooks    []Hook
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_c *EnrollmentPriceCreate) SetEnrollmentID(v int) *EnrollmentPriceCreate {
+	_c.mutation.SetEnrollmentID(v)
+	return _c
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_c *EnrollmentPriceCreate) SetEffectiveYear(v int) *EnrollmentPriceCreate {
+	_c.mutation.SetEffectiveYear(v)
+	return _c
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_c *EnrollmentPriceCreate) SetEffectiveMonth(v int) *EnrollmentPriceCreate {
+	_c.mutation.SetEffectiveMonth(v)
+	return _c
+}
+
+// SetLessonPriceOverrideCents sets the "lesson_price_override_cents" field.
+func (_c *EnrollmentPriceCreate) SetLessonPriceOverrideCents(v int64) *EnrollmentPriceCreate {
+	_c.mutation.SetLessonPriceOverrideCents(v)
+	return _c
+}
+
+// SetSubscriptionLessonPriceCents sets the "subscription_lesson_price_cents" field.
+func (_c *EnrollmentPriceCreate) SetSubscriptionLessonPriceCents(v int64) *EnrollmentPriceCreate {
+	_c.mutation.SetSubscriptionLessonPriceCents(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *EnrollmentPriceCreate) SetCreatedBy(v string) *EnrollmentPriceCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *EnrollmentPriceCreate) SetNillableCreatedBy(v *string) *EnrollmentPriceCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnrollmentPriceCreate) SetCreatedAt(v time.Time) *EnrollmentPriceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnrollmentPriceCreate) SetNillableCreatedAt(v *time.Time) *EnrollmentPriceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_c *EnrollmentPriceCreate) SetEnrollment(v *Enrollment) *EnrollmentPriceCreate {
+	return _c.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPriceMutation object of the builder.
+func (_c *EnrollmentPriceCreate) Mutation() *EnrollmentPriceMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrollmentPrice in the database.
+func (_c *EnrollmentPriceCreate) Save(ctx context.Context) (*EnrollmentPrice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrollmentPriceCreate) SaveX(ctx context.Context) *EnrollmentPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentPriceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentPriceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrollmentPriceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := enrollmentprice.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := enrollmentprice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrollmentPriceCreate) check() error {
+	if _, ok := _c.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "EnrollmentPrice.enrollment_id"`)}
+	}
+	if _, ok := _c.mutation.EffectiveYear(); !ok {
+		return &ValidationError{Name: "effective_year", err: errors.New(`ent: missing required field "EnrollmentPrice.effective_year"`)}
+	}
+	if _, ok := _c.mutation.EffectiveMonth(); !ok {
+		return &ValidationError{Name: "effective_month", err: errors.New(`ent: missing required field "EnrollmentPrice.effective_month"`)}
+	}
+	if v, ok := _c.mutation.EffectiveMonth(); ok {
+		if err := enrollmentprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "EnrollmentPrice.effective_month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LessonPriceOverrideCents(); !ok {
+		return &ValidationError{Name: "lesson_price_override_cents", err: errors.New(`ent: missing required field "EnrollmentPrice.lesson_price_override_cents"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionLessonPriceCents(); !ok {
+		return &ValidationError{Name: "subscription_lesson_price_cents", err: errors.New(`ent: missing required field "EnrollmentPrice.subscription_lesson_price_cents"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "EnrollmentPrice.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnrollmentPrice.created_at"`)}
+	}
+	if len(_c.mutation.EnrollmentIDs()) == 0 {
+		return &ValidationError{Name: "enrollment", err: errors.New(`ent: missing required edge "EnrollmentPrice.enrollment"`)}
+	}
+	return nil
+}
+
+func (_c *EnrollmentPriceCreate) sqlSave(ctx context.Context) (*EnrollmentPrice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrollmentPriceCreate) createSpec() (*EnrollmentPrice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrollmentPrice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrollmentprice.Table, sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EffectiveYear(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveYear, field.TypeInt, value)
+		_node.EffectiveYear = value
+	}
+	if value, ok := _c.mutation.EffectiveMonth(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveMonth, field.TypeInt, value)
+		_node.EffectiveMonth = value
+	}
+	if value, ok := _c.mutation.LessonPriceOverrideCents(); ok {
+		_spec.SetField(enrollmentprice.FieldLessonPriceOverrideCents, field.TypeInt64, value)
+		_node.LessonPriceOverrideCents = value
+	}
+	if value, ok := _c.mutation.SubscriptionLessonPriceCents(); ok {
+		_spec.SetField(enrollmentprice.FieldSubscriptionLessonPriceCents, field.TypeInt64, value)
+		_node.SubscriptionLessonPriceCents = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentprice.EnrollmentTable,
+			Columns: []string{enrollmentprice.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnrollmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnrollmentPriceCreateBulk is the builder for creating many EnrollmentPrice entities in bulk.
+type EnrollmentPriceCreateBulk struct {
+	config
+	err      error
+	builders []*EnrollmentPriceCreate
+}
+
+// Save creates the EnrollmentPrice entities in the database.
+func (_c *EnrollmentPriceCreateBulk) Save(ctx context.Context) ([]*EnrollmentPrice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrollmentPrice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrollmentPriceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrollmentPriceCreateBulk) SaveX(ctx context.Context) []*EnrollmentPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentPriceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentPriceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
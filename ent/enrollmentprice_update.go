@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentprice"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrollmentPriceUpdate is the builder for updating EnrollmentPrice entities.
+type EnrollmentPriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnrollmentPriceMutation
+}
+
+// Where appends a list predicates to the EnrollmentPriceUpdate builder.
+func (_u *EnrollmentPriceUpdate) Where(ps ...predicate.EnrollmentPrice) *EnrollmentPriceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *EnrollmentPriceUpdate) SetEnrollmentID(v int) *EnrollmentPriceUpdate {
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableEnrollmentID(v *int) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_u *EnrollmentPriceUpdate) SetEffectiveYear(v int) *EnrollmentPriceUpdate {
+	_u.mutation.ResetEffectiveYear()
+	_u.mutation.SetEffectiveYear(v)
+	return _u
+}
+
+// SetNillableEffectiveYear sets the "effective_year" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableEffectiveYear(v *int) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetEffectiveYear(*v)
+	}
+	return _u
+}
+
+// AddEffectiveYear adds value to the "effective_year" field.
+func (_u *EnrollmentPriceUpdate) AddEffectiveYear(v int) *EnrollmentPriceUpdate {
+	_u.mutation.AddEffectiveYear(v)
+	return _u
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_u *EnrollmentPriceUpdate) SetEffectiveMonth(v int) *EnrollmentPriceUpdate {
+	_u.mutation.ResetEffectiveMonth()
+	_u.mutation.SetEffectiveMonth(v)
+	return _u
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableEffectiveMonth(v *int) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetEffectiveMonth(*v)
+	}
+	return _u
+}
+
+// AddEffectiveMonth adds value to the "effective_month" field.
+func (_u *EnrollmentPriceUpdate) AddEffectiveMonth(v int) *EnrollmentPriceUpdate {
+	_u.mutation.AddEffectiveMonth(v)
+	return _u
+}
+
+// SetLessonPriceOverrideCents sets the "lesson_price_override_cents" field.
+func (_u *EnrollmentPriceUpdate) SetLessonPriceOverrideCents(v int64) *EnrollmentPriceUpdate {
+	_u.mutation.ResetLessonPriceOverrideCents()
+	_u.mutation.SetLessonPriceOverrideCents(v)
+	return _u
+}
+
+// SetNillableLessonPriceOverrideCents sets the "lesson_price_override_cents" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableLessonPriceOverrideCents(v *int64) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetLessonPriceOverrideCents(*v)
+	}
+	return _u
+}
+
+// AddLessonPriceOverrideCents adds value to the "lesson_price_override_cents" field.
+func (_u *EnrollmentPriceUpdate) AddLessonPriceOverrideCents(v int64) *EnrollmentPriceUpdate {
+	_u.mutation.AddLessonPriceOverrideCents(v)
+	return _u
+}
+
+// SetSubscriptionLessonPriceCents sets the "subscription_lesson_price_cents" field.
+func (_u *EnrollmentPriceUpdate) SetSubscriptionLessonPriceCents(v int64) *EnrollmentPriceUpdate {
+	_u.mutation.ResetSubscriptionLessonPriceCents()
+	_u.mutation.SetSubscriptionLessonPriceCents(v)
+	return _u
+}
+
+// SetNillableSubscriptionLessonPriceCents sets the "subscription_lesson_price_cents" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableSubscriptionLessonPriceCents(v *int64) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetSubscriptionLessonPriceCents(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionLessonPriceCents adds value to the "subscription_lesson_price_cents" field.
+func (_u *EnrollmentPriceUpdate) AddSubscriptionLessonPriceCents(v int64) *EnrollmentPriceUpdate {
+	_u.mutation.AddSubscriptionLessonPriceCents(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EnrollmentPriceUpdate) SetCreatedBy(v string) *EnrollmentPriceUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableCreatedBy(v *string) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EnrollmentPriceUpdate) SetCreatedAt(v time.Time) *EnrollmentPriceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdate) SetNillableCreatedAt(v *time.Time) *EnrollmentPriceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPriceUpdate) SetEnrollment(v *Enrollment) *EnrollmentPriceUpdate {
+	return _u.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPriceMutation object of the builder.
+func (_u *EnrollmentPriceUpdate) Mutation() *EnrollmentPriceMutation {
+	return _u.mutation
+}
+
+// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPriceUpdate) ClearEnrollment() *EnrollmentPriceUpdate {
+	_u.mutation.ClearEnrollment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrollmentPriceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentPriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrollmentPriceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentPriceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentPriceUpdate) check() error {
+	if v, ok := _u.mutation.EffectiveMonth(); ok {
+		if err := enrollmentprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "EnrollmentPrice.effective_month": %w`, err)}
+		}
+	}
+	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrollmentPrice.enrollment"`)
+	}
+	return nil
+}
+
+func (_u *EnrollmentPriceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentprice.Table, enrollmentprice.Columns, sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EffectiveYear(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveYear(); ok {
+		_spec.AddField(enrollmentprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EffectiveMonth(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(enrollmentprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LessonPriceOverrideCents(); ok {
+		_spec.SetField(enrollmentprice.FieldLessonPriceOverrideCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLessonPriceOverrideCents(); ok {
+		_spec.AddField(enrollmentprice.FieldLessonPriceOverrideCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionLessonPriceCents(); ok {
+		_spec.SetField(enrollmentprice.FieldSubscriptionLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionLessonPriceCents(); ok {
+		_spec.AddField(enrollmentprice.FieldSubscriptionLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrollmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentprice.EnrollmentTable,
+			Columns: []string{enrollmentprice.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentprice.EnrollmentTable,
+			Columns: []string{enrollmentprice.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrollmentPriceUpdateOne is the builder for updating a single EnrollmentPrice entity.
+type EnrollmentPriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnrollmentPriceMutation
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *EnrollmentPriceUpdateOne) SetEnrollmentID(v int) *EnrollmentPriceUpdateOne {
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableEnrollmentID(v *int) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// SetEffectiveYear sets the "effective_year" field.
+func (_u *EnrollmentPriceUpdateOne) SetEffectiveYear(v int) *EnrollmentPriceUpdateOne {
+	_u.mutation.ResetEffectiveYear()
+	_u.mutation.SetEffectiveYear(v)
+	return _u
+}
+
+// SetNillableEffectiveYear sets the "effective_year" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableEffectiveYear(v *int) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveYear(*v)
+	}
+	return _u
+}
+
+// AddEffectiveYear adds value to the "effective_year" field.
+func (_u *EnrollmentPriceUpdateOne) AddEffectiveYear(v int) *EnrollmentPriceUpdateOne {
+	_u.mutation.AddEffectiveYear(v)
+	return _u
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (_u *EnrollmentPriceUpdateOne) SetEffectiveMonth(v int) *EnrollmentPriceUpdateOne {
+	_u.mutation.ResetEffectiveMonth()
+	_u.mutation.SetEffectiveMonth(v)
+	return _u
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableEffectiveMonth(v *int) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveMonth(*v)
+	}
+	return _u
+}
+
+// AddEffectiveMonth adds value to the "effective_month" field.
+func (_u *EnrollmentPriceUpdateOne) AddEffectiveMonth(v int) *EnrollmentPriceUpdateOne {
+	_u.mutation.AddEffectiveMonth(v)
+	return _u
+}
+
+// SetLessonPriceOverrideCents sets the "lesson_price_override_cents" field.
+func (_u *EnrollmentPriceUpdateOne) SetLessonPriceOverrideCents(v int64) *EnrollmentPriceUpdateOne {
+	_u.mutation.ResetLessonPriceOverrideCents()
+	_u.mutation.SetLessonPriceOverrideCents(v)
+	return _u
+}
+
+// SetNillableLessonPriceOverrideCents sets the "lesson_price_override_cents" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableLessonPriceOverrideCents(v *int64) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetLessonPriceOverrideCents(*v)
+	}
+	return _u
+}
+
+// AddLessonPriceOverrideCents adds value to the "lesson_price_override_cents" field.
+func (_u *EnrollmentPriceUpdateOne) AddLessonPriceOverrideCents(v int64) *EnrollmentPriceUpdateOne {
+	_u.mutation.AddLessonPriceOverrideCents(v)
+	return _u
+}
+
+// SetSubscriptionLessonPriceCents sets the "subscription_lesson_price_cents" field.
+func (_u *EnrollmentPriceUpdateOne) SetSubscriptionLessonPriceCents(v int64) *EnrollmentPriceUpdateOne {
+	_u.mutation.ResetSubscriptionLessonPriceCents()
+	_u.mutation.SetSubscriptionLessonPriceCents(v)
+	return _u
+}
+
+// SetNillableSubscriptionLessonPriceCents sets the "subscription_lesson_price_cents" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableSubscriptionLessonPriceCents(v *int64) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionLessonPriceCents(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionLessonPriceCents adds value to the "subscription_lesson_price_cents" field.
+func (_u *EnrollmentPriceUpdateOne) AddSubscriptionLessonPriceCents(v int64) *EnrollmentPriceUpdateOne {
+	_u.mutation.AddSubscriptionLessonPriceCents(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EnrollmentPriceUpdateOne) SetCreatedBy(v string) *EnrollmentPriceUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableCreatedBy(v *string) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EnrollmentPriceUpdateOne) SetCreatedAt(v time.Time) *EnrollmentPriceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EnrollmentPriceUpdateOne) SetNillableCreatedAt(v *time.Time) *EnrollmentPriceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetEnrollment sets the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPriceUpdateOne) SetEnrollment(v *Enrollment) *EnrollmentPriceUpdateOne {
+	return _u.SetEnrollmentID(v.ID)
+}
+
+// Mutation returns the EnrollmentPriceMutation object of the builder.
+func (_u *EnrollmentPriceUpdateOne) Mutation() *EnrollmentPriceMutation {
+	return _u.mutation
+}
+
+// ClearEnrollment clears the "enrollment" edge to the Enrollment entity.
+func (_u *EnrollmentPriceUpdateOne) ClearEnrollment() *EnrollmentPriceUpdateOne {
+	_u.mutation.ClearEnrollment()
+	return _u
+}
+
+// Where appends a list predicates to the EnrollmentPriceUpdate builder.
+func (_u *EnrollmentPriceUpdateOne) Where(ps ...predicate.EnrollmentPrice) *EnrollmentPriceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrollmentPriceUpdateOne) Select(field string, fields ...string) *EnrollmentPriceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrollmentPrice entity.
+func (_u *EnrollmentPriceUpdateOne) Save(ctx context.Context) (*EnrollmentPrice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentPriceUpdateOne) SaveX(ctx context.Context) *EnrollmentPrice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrollmentPriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentPriceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentPriceUpdateOne) check() error {
+	if v, ok := _u.mutation.EffectiveMonth(); ok {
+		if err := enrollmentprice.EffectiveMonthValidator(v); err != nil {
+			return &ValidationError{Name: "effective_month", err: fmt.Errorf(`ent: validator failed for field "EnrollmentPrice.effective_month": %w`, err)}
+		}
+	}
+	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrollmentPrice.enrollment"`)
+	}
+	return nil
+}
+
+func (_u *EnrollmentPriceUpdateOne) sqlSave(ctx context.Context) (_node *EnrollmentPrice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentprice.Table, enrollmentprice.Columns, sqlgraph.NewFieldSpec(enrollmentprice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrollmentPrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrollmentprice.FieldID)
+		for _, f := range fields {
+			if !enrollmentprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrollmentprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EffectiveYear(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveYear(); ok {
+		_spec.AddField(enrollmentprice.FieldEffectiveYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EffectiveMonth(); ok {
+		_spec.SetField(enrollmentprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(enrollmentprice.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LessonPriceOverrideCents(); ok {
+		_spec.SetField(enrollmentprice.FieldLessonPriceOverrideCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLessonPriceOverrideCents(); ok {
+		_spec.AddField(enrollmentprice.FieldLessonPriceOverrideCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionLessonPriceCents(); ok {
+		_spec.SetField(enrollmentprice.FieldSubscriptionLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionLessonPriceCents(); ok {
+		_spec.AddField(enrollmentprice.FieldSubscriptionLessonPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(enrollmentprice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnrollmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentprice.EnrollmentTable,
+			Columns: []string{enrollmentprice.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrollmentprice.EnrollmentTable,
+			Columns: []string{enrollmentprice.EnrollmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnrollmentPrice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,16 +8,34 @@ import (
 	"fmt"
 	"langschool/ent/attendancemonth"
 	"langschool/ent/auditlog"
+	"langschool/ent/billingterm"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/idempotencykey"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/settings"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
 	"langschool/ent/teacher"
 	"langschool/ent/user"
+	"langschool/ent/waitlistentry"
 	"langschool/ent/websession"
 	"reflect"
 	"sync"
@@ -85,19 +103,37 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attendancemonth.Table: attendancemonth.ValidColumn,
-			auditlog.Table:        auditlog.ValidColumn,
-			course.Table:          course.ValidColumn,
-			coursemonthstat.Table: coursemonthstat.ValidColumn,
-			enrollment.Table:      enrollment.ValidColumn,
-			invoice.Table:         invoice.ValidColumn,
-			invoiceline.Table:     invoiceline.ValidColumn,
-			payment.Table:         payment.ValidColumn,
-			settings.Table:        settings.ValidColumn,
-			student.Table:         student.ValidColumn,
-			teacher.Table:         teacher.ValidColumn,
-			user.Table:            user.ValidColumn,
-			websession.Table:      websession.ValidColumn,
+			attendancemonth.Table:       attendancemonth.ValidColumn,
+			auditlog.Table:              auditlog.ValidColumn,
+			billingterm.Table:           billingterm.ValidColumn,
+			cashmovement.Table:          cashmovement.ValidColumn,
+			cashreceipt.Table:           cashreceipt.ValidColumn,
+			cashsession.Table:           cashsession.ValidColumn,
+			course.Table:                course.ValidColumn,
+			coursemonthstat.Table:       coursemonthstat.ValidColumn,
+			courseprice.Table:           courseprice.ValidColumn,
+			enrollment.Table:            enrollment.ValidColumn,
+			enrollmentpause.Table:       enrollmentpause.ValidColumn,
+			enrollmentprice.Table:       enrollmentprice.ValidColumn,
+			excusedabsence.Table:        excusedabsence.ValidColumn,
+			idempotencykey.Table:        idempotencykey.ValidColumn,
+			invoice.Table:               invoice.ValidColumn,
+			invoiceline.Table:           invoiceline.ValidColumn,
+			latefee.Table:               latefee.ValidColumn,
+			lead.Table:                  lead.ValidColumn,
+			lessonpackage.Table:         lessonpackage.ValidColumn,
+			payment.Table:               payment.ValidColumn,
+			paymentplan.Table:           paymentplan.ValidColumn,
+			paymentplaninstalment.Table: paymentplaninstalment.ValidColumn,
+			room.Table:                  room.ValidColumn,
+			scheduleslot.Table:          scheduleslot.ValidColumn,
+			settings.Table:              settings.ValidColumn,
+			student.Table:               student.ValidColumn,
+			studentcharge.Table:         studentcharge.ValidColumn,
+			teacher.Table:               teacher.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			waitlistentry.Table:         waitlistentry.ValidColumn,
+			websession.Table:            websession.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
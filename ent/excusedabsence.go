@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ExcusedAbsence is the model entity for the ExcusedAbsence schema.
+type ExcusedAbsence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID int `json:"course_id,omitempty"`
+	// LessonDate holds the value of the "lesson_date" field.
+	LessonDate time.Time `json:"lesson_date,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Resolution holds the value of the "resolution" field.
+	Resolution excusedabsence.Resolution `json:"resolution,omitempty"`
+	// MakeupDueOn holds the value of the "makeup_due_on" field.
+	MakeupDueOn *time.Time `json:"makeup_due_on,omitempty"`
+	// MakeupCourseID holds the value of the "makeup_course_id" field.
+	MakeupCourseID *int `json:"makeup_course_id,omitempty"`
+	// MadeUpOn holds the value of the "made_up_on" field.
+	MadeUpOn *time.Time `json:"made_up_on,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExcusedAbsenceQuery when eager-loading is set.
+	Edges        ExcusedAbsenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExcusedAbsenceEdges holds the relations/edges for other nodes in the graph.
+type ExcusedAbsenceEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// InvoiceLines holds the value of the invoice_lines edge.
+	InvoiceLines []*InvoiceLine `json:"invoice_lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExcusedAbsenceEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExcusedAbsenceEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// InvoiceLinesOrErr returns the InvoiceLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExcusedAbsenceEdges) InvoiceLinesOrErr() ([]*InvoiceLine, error) {
+	if e.loadedTypes[2] {
+		return e.InvoiceLines, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice_lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExcusedAbsence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case excusedabsence.FieldID, excusedabsence.FieldStudentID, excusedabsence.FieldCourseID, excusedabsence.FieldMakeupCourseID:
+			values[i] = new(sql.NullInt64)
+		case excusedabsence.FieldReason, excusedabsence.FieldResolution, excusedabsence.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case excusedabsence.FieldLessonDate, excusedabsence.FieldMakeupDueOn, excusedabsence.FieldMadeUpOn, excusedabsence.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExcusedAbsence fields.
+func (_m *ExcusedAbsence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case excusedabsence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case excusedabsence.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case excusedabsence.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = int(value.Int64)
+			}
+		case excusedabsence.FieldLessonDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lesson_date", values[i])
+			} else if value.Valid {
+				_m.LessonDate = value.Time
+			}
+		case excusedabsence.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case excusedabsence.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				_m.Resolution = excusedabsence.Resolution(value.String)
+			}
+		case excusedabsence.FieldMakeupDueOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field makeup_due_on", values[i])
+			} else if value.Valid {
+				_m.MakeupDueOn = new(time.Time)
+				*_m.MakeupDueOn = value.Time
+			}
+		case excusedabsence.FieldMakeupCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field makeup_course_id", values[i])
+			} else if value.Valid {
+				_m.MakeupCourseID = new(int)
+				*_m.MakeupCourseID = int(value.Int64)
+			}
+		case excusedabsence.FieldMadeUpOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field made_up_on", values[i])
+			} else if value.Valid {
+				_m.MadeUpOn = new(time.Time)
+				*_m.MadeUpOn = value.Time
+			}
+		case excusedabsence.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case excusedabsence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExcusedAbsence.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExcusedAbsence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the ExcusedAbsence entity.
+func (_m *ExcusedAbsence) QueryStudent() *StudentQuery {
+	return NewExcusedAbsenceClient(_m.config).QueryStudent(_m)
+}
+
+// QueryCourse queries the "course" edge of the ExcusedAbsence entity.
+func (_m *ExcusedAbsence) QueryCourse() *CourseQuery {
+	return NewExcusedAbsenceClient(_m.config).QueryCourse(_m)
+}
+
+// QueryInvoiceLines queries the "invoice_lines" edge of the ExcusedAbsence entity.
+func (_m *ExcusedAbsence) QueryInvoiceLines() *InvoiceLineQuery {
+	return NewExcusedAbsenceClient(_m.config).QueryInvoiceLines(_m)
+}
+
+// Update returns a builder for updating this ExcusedAbsence.
+// Note that you need to call ExcusedAbsence.Unwrap() before calling this method if this ExcusedAbsence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExcusedAbsence) Update() *ExcusedAbsenceUpdateOne {
+	return NewExcusedAbsenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExcusedAbsence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExcusedAbsence) Unwrap() *ExcusedAbsence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExcusedAbsence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExcusedAbsence) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExcusedAbsence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("course_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CourseID))
+	builder.WriteString(", ")
+	builder.WriteString("lesson_date=")
+	builder.WriteString(_m.LessonDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Resolution))
+	builder.WriteString(", ")
+	if v := _m.MakeupDueOn; v != nil {
+		builder.WriteString("makeup_due_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MakeupCourseID; v != nil {
+		builder.WriteString("makeup_course_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MadeUpOn; v != nil {
+		builder.WriteString("made_up_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExcusedAbsences is a parsable slice of ExcusedAbsence.
+type ExcusedAbsences []*ExcusedAbsence
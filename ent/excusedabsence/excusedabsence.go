@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package excusedabsence
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the excusedabsence type in the database.
+	Label = "excused_absence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldCourseID holds the string denoting the course_id field in the database.
+	FieldCourseID = "course_id"
+	// FieldLessonDate holds the string denoting the lesson_date field in the database.
+	FieldLessonDate = "lesson_date"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldMakeupDueOn holds the string denoting the makeup_due_on field in the database.
+	FieldMakeupDueOn = "makeup_due_on"
+	// FieldMakeupCourseID holds the string denoting the makeup_course_id field in the database.
+	FieldMakeupCourseID = "makeup_course_id"
+	// FieldMadeUpOn holds the string denoting the made_up_on field in the database.
+	FieldMadeUpOn = "made_up_on"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// EdgeInvoiceLines holds the string denoting the invoice_lines edge name in mutations.
+	EdgeInvoiceLines = "invoice_lines"
+	// Table holds the table name of the excusedabsence in the database.
+	Table = "excused_absences"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "excused_absences"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "excused_absences"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "course_id"
+	// InvoiceLinesTable is the table that holds the invoice_lines relation/edge.
+	InvoiceLinesTable = "invoice_lines"
+	// InvoiceLinesInverseTable is the table name for the InvoiceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoiceline" package.
+	InvoiceLinesInverseTable = "invoice_lines"
+	// InvoiceLinesColumn is the table column denoting the invoice_lines relation/edge.
+	InvoiceLinesColumn = "absence_id"
+)
+
+// Columns holds all SQL columns for excusedabsence fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldCourseID,
+	FieldLessonDate,
+	FieldReason,
+	FieldResolution,
+	FieldMakeupDueOn,
+	FieldMakeupCourseID,
+	FieldMadeUpOn,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Resolution defines the type for the "resolution" enum field.
+type Resolution string
+
+// Resolution values.
+const (
+	ResolutionMakeup Resolution = "makeup"
+	ResolutionCredit Resolution = "credit"
+)
+
+func (r Resolution) String() string {
+	return string(r)
+}
+
+// ResolutionValidator is a validator for the "resolution" field enum values. It is called by the builders before save.
+func ResolutionValidator(r Resolution) error {
+	switch r {
+	case ResolutionMakeup, ResolutionCredit:
+		return nil
+	default:
+		return fmt.Errorf("excusedabsence: invalid enum value for resolution field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the ExcusedAbsence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByCourseID orders the results by the course_id field.
+func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
+}
+
+// ByLessonDate orders the results by the lesson_date field.
+func ByLessonDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLessonDate, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByMakeupDueOn orders the results by the makeup_due_on field.
+func ByMakeupDueOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMakeupDueOn, opts...).ToFunc()
+}
+
+// ByMakeupCourseID orders the results by the makeup_course_id field.
+func ByMakeupCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMakeupCourseID, opts...).ToFunc()
+}
+
+// ByMadeUpOn orders the results by the made_up_on field.
+func ByMadeUpOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMadeUpOn, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceLinesCount orders the results by invoice_lines count.
+func ByInvoiceLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoiceLinesStep(), opts...)
+	}
+}
+
+// ByInvoiceLines orders the results by invoice_lines terms.
+func ByInvoiceLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+	)
+}
+func newInvoiceLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvoiceLinesTable, InvoiceLinesColumn),
+	)
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package excusedabsence
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldStudentID, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCourseID, v))
+}
+
+// LessonDate applies equality check predicate on the "lesson_date" field. It's identical to LessonDateEQ.
+func LessonDate(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldLessonDate, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldReason, v))
+}
+
+// MakeupDueOn applies equality check predicate on the "makeup_due_on" field. It's identical to MakeupDueOnEQ.
+func MakeupDueOn(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMakeupDueOn, v))
+}
+
+// MakeupCourseID applies equality check predicate on the "makeup_course_id" field. It's identical to MakeupCourseIDEQ.
+func MakeupCourseID(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMakeupCourseID, v))
+}
+
+// MadeUpOn applies equality check predicate on the "made_up_on" field. It's identical to MadeUpOnEQ.
+func MadeUpOn(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMadeUpOn, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// LessonDateEQ applies the EQ predicate on the "lesson_date" field.
+func LessonDateEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldLessonDate, v))
+}
+
+// LessonDateNEQ applies the NEQ predicate on the "lesson_date" field.
+func LessonDateNEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldLessonDate, v))
+}
+
+// LessonDateIn applies the In predicate on the "lesson_date" field.
+func LessonDateIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldLessonDate, vs...))
+}
+
+// LessonDateNotIn applies the NotIn predicate on the "lesson_date" field.
+func LessonDateNotIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldLessonDate, vs...))
+}
+
+// LessonDateGT applies the GT predicate on the "lesson_date" field.
+func LessonDateGT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldLessonDate, v))
+}
+
+// LessonDateGTE applies the GTE predicate on the "lesson_date" field.
+func LessonDateGTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldLessonDate, v))
+}
+
+// LessonDateLT applies the LT predicate on the "lesson_date" field.
+func LessonDateLT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldLessonDate, v))
+}
+
+// LessonDateLTE applies the LTE predicate on the "lesson_date" field.
+func LessonDateLTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldLessonDate, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v Resolution) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v Resolution) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...Resolution) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...Resolution) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// MakeupDueOnEQ applies the EQ predicate on the "makeup_due_on" field.
+func MakeupDueOnEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnNEQ applies the NEQ predicate on the "makeup_due_on" field.
+func MakeupDueOnNEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnIn applies the In predicate on the "makeup_due_on" field.
+func MakeupDueOnIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldMakeupDueOn, vs...))
+}
+
+// MakeupDueOnNotIn applies the NotIn predicate on the "makeup_due_on" field.
+func MakeupDueOnNotIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldMakeupDueOn, vs...))
+}
+
+// MakeupDueOnGT applies the GT predicate on the "makeup_due_on" field.
+func MakeupDueOnGT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnGTE applies the GTE predicate on the "makeup_due_on" field.
+func MakeupDueOnGTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnLT applies the LT predicate on the "makeup_due_on" field.
+func MakeupDueOnLT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnLTE applies the LTE predicate on the "makeup_due_on" field.
+func MakeupDueOnLTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldMakeupDueOn, v))
+}
+
+// MakeupDueOnIsNil applies the IsNil predicate on the "makeup_due_on" field.
+func MakeupDueOnIsNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIsNull(FieldMakeupDueOn))
+}
+
+// MakeupDueOnNotNil applies the NotNil predicate on the "makeup_due_on" field.
+func MakeupDueOnNotNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotNull(FieldMakeupDueOn))
+}
+
+// MakeupCourseIDEQ applies the EQ predicate on the "makeup_course_id" field.
+func MakeupCourseIDEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDNEQ applies the NEQ predicate on the "makeup_course_id" field.
+func MakeupCourseIDNEQ(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDIn applies the In predicate on the "makeup_course_id" field.
+func MakeupCourseIDIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldMakeupCourseID, vs...))
+}
+
+// MakeupCourseIDNotIn applies the NotIn predicate on the "makeup_course_id" field.
+func MakeupCourseIDNotIn(vs ...int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldMakeupCourseID, vs...))
+}
+
+// MakeupCourseIDGT applies the GT predicate on the "makeup_course_id" field.
+func MakeupCourseIDGT(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDGTE applies the GTE predicate on the "makeup_course_id" field.
+func MakeupCourseIDGTE(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDLT applies the LT predicate on the "makeup_course_id" field.
+func MakeupCourseIDLT(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDLTE applies the LTE predicate on the "makeup_course_id" field.
+func MakeupCourseIDLTE(v int) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldMakeupCourseID, v))
+}
+
+// MakeupCourseIDIsNil applies the IsNil predicate on the "makeup_course_id" field.
+func MakeupCourseIDIsNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIsNull(FieldMakeupCourseID))
+}
+
+// MakeupCourseIDNotNil applies the NotNil predicate on the "makeup_course_id" field.
+func MakeupCourseIDNotNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotNull(FieldMakeupCourseID))
+}
+
+// MadeUpOnEQ applies the EQ predicate on the "made_up_on" field.
+func MadeUpOnEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldMadeUpOn, v))
+}
+
+// MadeUpOnNEQ applies the NEQ predicate on the "made_up_on" field.
+func MadeUpOnNEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldMadeUpOn, v))
+}
+
+// MadeUpOnIn applies the In predicate on the "made_up_on" field.
+func MadeUpOnIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldMadeUpOn, vs...))
+}
+
+// MadeUpOnNotIn applies the NotIn predicate on the "made_up_on" field.
+func MadeUpOnNotIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldMadeUpOn, vs...))
+}
+
+// MadeUpOnGT applies the GT predicate on the "made_up_on" field.
+func MadeUpOnGT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldMadeUpOn, v))
+}
+
+// MadeUpOnGTE applies the GTE predicate on the "made_up_on" field.
+func MadeUpOnGTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldMadeUpOn, v))
+}
+
+// MadeUpOnLT applies the LT predicate on the "made_up_on" field.
+func MadeUpOnLT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldMadeUpOn, v))
+}
+
+// MadeUpOnLTE applies the LTE predicate on the "made_up_on" field.
+func MadeUpOnLTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldMadeUpOn, v))
+}
+
+// MadeUpOnIsNil applies the IsNil predicate on the "made_up_on" field.
+func MadeUpOnIsNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIsNull(FieldMadeUpOn))
+}
+
+// MadeUpOnNotNil applies the NotNil predicate on the "made_up_on" field.
+func MadeUpOnNotNil() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotNull(FieldMadeUpOn))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoiceLines applies the HasEdge predicate on the "invoice_lines" edge.
+func HasInvoiceLines() predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoiceLinesTable, InvoiceLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceLinesWith applies the HasEdge predicate on the "invoice_lines" edge with a given conditions (other predicates).
+func HasInvoiceLinesWith(preds ...predicate.InvoiceLine) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(func(s *sql.Selector) {
+		step := newInvoiceLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExcusedAbsence) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExcusedAbsence) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExcusedAbsence) predicate.ExcusedAbsence {
+	return predicate.ExcusedAbsence(sql.NotPredicates(p))
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/invoiceline"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExcusedAbsenceCreate is the builder for creating a ExcusedAbsence entity.
+type ExcusedAbsenceCreate struct {
+	config
+	mutation *ExcusedAbsenceMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *ExcusedAbsenceCreate) SetStudentID(v int) *ExcusedAbsenceCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *ExcusedAbsenceCreate) SetCourseID(v int) *ExcusedAbsenceCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetLessonDate sets the "lesson_date" field.
+func (_c *ExcusedAbsenceCreate) SetLessonDate(v time.Time) *ExcusedAbsenceCreate {
+	_c.mutation.SetLessonDate(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ExcusedAbsenceCreate) SetReason(v string) *ExcusedAbsenceCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableReason(v *string) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetResolution sets the "resolution" field.
+func (_c *ExcusedAbsenceCreate) SetResolution(v excusedabsence.Resolution) *ExcusedAbsenceCreate {
+	_c.mutation.SetResolution(v)
+	return _c
+}
+
+// SetMakeupDueOn sets the "makeup_due_on" field.
+func (_c *ExcusedAbsenceCreate) SetMakeupDueOn(v time.Time) *ExcusedAbsenceCreate {
+	_c.mutation.SetMakeupDueOn(v)
+	return _c
+}
+
+// SetNillableMakeupDueOn sets the "makeup_due_on" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableMakeupDueOn(v *time.Time) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetMakeupDueOn(*v)
+	}
+	return _c
+}
+
+// SetMakeupCourseID sets the "makeup_course_id" field.
+func (_c *ExcusedAbsenceCreate) SetMakeupCourseID(v int) *ExcusedAbsenceCreate {
+	_c.mutation.SetMakeupCourseID(v)
+	return _c
+}
+
+// SetNillableMakeupCourseID sets the "makeup_course_id" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableMakeupCourseID(v *int) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetMakeupCourseID(*v)
+	}
+	return _c
+}
+
+// SetMadeUpOn sets the "made_up_on" field.
+func (_c *ExcusedAbsenceCreate) SetMadeUpOn(v time.Time) *ExcusedAbsenceCreate {
+	_c.mutation.SetMadeUpOn(v)
+	return _c
+}
+
+// SetNillableMadeUpOn sets the "made_up_on" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableMadeUpOn(v *time.Time) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetMadeUpOn(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ExcusedAbsenceCreate) SetCreatedBy(v string) *ExcusedAbsenceCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableCreatedBy(v *string) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExcusedAbsenceCreate) SetCreatedAt(v time.Time) *ExcusedAbsenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExcusedAbsenceCreate) SetNillableCreatedAt(v *time.Time) *ExcusedAbsenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *ExcusedAbsenceCreate) SetStudent(v *Student) *ExcusedAbsenceCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *ExcusedAbsenceCreate) SetCourse(v *Course) *ExcusedAbsenceCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_c *ExcusedAbsenceCreate) AddInvoiceLineIDs(ids ...int) *ExcusedAbsenceCreate {
+	_c.mutation.AddInvoiceLineIDs(ids...)
+	return _c
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_c *ExcusedAbsenceCreate) AddInvoiceLines(v ...*InvoiceLine) *ExcusedAbsenceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the ExcusedAbsenceMutation object of the builder.
+func (_c *ExcusedAbsenceCreate) Mutation() *ExcusedAbsenceMutation {
+	return _c.mutation
+}
+
+// Save creates the ExcusedAbsence in the database.
+func (_c *ExcusedAbsenceCreate) Save(ctx context.Context) (*ExcusedAbsence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExcusedAbsenceCreate) SaveX(ctx context.Context) *ExcusedAbsence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExcusedAbsenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExcusedAbsenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExcusedAbsenceCreate) defaults() {
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := excusedabsence.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := excusedabsence.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := excusedabsence.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExcusedAbsenceCreate) check() error {
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "ExcusedAbsence.student_id"`)}
+	}
+	if _, ok := _c.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "ExcusedAbsence.course_id"`)}
+	}
+	if _, ok := _c.mutation.LessonDate(); !ok {
+		return &ValidationError{Name: "lesson_date", err: errors.New(`ent: missing required field "ExcusedAbsence.lesson_date"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ExcusedAbsence.reason"`)}
+	}
+	if _, ok := _c.mutation.Resolution(); !ok {
+		return &ValidationError{Name: "resolution", err: errors.New(`ent: missing required field "ExcusedAbsence.resolution"`)}
+	}
+	if v, ok := _c.mutation.Resolution(); ok {
+		if err := excusedabsence.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ExcusedAbsence.resolution": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ExcusedAbsence.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExcusedAbsence.created_at"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "ExcusedAbsence.student"`)}
+	}
+	if len(_c.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "ExcusedAbsence.course"`)}
+	}
+	return nil
+}
+
+func (_c *ExcusedAbsenceCreate) sqlSave(ctx context.Context) (*ExcusedAbsence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExcusedAbsenceCreate) createSpec() (*ExcusedAbsence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExcusedAbsence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(excusedabsence.Table, sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.LessonDate(); ok {
+		_spec.SetField(excusedabsence.FieldLessonDate, field.TypeTime, value)
+		_node.LessonDate = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(excusedabsence.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Resolution(); ok {
+		_spec.SetField(excusedabsence.FieldResolution, field.TypeEnum, value)
+		_node.Resolution = value
+	}
+	if value, ok := _c.mutation.MakeupDueOn(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupDueOn, field.TypeTime, value)
+		_node.MakeupDueOn = &value
+	}
+	if value, ok := _c.mutation.MakeupCourseID(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupCourseID, field.TypeInt, value)
+		_node.MakeupCourseID = &value
+	}
+	if value, ok := _c.mutation.MadeUpOn(); ok {
+		_spec.SetField(excusedabsence.FieldMadeUpOn, field.TypeTime, value)
+		_node.MadeUpOn = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.StudentTable,
+			Columns: []string{excusedabsence.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.CourseTable,
+			Columns: []string{excusedabsence.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExcusedAbsenceCreateBulk is the builder for creating many ExcusedAbsence entities in bulk.
+type ExcusedAbsenceCreateBulk struct {
+	config
+	err      error
+	builders []*ExcusedAbsenceCreate
+}
+
+// Save creates the ExcusedAbsence entities in the database.
+func (_c *ExcusedAbsenceCreateBulk) Save(ctx context.Context) ([]*ExcusedAbsence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExcusedAbsence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExcusedAbsenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExcusedAbsenceCreateBulk) SaveX(ctx context.Context) []*ExcusedAbsence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExcusedAbsenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExcusedAbsenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExcusedAbsenceDelete is the builder for deleting a ExcusedAbsence entity.
+type ExcusedAbsenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExcusedAbsenceMutation
+}
+
+// Where appends a list predicates to the ExcusedAbsenceDelete builder.
+func (_d *ExcusedAbsenceDelete) Where(ps ...predicate.ExcusedAbsence) *ExcusedAbsenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExcusedAbsenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExcusedAbsenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExcusedAbsenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(excusedabsence.Table, sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExcusedAbsenceDeleteOne is the builder for deleting a single ExcusedAbsence entity.
+type ExcusedAbsenceDeleteOne struct {
+	_d *ExcusedAbsenceDelete
+}
+
+// Where appends a list predicates to the ExcusedAbsenceDelete builder.
+func (_d *ExcusedAbsenceDeleteOne) Where(ps ...predicate.ExcusedAbsence) *ExcusedAbsenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExcusedAbsenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{excusedabsence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExcusedAbsenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
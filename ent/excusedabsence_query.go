@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/invoiceline"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExcusedAbsenceQuery is the builder for querying ExcusedAbsence entities.
+type ExcusedAbsenceQuery struct {
+	config
+	ctx              *QueryContext
+	order            []excusedabsence.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ExcusedAbsence
+	withStudent      *StudentQuery
+	withCourse       *CourseQuery
+	withInvoiceLines *InvoiceLineQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExcusedAbsenceQuery builder.
+func (_q *ExcusedAbsenceQuery) Where(ps ...predicate.ExcusedAbsence) *ExcusedAbsenceQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ExcusedAbsenceQuery) Limit(limit int) *ExcusedAbsenceQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ExcusedAbsenceQuery) Offset(offset int) *ExcusedAbsenceQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ExcusedAbsenceQuery) Unique(unique bool) *ExcusedAbsenceQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ExcusedAbsenceQuery) Order(o ...excusedabsence.OrderOption) *ExcusedAbsenceQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryStudent chains the current query on the "student" edge.
+func (_q *ExcusedAbsenceQuery) QueryStudent() *StudentQuery {
+	query := (&StudentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, selector),
+			sqlgraph.To(student.Table, student.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, excusedabsence.StudentTable, excusedabsence.StudentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCourse chains the current query on the "course" edge.
+func (_q *ExcusedAbsenceQuery) QueryCourse() *CourseQuery {
+	query := (&CourseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, selector),
+			sqlgraph.To(course.Table, course.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, excusedabsence.CourseTable, excusedabsence.CourseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoiceLines chains the current query on the "invoice_lines" edge.
+func (_q *ExcusedAbsenceQuery) QueryInvoiceLines() *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excusedabsence.Table, excusedabsence.FieldID, selector),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, excusedabsence.InvoiceLinesTable, excusedabsence.InvoiceLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExcusedAbsence entity from the query.
+// Returns a *NotFoundError when no ExcusedAbsence was found.
+func (_q *ExcusedAbsenceQuery) First(ctx context.Context) (*ExcusedAbsence, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{excusedabsence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) FirstX(ctx context.Context) *ExcusedAbsence {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExcusedAbsence ID from the query.
+// Returns a *NotFoundError when no ExcusedAbsence ID was found.
+func (_q *ExcusedAbsenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{excusedabsence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExcusedAbsence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExcusedAbsence entity is found.
+// Returns a *NotFoundError when no ExcusedAbsence entities are found.
+func (_q *ExcusedAbsenceQuery) Only(ctx context.Context) (*ExcusedAbsence, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{excusedabsence.Label}
+	default:
+		return nil, &NotSingularError{excusedabsence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) OnlyX(ctx context.Context) *ExcusedAbsence {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExcusedAbsence ID in the query.
+// Returns a *NotSingularError when more than one ExcusedAbsence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ExcusedAbsenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{excusedabsence.Label}
+	default:
+		err = &NotSingularError{excusedabsence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExcusedAbsences.
+func (_q *ExcusedAbsenceQuery) All(ctx context.Context) ([]*ExcusedAbsence, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExcusedAbsence, *ExcusedAbsenceQuery]()
+	return withInterceptors[[]*ExcusedAbsence](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) AllX(ctx context.Context) []*ExcusedAbsence {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExcusedAbsence IDs.
+func (_q *ExcusedAbsenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(excusedabsence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ExcusedAbsenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ExcusedAbsenceQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ExcusedAbsenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ExcusedAbsenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExcusedAbsenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ExcusedAbsenceQuery) Clone() *ExcusedAbsenceQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ExcusedAbsenceQuery{
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]excusedabsence.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.ExcusedAbsence{}, _q.predicates...),
+		withStudent:      _q.withStudent.Clone(),
+		withCourse:       _q.withCourse.Clone(),
+		withInvoiceLines: _q.withInvoiceLines.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithStudent tells the query-builder to eager-load the nodes that are connected to
+// the "student" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ExcusedAbsenceQuery) WithStudent(opts ...func(*StudentQuery)) *ExcusedAbsenceQuery {
+	query := (&StudentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStudent = query
+	return _q
+}
+
+// WithCourse tells the query-builder to eager-load the nodes that are connected to
+// the "course" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ExcusedAbsenceQuery) WithCourse(opts ...func(*CourseQuery)) *ExcusedAbsenceQuery {
+	query := (&CourseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCourse = query
+	return _q
+}
+
+// WithInvoiceLines tells the query-builder to eager-load the nodes that are connected to
+// the "invoice_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ExcusedAbsenceQuery) WithInvoiceLines(opts ...func(*InvoiceLineQuery)) *ExcusedAbsenceQuery {
+	query := (&InvoiceLineClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvoiceLines = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		StudentID int `json:"student_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExcusedAbsence.Query().
+//		GroupBy(excusedabsence.FieldStudentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ExcusedAbsenceQuery) GroupBy(field string, fields ...string) *ExcusedAbsenceGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExcusedAbsenceGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = excusedabsence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		StudentID int `json:"student_id,omitempty"`
+//	}
+//
+//	client.ExcusedAbsence.Query().
+//		Select(excusedabsence.FieldStudentID).
+//		Scan(ctx, &v)
+func (_q *ExcusedAbsenceQuery) Select(fields ...string) *ExcusedAbsenceSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ExcusedAbsenceSelect{ExcusedAbsenceQuery: _q}
+	sbuild.label = excusedabsence.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExcusedAbsenceSelect configured with the given aggregations.
+func (_q *ExcusedAbsenceQuery) Aggregate(fns ...AggregateFunc) *ExcusedAbsenceSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ExcusedAbsenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !excusedabsence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ExcusedAbsenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExcusedAbsence, error) {
+	var (
+		nodes       = []*ExcusedAbsence{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withStudent != nil,
+			_q.withCourse != nil,
+			_q.withInvoiceLines != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExcusedAbsence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExcusedAbsence{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withStudent; query != nil {
+		if err := _q.loadStudent(ctx, query, nodes, nil,
+			func(n *ExcusedAbsence, e *Student) { n.Edges.Student = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCourse; query != nil {
+		if err := _q.loadCourse(ctx, query, nodes, nil,
+			func(n *ExcusedAbsence, e *Course) { n.Edges.Course = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvoiceLines; query != nil {
+		if err := _q.loadInvoiceLines(ctx, query, nodes,
+			func(n *ExcusedAbsence) { n.Edges.InvoiceLines = []*InvoiceLine{} },
+			func(n *ExcusedAbsence, e *InvoiceLine) { n.Edges.InvoiceLines = append(n.Edges.InvoiceLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ExcusedAbsenceQuery) loadStudent(ctx context.Context, query *StudentQuery, nodes []*ExcusedAbsence, init func(*ExcusedAbsence), assign func(*ExcusedAbsence, *Student)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ExcusedAbsence)
+	for i := range nodes {
+		fk := nodes[i].StudentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(student.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "student_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ExcusedAbsenceQuery) loadCourse(ctx context.Context, query *CourseQuery, nodes []*ExcusedAbsence, init func(*ExcusedAbsence), assign func(*ExcusedAbsence, *Course)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ExcusedAbsence)
+	for i := range nodes {
+		fk := nodes[i].CourseID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(course.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "course_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ExcusedAbsenceQuery) loadInvoiceLines(ctx context.Context, query *InvoiceLineQuery, nodes []*ExcusedAbsence, init func(*ExcusedAbsence), assign func(*ExcusedAbsence, *InvoiceLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ExcusedAbsence)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invoiceline.FieldAbsenceID)
+	}
+	query.Where(predicate.InvoiceLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(excusedabsence.InvoiceLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AbsenceID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "absence_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "absence_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *ExcusedAbsenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ExcusedAbsenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(excusedabsence.Table, excusedabsence.Columns, sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, excusedabsence.FieldID)
+		for i := range fields {
+			if fields[i] != excusedabsence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withStudent != nil {
+			_spec.Node.AddColumnOnce(excusedabsence.FieldStudentID)
+		}
+		if _q.withCourse != nil {
+			_spec.Node.AddColumnOnce(excusedabsence.FieldCourseID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ExcusedAbsenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(excusedabsence.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = excusedabsence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExcusedAbsenceGroupBy is the group-by builder for ExcusedAbsence entities.
+type ExcusedAbsenceGroupBy struct {
+	selector
+	build *ExcusedAbsenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ExcusedAbsenceGroupBy) Aggregate(fns ...AggregateFunc) *ExcusedAbsenceGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ExcusedAbsenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExcusedAbsenceQuery, *ExcusedAbsenceGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ExcusedAbsenceGroupBy) sqlScan(ctx context.Context, root *ExcusedAbsenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExcusedAbsenceSelect is the builder for selecting fields of ExcusedAbsence entities.
+type ExcusedAbsenceSelect struct {
+	*ExcusedAbsenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ExcusedAbsenceSelect) Aggregate(fns ...AggregateFunc) *ExcusedAbsenceSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ExcusedAbsenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExcusedAbsenceQuery, *ExcusedAbsenceSelect](ctx, _s.ExcusedAbsenceQuery, _s, _s.inters, v)
+}
+
+func (_s *ExcusedAbsenceSelect) sqlScan(ctx context.Context, root *ExcusedAbsenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/invoiceline"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExcusedAbsenceUpdate is the builder for updating ExcusedAbsence entities.
+type ExcusedAbsenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExcusedAbsenceMutation
+}
+
+// Where appends a list predicates to the ExcusedAbsenceUpdate builder.
+func (_u *ExcusedAbsenceUpdate) Where(ps ...predicate.ExcusedAbsence) *ExcusedAbsenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *ExcusedAbsenceUpdate) SetStudentID(v int) *ExcusedAbsenceUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableStudentID(v *int) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *ExcusedAbsenceUpdate) SetCourseID(v int) *ExcusedAbsenceUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableCourseID(v *int) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetLessonDate sets the "lesson_date" field.
+func (_u *ExcusedAbsenceUpdate) SetLessonDate(v time.Time) *ExcusedAbsenceUpdate {
+	_u.mutation.SetLessonDate(v)
+	return _u
+}
+
+// SetNillableLessonDate sets the "lesson_date" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableLessonDate(v *time.Time) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetLessonDate(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExcusedAbsenceUpdate) SetReason(v string) *ExcusedAbsenceUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableReason(v *string) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *ExcusedAbsenceUpdate) SetResolution(v excusedabsence.Resolution) *ExcusedAbsenceUpdate {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableResolution(v *excusedabsence.Resolution) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// SetMakeupDueOn sets the "makeup_due_on" field.
+func (_u *ExcusedAbsenceUpdate) SetMakeupDueOn(v time.Time) *ExcusedAbsenceUpdate {
+	_u.mutation.SetMakeupDueOn(v)
+	return _u
+}
+
+// SetNillableMakeupDueOn sets the "makeup_due_on" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableMakeupDueOn(v *time.Time) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetMakeupDueOn(*v)
+	}
+	return _u
+}
+
+// ClearMakeupDueOn clears the value of the "makeup_due_on" field.
+func (_u *ExcusedAbsenceUpdate) ClearMakeupDueOn() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearMakeupDueOn()
+	return _u
+}
+
+// SetMakeupCourseID sets the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdate) SetMakeupCourseID(v int) *ExcusedAbsenceUpdate {
+	_u.mutation.ResetMakeupCourseID()
+	_u.mutation.SetMakeupCourseID(v)
+	return _u
+}
+
+// SetNillableMakeupCourseID sets the "makeup_course_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableMakeupCourseID(v *int) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetMakeupCourseID(*v)
+	}
+	return _u
+}
+
+// AddMakeupCourseID adds value to the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdate) AddMakeupCourseID(v int) *ExcusedAbsenceUpdate {
+	_u.mutation.AddMakeupCourseID(v)
+	return _u
+}
+
+// ClearMakeupCourseID clears the value of the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdate) ClearMakeupCourseID() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearMakeupCourseID()
+	return _u
+}
+
+// SetMadeUpOn sets the "made_up_on" field.
+func (_u *ExcusedAbsenceUpdate) SetMadeUpOn(v time.Time) *ExcusedAbsenceUpdate {
+	_u.mutation.SetMadeUpOn(v)
+	return _u
+}
+
+// SetNillableMadeUpOn sets the "made_up_on" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableMadeUpOn(v *time.Time) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetMadeUpOn(*v)
+	}
+	return _u
+}
+
+// ClearMadeUpOn clears the value of the "made_up_on" field.
+func (_u *ExcusedAbsenceUpdate) ClearMadeUpOn() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearMadeUpOn()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ExcusedAbsenceUpdate) SetCreatedBy(v string) *ExcusedAbsenceUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableCreatedBy(v *string) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ExcusedAbsenceUpdate) SetCreatedAt(v time.Time) *ExcusedAbsenceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdate) SetNillableCreatedAt(v *time.Time) *ExcusedAbsenceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *ExcusedAbsenceUpdate) SetStudent(v *Student) *ExcusedAbsenceUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *ExcusedAbsenceUpdate) SetCourse(v *Course) *ExcusedAbsenceUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_u *ExcusedAbsenceUpdate) AddInvoiceLineIDs(ids ...int) *ExcusedAbsenceUpdate {
+	_u.mutation.AddInvoiceLineIDs(ids...)
+	return _u
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_u *ExcusedAbsenceUpdate) AddInvoiceLines(v ...*InvoiceLine) *ExcusedAbsenceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the ExcusedAbsenceMutation object of the builder.
+func (_u *ExcusedAbsenceUpdate) Mutation() *ExcusedAbsenceMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *ExcusedAbsenceUpdate) ClearStudent() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *ExcusedAbsenceUpdate) ClearCourse() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoiceLines clears all "invoice_lines" edges to the InvoiceLine entity.
+func (_u *ExcusedAbsenceUpdate) ClearInvoiceLines() *ExcusedAbsenceUpdate {
+	_u.mutation.ClearInvoiceLines()
+	return _u
+}
+
+// RemoveInvoiceLineIDs removes the "invoice_lines" edge to InvoiceLine entities by IDs.
+func (_u *ExcusedAbsenceUpdate) RemoveInvoiceLineIDs(ids ...int) *ExcusedAbsenceUpdate {
+	_u.mutation.RemoveInvoiceLineIDs(ids...)
+	return _u
+}
+
+// RemoveInvoiceLines removes "invoice_lines" edges to InvoiceLine entities.
+func (_u *ExcusedAbsenceUpdate) RemoveInvoiceLines(v ...*InvoiceLine) *ExcusedAbsenceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExcusedAbsenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExcusedAbsenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExcusedAbsenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExcusedAbsenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExcusedAbsenceUpdate) check() error {
+	if v, ok := _u.mutation.Resolution(); ok {
+		if err := excusedabsence.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ExcusedAbsence.resolution": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExcusedAbsence.student"`)
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExcusedAbsence.course"`)
+	}
+	return nil
+}
+
+func (_u *ExcusedAbsenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(excusedabsence.Table, excusedabsence.Columns, sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LessonDate(); ok {
+		_spec.SetField(excusedabsence.FieldLessonDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(excusedabsence.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(excusedabsence.FieldResolution, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MakeupDueOn(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupDueOn, field.TypeTime, value)
+	}
+	if _u.mutation.MakeupDueOnCleared() {
+		_spec.ClearField(excusedabsence.FieldMakeupDueOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MakeupCourseID(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupCourseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMakeupCourseID(); ok {
+		_spec.AddField(excusedabsence.FieldMakeupCourseID, field.TypeInt, value)
+	}
+	if _u.mutation.MakeupCourseIDCleared() {
+		_spec.ClearField(excusedabsence.FieldMakeupCourseID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MadeUpOn(); ok {
+		_spec.SetField(excusedabsence.FieldMadeUpOn, field.TypeTime, value)
+	}
+	if _u.mutation.MadeUpOnCleared() {
+		_spec.ClearField(excusedabsence.FieldMadeUpOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.StudentTable,
+			Columns: []string{excusedabsence.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.StudentTable,
+			Columns: []string{excusedabsence.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.CourseTable,
+			Columns: []string{excusedabsence.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.CourseTable,
+			Columns: []string{excusedabsence.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoiceLinesIDs(); len(nodes) > 0 && !_u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{excusedabsence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExcusedAbsenceUpdateOne is the builder for updating a single ExcusedAbsence entity.
+type ExcusedAbsenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExcusedAbsenceMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *ExcusedAbsenceUpdateOne) SetStudentID(v int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableStudentID(v *int) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *ExcusedAbsenceUpdateOne) SetCourseID(v int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableCourseID(v *int) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetLessonDate sets the "lesson_date" field.
+func (_u *ExcusedAbsenceUpdateOne) SetLessonDate(v time.Time) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetLessonDate(v)
+	return _u
+}
+
+// SetNillableLessonDate sets the "lesson_date" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableLessonDate(v *time.Time) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetLessonDate(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ExcusedAbsenceUpdateOne) SetReason(v string) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableReason(v *string) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *ExcusedAbsenceUpdateOne) SetResolution(v excusedabsence.Resolution) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableResolution(v *excusedabsence.Resolution) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// SetMakeupDueOn sets the "makeup_due_on" field.
+func (_u *ExcusedAbsenceUpdateOne) SetMakeupDueOn(v time.Time) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetMakeupDueOn(v)
+	return _u
+}
+
+// SetNillableMakeupDueOn sets the "makeup_due_on" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableMakeupDueOn(v *time.Time) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetMakeupDueOn(*v)
+	}
+	return _u
+}
+
+// ClearMakeupDueOn clears the value of the "makeup_due_on" field.
+func (_u *ExcusedAbsenceUpdateOne) ClearMakeupDueOn() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearMakeupDueOn()
+	return _u
+}
+
+// SetMakeupCourseID sets the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdateOne) SetMakeupCourseID(v int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.ResetMakeupCourseID()
+	_u.mutation.SetMakeupCourseID(v)
+	return _u
+}
+
+// SetNillableMakeupCourseID sets the "makeup_course_id" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableMakeupCourseID(v *int) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetMakeupCourseID(*v)
+	}
+	return _u
+}
+
+// AddMakeupCourseID adds value to the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdateOne) AddMakeupCourseID(v int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.AddMakeupCourseID(v)
+	return _u
+}
+
+// ClearMakeupCourseID clears the value of the "makeup_course_id" field.
+func (_u *ExcusedAbsenceUpdateOne) ClearMakeupCourseID() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearMakeupCourseID()
+	return _u
+}
+
+// SetMadeUpOn sets the "made_up_on" field.
+func (_u *ExcusedAbsenceUpdateOne) SetMadeUpOn(v time.Time) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetMadeUpOn(v)
+	return _u
+}
+
+// SetNillableMadeUpOn sets the "made_up_on" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableMadeUpOn(v *time.Time) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetMadeUpOn(*v)
+	}
+	return _u
+}
+
+// ClearMadeUpOn clears the value of the "made_up_on" field.
+func (_u *ExcusedAbsenceUpdateOne) ClearMadeUpOn() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearMadeUpOn()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ExcusedAbsenceUpdateOne) SetCreatedBy(v string) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableCreatedBy(v *string) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ExcusedAbsenceUpdateOne) SetCreatedAt(v time.Time) *ExcusedAbsenceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ExcusedAbsenceUpdateOne) SetNillableCreatedAt(v *time.Time) *ExcusedAbsenceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *ExcusedAbsenceUpdateOne) SetStudent(v *Student) *ExcusedAbsenceUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *ExcusedAbsenceUpdateOne) SetCourse(v *Course) *ExcusedAbsenceUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_u *ExcusedAbsenceUpdateOne) AddInvoiceLineIDs(ids ...int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.AddInvoiceLineIDs(ids...)
+	return _u
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_u *ExcusedAbsenceUpdateOne) AddInvoiceLines(v ...*InvoiceLine) *ExcusedAbsenceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the ExcusedAbsenceMutation object of the builder.
+func (_u *ExcusedAbsenceUpdateOne) Mutation() *ExcusedAbsenceMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *ExcusedAbsenceUpdateOne) ClearStudent() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *ExcusedAbsenceUpdateOne) ClearCourse() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoiceLines clears all "invoice_lines" edges to the InvoiceLine entity.
+func (_u *ExcusedAbsenceUpdateOne) ClearInvoiceLines() *ExcusedAbsenceUpdateOne {
+	_u.mutation.ClearInvoiceLines()
+	return _u
+}
+
+// RemoveInvoiceLineIDs removes the "invoice_lines" edge to InvoiceLine entities by IDs.
+func (_u *ExcusedAbsenceUpdateOne) RemoveInvoiceLineIDs(ids ...int) *ExcusedAbsenceUpdateOne {
+	_u.mutation.RemoveInvoiceLineIDs(ids...)
+	return _u
+}
+
+// RemoveInvoiceLines removes "invoice_lines" edges to InvoiceLine entities.
+func (_u *ExcusedAbsenceUpdateOne) RemoveInvoiceLines(v ...*InvoiceLine) *ExcusedAbsenceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceLineIDs(ids...)
+}
+
+// Where appends a list predicates to the ExcusedAbsenceUpdate builder.
+func (_u *ExcusedAbsenceUpdateOne) Where(ps ...predicate.ExcusedAbsence) *ExcusedAbsenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExcusedAbsenceUpdateOne) Select(field string, fields ...string) *ExcusedAbsenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExcusedAbsence entity.
+func (_u *ExcusedAbsenceUpdateOne) Save(ctx context.Context) (*ExcusedAbsence, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExcusedAbsenceUpdateOne) SaveX(ctx context.Context) *ExcusedAbsence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExcusedAbsenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExcusedAbsenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExcusedAbsenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Resolution(); ok {
+		if err := excusedabsence.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ExcusedAbsence.resolution": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExcusedAbsence.student"`)
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExcusedAbsence.course"`)
+	}
+	return nil
+}
+
+func (_u *ExcusedAbsenceUpdateOne) sqlSave(ctx context.Context) (_node *ExcusedAbsence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(excusedabsence.Table, excusedabsence.Columns, sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExcusedAbsence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, excusedabsence.FieldID)
+		for _, f := range fields {
+			if !excusedabsence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != excusedabsence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LessonDate(); ok {
+		_spec.SetField(excusedabsence.FieldLessonDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(excusedabsence.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(excusedabsence.FieldResolution, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MakeupDueOn(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupDueOn, field.TypeTime, value)
+	}
+	if _u.mutation.MakeupDueOnCleared() {
+		_spec.ClearField(excusedabsence.FieldMakeupDueOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MakeupCourseID(); ok {
+		_spec.SetField(excusedabsence.FieldMakeupCourseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMakeupCourseID(); ok {
+		_spec.AddField(excusedabsence.FieldMakeupCourseID, field.TypeInt, value)
+	}
+	if _u.mutation.MakeupCourseIDCleared() {
+		_spec.ClearField(excusedabsence.FieldMakeupCourseID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MadeUpOn(); ok {
+		_spec.SetField(excusedabsence.FieldMadeUpOn, field.TypeTime, value)
+	}
+	if _u.mutation.MadeUpOnCleared() {
+		_spec.ClearField(excusedabsence.FieldMadeUpOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(excusedabsence.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.StudentTable,
+			Columns: []string{excusedabsence.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.StudentTable,
+			Columns: []string{excusedabsence.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.CourseTable,
+			Columns: []string{excusedabsence.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   excusedabsence.CourseTable,
+			Columns: []string{excusedabsence.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoiceLinesIDs(); len(nodes) > 0 && !_u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   excusedabsence.InvoiceLinesTable,
+			Columns: []string{excusedabsence.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExcusedAbsence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{excusedabsence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
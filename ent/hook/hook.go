@@ -32,6 +32,54 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The BillingTermFunc type is an adapter to allow the use of ordinary
+// function as BillingTerm mutator.
+type BillingTermFunc func(context.Context, *ent.BillingTermMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BillingTermFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BillingTermMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BillingTermMutation", m)
+}
+
+// The CashMovementFunc type is an adapter to allow the use of ordinary
+// function as CashMovement mutator.
+type CashMovementFunc func(context.Context, *ent.CashMovementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashMovementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashMovementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashMovementMutation", m)
+}
+
+// The CashReceiptFunc type is an adapter to allow the use of ordinary
+// function as CashReceipt mutator.
+type CashReceiptFunc func(context.Context, *ent.CashReceiptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashReceiptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashReceiptMutation", m)
+}
+
+// The CashSessionFunc type is an adapter to allow the use of ordinary
+// function as CashSession mutator.
+type CashSessionFunc func(context.Context, *ent.CashSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashSessionMutation", m)
+}
+
 // The CourseFunc type is an adapter to allow the use of ordinary
 // function as Course mutator.
 type CourseFunc func(context.Context, *ent.CourseMutation) (ent.Value, error)
@@ -56,6 +104,18 @@ func (f CourseMonthStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CourseMonthStatMutation", m)
 }
 
+// The CoursePriceFunc type is an adapter to allow the use of ordinary
+// function as CoursePrice mutator.
+type CoursePriceFunc func(context.Context, *ent.CoursePriceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoursePriceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoursePriceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoursePriceMutation", m)
+}
+
 // The EnrollmentFunc type is an adapter to allow the use of ordinary
 // function as Enrollment mutator.
 type EnrollmentFunc func(context.Context, *ent.EnrollmentMutation) (ent.Value, error)
@@ -68,6 +128,54 @@ func (f EnrollmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrollmentMutation", m)
 }
 
+// The EnrollmentPauseFunc type is an adapter to allow the use of ordinary
+// function as EnrollmentPause mutator.
+type EnrollmentPauseFunc func(context.Context, *ent.EnrollmentPauseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnrollmentPauseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnrollmentPauseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrollmentPauseMutation", m)
+}
+
+// The EnrollmentPriceFunc type is an adapter to allow the use of ordinary
+// function as EnrollmentPrice mutator.
+type EnrollmentPriceFunc func(context.Context, *ent.EnrollmentPriceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnrollmentPriceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnrollmentPriceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrollmentPriceMutation", m)
+}
+
+// The ExcusedAbsenceFunc type is an adapter to allow the use of ordinary
+// function as ExcusedAbsence mutator.
+type ExcusedAbsenceFunc func(context.Context, *ent.ExcusedAbsenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExcusedAbsenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExcusedAbsenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExcusedAbsenceMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
 // The InvoiceFunc type is an adapter to allow the use of ordinary
 // function as Invoice mutator.
 type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
@@ -92,6 +200,42 @@ func (f InvoiceLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceLineMutation", m)
 }
 
+// The LateFeeFunc type is an adapter to allow the use of ordinary
+// function as LateFee mutator.
+type LateFeeFunc func(context.Context, *ent.LateFeeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LateFeeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LateFeeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LateFeeMutation", m)
+}
+
+// The LeadFunc type is an adapter to allow the use of ordinary
+// function as Lead mutator.
+type LeadFunc func(context.Context, *ent.LeadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadMutation", m)
+}
+
+// The LessonPackageFunc type is an adapter to allow the use of ordinary
+// function as LessonPackage mutator.
+type LessonPackageFunc func(context.Context, *ent.LessonPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LessonPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LessonPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LessonPackageMutation", m)
+}
+
 // The PaymentFunc type is an adapter to allow the use of ordinary
 // function as Payment mutator.
 type PaymentFunc func(context.Context, *ent.PaymentMutation) (ent.Value, error)
@@ -104,6 +248,54 @@ func (f PaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentMutation", m)
 }
 
+// The PaymentPlanFunc type is an adapter to allow the use of ordinary
+// function as PaymentPlan mutator.
+type PaymentPlanFunc func(context.Context, *ent.PaymentPlanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentPlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentPlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentPlanMutation", m)
+}
+
+// The PaymentPlanInstalmentFunc type is an adapter to allow the use of ordinary
+// function as PaymentPlanInstalment mutator.
+type PaymentPlanInstalmentFunc func(context.Context, *ent.PaymentPlanInstalmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentPlanInstalmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentPlanInstalmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentPlanInstalmentMutation", m)
+}
+
+// The RoomFunc type is an adapter to allow the use of ordinary
+// function as Room mutator.
+type RoomFunc func(context.Context, *ent.RoomMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoomFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoomMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomMutation", m)
+}
+
+// The ScheduleSlotFunc type is an adapter to allow the use of ordinary
+// function as ScheduleSlot mutator.
+type ScheduleSlotFunc func(context.Context, *ent.ScheduleSlotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleSlotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduleSlotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduleSlotMutation", m)
+}
+
 // The SettingsFunc type is an adapter to allow the use of ordinary
 // function as Settings mutator.
 type SettingsFunc func(context.Context, *ent.SettingsMutation) (ent.Value, error)
@@ -128,6 +320,18 @@ func (f StudentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StudentMutation", m)
 }
 
+// The StudentChargeFunc type is an adapter to allow the use of ordinary
+// function as StudentCharge mutator.
+type StudentChargeFunc func(context.Context, *ent.StudentChargeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StudentChargeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StudentChargeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StudentChargeMutation", m)
+}
+
 // The TeacherFunc type is an adapter to allow the use of ordinary
 // function as Teacher mutator.
 type TeacherFunc func(context.Context, *ent.TeacherMutation) (ent.Value, error)
@@ -152,6 +356,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The WaitlistEntryFunc type is an adapter to allow the use of ordinary
+// function as WaitlistEntry mutator.
+type WaitlistEntryFunc func(context.Context, *ent.WaitlistEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaitlistEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaitlistEntryMutation", m)
+}
+
 // The WebSessionFunc type is an adapter to allow the use of ordinary
 // function as WebSession mutator.
 type WebSessionFunc func(context.Context, *ent.WebSessionMutation) (ent.Value, error)
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/invoice"
 	"langschool/ent/student"
 	"strings"
@@ -30,10 +31,18 @@ type Invoice struct {
 	LegacyTotalAmount float64 `json:"legacy_total_amount,omitempty"`
 	// TotalAmountCents holds the value of the "total_amount_cents" field.
 	TotalAmountCents int64 `json:"total_amount_cents,omitempty"`
+	// VatAmountCents holds the value of the "vat_amount_cents" field.
+	VatAmountCents int64 `json:"vat_amount_cents,omitempty"`
 	// Status holds the value of the "status" field.
 	Status invoice.Status `json:"status,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoice.Kind `json:"kind,omitempty"`
+	// BillingTermID holds the value of the "billing_term_id" field.
+	BillingTermID *int `json:"billing_term_id,omitempty"`
 	// Number holds the value of the "number" field.
 	Number *string `json:"number,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt *time.Time `json:"issued_at,omitempty"`
 	// PdfFilename holds the value of the "pdf_filename" field.
 	PdfFilename *string `json:"pdf_filename,omitempty"`
 	// PdfGeneratedAt holds the value of the "pdf_generated_at" field.
@@ -70,9 +79,17 @@ type InvoiceEdges struct {
 	Lines []*InvoiceLine `json:"lines,omitempty"`
 	// Payments holds the value of the payments edge.
 	Payments []*Payment `json:"payments,omitempty"`
+	// PaymentPlans holds the value of the payment_plans edge.
+	PaymentPlans []*PaymentPlan `json:"payment_plans,omitempty"`
+	// LateFees holds the value of the late_fees edge.
+	LateFees []*LateFee `json:"late_fees,omitempty"`
+	// LessonPackages holds the value of the lesson_packages edge.
+	LessonPackages []*LessonPackage `json:"lesson_packages,omitempty"`
+	// BillingTerm holds the value of the billing_term edge.
+	BillingTerm *BillingTerm `json:"billing_term,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // StudentOrErr returns the Student value or an error if the edge
@@ -104,6 +121,44 @@ func (e InvoiceEdges) PaymentsOrErr() ([]*Payment, error) {
 	return nil, &NotLoadedError{edge: "payments"}
 }
 
+// PaymentPlansOrErr returns the PaymentPlans value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) PaymentPlansOrErr() ([]*PaymentPlan, error) {
+	if e.loadedTypes[3] {
+		return e.PaymentPlans, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_plans"}
+}
+
+// LateFeesOrErr returns the LateFees value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LateFeesOrErr() ([]*LateFee, error) {
+	if e.loadedTypes[4] {
+		return e.LateFees, nil
+	}
+	return nil, &NotLoadedError{edge: "late_fees"}
+}
+
+// LessonPackagesOrErr returns the LessonPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LessonPackagesOrErr() ([]*LessonPackage, error) {
+	if e.loadedTypes[5] {
+		return e.LessonPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "lesson_packages"}
+}
+
+// BillingTermOrErr returns the BillingTerm value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) BillingTermOrErr() (*BillingTerm, error) {
+	if e.BillingTerm != nil {
+		return e.BillingTerm, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: billingterm.Label}
+	}
+	return nil, &NotLoadedError{edge: "billing_term"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -111,11 +166,11 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case invoice.FieldLegacyTotalAmount:
 			values[i] = new(sql.NullFloat64)
-		case invoice.FieldID, invoice.FieldVersion, invoice.FieldStudentID, invoice.FieldPeriodYear, invoice.FieldPeriodMonth, invoice.FieldTotalAmountCents, invoice.FieldPdfRevision, invoice.FieldLastEmailedRevision:
+		case invoice.FieldID, invoice.FieldVersion, invoice.FieldStudentID, invoice.FieldPeriodYear, invoice.FieldPeriodMonth, invoice.FieldTotalAmountCents, invoice.FieldVatAmountCents, invoice.FieldBillingTermID, invoice.FieldPdfRevision, invoice.FieldLastEmailedRevision:
 			values[i] = new(sql.NullInt64)
-		case invoice.FieldStatus, invoice.FieldNumber, invoice.FieldPdfFilename, invoice.FieldEmailDeliveryStatus, invoice.FieldLastEmailedTo, invoice.FieldLastEmailError:
+		case invoice.FieldStatus, invoice.FieldKind, invoice.FieldNumber, invoice.FieldPdfFilename, invoice.FieldEmailDeliveryStatus, invoice.FieldLastEmailedTo, invoice.FieldLastEmailError:
 			values[i] = new(sql.NullString)
-		case invoice.FieldPdfGeneratedAt, invoice.FieldLastEmailedAt, invoice.FieldLastEmailFailedAt, invoice.FieldCreatedAt, invoice.FieldUpdatedAt:
+		case invoice.FieldIssuedAt, invoice.FieldPdfGeneratedAt, invoice.FieldLastEmailedAt, invoice.FieldLastEmailFailedAt, invoice.FieldCreatedAt, invoice.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -174,12 +229,31 @@ func (_m *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TotalAmountCents = value.Int64
 			}
+		case invoice.FieldVatAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_amount_cents", values[i])
+			} else if value.Valid {
+				_m.VatAmountCents = value.Int64
+			}
 		case invoice.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = invoice.Status(value.String)
 			}
+		case invoice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = invoice.Kind(value.String)
+			}
+		case invoice.FieldBillingTermID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_term_id", values[i])
+			} else if value.Valid {
+				_m.BillingTermID = new(int)
+				*_m.BillingTermID = int(value.Int64)
+			}
 		case invoice.FieldNumber:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field number", values[i])
@@ -187,6 +261,13 @@ func (_m *Invoice) assignValues(columns []string, values []any) error {
 				_m.Number = new(string)
 				*_m.Number = value.String
 			}
+		case invoice.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				_m.IssuedAt = new(time.Time)
+				*_m.IssuedAt = value.Time
+			}
 		case invoice.FieldPdfFilename:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field pdf_filename", values[i])
@@ -291,6 +372,26 @@ func (_m *Invoice) QueryPayments() *PaymentQuery {
 	return NewInvoiceClient(_m.config).QueryPayments(_m)
 }
 
+// QueryPaymentPlans queries the "payment_plans" edge of the Invoice entity.
+func (_m *Invoice) QueryPaymentPlans() *PaymentPlanQuery {
+	return NewInvoiceClient(_m.config).QueryPaymentPlans(_m)
+}
+
+// QueryLateFees queries the "late_fees" edge of the Invoice entity.
+func (_m *Invoice) QueryLateFees() *LateFeeQuery {
+	return NewInvoiceClient(_m.config).QueryLateFees(_m)
+}
+
+// QueryLessonPackages queries the "lesson_packages" edge of the Invoice entity.
+func (_m *Invoice) QueryLessonPackages() *LessonPackageQuery {
+	return NewInvoiceClient(_m.config).QueryLessonPackages(_m)
+}
+
+// QueryBillingTerm queries the "billing_term" edge of the Invoice entity.
+func (_m *Invoice) QueryBillingTerm() *BillingTermQuery {
+	return NewInvoiceClient(_m.config).QueryBillingTerm(_m)
+}
+
 // Update returns a builder for updating this Invoice.
 // Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -332,14 +433,30 @@ func (_m *Invoice) String() string {
 	builder.WriteString("total_amount_cents=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalAmountCents))
 	builder.WriteString(", ")
+	builder.WriteString("vat_amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatAmountCents))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.BillingTermID; v != nil {
+		builder.WriteString("billing_term_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.Number; v != nil {
 		builder.WriteString("number=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.IssuedAt; v != nil {
+		builder.WriteString("issued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.PdfFilename; v != nil {
 		builder.WriteString("pdf_filename=")
 		builder.WriteString(*v)
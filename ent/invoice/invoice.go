@@ -27,10 +27,18 @@ const (
 	FieldLegacyTotalAmount = "total_amount"
 	// FieldTotalAmountCents holds the string denoting the total_amount_cents field in the database.
 	FieldTotalAmountCents = "total_amount_cents"
+	// FieldVatAmountCents holds the string denoting the vat_amount_cents field in the database.
+	FieldVatAmountCents = "vat_amount_cents"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldBillingTermID holds the string denoting the billing_term_id field in the database.
+	FieldBillingTermID = "billing_term_id"
 	// FieldNumber holds the string denoting the number field in the database.
 	FieldNumber = "number"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
 	// FieldPdfFilename holds the string denoting the pdf_filename field in the database.
 	FieldPdfFilename = "pdf_filename"
 	// FieldPdfGeneratedAt holds the string denoting the pdf_generated_at field in the database.
@@ -59,6 +67,14 @@ const (
 	EdgeLines = "lines"
 	// EdgePayments holds the string denoting the payments edge name in mutations.
 	EdgePayments = "payments"
+	// EdgePaymentPlans holds the string denoting the payment_plans edge name in mutations.
+	EdgePaymentPlans = "payment_plans"
+	// EdgeLateFees holds the string denoting the late_fees edge name in mutations.
+	EdgeLateFees = "late_fees"
+	// EdgeLessonPackages holds the string denoting the lesson_packages edge name in mutations.
+	EdgeLessonPackages = "lesson_packages"
+	// EdgeBillingTerm holds the string denoting the billing_term edge name in mutations.
+	EdgeBillingTerm = "billing_term"
 	// Table holds the table name of the invoice in the database.
 	Table = "invoices"
 	// StudentTable is the table that holds the student relation/edge.
@@ -82,6 +98,32 @@ const (
 	PaymentsInverseTable = "payments"
 	// PaymentsColumn is the table column denoting the payments relation/edge.
 	PaymentsColumn = "invoice_id"
+	// PaymentPlansTable is the table that holds the payment_plans relation/edge. The primary key declared below.
+	PaymentPlansTable = "payment_plan_invoices"
+	// PaymentPlansInverseTable is the table name for the PaymentPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentplan" package.
+	PaymentPlansInverseTable = "payment_plans"
+	// LateFeesTable is the table that holds the late_fees relation/edge.
+	LateFeesTable = "late_fees"
+	// LateFeesInverseTable is the table name for the LateFee entity.
+	// It exists in this package in order to avoid circular dependency with the "latefee" package.
+	LateFeesInverseTable = "late_fees"
+	// LateFeesColumn is the table column denoting the late_fees relation/edge.
+	LateFeesColumn = "invoice_id"
+	// LessonPackagesTable is the table that holds the lesson_packages relation/edge.
+	LessonPackagesTable = "lesson_packages"
+	// LessonPackagesInverseTable is the table name for the LessonPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "lessonpackage" package.
+	LessonPackagesInverseTable = "lesson_packages"
+	// LessonPackagesColumn is the table column denoting the lesson_packages relation/edge.
+	LessonPackagesColumn = "invoice_id"
+	// BillingTermTable is the table that holds the billing_term relation/edge.
+	BillingTermTable = "invoices"
+	// BillingTermInverseTable is the table name for the BillingTerm entity.
+	// It exists in this package in order to avoid circular dependency with the "billingterm" package.
+	BillingTermInverseTable = "billing_terms"
+	// BillingTermColumn is the table column denoting the billing_term relation/edge.
+	BillingTermColumn = "billing_term_id"
 )
 
 // Columns holds all SQL columns for invoice fields.
@@ -93,8 +135,12 @@ var Columns = []string{
 	FieldPeriodMonth,
 	FieldLegacyTotalAmount,
 	FieldTotalAmountCents,
+	FieldVatAmountCents,
 	FieldStatus,
+	FieldKind,
+	FieldBillingTermID,
 	FieldNumber,
+	FieldIssuedAt,
 	FieldPdfFilename,
 	FieldPdfGeneratedAt,
 	FieldPdfRevision,
@@ -108,6 +154,12 @@ var Columns = []string{
 	FieldUpdatedAt,
 }
 
+var (
+	// PaymentPlansPrimaryKey and PaymentPlansColumn2 are the table columns denoting the
+	// primary key for the payment_plans relation (M2M).
+	PaymentPlansPrimaryKey = []string{"payment_plan_id", "invoice_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -125,6 +177,8 @@ var (
 	DefaultLegacyTotalAmount float64
 	// DefaultTotalAmountCents holds the default value on creation for the "total_amount_cents" field.
 	DefaultTotalAmountCents int64
+	// DefaultVatAmountCents holds the default value on creation for the "vat_amount_cents" field.
+	DefaultVatAmountCents int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -163,6 +217,33 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindMonthly is the default value of the Kind enum.
+const DefaultKind = KindMonthly
+
+// Kind values.
+const (
+	KindMonthly Kind = "monthly"
+	KindPackage Kind = "package"
+	KindTerm    Kind = "term"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindMonthly, KindPackage, KindTerm:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for kind field: %q", k)
+	}
+}
+
 // EmailDeliveryStatus defines the type for the "email_delivery_status" enum field.
 type EmailDeliveryStatus string
 
@@ -228,16 +309,36 @@ func ByTotalAmountCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalAmountCents, opts...).ToFunc()
 }
 
+// ByVatAmountCents orders the results by the vat_amount_cents field.
+func ByVatAmountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatAmountCents, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByBillingTermID orders the results by the billing_term_id field.
+func ByBillingTermID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingTermID, opts...).ToFunc()
+}
+
 // ByNumber orders the results by the number field.
 func ByNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNumber, opts...).ToFunc()
 }
 
+// ByIssuedAt orders the results by the issued_at field.
+func ByIssuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedAt, opts...).ToFunc()
+}
+
 // ByPdfFilename orders the results by the pdf_filename field.
 func ByPdfFilename(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPdfFilename, opts...).ToFunc()
@@ -327,6 +428,55 @@ func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPaymentPlansCount orders the results by payment_plans count.
+func ByPaymentPlansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentPlansStep(), opts...)
+	}
+}
+
+// ByPaymentPlans orders the results by payment_plans terms.
+func ByPaymentPlans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentPlansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLateFeesCount orders the results by late_fees count.
+func ByLateFeesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLateFeesStep(), opts...)
+	}
+}
+
+// ByLateFees orders the results by late_fees terms.
+func ByLateFees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLateFeesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLessonPackagesCount orders the results by lesson_packages count.
+func ByLessonPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLessonPackagesStep(), opts...)
+	}
+}
+
+// ByLessonPackages orders the results by lesson_packages terms.
+func ByLessonPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLessonPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBillingTermField orders the results by billing_term field.
+func ByBillingTermField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillingTermStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newStudentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -348,3 +498,31 @@ func newPaymentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
 	)
 }
+func newPaymentPlansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentPlansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, PaymentPlansTable, PaymentPlansPrimaryKey...),
+	)
+}
+func newLateFeesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LateFeesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LateFeesTable, LateFeesColumn),
+	)
+}
+func newLessonPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LessonPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+	)
+}
+func newBillingTermStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillingTermInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BillingTermTable, BillingTermColumn),
+	)
+}
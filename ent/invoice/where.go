@@ -85,11 +85,26 @@ func TotalAmountCents(v int64) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldTotalAmountCents, v))
 }
 
+// VatAmountCents applies equality check predicate on the "vat_amount_cents" field. It's identical to VatAmountCentsEQ.
+func VatAmountCents(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldVatAmountCents, v))
+}
+
+// BillingTermID applies equality check predicate on the "billing_term_id" field. It's identical to BillingTermIDEQ.
+func BillingTermID(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBillingTermID, v))
+}
+
 // Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
 func Number(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
 }
 
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
 // PdfFilename applies equality check predicate on the "pdf_filename" field. It's identical to PdfFilenameEQ.
 func PdfFilename(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldPdfFilename, v))
@@ -360,6 +375,46 @@ func TotalAmountCentsLTE(v int64) predicate.Invoice {
 	return predicate.Invoice(sql.FieldLTE(FieldTotalAmountCents, v))
 }
 
+// VatAmountCentsEQ applies the EQ predicate on the "vat_amount_cents" field.
+func VatAmountCentsEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldVatAmountCents, v))
+}
+
+// VatAmountCentsNEQ applies the NEQ predicate on the "vat_amount_cents" field.
+func VatAmountCentsNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldVatAmountCents, v))
+}
+
+// VatAmountCentsIn applies the In predicate on the "vat_amount_cents" field.
+func VatAmountCentsIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldVatAmountCents, vs...))
+}
+
+// VatAmountCentsNotIn applies the NotIn predicate on the "vat_amount_cents" field.
+func VatAmountCentsNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldVatAmountCents, vs...))
+}
+
+// VatAmountCentsGT applies the GT predicate on the "vat_amount_cents" field.
+func VatAmountCentsGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldVatAmountCents, v))
+}
+
+// VatAmountCentsGTE applies the GTE predicate on the "vat_amount_cents" field.
+func VatAmountCentsGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldVatAmountCents, v))
+}
+
+// VatAmountCentsLT applies the LT predicate on the "vat_amount_cents" field.
+func VatAmountCentsLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldVatAmountCents, v))
+}
+
+// VatAmountCentsLTE applies the LTE predicate on the "vat_amount_cents" field.
+func VatAmountCentsLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldVatAmountCents, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldStatus, v))
@@ -380,6 +435,56 @@ func StatusNotIn(vs ...Status) predicate.Invoice {
 	return predicate.Invoice(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// BillingTermIDEQ applies the EQ predicate on the "billing_term_id" field.
+func BillingTermIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBillingTermID, v))
+}
+
+// BillingTermIDNEQ applies the NEQ predicate on the "billing_term_id" field.
+func BillingTermIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBillingTermID, v))
+}
+
+// BillingTermIDIn applies the In predicate on the "billing_term_id" field.
+func BillingTermIDIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBillingTermID, vs...))
+}
+
+// BillingTermIDNotIn applies the NotIn predicate on the "billing_term_id" field.
+func BillingTermIDNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBillingTermID, vs...))
+}
+
+// BillingTermIDIsNil applies the IsNil predicate on the "billing_term_id" field.
+func BillingTermIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldBillingTermID))
+}
+
+// BillingTermIDNotNil applies the NotNil predicate on the "billing_term_id" field.
+func BillingTermIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldBillingTermID))
+}
+
 // NumberEQ applies the EQ predicate on the "number" field.
 func NumberEQ(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
@@ -455,6 +560,56 @@ func NumberContainsFold(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// IssuedAtIsNil applies the IsNil predicate on the "issued_at" field.
+func IssuedAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldIssuedAt))
+}
+
+// IssuedAtNotNil applies the NotNil predicate on the "issued_at" field.
+func IssuedAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldIssuedAt))
+}
+
 // PdfFilenameEQ applies the EQ predicate on the "pdf_filename" field.
 func PdfFilenameEQ(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldPdfFilename, v))
@@ -1119,6 +1274,98 @@ func HasPaymentsWith(preds ...predicate.Payment) predicate.Invoice {
 	})
 }
 
+// HasPaymentPlans applies the HasEdge predicate on the "payment_plans" edge.
+func HasPaymentPlans() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, PaymentPlansTable, PaymentPlansPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentPlansWith applies the HasEdge predicate on the "payment_plans" edge with a given conditions (other predicates).
+func HasPaymentPlansWith(preds ...predicate.PaymentPlan) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newPaymentPlansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLateFees applies the HasEdge predicate on the "late_fees" edge.
+func HasLateFees() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LateFeesTable, LateFeesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLateFeesWith applies the HasEdge predicate on the "late_fees" edge with a given conditions (other predicates).
+func HasLateFeesWith(preds ...predicate.LateFee) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newLateFeesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLessonPackages applies the HasEdge predicate on the "lesson_packages" edge.
+func HasLessonPackages() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLessonPackagesWith applies the HasEdge predicate on the "lesson_packages" edge with a given conditions (other predicates).
+func HasLessonPackagesWith(preds ...predicate.LessonPackage) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newLessonPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBillingTerm applies the HasEdge predicate on the "billing_term" edge.
+func HasBillingTerm() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BillingTermTable, BillingTermColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillingTermWith applies the HasEdge predicate on the "billing_term" edge with a given conditions (other predicates).
+func HasBillingTermWith(preds ...predicate.BillingTerm) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newBillingTermStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Invoice) predicate.Invoice {
 	return predicate.Invoice(sql.AndPredicates(predicates...))
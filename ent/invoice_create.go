@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/student"
 	"time"
 
@@ -83,6 +87,20 @@ func (_c *InvoiceCreate) SetNillableTotalAmountCents(v *int64) *InvoiceCreate {
 	return _c
 }
 
+// SetVatAmountCents sets the "vat_amount_cents" field.
+func (_c *InvoiceCreate) SetVatAmountCents(v int64) *InvoiceCreate {
+	_c.mutation.SetVatAmountCents(v)
+	return _c
+}
+
+// SetNillableVatAmountCents sets the "vat_amount_cents" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableVatAmountCents(v *int64) *InvoiceCreate {
+	if v != nil {
+		_c.SetVatAmountCents(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *InvoiceCreate) SetStatus(v invoice.Status) *InvoiceCreate {
 	_c.mutation.SetStatus(v)
@@ -97,6 +115,34 @@ func (_c *InvoiceCreate) SetNillableStatus(v *invoice.Status) *InvoiceCreate {
 	return _c
 }
 
+// SetKind sets the "kind" field.
+func (_c *InvoiceCreate) SetKind(v invoice.Kind) *InvoiceCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableKind(v *invoice.Kind) *InvoiceCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetBillingTermID sets the "billing_term_id" field.
+func (_c *InvoiceCreate) SetBillingTermID(v int) *InvoiceCreate {
+	_c.mutation.SetBillingTermID(v)
+	return _c
+}
+
+// SetNillableBillingTermID sets the "billing_term_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBillingTermID(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetBillingTermID(*v)
+	}
+	return _c
+}
+
 // SetNumber sets the "number" field.
 func (_c *InvoiceCreate) SetNumber(v string) *InvoiceCreate {
 	_c.mutation.SetNumber(v)
@@ -111,6 +157,20 @@ func (_c *InvoiceCreate) SetNillableNumber(v *string) *InvoiceCreate {
 	return _c
 }
 
+// SetIssuedAt sets the "issued_at" field.
+func (_c *InvoiceCreate) SetIssuedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableIssuedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetIssuedAt(*v)
+	}
+	return _c
+}
+
 // SetPdfFilename sets the "pdf_filename" field.
 func (_c *InvoiceCreate) SetPdfFilename(v string) *InvoiceCreate {
 	_c.mutation.SetPdfFilename(v)
@@ -300,6 +360,56 @@ func (_c *InvoiceCreate) AddPayments(v ...*Payment) *InvoiceCreate {
 	return _c.AddPaymentIDs(ids...)
 }
 
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_c *InvoiceCreate) AddPaymentPlanIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddPaymentPlanIDs(ids...)
+	return _c
+}
+
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_c *InvoiceCreate) AddPaymentPlans(v ...*PaymentPlan) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_c *InvoiceCreate) AddLateFeeIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddLateFeeIDs(ids...)
+	return _c
+}
+
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_c *InvoiceCreate) AddLateFees(v ...*LateFee) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLateFeeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_c *InvoiceCreate) AddLessonPackageIDs(ids ...int) *InvoiceCreate {
+	_c.mutation.AddLessonPackageIDs(ids...)
+	return _c
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_c *InvoiceCreate) AddLessonPackages(v ...*LessonPackage) *InvoiceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLessonPackageIDs(ids...)
+}
+
+// SetBillingTerm sets the "billing_term" edge to the BillingTerm entity.
+func (_c *InvoiceCreate) SetBillingTerm(v *BillingTerm) *InvoiceCreate {
+	return _c.SetBillingTermID(v.ID)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
 	return _c.mutation
@@ -347,10 +457,18 @@ func (_c *InvoiceCreate) defaults() {
 		v := invoice.DefaultTotalAmountCents
 		_c.mutation.SetTotalAmountCents(v)
 	}
+	if _, ok := _c.mutation.VatAmountCents(); !ok {
+		v := invoice.DefaultVatAmountCents
+		_c.mutation.SetVatAmountCents(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := invoice.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := invoice.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 	if _, ok := _c.mutation.EmailDeliveryStatus(); !ok {
 		v := invoice.DefaultEmailDeliveryStatus
 		_c.mutation.SetEmailDeliveryStatus(v)
@@ -385,6 +503,9 @@ func (_c *InvoiceCreate) check() error {
 	if _, ok := _c.mutation.TotalAmountCents(); !ok {
 		return &ValidationError{Name: "total_amount_cents", err: errors.New(`ent: missing required field "Invoice.total_amount_cents"`)}
 	}
+	if _, ok := _c.mutation.VatAmountCents(); !ok {
+		return &ValidationError{Name: "vat_amount_cents", err: errors.New(`ent: missing required field "Invoice.vat_amount_cents"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
 	}
@@ -393,6 +514,14 @@ func (_c *InvoiceCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Invoice.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.EmailDeliveryStatus(); !ok {
 		return &ValidationError{Name: "email_delivery_status", err: errors.New(`ent: missing required field "Invoice.email_delivery_status"`)}
 	}
@@ -450,14 +579,26 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldTotalAmountCents, field.TypeInt64, value)
 		_node.TotalAmountCents = value
 	}
+	if value, ok := _c.mutation.VatAmountCents(); ok {
+		_spec.SetField(invoice.FieldVatAmountCents, field.TypeInt64, value)
+		_node.VatAmountCents = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
 	if value, ok := _c.mutation.Number(); ok {
 		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
 		_node.Number = &value
 	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = &value
+	}
 	if value, ok := _c.mutation.PdfFilename(); ok {
 		_spec.SetField(invoice.FieldPdfFilename, field.TypeString, value)
 		_node.PdfFilename = &value
@@ -551,6 +692,71 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BillingTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BillingTermTable,
+			Columns: []string{invoice.BillingTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BillingTermID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
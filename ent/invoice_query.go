@@ -6,9 +6,13 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
 	"math"
@@ -22,13 +26,17 @@ import (
 // InvoiceQuery is the builder for querying Invoice entities.
 type InvoiceQuery struct {
 	config
-	ctx          *QueryContext
-	order        []invoice.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Invoice
-	withStudent  *StudentQuery
-	withLines    *InvoiceLineQuery
-	withPayments *PaymentQuery
+	ctx                *QueryContext
+	order              []invoice.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Invoice
+	withStudent        *StudentQuery
+	withLines          *InvoiceLineQuery
+	withPayments       *PaymentQuery
+	withPaymentPlans   *PaymentPlanQuery
+	withLateFees       *LateFeeQuery
+	withLessonPackages *LessonPackageQuery
+	withBillingTerm    *BillingTermQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +139,94 @@ func (_q *InvoiceQuery) QueryPayments() *PaymentQuery {
 	return query
 }
 
+// QueryPaymentPlans chains the current query on the "payment_plans" edge.
+func (_q *InvoiceQuery) QueryPaymentPlans() *PaymentPlanQuery {
+	query := (&PaymentPlanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(paymentplan.Table, paymentplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, invoice.PaymentPlansTable, invoice.PaymentPlansPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLateFees chains the current query on the "late_fees" edge.
+func (_q *InvoiceQuery) QueryLateFees() *LateFeeQuery {
+	query := (&LateFeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(latefee.Table, latefee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LateFeesTable, invoice.LateFeesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLessonPackages chains the current query on the "lesson_packages" edge.
+func (_q *InvoiceQuery) QueryLessonPackages() *LessonPackageQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LessonPackagesTable, invoice.LessonPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBillingTerm chains the current query on the "billing_term" edge.
+func (_q *InvoiceQuery) QueryBillingTerm() *BillingTermQuery {
+	query := (&BillingTermClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(billingterm.Table, billingterm.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.BillingTermTable, invoice.BillingTermColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Invoice entity from the query.
 // Returns a *NotFoundError when no Invoice was found.
 func (_q *InvoiceQuery) First(ctx context.Context) (*Invoice, error) {
@@ -318,14 +414,18 @@ func (_q *InvoiceQuery) Clone() *InvoiceQuery {
 		return nil
 	}
 	return &InvoiceQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]invoice.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Invoice{}, _q.predicates...),
-		withStudent:  _q.withStudent.Clone(),
-		withLines:    _q.withLines.Clone(),
-		withPayments: _q.withPayments.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]invoice.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Invoice{}, _q.predicates...),
+		withStudent:        _q.withStudent.Clone(),
+		withLines:          _q.withLines.Clone(),
+		withPayments:       _q.withPayments.Clone(),
+		withPaymentPlans:   _q.withPaymentPlans.Clone(),
+		withLateFees:       _q.withLateFees.Clone(),
+		withLessonPackages: _q.withLessonPackages.Clone(),
+		withBillingTerm:    _q.withBillingTerm.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +465,50 @@ func (_q *InvoiceQuery) WithPayments(opts ...func(*PaymentQuery)) *InvoiceQuery
 	return _q
 }
 
+// WithPaymentPlans tells the query-builder to eager-load the nodes that are connected to
+// the "payment_plans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithPaymentPlans(opts ...func(*PaymentPlanQuery)) *InvoiceQuery {
+	query := (&PaymentPlanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaymentPlans = query
+	return _q
+}
+
+// WithLateFees tells the query-builder to eager-load the nodes that are connected to
+// the "late_fees" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithLateFees(opts ...func(*LateFeeQuery)) *InvoiceQuery {
+	query := (&LateFeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLateFees = query
+	return _q
+}
+
+// WithLessonPackages tells the query-builder to eager-load the nodes that are connected to
+// the "lesson_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithLessonPackages(opts ...func(*LessonPackageQuery)) *InvoiceQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLessonPackages = query
+	return _q
+}
+
+// WithBillingTerm tells the query-builder to eager-load the nodes that are connected to
+// the "billing_term" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithBillingTerm(opts ...func(*BillingTermQuery)) *InvoiceQuery {
+	query := (&BillingTermClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBillingTerm = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +587,14 @@ func (_q *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 	var (
 		nodes       = []*Invoice{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [7]bool{
 			_q.withStudent != nil,
 			_q.withLines != nil,
 			_q.withPayments != nil,
+			_q.withPaymentPlans != nil,
+			_q.withLateFees != nil,
+			_q.withLessonPackages != nil,
+			_q.withBillingTerm != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +635,33 @@ func (_q *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 			return nil, err
 		}
 	}
+	if query := _q.withPaymentPlans; query != nil {
+		if err := _q.loadPaymentPlans(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.PaymentPlans = []*PaymentPlan{} },
+			func(n *Invoice, e *PaymentPlan) { n.Edges.PaymentPlans = append(n.Edges.PaymentPlans, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLateFees; query != nil {
+		if err := _q.loadLateFees(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.LateFees = []*LateFee{} },
+			func(n *Invoice, e *LateFee) { n.Edges.LateFees = append(n.Edges.LateFees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLessonPackages; query != nil {
+		if err := _q.loadLessonPackages(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.LessonPackages = []*LessonPackage{} },
+			func(n *Invoice, e *LessonPackage) { n.Edges.LessonPackages = append(n.Edges.LessonPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBillingTerm; query != nil {
+		if err := _q.loadBillingTerm(ctx, query, nodes, nil,
+			func(n *Invoice, e *BillingTerm) { n.Edges.BillingTerm = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -582,6 +757,162 @@ func (_q *InvoiceQuery) loadPayments(ctx context.Context, query *PaymentQuery, n
 	}
 	return nil
 }
+func (_q *InvoiceQuery) loadPaymentPlans(ctx context.Context, query *PaymentPlanQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *PaymentPlan)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Invoice)
+	nids := make(map[int]map[*Invoice]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(invoice.PaymentPlansTable)
+		s.Join(joinT).On(s.C(paymentplan.FieldID), joinT.C(invoice.PaymentPlansPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(invoice.PaymentPlansPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(invoice.PaymentPlansPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Invoice]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*PaymentPlan](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "payment_plans" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadLateFees(ctx context.Context, query *LateFeeQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *LateFee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Invoice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(latefee.FieldInvoiceID)
+	}
+	query.Where(predicate.LateFee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(invoice.LateFeesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InvoiceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invoice_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadLessonPackages(ctx context.Context, query *LessonPackageQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *LessonPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Invoice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lessonpackage.FieldInvoiceID)
+	}
+	query.Where(predicate.LessonPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(invoice.LessonPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InvoiceID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "invoice_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invoice_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadBillingTerm(ctx context.Context, query *BillingTermQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *BillingTerm)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Invoice)
+	for i := range nodes {
+		if nodes[i].BillingTermID == nil {
+			continue
+		}
+		fk := *nodes[i].BillingTermID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(billingterm.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "billing_term_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *InvoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -611,6 +942,9 @@ func (_q *InvoiceQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withStudent != nil {
 			_spec.Node.AddColumnOnce(invoice.FieldStudentID)
 		}
+		if _q.withBillingTerm != nil {
+			_spec.Node.AddColumnOnce(invoice.FieldBillingTermID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
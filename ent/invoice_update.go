@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/billingterm"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
 	"time"
@@ -150,6 +154,27 @@ func (_u *InvoiceUpdate) AddTotalAmountCents(v int64) *InvoiceUpdate {
 	return _u
 }
 
+// SetVatAmountCents sets the "vat_amount_cents" field.
+func (_u *InvoiceUpdate) SetVatAmountCents(v int64) *InvoiceUpdate {
+	_u.mutation.ResetVatAmountCents()
+	_u.mutation.SetVatAmountCents(v)
+	return _u
+}
+
+// SetNillableVatAmountCents sets the "vat_amount_cents" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableVatAmountCents(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetVatAmountCents(*v)
+	}
+	return _u
+}
+
+// AddVatAmountCents adds value to the "vat_amount_cents" field.
+func (_u *InvoiceUpdate) AddVatAmountCents(v int64) *InvoiceUpdate {
+	_u.mutation.AddVatAmountCents(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *InvoiceUpdate) SetStatus(v invoice.Status) *InvoiceUpdate {
 	_u.mutation.SetStatus(v)
@@ -164,6 +189,40 @@ func (_u *InvoiceUpdate) SetNillableStatus(v *invoice.Status) *InvoiceUpdate {
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdate) SetKind(v invoice.Kind) *InvoiceUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableKind(v *invoice.Kind) *InvoiceUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetBillingTermID sets the "billing_term_id" field.
+func (_u *InvoiceUpdate) SetBillingTermID(v int) *InvoiceUpdate {
+	_u.mutation.SetBillingTermID(v)
+	return _u
+}
+
+// SetNillableBillingTermID sets the "billing_term_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBillingTermID(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBillingTermID(*v)
+	}
+	return _u
+}
+
+// ClearBillingTermID clears the value of the "billing_term_id" field.
+func (_u *InvoiceUpdate) ClearBillingTermID() *InvoiceUpdate {
+	_u.mutation.ClearBillingTermID()
+	return _u
+}
+
 // SetNumber sets the "number" field.
 func (_u *InvoiceUpdate) SetNumber(v string) *InvoiceUpdate {
 	_u.mutation.SetNumber(v)
@@ -184,6 +243,26 @@ func (_u *InvoiceUpdate) ClearNumber() *InvoiceUpdate {
 	return _u
 }
 
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdate) SetIssuedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssuedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (_u *InvoiceUpdate) ClearIssuedAt() *InvoiceUpdate {
+	_u.mutation.ClearIssuedAt()
+	return _u
+}
+
 // SetPdfFilename sets the "pdf_filename" field.
 func (_u *InvoiceUpdate) SetPdfFilename(v string) *InvoiceUpdate {
 	_u.mutation.SetPdfFilename(v)
@@ -439,6 +518,56 @@ func (_u *InvoiceUpdate) AddPayments(v ...*Payment) *InvoiceUpdate {
 	return _u.AddPaymentIDs(ids...)
 }
 
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_u *InvoiceUpdate) AddPaymentPlanIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddPaymentPlanIDs(ids...)
+	return _u
+}
+
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_u *InvoiceUpdate) AddPaymentPlans(v ...*PaymentPlan) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_u *InvoiceUpdate) AddLateFeeIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddLateFeeIDs(ids...)
+	return _u
+}
+
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_u *InvoiceUpdate) AddLateFees(v ...*LateFee) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLateFeeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *InvoiceUpdate) AddLessonPackageIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.AddLessonPackageIDs(ids...)
+	return _u
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *InvoiceUpdate) AddLessonPackages(v ...*LessonPackage) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// SetBillingTerm sets the "billing_term" edge to the BillingTerm entity.
+func (_u *InvoiceUpdate) SetBillingTerm(v *BillingTerm) *InvoiceUpdate {
+	return _u.SetBillingTermID(v.ID)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
 	return _u.mutation
@@ -492,6 +621,75 @@ func (_u *InvoiceUpdate) RemovePayments(v ...*Payment) *InvoiceUpdate {
 	return _u.RemovePaymentIDs(ids...)
 }
 
+// ClearPaymentPlans clears all "payment_plans" edges to the PaymentPlan entity.
+func (_u *InvoiceUpdate) ClearPaymentPlans() *InvoiceUpdate {
+	_u.mutation.ClearPaymentPlans()
+	return _u
+}
+
+// RemovePaymentPlanIDs removes the "payment_plans" edge to PaymentPlan entities by IDs.
+func (_u *InvoiceUpdate) RemovePaymentPlanIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemovePaymentPlanIDs(ids...)
+	return _u
+}
+
+// RemovePaymentPlans removes "payment_plans" edges to PaymentPlan entities.
+func (_u *InvoiceUpdate) RemovePaymentPlans(v ...*PaymentPlan) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentPlanIDs(ids...)
+}
+
+// ClearLateFees clears all "late_fees" edges to the LateFee entity.
+func (_u *InvoiceUpdate) ClearLateFees() *InvoiceUpdate {
+	_u.mutation.ClearLateFees()
+	return _u
+}
+
+// RemoveLateFeeIDs removes the "late_fees" edge to LateFee entities by IDs.
+func (_u *InvoiceUpdate) RemoveLateFeeIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveLateFeeIDs(ids...)
+	return _u
+}
+
+// RemoveLateFees removes "late_fees" edges to LateFee entities.
+func (_u *InvoiceUpdate) RemoveLateFees(v ...*LateFee) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLateFeeIDs(ids...)
+}
+
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *InvoiceUpdate) ClearLessonPackages() *InvoiceUpdate {
+	_u.mutation.ClearLessonPackages()
+	return _u
+}
+
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *InvoiceUpdate) RemoveLessonPackageIDs(ids ...int) *InvoiceUpdate {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *InvoiceUpdate) RemoveLessonPackages(v ...*LessonPackage) *InvoiceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLessonPackageIDs(ids...)
+}
+
+// ClearBillingTerm clears the "billing_term" edge to the BillingTerm entity.
+func (_u *InvoiceUpdate) ClearBillingTerm() *InvoiceUpdate {
+	_u.mutation.ClearBillingTerm()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -535,6 +733,11 @@ func (_u *InvoiceUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EmailDeliveryStatus(); ok {
 		if err := invoice.EmailDeliveryStatusValidator(v); err != nil {
 			return &ValidationError{Name: "email_delivery_status", err: fmt.Errorf(`ent: validator failed for field "Invoice.email_delivery_status": %w`, err)}
@@ -588,15 +791,30 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedTotalAmountCents(); ok {
 		_spec.AddField(invoice.FieldTotalAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatAmountCents(); ok {
+		_spec.SetField(invoice.FieldVatAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVatAmountCents(); ok {
+		_spec.AddField(invoice.FieldVatAmountCents, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Number(); ok {
 		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
 	}
 	if _u.mutation.NumberCleared() {
 		_spec.ClearField(invoice.FieldNumber, field.TypeString)
 	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IssuedAtCleared() {
+		_spec.ClearField(invoice.FieldIssuedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.PdfFilename(); ok {
 		_spec.SetField(invoice.FieldPdfFilename, field.TypeString, value)
 	}
@@ -785,6 +1003,170 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentPlansIDs(); len(nodes) > 0 && !_u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLateFeesIDs(); len(nodes) > 0 && !_u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillingTermCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BillingTermTable,
+			Columns: []string{invoice.BillingTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BillingTermTable,
+			Columns: []string{invoice.BillingTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invoice.Label}
@@ -924,6 +1306,27 @@ func (_u *InvoiceUpdateOne) AddTotalAmountCents(v int64) *InvoiceUpdateOne {
 	return _u
 }
 
+// SetVatAmountCents sets the "vat_amount_cents" field.
+func (_u *InvoiceUpdateOne) SetVatAmountCents(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetVatAmountCents()
+	_u.mutation.SetVatAmountCents(v)
+	return _u
+}
+
+// SetNillableVatAmountCents sets the "vat_amount_cents" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableVatAmountCents(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetVatAmountCents(*v)
+	}
+	return _u
+}
+
+// AddVatAmountCents adds value to the "vat_amount_cents" field.
+func (_u *InvoiceUpdateOne) AddVatAmountCents(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddVatAmountCents(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *InvoiceUpdateOne) SetStatus(v invoice.Status) *InvoiceUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -938,6 +1341,40 @@ func (_u *InvoiceUpdateOne) SetNillableStatus(v *invoice.Status) *InvoiceUpdateO
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdateOne) SetKind(v invoice.Kind) *InvoiceUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableKind(v *invoice.Kind) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetBillingTermID sets the "billing_term_id" field.
+func (_u *InvoiceUpdateOne) SetBillingTermID(v int) *InvoiceUpdateOne {
+	_u.mutation.SetBillingTermID(v)
+	return _u
+}
+
+// SetNillableBillingTermID sets the "billing_term_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBillingTermID(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBillingTermID(*v)
+	}
+	return _u
+}
+
+// ClearBillingTermID clears the value of the "billing_term_id" field.
+func (_u *InvoiceUpdateOne) ClearBillingTermID() *InvoiceUpdateOne {
+	_u.mutation.ClearBillingTermID()
+	return _u
+}
+
 // SetNumber sets the "number" field.
 func (_u *InvoiceUpdateOne) SetNumber(v string) *InvoiceUpdateOne {
 	_u.mutation.SetNumber(v)
@@ -958,6 +1395,26 @@ func (_u *InvoiceUpdateOne) ClearNumber() *InvoiceUpdateOne {
 	return _u
 }
 
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdateOne) SetIssuedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssuedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (_u *InvoiceUpdateOne) ClearIssuedAt() *InvoiceUpdateOne {
+	_u.mutation.ClearIssuedAt()
+	return _u
+}
+
 // SetPdfFilename sets the "pdf_filename" field.
 func (_u *InvoiceUpdateOne) SetPdfFilename(v string) *InvoiceUpdateOne {
 	_u.mutation.SetPdfFilename(v)
@@ -1213,6 +1670,56 @@ func (_u *InvoiceUpdateOne) AddPayments(v ...*Payment) *InvoiceUpdateOne {
 	return _u.AddPaymentIDs(ids...)
 }
 
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_u *InvoiceUpdateOne) AddPaymentPlanIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddPaymentPlanIDs(ids...)
+	return _u
+}
+
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_u *InvoiceUpdateOne) AddPaymentPlans(v ...*PaymentPlan) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_u *InvoiceUpdateOne) AddLateFeeIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddLateFeeIDs(ids...)
+	return _u
+}
+
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_u *InvoiceUpdateOne) AddLateFees(v ...*LateFee) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLateFeeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *InvoiceUpdateOne) AddLessonPackageIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.AddLessonPackageIDs(ids...)
+	return _u
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *InvoiceUpdateOne) AddLessonPackages(v ...*LessonPackage) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// SetBillingTerm sets the "billing_term" edge to the BillingTerm entity.
+func (_u *InvoiceUpdateOne) SetBillingTerm(v *BillingTerm) *InvoiceUpdateOne {
+	return _u.SetBillingTermID(v.ID)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
 	return _u.mutation
@@ -1266,6 +1773,75 @@ func (_u *InvoiceUpdateOne) RemovePayments(v ...*Payment) *InvoiceUpdateOne {
 	return _u.RemovePaymentIDs(ids...)
 }
 
+// ClearPaymentPlans clears all "payment_plans" edges to the PaymentPlan entity.
+func (_u *InvoiceUpdateOne) ClearPaymentPlans() *InvoiceUpdateOne {
+	_u.mutation.ClearPaymentPlans()
+	return _u
+}
+
+// RemovePaymentPlanIDs removes the "payment_plans" edge to PaymentPlan entities by IDs.
+func (_u *InvoiceUpdateOne) RemovePaymentPlanIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemovePaymentPlanIDs(ids...)
+	return _u
+}
+
+// RemovePaymentPlans removes "payment_plans" edges to PaymentPlan entities.
+func (_u *InvoiceUpdateOne) RemovePaymentPlans(v ...*PaymentPlan) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentPlanIDs(ids...)
+}
+
+// ClearLateFees clears all "late_fees" edges to the LateFee entity.
+func (_u *InvoiceUpdateOne) ClearLateFees() *InvoiceUpdateOne {
+	_u.mutation.ClearLateFees()
+	return _u
+}
+
+// RemoveLateFeeIDs removes the "late_fees" edge to LateFee entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveLateFeeIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveLateFeeIDs(ids...)
+	return _u
+}
+
+// RemoveLateFees removes "late_fees" edges to LateFee entities.
+func (_u *InvoiceUpdateOne) RemoveLateFees(v ...*LateFee) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLateFeeIDs(ids...)
+}
+
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *InvoiceUpdateOne) ClearLessonPackages() *InvoiceUpdateOne {
+	_u.mutation.ClearLessonPackages()
+	return _u
+}
+
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveLessonPackageIDs(ids ...int) *InvoiceUpdateOne {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *InvoiceUpdateOne) RemoveLessonPackages(v ...*LessonPackage) *InvoiceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLessonPackageIDs(ids...)
+}
+
+// ClearBillingTerm clears the "billing_term" edge to the BillingTerm entity.
+func (_u *InvoiceUpdateOne) ClearBillingTerm() *InvoiceUpdateOne {
+	_u.mutation.ClearBillingTerm()
+	return _u
+}
+
 // Where appends a list predicates to the InvoiceUpdate builder.
 func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1322,6 +1898,11 @@ func (_u *InvoiceUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EmailDeliveryStatus(); ok {
 		if err := invoice.EmailDeliveryStatusValidator(v); err != nil {
 			return &ValidationError{Name: "email_delivery_status", err: fmt.Errorf(`ent: validator failed for field "Invoice.email_delivery_status": %w`, err)}
@@ -1392,15 +1973,30 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 	if value, ok := _u.mutation.AddedTotalAmountCents(); ok {
 		_spec.AddField(invoice.FieldTotalAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatAmountCents(); ok {
+		_spec.SetField(invoice.FieldVatAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVatAmountCents(); ok {
+		_spec.AddField(invoice.FieldVatAmountCents, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Number(); ok {
 		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
 	}
 	if _u.mutation.NumberCleared() {
 		_spec.ClearField(invoice.FieldNumber, field.TypeString)
 	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IssuedAtCleared() {
+		_spec.ClearField(invoice.FieldIssuedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.PdfFilename(); ok {
 		_spec.SetField(invoice.FieldPdfFilename, field.TypeString, value)
 	}
@@ -1589,6 +2185,170 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentPlansIDs(); len(nodes) > 0 && !_u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   invoice.PaymentPlansTable,
+			Columns: invoice.PaymentPlansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLateFeesIDs(); len(nodes) > 0 && !_u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LateFeesTable,
+			Columns: []string{invoice.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LessonPackagesTable,
+			Columns: []string{invoice.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BillingTermCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BillingTermTable,
+			Columns: []string{invoice.BillingTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BillingTermTable,
+			Columns: []string{invoice.BillingTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingterm.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Invoice{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
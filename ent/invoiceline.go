@@ -5,8 +5,10 @@ package ent
 import (
 	"fmt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/studentcharge"
 	"strings"
 
 	"entgo.io/ent"
@@ -21,7 +23,13 @@ type InvoiceLine struct {
 	// InvoiceID holds the value of the "invoice_id" field.
 	InvoiceID int `json:"invoice_id,omitempty"`
 	// EnrollmentID holds the value of the "enrollment_id" field.
-	EnrollmentID int `json:"enrollment_id,omitempty"`
+	EnrollmentID *int `json:"enrollment_id,omitempty"`
+	// ChargeID holds the value of the "charge_id" field.
+	ChargeID *int `json:"charge_id,omitempty"`
+	// ChargeYear holds the value of the "charge_year" field.
+	ChargeYear int `json:"charge_year,omitempty"`
+	// AbsenceID holds the value of the "absence_id" field.
+	AbsenceID *int `json:"absence_id,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// Qty holds the value of the "qty" field.
@@ -34,6 +42,10 @@ type InvoiceLine struct {
 	UnitPriceCents int64 `json:"unit_price_cents,omitempty"`
 	// AmountCents holds the value of the "amount_cents" field.
 	AmountCents int64 `json:"amount_cents,omitempty"`
+	// VatRatePct holds the value of the "vat_rate_pct" field.
+	VatRatePct float64 `json:"vat_rate_pct,omitempty"`
+	// VatExemptNote holds the value of the "vat_exempt_note" field.
+	VatExemptNote string `json:"vat_exempt_note,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InvoiceLineQuery when eager-loading is set.
 	Edges        InvoiceLineEdges `json:"edges"`
@@ -46,9 +58,13 @@ type InvoiceLineEdges struct {
 	Invoice *Invoice `json:"invoice,omitempty"`
 	// Enrollment holds the value of the enrollment edge.
 	Enrollment *Enrollment `json:"enrollment,omitempty"`
+	// Charge holds the value of the charge edge.
+	Charge *StudentCharge `json:"charge,omitempty"`
+	// Absence holds the value of the absence edge.
+	Absence *ExcusedAbsence `json:"absence,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // InvoiceOrErr returns the Invoice value or an error if the edge
@@ -73,16 +89,38 @@ func (e InvoiceLineEdges) EnrollmentOrErr() (*Enrollment, error) {
 	return nil, &NotLoadedError{edge: "enrollment"}
 }
 
+// ChargeOrErr returns the Charge value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) ChargeOrErr() (*StudentCharge, error) {
+	if e.Charge != nil {
+		return e.Charge, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: studentcharge.Label}
+	}
+	return nil, &NotLoadedError{edge: "charge"}
+}
+
+// AbsenceOrErr returns the Absence value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) AbsenceOrErr() (*ExcusedAbsence, error) {
+	if e.Absence != nil {
+		return e.Absence, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: excusedabsence.Label}
+	}
+	return nil, &NotLoadedError{edge: "absence"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*InvoiceLine) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoiceline.FieldQty, invoiceline.FieldLegacyUnitPrice, invoiceline.FieldLegacyAmount:
+		case invoiceline.FieldQty, invoiceline.FieldLegacyUnitPrice, invoiceline.FieldLegacyAmount, invoiceline.FieldVatRatePct:
 			values[i] = new(sql.NullFloat64)
-		case invoiceline.FieldID, invoiceline.FieldInvoiceID, invoiceline.FieldEnrollmentID, invoiceline.FieldUnitPriceCents, invoiceline.FieldAmountCents:
+		case invoiceline.FieldID, invoiceline.FieldInvoiceID, invoiceline.FieldEnrollmentID, invoiceline.FieldChargeID, invoiceline.FieldChargeYear, invoiceline.FieldAbsenceID, invoiceline.FieldUnitPriceCents, invoiceline.FieldAmountCents:
 			values[i] = new(sql.NullInt64)
-		case invoiceline.FieldDescription:
+		case invoiceline.FieldDescription, invoiceline.FieldVatExemptNote:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -115,7 +153,28 @@ func (_m *InvoiceLine) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
 			} else if value.Valid {
-				_m.EnrollmentID = int(value.Int64)
+				_m.EnrollmentID = new(int)
+				*_m.EnrollmentID = int(value.Int64)
+			}
+		case invoiceline.FieldChargeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_id", values[i])
+			} else if value.Valid {
+				_m.ChargeID = new(int)
+				*_m.ChargeID = int(value.Int64)
+			}
+		case invoiceline.FieldChargeYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_year", values[i])
+			} else if value.Valid {
+				_m.ChargeYear = int(value.Int64)
+			}
+		case invoiceline.FieldAbsenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field absence_id", values[i])
+			} else if value.Valid {
+				_m.AbsenceID = new(int)
+				*_m.AbsenceID = int(value.Int64)
 			}
 		case invoiceline.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -153,6 +212,18 @@ func (_m *InvoiceLine) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AmountCents = value.Int64
 			}
+		case invoiceline.FieldVatRatePct:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_rate_pct", values[i])
+			} else if value.Valid {
+				_m.VatRatePct = value.Float64
+			}
+		case invoiceline.FieldVatExemptNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_exempt_note", values[i])
+			} else if value.Valid {
+				_m.VatExemptNote = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +247,16 @@ func (_m *InvoiceLine) QueryEnrollment() *EnrollmentQuery {
 	return NewInvoiceLineClient(_m.config).QueryEnrollment(_m)
 }
 
+// QueryCharge queries the "charge" edge of the InvoiceLine entity.
+func (_m *InvoiceLine) QueryCharge() *StudentChargeQuery {
+	return NewInvoiceLineClient(_m.config).QueryCharge(_m)
+}
+
+// QueryAbsence queries the "absence" edge of the InvoiceLine entity.
+func (_m *InvoiceLine) QueryAbsence() *ExcusedAbsenceQuery {
+	return NewInvoiceLineClient(_m.config).QueryAbsence(_m)
+}
+
 // Update returns a builder for updating this InvoiceLine.
 // Note that you need to call InvoiceLine.Unwrap() before calling this method if this InvoiceLine
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,8 +283,23 @@ func (_m *InvoiceLine) String() string {
 	builder.WriteString("invoice_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
 	builder.WriteString(", ")
-	builder.WriteString("enrollment_id=")
-	builder.WriteString(fmt.Sprintf("%v", _m.EnrollmentID))
+	if v := _m.EnrollmentID; v != nil {
+		builder.WriteString("enrollment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ChargeID; v != nil {
+		builder.WriteString("charge_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("charge_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargeYear))
+	builder.WriteString(", ")
+	if v := _m.AbsenceID; v != nil {
+		builder.WriteString("absence_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
@@ -222,6 +318,12 @@ func (_m *InvoiceLine) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("amount_cents=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
+	builder.WriteString(", ")
+	builder.WriteString("vat_rate_pct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatRatePct))
+	builder.WriteString(", ")
+	builder.WriteString("vat_exempt_note=")
+	builder.WriteString(_m.VatExemptNote)
 	builder.WriteByte(')')
 	return builder.String()
 }
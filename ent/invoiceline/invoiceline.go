@@ -16,6 +16,12 @@ const (
 	FieldInvoiceID = "invoice_id"
 	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
 	FieldEnrollmentID = "enrollment_id"
+	// FieldChargeID holds the string denoting the charge_id field in the database.
+	FieldChargeID = "charge_id"
+	// FieldChargeYear holds the string denoting the charge_year field in the database.
+	FieldChargeYear = "charge_year"
+	// FieldAbsenceID holds the string denoting the absence_id field in the database.
+	FieldAbsenceID = "absence_id"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldQty holds the string denoting the qty field in the database.
@@ -28,10 +34,18 @@ const (
 	FieldUnitPriceCents = "unit_price_cents"
 	// FieldAmountCents holds the string denoting the amount_cents field in the database.
 	FieldAmountCents = "amount_cents"
+	// FieldVatRatePct holds the string denoting the vat_rate_pct field in the database.
+	FieldVatRatePct = "vat_rate_pct"
+	// FieldVatExemptNote holds the string denoting the vat_exempt_note field in the database.
+	FieldVatExemptNote = "vat_exempt_note"
 	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
 	EdgeInvoice = "invoice"
 	// EdgeEnrollment holds the string denoting the enrollment edge name in mutations.
 	EdgeEnrollment = "enrollment"
+	// EdgeCharge holds the string denoting the charge edge name in mutations.
+	EdgeCharge = "charge"
+	// EdgeAbsence holds the string denoting the absence edge name in mutations.
+	EdgeAbsence = "absence"
 	// Table holds the table name of the invoiceline in the database.
 	Table = "invoice_lines"
 	// InvoiceTable is the table that holds the invoice relation/edge.
@@ -48,6 +62,20 @@ const (
 	EnrollmentInverseTable = "enrollments"
 	// EnrollmentColumn is the table column denoting the enrollment relation/edge.
 	EnrollmentColumn = "enrollment_id"
+	// ChargeTable is the table that holds the charge relation/edge.
+	ChargeTable = "invoice_lines"
+	// ChargeInverseTable is the table name for the StudentCharge entity.
+	// It exists in this package in order to avoid circular dependency with the "studentcharge" package.
+	ChargeInverseTable = "student_charges"
+	// ChargeColumn is the table column denoting the charge relation/edge.
+	ChargeColumn = "charge_id"
+	// AbsenceTable is the table that holds the absence relation/edge.
+	AbsenceTable = "invoice_lines"
+	// AbsenceInverseTable is the table name for the ExcusedAbsence entity.
+	// It exists in this package in order to avoid circular dependency with the "excusedabsence" package.
+	AbsenceInverseTable = "excused_absences"
+	// AbsenceColumn is the table column denoting the absence relation/edge.
+	AbsenceColumn = "absence_id"
 )
 
 // Columns holds all SQL columns for invoiceline fields.
@@ -55,12 +83,17 @@ var Columns = []string{
 	FieldID,
 	FieldInvoiceID,
 	FieldEnrollmentID,
+	FieldChargeID,
+	FieldChargeYear,
+	FieldAbsenceID,
 	FieldDescription,
 	FieldQty,
 	FieldLegacyUnitPrice,
 	FieldLegacyAmount,
 	FieldUnitPriceCents,
 	FieldAmountCents,
+	FieldVatRatePct,
+	FieldVatExemptNote,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +107,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultChargeYear holds the default value on creation for the "charge_year" field.
+	DefaultChargeYear int
 	// DefaultLegacyUnitPrice holds the default value on creation for the "legacy_unit_price" field.
 	DefaultLegacyUnitPrice float64
 	// DefaultLegacyAmount holds the default value on creation for the "legacy_amount" field.
@@ -82,6 +117,10 @@ var (
 	DefaultUnitPriceCents int64
 	// DefaultAmountCents holds the default value on creation for the "amount_cents" field.
 	DefaultAmountCents int64
+	// DefaultVatRatePct holds the default value on creation for the "vat_rate_pct" field.
+	DefaultVatRatePct float64
+	// DefaultVatExemptNote holds the default value on creation for the "vat_exempt_note" field.
+	DefaultVatExemptNote string
 )
 
 // OrderOption defines the ordering options for the InvoiceLine queries.
@@ -102,6 +141,21 @@ func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
 }
 
+// ByChargeID orders the results by the charge_id field.
+func ByChargeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeID, opts...).ToFunc()
+}
+
+// ByChargeYear orders the results by the charge_year field.
+func ByChargeYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeYear, opts...).ToFunc()
+}
+
+// ByAbsenceID orders the results by the absence_id field.
+func ByAbsenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbsenceID, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
@@ -132,6 +186,16 @@ func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
 }
 
+// ByVatRatePct orders the results by the vat_rate_pct field.
+func ByVatRatePct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatRatePct, opts...).ToFunc()
+}
+
+// ByVatExemptNote orders the results by the vat_exempt_note field.
+func ByVatExemptNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatExemptNote, opts...).ToFunc()
+}
+
 // ByInvoiceField orders the results by invoice field.
 func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -145,6 +209,20 @@ func ByEnrollmentField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEnrollmentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByChargeField orders the results by charge field.
+func ByChargeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChargeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAbsenceField orders the results by absence field.
+func ByAbsenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAbsenceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newInvoiceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -159,3 +237,17 @@ func newEnrollmentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EnrollmentTable, EnrollmentColumn),
 	)
 }
+func newChargeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChargeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChargeTable, ChargeColumn),
+	)
+}
+func newAbsenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AbsenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AbsenceTable, AbsenceColumn),
+	)
+}
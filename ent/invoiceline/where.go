@@ -64,6 +64,21 @@ func EnrollmentID(v int) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldEQ(FieldEnrollmentID, v))
 }
 
+// ChargeID applies equality check predicate on the "charge_id" field. It's identical to ChargeIDEQ.
+func ChargeID(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldChargeID, v))
+}
+
+// ChargeYear applies equality check predicate on the "charge_year" field. It's identical to ChargeYearEQ.
+func ChargeYear(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldChargeYear, v))
+}
+
+// AbsenceID applies equality check predicate on the "absence_id" field. It's identical to AbsenceIDEQ.
+func AbsenceID(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldAbsenceID, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldEQ(FieldDescription, v))
@@ -94,6 +109,16 @@ func AmountCents(v int64) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldEQ(FieldAmountCents, v))
 }
 
+// VatRatePct applies equality check predicate on the "vat_rate_pct" field. It's identical to VatRatePctEQ.
+func VatRatePct(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// VatExemptNote applies equality check predicate on the "vat_exempt_note" field. It's identical to VatExemptNoteEQ.
+func VatExemptNote(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldVatExemptNote, v))
+}
+
 // InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
 func InvoiceIDEQ(v int) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldEQ(FieldInvoiceID, v))
@@ -134,6 +159,116 @@ func EnrollmentIDNotIn(vs ...int) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldNotIn(FieldEnrollmentID, vs...))
 }
 
+// EnrollmentIDIsNil applies the IsNil predicate on the "enrollment_id" field.
+func EnrollmentIDIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldEnrollmentID))
+}
+
+// EnrollmentIDNotNil applies the NotNil predicate on the "enrollment_id" field.
+func EnrollmentIDNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldEnrollmentID))
+}
+
+// ChargeIDEQ applies the EQ predicate on the "charge_id" field.
+func ChargeIDEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldChargeID, v))
+}
+
+// ChargeIDNEQ applies the NEQ predicate on the "charge_id" field.
+func ChargeIDNEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldChargeID, v))
+}
+
+// ChargeIDIn applies the In predicate on the "charge_id" field.
+func ChargeIDIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldChargeID, vs...))
+}
+
+// ChargeIDNotIn applies the NotIn predicate on the "charge_id" field.
+func ChargeIDNotIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldChargeID, vs...))
+}
+
+// ChargeIDIsNil applies the IsNil predicate on the "charge_id" field.
+func ChargeIDIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldChargeID))
+}
+
+// ChargeIDNotNil applies the NotNil predicate on the "charge_id" field.
+func ChargeIDNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldChargeID))
+}
+
+// ChargeYearEQ applies the EQ predicate on the "charge_year" field.
+func ChargeYearEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldChargeYear, v))
+}
+
+// ChargeYearNEQ applies the NEQ predicate on the "charge_year" field.
+func ChargeYearNEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldChargeYear, v))
+}
+
+// ChargeYearIn applies the In predicate on the "charge_year" field.
+func ChargeYearIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldChargeYear, vs...))
+}
+
+// ChargeYearNotIn applies the NotIn predicate on the "charge_year" field.
+func ChargeYearNotIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldChargeYear, vs...))
+}
+
+// ChargeYearGT applies the GT predicate on the "charge_year" field.
+func ChargeYearGT(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldChargeYear, v))
+}
+
+// ChargeYearGTE applies the GTE predicate on the "charge_year" field.
+func ChargeYearGTE(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldChargeYear, v))
+}
+
+// ChargeYearLT applies the LT predicate on the "charge_year" field.
+func ChargeYearLT(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldChargeYear, v))
+}
+
+// ChargeYearLTE applies the LTE predicate on the "charge_year" field.
+func ChargeYearLTE(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldChargeYear, v))
+}
+
+// AbsenceIDEQ applies the EQ predicate on the "absence_id" field.
+func AbsenceIDEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldAbsenceID, v))
+}
+
+// AbsenceIDNEQ applies the NEQ predicate on the "absence_id" field.
+func AbsenceIDNEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldAbsenceID, v))
+}
+
+// AbsenceIDIn applies the In predicate on the "absence_id" field.
+func AbsenceIDIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldAbsenceID, vs...))
+}
+
+// AbsenceIDNotIn applies the NotIn predicate on the "absence_id" field.
+func AbsenceIDNotIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldAbsenceID, vs...))
+}
+
+// AbsenceIDIsNil applies the IsNil predicate on the "absence_id" field.
+func AbsenceIDIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldAbsenceID))
+}
+
+// AbsenceIDNotNil applies the NotNil predicate on the "absence_id" field.
+func AbsenceIDNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldAbsenceID))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldEQ(FieldDescription, v))
@@ -399,6 +534,111 @@ func AmountCentsLTE(v int64) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.FieldLTE(FieldAmountCents, v))
 }
 
+// VatRatePctEQ applies the EQ predicate on the "vat_rate_pct" field.
+func VatRatePctEQ(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctNEQ applies the NEQ predicate on the "vat_rate_pct" field.
+func VatRatePctNEQ(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctIn applies the In predicate on the "vat_rate_pct" field.
+func VatRatePctIn(vs ...float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctNotIn applies the NotIn predicate on the "vat_rate_pct" field.
+func VatRatePctNotIn(vs ...float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctGT applies the GT predicate on the "vat_rate_pct" field.
+func VatRatePctGT(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldVatRatePct, v))
+}
+
+// VatRatePctGTE applies the GTE predicate on the "vat_rate_pct" field.
+func VatRatePctGTE(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldVatRatePct, v))
+}
+
+// VatRatePctLT applies the LT predicate on the "vat_rate_pct" field.
+func VatRatePctLT(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldVatRatePct, v))
+}
+
+// VatRatePctLTE applies the LTE predicate on the "vat_rate_pct" field.
+func VatRatePctLTE(v float64) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldVatRatePct, v))
+}
+
+// VatExemptNoteEQ applies the EQ predicate on the "vat_exempt_note" field.
+func VatExemptNoteEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteNEQ applies the NEQ predicate on the "vat_exempt_note" field.
+func VatExemptNoteNEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteIn applies the In predicate on the "vat_exempt_note" field.
+func VatExemptNoteIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldVatExemptNote, vs...))
+}
+
+// VatExemptNoteNotIn applies the NotIn predicate on the "vat_exempt_note" field.
+func VatExemptNoteNotIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldVatExemptNote, vs...))
+}
+
+// VatExemptNoteGT applies the GT predicate on the "vat_exempt_note" field.
+func VatExemptNoteGT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteGTE applies the GTE predicate on the "vat_exempt_note" field.
+func VatExemptNoteGTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteLT applies the LT predicate on the "vat_exempt_note" field.
+func VatExemptNoteLT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteLTE applies the LTE predicate on the "vat_exempt_note" field.
+func VatExemptNoteLTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteContains applies the Contains predicate on the "vat_exempt_note" field.
+func VatExemptNoteContains(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContains(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteHasPrefix applies the HasPrefix predicate on the "vat_exempt_note" field.
+func VatExemptNoteHasPrefix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasPrefix(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteHasSuffix applies the HasSuffix predicate on the "vat_exempt_note" field.
+func VatExemptNoteHasSuffix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasSuffix(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteEqualFold applies the EqualFold predicate on the "vat_exempt_note" field.
+func VatExemptNoteEqualFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEqualFold(FieldVatExemptNote, v))
+}
+
+// VatExemptNoteContainsFold applies the ContainsFold predicate on the "vat_exempt_note" field.
+func VatExemptNoteContainsFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContainsFold(FieldVatExemptNote, v))
+}
+
 // HasInvoice applies the HasEdge predicate on the "invoice" edge.
 func HasInvoice() predicate.InvoiceLine {
 	return predicate.InvoiceLine(func(s *sql.Selector) {
@@ -445,6 +685,52 @@ func HasEnrollmentWith(preds ...predicate.Enrollment) predicate.InvoiceLine {
 	})
 }
 
+// HasCharge applies the HasEdge predicate on the "charge" edge.
+func HasCharge() predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChargeTable, ChargeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChargeWith applies the HasEdge predicate on the "charge" edge with a given conditions (other predicates).
+func HasChargeWith(preds ...predicate.StudentCharge) predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := newChargeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAbsence applies the HasEdge predicate on the "absence" edge.
+func HasAbsence() predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AbsenceTable, AbsenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAbsenceWith applies the HasEdge predicate on the "absence" edge with a given conditions (other predicates).
+func HasAbsenceWith(preds ...predicate.ExcusedAbsence) predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := newAbsenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InvoiceLine) predicate.InvoiceLine {
 	return predicate.InvoiceLine(sql.AndPredicates(predicates...))
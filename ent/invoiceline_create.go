@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/studentcharge"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -33,6 +35,56 @@ func (_c *InvoiceLineCreate) SetEnrollmentID(v int) *InvoiceLineCreate {
 	return _c
 }
 
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableEnrollmentID(v *int) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetEnrollmentID(*v)
+	}
+	return _c
+}
+
+// SetChargeID sets the "charge_id" field.
+func (_c *InvoiceLineCreate) SetChargeID(v int) *InvoiceLineCreate {
+	_c.mutation.SetChargeID(v)
+	return _c
+}
+
+// SetNillableChargeID sets the "charge_id" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableChargeID(v *int) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetChargeID(*v)
+	}
+	return _c
+}
+
+// SetChargeYear sets the "charge_year" field.
+func (_c *InvoiceLineCreate) SetChargeYear(v int) *InvoiceLineCreate {
+	_c.mutation.SetChargeYear(v)
+	return _c
+}
+
+// SetNillableChargeYear sets the "charge_year" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableChargeYear(v *int) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetChargeYear(*v)
+	}
+	return _c
+}
+
+// SetAbsenceID sets the "absence_id" field.
+func (_c *InvoiceLineCreate) SetAbsenceID(v int) *InvoiceLineCreate {
+	_c.mutation.SetAbsenceID(v)
+	return _c
+}
+
+// SetNillableAbsenceID sets the "absence_id" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableAbsenceID(v *int) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetAbsenceID(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *InvoiceLineCreate) SetDescription(v string) *InvoiceLineCreate {
 	_c.mutation.SetDescription(v)
@@ -101,6 +153,34 @@ func (_c *InvoiceLineCreate) SetNillableAmountCents(v *int64) *InvoiceLineCreate
 	return _c
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_c *InvoiceLineCreate) SetVatRatePct(v float64) *InvoiceLineCreate {
+	_c.mutation.SetVatRatePct(v)
+	return _c
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableVatRatePct(v *float64) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetVatRatePct(*v)
+	}
+	return _c
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_c *InvoiceLineCreate) SetVatExemptNote(v string) *InvoiceLineCreate {
+	_c.mutation.SetVatExemptNote(v)
+	return _c
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableVatExemptNote(v *string) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetVatExemptNote(*v)
+	}
+	return _c
+}
+
 // SetInvoice sets the "invoice" edge to the Invoice entity.
 func (_c *InvoiceLineCreate) SetInvoice(v *Invoice) *InvoiceLineCreate {
 	return _c.SetInvoiceID(v.ID)
@@ -111,6 +191,16 @@ func (_c *InvoiceLineCreate) SetEnrollment(v *Enrollment) *InvoiceLineCreate {
 	return _c.SetEnrollmentID(v.ID)
 }
 
+// SetCharge sets the "charge" edge to the StudentCharge entity.
+func (_c *InvoiceLineCreate) SetCharge(v *StudentCharge) *InvoiceLineCreate {
+	return _c.SetChargeID(v.ID)
+}
+
+// SetAbsence sets the "absence" edge to the ExcusedAbsence entity.
+func (_c *InvoiceLineCreate) SetAbsence(v *ExcusedAbsence) *InvoiceLineCreate {
+	return _c.SetAbsenceID(v.ID)
+}
+
 // Mutation returns the InvoiceLineMutation object of the builder.
 func (_c *InvoiceLineCreate) Mutation() *InvoiceLineMutation {
 	return _c.mutation
@@ -146,6 +236,10 @@ func (_c *InvoiceLineCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *InvoiceLineCreate) defaults() {
+	if _, ok := _c.mutation.ChargeYear(); !ok {
+		v := invoiceline.DefaultChargeYear
+		_c.mutation.SetChargeYear(v)
+	}
 	if _, ok := _c.mutation.LegacyUnitPrice(); !ok {
 		v := invoiceline.DefaultLegacyUnitPrice
 		_c.mutation.SetLegacyUnitPrice(v)
@@ -162,6 +256,14 @@ func (_c *InvoiceLineCreate) defaults() {
 		v := invoiceline.DefaultAmountCents
 		_c.mutation.SetAmountCents(v)
 	}
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		v := invoiceline.DefaultVatRatePct
+		_c.mutation.SetVatRatePct(v)
+	}
+	if _, ok := _c.mutation.VatExemptNote(); !ok {
+		v := invoiceline.DefaultVatExemptNote
+		_c.mutation.SetVatExemptNote(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -169,8 +271,8 @@ func (_c *InvoiceLineCreate) check() error {
 	if _, ok := _c.mutation.InvoiceID(); !ok {
 		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceLine.invoice_id"`)}
 	}
-	if _, ok := _c.mutation.EnrollmentID(); !ok {
-		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "InvoiceLine.enrollment_id"`)}
+	if _, ok := _c.mutation.ChargeYear(); !ok {
+		return &ValidationError{Name: "charge_year", err: errors.New(`ent: missing required field "InvoiceLine.charge_year"`)}
 	}
 	if _, ok := _c.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "InvoiceLine.description"`)}
@@ -190,12 +292,15 @@ func (_c *InvoiceLineCreate) check() error {
 	if _, ok := _c.mutation.AmountCents(); !ok {
 		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "InvoiceLine.amount_cents"`)}
 	}
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		return &ValidationError{Name: "vat_rate_pct", err: errors.New(`ent: missing required field "InvoiceLine.vat_rate_pct"`)}
+	}
+	if _, ok := _c.mutation.VatExemptNote(); !ok {
+		return &ValidationError{Name: "vat_exempt_note", err: errors.New(`ent: missing required field "InvoiceLine.vat_exempt_note"`)}
+	}
 	if len(_c.mutation.InvoiceIDs()) == 0 {
 		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoiceLine.invoice"`)}
 	}
-	if len(_c.mutation.EnrollmentIDs()) == 0 {
-		return &ValidationError{Name: "enrollment", err: errors.New(`ent: missing required edge "InvoiceLine.enrollment"`)}
-	}
 	return nil
 }
 
@@ -222,6 +327,10 @@ func (_c *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
 		_node = &InvoiceLine{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(invoiceline.Table, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.ChargeYear(); ok {
+		_spec.SetField(invoiceline.FieldChargeYear, field.TypeInt, value)
+		_node.ChargeYear = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
 		_node.Description = value
@@ -246,6 +355,14 @@ func (_c *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoiceline.FieldAmountCents, field.TypeInt64, value)
 		_node.AmountCents = value
 	}
+	if value, ok := _c.mutation.VatRatePct(); ok {
+		_spec.SetField(invoiceline.FieldVatRatePct, field.TypeFloat64, value)
+		_node.VatRatePct = value
+	}
+	if value, ok := _c.mutation.VatExemptNote(); ok {
+		_spec.SetField(invoiceline.FieldVatExemptNote, field.TypeString, value)
+		_node.VatExemptNote = value
+	}
 	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -277,7 +394,41 @@ func (_c *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.EnrollmentID = nodes[0]
+		_node.EnrollmentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChargeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.ChargeTable,
+			Columns: []string{invoiceline.ChargeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChargeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AbsenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.AbsenceTable,
+			Columns: []string{invoiceline.AbsenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AbsenceID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
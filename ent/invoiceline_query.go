@@ -6,9 +6,11 @@ import (
 	"context"
 	"fmt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/predicate"
+	"langschool/ent/studentcharge"
 	"math"
 
 	"entgo.io/ent"
@@ -26,6 +28,8 @@ type InvoiceLineQuery struct {
 	predicates     []predicate.InvoiceLine
 	withInvoice    *InvoiceQuery
 	withEnrollment *EnrollmentQuery
+	withCharge     *StudentChargeQuery
+	withAbsence    *ExcusedAbsenceQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +110,50 @@ func (_q *InvoiceLineQuery) QueryEnrollment() *EnrollmentQuery {
 	return query
 }
 
+// QueryCharge chains the current query on the "charge" edge.
+func (_q *InvoiceLineQuery) QueryCharge() *StudentChargeQuery {
+	query := (&StudentChargeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, selector),
+			sqlgraph.To(studentcharge.Table, studentcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.ChargeTable, invoiceline.ChargeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAbsence chains the current query on the "absence" edge.
+func (_q *InvoiceLineQuery) QueryAbsence() *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, selector),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.AbsenceTable, invoiceline.AbsenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first InvoiceLine entity from the query.
 // Returns a *NotFoundError when no InvoiceLine was found.
 func (_q *InvoiceLineQuery) First(ctx context.Context) (*InvoiceLine, error) {
@@ -300,6 +348,8 @@ func (_q *InvoiceLineQuery) Clone() *InvoiceLineQuery {
 		predicates:     append([]predicate.InvoiceLine{}, _q.predicates...),
 		withInvoice:    _q.withInvoice.Clone(),
 		withEnrollment: _q.withEnrollment.Clone(),
+		withCharge:     _q.withCharge.Clone(),
+		withAbsence:    _q.withAbsence.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +378,28 @@ func (_q *InvoiceLineQuery) WithEnrollment(opts ...func(*EnrollmentQuery)) *Invo
 	return _q
 }
 
+// WithCharge tells the query-builder to eager-load the nodes that are connected to
+// the "charge" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceLineQuery) WithCharge(opts ...func(*StudentChargeQuery)) *InvoiceLineQuery {
+	query := (&StudentChargeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCharge = query
+	return _q
+}
+
+// WithAbsence tells the query-builder to eager-load the nodes that are connected to
+// the "absence" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceLineQuery) WithAbsence(opts ...func(*ExcusedAbsenceQuery)) *InvoiceLineQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAbsence = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +478,11 @@ func (_q *InvoiceLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*InvoiceLine{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withInvoice != nil,
 			_q.withEnrollment != nil,
+			_q.withCharge != nil,
+			_q.withAbsence != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +515,18 @@ func (_q *InvoiceLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withCharge; query != nil {
+		if err := _q.loadCharge(ctx, query, nodes, nil,
+			func(n *InvoiceLine, e *StudentCharge) { n.Edges.Charge = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAbsence; query != nil {
+		if err := _q.loadAbsence(ctx, query, nodes, nil,
+			func(n *InvoiceLine, e *ExcusedAbsence) { n.Edges.Absence = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -477,7 +563,10 @@ func (_q *InvoiceLineQuery) loadEnrollment(ctx context.Context, query *Enrollmen
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*InvoiceLine)
 	for i := range nodes {
-		fk := nodes[i].EnrollmentID
+		if nodes[i].EnrollmentID == nil {
+			continue
+		}
+		fk := *nodes[i].EnrollmentID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -502,6 +591,70 @@ func (_q *InvoiceLineQuery) loadEnrollment(ctx context.Context, query *Enrollmen
 	}
 	return nil
 }
+func (_q *InvoiceLineQuery) loadCharge(ctx context.Context, query *StudentChargeQuery, nodes []*InvoiceLine, init func(*InvoiceLine), assign func(*InvoiceLine, *StudentCharge)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InvoiceLine)
+	for i := range nodes {
+		if nodes[i].ChargeID == nil {
+			continue
+		}
+		fk := *nodes[i].ChargeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(studentcharge.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "charge_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *InvoiceLineQuery) loadAbsence(ctx context.Context, query *ExcusedAbsenceQuery, nodes []*InvoiceLine, init func(*InvoiceLine), assign func(*InvoiceLine, *ExcusedAbsence)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InvoiceLine)
+	for i := range nodes {
+		if nodes[i].AbsenceID == nil {
+			continue
+		}
+		fk := *nodes[i].AbsenceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(excusedabsence.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "absence_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *InvoiceLineQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -534,6 +687,12 @@ func (_q *InvoiceLineQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withEnrollment != nil {
 			_spec.Node.AddColumnOnce(invoiceline.FieldEnrollmentID)
 		}
+		if _q.withCharge != nil {
+			_spec.Node.AddColumnOnce(invoiceline.FieldChargeID)
+		}
+		if _q.withAbsence != nil {
+			_spec.Node.AddColumnOnce(invoiceline.FieldAbsenceID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/predicate"
+	"langschool/ent/studentcharge"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -57,6 +59,73 @@ func (_u *InvoiceLineUpdate) SetNillableEnrollmentID(v *int) *InvoiceLineUpdate
 	return _u
 }
 
+// ClearEnrollmentID clears the value of the "enrollment_id" field.
+func (_u *InvoiceLineUpdate) ClearEnrollmentID() *InvoiceLineUpdate {
+	_u.mutation.ClearEnrollmentID()
+	return _u
+}
+
+// SetChargeID sets the "charge_id" field.
+func (_u *InvoiceLineUpdate) SetChargeID(v int) *InvoiceLineUpdate {
+	_u.mutation.SetChargeID(v)
+	return _u
+}
+
+// SetNillableChargeID sets the "charge_id" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableChargeID(v *int) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetChargeID(*v)
+	}
+	return _u
+}
+
+// ClearChargeID clears the value of the "charge_id" field.
+func (_u *InvoiceLineUpdate) ClearChargeID() *InvoiceLineUpdate {
+	_u.mutation.ClearChargeID()
+	return _u
+}
+
+// SetChargeYear sets the "charge_year" field.
+func (_u *InvoiceLineUpdate) SetChargeYear(v int) *InvoiceLineUpdate {
+	_u.mutation.ResetChargeYear()
+	_u.mutation.SetChargeYear(v)
+	return _u
+}
+
+// SetNillableChargeYear sets the "charge_year" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableChargeYear(v *int) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetChargeYear(*v)
+	}
+	return _u
+}
+
+// AddChargeYear adds value to the "charge_year" field.
+func (_u *InvoiceLineUpdate) AddChargeYear(v int) *InvoiceLineUpdate {
+	_u.mutation.AddChargeYear(v)
+	return _u
+}
+
+// SetAbsenceID sets the "absence_id" field.
+func (_u *InvoiceLineUpdate) SetAbsenceID(v int) *InvoiceLineUpdate {
+	_u.mutation.SetAbsenceID(v)
+	return _u
+}
+
+// SetNillableAbsenceID sets the "absence_id" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableAbsenceID(v *int) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetAbsenceID(*v)
+	}
+	return _u
+}
+
+// ClearAbsenceID clears the value of the "absence_id" field.
+func (_u *InvoiceLineUpdate) ClearAbsenceID() *InvoiceLineUpdate {
+	_u.mutation.ClearAbsenceID()
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *InvoiceLineUpdate) SetDescription(v string) *InvoiceLineUpdate {
 	_u.mutation.SetDescription(v)
@@ -176,6 +245,41 @@ func (_u *InvoiceLineUpdate) AddAmountCents(v int64) *InvoiceLineUpdate {
 	return _u
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *InvoiceLineUpdate) SetVatRatePct(v float64) *InvoiceLineUpdate {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableVatRatePct(v *float64) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *InvoiceLineUpdate) AddVatRatePct(v float64) *InvoiceLineUpdate {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_u *InvoiceLineUpdate) SetVatExemptNote(v string) *InvoiceLineUpdate {
+	_u.mutation.SetVatExemptNote(v)
+	return _u
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_u *InvoiceLineUpdate) SetNillableVatExemptNote(v *string) *InvoiceLineUpdate {
+	if v != nil {
+		_u.SetVatExemptNote(*v)
+	}
+	return _u
+}
+
 // SetInvoice sets the "invoice" edge to the Invoice entity.
 func (_u *InvoiceLineUpdate) SetInvoice(v *Invoice) *InvoiceLineUpdate {
 	return _u.SetInvoiceID(v.ID)
@@ -186,6 +290,16 @@ func (_u *InvoiceLineUpdate) SetEnrollment(v *Enrollment) *InvoiceLineUpdate {
 	return _u.SetEnrollmentID(v.ID)
 }
 
+// SetCharge sets the "charge" edge to the StudentCharge entity.
+func (_u *InvoiceLineUpdate) SetCharge(v *StudentCharge) *InvoiceLineUpdate {
+	return _u.SetChargeID(v.ID)
+}
+
+// SetAbsence sets the "absence" edge to the ExcusedAbsence entity.
+func (_u *InvoiceLineUpdate) SetAbsence(v *ExcusedAbsence) *InvoiceLineUpdate {
+	return _u.SetAbsenceID(v.ID)
+}
+
 // Mutation returns the InvoiceLineMutation object of the builder.
 func (_u *InvoiceLineUpdate) Mutation() *InvoiceLineMutation {
 	return _u.mutation
@@ -203,6 +317,18 @@ func (_u *InvoiceLineUpdate) ClearEnrollment() *InvoiceLineUpdate {
 	return _u
 }
 
+// ClearCharge clears the "charge" edge to the StudentCharge entity.
+func (_u *InvoiceLineUpdate) ClearCharge() *InvoiceLineUpdate {
+	_u.mutation.ClearCharge()
+	return _u
+}
+
+// ClearAbsence clears the "absence" edge to the ExcusedAbsence entity.
+func (_u *InvoiceLineUpdate) ClearAbsence() *InvoiceLineUpdate {
+	_u.mutation.ClearAbsence()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InvoiceLineUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -235,9 +361,6 @@ func (_u *InvoiceLineUpdate) check() error {
 	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "InvoiceLine.invoice"`)
 	}
-	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "InvoiceLine.enrollment"`)
-	}
 	return nil
 }
 
@@ -253,6 +376,12 @@ func (_u *InvoiceLineUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.ChargeYear(); ok {
+		_spec.SetField(invoiceline.FieldChargeYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargeYear(); ok {
+		_spec.AddField(invoiceline.FieldChargeYear, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
 	}
@@ -286,6 +415,15 @@ func (_u *InvoiceLineUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedAmountCents(); ok {
 		_spec.AddField(invoiceline.FieldAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(invoiceline.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(invoiceline.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.VatExemptNote(); ok {
+		_spec.SetField(invoiceline.FieldVatExemptNote, field.TypeString, value)
+	}
 	if _u.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -344,6 +482,64 @@ func (_u *InvoiceLineUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChargeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.ChargeTable,
+			Columns: []string{invoiceline.ChargeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChargeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.ChargeTable,
+			Columns: []string{invoiceline.ChargeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AbsenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.AbsenceTable,
+			Columns: []string{invoiceline.AbsenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AbsenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.AbsenceTable,
+			Columns: []string{invoiceline.AbsenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invoiceline.Label}
@@ -392,6 +588,73 @@ func (_u *InvoiceLineUpdateOne) SetNillableEnrollmentID(v *int) *InvoiceLineUpda
 	return _u
 }
 
+// ClearEnrollmentID clears the value of the "enrollment_id" field.
+func (_u *InvoiceLineUpdateOne) ClearEnrollmentID() *InvoiceLineUpdateOne {
+	_u.mutation.ClearEnrollmentID()
+	return _u
+}
+
+// SetChargeID sets the "charge_id" field.
+func (_u *InvoiceLineUpdateOne) SetChargeID(v int) *InvoiceLineUpdateOne {
+	_u.mutation.SetChargeID(v)
+	return _u
+}
+
+// SetNillableChargeID sets the "charge_id" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableChargeID(v *int) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetChargeID(*v)
+	}
+	return _u
+}
+
+// ClearChargeID clears the value of the "charge_id" field.
+func (_u *InvoiceLineUpdateOne) ClearChargeID() *InvoiceLineUpdateOne {
+	_u.mutation.ClearChargeID()
+	return _u
+}
+
+// SetChargeYear sets the "charge_year" field.
+func (_u *InvoiceLineUpdateOne) SetChargeYear(v int) *InvoiceLineUpdateOne {
+	_u.mutation.ResetChargeYear()
+	_u.mutation.SetChargeYear(v)
+	return _u
+}
+
+// SetNillableChargeYear sets the "charge_year" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableChargeYear(v *int) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetChargeYear(*v)
+	}
+	return _u
+}
+
+// AddChargeYear adds value to the "charge_year" field.
+func (_u *InvoiceLineUpdateOne) AddChargeYear(v int) *InvoiceLineUpdateOne {
+	_u.mutation.AddChargeYear(v)
+	return _u
+}
+
+// SetAbsenceID sets the "absence_id" field.
+func (_u *InvoiceLineUpdateOne) SetAbsenceID(v int) *InvoiceLineUpdateOne {
+	_u.mutation.SetAbsenceID(v)
+	return _u
+}
+
+// SetNillableAbsenceID sets the "absence_id" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableAbsenceID(v *int) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetAbsenceID(*v)
+	}
+	return _u
+}
+
+// ClearAbsenceID clears the value of the "absence_id" field.
+func (_u *InvoiceLineUpdateOne) ClearAbsenceID() *InvoiceLineUpdateOne {
+	_u.mutation.ClearAbsenceID()
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *InvoiceLineUpdateOne) SetDescription(v string) *InvoiceLineUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -511,6 +774,41 @@ func (_u *InvoiceLineUpdateOne) AddAmountCents(v int64) *InvoiceLineUpdateOne {
 	return _u
 }
 
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *InvoiceLineUpdateOne) SetVatRatePct(v float64) *InvoiceLineUpdateOne {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableVatRatePct(v *float64) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *InvoiceLineUpdateOne) AddVatRatePct(v float64) *InvoiceLineUpdateOne {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetVatExemptNote sets the "vat_exempt_note" field.
+func (_u *InvoiceLineUpdateOne) SetVatExemptNote(v string) *InvoiceLineUpdateOne {
+	_u.mutation.SetVatExemptNote(v)
+	return _u
+}
+
+// SetNillableVatExemptNote sets the "vat_exempt_note" field if the given value is not nil.
+func (_u *InvoiceLineUpdateOne) SetNillableVatExemptNote(v *string) *InvoiceLineUpdateOne {
+	if v != nil {
+		_u.SetVatExemptNote(*v)
+	}
+	return _u
+}
+
 // SetInvoice sets the "invoice" edge to the Invoice entity.
 func (_u *InvoiceLineUpdateOne) SetInvoice(v *Invoice) *InvoiceLineUpdateOne {
 	return _u.SetInvoiceID(v.ID)
@@ -521,6 +819,16 @@ func (_u *InvoiceLineUpdateOne) SetEnrollment(v *Enrollment) *InvoiceLineUpdateO
 	return _u.SetEnrollmentID(v.ID)
 }
 
+// SetCharge sets the "charge" edge to the StudentCharge entity.
+func (_u *InvoiceLineUpdateOne) SetCharge(v *StudentCharge) *InvoiceLineUpdateOne {
+	return _u.SetChargeID(v.ID)
+}
+
+// SetAbsence sets the "absence" edge to the ExcusedAbsence entity.
+func (_u *InvoiceLineUpdateOne) SetAbsence(v *ExcusedAbsence) *InvoiceLineUpdateOne {
+	return _u.SetAbsenceID(v.ID)
+}
+
 // Mutation returns the InvoiceLineMutation object of the builder.
 func (_u *InvoiceLineUpdateOne) Mutation() *InvoiceLineMutation {
 	return _u.mutation
@@ -538,6 +846,18 @@ func (_u *InvoiceLineUpdateOne) ClearEnrollment() *InvoiceLineUpdateOne {
 	return _u
 }
 
+// ClearCharge clears the "charge" edge to the StudentCharge entity.
+func (_u *InvoiceLineUpdateOne) ClearCharge() *InvoiceLineUpdateOne {
+	_u.mutation.ClearCharge()
+	return _u
+}
+
+// ClearAbsence clears the "absence" edge to the ExcusedAbsence entity.
+func (_u *InvoiceLineUpdateOne) ClearAbsence() *InvoiceLineUpdateOne {
+	_u.mutation.ClearAbsence()
+	return _u
+}
+
 // Where appends a list predicates to the InvoiceLineUpdate builder.
 func (_u *InvoiceLineUpdateOne) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdateOne {
 	_u.mutation.Where(ps...)
@@ -583,9 +903,6 @@ func (_u *InvoiceLineUpdateOne) check() error {
 	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "InvoiceLine.invoice"`)
 	}
-	if _u.mutation.EnrollmentCleared() && len(_u.mutation.EnrollmentIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "InvoiceLine.enrollment"`)
-	}
 	return nil
 }
 
@@ -618,6 +935,12 @@ func (_u *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLine
 			}
 		}
 	}
+	if value, ok := _u.mutation.ChargeYear(); ok {
+		_spec.SetField(invoiceline.FieldChargeYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargeYear(); ok {
+		_spec.AddField(invoiceline.FieldChargeYear, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
 	}
@@ -651,6 +974,15 @@ func (_u *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLine
 	if value, ok := _u.mutation.AddedAmountCents(); ok {
 		_spec.AddField(invoiceline.FieldAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(invoiceline.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(invoiceline.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.VatExemptNote(); ok {
+		_spec.SetField(invoiceline.FieldVatExemptNote, field.TypeString, value)
+	}
 	if _u.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -709,6 +1041,64 @@ func (_u *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLine
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChargeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.ChargeTable,
+			Columns: []string{invoiceline.ChargeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChargeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.ChargeTable,
+			Columns: []string{invoiceline.ChargeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AbsenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.AbsenceTable,
+			Columns: []string{invoiceline.AbsenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AbsenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.AbsenceTable,
+			Columns: []string{invoiceline.AbsenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &InvoiceLine{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
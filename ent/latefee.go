@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LateFee is the model entity for the LateFee schema.
+type LateFee struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// PeriodYear holds the value of the "period_year" field.
+	PeriodYear int `json:"period_year,omitempty"`
+	// PeriodMonth holds the value of the "period_month" field.
+	PeriodMonth int `json:"period_month,omitempty"`
+	// AmountCents holds the value of the "amount_cents" field.
+	AmountCents int64 `json:"amount_cents,omitempty"`
+	// DaysLate holds the value of the "days_late" field.
+	DaysLate int `json:"days_late,omitempty"`
+	// Status holds the value of the "status" field.
+	Status latefee.Status `json:"status,omitempty"`
+	// WaivedReason holds the value of the "waived_reason" field.
+	WaivedReason string `json:"waived_reason,omitempty"`
+	// WaivedBy holds the value of the "waived_by" field.
+	WaivedBy string `json:"waived_by,omitempty"`
+	// WaivedAt holds the value of the "waived_at" field.
+	WaivedAt *time.Time `json:"waived_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LateFeeQuery when eager-loading is set.
+	Edges        LateFeeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LateFeeEdges holds the relations/edges for other nodes in the graph.
+type LateFeeEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LateFeeEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LateFeeEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LateFee) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case latefee.FieldID, latefee.FieldStudentID, latefee.FieldInvoiceID, latefee.FieldPeriodYear, latefee.FieldPeriodMonth, latefee.FieldAmountCents, latefee.FieldDaysLate:
+			values[i] = new(sql.NullInt64)
+		case latefee.FieldStatus, latefee.FieldWaivedReason, latefee.FieldWaivedBy:
+			values[i] = new(sql.NullString)
+		case latefee.FieldWaivedAt, latefee.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LateFee fields.
+func (_m *LateFee) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case latefee.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case latefee.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case latefee.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				_m.InvoiceID = int(value.Int64)
+			}
+		case latefee.FieldPeriodYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_year", values[i])
+			} else if value.Valid {
+				_m.PeriodYear = int(value.Int64)
+			}
+		case latefee.FieldPeriodMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_month", values[i])
+			} else if value.Valid {
+				_m.PeriodMonth = int(value.Int64)
+			}
+		case latefee.FieldAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_cents", values[i])
+			} else if value.Valid {
+				_m.AmountCents = value.Int64
+			}
+		case latefee.FieldDaysLate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field days_late", values[i])
+			} else if value.Valid {
+				_m.DaysLate = int(value.Int64)
+			}
+		case latefee.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = latefee.Status(value.String)
+			}
+		case latefee.FieldWaivedReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field waived_reason", values[i])
+			} else if value.Valid {
+				_m.WaivedReason = value.String
+			}
+		case latefee.FieldWaivedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field waived_by", values[i])
+			} else if value.Valid {
+				_m.WaivedBy = value.String
+			}
+		case latefee.FieldWaivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field waived_at", values[i])
+			} else if value.Valid {
+				_m.WaivedAt = new(time.Time)
+				*_m.WaivedAt = value.Time
+			}
+		case latefee.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LateFee.
+// This includes values selected through modifiers, order, etc.
+func (_m *LateFee) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the LateFee entity.
+func (_m *LateFee) QueryStudent() *StudentQuery {
+	return NewLateFeeClient(_m.config).QueryStudent(_m)
+}
+
+// QueryInvoice queries the "invoice" edge of the LateFee entity.
+func (_m *LateFee) QueryInvoice() *InvoiceQuery {
+	return NewLateFeeClient(_m.config).QueryInvoice(_m)
+}
+
+// Update returns a builder for updating this LateFee.
+// Note that you need to call LateFee.Unwrap() before calling this method if this LateFee
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LateFee) Update() *LateFeeUpdateOne {
+	return NewLateFeeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LateFee entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LateFee) Unwrap() *LateFee {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LateFee is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LateFee) String() string {
+	var builder strings.Builder
+	builder.WriteString("LateFee(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("period_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeriodYear))
+	builder.WriteString(", ")
+	builder.WriteString("period_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeriodMonth))
+	builder.WriteString(", ")
+	builder.WriteString("amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
+	builder.WriteString(", ")
+	builder.WriteString("days_late=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DaysLate))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("waived_reason=")
+	builder.WriteString(_m.WaivedReason)
+	builder.WriteString(", ")
+	builder.WriteString("waived_by=")
+	builder.WriteString(_m.WaivedBy)
+	builder.WriteString(", ")
+	if v := _m.WaivedAt; v != nil {
+		builder.WriteString("waived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LateFees is a parsable slice of LateFee.
+type LateFees []*LateFee
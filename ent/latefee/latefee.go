@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package latefee
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the latefee type in the database.
+	Label = "late_fee"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldPeriodYear holds the string denoting the period_year field in the database.
+	FieldPeriodYear = "period_year"
+	// FieldPeriodMonth holds the string denoting the period_month field in the database.
+	FieldPeriodMonth = "period_month"
+	// FieldAmountCents holds the string denoting the amount_cents field in the database.
+	FieldAmountCents = "amount_cents"
+	// FieldDaysLate holds the string denoting the days_late field in the database.
+	FieldDaysLate = "days_late"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldWaivedReason holds the string denoting the waived_reason field in the database.
+	FieldWaivedReason = "waived_reason"
+	// FieldWaivedBy holds the string denoting the waived_by field in the database.
+	FieldWaivedBy = "waived_by"
+	// FieldWaivedAt holds the string denoting the waived_at field in the database.
+	FieldWaivedAt = "waived_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// Table holds the table name of the latefee in the database.
+	Table = "late_fees"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "late_fees"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "late_fees"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_id"
+)
+
+// Columns holds all SQL columns for latefee fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldInvoiceID,
+	FieldPeriodYear,
+	FieldPeriodMonth,
+	FieldAmountCents,
+	FieldDaysLate,
+	FieldStatus,
+	FieldWaivedReason,
+	FieldWaivedBy,
+	FieldWaivedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDaysLate holds the default value on creation for the "days_late" field.
+	DefaultDaysLate int
+	// DefaultWaivedReason holds the default value on creation for the "waived_reason" field.
+	DefaultWaivedReason string
+	// DefaultWaivedBy holds the default value on creation for the "waived_by" field.
+	DefaultWaivedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusBilled is the default value of the Status enum.
+const DefaultStatus = StatusBilled
+
+// Status values.
+const (
+	StatusBilled Status = "billed"
+	StatusWaived Status = "waived"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusBilled, StatusWaived:
+		return nil
+	default:
+		return fmt.Errorf("latefee: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the LateFee queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByPeriodYear orders the results by the period_year field.
+func ByPeriodYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodYear, opts...).ToFunc()
+}
+
+// ByPeriodMonth orders the results by the period_month field.
+func ByPeriodMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodMonth, opts...).ToFunc()
+}
+
+// ByAmountCents orders the results by the amount_cents field.
+func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
+}
+
+// ByDaysLate orders the results by the days_late field.
+func ByDaysLate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDaysLate, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByWaivedReason orders the results by the waived_reason field.
+func ByWaivedReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaivedReason, opts...).ToFunc()
+}
+
+// ByWaivedBy orders the results by the waived_by field.
+func ByWaivedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaivedBy, opts...).ToFunc()
+}
+
+// ByWaivedAt orders the results by the waived_at field.
+func ByWaivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaivedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+	)
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package latefee
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldStudentID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// PeriodYear applies equality check predicate on the "period_year" field. It's identical to PeriodYearEQ.
+func PeriodYear(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldPeriodYear, v))
+}
+
+// PeriodMonth applies equality check predicate on the "period_month" field. It's identical to PeriodMonthEQ.
+func PeriodMonth(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// AmountCents applies equality check predicate on the "amount_cents" field. It's identical to AmountCentsEQ.
+func AmountCents(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// DaysLate applies equality check predicate on the "days_late" field. It's identical to DaysLateEQ.
+func DaysLate(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldDaysLate, v))
+}
+
+// WaivedReason applies equality check predicate on the "waived_reason" field. It's identical to WaivedReasonEQ.
+func WaivedReason(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedReason, v))
+}
+
+// WaivedBy applies equality check predicate on the "waived_by" field. It's identical to WaivedByEQ.
+func WaivedBy(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedBy, v))
+}
+
+// WaivedAt applies equality check predicate on the "waived_at" field. It's identical to WaivedAtEQ.
+func WaivedAt(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// PeriodYearEQ applies the EQ predicate on the "period_year" field.
+func PeriodYearEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldPeriodYear, v))
+}
+
+// PeriodYearNEQ applies the NEQ predicate on the "period_year" field.
+func PeriodYearNEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldPeriodYear, v))
+}
+
+// PeriodYearIn applies the In predicate on the "period_year" field.
+func PeriodYearIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldPeriodYear, vs...))
+}
+
+// PeriodYearNotIn applies the NotIn predicate on the "period_year" field.
+func PeriodYearNotIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldPeriodYear, vs...))
+}
+
+// PeriodYearGT applies the GT predicate on the "period_year" field.
+func PeriodYearGT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldPeriodYear, v))
+}
+
+// PeriodYearGTE applies the GTE predicate on the "period_year" field.
+func PeriodYearGTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldPeriodYear, v))
+}
+
+// PeriodYearLT applies the LT predicate on the "period_year" field.
+func PeriodYearLT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldPeriodYear, v))
+}
+
+// PeriodYearLTE applies the LTE predicate on the "period_year" field.
+func PeriodYearLTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldPeriodYear, v))
+}
+
+// PeriodMonthEQ applies the EQ predicate on the "period_month" field.
+func PeriodMonthEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthNEQ applies the NEQ predicate on the "period_month" field.
+func PeriodMonthNEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthIn applies the In predicate on the "period_month" field.
+func PeriodMonthIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthNotIn applies the NotIn predicate on the "period_month" field.
+func PeriodMonthNotIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthGT applies the GT predicate on the "period_month" field.
+func PeriodMonthGT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthGTE applies the GTE predicate on the "period_month" field.
+func PeriodMonthGTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLT applies the LT predicate on the "period_month" field.
+func PeriodMonthLT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLTE applies the LTE predicate on the "period_month" field.
+func PeriodMonthLTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldPeriodMonth, v))
+}
+
+// AmountCentsEQ applies the EQ predicate on the "amount_cents" field.
+func AmountCentsEQ(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// AmountCentsNEQ applies the NEQ predicate on the "amount_cents" field.
+func AmountCentsNEQ(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldAmountCents, v))
+}
+
+// AmountCentsIn applies the In predicate on the "amount_cents" field.
+func AmountCentsIn(vs ...int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsNotIn applies the NotIn predicate on the "amount_cents" field.
+func AmountCentsNotIn(vs ...int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsGT applies the GT predicate on the "amount_cents" field.
+func AmountCentsGT(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldAmountCents, v))
+}
+
+// AmountCentsGTE applies the GTE predicate on the "amount_cents" field.
+func AmountCentsGTE(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldAmountCents, v))
+}
+
+// AmountCentsLT applies the LT predicate on the "amount_cents" field.
+func AmountCentsLT(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldAmountCents, v))
+}
+
+// AmountCentsLTE applies the LTE predicate on the "amount_cents" field.
+func AmountCentsLTE(v int64) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldAmountCents, v))
+}
+
+// DaysLateEQ applies the EQ predicate on the "days_late" field.
+func DaysLateEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldDaysLate, v))
+}
+
+// DaysLateNEQ applies the NEQ predicate on the "days_late" field.
+func DaysLateNEQ(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldDaysLate, v))
+}
+
+// DaysLateIn applies the In predicate on the "days_late" field.
+func DaysLateIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldDaysLate, vs...))
+}
+
+// DaysLateNotIn applies the NotIn predicate on the "days_late" field.
+func DaysLateNotIn(vs ...int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldDaysLate, vs...))
+}
+
+// DaysLateGT applies the GT predicate on the "days_late" field.
+func DaysLateGT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldDaysLate, v))
+}
+
+// DaysLateGTE applies the GTE predicate on the "days_late" field.
+func DaysLateGTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldDaysLate, v))
+}
+
+// DaysLateLT applies the LT predicate on the "days_late" field.
+func DaysLateLT(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldDaysLate, v))
+}
+
+// DaysLateLTE applies the LTE predicate on the "days_late" field.
+func DaysLateLTE(v int) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldDaysLate, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// WaivedReasonEQ applies the EQ predicate on the "waived_reason" field.
+func WaivedReasonEQ(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedReason, v))
+}
+
+// WaivedReasonNEQ applies the NEQ predicate on the "waived_reason" field.
+func WaivedReasonNEQ(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldWaivedReason, v))
+}
+
+// WaivedReasonIn applies the In predicate on the "waived_reason" field.
+func WaivedReasonIn(vs ...string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldWaivedReason, vs...))
+}
+
+// WaivedReasonNotIn applies the NotIn predicate on the "waived_reason" field.
+func WaivedReasonNotIn(vs ...string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldWaivedReason, vs...))
+}
+
+// WaivedReasonGT applies the GT predicate on the "waived_reason" field.
+func WaivedReasonGT(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldWaivedReason, v))
+}
+
+// WaivedReasonGTE applies the GTE predicate on the "waived_reason" field.
+func WaivedReasonGTE(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldWaivedReason, v))
+}
+
+// WaivedReasonLT applies the LT predicate on the "waived_reason" field.
+func WaivedReasonLT(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldWaivedReason, v))
+}
+
+// WaivedReasonLTE applies the LTE predicate on the "waived_reason" field.
+func WaivedReasonLTE(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldWaivedReason, v))
+}
+
+// WaivedReasonContains applies the Contains predicate on the "waived_reason" field.
+func WaivedReasonContains(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldContains(FieldWaivedReason, v))
+}
+
+// WaivedReasonHasPrefix applies the HasPrefix predicate on the "waived_reason" field.
+func WaivedReasonHasPrefix(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldHasPrefix(FieldWaivedReason, v))
+}
+
+// WaivedReasonHasSuffix applies the HasSuffix predicate on the "waived_reason" field.
+func WaivedReasonHasSuffix(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldHasSuffix(FieldWaivedReason, v))
+}
+
+// WaivedReasonEqualFold applies the EqualFold predicate on the "waived_reason" field.
+func WaivedReasonEqualFold(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEqualFold(FieldWaivedReason, v))
+}
+
+// WaivedReasonContainsFold applies the ContainsFold predicate on the "waived_reason" field.
+func WaivedReasonContainsFold(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldContainsFold(FieldWaivedReason, v))
+}
+
+// WaivedByEQ applies the EQ predicate on the "waived_by" field.
+func WaivedByEQ(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedBy, v))
+}
+
+// WaivedByNEQ applies the NEQ predicate on the "waived_by" field.
+func WaivedByNEQ(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldWaivedBy, v))
+}
+
+// WaivedByIn applies the In predicate on the "waived_by" field.
+func WaivedByIn(vs ...string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldWaivedBy, vs...))
+}
+
+// WaivedByNotIn applies the NotIn predicate on the "waived_by" field.
+func WaivedByNotIn(vs ...string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldWaivedBy, vs...))
+}
+
+// WaivedByGT applies the GT predicate on the "waived_by" field.
+func WaivedByGT(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldWaivedBy, v))
+}
+
+// WaivedByGTE applies the GTE predicate on the "waived_by" field.
+func WaivedByGTE(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldWaivedBy, v))
+}
+
+// WaivedByLT applies the LT predicate on the "waived_by" field.
+func WaivedByLT(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldWaivedBy, v))
+}
+
+// WaivedByLTE applies the LTE predicate on the "waived_by" field.
+func WaivedByLTE(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldWaivedBy, v))
+}
+
+// WaivedByContains applies the Contains predicate on the "waived_by" field.
+func WaivedByContains(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldContains(FieldWaivedBy, v))
+}
+
+// WaivedByHasPrefix applies the HasPrefix predicate on the "waived_by" field.
+func WaivedByHasPrefix(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldHasPrefix(FieldWaivedBy, v))
+}
+
+// WaivedByHasSuffix applies the HasSuffix predicate on the "waived_by" field.
+func WaivedByHasSuffix(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldHasSuffix(FieldWaivedBy, v))
+}
+
+// WaivedByEqualFold applies the EqualFold predicate on the "waived_by" field.
+func WaivedByEqualFold(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEqualFold(FieldWaivedBy, v))
+}
+
+// WaivedByContainsFold applies the ContainsFold predicate on the "waived_by" field.
+func WaivedByContainsFold(v string) predicate.LateFee {
+	return predicate.LateFee(sql.FieldContainsFold(FieldWaivedBy, v))
+}
+
+// WaivedAtEQ applies the EQ predicate on the "waived_at" field.
+func WaivedAtEQ(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldWaivedAt, v))
+}
+
+// WaivedAtNEQ applies the NEQ predicate on the "waived_at" field.
+func WaivedAtNEQ(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldWaivedAt, v))
+}
+
+// WaivedAtIn applies the In predicate on the "waived_at" field.
+func WaivedAtIn(vs ...time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldWaivedAt, vs...))
+}
+
+// WaivedAtNotIn applies the NotIn predicate on the "waived_at" field.
+func WaivedAtNotIn(vs ...time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldWaivedAt, vs...))
+}
+
+// WaivedAtGT applies the GT predicate on the "waived_at" field.
+func WaivedAtGT(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldWaivedAt, v))
+}
+
+// WaivedAtGTE applies the GTE predicate on the "waived_at" field.
+func WaivedAtGTE(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldWaivedAt, v))
+}
+
+// WaivedAtLT applies the LT predicate on the "waived_at" field.
+func WaivedAtLT(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldWaivedAt, v))
+}
+
+// WaivedAtLTE applies the LTE predicate on the "waived_at" field.
+func WaivedAtLTE(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldWaivedAt, v))
+}
+
+// WaivedAtIsNil applies the IsNil predicate on the "waived_at" field.
+func WaivedAtIsNil() predicate.LateFee {
+	return predicate.LateFee(sql.FieldIsNull(FieldWaivedAt))
+}
+
+// WaivedAtNotNil applies the NotNil predicate on the "waived_at" field.
+func WaivedAtNotNil() predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotNull(FieldWaivedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LateFee {
+	return predicate.LateFee(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.LateFee {
+	return predicate.LateFee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.LateFee {
+	return predicate.LateFee(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.LateFee {
+	return predicate.LateFee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.LateFee {
+	return predicate.LateFee(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LateFee) predicate.LateFee {
+	return predicate.LateFee(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LateFee) predicate.LateFee {
+	return predicate.LateFee(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LateFee) predicate.LateFee {
+	return predicate.LateFee(sql.NotPredicates(p))
+}
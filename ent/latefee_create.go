@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LateFeeCreate is the builder for creating a LateFee entity.
+type LateFeeCreate struct {
+	config
+	mutation *LateFeeMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *LateFeeCreate) SetStudentID(v int) *LateFeeCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *LateFeeCreate) SetInvoiceID(v int) *LateFeeCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetPeriodYear sets the "period_year" field.
+func (_c *LateFeeCreate) SetPeriodYear(v int) *LateFeeCreate {
+	_c.mutation.SetPeriodYear(v)
+	return _c
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (_c *LateFeeCreate) SetPeriodMonth(v int) *LateFeeCreate {
+	_c.mutation.SetPeriodMonth(v)
+	return _c
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_c *LateFeeCreate) SetAmountCents(v int64) *LateFeeCreate {
+	_c.mutation.SetAmountCents(v)
+	return _c
+}
+
+// SetDaysLate sets the "days_late" field.
+func (_c *LateFeeCreate) SetDaysLate(v int) *LateFeeCreate {
+	_c.mutation.SetDaysLate(v)
+	return _c
+}
+
+// SetNillableDaysLate sets the "days_late" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableDaysLate(v *int) *LateFeeCreate {
+	if v != nil {
+		_c.SetDaysLate(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LateFeeCreate) SetStatus(v latefee.Status) *LateFeeCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableStatus(v *latefee.Status) *LateFeeCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetWaivedReason sets the "waived_reason" field.
+func (_c *LateFeeCreate) SetWaivedReason(v string) *LateFeeCreate {
+	_c.mutation.SetWaivedReason(v)
+	return _c
+}
+
+// SetNillableWaivedReason sets the "waived_reason" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableWaivedReason(v *string) *LateFeeCreate {
+	if v != nil {
+		_c.SetWaivedReason(*v)
+	}
+	return _c
+}
+
+// SetWaivedBy sets the "waived_by" field.
+func (_c *LateFeeCreate) SetWaivedBy(v string) *LateFeeCreate {
+	_c.mutation.SetWaivedBy(v)
+	return _c
+}
+
+// SetNillableWaivedBy sets the "waived_by" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableWaivedBy(v *string) *LateFeeCreate {
+	if v != nil {
+		_c.SetWaivedBy(*v)
+	}
+	return _c
+}
+
+// SetWaivedAt sets the "waived_at" field.
+func (_c *LateFeeCreate) SetWaivedAt(v time.Time) *LateFeeCreate {
+	_c.mutation.SetWaivedAt(v)
+	return _c
+}
+
+// SetNillableWaivedAt sets the "waived_at" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableWaivedAt(v *time.Time) *LateFeeCreate {
+	if v != nil {
+		_c.SetWaivedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LateFeeCreate) SetCreatedAt(v time.Time) *LateFeeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LateFeeCreate) SetNillableCreatedAt(v *time.Time) *LateFeeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *LateFeeCreate) SetStudent(v *Student) *LateFeeCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *LateFeeCreate) SetInvoice(v *Invoice) *LateFeeCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LateFeeMutation object of the builder.
+func (_c *LateFeeCreate) Mutation() *LateFeeMutation {
+	return _c.mutation
+}
+
+// Save creates the LateFee in the database.
+func (_c *LateFeeCreate) Save(ctx context.Context) (*LateFee, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LateFeeCreate) SaveX(ctx context.Context) *LateFee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LateFeeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LateFeeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LateFeeCreate) defaults() {
+	if _, ok := _c.mutation.DaysLate(); !ok {
+		v := latefee.DefaultDaysLate
+		_c.mutation.SetDaysLate(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := latefee.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.WaivedReason(); !ok {
+		v := latefee.DefaultWaivedReason
+		_c.mutation.SetWaivedReason(v)
+	}
+	if _, ok := _c.mutation.WaivedBy(); !ok {
+		v := latefee.DefaultWaivedBy
+		_c.mutation.SetWaivedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := latefee.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LateFeeCreate) check() error {
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "LateFee.student_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "LateFee.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.PeriodYear(); !ok {
+		return &ValidationError{Name: "period_year", err: errors.New(`ent: missing required field "LateFee.period_year"`)}
+	}
+	if _, ok := _c.mutation.PeriodMonth(); !ok {
+		return &ValidationError{Name: "period_month", err: errors.New(`ent: missing required field "LateFee.period_month"`)}
+	}
+	if _, ok := _c.mutation.AmountCents(); !ok {
+		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "LateFee.amount_cents"`)}
+	}
+	if _, ok := _c.mutation.DaysLate(); !ok {
+		return &ValidationError{Name: "days_late", err: errors.New(`ent: missing required field "LateFee.days_late"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LateFee.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := latefee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LateFee.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WaivedReason(); !ok {
+		return &ValidationError{Name: "waived_reason", err: errors.New(`ent: missing required field "LateFee.waived_reason"`)}
+	}
+	if _, ok := _c.mutation.WaivedBy(); !ok {
+		return &ValidationError{Name: "waived_by", err: errors.New(`ent: missing required field "LateFee.waived_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LateFee.created_at"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "LateFee.student"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "LateFee.invoice"`)}
+	}
+	return nil
+}
+
+func (_c *LateFeeCreate) sqlSave(ctx context.Context) (*LateFee, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LateFeeCreate) createSpec() (*LateFee, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LateFee{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(latefee.Table, sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PeriodYear(); ok {
+		_spec.SetField(latefee.FieldPeriodYear, field.TypeInt, value)
+		_node.PeriodYear = value
+	}
+	if value, ok := _c.mutation.PeriodMonth(); ok {
+		_spec.SetField(latefee.FieldPeriodMonth, field.TypeInt, value)
+		_node.PeriodMonth = value
+	}
+	if value, ok := _c.mutation.AmountCents(); ok {
+		_spec.SetField(latefee.FieldAmountCents, field.TypeInt64, value)
+		_node.AmountCents = value
+	}
+	if value, ok := _c.mutation.DaysLate(); ok {
+		_spec.SetField(latefee.FieldDaysLate, field.TypeInt, value)
+		_node.DaysLate = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(latefee.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.WaivedReason(); ok {
+		_spec.SetField(latefee.FieldWaivedReason, field.TypeString, value)
+		_node.WaivedReason = value
+	}
+	if value, ok := _c.mutation.WaivedBy(); ok {
+		_spec.SetField(latefee.FieldWaivedBy, field.TypeString, value)
+		_node.WaivedBy = value
+	}
+	if value, ok := _c.mutation.WaivedAt(); ok {
+		_spec.SetField(latefee.FieldWaivedAt, field.TypeTime, value)
+		_node.WaivedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(latefee.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.StudentTable,
+			Columns: []string{latefee.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.InvoiceTable,
+			Columns: []string{latefee.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LateFeeCreateBulk is the builder for creating many LateFee entities in bulk.
+type LateFeeCreateBulk struct {
+	config
+	err      error
+	builders []*LateFeeCreate
+}
+
+// Save creates the LateFee entities in the database.
+func (_c *LateFeeCreateBulk) Save(ctx context.Context) ([]*LateFee, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LateFee, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LateFeeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LateFeeCreateBulk) SaveX(ctx context.Context) []*LateFee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LateFeeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LateFeeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,836 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LateFeeUpdate is the builder for updating LateFee entities.
+type LateFeeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LateFeeMutation
+}
+
+// Where appends a list predicates to the LateFeeUpdate builder.
+func (_u *LateFeeUpdate) Where(ps ...predicate.LateFee) *LateFeeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LateFeeUpdate) SetStudentID(v int) *LateFeeUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableStudentID(v *int) *LateFeeUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *LateFeeUpdate) SetInvoiceID(v int) *LateFeeUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableInvoiceID(v *int) *LateFeeUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetPeriodYear sets the "period_year" field.
+func (_u *LateFeeUpdate) SetPeriodYear(v int) *LateFeeUpdate {
+	_u.mutation.ResetPeriodYear()
+	_u.mutation.SetPeriodYear(v)
+	return _u
+}
+
+// SetNillablePeriodYear sets the "period_year" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillablePeriodYear(v *int) *LateFeeUpdate {
+	if v != nil {
+		_u.SetPeriodYear(*v)
+	}
+	return _u
+}
+
+// AddPeriodYear adds value to the "period_year" field.
+func (_u *LateFeeUpdate) AddPeriodYear(v int) *LateFeeUpdate {
+	_u.mutation.AddPeriodYear(v)
+	return _u
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (_u *LateFeeUpdate) SetPeriodMonth(v int) *LateFeeUpdate {
+	_u.mutation.ResetPeriodMonth()
+	_u.mutation.SetPeriodMonth(v)
+	return _u
+}
+
+// SetNillablePeriodMonth sets the "period_month" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillablePeriodMonth(v *int) *LateFeeUpdate {
+	if v != nil {
+		_u.SetPeriodMonth(*v)
+	}
+	return _u
+}
+
+// AddPeriodMonth adds value to the "period_month" field.
+func (_u *LateFeeUpdate) AddPeriodMonth(v int) *LateFeeUpdate {
+	_u.mutation.AddPeriodMonth(v)
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *LateFeeUpdate) SetAmountCents(v int64) *LateFeeUpdate {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableAmountCents(v *int64) *LateFeeUpdate {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *LateFeeUpdate) AddAmountCents(v int64) *LateFeeUpdate {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetDaysLate sets the "days_late" field.
+func (_u *LateFeeUpdate) SetDaysLate(v int) *LateFeeUpdate {
+	_u.mutation.ResetDaysLate()
+	_u.mutation.SetDaysLate(v)
+	return _u
+}
+
+// SetNillableDaysLate sets the "days_late" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableDaysLate(v *int) *LateFeeUpdate {
+	if v != nil {
+		_u.SetDaysLate(*v)
+	}
+	return _u
+}
+
+// AddDaysLate adds value to the "days_late" field.
+func (_u *LateFeeUpdate) AddDaysLate(v int) *LateFeeUpdate {
+	_u.mutation.AddDaysLate(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LateFeeUpdate) SetStatus(v latefee.Status) *LateFeeUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableStatus(v *latefee.Status) *LateFeeUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetWaivedReason sets the "waived_reason" field.
+func (_u *LateFeeUpdate) SetWaivedReason(v string) *LateFeeUpdate {
+	_u.mutation.SetWaivedReason(v)
+	return _u
+}
+
+// SetNillableWaivedReason sets the "waived_reason" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableWaivedReason(v *string) *LateFeeUpdate {
+	if v != nil {
+		_u.SetWaivedReason(*v)
+	}
+	return _u
+}
+
+// SetWaivedBy sets the "waived_by" field.
+func (_u *LateFeeUpdate) SetWaivedBy(v string) *LateFeeUpdate {
+	_u.mutation.SetWaivedBy(v)
+	return _u
+}
+
+// SetNillableWaivedBy sets the "waived_by" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableWaivedBy(v *string) *LateFeeUpdate {
+	if v != nil {
+		_u.SetWaivedBy(*v)
+	}
+	return _u
+}
+
+// SetWaivedAt sets the "waived_at" field.
+func (_u *LateFeeUpdate) SetWaivedAt(v time.Time) *LateFeeUpdate {
+	_u.mutation.SetWaivedAt(v)
+	return _u
+}
+
+// SetNillableWaivedAt sets the "waived_at" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableWaivedAt(v *time.Time) *LateFeeUpdate {
+	if v != nil {
+		_u.SetWaivedAt(*v)
+	}
+	return _u
+}
+
+// ClearWaivedAt clears the value of the "waived_at" field.
+func (_u *LateFeeUpdate) ClearWaivedAt() *LateFeeUpdate {
+	_u.mutation.ClearWaivedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LateFeeUpdate) SetCreatedAt(v time.Time) *LateFeeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LateFeeUpdate) SetNillableCreatedAt(v *time.Time) *LateFeeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LateFeeUpdate) SetStudent(v *Student) *LateFeeUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *LateFeeUpdate) SetInvoice(v *Invoice) *LateFeeUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LateFeeMutation object of the builder.
+func (_u *LateFeeUpdate) Mutation() *LateFeeMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LateFeeUpdate) ClearStudent() *LateFeeUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *LateFeeUpdate) ClearInvoice() *LateFeeUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LateFeeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LateFeeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LateFeeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LateFeeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LateFeeUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := latefee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LateFee.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LateFee.student"`)
+	}
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LateFee.invoice"`)
+	}
+	return nil
+}
+
+func (_u *LateFeeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(latefee.Table, latefee.Columns, sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodYear(); ok {
+		_spec.SetField(latefee.FieldPeriodYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodYear(); ok {
+		_spec.AddField(latefee.FieldPeriodYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodMonth(); ok {
+		_spec.SetField(latefee.FieldPeriodMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodMonth(); ok {
+		_spec.AddField(latefee.FieldPeriodMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(latefee.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(latefee.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DaysLate(); ok {
+		_spec.SetField(latefee.FieldDaysLate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDaysLate(); ok {
+		_spec.AddField(latefee.FieldDaysLate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(latefee.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WaivedReason(); ok {
+		_spec.SetField(latefee.FieldWaivedReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WaivedBy(); ok {
+		_spec.SetField(latefee.FieldWaivedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WaivedAt(); ok {
+		_spec.SetField(latefee.FieldWaivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WaivedAtCleared() {
+		_spec.ClearField(latefee.FieldWaivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(latefee.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.StudentTable,
+			Columns: []string{latefee.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.StudentTable,
+			Columns: []string{latefee.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.InvoiceTable,
+			Columns: []string{latefee.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.InvoiceTable,
+			Columns: []string{latefee.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{latefee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LateFeeUpdateOne is the builder for updating a single LateFee entity.
+type LateFeeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LateFeeMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LateFeeUpdateOne) SetStudentID(v int) *LateFeeUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableStudentID(v *int) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *LateFeeUpdateOne) SetInvoiceID(v int) *LateFeeUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableInvoiceID(v *int) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetPeriodYear sets the "period_year" field.
+func (_u *LateFeeUpdateOne) SetPeriodYear(v int) *LateFeeUpdateOne {
+	_u.mutation.ResetPeriodYear()
+	_u.mutation.SetPeriodYear(v)
+	return _u
+}
+
+// SetNillablePeriodYear sets the "period_year" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillablePeriodYear(v *int) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetPeriodYear(*v)
+	}
+	return _u
+}
+
+// AddPeriodYear adds value to the "period_year" field.
+func (_u *LateFeeUpdateOne) AddPeriodYear(v int) *LateFeeUpdateOne {
+	_u.mutation.AddPeriodYear(v)
+	return _u
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (_u *LateFeeUpdateOne) SetPeriodMonth(v int) *LateFeeUpdateOne {
+	_u.mutation.ResetPeriodMonth()
+	_u.mutation.SetPeriodMonth(v)
+	return _u
+}
+
+// SetNillablePeriodMonth sets the "period_month" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillablePeriodMonth(v *int) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetPeriodMonth(*v)
+	}
+	return _u
+}
+
+// AddPeriodMonth adds value to the "period_month" field.
+func (_u *LateFeeUpdateOne) AddPeriodMonth(v int) *LateFeeUpdateOne {
+	_u.mutation.AddPeriodMonth(v)
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *LateFeeUpdateOne) SetAmountCents(v int64) *LateFeeUpdateOne {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableAmountCents(v *int64) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *LateFeeUpdateOne) AddAmountCents(v int64) *LateFeeUpdateOne {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetDaysLate sets the "days_late" field.
+func (_u *LateFeeUpdateOne) SetDaysLate(v int) *LateFeeUpdateOne {
+	_u.mutation.ResetDaysLate()
+	_u.mutation.SetDaysLate(v)
+	return _u
+}
+
+// SetNillableDaysLate sets the "days_late" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableDaysLate(v *int) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetDaysLate(*v)
+	}
+	return _u
+}
+
+// AddDaysLate adds value to the "days_late" field.
+func (_u *LateFeeUpdateOne) AddDaysLate(v int) *LateFeeUpdateOne {
+	_u.mutation.AddDaysLate(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LateFeeUpdateOne) SetStatus(v latefee.Status) *LateFeeUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableStatus(v *latefee.Status) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetWaivedReason sets the "waived_reason" field.
+func (_u *LateFeeUpdateOne) SetWaivedReason(v string) *LateFeeUpdateOne {
+	_u.mutation.SetWaivedReason(v)
+	return _u
+}
+
+// SetNillableWaivedReason sets the "waived_reason" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableWaivedReason(v *string) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetWaivedReason(*v)
+	}
+	return _u
+}
+
+// SetWaivedBy sets the "waived_by" field.
+func (_u *LateFeeUpdateOne) SetWaivedBy(v string) *LateFeeUpdateOne {
+	_u.mutation.SetWaivedBy(v)
+	return _u
+}
+
+// SetNillableWaivedBy sets the "waived_by" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableWaivedBy(v *string) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetWaivedBy(*v)
+	}
+	return _u
+}
+
+// SetWaivedAt sets the "waived_at" field.
+func (_u *LateFeeUpdateOne) SetWaivedAt(v time.Time) *LateFeeUpdateOne {
+	_u.mutation.SetWaivedAt(v)
+	return _u
+}
+
+// SetNillableWaivedAt sets the "waived_at" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableWaivedAt(v *time.Time) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetWaivedAt(*v)
+	}
+	return _u
+}
+
+// ClearWaivedAt clears the value of the "waived_at" field.
+func (_u *LateFeeUpdateOne) ClearWaivedAt() *LateFeeUpdateOne {
+	_u.mutation.ClearWaivedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LateFeeUpdateOne) SetCreatedAt(v time.Time) *LateFeeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LateFeeUpdateOne) SetNillableCreatedAt(v *time.Time) *LateFeeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LateFeeUpdateOne) SetStudent(v *Student) *LateFeeUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *LateFeeUpdateOne) SetInvoice(v *Invoice) *LateFeeUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LateFeeMutation object of the builder.
+func (_u *LateFeeUpdateOne) Mutation() *LateFeeMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LateFeeUpdateOne) ClearStudent() *LateFeeUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *LateFeeUpdateOne) ClearInvoice() *LateFeeUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Where appends a list predicates to the LateFeeUpdate builder.
+func (_u *LateFeeUpdateOne) Where(ps ...predicate.LateFee) *LateFeeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LateFeeUpdateOne) Select(field string, fields ...string) *LateFeeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LateFee entity.
+func (_u *LateFeeUpdateOne) Save(ctx context.Context) (*LateFee, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LateFeeUpdateOne) SaveX(ctx context.Context) *LateFee {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LateFeeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LateFeeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LateFeeUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := latefee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LateFee.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LateFee.student"`)
+	}
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LateFee.invoice"`)
+	}
+	return nil
+}
+
+func (_u *LateFeeUpdateOne) sqlSave(ctx context.Context) (_node *LateFee, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(latefee.Table, latefee.Columns, sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LateFee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, latefee.FieldID)
+		for _, f := range fields {
+			if !latefee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != latefee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodYear(); ok {
+		_spec.SetField(latefee.FieldPeriodYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodYear(); ok {
+		_spec.AddField(latefee.FieldPeriodYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodMonth(); ok {
+		_spec.SetField(latefee.FieldPeriodMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeriodMonth(); ok {
+		_spec.AddField(latefee.FieldPeriodMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(latefee.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(latefee.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DaysLate(); ok {
+		_spec.SetField(latefee.FieldDaysLate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDaysLate(); ok {
+		_spec.AddField(latefee.FieldDaysLate, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(latefee.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WaivedReason(); ok {
+		_spec.SetField(latefee.FieldWaivedReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WaivedBy(); ok {
+		_spec.SetField(latefee.FieldWaivedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WaivedAt(); ok {
+		_spec.SetField(latefee.FieldWaivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WaivedAtCleared() {
+		_spec.ClearField(latefee.FieldWaivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(latefee.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.StudentTable,
+			Columns: []string{latefee.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.StudentTable,
+			Columns: []string{latefee.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.InvoiceTable,
+			Columns: []string{latefee.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   latefee.InvoiceTable,
+			Columns: []string{latefee.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LateFee{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{latefee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
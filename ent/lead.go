@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/lead"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lead is the model entity for the Lead schema.
+type Lead struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// FullName holds the value of the "full_name" field.
+	FullName string `json:"full_name,omitempty"`
+	// PersonalCode holds the value of the "personal_code" field.
+	PersonalCode string `json:"personal_code,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// IsMinor holds the value of the "is_minor" field.
+	IsMinor bool `json:"is_minor,omitempty"`
+	// PayerName holds the value of the "payer_name" field.
+	PayerName string `json:"payer_name,omitempty"`
+	// PayerRole holds the value of the "payer_role" field.
+	PayerRole string `json:"payer_role,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Status holds the value of the "status" field.
+	Status lead.Status `json:"status,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID *int `json:"course_id,omitempty"`
+	// TrialAt holds the value of the "trial_at" field.
+	TrialAt *time.Time `json:"trial_at,omitempty"`
+	// TrialPriceCents holds the value of the "trial_price_cents" field.
+	TrialPriceCents int64 `json:"trial_price_cents,omitempty"`
+	// TrialOutcome holds the value of the "trial_outcome" field.
+	TrialOutcome *lead.TrialOutcome `json:"trial_outcome,omitempty"`
+	// OutcomeNote holds the value of the "outcome_note" field.
+	OutcomeNote string `json:"outcome_note,omitempty"`
+	// LostReason holds the value of the "lost_reason" field.
+	LostReason string `json:"lost_reason,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID *int `json:"student_id,omitempty"`
+	// ConvertedAt holds the value of the "converted_at" field.
+	ConvertedAt *time.Time `json:"converted_at,omitempty"`
+	// TrialChargeID holds the value of the "trial_charge_id" field.
+	TrialChargeID *int `json:"trial_charge_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeadQuery when eager-loading is set.
+	Edges        LeadEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeadEdges holds the relations/edges for other nodes in the graph.
+type LeadEdges struct {
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lead) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lead.FieldIsMinor:
+			values[i] = new(sql.NullBool)
+		case lead.FieldID, lead.FieldVersion, lead.FieldCourseID, lead.FieldTrialPriceCents, lead.FieldStudentID, lead.FieldTrialChargeID:
+			values[i] = new(sql.NullInt64)
+		case lead.FieldFullName, lead.FieldPersonalCode, lead.FieldPhone, lead.FieldEmail, lead.FieldPayerName, lead.FieldPayerRole, lead.FieldSource, lead.FieldNote, lead.FieldStatus, lead.FieldTrialOutcome, lead.FieldOutcomeNote, lead.FieldLostReason, lead.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case lead.FieldTrialAt, lead.FieldConvertedAt, lead.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lead fields.
+func (_m *Lead) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lead.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case lead.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case lead.FieldFullName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field full_name", values[i])
+			} else if value.Valid {
+				_m.FullName = value.String
+			}
+		case lead.FieldPersonalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field personal_code", values[i])
+			} else if value.Valid {
+				_m.PersonalCode = value.String
+			}
+		case lead.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case lead.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case lead.FieldIsMinor:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_minor", values[i])
+			} else if value.Valid {
+				_m.IsMinor = value.Bool
+			}
+		case lead.FieldPayerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_name", values[i])
+			} else if value.Valid {
+				_m.PayerName = value.String
+			}
+		case lead.FieldPayerRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_role", values[i])
+			} else if value.Valid {
+				_m.PayerRole = value.String
+			}
+		case lead.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case lead.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case lead.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = lead.Status(value.String)
+			}
+		case lead.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = new(int)
+				*_m.CourseID = int(value.Int64)
+			}
+		case lead.FieldTrialAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_at", values[i])
+			} else if value.Valid {
+				_m.TrialAt = new(time.Time)
+				*_m.TrialAt = value.Time
+			}
+		case lead.FieldTrialPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_price_cents", values[i])
+			} else if value.Valid {
+				_m.TrialPriceCents = value.Int64
+			}
+		case lead.FieldTrialOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_outcome", values[i])
+			} else if value.Valid {
+				_m.TrialOutcome = new(lead.TrialOutcome)
+				*_m.TrialOutcome = lead.TrialOutcome(value.String)
+			}
+		case lead.FieldOutcomeNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome_note", values[i])
+			} else if value.Valid {
+				_m.OutcomeNote = value.String
+			}
+		case lead.FieldLostReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lost_reason", values[i])
+			} else if value.Valid {
+				_m.LostReason = value.String
+			}
+		case lead.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = new(int)
+				*_m.StudentID = int(value.Int64)
+			}
+		case lead.FieldConvertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field converted_at", values[i])
+			} else if value.Valid {
+				_m.ConvertedAt = new(time.Time)
+				*_m.ConvertedAt = value.Time
+			}
+		case lead.FieldTrialChargeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_charge_id", values[i])
+			} else if value.Valid {
+				_m.TrialChargeID = new(int)
+				*_m.TrialChargeID = int(value.Int64)
+			}
+		case lead.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case lead.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Lead.
+// This includes values selected through modifiers, order, etc.
+func (_m *Lead) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCourse queries the "course" edge of the Lead entity.
+func (_m *Lead) QueryCourse() *CourseQuery {
+	return NewLeadClient(_m.config).QueryCourse(_m)
+}
+
+// QueryStudent queries the "student" edge of the Lead entity.
+func (_m *Lead) QueryStudent() *StudentQuery {
+	return NewLeadClient(_m.config).QueryStudent(_m)
+}
+
+// Update returns a builder for updating this Lead.
+// Note that you need to call Lead.Unwrap() before calling this method if this Lead
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Lead) Update() *LeadUpdateOne {
+	return NewLeadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Lead entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Lead) Unwrap() *Lead {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lead is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Lead) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lead(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("full_name=")
+	builder.WriteString(_m.FullName)
+	builder.WriteString(", ")
+	builder.WriteString("personal_code=")
+	builder.WriteString(_m.PersonalCode)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("is_minor=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsMinor))
+	builder.WriteString(", ")
+	builder.WriteString("payer_name=")
+	builder.WriteString(_m.PayerName)
+	builder.WriteString(", ")
+	builder.WriteString("payer_role=")
+	builder.WriteString(_m.PayerRole)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CourseID; v != nil {
+		builder.WriteString("course_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrialAt; v != nil {
+		builder.WriteString("trial_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trial_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrialPriceCents))
+	builder.WriteString(", ")
+	if v := _m.TrialOutcome; v != nil {
+		builder.WriteString("trial_outcome=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("outcome_note=")
+	builder.WriteString(_m.OutcomeNote)
+	builder.WriteString(", ")
+	builder.WriteString("lost_reason=")
+	builder.WriteString(_m.LostReason)
+	builder.WriteString(", ")
+	if v := _m.StudentID; v != nil {
+		builder.WriteString("student_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConvertedAt; v != nil {
+		builder.WriteString("converted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrialChargeID; v != nil {
+		builder.WriteString("trial_charge_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leads is a parsable slice of Lead.
+type Leads []*Lead
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lead
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the lead type in the database.
+	Label = "lead"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldFullName holds the string denoting the full_name field in the database.
+	FieldFullName = "full_name"
+	// FieldPersonalCode holds the string denoting the personal_code field in the database.
+	FieldPersonalCode = "personal_code"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldIsMinor holds the string denoting the is_minor field in the database.
+	FieldIsMinor = "is_minor"
+	// FieldPayerName holds the string denoting the payer_name field in the database.
+	FieldPayerName = "payer_name"
+	// FieldPayerRole holds the string denoting the payer_role field in the database.
+	FieldPayerRole = "payer_role"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCourseID holds the string denoting the course_id field in the database.
+	FieldCourseID = "course_id"
+	// FieldTrialAt holds the string denoting the trial_at field in the database.
+	FieldTrialAt = "trial_at"
+	// FieldTrialPriceCents holds the string denoting the trial_price_cents field in the database.
+	FieldTrialPriceCents = "trial_price_cents"
+	// FieldTrialOutcome holds the string denoting the trial_outcome field in the database.
+	FieldTrialOutcome = "trial_outcome"
+	// FieldOutcomeNote holds the string denoting the outcome_note field in the database.
+	FieldOutcomeNote = "outcome_note"
+	// FieldLostReason holds the string denoting the lost_reason field in the database.
+	FieldLostReason = "lost_reason"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldConvertedAt holds the string denoting the converted_at field in the database.
+	FieldConvertedAt = "converted_at"
+	// FieldTrialChargeID holds the string denoting the trial_charge_id field in the database.
+	FieldTrialChargeID = "trial_charge_id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// Table holds the table name of the lead in the database.
+	Table = "leads"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "leads"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "course_id"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "leads"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+)
+
+// Columns holds all SQL columns for lead fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldFullName,
+	FieldPersonalCode,
+	FieldPhone,
+	FieldEmail,
+	FieldIsMinor,
+	FieldPayerName,
+	FieldPayerRole,
+	FieldSource,
+	FieldNote,
+	FieldStatus,
+	FieldCourseID,
+	FieldTrialAt,
+	FieldTrialPriceCents,
+	FieldTrialOutcome,
+	FieldOutcomeNote,
+	FieldLostReason,
+	FieldStudentID,
+	FieldConvertedAt,
+	FieldTrialChargeID,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultPersonalCode holds the default value on creation for the "personal_code" field.
+	DefaultPersonalCode string
+	// DefaultPhone holds the default value on creation for the "phone" field.
+	DefaultPhone string
+	// DefaultEmail holds the default value on creation for the "email" field.
+	DefaultEmail string
+	// DefaultIsMinor holds the default value on creation for the "is_minor" field.
+	DefaultIsMinor bool
+	// DefaultPayerName holds the default value on creation for the "payer_name" field.
+	DefaultPayerName string
+	// DefaultPayerRole holds the default value on creation for the "payer_role" field.
+	DefaultPayerRole string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultTrialPriceCents holds the default value on creation for the "trial_price_cents" field.
+	DefaultTrialPriceCents int64
+	// DefaultOutcomeNote holds the default value on creation for the "outcome_note" field.
+	DefaultOutcomeNote string
+	// DefaultLostReason holds the default value on creation for the "lost_reason" field.
+	DefaultLostReason string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusNew is the default value of the Status enum.
+const DefaultStatus = StatusNew
+
+// Status values.
+const (
+	StatusNew            Status = "new"
+	StatusTrialScheduled Status = "trial_scheduled"
+	StatusTrialDone      Status = "trial_done"
+	StatusConverted      Status = "converted"
+	StatusLost           Status = "lost"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusNew, StatusTrialScheduled, StatusTrialDone, StatusConverted, StatusLost:
+		return nil
+	default:
+		return fmt.Errorf("lead: invalid enum value for status field: %q", s)
+	}
+}
+
+// TrialOutcome defines the type for the "trial_outcome" enum field.
+type TrialOutcome string
+
+// TrialOutcome values.
+const (
+	TrialOutcomeAttended TrialOutcome = "attended"
+	TrialOutcomeNoShow   TrialOutcome = "no_show"
+)
+
+func (to TrialOutcome) String() string {
+	return string(to)
+}
+
+// TrialOutcomeValidator is a validator for the "trial_outcome" field enum values. It is called by the builders before save.
+func TrialOutcomeValidator(to TrialOutcome) error {
+	switch to {
+	case TrialOutcomeAttended, TrialOutcomeNoShow:
+		return nil
+	default:
+		return fmt.Errorf("lead: invalid enum value for trial_outcome field: %q", to)
+	}
+}
+
+// OrderOption defines the ordering options for the Lead queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByFullName orders the results by the full_name field.
+func ByFullName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFullName, opts...).ToFunc()
+}
+
+// ByPersonalCode orders the results by the personal_code field.
+func ByPersonalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonalCode, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByIsMinor orders the results by the is_minor field.
+func ByIsMinor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsMinor, opts...).ToFunc()
+}
+
+// ByPayerName orders the results by the payer_name field.
+func ByPayerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerName, opts...).ToFunc()
+}
+
+// ByPayerRole orders the results by the payer_role field.
+func ByPayerRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerRole, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCourseID orders the results by the course_id field.
+func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
+}
+
+// ByTrialAt orders the results by the trial_at field.
+func ByTrialAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialAt, opts...).ToFunc()
+}
+
+// ByTrialPriceCents orders the results by the trial_price_cents field.
+func ByTrialPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialPriceCents, opts...).ToFunc()
+}
+
+// ByTrialOutcome orders the results by the trial_outcome field.
+func ByTrialOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialOutcome, opts...).ToFunc()
+}
+
+// ByOutcomeNote orders the results by the outcome_note field.
+func ByOutcomeNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcomeNote, opts...).ToFunc()
+}
+
+// ByLostReason orders the results by the lost_reason field.
+func ByLostReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLostReason, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByConvertedAt orders the results by the converted_at field.
+func ByConvertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConvertedAt, opts...).ToFunc()
+}
+
+// ByTrialChargeID orders the results by the trial_charge_id field.
+func ByTrialChargeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialChargeID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+	)
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
@@ -0,0 +1,1322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lead
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVersion, v))
+}
+
+// FullName applies equality check predicate on the "full_name" field. It's identical to FullNameEQ.
+func FullName(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldFullName, v))
+}
+
+// PersonalCode applies equality check predicate on the "personal_code" field. It's identical to PersonalCodeEQ.
+func PersonalCode(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPersonalCode, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPhone, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldEmail, v))
+}
+
+// IsMinor applies equality check predicate on the "is_minor" field. It's identical to IsMinorEQ.
+func IsMinor(v bool) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldIsMinor, v))
+}
+
+// PayerName applies equality check predicate on the "payer_name" field. It's identical to PayerNameEQ.
+func PayerName(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPayerName, v))
+}
+
+// PayerRole applies equality check predicate on the "payer_role" field. It's identical to PayerRoleEQ.
+func PayerRole(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPayerRole, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSource, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldNote, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCourseID, v))
+}
+
+// TrialAt applies equality check predicate on the "trial_at" field. It's identical to TrialAtEQ.
+func TrialAt(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialAt, v))
+}
+
+// TrialPriceCents applies equality check predicate on the "trial_price_cents" field. It's identical to TrialPriceCentsEQ.
+func TrialPriceCents(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialPriceCents, v))
+}
+
+// OutcomeNote applies equality check predicate on the "outcome_note" field. It's identical to OutcomeNoteEQ.
+func OutcomeNote(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldOutcomeNote, v))
+}
+
+// LostReason applies equality check predicate on the "lost_reason" field. It's identical to LostReasonEQ.
+func LostReason(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldLostReason, v))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldStudentID, v))
+}
+
+// ConvertedAt applies equality check predicate on the "converted_at" field. It's identical to ConvertedAtEQ.
+func ConvertedAt(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldConvertedAt, v))
+}
+
+// TrialChargeID applies equality check predicate on the "trial_charge_id" field. It's identical to TrialChargeIDEQ.
+func TrialChargeID(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialChargeID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldVersion, v))
+}
+
+// FullNameEQ applies the EQ predicate on the "full_name" field.
+func FullNameEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldFullName, v))
+}
+
+// FullNameNEQ applies the NEQ predicate on the "full_name" field.
+func FullNameNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldFullName, v))
+}
+
+// FullNameIn applies the In predicate on the "full_name" field.
+func FullNameIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldFullName, vs...))
+}
+
+// FullNameNotIn applies the NotIn predicate on the "full_name" field.
+func FullNameNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldFullName, vs...))
+}
+
+// FullNameGT applies the GT predicate on the "full_name" field.
+func FullNameGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldFullName, v))
+}
+
+// FullNameGTE applies the GTE predicate on the "full_name" field.
+func FullNameGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldFullName, v))
+}
+
+// FullNameLT applies the LT predicate on the "full_name" field.
+func FullNameLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldFullName, v))
+}
+
+// FullNameLTE applies the LTE predicate on the "full_name" field.
+func FullNameLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldFullName, v))
+}
+
+// FullNameContains applies the Contains predicate on the "full_name" field.
+func FullNameContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldFullName, v))
+}
+
+// FullNameHasPrefix applies the HasPrefix predicate on the "full_name" field.
+func FullNameHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldFullName, v))
+}
+
+// FullNameHasSuffix applies the HasSuffix predicate on the "full_name" field.
+func FullNameHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldFullName, v))
+}
+
+// FullNameEqualFold applies the EqualFold predicate on the "full_name" field.
+func FullNameEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldFullName, v))
+}
+
+// FullNameContainsFold applies the ContainsFold predicate on the "full_name" field.
+func FullNameContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldFullName, v))
+}
+
+// PersonalCodeEQ applies the EQ predicate on the "personal_code" field.
+func PersonalCodeEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPersonalCode, v))
+}
+
+// PersonalCodeNEQ applies the NEQ predicate on the "personal_code" field.
+func PersonalCodeNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldPersonalCode, v))
+}
+
+// PersonalCodeIn applies the In predicate on the "personal_code" field.
+func PersonalCodeIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldPersonalCode, vs...))
+}
+
+// PersonalCodeNotIn applies the NotIn predicate on the "personal_code" field.
+func PersonalCodeNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldPersonalCode, vs...))
+}
+
+// PersonalCodeGT applies the GT predicate on the "personal_code" field.
+func PersonalCodeGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldPersonalCode, v))
+}
+
+// PersonalCodeGTE applies the GTE predicate on the "personal_code" field.
+func PersonalCodeGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldPersonalCode, v))
+}
+
+// PersonalCodeLT applies the LT predicate on the "personal_code" field.
+func PersonalCodeLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldPersonalCode, v))
+}
+
+// PersonalCodeLTE applies the LTE predicate on the "personal_code" field.
+func PersonalCodeLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldPersonalCode, v))
+}
+
+// PersonalCodeContains applies the Contains predicate on the "personal_code" field.
+func PersonalCodeContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldPersonalCode, v))
+}
+
+// PersonalCodeHasPrefix applies the HasPrefix predicate on the "personal_code" field.
+func PersonalCodeHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldPersonalCode, v))
+}
+
+// PersonalCodeHasSuffix applies the HasSuffix predicate on the "personal_code" field.
+func PersonalCodeHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldPersonalCode, v))
+}
+
+// PersonalCodeEqualFold applies the EqualFold predicate on the "personal_code" field.
+func PersonalCodeEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldPersonalCode, v))
+}
+
+// PersonalCodeContainsFold applies the ContainsFold predicate on the "personal_code" field.
+func PersonalCodeContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldPersonalCode, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// IsMinorEQ applies the EQ predicate on the "is_minor" field.
+func IsMinorEQ(v bool) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldIsMinor, v))
+}
+
+// IsMinorNEQ applies the NEQ predicate on the "is_minor" field.
+func IsMinorNEQ(v bool) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldIsMinor, v))
+}
+
+// PayerNameEQ applies the EQ predicate on the "payer_name" field.
+func PayerNameEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPayerName, v))
+}
+
+// PayerNameNEQ applies the NEQ predicate on the "payer_name" field.
+func PayerNameNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldPayerName, v))
+}
+
+// PayerNameIn applies the In predicate on the "payer_name" field.
+func PayerNameIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldPayerName, vs...))
+}
+
+// PayerNameNotIn applies the NotIn predicate on the "payer_name" field.
+func PayerNameNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldPayerName, vs...))
+}
+
+// PayerNameGT applies the GT predicate on the "payer_name" field.
+func PayerNameGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldPayerName, v))
+}
+
+// PayerNameGTE applies the GTE predicate on the "payer_name" field.
+func PayerNameGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldPayerName, v))
+}
+
+// PayerNameLT applies the LT predicate on the "payer_name" field.
+func PayerNameLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldPayerName, v))
+}
+
+// PayerNameLTE applies the LTE predicate on the "payer_name" field.
+func PayerNameLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldPayerName, v))
+}
+
+// PayerNameContains applies the Contains predicate on the "payer_name" field.
+func PayerNameContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldPayerName, v))
+}
+
+// PayerNameHasPrefix applies the HasPrefix predicate on the "payer_name" field.
+func PayerNameHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldPayerName, v))
+}
+
+// PayerNameHasSuffix applies the HasSuffix predicate on the "payer_name" field.
+func PayerNameHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldPayerName, v))
+}
+
+// PayerNameEqualFold applies the EqualFold predicate on the "payer_name" field.
+func PayerNameEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldPayerName, v))
+}
+
+// PayerNameContainsFold applies the ContainsFold predicate on the "payer_name" field.
+func PayerNameContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldPayerName, v))
+}
+
+// PayerRoleEQ applies the EQ predicate on the "payer_role" field.
+func PayerRoleEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldPayerRole, v))
+}
+
+// PayerRoleNEQ applies the NEQ predicate on the "payer_role" field.
+func PayerRoleNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldPayerRole, v))
+}
+
+// PayerRoleIn applies the In predicate on the "payer_role" field.
+func PayerRoleIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldPayerRole, vs...))
+}
+
+// PayerRoleNotIn applies the NotIn predicate on the "payer_role" field.
+func PayerRoleNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldPayerRole, vs...))
+}
+
+// PayerRoleGT applies the GT predicate on the "payer_role" field.
+func PayerRoleGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldPayerRole, v))
+}
+
+// PayerRoleGTE applies the GTE predicate on the "payer_role" field.
+func PayerRoleGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldPayerRole, v))
+}
+
+// PayerRoleLT applies the LT predicate on the "payer_role" field.
+func PayerRoleLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldPayerRole, v))
+}
+
+// PayerRoleLTE applies the LTE predicate on the "payer_role" field.
+func PayerRoleLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldPayerRole, v))
+}
+
+// PayerRoleContains applies the Contains predicate on the "payer_role" field.
+func PayerRoleContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldPayerRole, v))
+}
+
+// PayerRoleHasPrefix applies the HasPrefix predicate on the "payer_role" field.
+func PayerRoleHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldPayerRole, v))
+}
+
+// PayerRoleHasSuffix applies the HasSuffix predicate on the "payer_role" field.
+func PayerRoleHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldPayerRole, v))
+}
+
+// PayerRoleEqualFold applies the EqualFold predicate on the "payer_role" field.
+func PayerRoleEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldPayerRole, v))
+}
+
+// PayerRoleContainsFold applies the ContainsFold predicate on the "payer_role" field.
+func PayerRoleContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldPayerRole, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldSource, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldNote, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// CourseIDIsNil applies the IsNil predicate on the "course_id" field.
+func CourseIDIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldCourseID))
+}
+
+// CourseIDNotNil applies the NotNil predicate on the "course_id" field.
+func CourseIDNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldCourseID))
+}
+
+// TrialAtEQ applies the EQ predicate on the "trial_at" field.
+func TrialAtEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialAt, v))
+}
+
+// TrialAtNEQ applies the NEQ predicate on the "trial_at" field.
+func TrialAtNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldTrialAt, v))
+}
+
+// TrialAtIn applies the In predicate on the "trial_at" field.
+func TrialAtIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldTrialAt, vs...))
+}
+
+// TrialAtNotIn applies the NotIn predicate on the "trial_at" field.
+func TrialAtNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldTrialAt, vs...))
+}
+
+// TrialAtGT applies the GT predicate on the "trial_at" field.
+func TrialAtGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldTrialAt, v))
+}
+
+// TrialAtGTE applies the GTE predicate on the "trial_at" field.
+func TrialAtGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldTrialAt, v))
+}
+
+// TrialAtLT applies the LT predicate on the "trial_at" field.
+func TrialAtLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldTrialAt, v))
+}
+
+// TrialAtLTE applies the LTE predicate on the "trial_at" field.
+func TrialAtLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldTrialAt, v))
+}
+
+// TrialAtIsNil applies the IsNil predicate on the "trial_at" field.
+func TrialAtIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldTrialAt))
+}
+
+// TrialAtNotNil applies the NotNil predicate on the "trial_at" field.
+func TrialAtNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldTrialAt))
+}
+
+// TrialPriceCentsEQ applies the EQ predicate on the "trial_price_cents" field.
+func TrialPriceCentsEQ(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialPriceCents, v))
+}
+
+// TrialPriceCentsNEQ applies the NEQ predicate on the "trial_price_cents" field.
+func TrialPriceCentsNEQ(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldTrialPriceCents, v))
+}
+
+// TrialPriceCentsIn applies the In predicate on the "trial_price_cents" field.
+func TrialPriceCentsIn(vs ...int64) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldTrialPriceCents, vs...))
+}
+
+// TrialPriceCentsNotIn applies the NotIn predicate on the "trial_price_cents" field.
+func TrialPriceCentsNotIn(vs ...int64) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldTrialPriceCents, vs...))
+}
+
+// TrialPriceCentsGT applies the GT predicate on the "trial_price_cents" field.
+func TrialPriceCentsGT(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldTrialPriceCents, v))
+}
+
+// TrialPriceCentsGTE applies the GTE predicate on the "trial_price_cents" field.
+func TrialPriceCentsGTE(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldTrialPriceCents, v))
+}
+
+// TrialPriceCentsLT applies the LT predicate on the "trial_price_cents" field.
+func TrialPriceCentsLT(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldTrialPriceCents, v))
+}
+
+// TrialPriceCentsLTE applies the LTE predicate on the "trial_price_cents" field.
+func TrialPriceCentsLTE(v int64) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldTrialPriceCents, v))
+}
+
+// TrialOutcomeEQ applies the EQ predicate on the "trial_outcome" field.
+func TrialOutcomeEQ(v TrialOutcome) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialOutcome, v))
+}
+
+// TrialOutcomeNEQ applies the NEQ predicate on the "trial_outcome" field.
+func TrialOutcomeNEQ(v TrialOutcome) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldTrialOutcome, v))
+}
+
+// TrialOutcomeIn applies the In predicate on the "trial_outcome" field.
+func TrialOutcomeIn(vs ...TrialOutcome) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldTrialOutcome, vs...))
+}
+
+// TrialOutcomeNotIn applies the NotIn predicate on the "trial_outcome" field.
+func TrialOutcomeNotIn(vs ...TrialOutcome) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldTrialOutcome, vs...))
+}
+
+// TrialOutcomeIsNil applies the IsNil predicate on the "trial_outcome" field.
+func TrialOutcomeIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldTrialOutcome))
+}
+
+// TrialOutcomeNotNil applies the NotNil predicate on the "trial_outcome" field.
+func TrialOutcomeNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldTrialOutcome))
+}
+
+// OutcomeNoteEQ applies the EQ predicate on the "outcome_note" field.
+func OutcomeNoteEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteNEQ applies the NEQ predicate on the "outcome_note" field.
+func OutcomeNoteNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteIn applies the In predicate on the "outcome_note" field.
+func OutcomeNoteIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldOutcomeNote, vs...))
+}
+
+// OutcomeNoteNotIn applies the NotIn predicate on the "outcome_note" field.
+func OutcomeNoteNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldOutcomeNote, vs...))
+}
+
+// OutcomeNoteGT applies the GT predicate on the "outcome_note" field.
+func OutcomeNoteGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteGTE applies the GTE predicate on the "outcome_note" field.
+func OutcomeNoteGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteLT applies the LT predicate on the "outcome_note" field.
+func OutcomeNoteLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteLTE applies the LTE predicate on the "outcome_note" field.
+func OutcomeNoteLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteContains applies the Contains predicate on the "outcome_note" field.
+func OutcomeNoteContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteHasPrefix applies the HasPrefix predicate on the "outcome_note" field.
+func OutcomeNoteHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteHasSuffix applies the HasSuffix predicate on the "outcome_note" field.
+func OutcomeNoteHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteEqualFold applies the EqualFold predicate on the "outcome_note" field.
+func OutcomeNoteEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldOutcomeNote, v))
+}
+
+// OutcomeNoteContainsFold applies the ContainsFold predicate on the "outcome_note" field.
+func OutcomeNoteContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldOutcomeNote, v))
+}
+
+// LostReasonEQ applies the EQ predicate on the "lost_reason" field.
+func LostReasonEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldLostReason, v))
+}
+
+// LostReasonNEQ applies the NEQ predicate on the "lost_reason" field.
+func LostReasonNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldLostReason, v))
+}
+
+// LostReasonIn applies the In predicate on the "lost_reason" field.
+func LostReasonIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldLostReason, vs...))
+}
+
+// LostReasonNotIn applies the NotIn predicate on the "lost_reason" field.
+func LostReasonNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldLostReason, vs...))
+}
+
+// LostReasonGT applies the GT predicate on the "lost_reason" field.
+func LostReasonGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldLostReason, v))
+}
+
+// LostReasonGTE applies the GTE predicate on the "lost_reason" field.
+func LostReasonGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldLostReason, v))
+}
+
+// LostReasonLT applies the LT predicate on the "lost_reason" field.
+func LostReasonLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldLostReason, v))
+}
+
+// LostReasonLTE applies the LTE predicate on the "lost_reason" field.
+func LostReasonLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldLostReason, v))
+}
+
+// LostReasonContains applies the Contains predicate on the "lost_reason" field.
+func LostReasonContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldLostReason, v))
+}
+
+// LostReasonHasPrefix applies the HasPrefix predicate on the "lost_reason" field.
+func LostReasonHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldLostReason, v))
+}
+
+// LostReasonHasSuffix applies the HasSuffix predicate on the "lost_reason" field.
+func LostReasonHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldLostReason, v))
+}
+
+// LostReasonEqualFold applies the EqualFold predicate on the "lost_reason" field.
+func LostReasonEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldLostReason, v))
+}
+
+// LostReasonContainsFold applies the ContainsFold predicate on the "lost_reason" field.
+func LostReasonContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldLostReason, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDIsNil applies the IsNil predicate on the "student_id" field.
+func StudentIDIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldStudentID))
+}
+
+// StudentIDNotNil applies the NotNil predicate on the "student_id" field.
+func StudentIDNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldStudentID))
+}
+
+// ConvertedAtEQ applies the EQ predicate on the "converted_at" field.
+func ConvertedAtEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldConvertedAt, v))
+}
+
+// ConvertedAtNEQ applies the NEQ predicate on the "converted_at" field.
+func ConvertedAtNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldConvertedAt, v))
+}
+
+// ConvertedAtIn applies the In predicate on the "converted_at" field.
+func ConvertedAtIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldConvertedAt, vs...))
+}
+
+// ConvertedAtNotIn applies the NotIn predicate on the "converted_at" field.
+func ConvertedAtNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldConvertedAt, vs...))
+}
+
+// ConvertedAtGT applies the GT predicate on the "converted_at" field.
+func ConvertedAtGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldConvertedAt, v))
+}
+
+// ConvertedAtGTE applies the GTE predicate on the "converted_at" field.
+func ConvertedAtGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldConvertedAt, v))
+}
+
+// ConvertedAtLT applies the LT predicate on the "converted_at" field.
+func ConvertedAtLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldConvertedAt, v))
+}
+
+// ConvertedAtLTE applies the LTE predicate on the "converted_at" field.
+func ConvertedAtLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldConvertedAt, v))
+}
+
+// ConvertedAtIsNil applies the IsNil predicate on the "converted_at" field.
+func ConvertedAtIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldConvertedAt))
+}
+
+// ConvertedAtNotNil applies the NotNil predicate on the "converted_at" field.
+func ConvertedAtNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldConvertedAt))
+}
+
+// TrialChargeIDEQ applies the EQ predicate on the "trial_charge_id" field.
+func TrialChargeIDEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDNEQ applies the NEQ predicate on the "trial_charge_id" field.
+func TrialChargeIDNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDIn applies the In predicate on the "trial_charge_id" field.
+func TrialChargeIDIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldTrialChargeID, vs...))
+}
+
+// TrialChargeIDNotIn applies the NotIn predicate on the "trial_charge_id" field.
+func TrialChargeIDNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldTrialChargeID, vs...))
+}
+
+// TrialChargeIDGT applies the GT predicate on the "trial_charge_id" field.
+func TrialChargeIDGT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDGTE applies the GTE predicate on the "trial_charge_id" field.
+func TrialChargeIDGTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDLT applies the LT predicate on the "trial_charge_id" field.
+func TrialChargeIDLT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDLTE applies the LTE predicate on the "trial_charge_id" field.
+func TrialChargeIDLTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldTrialChargeID, v))
+}
+
+// TrialChargeIDIsNil applies the IsNil predicate on the "trial_charge_id" field.
+func TrialChargeIDIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldTrialChargeID))
+}
+
+// TrialChargeIDNotNil applies the NotNil predicate on the "trial_charge_id" field.
+func TrialChargeIDNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldTrialChargeID))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Lead) predicate.Lead {
+	return predicate.Lead(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Lead) predicate.Lead {
+	return predicate.Lead(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Lead) predicate.Lead {
+	return predicate.Lead(sql.NotPredicates(p))
+}
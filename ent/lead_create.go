@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/lead"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeadCreate is the builder for creating a Lead entity.
+type LeadCreate struct {
+	config
+	mutation *LeadMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *LeadCreate) SetVersion(v int) *LeadCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableVersion(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetFullName sets the "full_name" field.
+func (_c *LeadCreate) SetFullName(v string) *LeadCreate {
+	_c.mutation.SetFullName(v)
+	return _c
+}
+
+// SetPersonalCode sets the "personal_code" field.
+func (_c *LeadCreate) SetPersonalCode(v string) *LeadCreate {
+	_c.mutation.SetPersonalCode(v)
+	return _c
+}
+
+// SetNillablePersonalCode sets the "personal_code" field if the given value is not nil.
+func (_c *LeadCreate) SetNillablePersonalCode(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetPersonalCode(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *LeadCreate) SetPhone(v string) *LeadCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *LeadCreate) SetNillablePhone(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *LeadCreate) SetEmail(v string) *LeadCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableEmail(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetIsMinor sets the "is_minor" field.
+func (_c *LeadCreate) SetIsMinor(v bool) *LeadCreate {
+	_c.mutation.SetIsMinor(v)
+	return _c
+}
+
+// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableIsMinor(v *bool) *LeadCreate {
+	if v != nil {
+		_c.SetIsMinor(*v)
+	}
+	return _c
+}
+
+// SetPayerName sets the "payer_name" field.
+func (_c *LeadCreate) SetPayerName(v string) *LeadCreate {
+	_c.mutation.SetPayerName(v)
+	return _c
+}
+
+// SetNillablePayerName sets the "payer_name" field if the given value is not nil.
+func (_c *LeadCreate) SetNillablePayerName(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetPayerName(*v)
+	}
+	return _c
+}
+
+// SetPayerRole sets the "payer_role" field.
+func (_c *LeadCreate) SetPayerRole(v string) *LeadCreate {
+	_c.mutation.SetPayerRole(v)
+	return _c
+}
+
+// SetNillablePayerRole sets the "payer_role" field if the given value is not nil.
+func (_c *LeadCreate) SetNillablePayerRole(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetPayerRole(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *LeadCreate) SetSource(v string) *LeadCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableSource(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *LeadCreate) SetNote(v string) *LeadCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableNote(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LeadCreate) SetStatus(v lead.Status) *LeadCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableStatus(v *lead.Status) *LeadCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *LeadCreate) SetCourseID(v int) *LeadCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableCourseID(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetCourseID(*v)
+	}
+	return _c
+}
+
+// SetTrialAt sets the "trial_at" field.
+func (_c *LeadCreate) SetTrialAt(v time.Time) *LeadCreate {
+	_c.mutation.SetTrialAt(v)
+	return _c
+}
+
+// SetNillableTrialAt sets the "trial_at" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableTrialAt(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetTrialAt(*v)
+	}
+	return _c
+}
+
+// SetTrialPriceCents sets the "trial_price_cents" field.
+func (_c *LeadCreate) SetTrialPriceCents(v int64) *LeadCreate {
+	_c.mutation.SetTrialPriceCents(v)
+	return _c
+}
+
+// SetNillableTrialPriceCents sets the "trial_price_cents" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableTrialPriceCents(v *int64) *LeadCreate {
+	if v != nil {
+		_c.SetTrialPriceCents(*v)
+	}
+	return _c
+}
+
+// SetTrialOutcome sets the "trial_outcome" field.
+func (_c *LeadCreate) SetTrialOutcome(v lead.TrialOutcome) *LeadCreate {
+	_c.mutation.SetTrialOutcome(v)
+	return _c
+}
+
+// SetNillableTrialOutcome sets the "trial_outcome" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableTrialOutcome(v *lead.TrialOutcome) *LeadCreate {
+	if v != nil {
+		_c.SetTrialOutcome(*v)
+	}
+	return _c
+}
+
+// SetOutcomeNote sets the "outcome_note" field.
+func (_c *LeadCreate) SetOutcomeNote(v string) *LeadCreate {
+	_c.mutation.SetOutcomeNote(v)
+	return _c
+}
+
+// SetNillableOutcomeNote sets the "outcome_note" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableOutcomeNote(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetOutcomeNote(*v)
+	}
+	return _c
+}
+
+// SetLostReason sets the "lost_reason" field.
+func (_c *LeadCreate) SetLostReason(v string) *LeadCreate {
+	_c.mutation.SetLostReason(v)
+	return _c
+}
+
+// SetNillableLostReason sets the "lost_reason" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableLostReason(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetLostReason(*v)
+	}
+	return _c
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *LeadCreate) SetStudentID(v int) *LeadCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableStudentID(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetStudentID(*v)
+	}
+	return _c
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (_c *LeadCreate) SetConvertedAt(v time.Time) *LeadCreate {
+	_c.mutation.SetConvertedAt(v)
+	return _c
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableConvertedAt(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetConvertedAt(*v)
+	}
+	return _c
+}
+
+// SetTrialChargeID sets the "trial_charge_id" field.
+func (_c *LeadCreate) SetTrialChargeID(v int) *LeadCreate {
+	_c.mutation.SetTrialChargeID(v)
+	return _c
+}
+
+// SetNillableTrialChargeID sets the "trial_charge_id" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableTrialChargeID(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetTrialChargeID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LeadCreate) SetCreatedBy(v string) *LeadCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableCreatedBy(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeadCreate) SetCreatedAt(v time.Time) *LeadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableCreatedAt(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *LeadCreate) SetCourse(v *Course) *LeadCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *LeadCreate) SetStudent(v *Student) *LeadCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// Mutation returns the LeadMutation object of the builder.
+func (_c *LeadCreate) Mutation() *LeadMutation {
+	return _c.mutation
+}
+
+// Save creates the Lead in the database.
+func (_c *LeadCreate) Save(ctx context.Context) (*Lead, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeadCreate) SaveX(ctx context.Context) *Lead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeadCreate) defaults() {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := lead.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.PersonalCode(); !ok {
+		v := lead.DefaultPersonalCode
+		_c.mutation.SetPersonalCode(v)
+	}
+	if _, ok := _c.mutation.Phone(); !ok {
+		v := lead.DefaultPhone
+		_c.mutation.SetPhone(v)
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		v := lead.DefaultEmail
+		_c.mutation.SetEmail(v)
+	}
+	if _, ok := _c.mutation.IsMinor(); !ok {
+		v := lead.DefaultIsMinor
+		_c.mutation.SetIsMinor(v)
+	}
+	if _, ok := _c.mutation.PayerName(); !ok {
+		v := lead.DefaultPayerName
+		_c.mutation.SetPayerName(v)
+	}
+	if _, ok := _c.mutation.PayerRole(); !ok {
+		v := lead.DefaultPayerRole
+		_c.mutation.SetPayerRole(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := lead.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := lead.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := lead.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TrialPriceCents(); !ok {
+		v := lead.DefaultTrialPriceCents
+		_c.mutation.SetTrialPriceCents(v)
+	}
+	if _, ok := _c.mutation.OutcomeNote(); !ok {
+		v := lead.DefaultOutcomeNote
+		_c.mutation.SetOutcomeNote(v)
+	}
+	if _, ok := _c.mutation.LostReason(); !ok {
+		v := lead.DefaultLostReason
+		_c.mutation.SetLostReason(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := lead.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := lead.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeadCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Lead.version"`)}
+	}
+	if _, ok := _c.mutation.FullName(); !ok {
+		return &ValidationError{Name: "full_name", err: errors.New(`ent: missing required field "Lead.full_name"`)}
+	}
+	if _, ok := _c.mutation.PersonalCode(); !ok {
+		return &ValidationError{Name: "personal_code", err: errors.New(`ent: missing required field "Lead.personal_code"`)}
+	}
+	if _, ok := _c.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "Lead.phone"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Lead.email"`)}
+	}
+	if _, ok := _c.mutation.IsMinor(); !ok {
+		return &ValidationError{Name: "is_minor", err: errors.New(`ent: missing required field "Lead.is_minor"`)}
+	}
+	if _, ok := _c.mutation.PayerName(); !ok {
+		return &ValidationError{Name: "payer_name", err: errors.New(`ent: missing required field "Lead.payer_name"`)}
+	}
+	if _, ok := _c.mutation.PayerRole(); !ok {
+		return &ValidationError{Name: "payer_role", err: errors.New(`ent: missing required field "Lead.payer_role"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Lead.source"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "Lead.note"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Lead.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := lead.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Lead.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TrialPriceCents(); !ok {
+		return &ValidationError{Name: "trial_price_cents", err: errors.New(`ent: missing required field "Lead.trial_price_cents"`)}
+	}
+	if v, ok := _c.mutation.TrialOutcome(); ok {
+		if err := lead.TrialOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "trial_outcome", err: fmt.Errorf(`ent: validator failed for field "Lead.trial_outcome": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutcomeNote(); !ok {
+		return &ValidationError{Name: "outcome_note", err: errors.New(`ent: missing required field "Lead.outcome_note"`)}
+	}
+	if _, ok := _c.mutation.LostReason(); !ok {
+		return &ValidationError{Name: "lost_reason", err: errors.New(`ent: missing required field "Lead.lost_reason"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Lead.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Lead.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LeadCreate) sqlSave(ctx context.Context) (*Lead, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lead{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lead.Table, sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(lead.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.FullName(); ok {
+		_spec.SetField(lead.FieldFullName, field.TypeString, value)
+		_node.FullName = value
+	}
+	if value, ok := _c.mutation.PersonalCode(); ok {
+		_spec.SetField(lead.FieldPersonalCode, field.TypeString, value)
+		_node.PersonalCode = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(lead.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(lead.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.IsMinor(); ok {
+		_spec.SetField(lead.FieldIsMinor, field.TypeBool, value)
+		_node.IsMinor = value
+	}
+	if value, ok := _c.mutation.PayerName(); ok {
+		_spec.SetField(lead.FieldPayerName, field.TypeString, value)
+		_node.PayerName = value
+	}
+	if value, ok := _c.mutation.PayerRole(); ok {
+		_spec.SetField(lead.FieldPayerRole, field.TypeString, value)
+		_node.PayerRole = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(lead.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TrialAt(); ok {
+		_spec.SetField(lead.FieldTrialAt, field.TypeTime, value)
+		_node.TrialAt = &value
+	}
+	if value, ok := _c.mutation.TrialPriceCents(); ok {
+		_spec.SetField(lead.FieldTrialPriceCents, field.TypeInt64, value)
+		_node.TrialPriceCents = value
+	}
+	if value, ok := _c.mutation.TrialOutcome(); ok {
+		_spec.SetField(lead.FieldTrialOutcome, field.TypeEnum, value)
+		_node.TrialOutcome = &value
+	}
+	if value, ok := _c.mutation.OutcomeNote(); ok {
+		_spec.SetField(lead.FieldOutcomeNote, field.TypeString, value)
+		_node.OutcomeNote = value
+	}
+	if value, ok := _c.mutation.LostReason(); ok {
+		_spec.SetField(lead.FieldLostReason, field.TypeString, value)
+		_node.LostReason = value
+	}
+	if value, ok := _c.mutation.ConvertedAt(); ok {
+		_spec.SetField(lead.FieldConvertedAt, field.TypeTime, value)
+		_node.ConvertedAt = &value
+	}
+	if value, ok := _c.mutation.TrialChargeID(); ok {
+		_spec.SetField(lead.FieldTrialChargeID, field.TypeInt, value)
+		_node.TrialChargeID = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(lead.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(lead.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.CourseTable,
+			Columns: []string{lead.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.StudentTable,
+			Columns: []string{lead.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeadCreateBulk is the builder for creating many Lead entities in bulk.
+type LeadCreateBulk struct {
+	config
+	err      error
+	builders []*LeadCreate
+}
+
+// Save creates the Lead entities in the database.
+func (_c *LeadCreateBulk) Save(ctx context.Context) ([]*Lead, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Lead, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeadCreateBulk) SaveX(ctx context.Context) []*Lead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
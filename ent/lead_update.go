@@ -0,0 +1,1266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/lead"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeadUpdate is the builder for updating Lead entities.
+type LeadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeadMutation
+}
+
+// Where appends a list predicates to the LeadUpdate builder.
+func (_u *LeadUpdate) Where(ps ...predicate.Lead) *LeadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *LeadUpdate) SetVersion(v int) *LeadUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableVersion(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LeadUpdate) AddVersion(v int) *LeadUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetFullName sets the "full_name" field.
+func (_u *LeadUpdate) SetFullName(v string) *LeadUpdate {
+	_u.mutation.SetFullName(v)
+	return _u
+}
+
+// SetNillableFullName sets the "full_name" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableFullName(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetFullName(*v)
+	}
+	return _u
+}
+
+// SetPersonalCode sets the "personal_code" field.
+func (_u *LeadUpdate) SetPersonalCode(v string) *LeadUpdate {
+	_u.mutation.SetPersonalCode(v)
+	return _u
+}
+
+// SetNillablePersonalCode sets the "personal_code" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillablePersonalCode(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetPersonalCode(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *LeadUpdate) SetPhone(v string) *LeadUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillablePhone(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *LeadUpdate) SetEmail(v string) *LeadUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableEmail(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetIsMinor sets the "is_minor" field.
+func (_u *LeadUpdate) SetIsMinor(v bool) *LeadUpdate {
+	_u.mutation.SetIsMinor(v)
+	return _u
+}
+
+// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableIsMinor(v *bool) *LeadUpdate {
+	if v != nil {
+		_u.SetIsMinor(*v)
+	}
+	return _u
+}
+
+// SetPayerName sets the "payer_name" field.
+func (_u *LeadUpdate) SetPayerName(v string) *LeadUpdate {
+	_u.mutation.SetPayerName(v)
+	return _u
+}
+
+// SetNillablePayerName sets the "payer_name" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillablePayerName(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetPayerName(*v)
+	}
+	return _u
+}
+
+// SetPayerRole sets the "payer_role" field.
+func (_u *LeadUpdate) SetPayerRole(v string) *LeadUpdate {
+	_u.mutation.SetPayerRole(v)
+	return _u
+}
+
+// SetNillablePayerRole sets the "payer_role" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillablePayerRole(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetPayerRole(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LeadUpdate) SetSource(v string) *LeadUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableSource(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LeadUpdate) SetNote(v string) *LeadUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableNote(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeadUpdate) SetStatus(v lead.Status) *LeadUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableStatus(v *lead.Status) *LeadUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *LeadUpdate) SetCourseID(v int) *LeadUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableCourseID(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (_u *LeadUpdate) ClearCourseID() *LeadUpdate {
+	_u.mutation.ClearCourseID()
+	return _u
+}
+
+// SetTrialAt sets the "trial_at" field.
+func (_u *LeadUpdate) SetTrialAt(v time.Time) *LeadUpdate {
+	_u.mutation.SetTrialAt(v)
+	return _u
+}
+
+// SetNillableTrialAt sets the "trial_at" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableTrialAt(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetTrialAt(*v)
+	}
+	return _u
+}
+
+// ClearTrialAt clears the value of the "trial_at" field.
+func (_u *LeadUpdate) ClearTrialAt() *LeadUpdate {
+	_u.mutation.ClearTrialAt()
+	return _u
+}
+
+// SetTrialPriceCents sets the "trial_price_cents" field.
+func (_u *LeadUpdate) SetTrialPriceCents(v int64) *LeadUpdate {
+	_u.mutation.ResetTrialPriceCents()
+	_u.mutation.SetTrialPriceCents(v)
+	return _u
+}
+
+// SetNillableTrialPriceCents sets the "trial_price_cents" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableTrialPriceCents(v *int64) *LeadUpdate {
+	if v != nil {
+		_u.SetTrialPriceCents(*v)
+	}
+	return _u
+}
+
+// AddTrialPriceCents adds value to the "trial_price_cents" field.
+func (_u *LeadUpdate) AddTrialPriceCents(v int64) *LeadUpdate {
+	_u.mutation.AddTrialPriceCents(v)
+	return _u
+}
+
+// SetTrialOutcome sets the "trial_outcome" field.
+func (_u *LeadUpdate) SetTrialOutcome(v lead.TrialOutcome) *LeadUpdate {
+	_u.mutation.SetTrialOutcome(v)
+	return _u
+}
+
+// SetNillableTrialOutcome sets the "trial_outcome" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableTrialOutcome(v *lead.TrialOutcome) *LeadUpdate {
+	if v != nil {
+		_u.SetTrialOutcome(*v)
+	}
+	return _u
+}
+
+// ClearTrialOutcome clears the value of the "trial_outcome" field.
+func (_u *LeadUpdate) ClearTrialOutcome() *LeadUpdate {
+	_u.mutation.ClearTrialOutcome()
+	return _u
+}
+
+// SetOutcomeNote sets the "outcome_note" field.
+func (_u *LeadUpdate) SetOutcomeNote(v string) *LeadUpdate {
+	_u.mutation.SetOutcomeNote(v)
+	return _u
+}
+
+// SetNillableOutcomeNote sets the "outcome_note" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableOutcomeNote(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetOutcomeNote(*v)
+	}
+	return _u
+}
+
+// SetLostReason sets the "lost_reason" field.
+func (_u *LeadUpdate) SetLostReason(v string) *LeadUpdate {
+	_u.mutation.SetLostReason(v)
+	return _u
+}
+
+// SetNillableLostReason sets the "lost_reason" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableLostReason(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetLostReason(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LeadUpdate) SetStudentID(v int) *LeadUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableStudentID(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (_u *LeadUpdate) ClearStudentID() *LeadUpdate {
+	_u.mutation.ClearStudentID()
+	return _u
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (_u *LeadUpdate) SetConvertedAt(v time.Time) *LeadUpdate {
+	_u.mutation.SetConvertedAt(v)
+	return _u
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableConvertedAt(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetConvertedAt(*v)
+	}
+	return _u
+}
+
+// ClearConvertedAt clears the value of the "converted_at" field.
+func (_u *LeadUpdate) ClearConvertedAt() *LeadUpdate {
+	_u.mutation.ClearConvertedAt()
+	return _u
+}
+
+// SetTrialChargeID sets the "trial_charge_id" field.
+func (_u *LeadUpdate) SetTrialChargeID(v int) *LeadUpdate {
+	_u.mutation.ResetTrialChargeID()
+	_u.mutation.SetTrialChargeID(v)
+	return _u
+}
+
+// SetNillableTrialChargeID sets the "trial_charge_id" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableTrialChargeID(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetTrialChargeID(*v)
+	}
+	return _u
+}
+
+// AddTrialChargeID adds value to the "trial_charge_id" field.
+func (_u *LeadUpdate) AddTrialChargeID(v int) *LeadUpdate {
+	_u.mutation.AddTrialChargeID(v)
+	return _u
+}
+
+// ClearTrialChargeID clears the value of the "trial_charge_id" field.
+func (_u *LeadUpdate) ClearTrialChargeID() *LeadUpdate {
+	_u.mutation.ClearTrialChargeID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LeadUpdate) SetCreatedBy(v string) *LeadUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableCreatedBy(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LeadUpdate) SetCreatedAt(v time.Time) *LeadUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableCreatedAt(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *LeadUpdate) SetCourse(v *Course) *LeadUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LeadUpdate) SetStudent(v *Student) *LeadUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// Mutation returns the LeadMutation object of the builder.
+func (_u *LeadUpdate) Mutation() *LeadMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *LeadUpdate) ClearCourse() *LeadUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LeadUpdate) ClearStudent() *LeadUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := lead.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Lead.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrialOutcome(); ok {
+		if err := lead.TrialOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "trial_outcome", err: fmt.Errorf(`ent: validator failed for field "Lead.trial_outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lead.Table, lead.Columns, sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(lead.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(lead.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FullName(); ok {
+		_spec.SetField(lead.FieldFullName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PersonalCode(); ok {
+		_spec.SetField(lead.FieldPersonalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(lead.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(lead.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsMinor(); ok {
+		_spec.SetField(lead.FieldIsMinor, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PayerName(); ok {
+		_spec.SetField(lead.FieldPayerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerRole(); ok {
+		_spec.SetField(lead.FieldPayerRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(lead.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TrialAt(); ok {
+		_spec.SetField(lead.FieldTrialAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrialAtCleared() {
+		_spec.ClearField(lead.FieldTrialAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrialPriceCents(); ok {
+		_spec.SetField(lead.FieldTrialPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialPriceCents(); ok {
+		_spec.AddField(lead.FieldTrialPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TrialOutcome(); ok {
+		_spec.SetField(lead.FieldTrialOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.TrialOutcomeCleared() {
+		_spec.ClearField(lead.FieldTrialOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.OutcomeNote(); ok {
+		_spec.SetField(lead.FieldOutcomeNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LostReason(); ok {
+		_spec.SetField(lead.FieldLostReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConvertedAt(); ok {
+		_spec.SetField(lead.FieldConvertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConvertedAtCleared() {
+		_spec.ClearField(lead.FieldConvertedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrialChargeID(); ok {
+		_spec.SetField(lead.FieldTrialChargeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialChargeID(); ok {
+		_spec.AddField(lead.FieldTrialChargeID, field.TypeInt, value)
+	}
+	if _u.mutation.TrialChargeIDCleared() {
+		_spec.ClearField(lead.FieldTrialChargeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(lead.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lead.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.CourseTable,
+			Columns: []string{lead.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.CourseTable,
+			Columns: []string{lead.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.StudentTable,
+			Columns: []string{lead.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.StudentTable,
+			Columns: []string{lead.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadUpdateOne is the builder for updating a single Lead entity.
+type LeadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeadMutation
+}
+
+// SetVersion sets the "version" field.
+func (_u *LeadUpdateOne) SetVersion(v int) *LeadUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableVersion(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LeadUpdateOne) AddVersion(v int) *LeadUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetFullName sets the "full_name" field.
+func (_u *LeadUpdateOne) SetFullName(v string) *LeadUpdateOne {
+	_u.mutation.SetFullName(v)
+	return _u
+}
+
+// SetNillableFullName sets the "full_name" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableFullName(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetFullName(*v)
+	}
+	return _u
+}
+
+// SetPersonalCode sets the "personal_code" field.
+func (_u *LeadUpdateOne) SetPersonalCode(v string) *LeadUpdateOne {
+	_u.mutation.SetPersonalCode(v)
+	return _u
+}
+
+// SetNillablePersonalCode sets the "personal_code" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillablePersonalCode(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetPersonalCode(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *LeadUpdateOne) SetPhone(v string) *LeadUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillablePhone(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *LeadUpdateOne) SetEmail(v string) *LeadUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableEmail(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetIsMinor sets the "is_minor" field.
+func (_u *LeadUpdateOne) SetIsMinor(v bool) *LeadUpdateOne {
+	_u.mutation.SetIsMinor(v)
+	return _u
+}
+
+// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableIsMinor(v *bool) *LeadUpdateOne {
+	if v != nil {
+		_u.SetIsMinor(*v)
+	}
+	return _u
+}
+
+// SetPayerName sets the "payer_name" field.
+func (_u *LeadUpdateOne) SetPayerName(v string) *LeadUpdateOne {
+	_u.mutation.SetPayerName(v)
+	return _u
+}
+
+// SetNillablePayerName sets the "payer_name" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillablePayerName(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetPayerName(*v)
+	}
+	return _u
+}
+
+// SetPayerRole sets the "payer_role" field.
+func (_u *LeadUpdateOne) SetPayerRole(v string) *LeadUpdateOne {
+	_u.mutation.SetPayerRole(v)
+	return _u
+}
+
+// SetNillablePayerRole sets the "payer_role" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillablePayerRole(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetPayerRole(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LeadUpdateOne) SetSource(v string) *LeadUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableSource(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LeadUpdateOne) SetNote(v string) *LeadUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableNote(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeadUpdateOne) SetStatus(v lead.Status) *LeadUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableStatus(v *lead.Status) *LeadUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *LeadUpdateOne) SetCourseID(v int) *LeadUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableCourseID(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// ClearCourseID clears the value of the "course_id" field.
+func (_u *LeadUpdateOne) ClearCourseID() *LeadUpdateOne {
+	_u.mutation.ClearCourseID()
+	return _u
+}
+
+// SetTrialAt sets the "trial_at" field.
+func (_u *LeadUpdateOne) SetTrialAt(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetTrialAt(v)
+	return _u
+}
+
+// SetNillableTrialAt sets the "trial_at" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableTrialAt(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetTrialAt(*v)
+	}
+	return _u
+}
+
+// ClearTrialAt clears the value of the "trial_at" field.
+func (_u *LeadUpdateOne) ClearTrialAt() *LeadUpdateOne {
+	_u.mutation.ClearTrialAt()
+	return _u
+}
+
+// SetTrialPriceCents sets the "trial_price_cents" field.
+func (_u *LeadUpdateOne) SetTrialPriceCents(v int64) *LeadUpdateOne {
+	_u.mutation.ResetTrialPriceCents()
+	_u.mutation.SetTrialPriceCents(v)
+	return _u
+}
+
+// SetNillableTrialPriceCents sets the "trial_price_cents" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableTrialPriceCents(v *int64) *LeadUpdateOne {
+	if v != nil {
+		_u.SetTrialPriceCents(*v)
+	}
+	return _u
+}
+
+// AddTrialPriceCents adds value to the "trial_price_cents" field.
+func (_u *LeadUpdateOne) AddTrialPriceCents(v int64) *LeadUpdateOne {
+	_u.mutation.AddTrialPriceCents(v)
+	return _u
+}
+
+// SetTrialOutcome sets the "trial_outcome" field.
+func (_u *LeadUpdateOne) SetTrialOutcome(v lead.TrialOutcome) *LeadUpdateOne {
+	_u.mutation.SetTrialOutcome(v)
+	return _u
+}
+
+// SetNillableTrialOutcome sets the "trial_outcome" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableTrialOutcome(v *lead.TrialOutcome) *LeadUpdateOne {
+	if v != nil {
+		_u.SetTrialOutcome(*v)
+	}
+	return _u
+}
+
+// ClearTrialOutcome clears the value of the "trial_outcome" field.
+func (_u *LeadUpdateOne) ClearTrialOutcome() *LeadUpdateOne {
+	_u.mutation.ClearTrialOutcome()
+	return _u
+}
+
+// SetOutcomeNote sets the "outcome_note" field.
+func (_u *LeadUpdateOne) SetOutcomeNote(v string) *LeadUpdateOne {
+	_u.mutation.SetOutcomeNote(v)
+	return _u
+}
+
+// SetNillableOutcomeNote sets the "outcome_note" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableOutcomeNote(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetOutcomeNote(*v)
+	}
+	return _u
+}
+
+// SetLostReason sets the "lost_reason" field.
+func (_u *LeadUpdateOne) SetLostReason(v string) *LeadUpdateOne {
+	_u.mutation.SetLostReason(v)
+	return _u
+}
+
+// SetNillableLostReason sets the "lost_reason" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableLostReason(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetLostReason(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LeadUpdateOne) SetStudentID(v int) *LeadUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableStudentID(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// ClearStudentID clears the value of the "student_id" field.
+func (_u *LeadUpdateOne) ClearStudentID() *LeadUpdateOne {
+	_u.mutation.ClearStudentID()
+	return _u
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (_u *LeadUpdateOne) SetConvertedAt(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetConvertedAt(v)
+	return _u
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableConvertedAt(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetConvertedAt(*v)
+	}
+	return _u
+}
+
+// ClearConvertedAt clears the value of the "converted_at" field.
+func (_u *LeadUpdateOne) ClearConvertedAt() *LeadUpdateOne {
+	_u.mutation.ClearConvertedAt()
+	return _u
+}
+
+// SetTrialChargeID sets the "trial_charge_id" field.
+func (_u *LeadUpdateOne) SetTrialChargeID(v int) *LeadUpdateOne {
+	_u.mutation.ResetTrialChargeID()
+	_u.mutation.SetTrialChargeID(v)
+	return _u
+}
+
+// SetNillableTrialChargeID sets the "trial_charge_id" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableTrialChargeID(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetTrialChargeID(*v)
+	}
+	return _u
+}
+
+// AddTrialChargeID adds value to the "trial_charge_id" field.
+func (_u *LeadUpdateOne) AddTrialChargeID(v int) *LeadUpdateOne {
+	_u.mutation.AddTrialChargeID(v)
+	return _u
+}
+
+// ClearTrialChargeID clears the value of the "trial_charge_id" field.
+func (_u *LeadUpdateOne) ClearTrialChargeID() *LeadUpdateOne {
+	_u.mutation.ClearTrialChargeID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LeadUpdateOne) SetCreatedBy(v string) *LeadUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableCreatedBy(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LeadUpdateOne) SetCreatedAt(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableCreatedAt(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *LeadUpdateOne) SetCourse(v *Course) *LeadUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LeadUpdateOne) SetStudent(v *Student) *LeadUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// Mutation returns the LeadMutation object of the builder.
+func (_u *LeadUpdateOne) Mutation() *LeadMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *LeadUpdateOne) ClearCourse() *LeadUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LeadUpdateOne) ClearStudent() *LeadUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// Where appends a list predicates to the LeadUpdate builder.
+func (_u *LeadUpdateOne) Where(ps ...predicate.Lead) *LeadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadUpdateOne) Select(field string, fields ...string) *LeadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Lead entity.
+func (_u *LeadUpdateOne) Save(ctx context.Context) (*Lead, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadUpdateOne) SaveX(ctx context.Context) *Lead {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := lead.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Lead.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrialOutcome(); ok {
+		if err := lead.TrialOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "trial_outcome", err: fmt.Errorf(`ent: validator failed for field "Lead.trial_outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lead.Table, lead.Columns, sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lead.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lead.FieldID)
+		for _, f := range fields {
+			if !lead.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(lead.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(lead.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FullName(); ok {
+		_spec.SetField(lead.FieldFullName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PersonalCode(); ok {
+		_spec.SetField(lead.FieldPersonalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(lead.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(lead.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsMinor(); ok {
+		_spec.SetField(lead.FieldIsMinor, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PayerName(); ok {
+		_spec.SetField(lead.FieldPayerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerRole(); ok {
+		_spec.SetField(lead.FieldPayerRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(lead.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TrialAt(); ok {
+		_spec.SetField(lead.FieldTrialAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrialAtCleared() {
+		_spec.ClearField(lead.FieldTrialAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrialPriceCents(); ok {
+		_spec.SetField(lead.FieldTrialPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialPriceCents(); ok {
+		_spec.AddField(lead.FieldTrialPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TrialOutcome(); ok {
+		_spec.SetField(lead.FieldTrialOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.TrialOutcomeCleared() {
+		_spec.ClearField(lead.FieldTrialOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.OutcomeNote(); ok {
+		_spec.SetField(lead.FieldOutcomeNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LostReason(); ok {
+		_spec.SetField(lead.FieldLostReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConvertedAt(); ok {
+		_spec.SetField(lead.FieldConvertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConvertedAtCleared() {
+		_spec.ClearField(lead.FieldConvertedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrialChargeID(); ok {
+		_spec.SetField(lead.FieldTrialChargeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialChargeID(); ok {
+		_spec.AddField(lead.FieldTrialChargeID, field.TypeInt, value)
+	}
+	if _u.mutation.TrialChargeIDCleared() {
+		_spec.ClearField(lead.FieldTrialChargeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(lead.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lead.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.CourseTable,
+			Columns: []string{lead.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.CourseTable,
+			Columns: []string{lead.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.StudentTable,
+			Columns: []string{lead.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.StudentTable,
+			Columns: []string{lead.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Lead{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"langschool/ent/lessonpackage"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LessonPackage is the model entity for the LessonPackage schema.
+type LessonPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID int `json:"course_id,omitempty"`
+	// Lessons holds the value of the "lessons" field.
+	Lessons float64 `json:"lessons,omitempty"`
+	// PriceCents holds the value of the "price_cents" field.
+	PriceCents int64 `json:"price_cents,omitempty"`
+	// SoldAt holds the value of the "sold_at" field.
+	SoldAt time.Time `json:"sold_at,omitempty"`
+	// ExpiresOn holds the value of the "expires_on" field.
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID *int `json:"invoice_id,omitempty"`
+	// CarriedFromID holds the value of the "carried_from_id" field.
+	CarriedFromID *int `json:"carried_from_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status lessonpackage.Status `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// UnusedLessons holds the value of the "unused_lessons" field.
+	UnusedLessons float64 `json:"unused_lessons,omitempty"`
+	// RefundCents holds the value of the "refund_cents" field.
+	RefundCents int64 `json:"refund_cents,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LessonPackageQuery when eager-loading is set.
+	Edges        LessonPackageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LessonPackageEdges holds the relations/edges for other nodes in the graph.
+type LessonPackageEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LessonPackageEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LessonPackageEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LessonPackageEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LessonPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lessonpackage.FieldLessons, lessonpackage.FieldUnusedLessons:
+			values[i] = new(sql.NullFloat64)
+		case lessonpackage.FieldID, lessonpackage.FieldStudentID, lessonpackage.FieldCourseID, lessonpackage.FieldPriceCents, lessonpackage.FieldInvoiceID, lessonpackage.FieldCarriedFromID, lessonpackage.FieldRefundCents:
+			values[i] = new(sql.NullInt64)
+		case lessonpackage.FieldStatus, lessonpackage.FieldNote, lessonpackage.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case lessonpackage.FieldSoldAt, lessonpackage.FieldExpiresOn, lessonpackage.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LessonPackage fields.
+func (_m *LessonPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lessonpackage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case lessonpackage.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case lessonpackage.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = int(value.Int64)
+			}
+		case lessonpackage.FieldLessons:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field lessons", values[i])
+			} else if value.Valid {
+				_m.Lessons = value.Float64
+			}
+		case lessonpackage.FieldPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_cents", values[i])
+			} else if value.Valid {
+				_m.PriceCents = value.Int64
+			}
+		case lessonpackage.FieldSoldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sold_at", values[i])
+			} else if value.Valid {
+				_m.SoldAt = value.Time
+			}
+		case lessonpackage.FieldExpiresOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_on", values[i])
+			} else if value.Valid {
+				_m.ExpiresOn = new(time.Time)
+				*_m.ExpiresOn = value.Time
+			}
+		case lessonpackage.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				_m.InvoiceID = new(int)
+				*_m.InvoiceID = int(value.Int64)
+			}
+		case lessonpackage.FieldCarriedFromID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field carried_from_id", values[i])
+			} else if value.Valid {
+				_m.CarriedFromID = new(int)
+				*_m.CarriedFromID = int(value.Int64)
+			}
+		case lessonpackage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = lessonpackage.Status(value.String)
+			}
+		case lessonpackage.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case lessonpackage.FieldUnusedLessons:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field unused_lessons", values[i])
+			} else if value.Valid {
+				_m.UnusedLessons = value.Float64
+			}
+		case lessonpackage.FieldRefundCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_cents", values[i])
+			} else if value.Valid {
+				_m.RefundCents = value.Int64
+			}
+		case lessonpackage.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case lessonpackage.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LessonPackage.
+// This includes values selected through modifiers, order, etc.
+func (_m *LessonPackage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the LessonPackage entity.
+func (_m *LessonPackage) QueryStudent() *StudentQuery {
+	return NewLessonPackageClient(_m.config).QueryStudent(_m)
+}
+
+// QueryCourse queries the "course" edge of the LessonPackage entity.
+func (_m *LessonPackage) QueryCourse() *CourseQuery {
+	return NewLessonPackageClient(_m.config).QueryCourse(_m)
+}
+
+// QueryInvoice queries the "invoice" edge of the LessonPackage entity.
+func (_m *LessonPackage) QueryInvoice() *InvoiceQuery {
+	return NewLessonPackageClient(_m.config).QueryInvoice(_m)
+}
+
+// Update returns a builder for updating this LessonPackage.
+// Note that you need to call LessonPackage.Unwrap() before calling this method if this LessonPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LessonPackage) Update() *LessonPackageUpdateOne {
+	return NewLessonPackageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LessonPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LessonPackage) Unwrap() *LessonPackage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LessonPackage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LessonPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("LessonPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("course_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CourseID))
+	builder.WriteString(", ")
+	builder.WriteString("lessons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lessons))
+	builder.WriteString(", ")
+	builder.WriteString("price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("sold_at=")
+	builder.WriteString(_m.SoldAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresOn; v != nil {
+		builder.WriteString("expires_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.InvoiceID; v != nil {
+		builder.WriteString("invoice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CarriedFromID; v != nil {
+		builder.WriteString("carried_from_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unused_lessons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnusedLessons))
+	builder.WriteString(", ")
+	builder.WriteString("refund_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundCents))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LessonPackages is a parsable slice of LessonPackage.
+type LessonPackages []*LessonPackage
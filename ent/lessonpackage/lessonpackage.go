@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lessonpackage
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the lessonpackage type in the database.
+	Label = "lesson_package"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldCourseID holds the string denoting the course_id field in the database.
+	FieldCourseID = "course_id"
+	// FieldLessons holds the string denoting the lessons field in the database.
+	FieldLessons = "lessons"
+	// FieldPriceCents holds the string denoting the price_cents field in the database.
+	FieldPriceCents = "price_cents"
+	// FieldSoldAt holds the string denoting the sold_at field in the database.
+	FieldSoldAt = "sold_at"
+	// FieldExpiresOn holds the string denoting the expires_on field in the database.
+	FieldExpiresOn = "expires_on"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldCarriedFromID holds the string denoting the carried_from_id field in the database.
+	FieldCarriedFromID = "carried_from_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldUnusedLessons holds the string denoting the unused_lessons field in the database.
+	FieldUnusedLessons = "unused_lessons"
+	// FieldRefundCents holds the string denoting the refund_cents field in the database.
+	FieldRefundCents = "refund_cents"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgeCourse holds the string denoting the course edge name in mutations.
+	EdgeCourse = "course"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// Table holds the table name of the lessonpackage in the database.
+	Table = "lesson_packages"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "lesson_packages"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// CourseTable is the table that holds the course relation/edge.
+	CourseTable = "lesson_packages"
+	// CourseInverseTable is the table name for the Course entity.
+	// It exists in this package in order to avoid circular dependency with the "course" package.
+	CourseInverseTable = "courses"
+	// CourseColumn is the table column denoting the course relation/edge.
+	CourseColumn = "course_id"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "lesson_packages"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_id"
+)
+
+// Columns holds all SQL columns for lessonpackage fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldCourseID,
+	FieldLessons,
+	FieldPriceCents,
+	FieldSoldAt,
+	FieldExpiresOn,
+	FieldInvoiceID,
+	FieldCarriedFromID,
+	FieldStatus,
+	FieldClosedAt,
+	FieldUnusedLessons,
+	FieldRefundCents,
+	FieldNote,
+	FieldCreatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSoldAt holds the default value on creation for the "sold_at" field.
+	DefaultSoldAt func() time.Time
+	// DefaultUnusedLessons holds the default value on creation for the "unused_lessons" field.
+	DefaultUnusedLessons float64
+	// DefaultRefundCents holds the default value on creation for the "refund_cents" field.
+	DefaultRefundCents int64
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen        Status = "open"
+	StatusCarriedOver Status = "carried_over"
+	StatusRefunded    Status = "refunded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusCarriedOver, StatusRefunded:
+		return nil
+	default:
+		return fmt.Errorf("lessonpackage: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the LessonPackage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByCourseID orders the results by the course_id field.
+func ByCourseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCourseID, opts...).ToFunc()
+}
+
+// ByLessons orders the results by the lessons field.
+func ByLessons(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLessons, opts...).ToFunc()
+}
+
+// ByPriceCents orders the results by the price_cents field.
+func ByPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceCents, opts...).ToFunc()
+}
+
+// BySoldAt orders the results by the sold_at field.
+func BySoldAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoldAt, opts...).ToFunc()
+}
+
+// ByExpiresOn orders the results by the expires_on field.
+func ByExpiresOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresOn, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByCarriedFromID orders the results by the carried_from_id field.
+func ByCarriedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarriedFromID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByUnusedLessons orders the results by the unused_lessons field.
+func ByUnusedLessons(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnusedLessons, opts...).ToFunc()
+}
+
+// ByRefundCents orders the results by the refund_cents field.
+func ByRefundCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundCents, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCourseField orders the results by course field.
+func ByCourseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCourseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newCourseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CourseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+	)
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+	)
+}
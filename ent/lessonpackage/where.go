@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lessonpackage
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldStudentID, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCourseID, v))
+}
+
+// Lessons applies equality check predicate on the "lessons" field. It's identical to LessonsEQ.
+func Lessons(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldLessons, v))
+}
+
+// PriceCents applies equality check predicate on the "price_cents" field. It's identical to PriceCentsEQ.
+func PriceCents(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldPriceCents, v))
+}
+
+// SoldAt applies equality check predicate on the "sold_at" field. It's identical to SoldAtEQ.
+func SoldAt(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldSoldAt, v))
+}
+
+// ExpiresOn applies equality check predicate on the "expires_on" field. It's identical to ExpiresOnEQ.
+func ExpiresOn(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldExpiresOn, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// CarriedFromID applies equality check predicate on the "carried_from_id" field. It's identical to CarriedFromIDEQ.
+func CarriedFromID(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCarriedFromID, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// UnusedLessons applies equality check predicate on the "unused_lessons" field. It's identical to UnusedLessonsEQ.
+func UnusedLessons(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldUnusedLessons, v))
+}
+
+// RefundCents applies equality check predicate on the "refund_cents" field. It's identical to RefundCentsEQ.
+func RefundCents(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldRefundCents, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// LessonsEQ applies the EQ predicate on the "lessons" field.
+func LessonsEQ(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldLessons, v))
+}
+
+// LessonsNEQ applies the NEQ predicate on the "lessons" field.
+func LessonsNEQ(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldLessons, v))
+}
+
+// LessonsIn applies the In predicate on the "lessons" field.
+func LessonsIn(vs ...float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldLessons, vs...))
+}
+
+// LessonsNotIn applies the NotIn predicate on the "lessons" field.
+func LessonsNotIn(vs ...float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldLessons, vs...))
+}
+
+// LessonsGT applies the GT predicate on the "lessons" field.
+func LessonsGT(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldLessons, v))
+}
+
+// LessonsGTE applies the GTE predicate on the "lessons" field.
+func LessonsGTE(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldLessons, v))
+}
+
+// LessonsLT applies the LT predicate on the "lessons" field.
+func LessonsLT(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldLessons, v))
+}
+
+// LessonsLTE applies the LTE predicate on the "lessons" field.
+func LessonsLTE(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldLessons, v))
+}
+
+// PriceCentsEQ applies the EQ predicate on the "price_cents" field.
+func PriceCentsEQ(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldPriceCents, v))
+}
+
+// PriceCentsNEQ applies the NEQ predicate on the "price_cents" field.
+func PriceCentsNEQ(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldPriceCents, v))
+}
+
+// PriceCentsIn applies the In predicate on the "price_cents" field.
+func PriceCentsIn(vs ...int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldPriceCents, vs...))
+}
+
+// PriceCentsNotIn applies the NotIn predicate on the "price_cents" field.
+func PriceCentsNotIn(vs ...int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldPriceCents, vs...))
+}
+
+// PriceCentsGT applies the GT predicate on the "price_cents" field.
+func PriceCentsGT(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldPriceCents, v))
+}
+
+// PriceCentsGTE applies the GTE predicate on the "price_cents" field.
+func PriceCentsGTE(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldPriceCents, v))
+}
+
+// PriceCentsLT applies the LT predicate on the "price_cents" field.
+func PriceCentsLT(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldPriceCents, v))
+}
+
+// PriceCentsLTE applies the LTE predicate on the "price_cents" field.
+func PriceCentsLTE(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldPriceCents, v))
+}
+
+// SoldAtEQ applies the EQ predicate on the "sold_at" field.
+func SoldAtEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldSoldAt, v))
+}
+
+// SoldAtNEQ applies the NEQ predicate on the "sold_at" field.
+func SoldAtNEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldSoldAt, v))
+}
+
+// SoldAtIn applies the In predicate on the "sold_at" field.
+func SoldAtIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldSoldAt, vs...))
+}
+
+// SoldAtNotIn applies the NotIn predicate on the "sold_at" field.
+func SoldAtNotIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldSoldAt, vs...))
+}
+
+// SoldAtGT applies the GT predicate on the "sold_at" field.
+func SoldAtGT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldSoldAt, v))
+}
+
+// SoldAtGTE applies the GTE predicate on the "sold_at" field.
+func SoldAtGTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldSoldAt, v))
+}
+
+// SoldAtLT applies the LT predicate on the "sold_at" field.
+func SoldAtLT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldSoldAt, v))
+}
+
+// SoldAtLTE applies the LTE predicate on the "sold_at" field.
+func SoldAtLTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldSoldAt, v))
+}
+
+// ExpiresOnEQ applies the EQ predicate on the "expires_on" field.
+func ExpiresOnEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldExpiresOn, v))
+}
+
+// ExpiresOnNEQ applies the NEQ predicate on the "expires_on" field.
+func ExpiresOnNEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldExpiresOn, v))
+}
+
+// ExpiresOnIn applies the In predicate on the "expires_on" field.
+func ExpiresOnIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldExpiresOn, vs...))
+}
+
+// ExpiresOnNotIn applies the NotIn predicate on the "expires_on" field.
+func ExpiresOnNotIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldExpiresOn, vs...))
+}
+
+// ExpiresOnGT applies the GT predicate on the "expires_on" field.
+func ExpiresOnGT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldExpiresOn, v))
+}
+
+// ExpiresOnGTE applies the GTE predicate on the "expires_on" field.
+func ExpiresOnGTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldExpiresOn, v))
+}
+
+// ExpiresOnLT applies the LT predicate on the "expires_on" field.
+func ExpiresOnLT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldExpiresOn, v))
+}
+
+// ExpiresOnLTE applies the LTE predicate on the "expires_on" field.
+func ExpiresOnLTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldExpiresOn, v))
+}
+
+// ExpiresOnIsNil applies the IsNil predicate on the "expires_on" field.
+func ExpiresOnIsNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIsNull(FieldExpiresOn))
+}
+
+// ExpiresOnNotNil applies the NotNil predicate on the "expires_on" field.
+func ExpiresOnNotNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotNull(FieldExpiresOn))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIsNull(FieldInvoiceID))
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotNull(FieldInvoiceID))
+}
+
+// CarriedFromIDEQ applies the EQ predicate on the "carried_from_id" field.
+func CarriedFromIDEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDNEQ applies the NEQ predicate on the "carried_from_id" field.
+func CarriedFromIDNEQ(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDIn applies the In predicate on the "carried_from_id" field.
+func CarriedFromIDIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldCarriedFromID, vs...))
+}
+
+// CarriedFromIDNotIn applies the NotIn predicate on the "carried_from_id" field.
+func CarriedFromIDNotIn(vs ...int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldCarriedFromID, vs...))
+}
+
+// CarriedFromIDGT applies the GT predicate on the "carried_from_id" field.
+func CarriedFromIDGT(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDGTE applies the GTE predicate on the "carried_from_id" field.
+func CarriedFromIDGTE(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDLT applies the LT predicate on the "carried_from_id" field.
+func CarriedFromIDLT(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDLTE applies the LTE predicate on the "carried_from_id" field.
+func CarriedFromIDLTE(v int) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldCarriedFromID, v))
+}
+
+// CarriedFromIDIsNil applies the IsNil predicate on the "carried_from_id" field.
+func CarriedFromIDIsNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIsNull(FieldCarriedFromID))
+}
+
+// CarriedFromIDNotNil applies the NotNil predicate on the "carried_from_id" field.
+func CarriedFromIDNotNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotNull(FieldCarriedFromID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotNull(FieldClosedAt))
+}
+
+// UnusedLessonsEQ applies the EQ predicate on the "unused_lessons" field.
+func UnusedLessonsEQ(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldUnusedLessons, v))
+}
+
+// UnusedLessonsNEQ applies the NEQ predicate on the "unused_lessons" field.
+func UnusedLessonsNEQ(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldUnusedLessons, v))
+}
+
+// UnusedLessonsIn applies the In predicate on the "unused_lessons" field.
+func UnusedLessonsIn(vs ...float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldUnusedLessons, vs...))
+}
+
+// UnusedLessonsNotIn applies the NotIn predicate on the "unused_lessons" field.
+func UnusedLessonsNotIn(vs ...float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldUnusedLessons, vs...))
+}
+
+// UnusedLessonsGT applies the GT predicate on the "unused_lessons" field.
+func UnusedLessonsGT(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldUnusedLessons, v))
+}
+
+// UnusedLessonsGTE applies the GTE predicate on the "unused_lessons" field.
+func UnusedLessonsGTE(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldUnusedLessons, v))
+}
+
+// UnusedLessonsLT applies the LT predicate on the "unused_lessons" field.
+func UnusedLessonsLT(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldUnusedLessons, v))
+}
+
+// UnusedLessonsLTE applies the LTE predicate on the "unused_lessons" field.
+func UnusedLessonsLTE(v float64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldUnusedLessons, v))
+}
+
+// RefundCentsEQ applies the EQ predicate on the "refund_cents" field.
+func RefundCentsEQ(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldRefundCents, v))
+}
+
+// RefundCentsNEQ applies the NEQ predicate on the "refund_cents" field.
+func RefundCentsNEQ(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldRefundCents, v))
+}
+
+// RefundCentsIn applies the In predicate on the "refund_cents" field.
+func RefundCentsIn(vs ...int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldRefundCents, vs...))
+}
+
+// RefundCentsNotIn applies the NotIn predicate on the "refund_cents" field.
+func RefundCentsNotIn(vs ...int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldRefundCents, vs...))
+}
+
+// RefundCentsGT applies the GT predicate on the "refund_cents" field.
+func RefundCentsGT(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldRefundCents, v))
+}
+
+// RefundCentsGTE applies the GTE predicate on the "refund_cents" field.
+func RefundCentsGTE(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldRefundCents, v))
+}
+
+// RefundCentsLT applies the LT predicate on the "refund_cents" field.
+func RefundCentsLT(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldRefundCents, v))
+}
+
+// RefundCentsLTE applies the LTE predicate on the "refund_cents" field.
+func RefundCentsLTE(v int64) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldRefundCents, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.LessonPackage {
+	return predicate.LessonPackage(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LessonPackage) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LessonPackage) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LessonPackage) predicate.LessonPackage {
+	return predicate.LessonPackage(sql.NotPredicates(p))
+}
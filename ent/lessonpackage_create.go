@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"langschool/ent/lessonpackage"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LessonPackageCreate is the builder for creating a LessonPackage entity.
+type LessonPackageCreate struct {
+	config
+	mutation *LessonPackageMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *LessonPackageCreate) SetStudentID(v int) *LessonPackageCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *LessonPackageCreate) SetCourseID(v int) *LessonPackageCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetLessons sets the "lessons" field.
+func (_c *LessonPackageCreate) SetLessons(v float64) *LessonPackageCreate {
+	_c.mutation.SetLessons(v)
+	return _c
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (_c *LessonPackageCreate) SetPriceCents(v int64) *LessonPackageCreate {
+	_c.mutation.SetPriceCents(v)
+	return _c
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (_c *LessonPackageCreate) SetSoldAt(v time.Time) *LessonPackageCreate {
+	_c.mutation.SetSoldAt(v)
+	return _c
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableSoldAt(v *time.Time) *LessonPackageCreate {
+	if v != nil {
+		_c.SetSoldAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresOn sets the "expires_on" field.
+func (_c *LessonPackageCreate) SetExpiresOn(v time.Time) *LessonPackageCreate {
+	_c.mutation.SetExpiresOn(v)
+	return _c
+}
+
+// SetNillableExpiresOn sets the "expires_on" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableExpiresOn(v *time.Time) *LessonPackageCreate {
+	if v != nil {
+		_c.SetExpiresOn(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *LessonPackageCreate) SetInvoiceID(v int) *LessonPackageCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableInvoiceID(v *int) *LessonPackageCreate {
+	if v != nil {
+		_c.SetInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetCarriedFromID sets the "carried_from_id" field.
+func (_c *LessonPackageCreate) SetCarriedFromID(v int) *LessonPackageCreate {
+	_c.mutation.SetCarriedFromID(v)
+	return _c
+}
+
+// SetNillableCarriedFromID sets the "carried_from_id" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableCarriedFromID(v *int) *LessonPackageCreate {
+	if v != nil {
+		_c.SetCarriedFromID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LessonPackageCreate) SetStatus(v lessonpackage.Status) *LessonPackageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableStatus(v *lessonpackage.Status) *LessonPackageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *LessonPackageCreate) SetClosedAt(v time.Time) *LessonPackageCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableClosedAt(v *time.Time) *LessonPackageCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetUnusedLessons sets the "unused_lessons" field.
+func (_c *LessonPackageCreate) SetUnusedLessons(v float64) *LessonPackageCreate {
+	_c.mutation.SetUnusedLessons(v)
+	return _c
+}
+
+// SetNillableUnusedLessons sets the "unused_lessons" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableUnusedLessons(v *float64) *LessonPackageCreate {
+	if v != nil {
+		_c.SetUnusedLessons(*v)
+	}
+	return _c
+}
+
+// SetRefundCents sets the "refund_cents" field.
+func (_c *LessonPackageCreate) SetRefundCents(v int64) *LessonPackageCreate {
+	_c.mutation.SetRefundCents(v)
+	return _c
+}
+
+// SetNillableRefundCents sets the "refund_cents" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableRefundCents(v *int64) *LessonPackageCreate {
+	if v != nil {
+		_c.SetRefundCents(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *LessonPackageCreate) SetNote(v string) *LessonPackageCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableNote(v *string) *LessonPackageCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LessonPackageCreate) SetCreatedBy(v string) *LessonPackageCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LessonPackageCreate) SetNillableCreatedBy(v *string) *LessonPackageCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *LessonPackageCreate) SetStudent(v *Student) *LessonPackageCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *LessonPackageCreate) SetCourse(v *Course) *LessonPackageCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *LessonPackageCreate) SetInvoice(v *Invoice) *LessonPackageCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LessonPackageMutation object of the builder.
+func (_c *LessonPackageCreate) Mutation() *LessonPackageMutation {
+	return _c.mutation
+}
+
+// Save creates the LessonPackage in the database.
+func (_c *LessonPackageCreate) Save(ctx context.Context) (*LessonPackage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LessonPackageCreate) SaveX(ctx context.Context) *LessonPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LessonPackageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LessonPackageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LessonPackageCreate) defaults() {
+	if _, ok := _c.mutation.SoldAt(); !ok {
+		v := lessonpackage.DefaultSoldAt()
+		_c.mutation.SetSoldAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := lessonpackage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.UnusedLessons(); !ok {
+		v := lessonpackage.DefaultUnusedLessons
+		_c.mutation.SetUnusedLessons(v)
+	}
+	if _, ok := _c.mutation.RefundCents(); !ok {
+		v := lessonpackage.DefaultRefundCents
+		_c.mutation.SetRefundCents(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := lessonpackage.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := lessonpackage.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LessonPackageCreate) check() error {
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "LessonPackage.student_id"`)}
+	}
+	if _, ok := _c.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "LessonPackage.course_id"`)}
+	}
+	if _, ok := _c.mutation.Lessons(); !ok {
+		return &ValidationError{Name: "lessons", err: errors.New(`ent: missing required field "LessonPackage.lessons"`)}
+	}
+	if _, ok := _c.mutation.PriceCents(); !ok {
+		return &ValidationError{Name: "price_cents", err: errors.New(`ent: missing required field "LessonPackage.price_cents"`)}
+	}
+	if _, ok := _c.mutation.SoldAt(); !ok {
+		return &ValidationError{Name: "sold_at", err: errors.New(`ent: missing required field "LessonPackage.sold_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LessonPackage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := lessonpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LessonPackage.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnusedLessons(); !ok {
+		return &ValidationError{Name: "unused_lessons", err: errors.New(`ent: missing required field "LessonPackage.unused_lessons"`)}
+	}
+	if _, ok := _c.mutation.RefundCents(); !ok {
+		return &ValidationError{Name: "refund_cents", err: errors.New(`ent: missing required field "LessonPackage.refund_cents"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "LessonPackage.note"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "LessonPackage.created_by"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "LessonPackage.student"`)}
+	}
+	if len(_c.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "LessonPackage.course"`)}
+	}
+	return nil
+}
+
+func (_c *LessonPackageCreate) sqlSave(ctx context.Context) (*LessonPackage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LessonPackageCreate) createSpec() (*LessonPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LessonPackage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lessonpackage.Table, sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Lessons(); ok {
+		_spec.SetField(lessonpackage.FieldLessons, field.TypeFloat64, value)
+		_node.Lessons = value
+	}
+	if value, ok := _c.mutation.PriceCents(); ok {
+		_spec.SetField(lessonpackage.FieldPriceCents, field.TypeInt64, value)
+		_node.PriceCents = value
+	}
+	if value, ok := _c.mutation.SoldAt(); ok {
+		_spec.SetField(lessonpackage.FieldSoldAt, field.TypeTime, value)
+		_node.SoldAt = value
+	}
+	if value, ok := _c.mutation.ExpiresOn(); ok {
+		_spec.SetField(lessonpackage.FieldExpiresOn, field.TypeTime, value)
+		_node.ExpiresOn = &value
+	}
+	if value, ok := _c.mutation.CarriedFromID(); ok {
+		_spec.SetField(lessonpackage.FieldCarriedFromID, field.TypeInt, value)
+		_node.CarriedFromID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(lessonpackage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(lessonpackage.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.UnusedLessons(); ok {
+		_spec.SetField(lessonpackage.FieldUnusedLessons, field.TypeFloat64, value)
+		_node.UnusedLessons = value
+	}
+	if value, ok := _c.mutation.RefundCents(); ok {
+		_spec.SetField(lessonpackage.FieldRefundCents, field.TypeInt64, value)
+		_node.RefundCents = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(lessonpackage.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(lessonpackage.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.StudentTable,
+			Columns: []string{lessonpackage.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.CourseTable,
+			Columns: []string{lessonpackage.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.InvoiceTable,
+			Columns: []string{lessonpackage.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LessonPackageCreateBulk is the builder for creating many LessonPackage entities in bulk.
+type LessonPackageCreateBulk struct {
+	config
+	err      error
+	builders []*LessonPackageCreate
+}
+
+// Save creates the LessonPackage entities in the database.
+func (_c *LessonPackageCreateBulk) Save(ctx context.Context) ([]*LessonPackage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LessonPackage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LessonPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LessonPackageCreateBulk) SaveX(ctx context.Context) []*LessonPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LessonPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LessonPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
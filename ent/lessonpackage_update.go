@@ -0,0 +1,1081 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"langschool/ent/lessonpackage"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LessonPackageUpdate is the builder for updating LessonPackage entities.
+type LessonPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LessonPackageMutation
+}
+
+// Where appends a list predicates to the LessonPackageUpdate builder.
+func (_u *LessonPackageUpdate) Where(ps ...predicate.LessonPackage) *LessonPackageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LessonPackageUpdate) SetStudentID(v int) *LessonPackageUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableStudentID(v *int) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *LessonPackageUpdate) SetCourseID(v int) *LessonPackageUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableCourseID(v *int) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetLessons sets the "lessons" field.
+func (_u *LessonPackageUpdate) SetLessons(v float64) *LessonPackageUpdate {
+	_u.mutation.ResetLessons()
+	_u.mutation.SetLessons(v)
+	return _u
+}
+
+// SetNillableLessons sets the "lessons" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableLessons(v *float64) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetLessons(*v)
+	}
+	return _u
+}
+
+// AddLessons adds value to the "lessons" field.
+func (_u *LessonPackageUpdate) AddLessons(v float64) *LessonPackageUpdate {
+	_u.mutation.AddLessons(v)
+	return _u
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (_u *LessonPackageUpdate) SetPriceCents(v int64) *LessonPackageUpdate {
+	_u.mutation.ResetPriceCents()
+	_u.mutation.SetPriceCents(v)
+	return _u
+}
+
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillablePriceCents(v *int64) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetPriceCents(*v)
+	}
+	return _u
+}
+
+// AddPriceCents adds value to the "price_cents" field.
+func (_u *LessonPackageUpdate) AddPriceCents(v int64) *LessonPackageUpdate {
+	_u.mutation.AddPriceCents(v)
+	return _u
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (_u *LessonPackageUpdate) SetSoldAt(v time.Time) *LessonPackageUpdate {
+	_u.mutation.SetSoldAt(v)
+	return _u
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableSoldAt(v *time.Time) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetSoldAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresOn sets the "expires_on" field.
+func (_u *LessonPackageUpdate) SetExpiresOn(v time.Time) *LessonPackageUpdate {
+	_u.mutation.SetExpiresOn(v)
+	return _u
+}
+
+// SetNillableExpiresOn sets the "expires_on" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableExpiresOn(v *time.Time) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetExpiresOn(*v)
+	}
+	return _u
+}
+
+// ClearExpiresOn clears the value of the "expires_on" field.
+func (_u *LessonPackageUpdate) ClearExpiresOn() *LessonPackageUpdate {
+	_u.mutation.ClearExpiresOn()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *LessonPackageUpdate) SetInvoiceID(v int) *LessonPackageUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableInvoiceID(v *int) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *LessonPackageUpdate) ClearInvoiceID() *LessonPackageUpdate {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetCarriedFromID sets the "carried_from_id" field.
+func (_u *LessonPackageUpdate) SetCarriedFromID(v int) *LessonPackageUpdate {
+	_u.mutation.ResetCarriedFromID()
+	_u.mutation.SetCarriedFromID(v)
+	return _u
+}
+
+// SetNillableCarriedFromID sets the "carried_from_id" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableCarriedFromID(v *int) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetCarriedFromID(*v)
+	}
+	return _u
+}
+
+// AddCarriedFromID adds value to the "carried_from_id" field.
+func (_u *LessonPackageUpdate) AddCarriedFromID(v int) *LessonPackageUpdate {
+	_u.mutation.AddCarriedFromID(v)
+	return _u
+}
+
+// ClearCarriedFromID clears the value of the "carried_from_id" field.
+func (_u *LessonPackageUpdate) ClearCarriedFromID() *LessonPackageUpdate {
+	_u.mutation.ClearCarriedFromID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LessonPackageUpdate) SetStatus(v lessonpackage.Status) *LessonPackageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableStatus(v *lessonpackage.Status) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *LessonPackageUpdate) SetClosedAt(v time.Time) *LessonPackageUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableClosedAt(v *time.Time) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *LessonPackageUpdate) ClearClosedAt() *LessonPackageUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUnusedLessons sets the "unused_lessons" field.
+func (_u *LessonPackageUpdate) SetUnusedLessons(v float64) *LessonPackageUpdate {
+	_u.mutation.ResetUnusedLessons()
+	_u.mutation.SetUnusedLessons(v)
+	return _u
+}
+
+// SetNillableUnusedLessons sets the "unused_lessons" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableUnusedLessons(v *float64) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetUnusedLessons(*v)
+	}
+	return _u
+}
+
+// AddUnusedLessons adds value to the "unused_lessons" field.
+func (_u *LessonPackageUpdate) AddUnusedLessons(v float64) *LessonPackageUpdate {
+	_u.mutation.AddUnusedLessons(v)
+	return _u
+}
+
+// SetRefundCents sets the "refund_cents" field.
+func (_u *LessonPackageUpdate) SetRefundCents(v int64) *LessonPackageUpdate {
+	_u.mutation.ResetRefundCents()
+	_u.mutation.SetRefundCents(v)
+	return _u
+}
+
+// SetNillableRefundCents sets the "refund_cents" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableRefundCents(v *int64) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetRefundCents(*v)
+	}
+	return _u
+}
+
+// AddRefundCents adds value to the "refund_cents" field.
+func (_u *LessonPackageUpdate) AddRefundCents(v int64) *LessonPackageUpdate {
+	_u.mutation.AddRefundCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LessonPackageUpdate) SetNote(v string) *LessonPackageUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableNote(v *string) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LessonPackageUpdate) SetCreatedBy(v string) *LessonPackageUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LessonPackageUpdate) SetNillableCreatedBy(v *string) *LessonPackageUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LessonPackageUpdate) SetStudent(v *Student) *LessonPackageUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *LessonPackageUpdate) SetCourse(v *Course) *LessonPackageUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *LessonPackageUpdate) SetInvoice(v *Invoice) *LessonPackageUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LessonPackageMutation object of the builder.
+func (_u *LessonPackageUpdate) Mutation() *LessonPackageMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LessonPackageUpdate) ClearStudent() *LessonPackageUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *LessonPackageUpdate) ClearCourse() *LessonPackageUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *LessonPackageUpdate) ClearInvoice() *LessonPackageUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LessonPackageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LessonPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LessonPackageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LessonPackageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LessonPackageUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := lessonpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LessonPackage.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LessonPackage.student"`)
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LessonPackage.course"`)
+	}
+	return nil
+}
+
+func (_u *LessonPackageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lessonpackage.Table, lessonpackage.Columns, sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Lessons(); ok {
+		_spec.SetField(lessonpackage.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLessons(); ok {
+		_spec.AddField(lessonpackage.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PriceCents(); ok {
+		_spec.SetField(lessonpackage.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceCents(); ok {
+		_spec.AddField(lessonpackage.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SoldAt(); ok {
+		_spec.SetField(lessonpackage.FieldSoldAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresOn(); ok {
+		_spec.SetField(lessonpackage.FieldExpiresOn, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresOnCleared() {
+		_spec.ClearField(lessonpackage.FieldExpiresOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CarriedFromID(); ok {
+		_spec.SetField(lessonpackage.FieldCarriedFromID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCarriedFromID(); ok {
+		_spec.AddField(lessonpackage.FieldCarriedFromID, field.TypeInt, value)
+	}
+	if _u.mutation.CarriedFromIDCleared() {
+		_spec.ClearField(lessonpackage.FieldCarriedFromID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(lessonpackage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(lessonpackage.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(lessonpackage.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnusedLessons(); ok {
+		_spec.SetField(lessonpackage.FieldUnusedLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnusedLessons(); ok {
+		_spec.AddField(lessonpackage.FieldUnusedLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RefundCents(); ok {
+		_spec.SetField(lessonpackage.FieldRefundCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundCents(); ok {
+		_spec.AddField(lessonpackage.FieldRefundCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(lessonpackage.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(lessonpackage.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.StudentTable,
+			Columns: []string{lessonpackage.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.StudentTable,
+			Columns: []string{lessonpackage.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.CourseTable,
+			Columns: []string{lessonpackage.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.CourseTable,
+			Columns: []string{lessonpackage.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.InvoiceTable,
+			Columns: []string{lessonpackage.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.InvoiceTable,
+			Columns: []string{lessonpackage.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lessonpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LessonPackageUpdateOne is the builder for updating a single LessonPackage entity.
+type LessonPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LessonPackageMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *LessonPackageUpdateOne) SetStudentID(v int) *LessonPackageUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableStudentID(v *int) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *LessonPackageUpdateOne) SetCourseID(v int) *LessonPackageUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableCourseID(v *int) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetLessons sets the "lessons" field.
+func (_u *LessonPackageUpdateOne) SetLessons(v float64) *LessonPackageUpdateOne {
+	_u.mutation.ResetLessons()
+	_u.mutation.SetLessons(v)
+	return _u
+}
+
+// SetNillableLessons sets the "lessons" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableLessons(v *float64) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetLessons(*v)
+	}
+	return _u
+}
+
+// AddLessons adds value to the "lessons" field.
+func (_u *LessonPackageUpdateOne) AddLessons(v float64) *LessonPackageUpdateOne {
+	_u.mutation.AddLessons(v)
+	return _u
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (_u *LessonPackageUpdateOne) SetPriceCents(v int64) *LessonPackageUpdateOne {
+	_u.mutation.ResetPriceCents()
+	_u.mutation.SetPriceCents(v)
+	return _u
+}
+
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillablePriceCents(v *int64) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetPriceCents(*v)
+	}
+	return _u
+}
+
+// AddPriceCents adds value to the "price_cents" field.
+func (_u *LessonPackageUpdateOne) AddPriceCents(v int64) *LessonPackageUpdateOne {
+	_u.mutation.AddPriceCents(v)
+	return _u
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (_u *LessonPackageUpdateOne) SetSoldAt(v time.Time) *LessonPackageUpdateOne {
+	_u.mutation.SetSoldAt(v)
+	return _u
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableSoldAt(v *time.Time) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetSoldAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresOn sets the "expires_on" field.
+func (_u *LessonPackageUpdateOne) SetExpiresOn(v time.Time) *LessonPackageUpdateOne {
+	_u.mutation.SetExpiresOn(v)
+	return _u
+}
+
+// SetNillableExpiresOn sets the "expires_on" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableExpiresOn(v *time.Time) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetExpiresOn(*v)
+	}
+	return _u
+}
+
+// ClearExpiresOn clears the value of the "expires_on" field.
+func (_u *LessonPackageUpdateOne) ClearExpiresOn() *LessonPackageUpdateOne {
+	_u.mutation.ClearExpiresOn()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *LessonPackageUpdateOne) SetInvoiceID(v int) *LessonPackageUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableInvoiceID(v *int) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *LessonPackageUpdateOne) ClearInvoiceID() *LessonPackageUpdateOne {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetCarriedFromID sets the "carried_from_id" field.
+func (_u *LessonPackageUpdateOne) SetCarriedFromID(v int) *LessonPackageUpdateOne {
+	_u.mutation.ResetCarriedFromID()
+	_u.mutation.SetCarriedFromID(v)
+	return _u
+}
+
+// SetNillableCarriedFromID sets the "carried_from_id" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableCarriedFromID(v *int) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetCarriedFromID(*v)
+	}
+	return _u
+}
+
+// AddCarriedFromID adds value to the "carried_from_id" field.
+func (_u *LessonPackageUpdateOne) AddCarriedFromID(v int) *LessonPackageUpdateOne {
+	_u.mutation.AddCarriedFromID(v)
+	return _u
+}
+
+// ClearCarriedFromID clears the value of the "carried_from_id" field.
+func (_u *LessonPackageUpdateOne) ClearCarriedFromID() *LessonPackageUpdateOne {
+	_u.mutation.ClearCarriedFromID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LessonPackageUpdateOne) SetStatus(v lessonpackage.Status) *LessonPackageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableStatus(v *lessonpackage.Status) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *LessonPackageUpdateOne) SetClosedAt(v time.Time) *LessonPackageUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableClosedAt(v *time.Time) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *LessonPackageUpdateOne) ClearClosedAt() *LessonPackageUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUnusedLessons sets the "unused_lessons" field.
+func (_u *LessonPackageUpdateOne) SetUnusedLessons(v float64) *LessonPackageUpdateOne {
+	_u.mutation.ResetUnusedLessons()
+	_u.mutation.SetUnusedLessons(v)
+	return _u
+}
+
+// SetNillableUnusedLessons sets the "unused_lessons" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableUnusedLessons(v *float64) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetUnusedLessons(*v)
+	}
+	return _u
+}
+
+// AddUnusedLessons adds value to the "unused_lessons" field.
+func (_u *LessonPackageUpdateOne) AddUnusedLessons(v float64) *LessonPackageUpdateOne {
+	_u.mutation.AddUnusedLessons(v)
+	return _u
+}
+
+// SetRefundCents sets the "refund_cents" field.
+func (_u *LessonPackageUpdateOne) SetRefundCents(v int64) *LessonPackageUpdateOne {
+	_u.mutation.ResetRefundCents()
+	_u.mutation.SetRefundCents(v)
+	return _u
+}
+
+// SetNillableRefundCents sets the "refund_cents" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableRefundCents(v *int64) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetRefundCents(*v)
+	}
+	return _u
+}
+
+// AddRefundCents adds value to the "refund_cents" field.
+func (_u *LessonPackageUpdateOne) AddRefundCents(v int64) *LessonPackageUpdateOne {
+	_u.mutation.AddRefundCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LessonPackageUpdateOne) SetNote(v string) *LessonPackageUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableNote(v *string) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LessonPackageUpdateOne) SetCreatedBy(v string) *LessonPackageUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LessonPackageUpdateOne) SetNillableCreatedBy(v *string) *LessonPackageUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *LessonPackageUpdateOne) SetStudent(v *Student) *LessonPackageUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *LessonPackageUpdateOne) SetCourse(v *Course) *LessonPackageUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *LessonPackageUpdateOne) SetInvoice(v *Invoice) *LessonPackageUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the LessonPackageMutation object of the builder.
+func (_u *LessonPackageUpdateOne) Mutation() *LessonPackageMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *LessonPackageUpdateOne) ClearStudent() *LessonPackageUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *LessonPackageUpdateOne) ClearCourse() *LessonPackageUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *LessonPackageUpdateOne) ClearInvoice() *LessonPackageUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Where appends a list predicates to the LessonPackageUpdate builder.
+func (_u *LessonPackageUpdateOne) Where(ps ...predicate.LessonPackage) *LessonPackageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LessonPackageUpdateOne) Select(field string, fields ...string) *LessonPackageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LessonPackage entity.
+func (_u *LessonPackageUpdateOne) Save(ctx context.Context) (*LessonPackage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LessonPackageUpdateOne) SaveX(ctx context.Context) *LessonPackage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LessonPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LessonPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LessonPackageUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := lessonpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LessonPackage.status": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LessonPackage.student"`)
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LessonPackage.course"`)
+	}
+	return nil
+}
+
+func (_u *LessonPackageUpdateOne) sqlSave(ctx context.Context) (_node *LessonPackage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lessonpackage.Table, lessonpackage.Columns, sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LessonPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lessonpackage.FieldID)
+		for _, f := range fields {
+			if !lessonpackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lessonpackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Lessons(); ok {
+		_spec.SetField(lessonpackage.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLessons(); ok {
+		_spec.AddField(lessonpackage.FieldLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PriceCents(); ok {
+		_spec.SetField(lessonpackage.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceCents(); ok {
+		_spec.AddField(lessonpackage.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SoldAt(); ok {
+		_spec.SetField(lessonpackage.FieldSoldAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresOn(); ok {
+		_spec.SetField(lessonpackage.FieldExpiresOn, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresOnCleared() {
+		_spec.ClearField(lessonpackage.FieldExpiresOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CarriedFromID(); ok {
+		_spec.SetField(lessonpackage.FieldCarriedFromID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCarriedFromID(); ok {
+		_spec.AddField(lessonpackage.FieldCarriedFromID, field.TypeInt, value)
+	}
+	if _u.mutation.CarriedFromIDCleared() {
+		_spec.ClearField(lessonpackage.FieldCarriedFromID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(lessonpackage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(lessonpackage.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(lessonpackage.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnusedLessons(); ok {
+		_spec.SetField(lessonpackage.FieldUnusedLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnusedLessons(); ok {
+		_spec.AddField(lessonpackage.FieldUnusedLessons, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RefundCents(); ok {
+		_spec.SetField(lessonpackage.FieldRefundCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundCents(); ok {
+		_spec.AddField(lessonpackage.FieldRefundCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(lessonpackage.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(lessonpackage.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.StudentTable,
+			Columns: []string{lessonpackage.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.StudentTable,
+			Columns: []string{lessonpackage.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.CourseTable,
+			Columns: []string{lessonpackage.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.CourseTable,
+			Columns: []string{lessonpackage.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.InvoiceTable,
+			Columns: []string{lessonpackage.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lessonpackage.InvoiceTable,
+			Columns: []string{lessonpackage.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LessonPackage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lessonpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -91,6 +92,141 @@ var (
 			},
 		},
 	}
+	// BillingTermsColumns holds the columns for the "billing_terms" table.
+	BillingTermsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "start_year", Type: field.TypeInt},
+		{Name: "start_month", Type: field.TypeInt},
+		{Name: "end_year", Type: field.TypeInt},
+		{Name: "end_month", Type: field.TypeInt},
+		{Name: "lessons", Type: field.TypeFloat64},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BillingTermsTable holds the schema information for the "billing_terms" table.
+	BillingTermsTable = &schema.Table{
+		Name:       "billing_terms",
+		Columns:    BillingTermsColumns,
+		PrimaryKey: []*schema.Column{BillingTermsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "billing_terms_courses_billing_terms",
+				Columns:    []*schema.Column{BillingTermsColumns[9]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CashMovementsColumns holds the columns for the "cash_movements" table.
+	CashMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"withdrawal", "bank_deposit", "refund"}},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "payment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "session_id", Type: field.TypeInt},
+	}
+	// CashMovementsTable holds the schema information for the "cash_movements" table.
+	CashMovementsTable = &schema.Table{
+		Name:       "cash_movements",
+		Columns:    CashMovementsColumns,
+		PrimaryKey: []*schema.Column{CashMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cash_movements_cash_sessions_movements",
+				Columns:    []*schema.Column{CashMovementsColumns[8]},
+				RefColumns: []*schema.Column{CashSessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CashReceiptsColumns holds the columns for the "cash_receipts" table.
+	CashReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seq", Type: field.TypeInt, Unique: true},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "paid_at", Type: field.TypeTime},
+		{Name: "received_by_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "received_by", Type: field.TypeString, Default: ""},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"issued", "voided"}, Default: "issued"},
+		{Name: "voided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "voided_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "cash_session_id", Type: field.TypeInt, Nullable: true},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// CashReceiptsTable holds the schema information for the "cash_receipts" table.
+	CashReceiptsTable = &schema.Table{
+		Name:       "cash_receipts",
+		Columns:    CashReceiptsColumns,
+		PrimaryKey: []*schema.Column{CashReceiptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cash_receipts_cash_sessions_receipts",
+				Columns:    []*schema.Column{CashReceiptsColumns[12]},
+				RefColumns: []*schema.Column{CashSessionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cash_receipts_students_cash_receipts",
+				Columns:    []*schema.Column{CashReceiptsColumns[13]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cashreceipt_student_id_paid_at",
+				Unique:  false,
+				Columns: []*schema.Column{CashReceiptsColumns[13], CashReceiptsColumns[4]},
+			},
+			{
+				Name:    "cashreceipt_cash_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{CashReceiptsColumns[12]},
+			},
+		},
+	}
+	// CashSessionsColumns holds the columns for the "cash_sessions" table.
+	CashSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "opened_by_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "opened_by", Type: field.TypeString, Default: ""},
+		{Name: "opening_float_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "closed"}, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_by", Type: field.TypeString, Default: ""},
+		{Name: "counted_cents", Type: field.TypeInt64, Nullable: true},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "reopened_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reopened_by", Type: field.TypeString, Default: ""},
+	}
+	// CashSessionsTable holds the schema information for the "cash_sessions" table.
+	CashSessionsTable = &schema.Table{
+		Name:       "cash_sessions",
+		Columns:    CashSessionsColumns,
+		PrimaryKey: []*schema.Column{CashSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cashsession_status",
+				Unique:  false,
+				Columns: []*schema.Column{CashSessionsColumns[5]},
+			},
+			{
+				Name:    "cashsession_opened_at",
+				Unique:  false,
+				Columns: []*schema.Column{CashSessionsColumns[1]},
+			},
+		},
+	}
 	// CoursesColumns holds the columns for the "courses" table.
 	CoursesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -102,7 +238,11 @@ var (
 		{Name: "subscription_price", Type: field.TypeFloat64, Default: 0},
 		{Name: "lesson_price_cents", Type: field.TypeInt64, Default: 0},
 		{Name: "subscription_price_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "vat_rate_pct", Type: field.TypeFloat64, Default: 0},
+		{Name: "vat_exempt_note", Type: field.TypeString, Default: ""},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "billing_period", Type: field.TypeEnum, Enums: []string{"monthly", "term", "custom"}, Default: "monthly"},
+		{Name: "max_students", Type: field.TypeInt, Default: 0},
 		{Name: "teacher_id", Type: field.TypeInt, Nullable: true},
 	}
 	// CoursesTable holds the schema information for the "courses" table.
@@ -113,7 +253,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "courses_teachers_courses",
-				Columns:    []*schema.Column{CoursesColumns[10]},
+				Columns:    []*schema.Column{CoursesColumns[14]},
 				RefColumns: []*schema.Column{TeachersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -148,16 +288,50 @@ var (
 			},
 		},
 	}
+	// CoursePricesColumns holds the columns for the "course_prices" table.
+	CoursePricesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "effective_year", Type: field.TypeInt, Nullable: true},
+		{Name: "effective_month", Type: field.TypeInt, Nullable: true},
+		{Name: "lesson_price_cents", Type: field.TypeInt64},
+		{Name: "subscription_price_cents", Type: field.TypeInt64},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt},
+	}
+	// CoursePricesTable holds the schema information for the "course_prices" table.
+	CoursePricesTable = &schema.Table{
+		Name:       "course_prices",
+		Columns:    CoursePricesColumns,
+		PrimaryKey: []*schema.Column{CoursePricesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "course_prices_courses_prices",
+				Columns:    []*schema.Column{CoursePricesColumns[7]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "courseprice_course_id_effective_year_effective_month",
+				Unique:  true,
+				Columns: []*schema.Column{CoursePricesColumns[7], CoursePricesColumns[1], CoursePricesColumns[2]},
+			},
+		},
+	}
 	// EnrollmentsColumns holds the columns for the "enrollments" table.
 	EnrollmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "version", Type: field.TypeInt, Default: 1},
-		{Name: "billing_mode", Type: field.TypeEnum, Enums: []string{"subscription", "per_lesson"}},
+		{Name: "billing_mode", Type: field.TypeEnum, Enums: []string{"subscription", "per_lesson", "package"}},
 		{Name: "charge_materials", Type: field.TypeBool, Default: true},
 		{Name: "discount_pct", Type: field.TypeFloat64, Default: 0},
 		{Name: "lesson_price_override_cents", Type: field.TypeInt64, Default: -1},
 		{Name: "subscription_lesson_price_cents", Type: field.TypeInt64, Default: -1},
 		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "starts_on", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_on", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "course_id", Type: field.TypeInt},
@@ -171,13 +345,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "enrollments_courses_enrollments",
-				Columns:    []*schema.Column{EnrollmentsColumns[10]},
+				Columns:    []*schema.Column{EnrollmentsColumns[12]},
 				RefColumns: []*schema.Column{CoursesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "enrollments_students_enrollments",
-				Columns:    []*schema.Column{EnrollmentsColumns[11]},
+				Columns:    []*schema.Column{EnrollmentsColumns[13]},
 				RefColumns: []*schema.Column{StudentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -186,7 +360,142 @@ var (
 			{
 				Name:    "enrollment_student_id_course_id",
 				Unique:  true,
-				Columns: []*schema.Column{EnrollmentsColumns[11], EnrollmentsColumns[10]},
+				Columns: []*schema.Column{EnrollmentsColumns[13], EnrollmentsColumns[12]},
+			},
+		},
+	}
+	// EnrollmentPausesColumns holds the columns for the "enrollment_pauses" table.
+	EnrollmentPausesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "starts_on", Type: field.TypeTime},
+		{Name: "ends_on", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "enrollment_id", Type: field.TypeInt},
+	}
+	// EnrollmentPausesTable holds the schema information for the "enrollment_pauses" table.
+	EnrollmentPausesTable = &schema.Table{
+		Name:       "enrollment_pauses",
+		Columns:    EnrollmentPausesColumns,
+		PrimaryKey: []*schema.Column{EnrollmentPausesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "enrollment_pauses_enrollments_pauses",
+				Columns:    []*schema.Column{EnrollmentPausesColumns[6]},
+				RefColumns: []*schema.Column{EnrollmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "enrollmentpause_enrollment_id_starts_on",
+				Unique:  false,
+				Columns: []*schema.Column{EnrollmentPausesColumns[6], EnrollmentPausesColumns[1]},
+			},
+		},
+	}
+	// EnrollmentPricesColumns holds the columns for the "enrollment_prices" table.
+	EnrollmentPricesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "effective_year", Type: field.TypeInt},
+		{Name: "effective_month", Type: field.TypeInt},
+		{Name: "lesson_price_override_cents", Type: field.TypeInt64},
+		{Name: "subscription_lesson_price_cents", Type: field.TypeInt64},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "enrollment_id", Type: field.TypeInt},
+	}
+	// EnrollmentPricesTable holds the schema information for the "enrollment_prices" table.
+	EnrollmentPricesTable = &schema.Table{
+		Name:       "enrollment_prices",
+		Columns:    EnrollmentPricesColumns,
+		PrimaryKey: []*schema.Column{EnrollmentPricesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "enrollment_prices_enrollments_prices",
+				Columns:    []*schema.Column{EnrollmentPricesColumns[7]},
+				RefColumns: []*schema.Column{EnrollmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "enrollmentprice_enrollment_id_effective_year_effective_month",
+				Unique:  true,
+				Columns: []*schema.Column{EnrollmentPricesColumns[7], EnrollmentPricesColumns[1], EnrollmentPricesColumns[2]},
+			},
+		},
+	}
+	// ExcusedAbsencesColumns holds the columns for the "excused_absences" table.
+	ExcusedAbsencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "lesson_date", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "resolution", Type: field.TypeEnum, Enums: []string{"makeup", "credit"}},
+		{Name: "makeup_due_on", Type: field.TypeTime, Nullable: true},
+		{Name: "makeup_course_id", Type: field.TypeInt, Nullable: true},
+		{Name: "made_up_on", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// ExcusedAbsencesTable holds the schema information for the "excused_absences" table.
+	ExcusedAbsencesTable = &schema.Table{
+		Name:       "excused_absences",
+		Columns:    ExcusedAbsencesColumns,
+		PrimaryKey: []*schema.Column{ExcusedAbsencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "excused_absences_courses_excused_absences",
+				Columns:    []*schema.Column{ExcusedAbsencesColumns[9]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "excused_absences_students_excused_absences",
+				Columns:    []*schema.Column{ExcusedAbsencesColumns[10]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "excusedabsence_student_id_course_id_lesson_date",
+				Unique:  true,
+				Columns: []*schema.Column{ExcusedAbsencesColumns[10], ExcusedAbsencesColumns[9], ExcusedAbsencesColumns[1]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "key", Type: field.TypeString},
+		{Name: "method", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "content_type", Type: field.TypeString, Default: ""},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[9]},
 			},
 		},
 	}
@@ -198,8 +507,11 @@ var (
 		{Name: "period_month", Type: field.TypeInt},
 		{Name: "total_amount", Type: field.TypeFloat64, Default: 0},
 		{Name: "total_amount_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "vat_amount_cents", Type: field.TypeInt64, Default: 0},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "issued_pending_pdf", "issued", "paid_pending_pdf", "paid", "canceled"}, Default: "draft"},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"monthly", "package", "term"}, Default: "monthly"},
 		{Name: "number", Type: field.TypeString, Nullable: true},
+		{Name: "issued_at", Type: field.TypeTime, Nullable: true},
 		{Name: "pdf_filename", Type: field.TypeString, Nullable: true},
 		{Name: "pdf_generated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "pdf_revision", Type: field.TypeInt, Nullable: true},
@@ -211,6 +523,7 @@ var (
 		{Name: "last_email_failed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "billing_term_id", Type: field.TypeInt, Nullable: true},
 		{Name: "student_id", Type: field.TypeInt},
 	}
 	// InvoicesTable holds the schema information for the "invoices" table.
@@ -219,9 +532,15 @@ var (
 		Columns:    InvoicesColumns,
 		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_billing_terms_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[22]},
+				RefColumns: []*schema.Column{BillingTermsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "invoices_students_invoices",
-				Columns:    []*schema.Column{InvoicesColumns[19]},
+				Columns:    []*schema.Column{InvoicesColumns[23]},
 				RefColumns: []*schema.Column{StudentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -230,21 +549,37 @@ var (
 			{
 				Name:    "invoice_student_id_period_year_period_month",
 				Unique:  true,
-				Columns: []*schema.Column{InvoicesColumns[19], InvoicesColumns[2], InvoicesColumns[3]},
+				Columns: []*schema.Column{InvoicesColumns[23], InvoicesColumns[2], InvoicesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "kind = 'monthly'",
+				},
+			},
+			{
+				Name:    "invoice_student_id_billing_term_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[23], InvoicesColumns[22]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "kind = 'term'",
+				},
 			},
 		},
 	}
 	// InvoiceLinesColumns holds the columns for the "invoice_lines" table.
 	InvoiceLinesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "charge_year", Type: field.TypeInt, Default: 0},
 		{Name: "description", Type: field.TypeString},
 		{Name: "qty", Type: field.TypeFloat64},
 		{Name: "unit_price", Type: field.TypeFloat64, Default: 0},
 		{Name: "amount", Type: field.TypeFloat64, Default: 0},
 		{Name: "unit_price_cents", Type: field.TypeInt64, Default: 0},
 		{Name: "amount_cents", Type: field.TypeInt64, Default: 0},
-		{Name: "enrollment_id", Type: field.TypeInt},
+		{Name: "vat_rate_pct", Type: field.TypeFloat64, Default: 0},
+		{Name: "vat_exempt_note", Type: field.TypeString, Default: ""},
+		{Name: "enrollment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "absence_id", Type: field.TypeInt, Nullable: true},
 		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "charge_id", Type: field.TypeInt, Nullable: true},
 	}
 	// InvoiceLinesTable holds the schema information for the "invoice_lines" table.
 	InvoiceLinesTable = &schema.Table{
@@ -254,27 +589,205 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "invoice_lines_enrollments_invoice_lines",
-				Columns:    []*schema.Column{InvoiceLinesColumns[7]},
+				Columns:    []*schema.Column{InvoiceLinesColumns[10]},
 				RefColumns: []*schema.Column{EnrollmentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "invoice_lines_excused_absences_invoice_lines",
+				Columns:    []*schema.Column{InvoiceLinesColumns[11]},
+				RefColumns: []*schema.Column{ExcusedAbsencesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 			{
 				Symbol:     "invoice_lines_invoices_lines",
-				Columns:    []*schema.Column{InvoiceLinesColumns[8]},
+				Columns:    []*schema.Column{InvoiceLinesColumns[12]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "invoice_lines_student_charges_invoice_lines",
+				Columns:    []*schema.Column{InvoiceLinesColumns[13]},
+				RefColumns: []*schema.Column{StudentChargesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "invoiceline_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoiceLinesColumns[8]},
+				Columns: []*schema.Column{InvoiceLinesColumns[12]},
 			},
 			{
 				Name:    "invoiceline_enrollment_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoiceLinesColumns[7]},
+				Columns: []*schema.Column{InvoiceLinesColumns[10]},
+			},
+			{
+				Name:    "invoiceline_charge_id_charge_year",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[13], InvoiceLinesColumns[1]},
+			},
+			{
+				Name:    "invoiceline_absence_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[11]},
+			},
+		},
+	}
+	// LateFeesColumns holds the columns for the "late_fees" table.
+	LateFeesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "period_year", Type: field.TypeInt},
+		{Name: "period_month", Type: field.TypeInt},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "days_late", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"billed", "waived"}, Default: "billed"},
+		{Name: "waived_reason", Type: field.TypeString, Default: ""},
+		{Name: "waived_by", Type: field.TypeString, Default: ""},
+		{Name: "waived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// LateFeesTable holds the schema information for the "late_fees" table.
+	LateFeesTable = &schema.Table{
+		Name:       "late_fees",
+		Columns:    LateFeesColumns,
+		PrimaryKey: []*schema.Column{LateFeesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "late_fees_invoices_late_fees",
+				Columns:    []*schema.Column{LateFeesColumns[10]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "late_fees_students_late_fees",
+				Columns:    []*schema.Column{LateFeesColumns[11]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "latefee_invoice_id_period_year_period_month",
+				Unique:  true,
+				Columns: []*schema.Column{LateFeesColumns[10], LateFeesColumns[1], LateFeesColumns[2]},
+			},
+			{
+				Name:    "latefee_student_id_period_year_period_month",
+				Unique:  false,
+				Columns: []*schema.Column{LateFeesColumns[11], LateFeesColumns[1], LateFeesColumns[2]},
+			},
+		},
+	}
+	// LeadsColumns holds the columns for the "leads" table.
+	LeadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "full_name", Type: field.TypeString},
+		{Name: "personal_code", Type: field.TypeString, Default: ""},
+		{Name: "phone", Type: field.TypeString, Default: ""},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "is_minor", Type: field.TypeBool, Default: false},
+		{Name: "payer_name", Type: field.TypeString, Default: ""},
+		{Name: "payer_role", Type: field.TypeString, Default: ""},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"new", "trial_scheduled", "trial_done", "converted", "lost"}, Default: "new"},
+		{Name: "trial_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trial_price_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "trial_outcome", Type: field.TypeEnum, Nullable: true, Enums: []string{"attended", "no_show"}},
+		{Name: "outcome_note", Type: field.TypeString, Default: ""},
+		{Name: "lost_reason", Type: field.TypeString, Default: ""},
+		{Name: "converted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trial_charge_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt, Nullable: true},
+		{Name: "student_id", Type: field.TypeInt, Nullable: true},
+	}
+	// LeadsTable holds the schema information for the "leads" table.
+	LeadsTable = &schema.Table{
+		Name:       "leads",
+		Columns:    LeadsColumns,
+		PrimaryKey: []*schema.Column{LeadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leads_courses_leads",
+				Columns:    []*schema.Column{LeadsColumns[21]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "leads_students_leads",
+				Columns:    []*schema.Column{LeadsColumns[22]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lead_status",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[11]},
+			},
+			{
+				Name:    "lead_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[20]},
+			},
+		},
+	}
+	// LessonPackagesColumns holds the columns for the "lesson_packages" table.
+	LessonPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "lessons", Type: field.TypeFloat64},
+		{Name: "price_cents", Type: field.TypeInt64},
+		{Name: "sold_at", Type: field.TypeTime},
+		{Name: "expires_on", Type: field.TypeTime, Nullable: true},
+		{Name: "carried_from_id", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "carried_over", "refunded"}, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "unused_lessons", Type: field.TypeFloat64, Default: 0},
+		{Name: "refund_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "course_id", Type: field.TypeInt},
+		{Name: "invoice_id", Type: field.TypeInt, Nullable: true},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// LessonPackagesTable holds the schema information for the "lesson_packages" table.
+	LessonPackagesTable = &schema.Table{
+		Name:       "lesson_packages",
+		Columns:    LessonPackagesColumns,
+		PrimaryKey: []*schema.Column{LessonPackagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lesson_packages_courses_lesson_packages",
+				Columns:    []*schema.Column{LessonPackagesColumns[12]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "lesson_packages_invoices_lesson_packages",
+				Columns:    []*schema.Column{LessonPackagesColumns[13]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "lesson_packages_students_lesson_packages",
+				Columns:    []*schema.Column{LessonPackagesColumns[14]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lessonpackage_student_id_course_id",
+				Unique:  false,
+				Columns: []*schema.Column{LessonPackagesColumns[14], LessonPackagesColumns[12]},
 			},
 		},
 	}
@@ -287,6 +800,9 @@ var (
 		{Name: "method", Type: field.TypeEnum, Enums: []string{"cash", "bank"}},
 		{Name: "note", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"payment", "credit_applied", "refund", "transfer_in", "transfer_out", "lesson_credit"}, Default: "payment"},
+		{Name: "related_payment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "cash_receipt_id", Type: field.TypeInt, Nullable: true},
 		{Name: "invoice_id", Type: field.TypeInt, Nullable: true},
 		{Name: "student_id", Type: field.TypeInt},
 	}
@@ -296,15 +812,21 @@ var (
 		Columns:    PaymentsColumns,
 		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payments_cash_receipts_payments",
+				Columns:    []*schema.Column{PaymentsColumns[9]},
+				RefColumns: []*schema.Column{CashReceiptsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "payments_invoices_payments",
-				Columns:    []*schema.Column{PaymentsColumns[7]},
+				Columns:    []*schema.Column{PaymentsColumns[10]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "payments_students_payments",
-				Columns:    []*schema.Column{PaymentsColumns[8]},
+				Columns:    []*schema.Column{PaymentsColumns[11]},
 				RefColumns: []*schema.Column{StudentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -313,12 +835,153 @@ var (
 			{
 				Name:    "payment_student_id_paid_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentsColumns[8], PaymentsColumns[1]},
+				Columns: []*schema.Column{PaymentsColumns[11], PaymentsColumns[1]},
 			},
 			{
 				Name:    "payment_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentsColumns[7]},
+				Columns: []*schema.Column{PaymentsColumns[10]},
+			},
+			{
+				Name:    "payment_cash_receipt_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentsColumns[9]},
+			},
+			{
+				Name:    "payment_related_payment_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentsColumns[8]},
+			},
+		},
+	}
+	// PaymentPlansColumns holds the columns for the "payment_plans" table.
+	PaymentPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "total_cents", Type: field.TypeInt64},
+		{Name: "baseline_paid_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "canceled_by", Type: field.TypeString, Default: ""},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// PaymentPlansTable holds the schema information for the "payment_plans" table.
+	PaymentPlansTable = &schema.Table{
+		Name:       "payment_plans",
+		Columns:    PaymentPlansColumns,
+		PrimaryKey: []*schema.Column{PaymentPlansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_plans_students_payment_plans",
+				Columns:    []*schema.Column{PaymentPlansColumns[8]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentplan_student_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentPlansColumns[8]},
+			},
+		},
+	}
+	// PaymentPlanInstalmentsColumns holds the columns for the "payment_plan_instalments" table.
+	PaymentPlanInstalmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seq", Type: field.TypeInt},
+		{Name: "due_on", Type: field.TypeTime},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "plan_id", Type: field.TypeInt},
+	}
+	// PaymentPlanInstalmentsTable holds the schema information for the "payment_plan_instalments" table.
+	PaymentPlanInstalmentsTable = &schema.Table{
+		Name:       "payment_plan_instalments",
+		Columns:    PaymentPlanInstalmentsColumns,
+		PrimaryKey: []*schema.Column{PaymentPlanInstalmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_plan_instalments_payment_plans_instalments",
+				Columns:    []*schema.Column{PaymentPlanInstalmentsColumns[4]},
+				RefColumns: []*schema.Column{PaymentPlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentplaninstalment_plan_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentPlanInstalmentsColumns[4], PaymentPlanInstalmentsColumns[1]},
+			},
+		},
+	}
+	// RoomsColumns holds the columns for the "rooms" table.
+	RoomsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "capacity", Type: field.TypeInt, Default: 0},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RoomsTable holds the schema information for the "rooms" table.
+	RoomsTable = &schema.Table{
+		Name:       "rooms",
+		Columns:    RoomsColumns,
+		PrimaryKey: []*schema.Column{RoomsColumns[0]},
+	}
+	// ScheduleSlotsColumns holds the columns for the "schedule_slots" table.
+	ScheduleSlotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "weekday", Type: field.TypeInt},
+		{Name: "start_minute", Type: field.TypeInt},
+		{Name: "end_minute", Type: field.TypeInt},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt},
+		{Name: "room_id", Type: field.TypeInt, Nullable: true},
+		{Name: "teacher_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ScheduleSlotsTable holds the schema information for the "schedule_slots" table.
+	ScheduleSlotsTable = &schema.Table{
+		Name:       "schedule_slots",
+		Columns:    ScheduleSlotsColumns,
+		PrimaryKey: []*schema.Column{ScheduleSlotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_slots_courses_schedule_slots",
+				Columns:    []*schema.Column{ScheduleSlotsColumns[10]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "schedule_slots_rooms_schedule_slots",
+				Columns:    []*schema.Column{ScheduleSlotsColumns[11]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "schedule_slots_teachers_schedule_slots",
+				Columns:    []*schema.Column{ScheduleSlotsColumns[12]},
+				RefColumns: []*schema.Column{TeachersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduleslot_weekday",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleSlotsColumns[2]},
+			},
+			{
+				Name:    "scheduleslot_room_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleSlotsColumns[11]},
 			},
 		},
 	}
@@ -336,7 +999,22 @@ var (
 		{Name: "invoice_email_subject_template", Type: field.TypeString, Default: ""},
 		{Name: "invoice_email_body_template", Type: field.TypeString, Default: ""},
 		{Name: "invoice_reply_to", Type: field.TypeString, Default: ""},
+		{Name: "bank_beneficiary_name", Type: field.TypeString, Default: ""},
+		{Name: "bank_name", Type: field.TypeString, Default: ""},
+		{Name: "bank_bic", Type: field.TypeString, Default: ""},
+		{Name: "bank_iban", Type: field.TypeString, Default: ""},
+		{Name: "invoice_payment_qr_enabled", Type: field.TypeBool, Default: true},
+		{Name: "vat_enabled", Type: field.TypeBool, Default: false},
+		{Name: "vat_number", Type: field.TypeString, Default: ""},
 		{Name: "money_cents_migrated", Type: field.TypeBool, Default: false},
+		{Name: "late_fee_mode", Type: field.TypeEnum, Enums: []string{"off", "flat", "daily_interest"}, Default: "off"},
+		{Name: "late_fee_flat_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "late_fee_daily_rate_pct", Type: field.TypeFloat64, Default: 0},
+		{Name: "late_fee_grace_days", Type: field.TypeInt, Default: 0},
+		{Name: "late_fee_cap_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "excused_absence_policy", Type: field.TypeEnum, Enums: []string{"makeup", "credit"}, Default: "credit"},
+		{Name: "makeup_window_weeks", Type: field.TypeInt, Default: 4},
+		{Name: "waitlist_offer_days", Type: field.TypeInt, Default: 0},
 	}
 	// SettingsTable holds the schema information for the "settings" table.
 	SettingsTable = &schema.Table{
@@ -357,6 +1035,13 @@ var (
 		{Name: "is_minor", Type: field.TypeBool, Default: false},
 		{Name: "payer_name", Type: field.TypeString, Default: ""},
 		{Name: "payer_role", Type: field.TypeString, Default: ""},
+		{Name: "payer_personal_code", Type: field.TypeString, Default: ""},
+		{Name: "payer_type", Type: field.TypeEnum, Enums: []string{"person", "company"}, Default: "person"},
+		{Name: "payer_company_name", Type: field.TypeString, Default: ""},
+		{Name: "payer_reg_no", Type: field.TypeString, Default: ""},
+		{Name: "payer_vat_number", Type: field.TypeString, Default: ""},
+		{Name: "payer_legal_address", Type: field.TypeString, Default: ""},
+		{Name: "payer_billing_email", Type: field.TypeString, Default: ""},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 	}
 	// StudentsTable holds the schema information for the "students" table.
@@ -365,6 +1050,42 @@ var (
 		Columns:    StudentsColumns,
 		PrimaryKey: []*schema.Column{StudentsColumns[0]},
 	}
+	// StudentChargesColumns holds the columns for the "student_charges" table.
+	StudentChargesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"one_off", "yearly"}},
+		{Name: "description", Type: field.TypeString},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "vat_rate_pct", Type: field.TypeFloat64, Default: 0},
+		{Name: "due_year", Type: field.TypeInt},
+		{Name: "due_month", Type: field.TypeInt},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "canceled_by", Type: field.TypeString, Default: ""},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// StudentChargesTable holds the schema information for the "student_charges" table.
+	StudentChargesTable = &schema.Table{
+		Name:       "student_charges",
+		Columns:    StudentChargesColumns,
+		PrimaryKey: []*schema.Column{StudentChargesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "student_charges_students_charges",
+				Columns:    []*schema.Column{StudentChargesColumns[11]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "studentcharge_student_id",
+				Unique:  false,
+				Columns: []*schema.Column{StudentChargesColumns[11]},
+			},
+		},
+	}
 	// TeachersColumns holds the columns for the "teachers" table.
 	TeachersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -394,6 +1115,54 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "billing_mode", Type: field.TypeEnum, Enums: []string{"subscription", "per_lesson", "package"}, Default: "per_lesson"},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "offered", "enrolled", "declined", "expired"}, Default: "waiting"},
+		{Name: "offered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_emailed_to", Type: field.TypeString, Default: ""},
+		{Name: "enrollment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "course_id", Type: field.TypeInt},
+		{Name: "student_id", Type: field.TypeInt},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_courses_waitlist_entries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[11]},
+				RefColumns: []*schema.Column{CoursesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "waitlist_entries_students_waitlist_entries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[12]},
+				RefColumns: []*schema.Column{StudentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_course_id_status_rank",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[11], WaitlistEntriesColumns[4], WaitlistEntriesColumns[1]},
+			},
+			{
+				Name:    "waitlistentry_student_id",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[12]},
+			},
+		},
+	}
 	// WebSessionsColumns holds the columns for the "web_sessions" table.
 	WebSessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -417,34 +1186,108 @@ var (
 			},
 		},
 	}
+	// PaymentPlanInvoicesColumns holds the columns for the "payment_plan_invoices" table.
+	PaymentPlanInvoicesColumns = []*schema.Column{
+		{Name: "payment_plan_id", Type: field.TypeInt},
+		{Name: "invoice_id", Type: field.TypeInt},
+	}
+	// PaymentPlanInvoicesTable holds the schema information for the "payment_plan_invoices" table.
+	PaymentPlanInvoicesTable = &schema.Table{
+		Name:       "payment_plan_invoices",
+		Columns:    PaymentPlanInvoicesColumns,
+		PrimaryKey: []*schema.Column{PaymentPlanInvoicesColumns[0], PaymentPlanInvoicesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_plan_invoices_payment_plan_id",
+				Columns:    []*schema.Column{PaymentPlanInvoicesColumns[0]},
+				RefColumns: []*schema.Column{PaymentPlansColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "payment_plan_invoices_invoice_id",
+				Columns:    []*schema.Column{PaymentPlanInvoicesColumns[1]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttendanceMonthsTable,
 		AuditLogsTable,
+		BillingTermsTable,
+		CashMovementsTable,
+		CashReceiptsTable,
+		CashSessionsTable,
 		CoursesTable,
 		CourseMonthStatsTable,
+		CoursePricesTable,
 		EnrollmentsTable,
+		EnrollmentPausesTable,
+		EnrollmentPricesTable,
+		ExcusedAbsencesTable,
+		IdempotencyKeysTable,
 		InvoicesTable,
 		InvoiceLinesTable,
+		LateFeesTable,
+		LeadsTable,
+		LessonPackagesTable,
 		PaymentsTable,
+		PaymentPlansTable,
+		PaymentPlanInstalmentsTable,
+		RoomsTable,
+		ScheduleSlotsTable,
 		SettingsTable,
 		StudentsTable,
+		StudentChargesTable,
 		TeachersTable,
 		UsersTable,
+		WaitlistEntriesTable,
 		WebSessionsTable,
+		PaymentPlanInvoicesTable,
 	}
 )
 
 func init() {
 	AuditLogsTable.ForeignKeys[0].RefTable = UsersTable
+	BillingTermsTable.ForeignKeys[0].RefTable = CoursesTable
+	CashMovementsTable.ForeignKeys[0].RefTable = CashSessionsTable
+	CashReceiptsTable.ForeignKeys[0].RefTable = CashSessionsTable
+	CashReceiptsTable.ForeignKeys[1].RefTable = StudentsTable
 	CoursesTable.ForeignKeys[0].RefTable = TeachersTable
 	CourseMonthStatsTable.ForeignKeys[0].RefTable = CoursesTable
+	CoursePricesTable.ForeignKeys[0].RefTable = CoursesTable
 	EnrollmentsTable.ForeignKeys[0].RefTable = CoursesTable
 	EnrollmentsTable.ForeignKeys[1].RefTable = StudentsTable
-	InvoicesTable.ForeignKeys[0].RefTable = StudentsTable
+	EnrollmentPausesTable.ForeignKeys[0].RefTable = EnrollmentsTable
+	EnrollmentPricesTable.ForeignKeys[0].RefTable = EnrollmentsTable
+	ExcusedAbsencesTable.ForeignKeys[0].RefTable = CoursesTable
+	ExcusedAbsencesTable.ForeignKeys[1].RefTable = StudentsTable
+	InvoicesTable.ForeignKeys[0].RefTable = BillingTermsTable
+	InvoicesTable.ForeignKeys[1].RefTable = StudentsTable
 	InvoiceLinesTable.ForeignKeys[0].RefTable = EnrollmentsTable
-	InvoiceLinesTable.ForeignKeys[1].RefTable = InvoicesTable
-	PaymentsTable.ForeignKeys[0].RefTable = InvoicesTable
-	PaymentsTable.ForeignKeys[1].RefTable = StudentsTable
+	InvoiceLinesTable.ForeignKeys[1].RefTable = ExcusedAbsencesTable
+	InvoiceLinesTable.ForeignKeys[2].RefTable = InvoicesTable
+	InvoiceLinesTable.ForeignKeys[3].RefTable = StudentChargesTable
+	LateFeesTable.ForeignKeys[0].RefTable = InvoicesTable
+	LateFeesTable.ForeignKeys[1].RefTable = StudentsTable
+	LeadsTable.ForeignKeys[0].RefTable = CoursesTable
+	LeadsTable.ForeignKeys[1].RefTable = StudentsTable
+	LessonPackagesTable.ForeignKeys[0].RefTable = CoursesTable
+	LessonPackagesTable.ForeignKeys[1].RefTable = InvoicesTable
+	LessonPackagesTable.ForeignKeys[2].RefTable = StudentsTable
+	PaymentsTable.ForeignKeys[0].RefTable = CashReceiptsTable
+	PaymentsTable.ForeignKeys[1].RefTable = InvoicesTable
+	PaymentsTable.ForeignKeys[2].RefTable = StudentsTable
+	PaymentPlansTable.ForeignKeys[0].RefTable = StudentsTable
+	PaymentPlanInstalmentsTable.ForeignKeys[0].RefTable = PaymentPlansTable
+	ScheduleSlotsTable.ForeignKeys[0].RefTable = CoursesTable
+	ScheduleSlotsTable.ForeignKeys[1].RefTable = RoomsTable
+	ScheduleSlotsTable.ForeignKeys[2].RefTable = TeachersTable
+	StudentChargesTable.ForeignKeys[0].RefTable = StudentsTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = CoursesTable
+	WaitlistEntriesTable.ForeignKeys[1].RefTable = StudentsTable
 	WebSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	PaymentPlanInvoicesTable.ForeignKeys[0].RefTable = PaymentPlansTable
+	PaymentPlanInvoicesTable.ForeignKeys[1].RefTable = InvoicesTable
 }
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/invoice"
 	"langschool/ent/payment"
 	"langschool/ent/student"
@@ -35,6 +36,12 @@ type Payment struct {
 	Note string `json:"note,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CashReceiptID holds the value of the "cash_receipt_id" field.
+	CashReceiptID *int `json:"cash_receipt_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind payment.Kind `json:"kind,omitempty"`
+	// RelatedPaymentID holds the value of the "related_payment_id" field.
+	RelatedPaymentID *int `json:"related_payment_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PaymentQuery when eager-loading is set.
 	Edges        PaymentEdges `json:"edges"`
@@ -47,9 +54,11 @@ type PaymentEdges struct {
 	Student *Student `json:"student,omitempty"`
 	// Invoice holds the value of the invoice edge.
 	Invoice *Invoice `json:"invoice,omitempty"`
+	// CashReceipt holds the value of the cash_receipt edge.
+	CashReceipt *CashReceipt `json:"cash_receipt,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // StudentOrErr returns the Student value or an error if the edge
@@ -74,6 +83,17 @@ func (e PaymentEdges) InvoiceOrErr() (*Invoice, error) {
 	return nil, &NotLoadedError{edge: "invoice"}
 }
 
+// CashReceiptOrErr returns the CashReceipt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentEdges) CashReceiptOrErr() (*CashReceipt, error) {
+	if e.CashReceipt != nil {
+		return e.CashReceipt, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: cashreceipt.Label}
+	}
+	return nil, &NotLoadedError{edge: "cash_receipt"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Payment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -81,9 +101,9 @@ func (*Payment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case payment.FieldLegacyAmount:
 			values[i] = new(sql.NullFloat64)
-		case payment.FieldID, payment.FieldStudentID, payment.FieldInvoiceID, payment.FieldAmountCents:
+		case payment.FieldID, payment.FieldStudentID, payment.FieldInvoiceID, payment.FieldAmountCents, payment.FieldCashReceiptID, payment.FieldRelatedPaymentID:
 			values[i] = new(sql.NullInt64)
-		case payment.FieldMethod, payment.FieldNote:
+		case payment.FieldMethod, payment.FieldNote, payment.FieldKind:
 			values[i] = new(sql.NullString)
 		case payment.FieldPaidAt, payment.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -157,6 +177,26 @@ func (_m *Payment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case payment.FieldCashReceiptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cash_receipt_id", values[i])
+			} else if value.Valid {
+				_m.CashReceiptID = new(int)
+				*_m.CashReceiptID = int(value.Int64)
+			}
+		case payment.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = payment.Kind(value.String)
+			}
+		case payment.FieldRelatedPaymentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field related_payment_id", values[i])
+			} else if value.Valid {
+				_m.RelatedPaymentID = new(int)
+				*_m.RelatedPaymentID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -180,6 +220,11 @@ func (_m *Payment) QueryInvoice() *InvoiceQuery {
 	return NewPaymentClient(_m.config).QueryInvoice(_m)
 }
 
+// QueryCashReceipt queries the "cash_receipt" edge of the Payment entity.
+func (_m *Payment) QueryCashReceipt() *CashReceiptQuery {
+	return NewPaymentClient(_m.config).QueryCashReceipt(_m)
+}
+
 // Update returns a builder for updating this Payment.
 // Note that you need to call Payment.Unwrap() before calling this method if this Payment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -228,6 +273,19 @@ func (_m *Payment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CashReceiptID; v != nil {
+		builder.WriteString("cash_receipt_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.RelatedPaymentID; v != nil {
+		builder.WriteString("related_payment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
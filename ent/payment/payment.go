@@ -31,10 +31,18 @@ const (
 	FieldNote = "note"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldCashReceiptID holds the string denoting the cash_receipt_id field in the database.
+	FieldCashReceiptID = "cash_receipt_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldRelatedPaymentID holds the string denoting the related_payment_id field in the database.
+	FieldRelatedPaymentID = "related_payment_id"
 	// EdgeStudent holds the string denoting the student edge name in mutations.
 	EdgeStudent = "student"
 	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
 	EdgeInvoice = "invoice"
+	// EdgeCashReceipt holds the string denoting the cash_receipt edge name in mutations.
+	EdgeCashReceipt = "cash_receipt"
 	// Table holds the table name of the payment in the database.
 	Table = "payments"
 	// StudentTable is the table that holds the student relation/edge.
@@ -51,6 +59,13 @@ const (
 	InvoiceInverseTable = "invoices"
 	// InvoiceColumn is the table column denoting the invoice relation/edge.
 	InvoiceColumn = "invoice_id"
+	// CashReceiptTable is the table that holds the cash_receipt relation/edge.
+	CashReceiptTable = "payments"
+	// CashReceiptInverseTable is the table name for the CashReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "cashreceipt" package.
+	CashReceiptInverseTable = "cash_receipts"
+	// CashReceiptColumn is the table column denoting the cash_receipt relation/edge.
+	CashReceiptColumn = "cash_receipt_id"
 )
 
 // Columns holds all SQL columns for payment fields.
@@ -64,6 +79,9 @@ var Columns = []string{
 	FieldMethod,
 	FieldNote,
 	FieldCreatedAt,
+	FieldCashReceiptID,
+	FieldKind,
+	FieldRelatedPaymentID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -112,6 +130,36 @@ func MethodValidator(m Method) error {
 	}
 }
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindPayment is the default value of the Kind enum.
+const DefaultKind = KindPayment
+
+// Kind values.
+const (
+	KindPayment       Kind = "payment"
+	KindCreditApplied Kind = "credit_applied"
+	KindRefund        Kind = "refund"
+	KindTransferIn    Kind = "transfer_in"
+	KindTransferOut   Kind = "transfer_out"
+	KindLessonCredit  Kind = "lesson_credit"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindPayment, KindCreditApplied, KindRefund, KindTransferIn, KindTransferOut, KindLessonCredit:
+		return nil
+	default:
+		return fmt.Errorf("payment: invalid enum value for kind field: %q", k)
+	}
+}
+
 // OrderOption defines the ordering options for the Payment queries.
 type OrderOption func(*sql.Selector)
 
@@ -160,6 +208,21 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByCashReceiptID orders the results by the cash_receipt_id field.
+func ByCashReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashReceiptID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByRelatedPaymentID orders the results by the related_payment_id field.
+func ByRelatedPaymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelatedPaymentID, opts...).ToFunc()
+}
+
 // ByStudentField orders the results by student field.
 func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -173,6 +236,13 @@ func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCashReceiptField orders the results by cash_receipt field.
+func ByCashReceiptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCashReceiptStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newStudentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -187,3 +257,10 @@ func newInvoiceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
 	)
 }
+func newCashReceiptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CashReceiptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CashReceiptTable, CashReceiptColumn),
+	)
+}
@@ -90,6 +90,16 @@ func CreatedAt(v time.Time) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// CashReceiptID applies equality check predicate on the "cash_receipt_id" field. It's identical to CashReceiptIDEQ.
+func CashReceiptID(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCashReceiptID, v))
+}
+
+// RelatedPaymentID applies equality check predicate on the "related_payment_id" field. It's identical to RelatedPaymentIDEQ.
+func RelatedPaymentID(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldRelatedPaymentID, v))
+}
+
 // StudentIDEQ applies the EQ predicate on the "student_id" field.
 func StudentIDEQ(v int) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldStudentID, v))
@@ -385,6 +395,106 @@ func CreatedAtLTE(v time.Time) predicate.Payment {
 	return predicate.Payment(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// CashReceiptIDEQ applies the EQ predicate on the "cash_receipt_id" field.
+func CashReceiptIDEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCashReceiptID, v))
+}
+
+// CashReceiptIDNEQ applies the NEQ predicate on the "cash_receipt_id" field.
+func CashReceiptIDNEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldCashReceiptID, v))
+}
+
+// CashReceiptIDIn applies the In predicate on the "cash_receipt_id" field.
+func CashReceiptIDIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldCashReceiptID, vs...))
+}
+
+// CashReceiptIDNotIn applies the NotIn predicate on the "cash_receipt_id" field.
+func CashReceiptIDNotIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldCashReceiptID, vs...))
+}
+
+// CashReceiptIDIsNil applies the IsNil predicate on the "cash_receipt_id" field.
+func CashReceiptIDIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldCashReceiptID))
+}
+
+// CashReceiptIDNotNil applies the NotNil predicate on the "cash_receipt_id" field.
+func CashReceiptIDNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldCashReceiptID))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// RelatedPaymentIDEQ applies the EQ predicate on the "related_payment_id" field.
+func RelatedPaymentIDEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDNEQ applies the NEQ predicate on the "related_payment_id" field.
+func RelatedPaymentIDNEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDIn applies the In predicate on the "related_payment_id" field.
+func RelatedPaymentIDIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldRelatedPaymentID, vs...))
+}
+
+// RelatedPaymentIDNotIn applies the NotIn predicate on the "related_payment_id" field.
+func RelatedPaymentIDNotIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldRelatedPaymentID, vs...))
+}
+
+// RelatedPaymentIDGT applies the GT predicate on the "related_payment_id" field.
+func RelatedPaymentIDGT(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDGTE applies the GTE predicate on the "related_payment_id" field.
+func RelatedPaymentIDGTE(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDLT applies the LT predicate on the "related_payment_id" field.
+func RelatedPaymentIDLT(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDLTE applies the LTE predicate on the "related_payment_id" field.
+func RelatedPaymentIDLTE(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldRelatedPaymentID, v))
+}
+
+// RelatedPaymentIDIsNil applies the IsNil predicate on the "related_payment_id" field.
+func RelatedPaymentIDIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldRelatedPaymentID))
+}
+
+// RelatedPaymentIDNotNil applies the NotNil predicate on the "related_payment_id" field.
+func RelatedPaymentIDNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldRelatedPaymentID))
+}
+
 // HasStudent applies the HasEdge predicate on the "student" edge.
 func HasStudent() predicate.Payment {
 	return predicate.Payment(func(s *sql.Selector) {
@@ -431,6 +541,29 @@ func HasInvoiceWith(preds ...predicate.Invoice) predicate.Payment {
 	})
 }
 
+// HasCashReceipt applies the HasEdge predicate on the "cash_receipt" edge.
+func HasCashReceipt() predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CashReceiptTable, CashReceiptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCashReceiptWith applies the HasEdge predicate on the "cash_receipt" edge with a given conditions (other predicates).
+func HasCashReceiptWith(preds ...predicate.CashReceipt) predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := newCashReceiptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Payment) predicate.Payment {
 	return predicate.Payment(sql.AndPredicates(predicates...))
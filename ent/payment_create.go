@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/invoice"
 	"langschool/ent/payment"
 	"langschool/ent/student"
@@ -118,6 +119,48 @@ func (_c *PaymentCreate) SetNillableCreatedAt(v *time.Time) *PaymentCreate {
 	return _c
 }
 
+// SetCashReceiptID sets the "cash_receipt_id" field.
+func (_c *PaymentCreate) SetCashReceiptID(v int) *PaymentCreate {
+	_c.mutation.SetCashReceiptID(v)
+	return _c
+}
+
+// SetNillableCashReceiptID sets the "cash_receipt_id" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableCashReceiptID(v *int) *PaymentCreate {
+	if v != nil {
+		_c.SetCashReceiptID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *PaymentCreate) SetKind(v payment.Kind) *PaymentCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableKind(v *payment.Kind) *PaymentCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetRelatedPaymentID sets the "related_payment_id" field.
+func (_c *PaymentCreate) SetRelatedPaymentID(v int) *PaymentCreate {
+	_c.mutation.SetRelatedPaymentID(v)
+	return _c
+}
+
+// SetNillableRelatedPaymentID sets the "related_payment_id" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableRelatedPaymentID(v *int) *PaymentCreate {
+	if v != nil {
+		_c.SetRelatedPaymentID(*v)
+	}
+	return _c
+}
+
 // SetStudent sets the "student" edge to the Student entity.
 func (_c *PaymentCreate) SetStudent(v *Student) *PaymentCreate {
 	return _c.SetStudentID(v.ID)
@@ -128,6 +171,11 @@ func (_c *PaymentCreate) SetInvoice(v *Invoice) *PaymentCreate {
 	return _c.SetInvoiceID(v.ID)
 }
 
+// SetCashReceipt sets the "cash_receipt" edge to the CashReceipt entity.
+func (_c *PaymentCreate) SetCashReceipt(v *CashReceipt) *PaymentCreate {
+	return _c.SetCashReceiptID(v.ID)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_c *PaymentCreate) Mutation() *PaymentMutation {
 	return _c.mutation
@@ -183,6 +231,10 @@ func (_c *PaymentCreate) defaults() {
 		v := payment.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := payment.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -213,6 +265,14 @@ func (_c *PaymentCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payment.created_at"`)}
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Payment.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := payment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Payment.kind": %w`, err)}
+		}
+	}
 	if len(_c.mutation.StudentIDs()) == 0 {
 		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "Payment.student"`)}
 	}
@@ -266,6 +326,14 @@ func (_c *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(payment.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.RelatedPaymentID(); ok {
+		_spec.SetField(payment.FieldRelatedPaymentID, field.TypeInt, value)
+		_node.RelatedPaymentID = &value
+	}
 	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -300,6 +368,23 @@ func (_c *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		_node.InvoiceID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CashReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.CashReceiptTable,
+			Columns: []string{payment.CashReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CashReceiptID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/invoice"
 	"langschool/ent/payment"
 	"langschool/ent/predicate"
@@ -20,12 +21,13 @@ import (
 // PaymentQuery is the builder for querying Payment entities.
 type PaymentQuery struct {
 	config
-	ctx         *QueryContext
-	order       []payment.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Payment
-	withStudent *StudentQuery
-	withInvoice *InvoiceQuery
+	ctx             *QueryContext
+	order           []payment.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Payment
+	withStudent     *StudentQuery
+	withInvoice     *InvoiceQuery
+	withCashReceipt *CashReceiptQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (_q *PaymentQuery) QueryInvoice() *InvoiceQuery {
 	return query
 }
 
+// QueryCashReceipt chains the current query on the "cash_receipt" edge.
+func (_q *PaymentQuery) QueryCashReceipt() *CashReceiptQuery {
+	query := (&CashReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, selector),
+			sqlgraph.To(cashreceipt.Table, cashreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.CashReceiptTable, payment.CashReceiptColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Payment entity from the query.
 // Returns a *NotFoundError when no Payment was found.
 func (_q *PaymentQuery) First(ctx context.Context) (*Payment, error) {
@@ -293,13 +317,14 @@ func (_q *PaymentQuery) Clone() *PaymentQuery {
 		return nil
 	}
 	return &PaymentQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]payment.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Payment{}, _q.predicates...),
-		withStudent: _q.withStudent.Clone(),
-		withInvoice: _q.withInvoice.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]payment.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Payment{}, _q.predicates...),
+		withStudent:     _q.withStudent.Clone(),
+		withInvoice:     _q.withInvoice.Clone(),
+		withCashReceipt: _q.withCashReceipt.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +353,17 @@ func (_q *PaymentQuery) WithInvoice(opts ...func(*InvoiceQuery)) *PaymentQuery {
 	return _q
 }
 
+// WithCashReceipt tells the query-builder to eager-load the nodes that are connected to
+// the "cash_receipt" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PaymentQuery) WithCashReceipt(opts ...func(*CashReceiptQuery)) *PaymentQuery {
+	query := (&CashReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCashReceipt = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (_q *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 	var (
 		nodes       = []*Payment{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withStudent != nil,
 			_q.withInvoice != nil,
+			_q.withCashReceipt != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +478,12 @@ func (_q *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 			return nil, err
 		}
 	}
+	if query := _q.withCashReceipt; query != nil {
+		if err := _q.loadCashReceipt(ctx, query, nodes, nil,
+			func(n *Payment, e *CashReceipt) { n.Edges.CashReceipt = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +548,38 @@ func (_q *PaymentQuery) loadInvoice(ctx context.Context, query *InvoiceQuery, no
 	}
 	return nil
 }
+func (_q *PaymentQuery) loadCashReceipt(ctx context.Context, query *CashReceiptQuery, nodes []*Payment, init func(*Payment), assign func(*Payment, *CashReceipt)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Payment)
+	for i := range nodes {
+		if nodes[i].CashReceiptID == nil {
+			continue
+		}
+		fk := *nodes[i].CashReceiptID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(cashreceipt.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "cash_receipt_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *PaymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -537,6 +612,9 @@ func (_q *PaymentQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withInvoice != nil {
 			_spec.Node.AddColumnOnce(payment.FieldInvoiceID)
 		}
+		if _q.withCashReceipt != nil {
+			_spec.Node.AddColumnOnce(payment.FieldCashReceiptID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/invoice"
 	"langschool/ent/payment"
 	"langschool/ent/predicate"
@@ -162,6 +163,67 @@ func (_u *PaymentUpdate) SetNillableCreatedAt(v *time.Time) *PaymentUpdate {
 	return _u
 }
 
+// SetCashReceiptID sets the "cash_receipt_id" field.
+func (_u *PaymentUpdate) SetCashReceiptID(v int) *PaymentUpdate {
+	_u.mutation.SetCashReceiptID(v)
+	return _u
+}
+
+// SetNillableCashReceiptID sets the "cash_receipt_id" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableCashReceiptID(v *int) *PaymentUpdate {
+	if v != nil {
+		_u.SetCashReceiptID(*v)
+	}
+	return _u
+}
+
+// ClearCashReceiptID clears the value of the "cash_receipt_id" field.
+func (_u *PaymentUpdate) ClearCashReceiptID() *PaymentUpdate {
+	_u.mutation.ClearCashReceiptID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PaymentUpdate) SetKind(v payment.Kind) *PaymentUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableKind(v *payment.Kind) *PaymentUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRelatedPaymentID sets the "related_payment_id" field.
+func (_u *PaymentUpdate) SetRelatedPaymentID(v int) *PaymentUpdate {
+	_u.mutation.ResetRelatedPaymentID()
+	_u.mutation.SetRelatedPaymentID(v)
+	return _u
+}
+
+// SetNillableRelatedPaymentID sets the "related_payment_id" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableRelatedPaymentID(v *int) *PaymentUpdate {
+	if v != nil {
+		_u.SetRelatedPaymentID(*v)
+	}
+	return _u
+}
+
+// AddRelatedPaymentID adds value to the "related_payment_id" field.
+func (_u *PaymentUpdate) AddRelatedPaymentID(v int) *PaymentUpdate {
+	_u.mutation.AddRelatedPaymentID(v)
+	return _u
+}
+
+// ClearRelatedPaymentID clears the value of the "related_payment_id" field.
+func (_u *PaymentUpdate) ClearRelatedPaymentID() *PaymentUpdate {
+	_u.mutation.ClearRelatedPaymentID()
+	return _u
+}
+
 // SetStudent sets the "student" edge to the Student entity.
 func (_u *PaymentUpdate) SetStudent(v *Student) *PaymentUpdate {
 	return _u.SetStudentID(v.ID)
@@ -172,6 +234,11 @@ func (_u *PaymentUpdate) SetInvoice(v *Invoice) *PaymentUpdate {
 	return _u.SetInvoiceID(v.ID)
 }
 
+// SetCashReceipt sets the "cash_receipt" edge to the CashReceipt entity.
+func (_u *PaymentUpdate) SetCashReceipt(v *CashReceipt) *PaymentUpdate {
+	return _u.SetCashReceiptID(v.ID)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_u *PaymentUpdate) Mutation() *PaymentMutation {
 	return _u.mutation
@@ -189,6 +256,12 @@ func (_u *PaymentUpdate) ClearInvoice() *PaymentUpdate {
 	return _u
 }
 
+// ClearCashReceipt clears the "cash_receipt" edge to the CashReceipt entity.
+func (_u *PaymentUpdate) ClearCashReceipt() *PaymentUpdate {
+	_u.mutation.ClearCashReceipt()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PaymentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -223,6 +296,11 @@ func (_u *PaymentUpdate) check() error {
 			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Payment.method": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := payment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Payment.kind": %w`, err)}
+		}
+	}
 	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Payment.student"`)
 	}
@@ -265,6 +343,18 @@ func (_u *PaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(payment.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RelatedPaymentID(); ok {
+		_spec.SetField(payment.FieldRelatedPaymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRelatedPaymentID(); ok {
+		_spec.AddField(payment.FieldRelatedPaymentID, field.TypeInt, value)
+	}
+	if _u.mutation.RelatedPaymentIDCleared() {
+		_spec.ClearField(payment.FieldRelatedPaymentID, field.TypeInt)
+	}
 	if _u.mutation.StudentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -323,6 +413,35 @@ func (_u *PaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CashReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.CashReceiptTable,
+			Columns: []string{payment.CashReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CashReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.CashReceiptTable,
+			Columns: []string{payment.CashReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{payment.Label}
@@ -475,6 +594,67 @@ func (_u *PaymentUpdateOne) SetNillableCreatedAt(v *time.Time) *PaymentUpdateOne
 	return _u
 }
 
+// SetCashReceiptID sets the "cash_receipt_id" field.
+func (_u *PaymentUpdateOne) SetCashReceiptID(v int) *PaymentUpdateOne {
+	_u.mutation.SetCashReceiptID(v)
+	return _u
+}
+
+// SetNillableCashReceiptID sets the "cash_receipt_id" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableCashReceiptID(v *int) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetCashReceiptID(*v)
+	}
+	return _u
+}
+
+// ClearCashReceiptID clears the value of the "cash_receipt_id" field.
+func (_u *PaymentUpdateOne) ClearCashReceiptID() *PaymentUpdateOne {
+	_u.mutation.ClearCashReceiptID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PaymentUpdateOne) SetKind(v payment.Kind) *PaymentUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableKind(v *payment.Kind) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRelatedPaymentID sets the "related_payment_id" field.
+func (_u *PaymentUpdateOne) SetRelatedPaymentID(v int) *PaymentUpdateOne {
+	_u.mutation.ResetRelatedPaymentID()
+	_u.mutation.SetRelatedPaymentID(v)
+	return _u
+}
+
+// SetNillableRelatedPaymentID sets the "related_payment_id" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableRelatedPaymentID(v *int) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetRelatedPaymentID(*v)
+	}
+	return _u
+}
+
+// AddRelatedPaymentID adds value to the "related_payment_id" field.
+func (_u *PaymentUpdateOne) AddRelatedPaymentID(v int) *PaymentUpdateOne {
+	_u.mutation.AddRelatedPaymentID(v)
+	return _u
+}
+
+// ClearRelatedPaymentID clears the value of the "related_payment_id" field.
+func (_u *PaymentUpdateOne) ClearRelatedPaymentID() *PaymentUpdateOne {
+	_u.mutation.ClearRelatedPaymentID()
+	return _u
+}
+
 // SetStudent sets the "student" edge to the Student entity.
 func (_u *PaymentUpdateOne) SetStudent(v *Student) *PaymentUpdateOne {
 	return _u.SetStudentID(v.ID)
@@ -485,6 +665,11 @@ func (_u *PaymentUpdateOne) SetInvoice(v *Invoice) *PaymentUpdateOne {
 	return _u.SetInvoiceID(v.ID)
 }
 
+// SetCashReceipt sets the "cash_receipt" edge to the CashReceipt entity.
+func (_u *PaymentUpdateOne) SetCashReceipt(v *CashReceipt) *PaymentUpdateOne {
+	return _u.SetCashReceiptID(v.ID)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_u *PaymentUpdateOne) Mutation() *PaymentMutation {
 	return _u.mutation
@@ -502,6 +687,12 @@ func (_u *PaymentUpdateOne) ClearInvoice() *PaymentUpdateOne {
 	return _u
 }
 
+// ClearCashReceipt clears the "cash_receipt" edge to the CashReceipt entity.
+func (_u *PaymentUpdateOne) ClearCashReceipt() *PaymentUpdateOne {
+	_u.mutation.ClearCashReceipt()
+	return _u
+}
+
 // Where appends a list predicates to the PaymentUpdate builder.
 func (_u *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -549,6 +740,11 @@ func (_u *PaymentUpdateOne) check() error {
 			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Payment.method": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := payment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Payment.kind": %w`, err)}
+		}
+	}
 	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Payment.student"`)
 	}
@@ -608,6 +804,18 @@ func (_u *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err er
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(payment.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RelatedPaymentID(); ok {
+		_spec.SetField(payment.FieldRelatedPaymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRelatedPaymentID(); ok {
+		_spec.AddField(payment.FieldRelatedPaymentID, field.TypeInt, value)
+	}
+	if _u.mutation.RelatedPaymentIDCleared() {
+		_spec.ClearField(payment.FieldRelatedPaymentID, field.TypeInt)
+	}
 	if _u.mutation.StudentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -666,6 +874,35 @@ func (_u *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CashReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.CashReceiptTable,
+			Columns: []string{payment.CashReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CashReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.CashReceiptTable,
+			Columns: []string{payment.CashReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Payment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/paymentplan"
+	"langschool/ent/student"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PaymentPlan is the model entity for the PaymentPlan schema.
+type PaymentPlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// TotalCents holds the value of the "total_cents" field.
+	TotalCents int64 `json:"total_cents,omitempty"`
+	// BaselinePaidCents holds the value of the "baseline_paid_cents" field.
+	BaselinePaidCents int64 `json:"baseline_paid_cents,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CanceledAt holds the value of the "canceled_at" field.
+	CanceledAt *time.Time `json:"canceled_at,omitempty"`
+	// CanceledBy holds the value of the "canceled_by" field.
+	CanceledBy string `json:"canceled_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentPlanQuery when eager-loading is set.
+	Edges        PaymentPlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentPlanEdges holds the relations/edges for other nodes in the graph.
+type PaymentPlanEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// Instalments holds the value of the instalments edge.
+	Instalments []*PaymentPlanInstalment `json:"instalments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentPlanEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentPlanEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[1] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// InstalmentsOrErr returns the Instalments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentPlanEdges) InstalmentsOrErr() ([]*PaymentPlanInstalment, error) {
+	if e.loadedTypes[2] {
+		return e.Instalments, nil
+	}
+	return nil, &NotLoadedError{edge: "instalments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentPlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentplan.FieldID, paymentplan.FieldStudentID, paymentplan.FieldTotalCents, paymentplan.FieldBaselinePaidCents:
+			values[i] = new(sql.NullInt64)
+		case paymentplan.FieldNote, paymentplan.FieldCreatedBy, paymentplan.FieldCanceledBy:
+			values[i] = new(sql.NullString)
+		case paymentplan.FieldCreatedAt, paymentplan.FieldCanceledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentPlan fields.
+func (_m *PaymentPlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentplan.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case paymentplan.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case paymentplan.FieldTotalCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cents", values[i])
+			} else if value.Valid {
+				_m.TotalCents = value.Int64
+			}
+		case paymentplan.FieldBaselinePaidCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field baseline_paid_cents", values[i])
+			} else if value.Valid {
+				_m.BaselinePaidCents = value.Int64
+			}
+		case paymentplan.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case paymentplan.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case paymentplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentplan.FieldCanceledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field canceled_at", values[i])
+			} else if value.Valid {
+				_m.CanceledAt = new(time.Time)
+				*_m.CanceledAt = value.Time
+			}
+		case paymentplan.FieldCanceledBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field canceled_by", values[i])
+			} else if value.Valid {
+				_m.CanceledBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentPlan.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentPlan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the PaymentPlan entity.
+func (_m *PaymentPlan) QueryStudent() *StudentQuery {
+	return NewPaymentPlanClient(_m.config).QueryStudent(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the PaymentPlan entity.
+func (_m *PaymentPlan) QueryInvoices() *InvoiceQuery {
+	return NewPaymentPlanClient(_m.config).QueryInvoices(_m)
+}
+
+// QueryInstalments queries the "instalments" edge of the PaymentPlan entity.
+func (_m *PaymentPlan) QueryInstalments() *PaymentPlanInstalmentQuery {
+	return NewPaymentPlanClient(_m.config).QueryInstalments(_m)
+}
+
+// Update returns a builder for updating this PaymentPlan.
+// Note that you need to call PaymentPlan.Unwrap() before calling this method if this PaymentPlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentPlan) Update() *PaymentPlanUpdateOne {
+	return NewPaymentPlanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentPlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentPlan) Unwrap() *PaymentPlan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentPlan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentPlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentPlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("total_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalCents))
+	builder.WriteString(", ")
+	builder.WriteString("baseline_paid_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BaselinePaidCents))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CanceledAt; v != nil {
+		builder.WriteString("canceled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("canceled_by=")
+	builder.WriteString(_m.CanceledBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentPlans is a parsable slice of PaymentPlan.
+type PaymentPlans []*PaymentPlan
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentplan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentplan type in the database.
+	Label = "payment_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldTotalCents holds the string denoting the total_cents field in the database.
+	FieldTotalCents = "total_cents"
+	// FieldBaselinePaidCents holds the string denoting the baseline_paid_cents field in the database.
+	FieldBaselinePaidCents = "baseline_paid_cents"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCanceledAt holds the string denoting the canceled_at field in the database.
+	FieldCanceledAt = "canceled_at"
+	// FieldCanceledBy holds the string denoting the canceled_by field in the database.
+	FieldCanceledBy = "canceled_by"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// EdgeInstalments holds the string denoting the instalments edge name in mutations.
+	EdgeInstalments = "instalments"
+	// Table holds the table name of the paymentplan in the database.
+	Table = "payment_plans"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "payment_plans"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// InvoicesTable is the table that holds the invoices relation/edge. The primary key declared below.
+	InvoicesTable = "payment_plan_invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InstalmentsTable is the table that holds the instalments relation/edge.
+	InstalmentsTable = "payment_plan_instalments"
+	// InstalmentsInverseTable is the table name for the PaymentPlanInstalment entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentplaninstalment" package.
+	InstalmentsInverseTable = "payment_plan_instalments"
+	// InstalmentsColumn is the table column denoting the instalments relation/edge.
+	InstalmentsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for paymentplan fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldTotalCents,
+	FieldBaselinePaidCents,
+	FieldNote,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldCanceledAt,
+	FieldCanceledBy,
+}
+
+var (
+	// InvoicesPrimaryKey and InvoicesColumn2 are the table columns denoting the
+	// primary key for the invoices relation (M2M).
+	InvoicesPrimaryKey = []string{"payment_plan_id", "invoice_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBaselinePaidCents holds the default value on creation for the "baseline_paid_cents" field.
+	DefaultBaselinePaidCents int64
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultCanceledBy holds the default value on creation for the "canceled_by" field.
+	DefaultCanceledBy string
+)
+
+// OrderOption defines the ordering options for the PaymentPlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByTotalCents orders the results by the total_cents field.
+func ByTotalCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCents, opts...).ToFunc()
+}
+
+// ByBaselinePaidCents orders the results by the baseline_paid_cents field.
+func ByBaselinePaidCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaselinePaidCents, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCanceledAt orders the results by the canceled_at field.
+func ByCanceledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanceledAt, opts...).ToFunc()
+}
+
+// ByCanceledBy orders the results by the canceled_by field.
+func ByCanceledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanceledBy, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoicesCount orders the results by invoices count.
+func ByInvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoicesStep(), opts...)
+	}
+}
+
+// ByInvoices orders the results by invoices terms.
+func ByInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInstalmentsCount orders the results by instalments count.
+func ByInstalmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInstalmentsStep(), opts...)
+	}
+}
+
+// ByInstalments orders the results by instalments terms.
+func ByInstalments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInstalmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newInvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, InvoicesTable, InvoicesPrimaryKey...),
+	)
+}
+func newInstalmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InstalmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InstalmentsTable, InstalmentsColumn),
+	)
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentplan
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldStudentID, v))
+}
+
+// TotalCents applies equality check predicate on the "total_cents" field. It's identical to TotalCentsEQ.
+func TotalCents(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldTotalCents, v))
+}
+
+// BaselinePaidCents applies equality check predicate on the "baseline_paid_cents" field. It's identical to BaselinePaidCentsEQ.
+func BaselinePaidCents(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldBaselinePaidCents, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CanceledAt applies equality check predicate on the "canceled_at" field. It's identical to CanceledAtEQ.
+func CanceledAt(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CanceledBy applies equality check predicate on the "canceled_by" field. It's identical to CanceledByEQ.
+func CanceledBy(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCanceledBy, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// TotalCentsEQ applies the EQ predicate on the "total_cents" field.
+func TotalCentsEQ(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldTotalCents, v))
+}
+
+// TotalCentsNEQ applies the NEQ predicate on the "total_cents" field.
+func TotalCentsNEQ(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldTotalCents, v))
+}
+
+// TotalCentsIn applies the In predicate on the "total_cents" field.
+func TotalCentsIn(vs ...int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldTotalCents, vs...))
+}
+
+// TotalCentsNotIn applies the NotIn predicate on the "total_cents" field.
+func TotalCentsNotIn(vs ...int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldTotalCents, vs...))
+}
+
+// TotalCentsGT applies the GT predicate on the "total_cents" field.
+func TotalCentsGT(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldTotalCents, v))
+}
+
+// TotalCentsGTE applies the GTE predicate on the "total_cents" field.
+func TotalCentsGTE(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldTotalCents, v))
+}
+
+// TotalCentsLT applies the LT predicate on the "total_cents" field.
+func TotalCentsLT(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldTotalCents, v))
+}
+
+// TotalCentsLTE applies the LTE predicate on the "total_cents" field.
+func TotalCentsLTE(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldTotalCents, v))
+}
+
+// BaselinePaidCentsEQ applies the EQ predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsEQ(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldBaselinePaidCents, v))
+}
+
+// BaselinePaidCentsNEQ applies the NEQ predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsNEQ(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldBaselinePaidCents, v))
+}
+
+// BaselinePaidCentsIn applies the In predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsIn(vs ...int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldBaselinePaidCents, vs...))
+}
+
+// BaselinePaidCentsNotIn applies the NotIn predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsNotIn(vs ...int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldBaselinePaidCents, vs...))
+}
+
+// BaselinePaidCentsGT applies the GT predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsGT(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldBaselinePaidCents, v))
+}
+
+// BaselinePaidCentsGTE applies the GTE predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsGTE(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldBaselinePaidCents, v))
+}
+
+// BaselinePaidCentsLT applies the LT predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsLT(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldBaselinePaidCents, v))
+}
+
+// BaselinePaidCentsLTE applies the LTE predicate on the "baseline_paid_cents" field.
+func BaselinePaidCentsLTE(v int64) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldBaselinePaidCents, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CanceledAtEQ applies the EQ predicate on the "canceled_at" field.
+func CanceledAtEQ(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtNEQ applies the NEQ predicate on the "canceled_at" field.
+func CanceledAtNEQ(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtIn applies the In predicate on the "canceled_at" field.
+func CanceledAtIn(vs ...time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtNotIn applies the NotIn predicate on the "canceled_at" field.
+func CanceledAtNotIn(vs ...time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtGT applies the GT predicate on the "canceled_at" field.
+func CanceledAtGT(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldCanceledAt, v))
+}
+
+// CanceledAtGTE applies the GTE predicate on the "canceled_at" field.
+func CanceledAtGTE(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldCanceledAt, v))
+}
+
+// CanceledAtLT applies the LT predicate on the "canceled_at" field.
+func CanceledAtLT(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldCanceledAt, v))
+}
+
+// CanceledAtLTE applies the LTE predicate on the "canceled_at" field.
+func CanceledAtLTE(v time.Time) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldCanceledAt, v))
+}
+
+// CanceledAtIsNil applies the IsNil predicate on the "canceled_at" field.
+func CanceledAtIsNil() predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIsNull(FieldCanceledAt))
+}
+
+// CanceledAtNotNil applies the NotNil predicate on the "canceled_at" field.
+func CanceledAtNotNil() predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotNull(FieldCanceledAt))
+}
+
+// CanceledByEQ applies the EQ predicate on the "canceled_by" field.
+func CanceledByEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEQ(FieldCanceledBy, v))
+}
+
+// CanceledByNEQ applies the NEQ predicate on the "canceled_by" field.
+func CanceledByNEQ(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNEQ(FieldCanceledBy, v))
+}
+
+// CanceledByIn applies the In predicate on the "canceled_by" field.
+func CanceledByIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldIn(FieldCanceledBy, vs...))
+}
+
+// CanceledByNotIn applies the NotIn predicate on the "canceled_by" field.
+func CanceledByNotIn(vs ...string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldNotIn(FieldCanceledBy, vs...))
+}
+
+// CanceledByGT applies the GT predicate on the "canceled_by" field.
+func CanceledByGT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGT(FieldCanceledBy, v))
+}
+
+// CanceledByGTE applies the GTE predicate on the "canceled_by" field.
+func CanceledByGTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldGTE(FieldCanceledBy, v))
+}
+
+// CanceledByLT applies the LT predicate on the "canceled_by" field.
+func CanceledByLT(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLT(FieldCanceledBy, v))
+}
+
+// CanceledByLTE applies the LTE predicate on the "canceled_by" field.
+func CanceledByLTE(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldLTE(FieldCanceledBy, v))
+}
+
+// CanceledByContains applies the Contains predicate on the "canceled_by" field.
+func CanceledByContains(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContains(FieldCanceledBy, v))
+}
+
+// CanceledByHasPrefix applies the HasPrefix predicate on the "canceled_by" field.
+func CanceledByHasPrefix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasPrefix(FieldCanceledBy, v))
+}
+
+// CanceledByHasSuffix applies the HasSuffix predicate on the "canceled_by" field.
+func CanceledByHasSuffix(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldHasSuffix(FieldCanceledBy, v))
+}
+
+// CanceledByEqualFold applies the EqualFold predicate on the "canceled_by" field.
+func CanceledByEqualFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldEqualFold(FieldCanceledBy, v))
+}
+
+// CanceledByContainsFold applies the ContainsFold predicate on the "canceled_by" field.
+func CanceledByContainsFold(v string) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.FieldContainsFold(FieldCanceledBy, v))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, InvoicesTable, InvoicesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := newInvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInstalments applies the HasEdge predicate on the "instalments" edge.
+func HasInstalments() predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InstalmentsTable, InstalmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstalmentsWith applies the HasEdge predicate on the "instalments" edge with a given conditions (other predicates).
+func HasInstalmentsWith(preds ...predicate.PaymentPlanInstalment) predicate.PaymentPlan {
+	return predicate.PaymentPlan(func(s *sql.Selector) {
+		step := newInstalmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentPlan) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentPlan) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentPlan) predicate.PaymentPlan {
+	return predicate.PaymentPlan(sql.NotPredicates(p))
+}
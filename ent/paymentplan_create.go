@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoice"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentPlanCreate is the builder for creating a PaymentPlan entity.
+type PaymentPlanCreate struct {
+	config
+	mutation *PaymentPlanMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *PaymentPlanCreate) SetStudentID(v int) *PaymentPlanCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetTotalCents sets the "total_cents" field.
+func (_c *PaymentPlanCreate) SetTotalCents(v int64) *PaymentPlanCreate {
+	_c.mutation.SetTotalCents(v)
+	return _c
+}
+
+// SetBaselinePaidCents sets the "baseline_paid_cents" field.
+func (_c *PaymentPlanCreate) SetBaselinePaidCents(v int64) *PaymentPlanCreate {
+	_c.mutation.SetBaselinePaidCents(v)
+	return _c
+}
+
+// SetNillableBaselinePaidCents sets the "baseline_paid_cents" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableBaselinePaidCents(v *int64) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetBaselinePaidCents(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *PaymentPlanCreate) SetNote(v string) *PaymentPlanCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableNote(v *string) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PaymentPlanCreate) SetCreatedBy(v string) *PaymentPlanCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableCreatedBy(v *string) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentPlanCreate) SetCreatedAt(v time.Time) *PaymentPlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableCreatedAt(v *time.Time) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_c *PaymentPlanCreate) SetCanceledAt(v time.Time) *PaymentPlanCreate {
+	_c.mutation.SetCanceledAt(v)
+	return _c
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableCanceledAt(v *time.Time) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetCanceledAt(*v)
+	}
+	return _c
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_c *PaymentPlanCreate) SetCanceledBy(v string) *PaymentPlanCreate {
+	_c.mutation.SetCanceledBy(v)
+	return _c
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_c *PaymentPlanCreate) SetNillableCanceledBy(v *string) *PaymentPlanCreate {
+	if v != nil {
+		_c.SetCanceledBy(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *PaymentPlanCreate) SetStudent(v *Student) *PaymentPlanCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_c *PaymentPlanCreate) AddInvoiceIDs(ids ...int) *PaymentPlanCreate {
+	_c.mutation.AddInvoiceIDs(ids...)
+	return _c
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_c *PaymentPlanCreate) AddInvoices(v ...*Invoice) *PaymentPlanCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceIDs(ids...)
+}
+
+// AddInstalmentIDs adds the "instalments" edge to the PaymentPlanInstalment entity by IDs.
+func (_c *PaymentPlanCreate) AddInstalmentIDs(ids ...int) *PaymentPlanCreate {
+	_c.mutation.AddInstalmentIDs(ids...)
+	return _c
+}
+
+// AddInstalments adds the "instalments" edges to the PaymentPlanInstalment entity.
+func (_c *PaymentPlanCreate) AddInstalments(v ...*PaymentPlanInstalment) *PaymentPlanCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInstalmentIDs(ids...)
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (_c *PaymentPlanCreate) Mutation() *PaymentPlanMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentPlan in the database.
+func (_c *PaymentPlanCreate) Save(ctx context.Context) (*PaymentPlan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentPlanCreate) SaveX(ctx context.Context) *PaymentPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentPlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentPlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentPlanCreate) defaults() {
+	if _, ok := _c.mutation.BaselinePaidCents(); !ok {
+		v := paymentplan.DefaultBaselinePaidCents
+		_c.mutation.SetBaselinePaidCents(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := paymentplan.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := paymentplan.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentplan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.CanceledBy(); !ok {
+		v := paymentplan.DefaultCanceledBy
+		_c.mutation.SetCanceledBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentPlanCreate) check() error {
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "PaymentPlan.student_id"`)}
+	}
+	if _, ok := _c.mutation.TotalCents(); !ok {
+		return &ValidationError{Name: "total_cents", err: errors.New(`ent: missing required field "PaymentPlan.total_cents"`)}
+	}
+	if _, ok := _c.mutation.BaselinePaidCents(); !ok {
+		return &ValidationError{Name: "baseline_paid_cents", err: errors.New(`ent: missing required field "PaymentPlan.baseline_paid_cents"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "PaymentPlan.note"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "PaymentPlan.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentPlan.created_at"`)}
+	}
+	if _, ok := _c.mutation.CanceledBy(); !ok {
+		return &ValidationError{Name: "canceled_by", err: errors.New(`ent: missing required field "PaymentPlan.canceled_by"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "PaymentPlan.student"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentPlanCreate) sqlSave(ctx context.Context) (*PaymentPlan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentPlanCreate) createSpec() (*PaymentPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentPlan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentplan.Table, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TotalCents(); ok {
+		_spec.SetField(paymentplan.FieldTotalCents, field.TypeInt64, value)
+		_node.TotalCents = value
+	}
+	if value, ok := _c.mutation.BaselinePaidCents(); ok {
+		_spec.SetField(paymentplan.FieldBaselinePaidCents, field.TypeInt64, value)
+		_node.BaselinePaidCents = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(paymentplan.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentplan.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CanceledAt(); ok {
+		_spec.SetField(paymentplan.FieldCanceledAt, field.TypeTime, value)
+		_node.CanceledAt = &value
+	}
+	if value, ok := _c.mutation.CanceledBy(); ok {
+		_spec.SetField(paymentplan.FieldCanceledBy, field.TypeString, value)
+		_node.CanceledBy = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplan.StudentTable,
+			Columns: []string{paymentplan.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InstalmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentPlanCreateBulk is the builder for creating many PaymentPlan entities in bulk.
+type PaymentPlanCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentPlanCreate
+}
+
+// Save creates the PaymentPlan entities in the database.
+func (_c *PaymentPlanCreateBulk) Save(ctx context.Context) ([]*PaymentPlan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentPlan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentPlanCreateBulk) SaveX(ctx context.Context) []*PaymentPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,929 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoice"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentPlanUpdate is the builder for updating PaymentPlan entities.
+type PaymentPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentPlanMutation
+}
+
+// Where appends a list predicates to the PaymentPlanUpdate builder.
+func (_u *PaymentPlanUpdate) Where(ps ...predicate.PaymentPlan) *PaymentPlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *PaymentPlanUpdate) SetStudentID(v int) *PaymentPlanUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableStudentID(v *int) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetTotalCents sets the "total_cents" field.
+func (_u *PaymentPlanUpdate) SetTotalCents(v int64) *PaymentPlanUpdate {
+	_u.mutation.ResetTotalCents()
+	_u.mutation.SetTotalCents(v)
+	return _u
+}
+
+// SetNillableTotalCents sets the "total_cents" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableTotalCents(v *int64) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetTotalCents(*v)
+	}
+	return _u
+}
+
+// AddTotalCents adds value to the "total_cents" field.
+func (_u *PaymentPlanUpdate) AddTotalCents(v int64) *PaymentPlanUpdate {
+	_u.mutation.AddTotalCents(v)
+	return _u
+}
+
+// SetBaselinePaidCents sets the "baseline_paid_cents" field.
+func (_u *PaymentPlanUpdate) SetBaselinePaidCents(v int64) *PaymentPlanUpdate {
+	_u.mutation.ResetBaselinePaidCents()
+	_u.mutation.SetBaselinePaidCents(v)
+	return _u
+}
+
+// SetNillableBaselinePaidCents sets the "baseline_paid_cents" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableBaselinePaidCents(v *int64) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetBaselinePaidCents(*v)
+	}
+	return _u
+}
+
+// AddBaselinePaidCents adds value to the "baseline_paid_cents" field.
+func (_u *PaymentPlanUpdate) AddBaselinePaidCents(v int64) *PaymentPlanUpdate {
+	_u.mutation.AddBaselinePaidCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *PaymentPlanUpdate) SetNote(v string) *PaymentPlanUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableNote(v *string) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentPlanUpdate) SetCreatedBy(v string) *PaymentPlanUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableCreatedBy(v *string) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PaymentPlanUpdate) SetCreatedAt(v time.Time) *PaymentPlanUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableCreatedAt(v *time.Time) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *PaymentPlanUpdate) SetCanceledAt(v time.Time) *PaymentPlanUpdate {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableCanceledAt(v *time.Time) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *PaymentPlanUpdate) ClearCanceledAt() *PaymentPlanUpdate {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_u *PaymentPlanUpdate) SetCanceledBy(v string) *PaymentPlanUpdate {
+	_u.mutation.SetCanceledBy(v)
+	return _u
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_u *PaymentPlanUpdate) SetNillableCanceledBy(v *string) *PaymentPlanUpdate {
+	if v != nil {
+		_u.SetCanceledBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *PaymentPlanUpdate) SetStudent(v *Student) *PaymentPlanUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *PaymentPlanUpdate) AddInvoiceIDs(ids ...int) *PaymentPlanUpdate {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *PaymentPlanUpdate) AddInvoices(v ...*Invoice) *PaymentPlanUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddInstalmentIDs adds the "instalments" edge to the PaymentPlanInstalment entity by IDs.
+func (_u *PaymentPlanUpdate) AddInstalmentIDs(ids ...int) *PaymentPlanUpdate {
+	_u.mutation.AddInstalmentIDs(ids...)
+	return _u
+}
+
+// AddInstalments adds the "instalments" edges to the PaymentPlanInstalment entity.
+func (_u *PaymentPlanUpdate) AddInstalments(v ...*PaymentPlanInstalment) *PaymentPlanUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInstalmentIDs(ids...)
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (_u *PaymentPlanUpdate) Mutation() *PaymentPlanMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *PaymentPlanUpdate) ClearStudent() *PaymentPlanUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *PaymentPlanUpdate) ClearInvoices() *PaymentPlanUpdate {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *PaymentPlanUpdate) RemoveInvoiceIDs(ids ...int) *PaymentPlanUpdate {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *PaymentPlanUpdate) RemoveInvoices(v ...*Invoice) *PaymentPlanUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearInstalments clears all "instalments" edges to the PaymentPlanInstalment entity.
+func (_u *PaymentPlanUpdate) ClearInstalments() *PaymentPlanUpdate {
+	_u.mutation.ClearInstalments()
+	return _u
+}
+
+// RemoveInstalmentIDs removes the "instalments" edge to PaymentPlanInstalment entities by IDs.
+func (_u *PaymentPlanUpdate) RemoveInstalmentIDs(ids ...int) *PaymentPlanUpdate {
+	_u.mutation.RemoveInstalmentIDs(ids...)
+	return _u
+}
+
+// RemoveInstalments removes "instalments" edges to PaymentPlanInstalment entities.
+func (_u *PaymentPlanUpdate) RemoveInstalments(v ...*PaymentPlanInstalment) *PaymentPlanUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInstalmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentPlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentPlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentPlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentPlanUpdate) check() error {
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentPlan.student"`)
+	}
+	return nil
+}
+
+func (_u *PaymentPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplan.Table, paymentplan.Columns, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TotalCents(); ok {
+		_spec.SetField(paymentplan.FieldTotalCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCents(); ok {
+		_spec.AddField(paymentplan.FieldTotalCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BaselinePaidCents(); ok {
+		_spec.SetField(paymentplan.FieldBaselinePaidCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBaselinePaidCents(); ok {
+		_spec.AddField(paymentplan.FieldBaselinePaidCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(paymentplan.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentplan.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentplan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(paymentplan.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(paymentplan.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledBy(); ok {
+		_spec.SetField(paymentplan.FieldCanceledBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplan.StudentTable,
+			Columns: []string{paymentplan.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplan.StudentTable,
+			Columns: []string{paymentplan.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstalmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInstalmentsIDs(); len(nodes) > 0 && !_u.mutation.InstalmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstalmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentPlanUpdateOne is the builder for updating a single PaymentPlan entity.
+type PaymentPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentPlanMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *PaymentPlanUpdateOne) SetStudentID(v int) *PaymentPlanUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableStudentID(v *int) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetTotalCents sets the "total_cents" field.
+func (_u *PaymentPlanUpdateOne) SetTotalCents(v int64) *PaymentPlanUpdateOne {
+	_u.mutation.ResetTotalCents()
+	_u.mutation.SetTotalCents(v)
+	return _u
+}
+
+// SetNillableTotalCents sets the "total_cents" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableTotalCents(v *int64) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetTotalCents(*v)
+	}
+	return _u
+}
+
+// AddTotalCents adds value to the "total_cents" field.
+func (_u *PaymentPlanUpdateOne) AddTotalCents(v int64) *PaymentPlanUpdateOne {
+	_u.mutation.AddTotalCents(v)
+	return _u
+}
+
+// SetBaselinePaidCents sets the "baseline_paid_cents" field.
+func (_u *PaymentPlanUpdateOne) SetBaselinePaidCents(v int64) *PaymentPlanUpdateOne {
+	_u.mutation.ResetBaselinePaidCents()
+	_u.mutation.SetBaselinePaidCents(v)
+	return _u
+}
+
+// SetNillableBaselinePaidCents sets the "baseline_paid_cents" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableBaselinePaidCents(v *int64) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetBaselinePaidCents(*v)
+	}
+	return _u
+}
+
+// AddBaselinePaidCents adds value to the "baseline_paid_cents" field.
+func (_u *PaymentPlanUpdateOne) AddBaselinePaidCents(v int64) *PaymentPlanUpdateOne {
+	_u.mutation.AddBaselinePaidCents(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *PaymentPlanUpdateOne) SetNote(v string) *PaymentPlanUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableNote(v *string) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentPlanUpdateOne) SetCreatedBy(v string) *PaymentPlanUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableCreatedBy(v *string) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PaymentPlanUpdateOne) SetCreatedAt(v time.Time) *PaymentPlanUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableCreatedAt(v *time.Time) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *PaymentPlanUpdateOne) SetCanceledAt(v time.Time) *PaymentPlanUpdateOne {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableCanceledAt(v *time.Time) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *PaymentPlanUpdateOne) ClearCanceledAt() *PaymentPlanUpdateOne {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_u *PaymentPlanUpdateOne) SetCanceledBy(v string) *PaymentPlanUpdateOne {
+	_u.mutation.SetCanceledBy(v)
+	return _u
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_u *PaymentPlanUpdateOne) SetNillableCanceledBy(v *string) *PaymentPlanUpdateOne {
+	if v != nil {
+		_u.SetCanceledBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *PaymentPlanUpdateOne) SetStudent(v *Student) *PaymentPlanUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *PaymentPlanUpdateOne) AddInvoiceIDs(ids ...int) *PaymentPlanUpdateOne {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *PaymentPlanUpdateOne) AddInvoices(v ...*Invoice) *PaymentPlanUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddInstalmentIDs adds the "instalments" edge to the PaymentPlanInstalment entity by IDs.
+func (_u *PaymentPlanUpdateOne) AddInstalmentIDs(ids ...int) *PaymentPlanUpdateOne {
+	_u.mutation.AddInstalmentIDs(ids...)
+	return _u
+}
+
+// AddInstalments adds the "instalments" edges to the PaymentPlanInstalment entity.
+func (_u *PaymentPlanUpdateOne) AddInstalments(v ...*PaymentPlanInstalment) *PaymentPlanUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInstalmentIDs(ids...)
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (_u *PaymentPlanUpdateOne) Mutation() *PaymentPlanMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *PaymentPlanUpdateOne) ClearStudent() *PaymentPlanUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *PaymentPlanUpdateOne) ClearInvoices() *PaymentPlanUpdateOne {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *PaymentPlanUpdateOne) RemoveInvoiceIDs(ids ...int) *PaymentPlanUpdateOne {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *PaymentPlanUpdateOne) RemoveInvoices(v ...*Invoice) *PaymentPlanUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearInstalments clears all "instalments" edges to the PaymentPlanInstalment entity.
+func (_u *PaymentPlanUpdateOne) ClearInstalments() *PaymentPlanUpdateOne {
+	_u.mutation.ClearInstalments()
+	return _u
+}
+
+// RemoveInstalmentIDs removes the "instalments" edge to PaymentPlanInstalment entities by IDs.
+func (_u *PaymentPlanUpdateOne) RemoveInstalmentIDs(ids ...int) *PaymentPlanUpdateOne {
+	_u.mutation.RemoveInstalmentIDs(ids...)
+	return _u
+}
+
+// RemoveInstalments removes "instalments" edges to PaymentPlanInstalment entities.
+func (_u *PaymentPlanUpdateOne) RemoveInstalments(v ...*PaymentPlanInstalment) *PaymentPlanUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInstalmentIDs(ids...)
+}
+
+// Where appends a list predicates to the PaymentPlanUpdate builder.
+func (_u *PaymentPlanUpdateOne) Where(ps ...predicate.PaymentPlan) *PaymentPlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentPlanUpdateOne) Select(field string, fields ...string) *PaymentPlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentPlan entity.
+func (_u *PaymentPlanUpdateOne) Save(ctx context.Context) (*PaymentPlan, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentPlanUpdateOne) SaveX(ctx context.Context) *PaymentPlan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentPlanUpdateOne) check() error {
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentPlan.student"`)
+	}
+	return nil
+}
+
+func (_u *PaymentPlanUpdateOne) sqlSave(ctx context.Context) (_node *PaymentPlan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplan.Table, paymentplan.Columns, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentplan.FieldID)
+		for _, f := range fields {
+			if !paymentplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TotalCents(); ok {
+		_spec.SetField(paymentplan.FieldTotalCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCents(); ok {
+		_spec.AddField(paymentplan.FieldTotalCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BaselinePaidCents(); ok {
+		_spec.SetField(paymentplan.FieldBaselinePaidCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBaselinePaidCents(); ok {
+		_spec.AddField(paymentplan.FieldBaselinePaidCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(paymentplan.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentplan.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentplan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(paymentplan.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(paymentplan.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledBy(); ok {
+		_spec.SetField(paymentplan.FieldCanceledBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplan.StudentTable,
+			Columns: []string{paymentplan.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplan.StudentTable,
+			Columns: []string{paymentplan.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   paymentplan.InvoicesTable,
+			Columns: paymentplan.InvoicesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstalmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInstalmentsIDs(); len(nodes) > 0 && !_u.mutation.InstalmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstalmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentplan.InstalmentsTable,
+			Columns: []string{paymentplan.InstalmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentPlan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
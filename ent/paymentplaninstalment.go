@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PaymentPlanInstalment is the model entity for the PaymentPlanInstalment schema.
+type PaymentPlanInstalment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID int `json:"plan_id,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq,omitempty"`
+	// DueOn holds the value of the "due_on" field.
+	DueOn time.Time `json:"due_on,omitempty"`
+	// AmountCents holds the value of the "amount_cents" field.
+	AmountCents int64 `json:"amount_cents,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentPlanInstalmentQuery when eager-loading is set.
+	Edges        PaymentPlanInstalmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentPlanInstalmentEdges holds the relations/edges for other nodes in the graph.
+type PaymentPlanInstalmentEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *PaymentPlan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentPlanInstalmentEdges) PlanOrErr() (*PaymentPlan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentplan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentPlanInstalment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentplaninstalment.FieldID, paymentplaninstalment.FieldPlanID, paymentplaninstalment.FieldSeq, paymentplaninstalment.FieldAmountCents:
+			values[i] = new(sql.NullInt64)
+		case paymentplaninstalment.FieldDueOn:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentPlanInstalment fields.
+func (_m *PaymentPlanInstalment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentplaninstalment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case paymentplaninstalment.FieldPlanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				_m.PlanID = int(value.Int64)
+			}
+		case paymentplaninstalment.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = int(value.Int64)
+			}
+		case paymentplaninstalment.FieldDueOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_on", values[i])
+			} else if value.Valid {
+				_m.DueOn = value.Time
+			}
+		case paymentplaninstalment.FieldAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_cents", values[i])
+			} else if value.Valid {
+				_m.AmountCents = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentPlanInstalment.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentPlanInstalment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlan queries the "plan" edge of the PaymentPlanInstalment entity.
+func (_m *PaymentPlanInstalment) QueryPlan() *PaymentPlanQuery {
+	return NewPaymentPlanInstalmentClient(_m.config).QueryPlan(_m)
+}
+
+// Update returns a builder for updating this PaymentPlanInstalment.
+// Note that you need to call PaymentPlanInstalment.Unwrap() before calling this method if this PaymentPlanInstalment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentPlanInstalment) Update() *PaymentPlanInstalmentUpdateOne {
+	return NewPaymentPlanInstalmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentPlanInstalment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentPlanInstalment) Unwrap() *PaymentPlanInstalment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentPlanInstalment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentPlanInstalment) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentPlanInstalment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("plan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlanID))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("due_on=")
+	builder.WriteString(_m.DueOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentPlanInstalments is a parsable slice of PaymentPlanInstalment.
+type PaymentPlanInstalments []*PaymentPlanInstalment
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentplaninstalment
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLTE(FieldID, id))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldPlanID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldSeq, v))
+}
+
+// DueOn applies equality check predicate on the "due_on" field. It's identical to DueOnEQ.
+func DueOn(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldDueOn, v))
+}
+
+// AmountCents applies equality check predicate on the "amount_cents" field. It's identical to AmountCentsEQ.
+func AmountCents(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLTE(FieldSeq, v))
+}
+
+// DueOnEQ applies the EQ predicate on the "due_on" field.
+func DueOnEQ(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldDueOn, v))
+}
+
+// DueOnNEQ applies the NEQ predicate on the "due_on" field.
+func DueOnNEQ(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNEQ(FieldDueOn, v))
+}
+
+// DueOnIn applies the In predicate on the "due_on" field.
+func DueOnIn(vs ...time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldIn(FieldDueOn, vs...))
+}
+
+// DueOnNotIn applies the NotIn predicate on the "due_on" field.
+func DueOnNotIn(vs ...time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNotIn(FieldDueOn, vs...))
+}
+
+// DueOnGT applies the GT predicate on the "due_on" field.
+func DueOnGT(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGT(FieldDueOn, v))
+}
+
+// DueOnGTE applies the GTE predicate on the "due_on" field.
+func DueOnGTE(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGTE(FieldDueOn, v))
+}
+
+// DueOnLT applies the LT predicate on the "due_on" field.
+func DueOnLT(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLT(FieldDueOn, v))
+}
+
+// DueOnLTE applies the LTE predicate on the "due_on" field.
+func DueOnLTE(v time.Time) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLTE(FieldDueOn, v))
+}
+
+// AmountCentsEQ applies the EQ predicate on the "amount_cents" field.
+func AmountCentsEQ(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// AmountCentsNEQ applies the NEQ predicate on the "amount_cents" field.
+func AmountCentsNEQ(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNEQ(FieldAmountCents, v))
+}
+
+// AmountCentsIn applies the In predicate on the "amount_cents" field.
+func AmountCentsIn(vs ...int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsNotIn applies the NotIn predicate on the "amount_cents" field.
+func AmountCentsNotIn(vs ...int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldNotIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsGT applies the GT predicate on the "amount_cents" field.
+func AmountCentsGT(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGT(FieldAmountCents, v))
+}
+
+// AmountCentsGTE applies the GTE predicate on the "amount_cents" field.
+func AmountCentsGTE(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldGTE(FieldAmountCents, v))
+}
+
+// AmountCentsLT applies the LT predicate on the "amount_cents" field.
+func AmountCentsLT(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLT(FieldAmountCents, v))
+}
+
+// AmountCentsLTE applies the LTE predicate on the "amount_cents" field.
+func AmountCentsLTE(v int64) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.FieldLTE(FieldAmountCents, v))
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.PaymentPlan) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentPlanInstalment) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentPlanInstalment) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentPlanInstalment) predicate.PaymentPlanInstalment {
+	return predicate.PaymentPlanInstalment(sql.NotPredicates(p))
+}
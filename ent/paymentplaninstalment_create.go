@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentPlanInstalmentCreate is the builder for creating a PaymentPlanInstalment entity.
+type PaymentPlanInstalmentCreate struct {
+	config
+	mutation *PaymentPlanInstalmentMutation
+	hooks    []Hook
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_c *PaymentPlanInstalmentCreate) SetPlanID(v int) *PaymentPlanInstalmentCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *PaymentPlanInstalmentCreate) SetSeq(v int) *PaymentPlanInstalmentCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetDueOn sets the "due_on" field.
+func (_c *PaymentPlanInstalmentCreate) SetDueOn(v time.Time) *PaymentPlanInstalmentCreate {
+	_c.mutation.SetDueOn(v)
+	return _c
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_c *PaymentPlanInstalmentCreate) SetAmountCents(v int64) *PaymentPlanInstalmentCreate {
+	_c.mutation.SetAmountCents(v)
+	return _c
+}
+
+// SetPlan sets the "plan" edge to the PaymentPlan entity.
+func (_c *PaymentPlanInstalmentCreate) SetPlan(v *PaymentPlan) *PaymentPlanInstalmentCreate {
+	return _c.SetPlanID(v.ID)
+}
+
+// Mutation returns the PaymentPlanInstalmentMutation object of the builder.
+func (_c *PaymentPlanInstalmentCreate) Mutation() *PaymentPlanInstalmentMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentPlanInstalment in the database.
+func (_c *PaymentPlanInstalmentCreate) Save(ctx context.Context) (*PaymentPlanInstalment, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentPlanInstalmentCreate) SaveX(ctx context.Context) *PaymentPlanInstalment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentPlanInstalmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentPlanInstalmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentPlanInstalmentCreate) check() error {
+	if _, ok := _c.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "PaymentPlanInstalment.plan_id"`)}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "PaymentPlanInstalment.seq"`)}
+	}
+	if _, ok := _c.mutation.DueOn(); !ok {
+		return &ValidationError{Name: "due_on", err: errors.New(`ent: missing required field "PaymentPlanInstalment.due_on"`)}
+	}
+	if _, ok := _c.mutation.AmountCents(); !ok {
+		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "PaymentPlanInstalment.amount_cents"`)}
+	}
+	if len(_c.mutation.PlanIDs()) == 0 {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required edge "PaymentPlanInstalment.plan"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentPlanInstalmentCreate) sqlSave(ctx context.Context) (*PaymentPlanInstalment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentPlanInstalmentCreate) createSpec() (*PaymentPlanInstalment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentPlanInstalment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentplaninstalment.Table, sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(paymentplaninstalment.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.DueOn(); ok {
+		_spec.SetField(paymentplaninstalment.FieldDueOn, field.TypeTime, value)
+		_node.DueOn = value
+	}
+	if value, ok := _c.mutation.AmountCents(); ok {
+		_spec.SetField(paymentplaninstalment.FieldAmountCents, field.TypeInt64, value)
+		_node.AmountCents = value
+	}
+	if nodes := _c.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplaninstalment.PlanTable,
+			Columns: []string{paymentplaninstalment.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentPlanInstalmentCreateBulk is the builder for creating many PaymentPlanInstalment entities in bulk.
+type PaymentPlanInstalmentCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentPlanInstalmentCreate
+}
+
+// Save creates the PaymentPlanInstalment entities in the database.
+func (_c *PaymentPlanInstalmentCreateBulk) Save(ctx context.Context) ([]*PaymentPlanInstalment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentPlanInstalment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentPlanInstalmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentPlanInstalmentCreateBulk) SaveX(ctx context.Context) []*PaymentPlanInstalment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentPlanInstalmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentPlanInstalmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
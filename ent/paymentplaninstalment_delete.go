@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentPlanInstalmentDelete is the builder for deleting a PaymentPlanInstalment entity.
+type PaymentPlanInstalmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentPlanInstalmentMutation
+}
+
+// Where appends a list predicates to the PaymentPlanInstalmentDelete builder.
+func (_d *PaymentPlanInstalmentDelete) Where(ps ...predicate.PaymentPlanInstalment) *PaymentPlanInstalmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PaymentPlanInstalmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentPlanInstalmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PaymentPlanInstalmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentplaninstalment.Table, sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PaymentPlanInstalmentDeleteOne is the builder for deleting a single PaymentPlanInstalment entity.
+type PaymentPlanInstalmentDeleteOne struct {
+	_d *PaymentPlanInstalmentDelete
+}
+
+// Where appends a list predicates to the PaymentPlanInstalmentDelete builder.
+func (_d *PaymentPlanInstalmentDeleteOne) Where(ps ...predicate.PaymentPlanInstalment) *PaymentPlanInstalmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PaymentPlanInstalmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentplaninstalment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PaymentPlanInstalmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentPlanInstalmentUpdate is the builder for updating PaymentPlanInstalment entities.
+type PaymentPlanInstalmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentPlanInstalmentMutation
+}
+
+// Where appends a list predicates to the PaymentPlanInstalmentUpdate builder.
+func (_u *PaymentPlanInstalmentUpdate) Where(ps ...predicate.PaymentPlanInstalment) *PaymentPlanInstalmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *PaymentPlanInstalmentUpdate) SetPlanID(v int) *PaymentPlanInstalmentUpdate {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdate) SetNillablePlanID(v *int) *PaymentPlanInstalmentUpdate {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *PaymentPlanInstalmentUpdate) SetSeq(v int) *PaymentPlanInstalmentUpdate {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdate) SetNillableSeq(v *int) *PaymentPlanInstalmentUpdate {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *PaymentPlanInstalmentUpdate) AddSeq(v int) *PaymentPlanInstalmentUpdate {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetDueOn sets the "due_on" field.
+func (_u *PaymentPlanInstalmentUpdate) SetDueOn(v time.Time) *PaymentPlanInstalmentUpdate {
+	_u.mutation.SetDueOn(v)
+	return _u
+}
+
+// SetNillableDueOn sets the "due_on" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdate) SetNillableDueOn(v *time.Time) *PaymentPlanInstalmentUpdate {
+	if v != nil {
+		_u.SetDueOn(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *PaymentPlanInstalmentUpdate) SetAmountCents(v int64) *PaymentPlanInstalmentUpdate {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdate) SetNillableAmountCents(v *int64) *PaymentPlanInstalmentUpdate {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *PaymentPlanInstalmentUpdate) AddAmountCents(v int64) *PaymentPlanInstalmentUpdate {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetPlan sets the "plan" edge to the PaymentPlan entity.
+func (_u *PaymentPlanInstalmentUpdate) SetPlan(v *PaymentPlan) *PaymentPlanInstalmentUpdate {
+	return _u.SetPlanID(v.ID)
+}
+
+// Mutation returns the PaymentPlanInstalmentMutation object of the builder.
+func (_u *PaymentPlanInstalmentUpdate) Mutation() *PaymentPlanInstalmentMutation {
+	return _u.mutation
+}
+
+// ClearPlan clears the "plan" edge to the PaymentPlan entity.
+func (_u *PaymentPlanInstalmentUpdate) ClearPlan() *PaymentPlanInstalmentUpdate {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentPlanInstalmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentPlanInstalmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentPlanInstalmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentPlanInstalmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentPlanInstalmentUpdate) check() error {
+	if _u.mutation.PlanCleared() && len(_u.mutation.PlanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentPlanInstalment.plan"`)
+	}
+	return nil
+}
+
+func (_u *PaymentPlanInstalmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplaninstalment.Table, paymentplaninstalment.Columns, sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(paymentplaninstalment.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(paymentplaninstalment.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueOn(); ok {
+		_spec.SetField(paymentplaninstalment.FieldDueOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(paymentplaninstalment.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(paymentplaninstalment.FieldAmountCents, field.TypeInt64, value)
+	}
+	if _u.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplaninstalment.PlanTable,
+			Columns: []string{paymentplaninstalment.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplaninstalment.PlanTable,
+			Columns: []string{paymentplaninstalment.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplaninstalment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentPlanInstalmentUpdateOne is the builder for updating a single PaymentPlanInstalment entity.
+type PaymentPlanInstalmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentPlanInstalmentMutation
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *PaymentPlanInstalmentUpdateOne) SetPlanID(v int) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdateOne) SetNillablePlanID(v *int) *PaymentPlanInstalmentUpdateOne {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *PaymentPlanInstalmentUpdateOne) SetSeq(v int) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdateOne) SetNillableSeq(v *int) *PaymentPlanInstalmentUpdateOne {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *PaymentPlanInstalmentUpdateOne) AddSeq(v int) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetDueOn sets the "due_on" field.
+func (_u *PaymentPlanInstalmentUpdateOne) SetDueOn(v time.Time) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.SetDueOn(v)
+	return _u
+}
+
+// SetNillableDueOn sets the "due_on" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdateOne) SetNillableDueOn(v *time.Time) *PaymentPlanInstalmentUpdateOne {
+	if v != nil {
+		_u.SetDueOn(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *PaymentPlanInstalmentUpdateOne) SetAmountCents(v int64) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *PaymentPlanInstalmentUpdateOne) SetNillableAmountCents(v *int64) *PaymentPlanInstalmentUpdateOne {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *PaymentPlanInstalmentUpdateOne) AddAmountCents(v int64) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetPlan sets the "plan" edge to the PaymentPlan entity.
+func (_u *PaymentPlanInstalmentUpdateOne) SetPlan(v *PaymentPlan) *PaymentPlanInstalmentUpdateOne {
+	return _u.SetPlanID(v.ID)
+}
+
+// Mutation returns the PaymentPlanInstalmentMutation object of the builder.
+func (_u *PaymentPlanInstalmentUpdateOne) Mutation() *PaymentPlanInstalmentMutation {
+	return _u.mutation
+}
+
+// ClearPlan clears the "plan" edge to the PaymentPlan entity.
+func (_u *PaymentPlanInstalmentUpdateOne) ClearPlan() *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// Where appends a list predicates to the PaymentPlanInstalmentUpdate builder.
+func (_u *PaymentPlanInstalmentUpdateOne) Where(ps ...predicate.PaymentPlanInstalment) *PaymentPlanInstalmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentPlanInstalmentUpdateOne) Select(field string, fields ...string) *PaymentPlanInstalmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentPlanInstalment entity.
+func (_u *PaymentPlanInstalmentUpdateOne) Save(ctx context.Context) (*PaymentPlanInstalment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentPlanInstalmentUpdateOne) SaveX(ctx context.Context) *PaymentPlanInstalment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentPlanInstalmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentPlanInstalmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentPlanInstalmentUpdateOne) check() error {
+	if _u.mutation.PlanCleared() && len(_u.mutation.PlanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentPlanInstalment.plan"`)
+	}
+	return nil
+}
+
+func (_u *PaymentPlanInstalmentUpdateOne) sqlSave(ctx context.Context) (_node *PaymentPlanInstalment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplaninstalment.Table, paymentplaninstalment.Columns, sqlgraph.NewFieldSpec(paymentplaninstalment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentPlanInstalment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentplaninstalment.FieldID)
+		for _, f := range fields {
+			if !paymentplaninstalment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentplaninstalment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(paymentplaninstalment.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(paymentplaninstalment.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueOn(); ok {
+		_spec.SetField(paymentplaninstalment.FieldDueOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(paymentplaninstalment.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(paymentplaninstalment.FieldAmountCents, field.TypeInt64, value)
+	}
+	if _u.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplaninstalment.PlanTable,
+			Columns: []string{paymentplaninstalment.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentplaninstalment.PlanTable,
+			Columns: []string{paymentplaninstalment.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentPlanInstalment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplaninstalment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
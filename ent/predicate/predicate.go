@@ -12,35 +12,89 @@ type AttendanceMonth func(*sql.Selector)
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// BillingTerm is the predicate function for billingterm builders.
+type BillingTerm func(*sql.Selector)
+
+// CashMovement is the predicate function for cashmovement builders.
+type CashMovement func(*sql.Selector)
+
+// CashReceipt is the predicate function for cashreceipt builders.
+type CashReceipt func(*sql.Selector)
+
+// CashSession is the predicate function for cashsession builders.
+type CashSession func(*sql.Selector)
+
 // Course is the predicate function for course builders.
 type Course func(*sql.Selector)
 
 // CourseMonthStat is the predicate function for coursemonthstat builders.
 type CourseMonthStat func(*sql.Selector)
 
+// CoursePrice is the predicate function for courseprice builders.
+type CoursePrice func(*sql.Selector)
+
 // Enrollment is the predicate function for enrollment builders.
 type Enrollment func(*sql.Selector)
 
+// EnrollmentPause is the predicate function for enrollmentpause builders.
+type EnrollmentPause func(*sql.Selector)
+
+// EnrollmentPrice is the predicate function for enrollmentprice builders.
+type EnrollmentPrice func(*sql.Selector)
+
+// ExcusedAbsence is the predicate function for excusedabsence builders.
+type ExcusedAbsence func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
 // Invoice is the predicate function for invoice builders.
 type Invoice func(*sql.Selector)
 
 // InvoiceLine is the predicate function for invoiceline builders.
 type InvoiceLine func(*sql.Selector)
 
+// LateFee is the predicate function for latefee builders.
+type LateFee func(*sql.Selector)
+
+// Lead is the predicate function for lead builders.
+type Lead func(*sql.Selector)
+
+// LessonPackage is the predicate function for lessonpackage builders.
+type LessonPackage func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
+// PaymentPlan is the predicate function for paymentplan builders.
+type PaymentPlan func(*sql.Selector)
+
+// PaymentPlanInstalment is the predicate function for paymentplaninstalment builders.
+type PaymentPlanInstalment func(*sql.Selector)
+
+// Room is the predicate function for room builders.
+type Room func(*sql.Selector)
+
+// ScheduleSlot is the predicate function for scheduleslot builders.
+type ScheduleSlot func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
 // Student is the predicate function for student builders.
 type Student func(*sql.Selector)
 
+// StudentCharge is the predicate function for studentcharge builders.
+type StudentCharge func(*sql.Selector)
+
 // Teacher is the predicate function for teacher builders.
 type Teacher func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
+
 // WebSession is the predicate function for websession builders.
 type WebSession func(*sql.Selector)
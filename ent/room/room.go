@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package room
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the room type in the database.
+	Label = "room"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCapacity holds the string denoting the capacity field in the database.
+	FieldCapacity = "capacity"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeScheduleSlots holds the string denoting the schedule_slots edge name in mutations.
+	EdgeScheduleSlots = "schedule_slots"
+	// Table holds the table name of the room in the database.
+	Table = "rooms"
+	// ScheduleSlotsTable is the table that holds the schedule_slots relation/edge.
+	ScheduleSlotsTable = "schedule_slots"
+	// ScheduleSlotsInverseTable is the table name for the ScheduleSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduleslot" package.
+	ScheduleSlotsInverseTable = "schedule_slots"
+	// ScheduleSlotsColumn is the table column denoting the schedule_slots relation/edge.
+	ScheduleSlotsColumn = "room_id"
+)
+
+// Columns holds all SQL columns for room fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldName,
+	FieldCapacity,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultCapacity holds the default value on creation for the "capacity" field.
+	DefaultCapacity int
+	// CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	CapacityValidator func(int) error
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Room queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCapacity orders the results by the capacity field.
+func ByCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacity, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByScheduleSlotsCount orders the results by schedule_slots count.
+func ByScheduleSlotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduleSlotsStep(), opts...)
+	}
+}
+
+// ByScheduleSlots orders the results by schedule_slots terms.
+func ByScheduleSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduleSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newScheduleSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduleSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduleSlotsTable, ScheduleSlotsColumn),
+	)
+}
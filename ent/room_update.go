@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/predicate"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoomUpdate is the builder for updating Room entities.
+type RoomUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoomMutation
+}
+
+// Where appends a list predicates to the RoomUpdate builder.
+func (_u *RoomUpdate) Where(ps ...predicate.Room) *RoomUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *RoomUpdate) SetVersion(v int) *RoomUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableVersion(v *int) *RoomUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *RoomUpdate) AddVersion(v int) *RoomUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoomUpdate) SetName(v string) *RoomUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableName(v *string) *RoomUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *RoomUpdate) SetCapacity(v int) *RoomUpdate {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableCapacity(v *int) *RoomUpdate {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *RoomUpdate) AddCapacity(v int) *RoomUpdate {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *RoomUpdate) SetNote(v string) *RoomUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableNote(v *string) *RoomUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RoomUpdate) SetCreatedAt(v time.Time) *RoomUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableCreatedAt(v *time.Time) *RoomUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *RoomUpdate) AddScheduleSlotIDs(ids ...int) *RoomUpdate {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *RoomUpdate) AddScheduleSlots(v ...*ScheduleSlot) *RoomUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
+// Mutation returns the RoomMutation object of the builder.
+func (_u *RoomUpdate) Mutation() *RoomMutation {
+	return _u.mutation
+}
+
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *RoomUpdate) ClearScheduleSlots() *RoomUpdate {
+	_u.mutation.ClearScheduleSlots()
+	return _u
+}
+
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *RoomUpdate) RemoveScheduleSlotIDs(ids ...int) *RoomUpdate {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *RoomUpdate) RemoveScheduleSlots(v ...*ScheduleSlot) *RoomUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduleSlotIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoomUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoomUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoomUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoomUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoomUpdate) check() error {
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := room.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Room.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoomUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(room.Table, room.Columns, sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(room.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(room.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(room.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(room.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(room.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(room.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(room.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{room.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoomUpdateOne is the builder for updating a single Room entity.
+type RoomUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomMutation
+}
+
+// SetVersion sets the "version" field.
+func (_u *RoomUpdateOne) SetVersion(v int) *RoomUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableVersion(v *int) *RoomUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *RoomUpdateOne) AddVersion(v int) *RoomUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoomUpdateOne) SetName(v string) *RoomUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableName(v *string) *RoomUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *RoomUpdateOne) SetCapacity(v int) *RoomUpdateOne {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableCapacity(v *int) *RoomUpdateOne {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *RoomUpdateOne) AddCapacity(v int) *RoomUpdateOne {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *RoomUpdateOne) SetNote(v string) *RoomUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableNote(v *string) *RoomUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RoomUpdateOne) SetCreatedAt(v time.Time) *RoomUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableCreatedAt(v *time.Time) *RoomUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *RoomUpdateOne) AddScheduleSlotIDs(ids ...int) *RoomUpdateOne {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *RoomUpdateOne) AddScheduleSlots(v ...*ScheduleSlot) *RoomUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
+// Mutation returns the RoomMutation object of the builder.
+func (_u *RoomUpdateOne) Mutation() *RoomMutation {
+	return _u.mutation
+}
+
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *RoomUpdateOne) ClearScheduleSlots() *RoomUpdateOne {
+	_u.mutation.ClearScheduleSlots()
+	return _u
+}
+
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *RoomUpdateOne) RemoveScheduleSlotIDs(ids ...int) *RoomUpdateOne {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *RoomUpdateOne) RemoveScheduleSlots(v ...*ScheduleSlot) *RoomUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduleSlotIDs(ids...)
+}
+
+// Where appends a list predicates to the RoomUpdate builder.
+func (_u *RoomUpdateOne) Where(ps ...predicate.Room) *RoomUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoomUpdateOne) Select(field string, fields ...string) *RoomUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Room entity.
+func (_u *RoomUpdateOne) Save(ctx context.Context) (*Room, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoomUpdateOne) SaveX(ctx context.Context) *Room {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoomUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoomUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoomUpdateOne) check() error {
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := room.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Room.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(room.Table, room.Columns, sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Room.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, room.FieldID)
+		for _, f := range fields {
+			if !room.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != room.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(room.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(room.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(room.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(room.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(room.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(room.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(room.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   room.ScheduleSlotsTable,
+			Columns: []string{room.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Room{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{room.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,17 +5,34 @@ package ent
 import (
 	"langschool/ent/attendancemonth"
 	"langschool/ent/auditlog"
+	"langschool/ent/billingterm"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
 	"langschool/ent/course"
 	"langschool/ent/coursemonthstat"
+	"langschool/ent/courseprice"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/idempotencykey"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/schema"
 	"langschool/ent/settings"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
 	"langschool/ent/teacher"
 	"langschool/ent/user"
+	"langschool/ent/waitlistentry"
 	"langschool/ent/websession"
 	"time"
 )
@@ -48,6 +65,82 @@ func init() {
 	auditlogDescCreatedAt := auditlogFields[10].Descriptor()
 	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	billingtermFields := schema.BillingTerm{}.Fields()
+	_ = billingtermFields
+	// billingtermDescStartMonth is the schema descriptor for start_month field.
+	billingtermDescStartMonth := billingtermFields[2].Descriptor()
+	// billingterm.StartMonthValidator is a validator for the "start_month" field. It is called by the builders before save.
+	billingterm.StartMonthValidator = billingtermDescStartMonth.Validators[0].(func(int) error)
+	// billingtermDescEndMonth is the schema descriptor for end_month field.
+	billingtermDescEndMonth := billingtermFields[4].Descriptor()
+	// billingterm.EndMonthValidator is a validator for the "end_month" field. It is called by the builders before save.
+	billingterm.EndMonthValidator = billingtermDescEndMonth.Validators[0].(func(int) error)
+	// billingtermDescCreatedBy is the schema descriptor for created_by field.
+	billingtermDescCreatedBy := billingtermFields[7].Descriptor()
+	// billingterm.DefaultCreatedBy holds the default value on creation for the created_by field.
+	billingterm.DefaultCreatedBy = billingtermDescCreatedBy.Default.(string)
+	// billingtermDescCreatedAt is the schema descriptor for created_at field.
+	billingtermDescCreatedAt := billingtermFields[8].Descriptor()
+	// billingterm.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingterm.DefaultCreatedAt = billingtermDescCreatedAt.Default.(func() time.Time)
+	cashmovementFields := schema.CashMovement{}.Fields()
+	_ = cashmovementFields
+	// cashmovementDescNote is the schema descriptor for note field.
+	cashmovementDescNote := cashmovementFields[3].Descriptor()
+	// cashmovement.DefaultNote holds the default value on creation for the note field.
+	cashmovement.DefaultNote = cashmovementDescNote.Default.(string)
+	// cashmovementDescCreatedBy is the schema descriptor for created_by field.
+	cashmovementDescCreatedBy := cashmovementFields[5].Descriptor()
+	// cashmovement.DefaultCreatedBy holds the default value on creation for the created_by field.
+	cashmovement.DefaultCreatedBy = cashmovementDescCreatedBy.Default.(string)
+	// cashmovementDescCreatedAt is the schema descriptor for created_at field.
+	cashmovementDescCreatedAt := cashmovementFields[6].Descriptor()
+	// cashmovement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashmovement.DefaultCreatedAt = cashmovementDescCreatedAt.Default.(func() time.Time)
+	cashreceiptFields := schema.CashReceipt{}.Fields()
+	_ = cashreceiptFields
+	// cashreceiptDescReceivedBy is the schema descriptor for received_by field.
+	cashreceiptDescReceivedBy := cashreceiptFields[6].Descriptor()
+	// cashreceipt.DefaultReceivedBy holds the default value on creation for the received_by field.
+	cashreceipt.DefaultReceivedBy = cashreceiptDescReceivedBy.Default.(string)
+	// cashreceiptDescNote is the schema descriptor for note field.
+	cashreceiptDescNote := cashreceiptFields[7].Descriptor()
+	// cashreceipt.DefaultNote holds the default value on creation for the note field.
+	cashreceipt.DefaultNote = cashreceiptDescNote.Default.(string)
+	// cashreceiptDescVoidedBy is the schema descriptor for voided_by field.
+	cashreceiptDescVoidedBy := cashreceiptFields[10].Descriptor()
+	// cashreceipt.DefaultVoidedBy holds the default value on creation for the voided_by field.
+	cashreceipt.DefaultVoidedBy = cashreceiptDescVoidedBy.Default.(string)
+	// cashreceiptDescCreatedAt is the schema descriptor for created_at field.
+	cashreceiptDescCreatedAt := cashreceiptFields[11].Descriptor()
+	// cashreceipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashreceipt.DefaultCreatedAt = cashreceiptDescCreatedAt.Default.(func() time.Time)
+	cashsessionFields := schema.CashSession{}.Fields()
+	_ = cashsessionFields
+	// cashsessionDescOpenedAt is the schema descriptor for opened_at field.
+	cashsessionDescOpenedAt := cashsessionFields[0].Descriptor()
+	// cashsession.DefaultOpenedAt holds the default value on creation for the opened_at field.
+	cashsession.DefaultOpenedAt = cashsessionDescOpenedAt.Default.(func() time.Time)
+	// cashsessionDescOpenedBy is the schema descriptor for opened_by field.
+	cashsessionDescOpenedBy := cashsessionFields[2].Descriptor()
+	// cashsession.DefaultOpenedBy holds the default value on creation for the opened_by field.
+	cashsession.DefaultOpenedBy = cashsessionDescOpenedBy.Default.(string)
+	// cashsessionDescOpeningFloatCents is the schema descriptor for opening_float_cents field.
+	cashsessionDescOpeningFloatCents := cashsessionFields[3].Descriptor()
+	// cashsession.DefaultOpeningFloatCents holds the default value on creation for the opening_float_cents field.
+	cashsession.DefaultOpeningFloatCents = cashsessionDescOpeningFloatCents.Default.(int64)
+	// cashsessionDescClosedBy is the schema descriptor for closed_by field.
+	cashsessionDescClosedBy := cashsessionFields[6].Descriptor()
+	// cashsession.DefaultClosedBy holds the default value on creation for the closed_by field.
+	cashsession.DefaultClosedBy = cashsessionDescClosedBy.Default.(string)
+	// cashsessionDescNote is the schema descriptor for note field.
+	cashsessionDescNote := cashsessionFields[8].Descriptor()
+	// cashsession.DefaultNote holds the default value on creation for the note field.
+	cashsession.DefaultNote = cashsessionDescNote.Default.(string)
+	// cashsessionDescReopenedBy is the schema descriptor for reopened_by field.
+	cashsessionDescReopenedBy := cashsessionFields[10].Descriptor()
+	// cashsession.DefaultReopenedBy holds the default value on creation for the reopened_by field.
+	cashsession.DefaultReopenedBy = cashsessionDescReopenedBy.Default.(string)
 	courseMixin := schema.Course{}.Mixin()
 	courseMixinFields0 := courseMixin[0].Fields()
 	_ = courseMixinFields0
@@ -77,16 +170,44 @@ func init() {
 	courseDescSubscriptionPriceCents := courseFields[7].Descriptor()
 	// course.DefaultSubscriptionPriceCents holds the default value on creation for the subscription_price_cents field.
 	course.DefaultSubscriptionPriceCents = courseDescSubscriptionPriceCents.Default.(int64)
+	// courseDescVatRatePct is the schema descriptor for vat_rate_pct field.
+	courseDescVatRatePct := courseFields[8].Descriptor()
+	// course.DefaultVatRatePct holds the default value on creation for the vat_rate_pct field.
+	course.DefaultVatRatePct = courseDescVatRatePct.Default.(float64)
+	// courseDescVatExemptNote is the schema descriptor for vat_exempt_note field.
+	courseDescVatExemptNote := courseFields[9].Descriptor()
+	// course.DefaultVatExemptNote holds the default value on creation for the vat_exempt_note field.
+	course.DefaultVatExemptNote = courseDescVatExemptNote.Default.(string)
 	// courseDescIsActive is the schema descriptor for is_active field.
-	courseDescIsActive := courseFields[8].Descriptor()
+	courseDescIsActive := courseFields[10].Descriptor()
 	// course.DefaultIsActive holds the default value on creation for the is_active field.
 	course.DefaultIsActive = courseDescIsActive.Default.(bool)
+	// courseDescMaxStudents is the schema descriptor for max_students field.
+	courseDescMaxStudents := courseFields[12].Descriptor()
+	// course.DefaultMaxStudents holds the default value on creation for the max_students field.
+	course.DefaultMaxStudents = courseDescMaxStudents.Default.(int)
+	// course.MaxStudentsValidator is a validator for the "max_students" field. It is called by the builders before save.
+	course.MaxStudentsValidator = courseDescMaxStudents.Validators[0].(func(int) error)
 	coursemonthstatFields := schema.CourseMonthStat{}.Fields()
 	_ = coursemonthstatFields
 	// coursemonthstatDescSubscriptionLessonsHeld is the schema descriptor for subscription_lessons_held field.
 	coursemonthstatDescSubscriptionLessonsHeld := coursemonthstatFields[3].Descriptor()
 	// coursemonthstat.DefaultSubscriptionLessonsHeld holds the default value on creation for the subscription_lessons_held field.
 	coursemonthstat.DefaultSubscriptionLessonsHeld = coursemonthstatDescSubscriptionLessonsHeld.Default.(float64)
+	coursepriceFields := schema.CoursePrice{}.Fields()
+	_ = coursepriceFields
+	// coursepriceDescEffectiveMonth is the schema descriptor for effective_month field.
+	coursepriceDescEffectiveMonth := coursepriceFields[2].Descriptor()
+	// courseprice.EffectiveMonthValidator is a validator for the "effective_month" field. It is called by the builders before save.
+	courseprice.EffectiveMonthValidator = coursepriceDescEffectiveMonth.Validators[0].(func(int) error)
+	// coursepriceDescCreatedBy is the schema descriptor for created_by field.
+	coursepriceDescCreatedBy := coursepriceFields[5].Descriptor()
+	// courseprice.DefaultCreatedBy holds the default value on creation for the created_by field.
+	courseprice.DefaultCreatedBy = coursepriceDescCreatedBy.Default.(string)
+	// coursepriceDescCreatedAt is the schema descriptor for created_at field.
+	coursepriceDescCreatedAt := coursepriceFields[6].Descriptor()
+	// courseprice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	courseprice.DefaultCreatedAt = coursepriceDescCreatedAt.Default.(func() time.Time)
 	enrollmentMixin := schema.Enrollment{}.Mixin()
 	enrollmentMixinFields0 := enrollmentMixin[0].Fields()
 	_ = enrollmentMixinFields0
@@ -117,15 +238,71 @@ func init() {
 	// enrollment.DefaultNote holds the default value on creation for the note field.
 	enrollment.DefaultNote = enrollmentDescNote.Default.(string)
 	// enrollmentDescCreatedAt is the schema descriptor for created_at field.
-	enrollmentDescCreatedAt := enrollmentFields[8].Descriptor()
+	enrollmentDescCreatedAt := enrollmentFields[10].Descriptor()
 	// enrollment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	enrollment.DefaultCreatedAt = enrollmentDescCreatedAt.Default.(func() time.Time)
 	// enrollmentDescUpdatedAt is the schema descriptor for updated_at field.
-	enrollmentDescUpdatedAt := enrollmentFields[9].Descriptor()
+	enrollmentDescUpdatedAt := enrollmentFields[11].Descriptor()
 	// enrollment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	enrollment.DefaultUpdatedAt = enrollmentDescUpdatedAt.Default.(func() time.Time)
 	// enrollment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	enrollment.UpdateDefaultUpdatedAt = enrollmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	enrollmentpauseFields := schema.EnrollmentPause{}.Fields()
+	_ = enrollmentpauseFields
+	// enrollmentpauseDescReason is the schema descriptor for reason field.
+	enrollmentpauseDescReason := enrollmentpauseFields[3].Descriptor()
+	// enrollmentpause.DefaultReason holds the default value on creation for the reason field.
+	enrollmentpause.DefaultReason = enrollmentpauseDescReason.Default.(string)
+	// enrollmentpauseDescCreatedBy is the schema descriptor for created_by field.
+	enrollmentpauseDescCreatedBy := enrollmentpauseFields[4].Descriptor()
+	// enrollmentpause.DefaultCreatedBy holds the default value on creation for the created_by field.
+	enrollmentpause.DefaultCreatedBy = enrollmentpauseDescCreatedBy.Default.(string)
+	// enrollmentpauseDescCreatedAt is the schema descriptor for created_at field.
+	enrollmentpauseDescCreatedAt := enrollmentpauseFields[5].Descriptor()
+	// enrollmentpause.DefaultCreatedAt holds the default value on creation for the created_at field.
+	enrollmentpause.DefaultCreatedAt = enrollmentpauseDescCreatedAt.Default.(func() time.Time)
+	enrollmentpriceFields := schema.EnrollmentPrice{}.Fields()
+	_ = enrollmentpriceFields
+	// enrollmentpriceDescEffectiveMonth is the schema descriptor for effective_month field.
+	enrollmentpriceDescEffectiveMonth := enrollmentpriceFields[2].Descriptor()
+	// enrollmentprice.EffectiveMonthValidator is a validator for the "effective_month" field. It is called by the builders before save.
+	enrollmentprice.EffectiveMonthValidator = enrollmentpriceDescEffectiveMonth.Validators[0].(func(int) error)
+	// enrollmentpriceDescCreatedBy is the schema descriptor for created_by field.
+	enrollmentpriceDescCreatedBy := enrollmentpriceFields[5].Descriptor()
+	// enrollmentprice.DefaultCreatedBy holds the default value on creation for the created_by field.
+	enrollmentprice.DefaultCreatedBy = enrollmentpriceDescCreatedBy.Default.(string)
+	// enrollmentpriceDescCreatedAt is the schema descriptor for created_at field.
+	enrollmentpriceDescCreatedAt := enrollmentpriceFields[6].Descriptor()
+	// enrollmentprice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	enrollmentprice.DefaultCreatedAt = enrollmentpriceDescCreatedAt.Default.(func() time.Time)
+	excusedabsenceFields := schema.ExcusedAbsence{}.Fields()
+	_ = excusedabsenceFields
+	// excusedabsenceDescReason is the schema descriptor for reason field.
+	excusedabsenceDescReason := excusedabsenceFields[3].Descriptor()
+	// excusedabsence.DefaultReason holds the default value on creation for the reason field.
+	excusedabsence.DefaultReason = excusedabsenceDescReason.Default.(string)
+	// excusedabsenceDescCreatedBy is the schema descriptor for created_by field.
+	excusedabsenceDescCreatedBy := excusedabsenceFields[8].Descriptor()
+	// excusedabsence.DefaultCreatedBy holds the default value on creation for the created_by field.
+	excusedabsence.DefaultCreatedBy = excusedabsenceDescCreatedBy.Default.(string)
+	// excusedabsenceDescCreatedAt is the schema descriptor for created_at field.
+	excusedabsenceDescCreatedAt := excusedabsenceFields[9].Descriptor()
+	// excusedabsence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	excusedabsence.DefaultCreatedAt = excusedabsenceDescCreatedAt.Default.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescStatusCode is the schema descriptor for status_code field.
+	idempotencykeyDescStatusCode := idempotencykeyFields[5].Descriptor()
+	// idempotencykey.DefaultStatusCode holds the default value on creation for the status_code field.
+	idempotencykey.DefaultStatusCode = idempotencykeyDescStatusCode.Default.(int)
+	// idempotencykeyDescContentType is the schema descriptor for content_type field.
+	idempotencykeyDescContentType := idempotencykeyFields[6].Descriptor()
+	// idempotencykey.DefaultContentType holds the default value on creation for the content_type field.
+	idempotencykey.DefaultContentType = idempotencykeyDescContentType.Default.(string)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[8].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
 	invoiceMixin := schema.Invoice{}.Mixin()
 	invoiceMixinFields0 := invoiceMixin[0].Fields()
 	_ = invoiceMixinFields0
@@ -143,34 +320,151 @@ func init() {
 	invoiceDescTotalAmountCents := invoiceFields[4].Descriptor()
 	// invoice.DefaultTotalAmountCents holds the default value on creation for the total_amount_cents field.
 	invoice.DefaultTotalAmountCents = invoiceDescTotalAmountCents.Default.(int64)
+	// invoiceDescVatAmountCents is the schema descriptor for vat_amount_cents field.
+	invoiceDescVatAmountCents := invoiceFields[5].Descriptor()
+	// invoice.DefaultVatAmountCents holds the default value on creation for the vat_amount_cents field.
+	invoice.DefaultVatAmountCents = invoiceDescVatAmountCents.Default.(int64)
 	// invoiceDescCreatedAt is the schema descriptor for created_at field.
-	invoiceDescCreatedAt := invoiceFields[16].Descriptor()
+	invoiceDescCreatedAt := invoiceFields[20].Descriptor()
 	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
 	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
 	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
-	invoiceDescUpdatedAt := invoiceFields[17].Descriptor()
+	invoiceDescUpdatedAt := invoiceFields[21].Descriptor()
 	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
 	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	invoicelineFields := schema.InvoiceLine{}.Fields()
 	_ = invoicelineFields
+	// invoicelineDescChargeYear is the schema descriptor for charge_year field.
+	invoicelineDescChargeYear := invoicelineFields[3].Descriptor()
+	// invoiceline.DefaultChargeYear holds the default value on creation for the charge_year field.
+	invoiceline.DefaultChargeYear = invoicelineDescChargeYear.Default.(int)
 	// invoicelineDescLegacyUnitPrice is the schema descriptor for legacy_unit_price field.
-	invoicelineDescLegacyUnitPrice := invoicelineFields[4].Descriptor()
+	invoicelineDescLegacyUnitPrice := invoicelineFields[7].Descriptor()
 	// invoiceline.DefaultLegacyUnitPrice holds the default value on creation for the legacy_unit_price field.
 	invoiceline.DefaultLegacyUnitPrice = invoicelineDescLegacyUnitPrice.Default.(float64)
 	// invoicelineDescLegacyAmount is the schema descriptor for legacy_amount field.
-	invoicelineDescLegacyAmount := invoicelineFields[5].Descriptor()
+	invoicelineDescLegacyAmount := invoicelineFields[8].Descriptor()
 	// invoiceline.DefaultLegacyAmount holds the default value on creation for the legacy_amount field.
 	invoiceline.DefaultLegacyAmount = invoicelineDescLegacyAmount.Default.(float64)
 	// invoicelineDescUnitPriceCents is the schema descriptor for unit_price_cents field.
-	invoicelineDescUnitPriceCents := invoicelineFields[6].Descriptor()
+	invoicelineDescUnitPriceCents := invoicelineFields[9].Descriptor()
 	// invoiceline.DefaultUnitPriceCents holds the default value on creation for the unit_price_cents field.
 	invoiceline.DefaultUnitPriceCents = invoicelineDescUnitPriceCents.Default.(int64)
 	// invoicelineDescAmountCents is the schema descriptor for amount_cents field.
-	invoicelineDescAmountCents := invoicelineFields[7].Descriptor()
+	invoicelineDescAmountCents := invoicelineFields[10].Descriptor()
 	// invoiceline.DefaultAmountCents holds the default value on creation for the amount_cents field.
 	invoiceline.DefaultAmountCents = invoicelineDescAmountCents.Default.(int64)
+	// invoicelineDescVatRatePct is the schema descriptor for vat_rate_pct field.
+	invoicelineDescVatRatePct := invoicelineFields[11].Descriptor()
+	// invoiceline.DefaultVatRatePct holds the default value on creation for the vat_rate_pct field.
+	invoiceline.DefaultVatRatePct = invoicelineDescVatRatePct.Default.(float64)
+	// invoicelineDescVatExemptNote is the schema descriptor for vat_exempt_note field.
+	invoicelineDescVatExemptNote := invoicelineFields[12].Descriptor()
+	// invoiceline.DefaultVatExemptNote holds the default value on creation for the vat_exempt_note field.
+	invoiceline.DefaultVatExemptNote = invoicelineDescVatExemptNote.Default.(string)
+	latefeeFields := schema.LateFee{}.Fields()
+	_ = latefeeFields
+	// latefeeDescDaysLate is the schema descriptor for days_late field.
+	latefeeDescDaysLate := latefeeFields[5].Descriptor()
+	// latefee.DefaultDaysLate holds the default value on creation for the days_late field.
+	latefee.DefaultDaysLate = latefeeDescDaysLate.Default.(int)
+	// latefeeDescWaivedReason is the schema descriptor for waived_reason field.
+	latefeeDescWaivedReason := latefeeFields[7].Descriptor()
+	// latefee.DefaultWaivedReason holds the default value on creation for the waived_reason field.
+	latefee.DefaultWaivedReason = latefeeDescWaivedReason.Default.(string)
+	// latefeeDescWaivedBy is the schema descriptor for waived_by field.
+	latefeeDescWaivedBy := latefeeFields[8].Descriptor()
+	// latefee.DefaultWaivedBy holds the default value on creation for the waived_by field.
+	latefee.DefaultWaivedBy = latefeeDescWaivedBy.Default.(string)
+	// latefeeDescCreatedAt is the schema descriptor for created_at field.
+	latefeeDescCreatedAt := latefeeFields[10].Descriptor()
+	// latefee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	latefee.DefaultCreatedAt = latefeeDescCreatedAt.Default.(func() time.Time)
+	leadMixin := schema.Lead{}.Mixin()
+	leadMixinFields0 := leadMixin[0].Fields()
+	_ = leadMixinFields0
+	leadFields := schema.Lead{}.Fields()
+	_ = leadFields
+	// leadDescVersion is the schema descriptor for version field.
+	leadDescVersion := leadMixinFields0[0].Descriptor()
+	// lead.DefaultVersion holds the default value on creation for the version field.
+	lead.DefaultVersion = leadDescVersion.Default.(int)
+	// leadDescPersonalCode is the schema descriptor for personal_code field.
+	leadDescPersonalCode := leadFields[1].Descriptor()
+	// lead.DefaultPersonalCode holds the default value on creation for the personal_code field.
+	lead.DefaultPersonalCode = leadDescPersonalCode.Default.(string)
+	// leadDescPhone is the schema descriptor for phone field.
+	leadDescPhone := leadFields[2].Descriptor()
+	// lead.DefaultPhone holds the default value on creation for the phone field.
+	lead.DefaultPhone = leadDescPhone.Default.(string)
+	// leadDescEmail is the schema descriptor for email field.
+	leadDescEmail := leadFields[3].Descriptor()
+	// lead.DefaultEmail holds the default value on creation for the email field.
+	lead.DefaultEmail = leadDescEmail.Default.(string)
+	// leadDescIsMinor is the schema descriptor for is_minor field.
+	leadDescIsMinor := leadFields[4].Descriptor()
+	// lead.DefaultIsMinor holds the default value on creation for the is_minor field.
+	lead.DefaultIsMinor = leadDescIsMinor.Default.(bool)
+	// leadDescPayerName is the schema descriptor for payer_name field.
+	leadDescPayerName := leadFields[5].Descriptor()
+	// lead.DefaultPayerName holds the default value on creation for the payer_name field.
+	lead.DefaultPayerName = leadDescPayerName.Default.(string)
+	// leadDescPayerRole is the schema descriptor for payer_role field.
+	leadDescPayerRole := leadFields[6].Descriptor()
+	// lead.DefaultPayerRole holds the default value on creation for the payer_role field.
+	lead.DefaultPayerRole = leadDescPayerRole.Default.(string)
+	// leadDescSource is the schema descriptor for source field.
+	leadDescSource := leadFields[7].Descriptor()
+	// lead.DefaultSource holds the default value on creation for the source field.
+	lead.DefaultSource = leadDescSource.Default.(string)
+	// leadDescNote is the schema descriptor for note field.
+	leadDescNote := leadFields[8].Descriptor()
+	// lead.DefaultNote holds the default value on creation for the note field.
+	lead.DefaultNote = leadDescNote.Default.(string)
+	// leadDescTrialPriceCents is the schema descriptor for trial_price_cents field.
+	leadDescTrialPriceCents := leadFields[12].Descriptor()
+	// lead.DefaultTrialPriceCents holds the default value on creation for the trial_price_cents field.
+	lead.DefaultTrialPriceCents = leadDescTrialPriceCents.Default.(int64)
+	// leadDescOutcomeNote is the schema descriptor for outcome_note field.
+	leadDescOutcomeNote := leadFields[14].Descriptor()
+	// lead.DefaultOutcomeNote holds the default value on creation for the outcome_note field.
+	lead.DefaultOutcomeNote = leadDescOutcomeNote.Default.(string)
+	// leadDescLostReason is the schema descriptor for lost_reason field.
+	leadDescLostReason := leadFields[15].Descriptor()
+	// lead.DefaultLostReason holds the default value on creation for the lost_reason field.
+	lead.DefaultLostReason = leadDescLostReason.Default.(string)
+	// leadDescCreatedBy is the schema descriptor for created_by field.
+	leadDescCreatedBy := leadFields[19].Descriptor()
+	// lead.DefaultCreatedBy holds the default value on creation for the created_by field.
+	lead.DefaultCreatedBy = leadDescCreatedBy.Default.(string)
+	// leadDescCreatedAt is the schema descriptor for created_at field.
+	leadDescCreatedAt := leadFields[20].Descriptor()
+	// lead.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lead.DefaultCreatedAt = leadDescCreatedAt.Default.(func() time.Time)
+	lessonpackageFields := schema.LessonPackage{}.Fields()
+	_ = lessonpackageFields
+	// lessonpackageDescSoldAt is the schema descriptor for sold_at field.
+	lessonpackageDescSoldAt := lessonpackageFields[4].Descriptor()
+	// lessonpackage.DefaultSoldAt holds the default value on creation for the sold_at field.
+	lessonpackage.DefaultSoldAt = lessonpackageDescSoldAt.Default.(func() time.Time)
+	// lessonpackageDescUnusedLessons is the schema descriptor for unused_lessons field.
+	lessonpackageDescUnusedLessons := lessonpackageFields[10].Descriptor()
+	// lessonpackage.DefaultUnusedLessons holds the default value on creation for the unused_lessons field.
+	lessonpackage.DefaultUnusedLessons = lessonpackageDescUnusedLessons.Default.(float64)
+	// lessonpackageDescRefundCents is the schema descriptor for refund_cents field.
+	lessonpackageDescRefundCents := lessonpackageFields[11].Descriptor()
+	// lessonpackage.DefaultRefundCents holds the default value on creation for the refund_cents field.
+	lessonpackage.DefaultRefundCents = lessonpackageDescRefundCents.Default.(int64)
+	// lessonpackageDescNote is the schema descriptor for note field.
+	lessonpackageDescNote := lessonpackageFields[12].Descriptor()
+	// lessonpackage.DefaultNote holds the default value on creation for the note field.
+	lessonpackage.DefaultNote = lessonpackageDescNote.Default.(string)
+	// lessonpackageDescCreatedBy is the schema descriptor for created_by field.
+	lessonpackageDescCreatedBy := lessonpackageFields[13].Descriptor()
+	// lessonpackage.DefaultCreatedBy holds the default value on creation for the created_by field.
+	lessonpackage.DefaultCreatedBy = lessonpackageDescCreatedBy.Default.(string)
 	paymentFields := schema.Payment{}.Fields()
 	_ = paymentFields
 	// paymentDescPaidAt is the schema descriptor for paid_at field.
@@ -193,6 +487,84 @@ func init() {
 	paymentDescCreatedAt := paymentFields[7].Descriptor()
 	// payment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
+	paymentplanFields := schema.PaymentPlan{}.Fields()
+	_ = paymentplanFields
+	// paymentplanDescBaselinePaidCents is the schema descriptor for baseline_paid_cents field.
+	paymentplanDescBaselinePaidCents := paymentplanFields[2].Descriptor()
+	// paymentplan.DefaultBaselinePaidCents holds the default value on creation for the baseline_paid_cents field.
+	paymentplan.DefaultBaselinePaidCents = paymentplanDescBaselinePaidCents.Default.(int64)
+	// paymentplanDescNote is the schema descriptor for note field.
+	paymentplanDescNote := paymentplanFields[3].Descriptor()
+	// paymentplan.DefaultNote holds the default value on creation for the note field.
+	paymentplan.DefaultNote = paymentplanDescNote.Default.(string)
+	// paymentplanDescCreatedBy is the schema descriptor for created_by field.
+	paymentplanDescCreatedBy := paymentplanFields[4].Descriptor()
+	// paymentplan.DefaultCreatedBy holds the default value on creation for the created_by field.
+	paymentplan.DefaultCreatedBy = paymentplanDescCreatedBy.Default.(string)
+	// paymentplanDescCreatedAt is the schema descriptor for created_at field.
+	paymentplanDescCreatedAt := paymentplanFields[5].Descriptor()
+	// paymentplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentplan.DefaultCreatedAt = paymentplanDescCreatedAt.Default.(func() time.Time)
+	// paymentplanDescCanceledBy is the schema descriptor for canceled_by field.
+	paymentplanDescCanceledBy := paymentplanFields[7].Descriptor()
+	// paymentplan.DefaultCanceledBy holds the default value on creation for the canceled_by field.
+	paymentplan.DefaultCanceledBy = paymentplanDescCanceledBy.Default.(string)
+	roomMixin := schema.Room{}.Mixin()
+	roomMixinFields0 := roomMixin[0].Fields()
+	_ = roomMixinFields0
+	roomFields := schema.Room{}.Fields()
+	_ = roomFields
+	// roomDescVersion is the schema descriptor for version field.
+	roomDescVersion := roomMixinFields0[0].Descriptor()
+	// room.DefaultVersion holds the default value on creation for the version field.
+	room.DefaultVersion = roomDescVersion.Default.(int)
+	// roomDescCapacity is the schema descriptor for capacity field.
+	roomDescCapacity := roomFields[1].Descriptor()
+	// room.DefaultCapacity holds the default value on creation for the capacity field.
+	room.DefaultCapacity = roomDescCapacity.Default.(int)
+	// room.CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	room.CapacityValidator = roomDescCapacity.Validators[0].(func(int) error)
+	// roomDescNote is the schema descriptor for note field.
+	roomDescNote := roomFields[2].Descriptor()
+	// room.DefaultNote holds the default value on creation for the note field.
+	room.DefaultNote = roomDescNote.Default.(string)
+	// roomDescCreatedAt is the schema descriptor for created_at field.
+	roomDescCreatedAt := roomFields[3].Descriptor()
+	// room.DefaultCreatedAt holds the default value on creation for the created_at field.
+	room.DefaultCreatedAt = roomDescCreatedAt.Default.(func() time.Time)
+	scheduleslotMixin := schema.ScheduleSlot{}.Mixin()
+	scheduleslotMixinFields0 := scheduleslotMixin[0].Fields()
+	_ = scheduleslotMixinFields0
+	scheduleslotFields := schema.ScheduleSlot{}.Fields()
+	_ = scheduleslotFields
+	// scheduleslotDescVersion is the schema descriptor for version field.
+	scheduleslotDescVersion := scheduleslotMixinFields0[0].Descriptor()
+	// scheduleslot.DefaultVersion holds the default value on creation for the version field.
+	scheduleslot.DefaultVersion = scheduleslotDescVersion.Default.(int)
+	// scheduleslotDescWeekday is the schema descriptor for weekday field.
+	scheduleslotDescWeekday := scheduleslotFields[3].Descriptor()
+	// scheduleslot.WeekdayValidator is a validator for the "weekday" field. It is called by the builders before save.
+	scheduleslot.WeekdayValidator = scheduleslotDescWeekday.Validators[0].(func(int) error)
+	// scheduleslotDescStartMinute is the schema descriptor for start_minute field.
+	scheduleslotDescStartMinute := scheduleslotFields[4].Descriptor()
+	// scheduleslot.StartMinuteValidator is a validator for the "start_minute" field. It is called by the builders before save.
+	scheduleslot.StartMinuteValidator = scheduleslotDescStartMinute.Validators[0].(func(int) error)
+	// scheduleslotDescEndMinute is the schema descriptor for end_minute field.
+	scheduleslotDescEndMinute := scheduleslotFields[5].Descriptor()
+	// scheduleslot.EndMinuteValidator is a validator for the "end_minute" field. It is called by the builders before save.
+	scheduleslot.EndMinuteValidator = scheduleslotDescEndMinute.Validators[0].(func(int) error)
+	// scheduleslotDescNote is the schema descriptor for note field.
+	scheduleslotDescNote := scheduleslotFields[8].Descriptor()
+	// scheduleslot.DefaultNote holds the default value on creation for the note field.
+	scheduleslot.DefaultNote = scheduleslotDescNote.Default.(string)
+	// scheduleslotDescCreatedBy is the schema descriptor for created_by field.
+	scheduleslotDescCreatedBy := scheduleslotFields[9].Descriptor()
+	// scheduleslot.DefaultCreatedBy holds the default value on creation for the created_by field.
+	scheduleslot.DefaultCreatedBy = scheduleslotDescCreatedBy.Default.(string)
+	// scheduleslotDescCreatedAt is the schema descriptor for created_at field.
+	scheduleslotDescCreatedAt := scheduleslotFields[10].Descriptor()
+	// scheduleslot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduleslot.DefaultCreatedAt = scheduleslotDescCreatedAt.Default.(func() time.Time)
 	settingsFields := schema.Settings{}.Fields()
 	_ = settingsFields
 	// settingsDescOrgName is the schema descriptor for org_name field.
@@ -235,10 +607,62 @@ func init() {
 	settingsDescInvoiceReplyTo := settingsFields[10].Descriptor()
 	// settings.DefaultInvoiceReplyTo holds the default value on creation for the invoice_reply_to field.
 	settings.DefaultInvoiceReplyTo = settingsDescInvoiceReplyTo.Default.(string)
+	// settingsDescBankBeneficiaryName is the schema descriptor for bank_beneficiary_name field.
+	settingsDescBankBeneficiaryName := settingsFields[11].Descriptor()
+	// settings.DefaultBankBeneficiaryName holds the default value on creation for the bank_beneficiary_name field.
+	settings.DefaultBankBeneficiaryName = settingsDescBankBeneficiaryName.Default.(string)
+	// settingsDescBankName is the schema descriptor for bank_name field.
+	settingsDescBankName := settingsFields[12].Descriptor()
+	// settings.DefaultBankName holds the default value on creation for the bank_name field.
+	settings.DefaultBankName = settingsDescBankName.Default.(string)
+	// settingsDescBankBic is the schema descriptor for bank_bic field.
+	settingsDescBankBic := settingsFields[13].Descriptor()
+	// settings.DefaultBankBic holds the default value on creation for the bank_bic field.
+	settings.DefaultBankBic = settingsDescBankBic.Default.(string)
+	// settingsDescBankIban is the schema descriptor for bank_iban field.
+	settingsDescBankIban := settingsFields[14].Descriptor()
+	// settings.DefaultBankIban holds the default value on creation for the bank_iban field.
+	settings.DefaultBankIban = settingsDescBankIban.Default.(string)
+	// settingsDescInvoicePaymentQrEnabled is the schema descriptor for invoice_payment_qr_enabled field.
+	settingsDescInvoicePaymentQrEnabled := settingsFields[15].Descriptor()
+	// settings.DefaultInvoicePaymentQrEnabled holds the default value on creation for the invoice_payment_qr_enabled field.
+	settings.DefaultInvoicePaymentQrEnabled = settingsDescInvoicePaymentQrEnabled.Default.(bool)
+	// settingsDescVatEnabled is the schema descriptor for vat_enabled field.
+	settingsDescVatEnabled := settingsFields[16].Descriptor()
+	// settings.DefaultVatEnabled holds the default value on creation for the vat_enabled field.
+	settings.DefaultVatEnabled = settingsDescVatEnabled.Default.(bool)
+	// settingsDescVatNumber is the schema descriptor for vat_number field.
+	settingsDescVatNumber := settingsFields[17].Descriptor()
+	// settings.DefaultVatNumber holds the default value on creation for the vat_number field.
+	settings.DefaultVatNumber = settingsDescVatNumber.Default.(string)
 	// settingsDescMoneyCentsMigrated is the schema descriptor for money_cents_migrated field.
-	settingsDescMoneyCentsMigrated := settingsFields[11].Descriptor()
+	settingsDescMoneyCentsMigrated := settingsFields[18].Descriptor()
 	// settings.DefaultMoneyCentsMigrated holds the default value on creation for the money_cents_migrated field.
 	settings.DefaultMoneyCentsMigrated = settingsDescMoneyCentsMigrated.Default.(bool)
+	// settingsDescLateFeeFlatCents is the schema descriptor for late_fee_flat_cents field.
+	settingsDescLateFeeFlatCents := settingsFields[20].Descriptor()
+	// settings.DefaultLateFeeFlatCents holds the default value on creation for the late_fee_flat_cents field.
+	settings.DefaultLateFeeFlatCents = settingsDescLateFeeFlatCents.Default.(int64)
+	// settingsDescLateFeeDailyRatePct is the schema descriptor for late_fee_daily_rate_pct field.
+	settingsDescLateFeeDailyRatePct := settingsFields[21].Descriptor()
+	// settings.DefaultLateFeeDailyRatePct holds the default value on creation for the late_fee_daily_rate_pct field.
+	settings.DefaultLateFeeDailyRatePct = settingsDescLateFeeDailyRatePct.Default.(float64)
+	// settingsDescLateFeeGraceDays is the schema descriptor for late_fee_grace_days field.
+	settingsDescLateFeeGraceDays := settingsFields[22].Descriptor()
+	// settings.DefaultLateFeeGraceDays holds the default value on creation for the late_fee_grace_days field.
+	settings.DefaultLateFeeGraceDays = settingsDescLateFeeGraceDays.Default.(int)
+	// settingsDescLateFeeCapCents is the schema descriptor for late_fee_cap_cents field.
+	settingsDescLateFeeCapCents := settingsFields[23].Descriptor()
+	// settings.DefaultLateFeeCapCents holds the default value on creation for the late_fee_cap_cents field.
+	settings.DefaultLateFeeCapCents = settingsDescLateFeeCapCents.Default.(int64)
+	// settingsDescMakeupWindowWeeks is the schema descriptor for makeup_window_weeks field.
+	settingsDescMakeupWindowWeeks := settingsFields[25].Descriptor()
+	// settings.DefaultMakeupWindowWeeks holds the default value on creation for the makeup_window_weeks field.
+	settings.DefaultMakeupWindowWeeks = settingsDescMakeupWindowWeeks.Default.(int)
+	// settingsDescWaitlistOfferDays is the schema descriptor for waitlist_offer_days field.
+	settingsDescWaitlistOfferDays := settingsFields[26].Descriptor()
+	// settings.DefaultWaitlistOfferDays holds the default value on creation for the waitlist_offer_days field.
+	settings.DefaultWaitlistOfferDays = settingsDescWaitlistOfferDays.Default.(int)
 	studentMixin := schema.Student{}.Mixin()
 	studentMixinFields0 := studentMixin[0].Fields()
 	_ = studentMixinFields0
@@ -280,10 +704,52 @@ func init() {
 	studentDescPayerRole := studentFields[8].Descriptor()
 	// student.DefaultPayerRole holds the default value on creation for the payer_role field.
 	student.DefaultPayerRole = studentDescPayerRole.Default.(string)
+	// studentDescPayerPersonalCode is the schema descriptor for payer_personal_code field.
+	studentDescPayerPersonalCode := studentFields[9].Descriptor()
+	// student.DefaultPayerPersonalCode holds the default value on creation for the payer_personal_code field.
+	student.DefaultPayerPersonalCode = studentDescPayerPersonalCode.Default.(string)
+	// studentDescPayerCompanyName is the schema descriptor for payer_company_name field.
+	studentDescPayerCompanyName := studentFields[11].Descriptor()
+	// student.DefaultPayerCompanyName holds the default value on creation for the payer_company_name field.
+	student.DefaultPayerCompanyName = studentDescPayerCompanyName.Default.(string)
+	// studentDescPayerRegNo is the schema descriptor for payer_reg_no field.
+	studentDescPayerRegNo := studentFields[12].Descriptor()
+	// student.DefaultPayerRegNo holds the default value on creation for the payer_reg_no field.
+	student.DefaultPayerRegNo = studentDescPayerRegNo.Default.(string)
+	// studentDescPayerVatNumber is the schema descriptor for payer_vat_number field.
+	studentDescPayerVatNumber := studentFields[13].Descriptor()
+	// student.DefaultPayerVatNumber holds the default value on creation for the payer_vat_number field.
+	student.DefaultPayerVatNumber = studentDescPayerVatNumber.Default.(string)
+	// studentDescPayerLegalAddress is the schema descriptor for payer_legal_address field.
+	studentDescPayerLegalAddress := studentFields[14].Descriptor()
+	// student.DefaultPayerLegalAddress holds the default value on creation for the payer_legal_address field.
+	student.DefaultPayerLegalAddress = studentDescPayerLegalAddress.Default.(string)
+	// studentDescPayerBillingEmail is the schema descriptor for payer_billing_email field.
+	studentDescPayerBillingEmail := studentFields[15].Descriptor()
+	// student.DefaultPayerBillingEmail holds the default value on creation for the payer_billing_email field.
+	student.DefaultPayerBillingEmail = studentDescPayerBillingEmail.Default.(string)
 	// studentDescIsActive is the schema descriptor for is_active field.
-	studentDescIsActive := studentFields[9].Descriptor()
+	studentDescIsActive := studentFields[16].Descriptor()
 	// student.DefaultIsActive holds the default value on creation for the is_active field.
 	student.DefaultIsActive = studentDescIsActive.Default.(bool)
+	studentchargeFields := schema.StudentCharge{}.Fields()
+	_ = studentchargeFields
+	// studentchargeDescVatRatePct is the schema descriptor for vat_rate_pct field.
+	studentchargeDescVatRatePct := studentchargeFields[4].Descriptor()
+	// studentcharge.DefaultVatRatePct holds the default value on creation for the vat_rate_pct field.
+	studentcharge.DefaultVatRatePct = studentchargeDescVatRatePct.Default.(float64)
+	// studentchargeDescCreatedBy is the schema descriptor for created_by field.
+	studentchargeDescCreatedBy := studentchargeFields[7].Descriptor()
+	// studentcharge.DefaultCreatedBy holds the default value on creation for the created_by field.
+	studentcharge.DefaultCreatedBy = studentchargeDescCreatedBy.Default.(string)
+	// studentchargeDescCreatedAt is the schema descriptor for created_at field.
+	studentchargeDescCreatedAt := studentchargeFields[8].Descriptor()
+	// studentcharge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	studentcharge.DefaultCreatedAt = studentchargeDescCreatedAt.Default.(func() time.Time)
+	// studentchargeDescCanceledBy is the schema descriptor for canceled_by field.
+	studentchargeDescCanceledBy := studentchargeFields[10].Descriptor()
+	// studentcharge.DefaultCanceledBy holds the default value on creation for the canceled_by field.
+	studentcharge.DefaultCanceledBy = studentchargeDescCanceledBy.Default.(string)
 	teacherFields := schema.Teacher{}.Fields()
 	_ = teacherFields
 	// teacherDescIsActive is the schema descriptor for is_active field.
@@ -314,6 +780,24 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescNote is the schema descriptor for note field.
+	waitlistentryDescNote := waitlistentryFields[4].Descriptor()
+	// waitlistentry.DefaultNote holds the default value on creation for the note field.
+	waitlistentry.DefaultNote = waitlistentryDescNote.Default.(string)
+	// waitlistentryDescOfferEmailedTo is the schema descriptor for offer_emailed_to field.
+	waitlistentryDescOfferEmailedTo := waitlistentryFields[8].Descriptor()
+	// waitlistentry.DefaultOfferEmailedTo holds the default value on creation for the offer_emailed_to field.
+	waitlistentry.DefaultOfferEmailedTo = waitlistentryDescOfferEmailedTo.Default.(string)
+	// waitlistentryDescCreatedBy is the schema descriptor for created_by field.
+	waitlistentryDescCreatedBy := waitlistentryFields[10].Descriptor()
+	// waitlistentry.DefaultCreatedBy holds the default value on creation for the created_by field.
+	waitlistentry.DefaultCreatedBy = waitlistentryDescCreatedBy.Default.(string)
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[11].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
 	websessionFields := schema.WebSession{}.Fields()
 	_ = websessionFields
 	// websessionDescCreatedAt is the schema descriptor for created_at field.
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
+	"langschool/ent/teacher"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScheduleSlot is the model entity for the ScheduleSlot schema.
+type ScheduleSlot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// CourseID holds the value of the "course_id" field.
+	CourseID int `json:"course_id,omitempty"`
+	// RoomID holds the value of the "room_id" field.
+	RoomID *int `json:"room_id,omitempty"`
+	// TeacherID holds the value of the "teacher_id" field.
+	TeacherID *int `json:"teacher_id,omitempty"`
+	// Weekday holds the value of the "weekday" field.
+	Weekday int `json:"weekday,omitempty"`
+	// StartMinute holds the value of the "start_minute" field.
+	StartMinute int `json:"start_minute,omitempty"`
+	// EndMinute holds the value of the "end_minute" field.
+	EndMinute int `json:"end_minute,omitempty"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleSlotQuery when eager-loading is set.
+	Edges        ScheduleSlotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleSlotEdges holds the relations/edges for other nodes in the graph.
+type ScheduleSlotEdges struct {
+	// Course holds the value of the course edge.
+	Course *Course `json:"course,omitempty"`
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Teacher holds the value of the teacher edge.
+	Teacher *Teacher `json:"teacher,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CourseOrErr returns the Course value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleSlotEdges) CourseOrErr() (*Course, error) {
+	if e.Course != nil {
+		return e.Course, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: course.Label}
+	}
+	return nil, &NotLoadedError{edge: "course"}
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleSlotEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// TeacherOrErr returns the Teacher value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleSlotEdges) TeacherOrErr() (*Teacher, error) {
+	if e.Teacher != nil {
+		return e.Teacher, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: teacher.Label}
+	}
+	return nil, &NotLoadedError{edge: "teacher"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduleSlot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduleslot.FieldID, scheduleslot.FieldVersion, scheduleslot.FieldCourseID, scheduleslot.FieldRoomID, scheduleslot.FieldTeacherID, scheduleslot.FieldWeekday, scheduleslot.FieldStartMinute, scheduleslot.FieldEndMinute:
+			values[i] = new(sql.NullInt64)
+		case scheduleslot.FieldNote, scheduleslot.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case scheduleslot.FieldValidFrom, scheduleslot.FieldValidUntil, scheduleslot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduleSlot fields.
+func (_m *ScheduleSlot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduleslot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case scheduleslot.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case scheduleslot.FieldCourseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field course_id", values[i])
+			} else if value.Valid {
+				_m.CourseID = int(value.Int64)
+			}
+		case scheduleslot.FieldRoomID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				_m.RoomID = new(int)
+				*_m.RoomID = int(value.Int64)
+			}
+		case scheduleslot.FieldTeacherID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_id", values[i])
+			} else if value.Valid {
+				_m.TeacherID = new(int)
+				*_m.TeacherID = int(value.Int64)
+			}
+		case scheduleslot.FieldWeekday:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weekday", values[i])
+			} else if value.Valid {
+				_m.Weekday = int(value.Int64)
+			}
+		case scheduleslot.FieldStartMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_minute", values[i])
+			} else if value.Valid {
+				_m.StartMinute = int(value.Int64)
+			}
+		case scheduleslot.FieldEndMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_minute", values[i])
+			} else if value.Valid {
+				_m.EndMinute = int(value.Int64)
+			}
+		case scheduleslot.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case scheduleslot.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				_m.ValidUntil = new(time.Time)
+				*_m.ValidUntil = value.Time
+			}
+		case scheduleslot.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case scheduleslot.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case scheduleslot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduleSlot.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduleSlot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCourse queries the "course" edge of the ScheduleSlot entity.
+func (_m *ScheduleSlot) QueryCourse() *CourseQuery {
+	return NewScheduleSlotClient(_m.config).QueryCourse(_m)
+}
+
+// QueryRoom queries the "room" edge of the ScheduleSlot entity.
+func (_m *ScheduleSlot) QueryRoom() *RoomQuery {
+	return NewScheduleSlotClient(_m.config).QueryRoom(_m)
+}
+
+// QueryTeacher queries the "teacher" edge of the ScheduleSlot entity.
+func (_m *ScheduleSlot) QueryTeacher() *TeacherQuery {
+	return NewScheduleSlotClient(_m.config).QueryTeacher(_m)
+}
+
+// Update returns a builder for updating this ScheduleSlot.
+// Note that you need to call ScheduleSlot.Unwrap() before calling this method if this ScheduleSlot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduleSlot) Update() *ScheduleSlotUpdateOne {
+	return NewScheduleSlotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduleSlot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduleSlot) Unwrap() *ScheduleSlot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduleSlot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduleSlot) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduleSlot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("course_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CourseID))
+	builder.WriteString(", ")
+	if v := _m.RoomID; v != nil {
+		builder.WriteString("room_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TeacherID; v != nil {
+		builder.WriteString("teacher_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("weekday=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weekday))
+	builder.WriteString(", ")
+	builder.WriteString("start_minute=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartMinute))
+	builder.WriteString(", ")
+	builder.WriteString("end_minute=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndMinute))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidUntil; v != nil {
+		builder.WriteString("valid_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduleSlots is a parsable slice of ScheduleSlot.
+type ScheduleSlots []*ScheduleSlot
@@ -0,0 +1,700 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduleslot
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldVersion, v))
+}
+
+// CourseID applies equality check predicate on the "course_id" field. It's identical to CourseIDEQ.
+func CourseID(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCourseID, v))
+}
+
+// RoomID applies equality check predicate on the "room_id" field. It's identical to RoomIDEQ.
+func RoomID(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldRoomID, v))
+}
+
+// TeacherID applies equality check predicate on the "teacher_id" field. It's identical to TeacherIDEQ.
+func TeacherID(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// Weekday applies equality check predicate on the "weekday" field. It's identical to WeekdayEQ.
+func Weekday(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldWeekday, v))
+}
+
+// StartMinute applies equality check predicate on the "start_minute" field. It's identical to StartMinuteEQ.
+func StartMinute(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldStartMinute, v))
+}
+
+// EndMinute applies equality check predicate on the "end_minute" field. It's identical to EndMinuteEQ.
+func EndMinute(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldEndMinute, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldVersion, v))
+}
+
+// CourseIDEQ applies the EQ predicate on the "course_id" field.
+func CourseIDEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCourseID, v))
+}
+
+// CourseIDNEQ applies the NEQ predicate on the "course_id" field.
+func CourseIDNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldCourseID, v))
+}
+
+// CourseIDIn applies the In predicate on the "course_id" field.
+func CourseIDIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldCourseID, vs...))
+}
+
+// CourseIDNotIn applies the NotIn predicate on the "course_id" field.
+func CourseIDNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldCourseID, vs...))
+}
+
+// RoomIDEQ applies the EQ predicate on the "room_id" field.
+func RoomIDEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldRoomID, v))
+}
+
+// RoomIDNEQ applies the NEQ predicate on the "room_id" field.
+func RoomIDNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldRoomID, v))
+}
+
+// RoomIDIn applies the In predicate on the "room_id" field.
+func RoomIDIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldRoomID, vs...))
+}
+
+// RoomIDNotIn applies the NotIn predicate on the "room_id" field.
+func RoomIDNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldRoomID, vs...))
+}
+
+// RoomIDIsNil applies the IsNil predicate on the "room_id" field.
+func RoomIDIsNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIsNull(FieldRoomID))
+}
+
+// RoomIDNotNil applies the NotNil predicate on the "room_id" field.
+func RoomIDNotNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotNull(FieldRoomID))
+}
+
+// TeacherIDEQ applies the EQ predicate on the "teacher_id" field.
+func TeacherIDEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// TeacherIDNEQ applies the NEQ predicate on the "teacher_id" field.
+func TeacherIDNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldTeacherID, v))
+}
+
+// TeacherIDIn applies the In predicate on the "teacher_id" field.
+func TeacherIDIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDNotIn applies the NotIn predicate on the "teacher_id" field.
+func TeacherIDNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDIsNil applies the IsNil predicate on the "teacher_id" field.
+func TeacherIDIsNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIsNull(FieldTeacherID))
+}
+
+// TeacherIDNotNil applies the NotNil predicate on the "teacher_id" field.
+func TeacherIDNotNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotNull(FieldTeacherID))
+}
+
+// WeekdayEQ applies the EQ predicate on the "weekday" field.
+func WeekdayEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldWeekday, v))
+}
+
+// WeekdayNEQ applies the NEQ predicate on the "weekday" field.
+func WeekdayNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldWeekday, v))
+}
+
+// WeekdayIn applies the In predicate on the "weekday" field.
+func WeekdayIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldWeekday, vs...))
+}
+
+// WeekdayNotIn applies the NotIn predicate on the "weekday" field.
+func WeekdayNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldWeekday, vs...))
+}
+
+// WeekdayGT applies the GT predicate on the "weekday" field.
+func WeekdayGT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldWeekday, v))
+}
+
+// WeekdayGTE applies the GTE predicate on the "weekday" field.
+func WeekdayGTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldWeekday, v))
+}
+
+// WeekdayLT applies the LT predicate on the "weekday" field.
+func WeekdayLT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldWeekday, v))
+}
+
+// WeekdayLTE applies the LTE predicate on the "weekday" field.
+func WeekdayLTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldWeekday, v))
+}
+
+// StartMinuteEQ applies the EQ predicate on the "start_minute" field.
+func StartMinuteEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldStartMinute, v))
+}
+
+// StartMinuteNEQ applies the NEQ predicate on the "start_minute" field.
+func StartMinuteNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldStartMinute, v))
+}
+
+// StartMinuteIn applies the In predicate on the "start_minute" field.
+func StartMinuteIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldStartMinute, vs...))
+}
+
+// StartMinuteNotIn applies the NotIn predicate on the "start_minute" field.
+func StartMinuteNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldStartMinute, vs...))
+}
+
+// StartMinuteGT applies the GT predicate on the "start_minute" field.
+func StartMinuteGT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldStartMinute, v))
+}
+
+// StartMinuteGTE applies the GTE predicate on the "start_minute" field.
+func StartMinuteGTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldStartMinute, v))
+}
+
+// StartMinuteLT applies the LT predicate on the "start_minute" field.
+func StartMinuteLT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldStartMinute, v))
+}
+
+// StartMinuteLTE applies the LTE predicate on the "start_minute" field.
+func StartMinuteLTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldStartMinute, v))
+}
+
+// EndMinuteEQ applies the EQ predicate on the "end_minute" field.
+func EndMinuteEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldEndMinute, v))
+}
+
+// EndMinuteNEQ applies the NEQ predicate on the "end_minute" field.
+func EndMinuteNEQ(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldEndMinute, v))
+}
+
+// EndMinuteIn applies the In predicate on the "end_minute" field.
+func EndMinuteIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldEndMinute, vs...))
+}
+
+// EndMinuteNotIn applies the NotIn predicate on the "end_minute" field.
+func EndMinuteNotIn(vs ...int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldEndMinute, vs...))
+}
+
+// EndMinuteGT applies the GT predicate on the "end_minute" field.
+func EndMinuteGT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldEndMinute, v))
+}
+
+// EndMinuteGTE applies the GTE predicate on the "end_minute" field.
+func EndMinuteGTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldEndMinute, v))
+}
+
+// EndMinuteLT applies the LT predicate on the "end_minute" field.
+func EndMinuteLT(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldEndMinute, v))
+}
+
+// EndMinuteLTE applies the LTE predicate on the "end_minute" field.
+func EndMinuteLTE(v int) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldEndMinute, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "valid_until" field.
+func ValidUntilIsNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIsNull(FieldValidUntil))
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "valid_until" field.
+func ValidUntilNotNil() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotNull(FieldValidUntil))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCourse applies the HasEdge predicate on the "course" edge.
+func HasCourse() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CourseTable, CourseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCourseWith applies the HasEdge predicate on the "course" edge with a given conditions (other predicates).
+func HasCourseWith(preds ...predicate.Course) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := newCourseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeacher applies the HasEdge predicate on the "teacher" edge.
+func HasTeacher() predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeacherTable, TeacherColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeacherWith applies the HasEdge predicate on the "teacher" edge with a given conditions (other predicates).
+func HasTeacherWith(preds ...predicate.Teacher) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(func(s *sql.Selector) {
+		step := newTeacherStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduleSlot) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduleSlot) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduleSlot) predicate.ScheduleSlot {
+	return predicate.ScheduleSlot(sql.NotPredicates(p))
+}
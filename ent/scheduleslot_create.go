@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
+	"langschool/ent/teacher"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleSlotCreate is the builder for creating a ScheduleSlot entity.
+type ScheduleSlotCreate struct {
+	config
+	mutation *ScheduleSlotMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *ScheduleSlotCreate) SetVersion(v int) *ScheduleSlotCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableVersion(v *int) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *ScheduleSlotCreate) SetCourseID(v int) *ScheduleSlotCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetRoomID sets the "room_id" field.
+func (_c *ScheduleSlotCreate) SetRoomID(v int) *ScheduleSlotCreate {
+	_c.mutation.SetRoomID(v)
+	return _c
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableRoomID(v *int) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetRoomID(*v)
+	}
+	return _c
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_c *ScheduleSlotCreate) SetTeacherID(v int) *ScheduleSlotCreate {
+	_c.mutation.SetTeacherID(v)
+	return _c
+}
+
+// SetNillableTeacherID sets the "teacher_id" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableTeacherID(v *int) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetTeacherID(*v)
+	}
+	return _c
+}
+
+// SetWeekday sets the "weekday" field.
+func (_c *ScheduleSlotCreate) SetWeekday(v int) *ScheduleSlotCreate {
+	_c.mutation.SetWeekday(v)
+	return _c
+}
+
+// SetStartMinute sets the "start_minute" field.
+func (_c *ScheduleSlotCreate) SetStartMinute(v int) *ScheduleSlotCreate {
+	_c.mutation.SetStartMinute(v)
+	return _c
+}
+
+// SetEndMinute sets the "end_minute" field.
+func (_c *ScheduleSlotCreate) SetEndMinute(v int) *ScheduleSlotCreate {
+	_c.mutation.SetEndMinute(v)
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *ScheduleSlotCreate) SetValidFrom(v time.Time) *ScheduleSlotCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_c *ScheduleSlotCreate) SetValidUntil(v time.Time) *ScheduleSlotCreate {
+	_c.mutation.SetValidUntil(v)
+	return _c
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableValidUntil(v *time.Time) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetValidUntil(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *ScheduleSlotCreate) SetNote(v string) *ScheduleSlotCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableNote(v *string) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ScheduleSlotCreate) SetCreatedBy(v string) *ScheduleSlotCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableCreatedBy(v *string) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduleSlotCreate) SetCreatedAt(v time.Time) *ScheduleSlotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduleSlotCreate) SetNillableCreatedAt(v *time.Time) *ScheduleSlotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *ScheduleSlotCreate) SetCourse(v *Course) *ScheduleSlotCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (_c *ScheduleSlotCreate) SetRoom(v *Room) *ScheduleSlotCreate {
+	return _c.SetRoomID(v.ID)
+}
+
+// SetTeacher sets the "teacher" edge to the Teacher entity.
+func (_c *ScheduleSlotCreate) SetTeacher(v *Teacher) *ScheduleSlotCreate {
+	return _c.SetTeacherID(v.ID)
+}
+
+// Mutation returns the ScheduleSlotMutation object of the builder.
+func (_c *ScheduleSlotCreate) Mutation() *ScheduleSlotMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduleSlot in the database.
+func (_c *ScheduleSlotCreate) Save(ctx context.Context) (*ScheduleSlot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduleSlotCreate) SaveX(ctx context.Context) *ScheduleSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduleSlotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduleSlotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduleSlotCreate) defaults() {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := scheduleslot.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := scheduleslot.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := scheduleslot.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduleslot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduleSlotCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ScheduleSlot.version"`)}
+	}
+	if _, ok := _c.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "ScheduleSlot.course_id"`)}
+	}
+	if _, ok := _c.mutation.Weekday(); !ok {
+		return &ValidationError{Name: "weekday", err: errors.New(`ent: missing required field "ScheduleSlot.weekday"`)}
+	}
+	if v, ok := _c.mutation.Weekday(); ok {
+		if err := scheduleslot.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.weekday": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartMinute(); !ok {
+		return &ValidationError{Name: "start_minute", err: errors.New(`ent: missing required field "ScheduleSlot.start_minute"`)}
+	}
+	if v, ok := _c.mutation.StartMinute(); ok {
+		if err := scheduleslot.StartMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "start_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.start_minute": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndMinute(); !ok {
+		return &ValidationError{Name: "end_minute", err: errors.New(`ent: missing required field "ScheduleSlot.end_minute"`)}
+	}
+	if v, ok := _c.mutation.EndMinute(); ok {
+		if err := scheduleslot.EndMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "end_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.end_minute": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "ScheduleSlot.valid_from"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "ScheduleSlot.note"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ScheduleSlot.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduleSlot.created_at"`)}
+	}
+	if len(_c.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "ScheduleSlot.course"`)}
+	}
+	return nil
+}
+
+func (_c *ScheduleSlotCreate) sqlSave(ctx context.Context) (*ScheduleSlot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduleSlotCreate) createSpec() (*ScheduleSlot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduleSlot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduleslot.Table, sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(scheduleslot.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Weekday(); ok {
+		_spec.SetField(scheduleslot.FieldWeekday, field.TypeInt, value)
+		_node.Weekday = value
+	}
+	if value, ok := _c.mutation.StartMinute(); ok {
+		_spec.SetField(scheduleslot.FieldStartMinute, field.TypeInt, value)
+		_node.StartMinute = value
+	}
+	if value, ok := _c.mutation.EndMinute(); ok {
+		_spec.SetField(scheduleslot.FieldEndMinute, field.TypeInt, value)
+		_node.EndMinute = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(scheduleslot.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidUntil(); ok {
+		_spec.SetField(scheduleslot.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(scheduleslot.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.CourseTable,
+			Columns: []string{scheduleslot.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.RoomTable,
+			Columns: []string{scheduleslot.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TeacherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.TeacherTable,
+			Columns: []string{scheduleslot.TeacherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teacher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeacherID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduleSlotCreateBulk is the builder for creating many ScheduleSlot entities in bulk.
+type ScheduleSlotCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleSlotCreate
+}
+
+// Save creates the ScheduleSlot entities in the database.
+func (_c *ScheduleSlotCreateBulk) Save(ctx context.Context) ([]*ScheduleSlot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduleSlot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleSlotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduleSlotCreateBulk) SaveX(ctx context.Context) []*ScheduleSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduleSlotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduleSlotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
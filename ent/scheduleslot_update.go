@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/predicate"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
+	"langschool/ent/teacher"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleSlotUpdate is the builder for updating ScheduleSlot entities.
+type ScheduleSlotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleSlotMutation
+}
+
+// Where appends a list predicates to the ScheduleSlotUpdate builder.
+func (_u *ScheduleSlotUpdate) Where(ps ...predicate.ScheduleSlot) *ScheduleSlotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ScheduleSlotUpdate) SetVersion(v int) *ScheduleSlotUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableVersion(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ScheduleSlotUpdate) AddVersion(v int) *ScheduleSlotUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *ScheduleSlotUpdate) SetCourseID(v int) *ScheduleSlotUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableCourseID(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetRoomID sets the "room_id" field.
+func (_u *ScheduleSlotUpdate) SetRoomID(v int) *ScheduleSlotUpdate {
+	_u.mutation.SetRoomID(v)
+	return _u
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableRoomID(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetRoomID(*v)
+	}
+	return _u
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (_u *ScheduleSlotUpdate) ClearRoomID() *ScheduleSlotUpdate {
+	_u.mutation.ClearRoomID()
+	return _u
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_u *ScheduleSlotUpdate) SetTeacherID(v int) *ScheduleSlotUpdate {
+	_u.mutation.SetTeacherID(v)
+	return _u
+}
+
+// SetNillableTeacherID sets the "teacher_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableTeacherID(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetTeacherID(*v)
+	}
+	return _u
+}
+
+// ClearTeacherID clears the value of the "teacher_id" field.
+func (_u *ScheduleSlotUpdate) ClearTeacherID() *ScheduleSlotUpdate {
+	_u.mutation.ClearTeacherID()
+	return _u
+}
+
+// SetWeekday sets the "weekday" field.
+func (_u *ScheduleSlotUpdate) SetWeekday(v int) *ScheduleSlotUpdate {
+	_u.mutation.ResetWeekday()
+	_u.mutation.SetWeekday(v)
+	return _u
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableWeekday(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetWeekday(*v)
+	}
+	return _u
+}
+
+// AddWeekday adds value to the "weekday" field.
+func (_u *ScheduleSlotUpdate) AddWeekday(v int) *ScheduleSlotUpdate {
+	_u.mutation.AddWeekday(v)
+	return _u
+}
+
+// SetStartMinute sets the "start_minute" field.
+func (_u *ScheduleSlotUpdate) SetStartMinute(v int) *ScheduleSlotUpdate {
+	_u.mutation.ResetStartMinute()
+	_u.mutation.SetStartMinute(v)
+	return _u
+}
+
+// SetNillableStartMinute sets the "start_minute" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableStartMinute(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetStartMinute(*v)
+	}
+	return _u
+}
+
+// AddStartMinute adds value to the "start_minute" field.
+func (_u *ScheduleSlotUpdate) AddStartMinute(v int) *ScheduleSlotUpdate {
+	_u.mutation.AddStartMinute(v)
+	return _u
+}
+
+// SetEndMinute sets the "end_minute" field.
+func (_u *ScheduleSlotUpdate) SetEndMinute(v int) *ScheduleSlotUpdate {
+	_u.mutation.ResetEndMinute()
+	_u.mutation.SetEndMinute(v)
+	return _u
+}
+
+// SetNillableEndMinute sets the "end_minute" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableEndMinute(v *int) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetEndMinute(*v)
+	}
+	return _u
+}
+
+// AddEndMinute adds value to the "end_minute" field.
+func (_u *ScheduleSlotUpdate) AddEndMinute(v int) *ScheduleSlotUpdate {
+	_u.mutation.AddEndMinute(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *ScheduleSlotUpdate) SetValidFrom(v time.Time) *ScheduleSlotUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableValidFrom(v *time.Time) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *ScheduleSlotUpdate) SetValidUntil(v time.Time) *ScheduleSlotUpdate {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableValidUntil(v *time.Time) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *ScheduleSlotUpdate) ClearValidUntil() *ScheduleSlotUpdate {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ScheduleSlotUpdate) SetNote(v string) *ScheduleSlotUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableNote(v *string) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ScheduleSlotUpdate) SetCreatedBy(v string) *ScheduleSlotUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableCreatedBy(v *string) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ScheduleSlotUpdate) SetCreatedAt(v time.Time) *ScheduleSlotUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ScheduleSlotUpdate) SetNillableCreatedAt(v *time.Time) *ScheduleSlotUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *ScheduleSlotUpdate) SetCourse(v *Course) *ScheduleSlotUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (_u *ScheduleSlotUpdate) SetRoom(v *Room) *ScheduleSlotUpdate {
+	return _u.SetRoomID(v.ID)
+}
+
+// SetTeacher sets the "teacher" edge to the Teacher entity.
+func (_u *ScheduleSlotUpdate) SetTeacher(v *Teacher) *ScheduleSlotUpdate {
+	return _u.SetTeacherID(v.ID)
+}
+
+// Mutation returns the ScheduleSlotMutation object of the builder.
+func (_u *ScheduleSlotUpdate) Mutation() *ScheduleSlotMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *ScheduleSlotUpdate) ClearCourse() *ScheduleSlotUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (_u *ScheduleSlotUpdate) ClearRoom() *ScheduleSlotUpdate {
+	_u.mutation.ClearRoom()
+	return _u
+}
+
+// ClearTeacher clears the "teacher" edge to the Teacher entity.
+func (_u *ScheduleSlotUpdate) ClearTeacher() *ScheduleSlotUpdate {
+	_u.mutation.ClearTeacher()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduleSlotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduleSlotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduleSlotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduleSlotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduleSlotUpdate) check() error {
+	if v, ok := _u.mutation.Weekday(); ok {
+		if err := scheduleslot.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.weekday": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartMinute(); ok {
+		if err := scheduleslot.StartMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "start_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.start_minute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndMinute(); ok {
+		if err := scheduleslot.EndMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "end_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.end_minute": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduleSlot.course"`)
+	}
+	return nil
+}
+
+func (_u *ScheduleSlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduleslot.Table, scheduleslot.Columns, sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(scheduleslot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(scheduleslot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Weekday(); ok {
+		_spec.SetField(scheduleslot.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeekday(); ok {
+		_spec.AddField(scheduleslot.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartMinute(); ok {
+		_spec.SetField(scheduleslot.FieldStartMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartMinute(); ok {
+		_spec.AddField(scheduleslot.FieldStartMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndMinute(); ok {
+		_spec.SetField(scheduleslot.FieldEndMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndMinute(); ok {
+		_spec.AddField(scheduleslot.FieldEndMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(scheduleslot.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(scheduleslot.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(scheduleslot.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(scheduleslot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.CourseTable,
+			Columns: []string{scheduleslot.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.CourseTable,
+			Columns: []string{scheduleslot.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.RoomTable,
+			Columns: []string{scheduleslot.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.RoomTable,
+			Columns: []string{scheduleslot.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TeacherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.TeacherTable,
+			Columns: []string{scheduleslot.TeacherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teacher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeacherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.TeacherTable,
+			Columns: []string{scheduleslot.TeacherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teacher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduleslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduleSlotUpdateOne is the builder for updating a single ScheduleSlot entity.
+type ScheduleSlotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleSlotMutation
+}
+
+// SetVersion sets the "version" field.
+func (_u *ScheduleSlotUpdateOne) SetVersion(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableVersion(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ScheduleSlotUpdateOne) AddVersion(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *ScheduleSlotUpdateOne) SetCourseID(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableCourseID(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetRoomID sets the "room_id" field.
+func (_u *ScheduleSlotUpdateOne) SetRoomID(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.SetRoomID(v)
+	return _u
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableRoomID(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetRoomID(*v)
+	}
+	return _u
+}
+
+// ClearRoomID clears the value of the "room_id" field.
+func (_u *ScheduleSlotUpdateOne) ClearRoomID() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearRoomID()
+	return _u
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_u *ScheduleSlotUpdateOne) SetTeacherID(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.SetTeacherID(v)
+	return _u
+}
+
+// SetNillableTeacherID sets the "teacher_id" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableTeacherID(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetTeacherID(*v)
+	}
+	return _u
+}
+
+// ClearTeacherID clears the value of the "teacher_id" field.
+func (_u *ScheduleSlotUpdateOne) ClearTeacherID() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearTeacherID()
+	return _u
+}
+
+// SetWeekday sets the "weekday" field.
+func (_u *ScheduleSlotUpdateOne) SetWeekday(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.ResetWeekday()
+	_u.mutation.SetWeekday(v)
+	return _u
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableWeekday(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetWeekday(*v)
+	}
+	return _u
+}
+
+// AddWeekday adds value to the "weekday" field.
+func (_u *ScheduleSlotUpdateOne) AddWeekday(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.AddWeekday(v)
+	return _u
+}
+
+// SetStartMinute sets the "start_minute" field.
+func (_u *ScheduleSlotUpdateOne) SetStartMinute(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.ResetStartMinute()
+	_u.mutation.SetStartMinute(v)
+	return _u
+}
+
+// SetNillableStartMinute sets the "start_minute" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableStartMinute(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetStartMinute(*v)
+	}
+	return _u
+}
+
+// AddStartMinute adds value to the "start_minute" field.
+func (_u *ScheduleSlotUpdateOne) AddStartMinute(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.AddStartMinute(v)
+	return _u
+}
+
+// SetEndMinute sets the "end_minute" field.
+func (_u *ScheduleSlotUpdateOne) SetEndMinute(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.ResetEndMinute()
+	_u.mutation.SetEndMinute(v)
+	return _u
+}
+
+// SetNillableEndMinute sets the "end_minute" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableEndMinute(v *int) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetEndMinute(*v)
+	}
+	return _u
+}
+
+// AddEndMinute adds value to the "end_minute" field.
+func (_u *ScheduleSlotUpdateOne) AddEndMinute(v int) *ScheduleSlotUpdateOne {
+	_u.mutation.AddEndMinute(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *ScheduleSlotUpdateOne) SetValidFrom(v time.Time) *ScheduleSlotUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableValidFrom(v *time.Time) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *ScheduleSlotUpdateOne) SetValidUntil(v time.Time) *ScheduleSlotUpdateOne {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableValidUntil(v *time.Time) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *ScheduleSlotUpdateOne) ClearValidUntil() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ScheduleSlotUpdateOne) SetNote(v string) *ScheduleSlotUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableNote(v *string) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ScheduleSlotUpdateOne) SetCreatedBy(v string) *ScheduleSlotUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableCreatedBy(v *string) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ScheduleSlotUpdateOne) SetCreatedAt(v time.Time) *ScheduleSlotUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ScheduleSlotUpdateOne) SetNillableCreatedAt(v *time.Time) *ScheduleSlotUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *ScheduleSlotUpdateOne) SetCourse(v *Course) *ScheduleSlotUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (_u *ScheduleSlotUpdateOne) SetRoom(v *Room) *ScheduleSlotUpdateOne {
+	return _u.SetRoomID(v.ID)
+}
+
+// SetTeacher sets the "teacher" edge to the Teacher entity.
+func (_u *ScheduleSlotUpdateOne) SetTeacher(v *Teacher) *ScheduleSlotUpdateOne {
+	return _u.SetTeacherID(v.ID)
+}
+
+// Mutation returns the ScheduleSlotMutation object of the builder.
+func (_u *ScheduleSlotUpdateOne) Mutation() *ScheduleSlotMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *ScheduleSlotUpdateOne) ClearCourse() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (_u *ScheduleSlotUpdateOne) ClearRoom() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearRoom()
+	return _u
+}
+
+// ClearTeacher clears the "teacher" edge to the Teacher entity.
+func (_u *ScheduleSlotUpdateOne) ClearTeacher() *ScheduleSlotUpdateOne {
+	_u.mutation.ClearTeacher()
+	return _u
+}
+
+// Where appends a list predicates to the ScheduleSlotUpdate builder.
+func (_u *ScheduleSlotUpdateOne) Where(ps ...predicate.ScheduleSlot) *ScheduleSlotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduleSlotUpdateOne) Select(field string, fields ...string) *ScheduleSlotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduleSlot entity.
+func (_u *ScheduleSlotUpdateOne) Save(ctx context.Context) (*ScheduleSlot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduleSlotUpdateOne) SaveX(ctx context.Context) *ScheduleSlot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduleSlotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduleSlotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduleSlotUpdateOne) check() error {
+	if v, ok := _u.mutation.Weekday(); ok {
+		if err := scheduleslot.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.weekday": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartMinute(); ok {
+		if err := scheduleslot.StartMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "start_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.start_minute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndMinute(); ok {
+		if err := scheduleslot.EndMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "end_minute", err: fmt.Errorf(`ent: validator failed for field "ScheduleSlot.end_minute": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduleSlot.course"`)
+	}
+	return nil
+}
+
+func (_u *ScheduleSlotUpdateOne) sqlSave(ctx context.Context) (_node *ScheduleSlot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduleslot.Table, scheduleslot.Columns, sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduleSlot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduleslot.FieldID)
+		for _, f := range fields {
+			if !scheduleslot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduleslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(scheduleslot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(scheduleslot.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Weekday(); ok {
+		_spec.SetField(scheduleslot.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeekday(); ok {
+		_spec.AddField(scheduleslot.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartMinute(); ok {
+		_spec.SetField(scheduleslot.FieldStartMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartMinute(); ok {
+		_spec.AddField(scheduleslot.FieldStartMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndMinute(); ok {
+		_spec.SetField(scheduleslot.FieldEndMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndMinute(); ok {
+		_spec.AddField(scheduleslot.FieldEndMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(scheduleslot.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(scheduleslot.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(scheduleslot.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(scheduleslot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduleslot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.CourseTable,
+			Columns: []string{scheduleslot.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.CourseTable,
+			Columns: []string{scheduleslot.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.RoomTable,
+			Columns: []string{scheduleslot.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.RoomTable,
+			Columns: []string{scheduleslot.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TeacherCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.TeacherTable,
+			Columns: []string{scheduleslot.TeacherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teacher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeacherIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduleslot.TeacherTable,
+			Columns: []string{scheduleslot.TeacherColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teacher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScheduleSlot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduleslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
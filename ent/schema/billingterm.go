@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// BillingTerm is a span of months billed with one invoice up front, such as
+// an autumn term. School terms apply to every course billed per term; a
+// course with custom periods has its own terms.
+type BillingTerm struct{ ent.Schema }
+
+func (BillingTerm) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("start_year"),
+		field.Int("start_month").Range(1, 12),
+		field.Int("end_year"),
+		field.Int("end_month").Range(1, 12),
+		// Lessons billed for each enrollment covered by the term.
+		field.Float("lessons"),
+		// Unset for school terms.
+		field.Int("course_id").Optional().Nillable(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (BillingTerm) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("billing_terms").
+			Unique().
+			Field("course_id"),
+		edge.To("invoices", Invoice.Type),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CashMovement is cash taken out of the drawer during a session: spent
+// (withdrawal), taken to the bank or paid back to a payer (refund).
+type CashMovement struct{ ent.Schema }
+
+func (CashMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("session_id"),
+		field.Enum("kind").Values("withdrawal", "bank_deposit", "refund"),
+		field.Int64("amount_cents"),
+		field.String("note").Default(""),
+		field.Int("created_by_user_id").Optional().Nillable(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+		// Refund payment row for kind refund.
+		field.Int("payment_id").Optional().Nillable(),
+	}
+}
+
+func (CashMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", CashSession.Type).
+			Ref("movements").
+			Unique().
+			Field("session_id").
+			Required(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CashReceipt is the numbered document handed to the payer for a cash
+// payment. One receipt covers every payment row the cash amount was split
+// into across invoices and credit.
+type CashReceipt struct{ ent.Schema }
+
+func (CashReceipt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("seq").Unique(),
+		field.String("number").Unique(),
+		field.Int("student_id"),
+		field.Int64("amount_cents"),
+		field.Time("paid_at"),
+		field.Int("received_by_user_id").Optional().Nillable(),
+		field.String("received_by").Default(""),
+		field.String("note").Default(""),
+		field.Enum("status").Values("issued", "voided").Default("issued"),
+		field.Time("voided_at").Optional().Nillable(),
+		field.String("voided_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+		// Drawer session open when the cash was taken, if any.
+		field.Int("cash_session_id").Optional().Nillable(),
+	}
+}
+
+func (CashReceipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("cash_receipts").
+			Unique().
+			Field("student_id").
+			Required(),
+		edge.To("payments", Payment.Type),
+		edge.From("cash_session", CashSession.Type).
+			Ref("receipts").
+			Unique().
+			Field("cash_session_id"),
+	}
+}
+
+func (CashReceipt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("student_id", "paid_at"),
+		index.Fields("cash_session_id"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CashSession is one day (or shift) of the front-desk cash drawer, from the
+// opening float to the counted closing amount.
+type CashSession struct{ ent.Schema }
+
+func (CashSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("opened_at").Default(time.Now),
+		field.Int("opened_by_user_id").Optional().Nillable(),
+		field.String("opened_by").Default(""),
+		field.Int64("opening_float_cents").Default(0),
+		field.Enum("status").Values("open", "closed").Default("open"),
+		field.Time("closed_at").Optional().Nillable(),
+		field.String("closed_by").Default(""),
+		field.Int64("counted_cents").Optional().Nillable(),
+		field.String("note").Default(""),
+		// Set when an admin reopens a closed session to correct it.
+		field.Time("reopened_at").Optional().Nillable(),
+		field.String("reopened_by").Default(""),
+	}
+}
+
+func (CashSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("movements", CashMovement.Type),
+		edge.To("receipts", CashReceipt.Type),
+	}
+}
+
+func (CashSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("opened_at"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -22,9 +23,17 @@ func (Course) Fields() []ent.Field {
 		field.Enum("type").Values("group", "individual"),
 		field.Float("legacy_lesson_price").StorageKey("lesson_price").Default(0),
 		field.Float("legacy_subscription_price").StorageKey("subscription_price").Default(0),
+		// Prices in force when the course's prices last changed; the price of
+		// a billing period comes from its price history (invoice.CoursePricesAt).
 		field.Int64("lesson_price_cents").Default(0),
 		field.Int64("subscription_price_cents").Default(0),
+		field.Float("vat_rate_pct").Default(0),
+		field.String("vat_exempt_note").Default(""),
 		field.Bool("is_active").Default(true),
+		// monthly, per school term, or per the course's own terms.
+		field.Enum("billing_period").Values("monthly", "term", "custom").Default("monthly"),
+		// Seats in the course; 0 means no limit.
+		field.Int("max_students").Default(0).NonNegative(),
 	}
 }
 
@@ -36,5 +45,16 @@ func (Course) Edges() []ent.Edge {
 			Unique(),
 		edge.To("enrollments", Enrollment.Type),
 		edge.To("month_stats", CourseMonthStat.Type),
+		edge.To("lesson_packages", LessonPackage.Type),
+		edge.To("prices", CoursePrice.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("billing_terms", BillingTerm.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("excused_absences", ExcusedAbsence.Type),
+		edge.To("waitlist_entries", WaitlistEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("leads", Lead.Type),
+		edge.To("schedule_slots", ScheduleSlot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CoursePrice is a change of a course's prices, in force from the billing
+// period it names until the next change. The first change also records the
+// course's opening prices as an entry without a period, in force for every
+// period before it; a course without entries is billed from its row.
+type CoursePrice struct{ ent.Schema }
+
+func (CoursePrice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("course_id"),
+		// Both unset for the opening prices.
+		field.Int("effective_year").Optional().Nillable(),
+		field.Int("effective_month").Range(1, 12).Optional().Nillable(),
+		field.Int64("lesson_price_cents"),
+		field.Int64("subscription_price_cents"),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (CoursePrice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("prices").
+			Unique().
+			Field("course_id").
+			Required(),
+	}
+}
+
+func (CoursePrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("course_id", "effective_year", "effective_month").Unique(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,12 +22,15 @@ func (Enrollment) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("student_id"),
 		field.Int("course_id"),
-		field.Enum("billing_mode").Values("subscription", "per_lesson"),
+		field.Enum("billing_mode").Values("subscription", "per_lesson", "package"),
 		field.Bool("charge_materials").Default(true),
 		field.Float("discount_pct").Default(0),
 		field.Int64("lesson_price_override_cents").Default(-1),
 		field.Int64("subscription_lesson_price_cents").Default(-1),
 		field.String("note").Default(""),
+		// First and last day of the enrollment; unset means open-ended.
+		field.Time("starts_on").Optional().Nillable(),
+		field.Time("ends_on").Optional().Nillable(),
 		field.Time("created_at").Optional().Nillable().Default(time.Now),
 		field.Time("updated_at").Optional().Nillable().Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -48,7 +52,14 @@ func (Enrollment) Edges() []ent.Edge {
 			Unique(), // one enrollment has exactly 1 course
 
 		// Inverse sides to the FK owners from other tables:
-		edge.To("invoice_lines", InvoiceLine.Type), // inverse to InvoiceLine.enrollment
+		// Inverse to InvoiceLine.enrollment. Billed enrollments must not be
+		// deleted, even though fee lines have no enrollment.
+		edge.To("invoice_lines", InvoiceLine.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+		edge.To("prices", EnrollmentPrice.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pauses", EnrollmentPause.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnrollmentPause freezes an enrollment from its first to its last day, e.g.
+// for holidays or illness, while the student keeps the seat.
+type EnrollmentPause struct{ ent.Schema }
+
+func (EnrollmentPause) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("enrollment_id"),
+		field.Time("starts_on"),
+		field.Time("ends_on"),
+		field.String("reason").Default(""),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (EnrollmentPause) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("enrollment", Enrollment.Type).
+			Ref("pauses").
+			Unique().
+			Field("enrollment_id").
+			Required(),
+	}
+}
+
+func (EnrollmentPause) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enrollment_id", "starts_on"),
+	}
+}
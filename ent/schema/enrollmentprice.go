@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnrollmentPrice records the enrollment's own prices in force from a billing
+// period until the next entry. The enrollment row keeps the latest prices;
+// periods before the first entry use them as well.
+type EnrollmentPrice struct{ ent.Schema }
+
+func (EnrollmentPrice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("enrollment_id"),
+		field.Int("effective_year"),
+		field.Int("effective_month").Range(1, 12),
+		field.Int64("lesson_price_override_cents"),
+		field.Int64("subscription_lesson_price_cents"),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (EnrollmentPrice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("enrollment", Enrollment.Type).
+			Ref("prices").
+			Unique().
+			Field("enrollment_id").
+			Required(),
+	}
+}
+
+func (EnrollmentPrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enrollment_id", "effective_year", "effective_month").Unique(),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExcusedAbsence is a subscription lesson a student missed with a valid
+// excuse. Depending on the school policy when it was recorded, it entitles
+// the student to a makeup lesson in another group until makeup_due_on, or it
+// is credited on the student's next draft.
+type ExcusedAbsence struct{ ent.Schema }
+
+func (ExcusedAbsence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("student_id"),
+		field.Int("course_id"),
+		field.Time("lesson_date"),
+		field.String("reason").Default(""),
+		field.Enum("resolution").Values("makeup", "credit"),
+		field.Time("makeup_due_on").Optional().Nillable(),
+		// Set once the makeup lesson has been attended.
+		field.Int("makeup_course_id").Optional().Nillable(),
+		field.Time("made_up_on").Optional().Nillable(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ExcusedAbsence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("excused_absences").
+			Unique().
+			Field("student_id").
+			Required(),
+		edge.From("course", Course.Type).
+			Ref("excused_absences").
+			Unique().
+			Field("course_id").
+			Required(),
+		// Credits on issued invoices must not be deleted.
+		edge.To("invoice_lines", InvoiceLine.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+	}
+}
+
+func (ExcusedAbsence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("student_id", "course_id", "lesson_date").Unique(),
+	}
+}
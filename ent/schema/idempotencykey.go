@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey remembers the response to a POST request sent with an
+// Idempotency-Key header, so a retried request is answered with the original
+// response instead of being executed again.
+type IdempotencyKey struct{ ent.Schema }
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("key"),
+		field.String("method"),
+		field.String("path"),
+		// SHA-256 of method, path and body; a reused key must match it.
+		field.String("request_hash"),
+		// Zero while the original request is still being handled.
+		field.Int("status_code").Default(0),
+		field.String("content_type").Default(""),
+		field.Bytes("response_body").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "key").Unique(),
+		index.Fields("created_at"),
+	}
+}
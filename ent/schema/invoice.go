@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -23,9 +24,16 @@ func (Invoice) Fields() []ent.Field {
 		field.Int("period_year"),
 		field.Int("period_month"),
 		field.Float("legacy_total_amount").StorageKey("total_amount").Default(0),
-		field.Int64("total_amount_cents").Default(0),
+		field.Int64("total_amount_cents").Default(0), // gross, VAT included
+		field.Int64("vat_amount_cents").Default(0),
 		field.Enum("status").Values("draft", "issued_pending_pdf", "issued", "paid_pending_pdf", "paid", "canceled").Default("draft"),
+		// Monthly invoices are built from attendance by GenerateDrafts; package
+		// invoices are issued when a lesson package is sold. Term invoices bill
+		// a billing term up front and are dated to its first month.
+		field.Enum("kind").Values("monthly", "package", "term").Default("monthly"),
+		field.Int("billing_term_id").Optional().Nillable(),
 		field.String("number").Nillable().Optional(),
+		field.Time("issued_at").Optional().Nillable(), // when the number was assigned; unset for legacy invoices
 		field.String("pdf_filename").Nillable().Optional(),
 		field.Time("pdf_generated_at").Optional().Nillable(),
 		field.Int("pdf_revision").Optional().Nillable(),
@@ -52,11 +60,35 @@ func (Invoice) Edges() []ent.Edge {
 		// Inverse side to InvoiceLine.invoice (FK in the lines)
 		edge.To("lines", InvoiceLine.Type),
 		edge.To("payments", Payment.Type),
+
+		// Payment plans covering this invoice, including canceled ones.
+		edge.From("payment_plans", PaymentPlan.Type).
+			Ref("invoices"),
+
+		// Late fees charged for this invoice being overdue.
+		edge.To("late_fees", LateFee.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		// Lesson packages sold with this invoice.
+		edge.To("lesson_packages", LessonPackage.Type),
+
+		edge.From("billing_term", BillingTerm.Type).
+			Ref("invoices").
+			Field("billing_term_id").
+			Unique(),
 	}
 }
 
 func (Invoice) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("student_id", "period_year", "period_month").Unique(),
+		// One monthly invoice per student and period; packages can be sold
+		// several times a month.
+		index.Fields("student_id", "period_year", "period_month").
+			Unique().
+			Annotations(entsql.IndexWhere("kind = 'monthly'")),
+		// One term invoice per student and term.
+		index.Fields("student_id", "billing_term_id").
+			Unique().
+			Annotations(entsql.IndexWhere("kind = 'term'")),
 	}
 }
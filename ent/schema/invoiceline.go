@@ -12,13 +12,22 @@ type InvoiceLine struct{ ent.Schema }
 func (InvoiceLine) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("invoice_id"),
-		field.Int("enrollment_id"),
+		// Unset on lines that are not for a course, e.g. late fees.
+		field.Int("enrollment_id").Optional().Nillable(),
+		// Set on lines billing a student charge; charge_year tells which
+		// occurrence of a yearly charge the line is for.
+		field.Int("charge_id").Optional().Nillable(),
+		field.Int("charge_year").Default(0),
+		// Set on lines crediting an excused absence.
+		field.Int("absence_id").Optional().Nillable(),
 		field.String("description"),
 		field.Float("qty"),
 		field.Float("legacy_unit_price").StorageKey("unit_price").Default(0),
 		field.Float("legacy_amount").StorageKey("amount").Default(0),
 		field.Int64("unit_price_cents").Default(0),
-		field.Int64("amount_cents").Default(0),
+		field.Int64("amount_cents").Default(0), // net of VAT
+		field.Float("vat_rate_pct").Default(0),
+		field.String("vat_exempt_note").Default(""),
 	}
 }
 
@@ -35,8 +44,17 @@ func (InvoiceLine) Edges() []ent.Edge {
 		edge.From("enrollment", Enrollment.Type).
 			Ref("invoice_lines").
 			Field("enrollment_id").
-			Required().
-			Unique(), // IMPORTANT: a line belongs to one enrollment
+			Unique(), // IMPORTANT: a line belongs to at most one enrollment
+
+		edge.From("charge", StudentCharge.Type).
+			Ref("invoice_lines").
+			Field("charge_id").
+			Unique(),
+
+		edge.From("absence", ExcusedAbsence.Type).
+			Ref("invoice_lines").
+			Field("absence_id").
+			Unique(),
 	}
 }
 
@@ -44,5 +62,7 @@ func (InvoiceLine) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("invoice_id"),
 		index.Fields("enrollment_id"),
+		index.Fields("charge_id", "charge_year"),
+		index.Fields("absence_id"),
 	}
 }
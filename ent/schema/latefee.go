@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LateFee is a late-payment fee for an overdue invoice, billed as a line of
+// the student's draft for period_year/period_month. A waived fee stays on
+// record so the same charge is not generated again.
+type LateFee struct{ ent.Schema }
+
+func (LateFee) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("student_id"),
+		field.Int("invoice_id"), // the overdue invoice
+		field.Int("period_year"),
+		field.Int("period_month"),
+		field.Int64("amount_cents"),
+		// Days past the due date covered by this and earlier fees.
+		field.Int("days_late").Default(0),
+		field.Enum("status").Values("billed", "waived").Default("billed"),
+		field.String("waived_reason").Default(""),
+		field.String("waived_by").Default(""),
+		field.Time("waived_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (LateFee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("late_fees").
+			Unique().
+			Field("student_id").
+			Required(),
+		edge.From("invoice", Invoice.Type).
+			Ref("late_fees").
+			Unique().
+			Field("invoice_id").
+			Required(),
+	}
+}
+
+func (LateFee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id", "period_year", "period_month").Unique(),
+		index.Fields("student_id", "period_year", "period_month"),
+	}
+}
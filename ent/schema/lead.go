@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Lead is a prospective student, from the first inquiry through a trial
+// lesson to becoming a student (converted) or dropping out (lost).
+type Lead struct{ ent.Schema }
+
+func (Lead) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		VersionMixin{},
+	}
+}
+
+func (Lead) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("full_name"),
+		field.String("personal_code").Default(""),
+		field.String("phone").Default(""),
+		field.String("email").Default(""),
+		field.Bool("is_minor").Default(false),
+		field.String("payer_name").Default(""),
+		field.String("payer_role").Default(""),
+		// Where the inquiry came from, e.g. "website" or "recommendation".
+		field.String("source").Default(""),
+		field.String("note").Default(""),
+		field.Enum("status").
+			Values("new", "trial_scheduled", "trial_done", "converted", "lost").
+			Default("new"),
+		// The course the lead is interested in and the trial is held in.
+		field.Int("course_id").Optional().Nillable(),
+		field.Time("trial_at").Optional().Nillable(),
+		// Charged to the student on conversion when the trial was attended.
+		field.Int64("trial_price_cents").Default(0),
+		field.Enum("trial_outcome").Values("attended", "no_show").Optional().Nillable(),
+		field.String("outcome_note").Default(""),
+		field.String("lost_reason").Default(""),
+		field.Int("student_id").Optional().Nillable(),
+		field.Time("converted_at").Optional().Nillable(),
+		field.Int("trial_charge_id").Optional().Nillable(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Lead) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("leads").
+			Unique().
+			Field("course_id"),
+		edge.From("student", Student.Type).
+			Ref("leads").
+			Unique().
+			Field("student_id"),
+	}
+}
+
+func (Lead) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LessonPackage is a prepaid block of lessons of one course, drawn down by
+// the student's attendance in that course.
+type LessonPackage struct{ ent.Schema }
+
+func (LessonPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("student_id"),
+		field.Int("course_id"),
+		field.Float("lessons"),
+		// Gross value of the lessons: the invoice total, or for lessons carried
+		// over their share of the original package's value.
+		field.Int64("price_cents"),
+		field.Time("sold_at").Default(time.Now),
+		field.Time("expires_on").Optional().Nillable(), // last day the lessons can be used
+		// Unset for packages made from lessons carried over.
+		field.Int("invoice_id").Optional().Nillable(),
+		field.Int("carried_from_id").Optional().Nillable(),
+		// open, or how the unused lessons were settled when it was closed.
+		field.Enum("status").Values("open", "carried_over", "refunded").Default("open"),
+		field.Time("closed_at").Optional().Nillable(),
+		field.Float("unused_lessons").Default(0), // at closing
+		field.Int64("refund_cents").Default(0),
+		field.String("note").Default(""),
+		field.String("created_by").Default(""),
+	}
+}
+
+func (LessonPackage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("lesson_packages").
+			Unique().
+			Field("student_id").
+			Required(),
+		edge.From("course", Course.Type).
+			Ref("lesson_packages").
+			Unique().
+			Field("course_id").
+			Required(),
+		edge.From("invoice", Invoice.Type).
+			Ref("lesson_packages").
+			Unique().
+			Field("invoice_id"),
+	}
+}
+
+func (LessonPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("student_id", "course_id"),
+	}
+}
@@ -21,6 +21,16 @@ func (Payment) Fields() []ent.Field {
 		field.Enum("method").Values("cash", "bank"),
 		field.String("note").Default(""),
 		field.Time("created_at").Default(time.Now),
+		// Set on every row of a cash payment; see CashReceipt.
+		field.Int("cash_receipt_id").Optional().Nillable(),
+		// Refunds and outgoing transfers are stored with negative amounts, so
+		// sums over a student's rows stay equal to the money kept. Lesson
+		// credit is the value of unused package lessons given back as credit.
+		field.Enum("kind").
+			Values("payment", "credit_applied", "refund", "transfer_in", "transfer_out", "lesson_credit").
+			Default("payment"),
+		// Refunded payment, or the other half of a credit transfer.
+		field.Int("related_payment_id").Optional().Nillable(),
 	}
 }
 
@@ -38,6 +48,11 @@ func (Payment) Edges() []ent.Edge {
 			Ref("payments").
 			Unique().
 			Field("invoice_id"),
+
+		edge.From("cash_receipt", CashReceipt.Type).
+			Ref("payments").
+			Unique().
+			Field("cash_receipt_id"),
 	}
 }
 
@@ -45,5 +60,7 @@ func (Payment) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("student_id", "paid_at"),
 		index.Fields("invoice_id"),
+		index.Fields("cash_receipt_id"),
+		index.Fields("related_payment_id"),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentPlan spreads a student's open invoices over a schedule of
+// instalments. Progress is measured by what is paid on the plan's invoices
+// after the plan was agreed.
+type PaymentPlan struct{ ent.Schema }
+
+func (PaymentPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("student_id"),
+		// Remaining debt of the invoices when the plan was agreed; equals the
+		// sum of the instalments.
+		field.Int64("total_cents"),
+		// Already paid on the invoices when the plan was agreed.
+		field.Int64("baseline_paid_cents").Default(0),
+		field.String("note").Default(""),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("canceled_at").Optional().Nillable(),
+		field.String("canceled_by").Default(""),
+	}
+}
+
+func (PaymentPlan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("payment_plans").
+			Unique().
+			Field("student_id").
+			Required(),
+		edge.To("invoices", Invoice.Type),
+		edge.To("instalments", PaymentPlanInstalment.Type),
+	}
+}
+
+func (PaymentPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("student_id"),
+	}
+}
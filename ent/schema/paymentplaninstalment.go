@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentPlanInstalment is one scheduled amount of a payment plan.
+// Payments fill the instalments in due-date order.
+type PaymentPlanInstalment struct{ ent.Schema }
+
+func (PaymentPlanInstalment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("plan_id"),
+		field.Int("seq"),
+		field.Time("due_on"),
+		field.Int64("amount_cents"),
+	}
+}
+
+func (PaymentPlanInstalment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plan", PaymentPlan.Type).
+			Ref("instalments").
+			Unique().
+			Field("plan_id").
+			Required(),
+	}
+}
+
+func (PaymentPlanInstalment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("plan_id", "seq").Unique(),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Room is a classroom lessons are scheduled in.
+type Room struct{ ent.Schema }
+
+func (Room) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		VersionMixin{},
+	}
+}
+
+func (Room) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		// Seats in the room; 0 when not recorded.
+		field.Int("capacity").Default(0).NonNegative(),
+		field.String("note").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Room) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("schedule_slots", ScheduleSlot.Type),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduleSlot is a weekly lesson of a course: a weekday and time in a room,
+// repeated from valid_from until valid_until.
+type ScheduleSlot struct{ ent.Schema }
+
+func (ScheduleSlot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		VersionMixin{},
+	}
+}
+
+func (ScheduleSlot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("course_id"),
+		field.Int("room_id").Optional().Nillable(),
+		// A substitute for the course's teacher; unset means the course's
+		// teacher gives the lesson.
+		field.Int("teacher_id").Optional().Nillable(),
+		// ISO weekday, 1 = Monday.
+		field.Int("weekday").Range(1, 7),
+		// Minutes since midnight.
+		field.Int("start_minute").Range(0, 24*60),
+		field.Int("end_minute").Range(0, 24*60),
+		field.Time("valid_from"),
+		field.Time("valid_until").Optional().Nillable(),
+		field.String("note").Default(""),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ScheduleSlot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("schedule_slots").
+			Unique().
+			Field("course_id").
+			Required(),
+		edge.From("room", Room.Type).
+			Ref("schedule_slots").
+			Unique().
+			Field("room_id"),
+		edge.From("teacher", Teacher.Type).
+			Ref("schedule_slots").
+			Unique().
+			Field("teacher_id"),
+	}
+}
+
+func (ScheduleSlot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("weekday"),
+		index.Fields("room_id"),
+	}
+}
@@ -21,6 +21,26 @@ func (Settings) Fields() []ent.Field {
 		field.String("invoice_email_subject_template").Default(""),
 		field.String("invoice_email_body_template").Default(""),
 		field.String("invoice_reply_to").Default(""),
+		field.String("bank_beneficiary_name").Default(""),
+		field.String("bank_name").Default(""),
+		field.String("bank_bic").Default(""),
+		field.String("bank_iban").Default(""),
+		field.Bool("invoice_payment_qr_enabled").Default(true),
+		field.Bool("vat_enabled").Default(false),
+		field.String("vat_number").Default(""),
 		field.Bool("money_cents_migrated").Default(false),
+		// Late-payment fees charged on the next draft after an invoice's due date.
+		field.Enum("late_fee_mode").Values("off", "flat", "daily_interest").Default("off"),
+		field.Int64("late_fee_flat_cents").Default(0),
+		field.Float("late_fee_daily_rate_pct").Default(0), // % of the unpaid amount per day late
+		field.Int("late_fee_grace_days").Default(0),
+		field.Int64("late_fee_cap_cents").Default(0), // per overdue invoice; 0 = no cap
+		// What an excused absence from a subscription lesson yields: a makeup
+		// lesson within makeup_window_weeks, or a credit on the next draft.
+		field.Enum("excused_absence_policy").Values("makeup", "credit").Default("credit"),
+		field.Int("makeup_window_weeks").Default(4),
+		// Days a freed course seat is offered to the next waiting student;
+		// 0 enrolls them directly.
+		field.Int("waitlist_offer_days").Default(0),
 	}
 }
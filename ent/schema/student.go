@@ -26,6 +26,16 @@ func (Student) Fields() []ent.Field {
 		field.Bool("is_minor").Default(false),
 		field.String("payer_name").Default(""),
 		field.String("payer_role").Default(""),
+		// Personal code of the paying parent, printed on the annual
+		// education-expense certificate used for tax returns.
+		field.String("payer_personal_code").Default(""),
+		// Company payers (e.g. an employer) are invoiced with their legal details.
+		field.Enum("payer_type").Values("person", "company").Default("person"),
+		field.String("payer_company_name").Default(""),
+		field.String("payer_reg_no").Default(""),
+		field.String("payer_vat_number").Default(""),
+		field.String("payer_legal_address").Default(""),
+		field.String("payer_billing_email").Default(""),
 		field.Bool("is_active").Default(true),
 	}
 }
@@ -35,5 +45,13 @@ func (Student) Edges() []ent.Edge {
 		edge.To("enrollments", Enrollment.Type),
 		edge.To("invoices", Invoice.Type),
 		edge.To("payments", Payment.Type),
+		edge.To("cash_receipts", CashReceipt.Type),
+		edge.To("payment_plans", PaymentPlan.Type),
+		edge.To("late_fees", LateFee.Type),
+		edge.To("charges", StudentCharge.Type),
+		edge.To("lesson_packages", LessonPackage.Type),
+		edge.To("excused_absences", ExcusedAbsence.Type),
+		edge.To("waitlist_entries", WaitlistEntry.Type),
+		edge.To("leads", Lead.Type),
 	}
 }
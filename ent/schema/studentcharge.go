@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StudentCharge is a charge billed to a student outside of lessons, such as
+// a registration fee (once) or a membership fee (every year). Each
+// occurrence is billed on exactly one invoice line.
+type StudentCharge struct{ ent.Schema }
+
+func (StudentCharge) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("student_id"),
+		field.Enum("kind").Values("one_off", "yearly"),
+		field.String("description"),
+		field.Int64("amount_cents"),
+		field.Float("vat_rate_pct").Default(0),
+		// A one-off charge is due in due_year/due_month; a yearly one in
+		// due_month of every year from due_year on.
+		field.Int("due_year"),
+		field.Int("due_month"),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("canceled_at").Optional().Nillable(),
+		field.String("canceled_by").Default(""),
+	}
+}
+
+func (StudentCharge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("student", Student.Type).
+			Ref("charges").
+			Unique().
+			Field("student_id").
+			Required(),
+		// Billed charges must not be deleted; cancel them instead.
+		edge.To("invoice_lines", InvoiceLine.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+	}
+}
+
+func (StudentCharge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("student_id"),
+	}
+}
@@ -18,5 +18,6 @@ func (Teacher) Fields() []ent.Field {
 func (Teacher) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("courses", Course.Type),
+		edge.To("schedule_slots", ScheduleSlot.Type),
 	}
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WaitlistEntry is a student waiting for a seat in a full course. Waiting
+// entries are promoted by rank when a seat frees up: enrolled directly, or
+// offered the seat until offer_expires_at.
+type WaitlistEntry struct{ ent.Schema }
+
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("course_id"),
+		field.Int("student_id"),
+		field.Int("rank"), // 1 is next in line
+		// Billing mode of the enrollment made on promotion.
+		field.Enum("billing_mode").Values("subscription", "per_lesson", "package").Default("per_lesson"),
+		field.String("note").Default(""),
+		field.Enum("status").Values("waiting", "offered", "enrolled", "declined", "expired").Default("waiting"),
+		field.Time("offered_at").Optional().Nillable(),
+		field.Time("offer_expires_at").Optional().Nillable(),
+		field.String("offer_emailed_to").Default(""),
+		field.Int("enrollment_id").Optional().Nillable(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("course", Course.Type).
+			Ref("waitlist_entries").
+			Unique().
+			Field("course_id").
+			Required(),
+		edge.From("student", Student.Type).
+			Ref("waitlist_entries").
+			Unique().
+			Field("student_id").
+			Required(),
+	}
+}
+
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("course_id", "status", "rank"),
+		index.Fields("student_id"),
+	}
+}
@@ -38,9 +38,39 @@ type Settings struct {
 	InvoiceEmailBodyTemplate string `json:"invoice_email_body_template,omitempty"`
 	// InvoiceReplyTo holds the value of the "invoice_reply_to" field.
 	InvoiceReplyTo string `json:"invoice_reply_to,omitempty"`
+	// BankBeneficiaryName holds the value of the "bank_beneficiary_name" field.
+	BankBeneficiaryName string `json:"bank_beneficiary_name,omitempty"`
+	// BankName holds the value of the "bank_name" field.
+	BankName string `json:"bank_name,omitempty"`
+	// BankBic holds the value of the "bank_bic" field.
+	BankBic string `json:"bank_bic,omitempty"`
+	// BankIban holds the value of the "bank_iban" field.
+	BankIban string `json:"bank_iban,omitempty"`
+	// InvoicePaymentQrEnabled holds the value of the "invoice_payment_qr_enabled" field.
+	InvoicePaymentQrEnabled bool `json:"invoice_payment_qr_enabled,omitempty"`
+	// VatEnabled holds the value of the "vat_enabled" field.
+	VatEnabled bool `json:"vat_enabled,omitempty"`
+	// VatNumber holds the value of the "vat_number" field.
+	VatNumber string `json:"vat_number,omitempty"`
 	// MoneyCentsMigrated holds the value of the "money_cents_migrated" field.
 	MoneyCentsMigrated bool `json:"money_cents_migrated,omitempty"`
-	selectValues       sql.SelectValues
+	// LateFeeMode holds the value of the "late_fee_mode" field.
+	LateFeeMode settings.LateFeeMode `json:"late_fee_mode,omitempty"`
+	// LateFeeFlatCents holds the value of the "late_fee_flat_cents" field.
+	LateFeeFlatCents int64 `json:"late_fee_flat_cents,omitempty"`
+	// LateFeeDailyRatePct holds the value of the "late_fee_daily_rate_pct" field.
+	LateFeeDailyRatePct float64 `json:"late_fee_daily_rate_pct,omitempty"`
+	// LateFeeGraceDays holds the value of the "late_fee_grace_days" field.
+	LateFeeGraceDays int `json:"late_fee_grace_days,omitempty"`
+	// LateFeeCapCents holds the value of the "late_fee_cap_cents" field.
+	LateFeeCapCents int64 `json:"late_fee_cap_cents,omitempty"`
+	// ExcusedAbsencePolicy holds the value of the "excused_absence_policy" field.
+	ExcusedAbsencePolicy settings.ExcusedAbsencePolicy `json:"excused_absence_policy,omitempty"`
+	// MakeupWindowWeeks holds the value of the "makeup_window_weeks" field.
+	MakeupWindowWeeks int `json:"makeup_window_weeks,omitempty"`
+	// WaitlistOfferDays holds the value of the "waitlist_offer_days" field.
+	WaitlistOfferDays int `json:"waitlist_offer_days,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -48,11 +78,13 @@ func (*Settings) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case settings.FieldMoneyCentsMigrated:
+		case settings.FieldInvoicePaymentQrEnabled, settings.FieldVatEnabled, settings.FieldMoneyCentsMigrated:
 			values[i] = new(sql.NullBool)
-		case settings.FieldID, settings.FieldSingletonID, settings.FieldNextSeq, settings.FieldInvoiceDayOfMonth:
+		case settings.FieldLateFeeDailyRatePct:
+			values[i] = new(sql.NullFloat64)
+		case settings.FieldID, settings.FieldSingletonID, settings.FieldNextSeq, settings.FieldInvoiceDayOfMonth, settings.FieldLateFeeFlatCents, settings.FieldLateFeeGraceDays, settings.FieldLateFeeCapCents, settings.FieldMakeupWindowWeeks, settings.FieldWaitlistOfferDays:
 			values[i] = new(sql.NullInt64)
-		case settings.FieldOrgName, settings.FieldAddress, settings.FieldInvoicePrefix, settings.FieldCurrency, settings.FieldLocale, settings.FieldInvoiceEmailSubjectTemplate, settings.FieldInvoiceEmailBodyTemplate, settings.FieldInvoiceReplyTo:
+		case settings.FieldOrgName, settings.FieldAddress, settings.FieldInvoicePrefix, settings.FieldCurrency, settings.FieldLocale, settings.FieldInvoiceEmailSubjectTemplate, settings.FieldInvoiceEmailBodyTemplate, settings.FieldInvoiceReplyTo, settings.FieldBankBeneficiaryName, settings.FieldBankName, settings.FieldBankBic, settings.FieldBankIban, settings.FieldVatNumber, settings.FieldLateFeeMode, settings.FieldExcusedAbsencePolicy:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -141,12 +173,102 @@ func (_m *Settings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.InvoiceReplyTo = value.String
 			}
+		case settings.FieldBankBeneficiaryName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_beneficiary_name", values[i])
+			} else if value.Valid {
+				_m.BankBeneficiaryName = value.String
+			}
+		case settings.FieldBankName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_name", values[i])
+			} else if value.Valid {
+				_m.BankName = value.String
+			}
+		case settings.FieldBankBic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_bic", values[i])
+			} else if value.Valid {
+				_m.BankBic = value.String
+			}
+		case settings.FieldBankIban:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_iban", values[i])
+			} else if value.Valid {
+				_m.BankIban = value.String
+			}
+		case settings.FieldInvoicePaymentQrEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_payment_qr_enabled", values[i])
+			} else if value.Valid {
+				_m.InvoicePaymentQrEnabled = value.Bool
+			}
+		case settings.FieldVatEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_enabled", values[i])
+			} else if value.Valid {
+				_m.VatEnabled = value.Bool
+			}
+		case settings.FieldVatNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_number", values[i])
+			} else if value.Valid {
+				_m.VatNumber = value.String
+			}
 		case settings.FieldMoneyCentsMigrated:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field money_cents_migrated", values[i])
 			} else if value.Valid {
 				_m.MoneyCentsMigrated = value.Bool
 			}
+		case settings.FieldLateFeeMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_mode", values[i])
+			} else if value.Valid {
+				_m.LateFeeMode = settings.LateFeeMode(value.String)
+			}
+		case settings.FieldLateFeeFlatCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_flat_cents", values[i])
+			} else if value.Valid {
+				_m.LateFeeFlatCents = value.Int64
+			}
+		case settings.FieldLateFeeDailyRatePct:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_daily_rate_pct", values[i])
+			} else if value.Valid {
+				_m.LateFeeDailyRatePct = value.Float64
+			}
+		case settings.FieldLateFeeGraceDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_grace_days", values[i])
+			} else if value.Valid {
+				_m.LateFeeGraceDays = int(value.Int64)
+			}
+		case settings.FieldLateFeeCapCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_cap_cents", values[i])
+			} else if value.Valid {
+				_m.LateFeeCapCents = value.Int64
+			}
+		case settings.FieldExcusedAbsencePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excused_absence_policy", values[i])
+			} else if value.Valid {
+				_m.ExcusedAbsencePolicy = settings.ExcusedAbsencePolicy(value.String)
+			}
+		case settings.FieldMakeupWindowWeeks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field makeup_window_weeks", values[i])
+			} else if value.Valid {
+				_m.MakeupWindowWeeks = int(value.Int64)
+			}
+		case settings.FieldWaitlistOfferDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field waitlist_offer_days", values[i])
+			} else if value.Valid {
+				_m.WaitlistOfferDays = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -216,8 +338,53 @@ func (_m *Settings) String() string {
 	builder.WriteString("invoice_reply_to=")
 	builder.WriteString(_m.InvoiceReplyTo)
 	builder.WriteString(", ")
+	builder.WriteString("bank_beneficiary_name=")
+	builder.WriteString(_m.BankBeneficiaryName)
+	builder.WriteString(", ")
+	builder.WriteString("bank_name=")
+	builder.WriteString(_m.BankName)
+	builder.WriteString(", ")
+	builder.WriteString("bank_bic=")
+	builder.WriteString(_m.BankBic)
+	builder.WriteString(", ")
+	builder.WriteString("bank_iban=")
+	builder.WriteString(_m.BankIban)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_payment_qr_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoicePaymentQrEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("vat_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("vat_number=")
+	builder.WriteString(_m.VatNumber)
+	builder.WriteString(", ")
 	builder.WriteString("money_cents_migrated=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MoneyCentsMigrated))
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeMode))
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_flat_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeFlatCents))
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_daily_rate_pct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeDailyRatePct))
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_grace_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeGraceDays))
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_cap_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeCapCents))
+	builder.WriteString(", ")
+	builder.WriteString("excused_absence_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcusedAbsencePolicy))
+	builder.WriteString(", ")
+	builder.WriteString("makeup_window_weeks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MakeupWindowWeeks))
+	builder.WriteString(", ")
+	builder.WriteString("waitlist_offer_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WaitlistOfferDays))
 	builder.WriteByte(')')
 	return builder.String()
 }
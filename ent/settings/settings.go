@@ -3,6 +3,8 @@
 package settings
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -33,8 +35,38 @@ const (
 	FieldInvoiceEmailBodyTemplate = "invoice_email_body_template"
 	// FieldInvoiceReplyTo holds the string denoting the invoice_reply_to field in the database.
 	FieldInvoiceReplyTo = "invoice_reply_to"
+	// FieldBankBeneficiaryName holds the string denoting the bank_beneficiary_name field in the database.
+	FieldBankBeneficiaryName = "bank_beneficiary_name"
+	// FieldBankName holds the string denoting the bank_name field in the database.
+	FieldBankName = "bank_name"
+	// FieldBankBic holds the string denoting the bank_bic field in the database.
+	FieldBankBic = "bank_bic"
+	// FieldBankIban holds the string denoting the bank_iban field in the database.
+	FieldBankIban = "bank_iban"
+	// FieldInvoicePaymentQrEnabled holds the string denoting the invoice_payment_qr_enabled field in the database.
+	FieldInvoicePaymentQrEnabled = "invoice_payment_qr_enabled"
+	// FieldVatEnabled holds the string denoting the vat_enabled field in the database.
+	FieldVatEnabled = "vat_enabled"
+	// FieldVatNumber holds the string denoting the vat_number field in the database.
+	FieldVatNumber = "vat_number"
 	// FieldMoneyCentsMigrated holds the string denoting the money_cents_migrated field in the database.
 	FieldMoneyCentsMigrated = "money_cents_migrated"
+	// FieldLateFeeMode holds the string denoting the late_fee_mode field in the database.
+	FieldLateFeeMode = "late_fee_mode"
+	// FieldLateFeeFlatCents holds the string denoting the late_fee_flat_cents field in the database.
+	FieldLateFeeFlatCents = "late_fee_flat_cents"
+	// FieldLateFeeDailyRatePct holds the string denoting the late_fee_daily_rate_pct field in the database.
+	FieldLateFeeDailyRatePct = "late_fee_daily_rate_pct"
+	// FieldLateFeeGraceDays holds the string denoting the late_fee_grace_days field in the database.
+	FieldLateFeeGraceDays = "late_fee_grace_days"
+	// FieldLateFeeCapCents holds the string denoting the late_fee_cap_cents field in the database.
+	FieldLateFeeCapCents = "late_fee_cap_cents"
+	// FieldExcusedAbsencePolicy holds the string denoting the excused_absence_policy field in the database.
+	FieldExcusedAbsencePolicy = "excused_absence_policy"
+	// FieldMakeupWindowWeeks holds the string denoting the makeup_window_weeks field in the database.
+	FieldMakeupWindowWeeks = "makeup_window_weeks"
+	// FieldWaitlistOfferDays holds the string denoting the waitlist_offer_days field in the database.
+	FieldWaitlistOfferDays = "waitlist_offer_days"
 	// Table holds the table name of the settings in the database.
 	Table = "settings"
 )
@@ -53,7 +85,22 @@ var Columns = []string{
 	FieldInvoiceEmailSubjectTemplate,
 	FieldInvoiceEmailBodyTemplate,
 	FieldInvoiceReplyTo,
+	FieldBankBeneficiaryName,
+	FieldBankName,
+	FieldBankBic,
+	FieldBankIban,
+	FieldInvoicePaymentQrEnabled,
+	FieldVatEnabled,
+	FieldVatNumber,
 	FieldMoneyCentsMigrated,
+	FieldLateFeeMode,
+	FieldLateFeeFlatCents,
+	FieldLateFeeDailyRatePct,
+	FieldLateFeeGraceDays,
+	FieldLateFeeCapCents,
+	FieldExcusedAbsencePolicy,
+	FieldMakeupWindowWeeks,
+	FieldWaitlistOfferDays,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,10 +134,89 @@ var (
 	DefaultInvoiceEmailBodyTemplate string
 	// DefaultInvoiceReplyTo holds the default value on creation for the "invoice_reply_to" field.
 	DefaultInvoiceReplyTo string
+	// DefaultBankBeneficiaryName holds the default value on creation for the "bank_beneficiary_name" field.
+	DefaultBankBeneficiaryName string
+	// DefaultBankName holds the default value on creation for the "bank_name" field.
+	DefaultBankName string
+	// DefaultBankBic holds the default value on creation for the "bank_bic" field.
+	DefaultBankBic string
+	// DefaultBankIban holds the default value on creation for the "bank_iban" field.
+	DefaultBankIban string
+	// DefaultInvoicePaymentQrEnabled holds the default value on creation for the "invoice_payment_qr_enabled" field.
+	DefaultInvoicePaymentQrEnabled bool
+	// DefaultVatEnabled holds the default value on creation for the "vat_enabled" field.
+	DefaultVatEnabled bool
+	// DefaultVatNumber holds the default value on creation for the "vat_number" field.
+	DefaultVatNumber string
 	// DefaultMoneyCentsMigrated holds the default value on creation for the "money_cents_migrated" field.
 	DefaultMoneyCentsMigrated bool
+	// DefaultLateFeeFlatCents holds the default value on creation for the "late_fee_flat_cents" field.
+	DefaultLateFeeFlatCents int64
+	// DefaultLateFeeDailyRatePct holds the default value on creation for the "late_fee_daily_rate_pct" field.
+	DefaultLateFeeDailyRatePct float64
+	// DefaultLateFeeGraceDays holds the default value on creation for the "late_fee_grace_days" field.
+	DefaultLateFeeGraceDays int
+	// DefaultLateFeeCapCents holds the default value on creation for the "late_fee_cap_cents" field.
+	DefaultLateFeeCapCents int64
+	// DefaultMakeupWindowWeeks holds the default value on creation for the "makeup_window_weeks" field.
+	DefaultMakeupWindowWeeks int
+	// DefaultWaitlistOfferDays holds the default value on creation for the "waitlist_offer_days" field.
+	DefaultWaitlistOfferDays int
+)
+
+// LateFeeMode defines the type for the "late_fee_mode" enum field.
+type LateFeeMode string
+
+// LateFeeModeOff is the default value of the LateFeeMode enum.
+const DefaultLateFeeMode = LateFeeModeOff
+
+// LateFeeMode values.
+const (
+	LateFeeModeOff           LateFeeMode = "off"
+	LateFeeModeFlat          LateFeeMode = "flat"
+	LateFeeModeDailyInterest LateFeeMode = "daily_interest"
+)
+
+func (lfm LateFeeMode) String() string {
+	return string(lfm)
+}
+
+// LateFeeModeValidator is a validator for the "late_fee_mode" field enum values. It is called by the builders before save.
+func LateFeeModeValidator(lfm LateFeeMode) error {
+	switch lfm {
+	case LateFeeModeOff, LateFeeModeFlat, LateFeeModeDailyInterest:
+		return nil
+	default:
+		return fmt.Errorf("settings: invalid enum value for late_fee_mode field: %q", lfm)
+	}
+}
+
+// ExcusedAbsencePolicy defines the type for the "excused_absence_policy" enum field.
+type ExcusedAbsencePolicy string
+
+// ExcusedAbsencePolicyCredit is the default value of the ExcusedAbsencePolicy enum.
+const DefaultExcusedAbsencePolicy = ExcusedAbsencePolicyCredit
+
+// ExcusedAbsencePolicy values.
+const (
+	ExcusedAbsencePolicyMakeup ExcusedAbsencePolicy = "makeup"
+	ExcusedAbsencePolicyCredit ExcusedAbsencePolicy = "credit"
 )
 
+func (eap ExcusedAbsencePolicy) String() string {
+	return string(eap)
+}
+
+// ExcusedAbsencePolicyValidator is a validator for the "excused_absence_policy" field enum values. It is called by the builders before save.
+func ExcusedAbsencePolicyValidator(eap ExcusedAbsencePolicy) error {
+	switch eap {
+	case ExcusedAbsencePolicyMakeup, ExcusedAbsencePolicyCredit:
+		return nil
+	default:
+		return fmt.Errorf("settings: invalid enum value for excused_absence_policy field: %q", eap)
+	}
+}
+
 // OrderOption defines the ordering options for the Settings queries.
 type OrderOption func(*sql.Selector)
 
@@ -154,7 +280,82 @@ func ByInvoiceReplyTo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvoiceReplyTo, opts...).ToFunc()
 }
 
+// ByBankBeneficiaryName orders the results by the bank_beneficiary_name field.
+func ByBankBeneficiaryName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankBeneficiaryName, opts...).ToFunc()
+}
+
+// ByBankName orders the results by the bank_name field.
+func ByBankName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankName, opts...).ToFunc()
+}
+
+// ByBankBic orders the results by the bank_bic field.
+func ByBankBic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankBic, opts...).ToFunc()
+}
+
+// ByBankIban orders the results by the bank_iban field.
+func ByBankIban(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankIban, opts...).ToFunc()
+}
+
+// ByInvoicePaymentQrEnabled orders the results by the invoice_payment_qr_enabled field.
+func ByInvoicePaymentQrEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoicePaymentQrEnabled, opts...).ToFunc()
+}
+
+// ByVatEnabled orders the results by the vat_enabled field.
+func ByVatEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatEnabled, opts...).ToFunc()
+}
+
+// ByVatNumber orders the results by the vat_number field.
+func ByVatNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatNumber, opts...).ToFunc()
+}
+
 // ByMoneyCentsMigrated orders the results by the money_cents_migrated field.
 func ByMoneyCentsMigrated(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMoneyCentsMigrated, opts...).ToFunc()
 }
+
+// ByLateFeeMode orders the results by the late_fee_mode field.
+func ByLateFeeMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeMode, opts...).ToFunc()
+}
+
+// ByLateFeeFlatCents orders the results by the late_fee_flat_cents field.
+func ByLateFeeFlatCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeFlatCents, opts...).ToFunc()
+}
+
+// ByLateFeeDailyRatePct orders the results by the late_fee_daily_rate_pct field.
+func ByLateFeeDailyRatePct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeDailyRatePct, opts...).ToFunc()
+}
+
+// ByLateFeeGraceDays orders the results by the late_fee_grace_days field.
+func ByLateFeeGraceDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeGraceDays, opts...).ToFunc()
+}
+
+// ByLateFeeCapCents orders the results by the late_fee_cap_cents field.
+func ByLateFeeCapCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeCapCents, opts...).ToFunc()
+}
+
+// ByExcusedAbsencePolicy orders the results by the excused_absence_policy field.
+func ByExcusedAbsencePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcusedAbsencePolicy, opts...).ToFunc()
+}
+
+// ByMakeupWindowWeeks orders the results by the makeup_window_weeks field.
+func ByMakeupWindowWeeks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMakeupWindowWeeks, opts...).ToFunc()
+}
+
+// ByWaitlistOfferDays orders the results by the waitlist_offer_days field.
+func ByWaitlistOfferDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaitlistOfferDays, opts...).ToFunc()
+}
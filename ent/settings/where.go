@@ -108,11 +108,76 @@ func InvoiceReplyTo(v string) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldInvoiceReplyTo, v))
 }
 
+// BankBeneficiaryName applies equality check predicate on the "bank_beneficiary_name" field. It's identical to BankBeneficiaryNameEQ.
+func BankBeneficiaryName(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankBeneficiaryName, v))
+}
+
+// BankName applies equality check predicate on the "bank_name" field. It's identical to BankNameEQ.
+func BankName(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankBic applies equality check predicate on the "bank_bic" field. It's identical to BankBicEQ.
+func BankBic(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankBic, v))
+}
+
+// BankIban applies equality check predicate on the "bank_iban" field. It's identical to BankIbanEQ.
+func BankIban(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankIban, v))
+}
+
+// InvoicePaymentQrEnabled applies equality check predicate on the "invoice_payment_qr_enabled" field. It's identical to InvoicePaymentQrEnabledEQ.
+func InvoicePaymentQrEnabled(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoicePaymentQrEnabled, v))
+}
+
+// VatEnabled applies equality check predicate on the "vat_enabled" field. It's identical to VatEnabledEQ.
+func VatEnabled(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldVatEnabled, v))
+}
+
+// VatNumber applies equality check predicate on the "vat_number" field. It's identical to VatNumberEQ.
+func VatNumber(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldVatNumber, v))
+}
+
 // MoneyCentsMigrated applies equality check predicate on the "money_cents_migrated" field. It's identical to MoneyCentsMigratedEQ.
 func MoneyCentsMigrated(v bool) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldMoneyCentsMigrated, v))
 }
 
+// LateFeeFlatCents applies equality check predicate on the "late_fee_flat_cents" field. It's identical to LateFeeFlatCentsEQ.
+func LateFeeFlatCents(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeDailyRatePct applies equality check predicate on the "late_fee_daily_rate_pct" field. It's identical to LateFeeDailyRatePctEQ.
+func LateFeeDailyRatePct(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeGraceDays applies equality check predicate on the "late_fee_grace_days" field. It's identical to LateFeeGraceDaysEQ.
+func LateFeeGraceDays(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeCapCents applies equality check predicate on the "late_fee_cap_cents" field. It's identical to LateFeeCapCentsEQ.
+func LateFeeCapCents(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeCapCents, v))
+}
+
+// MakeupWindowWeeks applies equality check predicate on the "makeup_window_weeks" field. It's identical to MakeupWindowWeeksEQ.
+func MakeupWindowWeeks(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldMakeupWindowWeeks, v))
+}
+
+// WaitlistOfferDays applies equality check predicate on the "waitlist_offer_days" field. It's identical to WaitlistOfferDaysEQ.
+func WaitlistOfferDays(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldWaitlistOfferDays, v))
+}
+
 // SingletonIDEQ applies the EQ predicate on the "singleton_id" field.
 func SingletonIDEQ(v int) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldSingletonID, v))
@@ -753,6 +818,351 @@ func InvoiceReplyToContainsFold(v string) predicate.Settings {
 	return predicate.Settings(sql.FieldContainsFold(FieldInvoiceReplyTo, v))
 }
 
+// BankBeneficiaryNameEQ applies the EQ predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameNEQ applies the NEQ predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameIn applies the In predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldBankBeneficiaryName, vs...))
+}
+
+// BankBeneficiaryNameNotIn applies the NotIn predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldBankBeneficiaryName, vs...))
+}
+
+// BankBeneficiaryNameGT applies the GT predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameGTE applies the GTE predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameLT applies the LT predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameLTE applies the LTE predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameContains applies the Contains predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameHasPrefix applies the HasPrefix predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameHasSuffix applies the HasSuffix predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameEqualFold applies the EqualFold predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldBankBeneficiaryName, v))
+}
+
+// BankBeneficiaryNameContainsFold applies the ContainsFold predicate on the "bank_beneficiary_name" field.
+func BankBeneficiaryNameContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldBankBeneficiaryName, v))
+}
+
+// BankNameEQ applies the EQ predicate on the "bank_name" field.
+func BankNameEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankNameNEQ applies the NEQ predicate on the "bank_name" field.
+func BankNameNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldBankName, v))
+}
+
+// BankNameIn applies the In predicate on the "bank_name" field.
+func BankNameIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldBankName, vs...))
+}
+
+// BankNameNotIn applies the NotIn predicate on the "bank_name" field.
+func BankNameNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldBankName, vs...))
+}
+
+// BankNameGT applies the GT predicate on the "bank_name" field.
+func BankNameGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldBankName, v))
+}
+
+// BankNameGTE applies the GTE predicate on the "bank_name" field.
+func BankNameGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldBankName, v))
+}
+
+// BankNameLT applies the LT predicate on the "bank_name" field.
+func BankNameLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldBankName, v))
+}
+
+// BankNameLTE applies the LTE predicate on the "bank_name" field.
+func BankNameLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldBankName, v))
+}
+
+// BankNameContains applies the Contains predicate on the "bank_name" field.
+func BankNameContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldBankName, v))
+}
+
+// BankNameHasPrefix applies the HasPrefix predicate on the "bank_name" field.
+func BankNameHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldBankName, v))
+}
+
+// BankNameHasSuffix applies the HasSuffix predicate on the "bank_name" field.
+func BankNameHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldBankName, v))
+}
+
+// BankNameEqualFold applies the EqualFold predicate on the "bank_name" field.
+func BankNameEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldBankName, v))
+}
+
+// BankNameContainsFold applies the ContainsFold predicate on the "bank_name" field.
+func BankNameContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldBankName, v))
+}
+
+// BankBicEQ applies the EQ predicate on the "bank_bic" field.
+func BankBicEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankBic, v))
+}
+
+// BankBicNEQ applies the NEQ predicate on the "bank_bic" field.
+func BankBicNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldBankBic, v))
+}
+
+// BankBicIn applies the In predicate on the "bank_bic" field.
+func BankBicIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldBankBic, vs...))
+}
+
+// BankBicNotIn applies the NotIn predicate on the "bank_bic" field.
+func BankBicNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldBankBic, vs...))
+}
+
+// BankBicGT applies the GT predicate on the "bank_bic" field.
+func BankBicGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldBankBic, v))
+}
+
+// BankBicGTE applies the GTE predicate on the "bank_bic" field.
+func BankBicGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldBankBic, v))
+}
+
+// BankBicLT applies the LT predicate on the "bank_bic" field.
+func BankBicLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldBankBic, v))
+}
+
+// BankBicLTE applies the LTE predicate on the "bank_bic" field.
+func BankBicLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldBankBic, v))
+}
+
+// BankBicContains applies the Contains predicate on the "bank_bic" field.
+func BankBicContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldBankBic, v))
+}
+
+// BankBicHasPrefix applies the HasPrefix predicate on the "bank_bic" field.
+func BankBicHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldBankBic, v))
+}
+
+// BankBicHasSuffix applies the HasSuffix predicate on the "bank_bic" field.
+func BankBicHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldBankBic, v))
+}
+
+// BankBicEqualFold applies the EqualFold predicate on the "bank_bic" field.
+func BankBicEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldBankBic, v))
+}
+
+// BankBicContainsFold applies the ContainsFold predicate on the "bank_bic" field.
+func BankBicContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldBankBic, v))
+}
+
+// BankIbanEQ applies the EQ predicate on the "bank_iban" field.
+func BankIbanEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldBankIban, v))
+}
+
+// BankIbanNEQ applies the NEQ predicate on the "bank_iban" field.
+func BankIbanNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldBankIban, v))
+}
+
+// BankIbanIn applies the In predicate on the "bank_iban" field.
+func BankIbanIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldBankIban, vs...))
+}
+
+// BankIbanNotIn applies the NotIn predicate on the "bank_iban" field.
+func BankIbanNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldBankIban, vs...))
+}
+
+// BankIbanGT applies the GT predicate on the "bank_iban" field.
+func BankIbanGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldBankIban, v))
+}
+
+// BankIbanGTE applies the GTE predicate on the "bank_iban" field.
+func BankIbanGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldBankIban, v))
+}
+
+// BankIbanLT applies the LT predicate on the "bank_iban" field.
+func BankIbanLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldBankIban, v))
+}
+
+// BankIbanLTE applies the LTE predicate on the "bank_iban" field.
+func BankIbanLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldBankIban, v))
+}
+
+// BankIbanContains applies the Contains predicate on the "bank_iban" field.
+func BankIbanContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldBankIban, v))
+}
+
+// BankIbanHasPrefix applies the HasPrefix predicate on the "bank_iban" field.
+func BankIbanHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldBankIban, v))
+}
+
+// BankIbanHasSuffix applies the HasSuffix predicate on the "bank_iban" field.
+func BankIbanHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldBankIban, v))
+}
+
+// BankIbanEqualFold applies the EqualFold predicate on the "bank_iban" field.
+func BankIbanEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldBankIban, v))
+}
+
+// BankIbanContainsFold applies the ContainsFold predicate on the "bank_iban" field.
+func BankIbanContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldBankIban, v))
+}
+
+// InvoicePaymentQrEnabledEQ applies the EQ predicate on the "invoice_payment_qr_enabled" field.
+func InvoicePaymentQrEnabledEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoicePaymentQrEnabled, v))
+}
+
+// InvoicePaymentQrEnabledNEQ applies the NEQ predicate on the "invoice_payment_qr_enabled" field.
+func InvoicePaymentQrEnabledNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoicePaymentQrEnabled, v))
+}
+
+// VatEnabledEQ applies the EQ predicate on the "vat_enabled" field.
+func VatEnabledEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldVatEnabled, v))
+}
+
+// VatEnabledNEQ applies the NEQ predicate on the "vat_enabled" field.
+func VatEnabledNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldVatEnabled, v))
+}
+
+// VatNumberEQ applies the EQ predicate on the "vat_number" field.
+func VatNumberEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldVatNumber, v))
+}
+
+// VatNumberNEQ applies the NEQ predicate on the "vat_number" field.
+func VatNumberNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldVatNumber, v))
+}
+
+// VatNumberIn applies the In predicate on the "vat_number" field.
+func VatNumberIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldVatNumber, vs...))
+}
+
+// VatNumberNotIn applies the NotIn predicate on the "vat_number" field.
+func VatNumberNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldVatNumber, vs...))
+}
+
+// VatNumberGT applies the GT predicate on the "vat_number" field.
+func VatNumberGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldVatNumber, v))
+}
+
+// VatNumberGTE applies the GTE predicate on the "vat_number" field.
+func VatNumberGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldVatNumber, v))
+}
+
+// VatNumberLT applies the LT predicate on the "vat_number" field.
+func VatNumberLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldVatNumber, v))
+}
+
+// VatNumberLTE applies the LTE predicate on the "vat_number" field.
+func VatNumberLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldVatNumber, v))
+}
+
+// VatNumberContains applies the Contains predicate on the "vat_number" field.
+func VatNumberContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldVatNumber, v))
+}
+
+// VatNumberHasPrefix applies the HasPrefix predicate on the "vat_number" field.
+func VatNumberHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldVatNumber, v))
+}
+
+// VatNumberHasSuffix applies the HasSuffix predicate on the "vat_number" field.
+func VatNumberHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldVatNumber, v))
+}
+
+// VatNumberEqualFold applies the EqualFold predicate on the "vat_number" field.
+func VatNumberEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldVatNumber, v))
+}
+
+// VatNumberContainsFold applies the ContainsFold predicate on the "vat_number" field.
+func VatNumberContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldVatNumber, v))
+}
+
 // MoneyCentsMigratedEQ applies the EQ predicate on the "money_cents_migrated" field.
 func MoneyCentsMigratedEQ(v bool) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldMoneyCentsMigrated, v))
@@ -763,6 +1173,286 @@ func MoneyCentsMigratedNEQ(v bool) predicate.Settings {
 	return predicate.Settings(sql.FieldNEQ(FieldMoneyCentsMigrated, v))
 }
 
+// LateFeeModeEQ applies the EQ predicate on the "late_fee_mode" field.
+func LateFeeModeEQ(v LateFeeMode) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeMode, v))
+}
+
+// LateFeeModeNEQ applies the NEQ predicate on the "late_fee_mode" field.
+func LateFeeModeNEQ(v LateFeeMode) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldLateFeeMode, v))
+}
+
+// LateFeeModeIn applies the In predicate on the "late_fee_mode" field.
+func LateFeeModeIn(vs ...LateFeeMode) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldLateFeeMode, vs...))
+}
+
+// LateFeeModeNotIn applies the NotIn predicate on the "late_fee_mode" field.
+func LateFeeModeNotIn(vs ...LateFeeMode) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldLateFeeMode, vs...))
+}
+
+// LateFeeFlatCentsEQ applies the EQ predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeFlatCentsNEQ applies the NEQ predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsNEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeFlatCentsIn applies the In predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldLateFeeFlatCents, vs...))
+}
+
+// LateFeeFlatCentsNotIn applies the NotIn predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsNotIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldLateFeeFlatCents, vs...))
+}
+
+// LateFeeFlatCentsGT applies the GT predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsGT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeFlatCentsGTE applies the GTE predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsGTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeFlatCentsLT applies the LT predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsLT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeFlatCentsLTE applies the LTE predicate on the "late_fee_flat_cents" field.
+func LateFeeFlatCentsLTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldLateFeeFlatCents, v))
+}
+
+// LateFeeDailyRatePctEQ applies the EQ predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctEQ(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeDailyRatePctNEQ applies the NEQ predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctNEQ(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeDailyRatePctIn applies the In predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctIn(vs ...float64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldLateFeeDailyRatePct, vs...))
+}
+
+// LateFeeDailyRatePctNotIn applies the NotIn predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctNotIn(vs ...float64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldLateFeeDailyRatePct, vs...))
+}
+
+// LateFeeDailyRatePctGT applies the GT predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctGT(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeDailyRatePctGTE applies the GTE predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctGTE(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeDailyRatePctLT applies the LT predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctLT(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeDailyRatePctLTE applies the LTE predicate on the "late_fee_daily_rate_pct" field.
+func LateFeeDailyRatePctLTE(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldLateFeeDailyRatePct, v))
+}
+
+// LateFeeGraceDaysEQ applies the EQ predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeGraceDaysNEQ applies the NEQ predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeGraceDaysIn applies the In predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldLateFeeGraceDays, vs...))
+}
+
+// LateFeeGraceDaysNotIn applies the NotIn predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldLateFeeGraceDays, vs...))
+}
+
+// LateFeeGraceDaysGT applies the GT predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeGraceDaysGTE applies the GTE predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeGraceDaysLT applies the LT predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeGraceDaysLTE applies the LTE predicate on the "late_fee_grace_days" field.
+func LateFeeGraceDaysLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldLateFeeGraceDays, v))
+}
+
+// LateFeeCapCentsEQ applies the EQ predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldLateFeeCapCents, v))
+}
+
+// LateFeeCapCentsNEQ applies the NEQ predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsNEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldLateFeeCapCents, v))
+}
+
+// LateFeeCapCentsIn applies the In predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldLateFeeCapCents, vs...))
+}
+
+// LateFeeCapCentsNotIn applies the NotIn predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsNotIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldLateFeeCapCents, vs...))
+}
+
+// LateFeeCapCentsGT applies the GT predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsGT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldLateFeeCapCents, v))
+}
+
+// LateFeeCapCentsGTE applies the GTE predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsGTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldLateFeeCapCents, v))
+}
+
+// LateFeeCapCentsLT applies the LT predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsLT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldLateFeeCapCents, v))
+}
+
+// LateFeeCapCentsLTE applies the LTE predicate on the "late_fee_cap_cents" field.
+func LateFeeCapCentsLTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldLateFeeCapCents, v))
+}
+
+// ExcusedAbsencePolicyEQ applies the EQ predicate on the "excused_absence_policy" field.
+func ExcusedAbsencePolicyEQ(v ExcusedAbsencePolicy) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldExcusedAbsencePolicy, v))
+}
+
+// ExcusedAbsencePolicyNEQ applies the NEQ predicate on the "excused_absence_policy" field.
+func ExcusedAbsencePolicyNEQ(v ExcusedAbsencePolicy) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldExcusedAbsencePolicy, v))
+}
+
+// ExcusedAbsencePolicyIn applies the In predicate on the "excused_absence_policy" field.
+func ExcusedAbsencePolicyIn(vs ...ExcusedAbsencePolicy) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldExcusedAbsencePolicy, vs...))
+}
+
+// ExcusedAbsencePolicyNotIn applies the NotIn predicate on the "excused_absence_policy" field.
+func ExcusedAbsencePolicyNotIn(vs ...ExcusedAbsencePolicy) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldExcusedAbsencePolicy, vs...))
+}
+
+// MakeupWindowWeeksEQ applies the EQ predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldMakeupWindowWeeks, v))
+}
+
+// MakeupWindowWeeksNEQ applies the NEQ predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldMakeupWindowWeeks, v))
+}
+
+// MakeupWindowWeeksIn applies the In predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldMakeupWindowWeeks, vs...))
+}
+
+// MakeupWindowWeeksNotIn applies the NotIn predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldMakeupWindowWeeks, vs...))
+}
+
+// MakeupWindowWeeksGT applies the GT predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldMakeupWindowWeeks, v))
+}
+
+// MakeupWindowWeeksGTE applies the GTE predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldMakeupWindowWeeks, v))
+}
+
+// MakeupWindowWeeksLT applies the LT predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldMakeupWindowWeeks, v))
+}
+
+// MakeupWindowWeeksLTE applies the LTE predicate on the "makeup_window_weeks" field.
+func MakeupWindowWeeksLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldMakeupWindowWeeks, v))
+}
+
+// WaitlistOfferDaysEQ applies the EQ predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldWaitlistOfferDays, v))
+}
+
+// WaitlistOfferDaysNEQ applies the NEQ predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldWaitlistOfferDays, v))
+}
+
+// WaitlistOfferDaysIn applies the In predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldWaitlistOfferDays, vs...))
+}
+
+// WaitlistOfferDaysNotIn applies the NotIn predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldWaitlistOfferDays, vs...))
+}
+
+// WaitlistOfferDaysGT applies the GT predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldWaitlistOfferDays, v))
+}
+
+// WaitlistOfferDaysGTE applies the GTE predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldWaitlistOfferDays, v))
+}
+
+// WaitlistOfferDaysLT applies the LT predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldWaitlistOfferDays, v))
+}
+
+// WaitlistOfferDaysLTE applies the LTE predicate on the "waitlist_offer_days" field.
+func WaitlistOfferDaysLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldWaitlistOfferDays, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Settings) predicate.Settings {
 	return predicate.Settings(sql.AndPredicates(predicates...))
@@ -165,6 +165,104 @@ func (_c *SettingsCreate) SetNillableInvoiceReplyTo(v *string) *SettingsCreate {
 	return _c
 }
 
+// SetBankBeneficiaryName sets the "bank_beneficiary_name" field.
+func (_c *SettingsCreate) SetBankBeneficiaryName(v string) *SettingsCreate {
+	_c.mutation.SetBankBeneficiaryName(v)
+	return _c
+}
+
+// SetNillableBankBeneficiaryName sets the "bank_beneficiary_name" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableBankBeneficiaryName(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetBankBeneficiaryName(*v)
+	}
+	return _c
+}
+
+// SetBankName sets the "bank_name" field.
+func (_c *SettingsCreate) SetBankName(v string) *SettingsCreate {
+	_c.mutation.SetBankName(v)
+	return _c
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableBankName(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetBankName(*v)
+	}
+	return _c
+}
+
+// SetBankBic sets the "bank_bic" field.
+func (_c *SettingsCreate) SetBankBic(v string) *SettingsCreate {
+	_c.mutation.SetBankBic(v)
+	return _c
+}
+
+// SetNillableBankBic sets the "bank_bic" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableBankBic(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetBankBic(*v)
+	}
+	return _c
+}
+
+// SetBankIban sets the "bank_iban" field.
+func (_c *SettingsCreate) SetBankIban(v string) *SettingsCreate {
+	_c.mutation.SetBankIban(v)
+	return _c
+}
+
+// SetNillableBankIban sets the "bank_iban" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableBankIban(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetBankIban(*v)
+	}
+	return _c
+}
+
+// SetInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field.
+func (_c *SettingsCreate) SetInvoicePaymentQrEnabled(v bool) *SettingsCreate {
+	_c.mutation.SetInvoicePaymentQrEnabled(v)
+	return _c
+}
+
+// SetNillableInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoicePaymentQrEnabled(v *bool) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoicePaymentQrEnabled(*v)
+	}
+	return _c
+}
+
+// SetVatEnabled sets the "vat_enabled" field.
+func (_c *SettingsCreate) SetVatEnabled(v bool) *SettingsCreate {
+	_c.mutation.SetVatEnabled(v)
+	return _c
+}
+
+// SetNillableVatEnabled sets the "vat_enabled" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableVatEnabled(v *bool) *SettingsCreate {
+	if v != nil {
+		_c.SetVatEnabled(*v)
+	}
+	return _c
+}
+
+// SetVatNumber sets the "vat_number" field.
+func (_c *SettingsCreate) SetVatNumber(v string) *SettingsCreate {
+	_c.mutation.SetVatNumber(v)
+	return _c
+}
+
+// SetNillableVatNumber sets the "vat_number" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableVatNumber(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetVatNumber(*v)
+	}
+	return _c
+}
+
 // SetMoneyCentsMigrated sets the "money_cents_migrated" field.
 func (_c *SettingsCreate) SetMoneyCentsMigrated(v bool) *SettingsCreate {
 	_c.mutation.SetMoneyCentsMigrated(v)
@@ -179,6 +277,118 @@ func (_c *SettingsCreate) SetNillableMoneyCentsMigrated(v *bool) *SettingsCreate
 	return _c
 }
 
+// SetLateFeeMode sets the "late_fee_mode" field.
+func (_c *SettingsCreate) SetLateFeeMode(v settings.LateFeeMode) *SettingsCreate {
+	_c.mutation.SetLateFeeMode(v)
+	return _c
+}
+
+// SetNillableLateFeeMode sets the "late_fee_mode" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableLateFeeMode(v *settings.LateFeeMode) *SettingsCreate {
+	if v != nil {
+		_c.SetLateFeeMode(*v)
+	}
+	return _c
+}
+
+// SetLateFeeFlatCents sets the "late_fee_flat_cents" field.
+func (_c *SettingsCreate) SetLateFeeFlatCents(v int64) *SettingsCreate {
+	_c.mutation.SetLateFeeFlatCents(v)
+	return _c
+}
+
+// SetNillableLateFeeFlatCents sets the "late_fee_flat_cents" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableLateFeeFlatCents(v *int64) *SettingsCreate {
+	if v != nil {
+		_c.SetLateFeeFlatCents(*v)
+	}
+	return _c
+}
+
+// SetLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field.
+func (_c *SettingsCreate) SetLateFeeDailyRatePct(v float64) *SettingsCreate {
+	_c.mutation.SetLateFeeDailyRatePct(v)
+	return _c
+}
+
+// SetNillableLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableLateFeeDailyRatePct(v *float64) *SettingsCreate {
+	if v != nil {
+		_c.SetLateFeeDailyRatePct(*v)
+	}
+	return _c
+}
+
+// SetLateFeeGraceDays sets the "late_fee_grace_days" field.
+func (_c *SettingsCreate) SetLateFeeGraceDays(v int) *SettingsCreate {
+	_c.mutation.SetLateFeeGraceDays(v)
+	return _c
+}
+
+// SetNillableLateFeeGraceDays sets the "late_fee_grace_days" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableLateFeeGraceDays(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetLateFeeGraceDays(*v)
+	}
+	return _c
+}
+
+// SetLateFeeCapCents sets the "late_fee_cap_cents" field.
+func (_c *SettingsCreate) SetLateFeeCapCents(v int64) *SettingsCreate {
+	_c.mutation.SetLateFeeCapCents(v)
+	return _c
+}
+
+// SetNillableLateFeeCapCents sets the "late_fee_cap_cents" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableLateFeeCapCents(v *int64) *SettingsCreate {
+	if v != nil {
+		_c.SetLateFeeCapCents(*v)
+	}
+	return _c
+}
+
+// SetExcusedAbsencePolicy sets the "excused_absence_policy" field.
+func (_c *SettingsCreate) SetExcusedAbsencePolicy(v settings.ExcusedAbsencePolicy) *SettingsCreate {
+	_c.mutation.SetExcusedAbsencePolicy(v)
+	return _c
+}
+
+// SetNillableExcusedAbsencePolicy sets the "excused_absence_policy" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableExcusedAbsencePolicy(v *settings.ExcusedAbsencePolicy) *SettingsCreate {
+	if v != nil {
+		_c.SetExcusedAbsencePolicy(*v)
+	}
+	return _c
+}
+
+// SetMakeupWindowWeeks sets the "makeup_window_weeks" field.
+func (_c *SettingsCreate) SetMakeupWindowWeeks(v int) *SettingsCreate {
+	_c.mutation.SetMakeupWindowWeeks(v)
+	return _c
+}
+
+// SetNillableMakeupWindowWeeks sets the "makeup_window_weeks" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableMakeupWindowWeeks(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetMakeupWindowWeeks(*v)
+	}
+	return _c
+}
+
+// SetWaitlistOfferDays sets the "waitlist_offer_days" field.
+func (_c *SettingsCreate) SetWaitlistOfferDays(v int) *SettingsCreate {
+	_c.mutation.SetWaitlistOfferDays(v)
+	return _c
+}
+
+// SetNillableWaitlistOfferDays sets the "waitlist_offer_days" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableWaitlistOfferDays(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetWaitlistOfferDays(*v)
+	}
+	return _c
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (_c *SettingsCreate) Mutation() *SettingsMutation {
 	return _c.mutation
@@ -254,10 +464,70 @@ func (_c *SettingsCreate) defaults() {
 		v := settings.DefaultInvoiceReplyTo
 		_c.mutation.SetInvoiceReplyTo(v)
 	}
+	if _, ok := _c.mutation.BankBeneficiaryName(); !ok {
+		v := settings.DefaultBankBeneficiaryName
+		_c.mutation.SetBankBeneficiaryName(v)
+	}
+	if _, ok := _c.mutation.BankName(); !ok {
+		v := settings.DefaultBankName
+		_c.mutation.SetBankName(v)
+	}
+	if _, ok := _c.mutation.BankBic(); !ok {
+		v := settings.DefaultBankBic
+		_c.mutation.SetBankBic(v)
+	}
+	if _, ok := _c.mutation.BankIban(); !ok {
+		v := settings.DefaultBankIban
+		_c.mutation.SetBankIban(v)
+	}
+	if _, ok := _c.mutation.InvoicePaymentQrEnabled(); !ok {
+		v := settings.DefaultInvoicePaymentQrEnabled
+		_c.mutation.SetInvoicePaymentQrEnabled(v)
+	}
+	if _, ok := _c.mutation.VatEnabled(); !ok {
+		v := settings.DefaultVatEnabled
+		_c.mutation.SetVatEnabled(v)
+	}
+	if _, ok := _c.mutation.VatNumber(); !ok {
+		v := settings.DefaultVatNumber
+		_c.mutation.SetVatNumber(v)
+	}
 	if _, ok := _c.mutation.MoneyCentsMigrated(); !ok {
 		v := settings.DefaultMoneyCentsMigrated
 		_c.mutation.SetMoneyCentsMigrated(v)
 	}
+	if _, ok := _c.mutation.LateFeeMode(); !ok {
+		v := settings.DefaultLateFeeMode
+		_c.mutation.SetLateFeeMode(v)
+	}
+	if _, ok := _c.mutation.LateFeeFlatCents(); !ok {
+		v := settings.DefaultLateFeeFlatCents
+		_c.mutation.SetLateFeeFlatCents(v)
+	}
+	if _, ok := _c.mutation.LateFeeDailyRatePct(); !ok {
+		v := settings.DefaultLateFeeDailyRatePct
+		_c.mutation.SetLateFeeDailyRatePct(v)
+	}
+	if _, ok := _c.mutation.LateFeeGraceDays(); !ok {
+		v := settings.DefaultLateFeeGraceDays
+		_c.mutation.SetLateFeeGraceDays(v)
+	}
+	if _, ok := _c.mutation.LateFeeCapCents(); !ok {
+		v := settings.DefaultLateFeeCapCents
+		_c.mutation.SetLateFeeCapCents(v)
+	}
+	if _, ok := _c.mutation.ExcusedAbsencePolicy(); !ok {
+		v := settings.DefaultExcusedAbsencePolicy
+		_c.mutation.SetExcusedAbsencePolicy(v)
+	}
+	if _, ok := _c.mutation.MakeupWindowWeeks(); !ok {
+		v := settings.DefaultMakeupWindowWeeks
+		_c.mutation.SetMakeupWindowWeeks(v)
+	}
+	if _, ok := _c.mutation.WaitlistOfferDays(); !ok {
+		v := settings.DefaultWaitlistOfferDays
+		_c.mutation.SetWaitlistOfferDays(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -295,9 +565,64 @@ func (_c *SettingsCreate) check() error {
 	if _, ok := _c.mutation.InvoiceReplyTo(); !ok {
 		return &ValidationError{Name: "invoice_reply_to", err: errors.New(`ent: missing required field "Settings.invoice_reply_to"`)}
 	}
+	if _, ok := _c.mutation.BankBeneficiaryName(); !ok {
+		return &ValidationError{Name: "bank_beneficiary_name", err: errors.New(`ent: missing required field "Settings.bank_beneficiary_name"`)}
+	}
+	if _, ok := _c.mutation.BankName(); !ok {
+		return &ValidationError{Name: "bank_name", err: errors.New(`ent: missing required field "Settings.bank_name"`)}
+	}
+	if _, ok := _c.mutation.BankBic(); !ok {
+		return &ValidationError{Name: "bank_bic", err: errors.New(`ent: missing required field "Settings.bank_bic"`)}
+	}
+	if _, ok := _c.mutation.BankIban(); !ok {
+		return &ValidationError{Name: "bank_iban", err: errors.New(`ent: missing required field "Settings.bank_iban"`)}
+	}
+	if _, ok := _c.mutation.InvoicePaymentQrEnabled(); !ok {
+		return &ValidationError{Name: "invoice_payment_qr_enabled", err: errors.New(`ent: missing required field "Settings.invoice_payment_qr_enabled"`)}
+	}
+	if _, ok := _c.mutation.VatEnabled(); !ok {
+		return &ValidationError{Name: "vat_enabled", err: errors.New(`ent: missing required field "Settings.vat_enabled"`)}
+	}
+	if _, ok := _c.mutation.VatNumber(); !ok {
+		return &ValidationError{Name: "vat_number", err: errors.New(`ent: missing required field "Settings.vat_number"`)}
+	}
 	if _, ok := _c.mutation.MoneyCentsMigrated(); !ok {
 		return &ValidationError{Name: "money_cents_migrated", err: errors.New(`ent: missing required field "Settings.money_cents_migrated"`)}
 	}
+	if _, ok := _c.mutation.LateFeeMode(); !ok {
+		return &ValidationError{Name: "late_fee_mode", err: errors.New(`ent: missing required field "Settings.late_fee_mode"`)}
+	}
+	if v, ok := _c.mutation.LateFeeMode(); ok {
+		if err := settings.LateFeeModeValidator(v); err != nil {
+			return &ValidationError{Name: "late_fee_mode", err: fmt.Errorf(`ent: validator failed for field "Settings.late_fee_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LateFeeFlatCents(); !ok {
+		return &ValidationError{Name: "late_fee_flat_cents", err: errors.New(`ent: missing required field "Settings.late_fee_flat_cents"`)}
+	}
+	if _, ok := _c.mutation.LateFeeDailyRatePct(); !ok {
+		return &ValidationError{Name: "late_fee_daily_rate_pct", err: errors.New(`ent: missing required field "Settings.late_fee_daily_rate_pct"`)}
+	}
+	if _, ok := _c.mutation.LateFeeGraceDays(); !ok {
+		return &ValidationError{Name: "late_fee_grace_days", err: errors.New(`ent: missing required field "Settings.late_fee_grace_days"`)}
+	}
+	if _, ok := _c.mutation.LateFeeCapCents(); !ok {
+		return &ValidationError{Name: "late_fee_cap_cents", err: errors.New(`ent: missing required field "Settings.late_fee_cap_cents"`)}
+	}
+	if _, ok := _c.mutation.ExcusedAbsencePolicy(); !ok {
+		return &ValidationError{Name: "excused_absence_policy", err: errors.New(`ent: missing required field "Settings.excused_absence_policy"`)}
+	}
+	if v, ok := _c.mutation.ExcusedAbsencePolicy(); ok {
+		if err := settings.ExcusedAbsencePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "excused_absence_policy", err: fmt.Errorf(`ent: validator failed for field "Settings.excused_absence_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MakeupWindowWeeks(); !ok {
+		return &ValidationError{Name: "makeup_window_weeks", err: errors.New(`ent: missing required field "Settings.makeup_window_weeks"`)}
+	}
+	if _, ok := _c.mutation.WaitlistOfferDays(); !ok {
+		return &ValidationError{Name: "waitlist_offer_days", err: errors.New(`ent: missing required field "Settings.waitlist_offer_days"`)}
+	}
 	return nil
 }
 
@@ -368,10 +693,70 @@ func (_c *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
 		_spec.SetField(settings.FieldInvoiceReplyTo, field.TypeString, value)
 		_node.InvoiceReplyTo = value
 	}
+	if value, ok := _c.mutation.BankBeneficiaryName(); ok {
+		_spec.SetField(settings.FieldBankBeneficiaryName, field.TypeString, value)
+		_node.BankBeneficiaryName = value
+	}
+	if value, ok := _c.mutation.BankName(); ok {
+		_spec.SetField(settings.FieldBankName, field.TypeString, value)
+		_node.BankName = value
+	}
+	if value, ok := _c.mutation.BankBic(); ok {
+		_spec.SetField(settings.FieldBankBic, field.TypeString, value)
+		_node.BankBic = value
+	}
+	if value, ok := _c.mutation.BankIban(); ok {
+		_spec.SetField(settings.FieldBankIban, field.TypeString, value)
+		_node.BankIban = value
+	}
+	if value, ok := _c.mutation.InvoicePaymentQrEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicePaymentQrEnabled, field.TypeBool, value)
+		_node.InvoicePaymentQrEnabled = value
+	}
+	if value, ok := _c.mutation.VatEnabled(); ok {
+		_spec.SetField(settings.FieldVatEnabled, field.TypeBool, value)
+		_node.VatEnabled = value
+	}
+	if value, ok := _c.mutation.VatNumber(); ok {
+		_spec.SetField(settings.FieldVatNumber, field.TypeString, value)
+		_node.VatNumber = value
+	}
 	if value, ok := _c.mutation.MoneyCentsMigrated(); ok {
 		_spec.SetField(settings.FieldMoneyCentsMigrated, field.TypeBool, value)
 		_node.MoneyCentsMigrated = value
 	}
+	if value, ok := _c.mutation.LateFeeMode(); ok {
+		_spec.SetField(settings.FieldLateFeeMode, field.TypeEnum, value)
+		_node.LateFeeMode = value
+	}
+	if value, ok := _c.mutation.LateFeeFlatCents(); ok {
+		_spec.SetField(settings.FieldLateFeeFlatCents, field.TypeInt64, value)
+		_node.LateFeeFlatCents = value
+	}
+	if value, ok := _c.mutation.LateFeeDailyRatePct(); ok {
+		_spec.SetField(settings.FieldLateFeeDailyRatePct, field.TypeFloat64, value)
+		_node.LateFeeDailyRatePct = value
+	}
+	if value, ok := _c.mutation.LateFeeGraceDays(); ok {
+		_spec.SetField(settings.FieldLateFeeGraceDays, field.TypeInt, value)
+		_node.LateFeeGraceDays = value
+	}
+	if value, ok := _c.mutation.LateFeeCapCents(); ok {
+		_spec.SetField(settings.FieldLateFeeCapCents, field.TypeInt64, value)
+		_node.LateFeeCapCents = value
+	}
+	if value, ok := _c.mutation.ExcusedAbsencePolicy(); ok {
+		_spec.SetField(settings.FieldExcusedAbsencePolicy, field.TypeEnum, value)
+		_node.ExcusedAbsencePolicy = value
+	}
+	if value, ok := _c.mutation.MakeupWindowWeeks(); ok {
+		_spec.SetField(settings.FieldMakeupWindowWeeks, field.TypeInt, value)
+		_node.MakeupWindowWeeks = value
+	}
+	if value, ok := _c.mutation.WaitlistOfferDays(); ok {
+		_spec.SetField(settings.FieldWaitlistOfferDays, field.TypeInt, value)
+		_node.WaitlistOfferDays = value
+	}
 	return _node, _spec
 }
 
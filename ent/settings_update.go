@@ -202,6 +202,104 @@ func (_u *SettingsUpdate) SetNillableInvoiceReplyTo(v *string) *SettingsUpdate {
 	return _u
 }
 
+// SetBankBeneficiaryName sets the "bank_beneficiary_name" field.
+func (_u *SettingsUpdate) SetBankBeneficiaryName(v string) *SettingsUpdate {
+	_u.mutation.SetBankBeneficiaryName(v)
+	return _u
+}
+
+// SetNillableBankBeneficiaryName sets the "bank_beneficiary_name" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableBankBeneficiaryName(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetBankBeneficiaryName(*v)
+	}
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *SettingsUpdate) SetBankName(v string) *SettingsUpdate {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableBankName(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetBankBic sets the "bank_bic" field.
+func (_u *SettingsUpdate) SetBankBic(v string) *SettingsUpdate {
+	_u.mutation.SetBankBic(v)
+	return _u
+}
+
+// SetNillableBankBic sets the "bank_bic" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableBankBic(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetBankBic(*v)
+	}
+	return _u
+}
+
+// SetBankIban sets the "bank_iban" field.
+func (_u *SettingsUpdate) SetBankIban(v string) *SettingsUpdate {
+	_u.mutation.SetBankIban(v)
+	return _u
+}
+
+// SetNillableBankIban sets the "bank_iban" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableBankIban(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetBankIban(*v)
+	}
+	return _u
+}
+
+// SetInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field.
+func (_u *SettingsUpdate) SetInvoicePaymentQrEnabled(v bool) *SettingsUpdate {
+	_u.mutation.SetInvoicePaymentQrEnabled(v)
+	return _u
+}
+
+// SetNillableInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoicePaymentQrEnabled(v *bool) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoicePaymentQrEnabled(*v)
+	}
+	return _u
+}
+
+// SetVatEnabled sets the "vat_enabled" field.
+func (_u *SettingsUpdate) SetVatEnabled(v bool) *SettingsUpdate {
+	_u.mutation.SetVatEnabled(v)
+	return _u
+}
+
+// SetNillableVatEnabled sets the "vat_enabled" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableVatEnabled(v *bool) *SettingsUpdate {
+	if v != nil {
+		_u.SetVatEnabled(*v)
+	}
+	return _u
+}
+
+// SetVatNumber sets the "vat_number" field.
+func (_u *SettingsUpdate) SetVatNumber(v string) *SettingsUpdate {
+	_u.mutation.SetVatNumber(v)
+	return _u
+}
+
+// SetNillableVatNumber sets the "vat_number" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableVatNumber(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetVatNumber(*v)
+	}
+	return _u
+}
+
 // SetMoneyCentsMigrated sets the "money_cents_migrated" field.
 func (_u *SettingsUpdate) SetMoneyCentsMigrated(v bool) *SettingsUpdate {
 	_u.mutation.SetMoneyCentsMigrated(v)
@@ -216,6 +314,160 @@ func (_u *SettingsUpdate) SetNillableMoneyCentsMigrated(v *bool) *SettingsUpdate
 	return _u
 }
 
+// SetLateFeeMode sets the "late_fee_mode" field.
+func (_u *SettingsUpdate) SetLateFeeMode(v settings.LateFeeMode) *SettingsUpdate {
+	_u.mutation.SetLateFeeMode(v)
+	return _u
+}
+
+// SetNillableLateFeeMode sets the "late_fee_mode" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableLateFeeMode(v *settings.LateFeeMode) *SettingsUpdate {
+	if v != nil {
+		_u.SetLateFeeMode(*v)
+	}
+	return _u
+}
+
+// SetLateFeeFlatCents sets the "late_fee_flat_cents" field.
+func (_u *SettingsUpdate) SetLateFeeFlatCents(v int64) *SettingsUpdate {
+	_u.mutation.ResetLateFeeFlatCents()
+	_u.mutation.SetLateFeeFlatCents(v)
+	return _u
+}
+
+// SetNillableLateFeeFlatCents sets the "late_fee_flat_cents" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableLateFeeFlatCents(v *int64) *SettingsUpdate {
+	if v != nil {
+		_u.SetLateFeeFlatCents(*v)
+	}
+	return _u
+}
+
+// AddLateFeeFlatCents adds value to the "late_fee_flat_cents" field.
+func (_u *SettingsUpdate) AddLateFeeFlatCents(v int64) *SettingsUpdate {
+	_u.mutation.AddLateFeeFlatCents(v)
+	return _u
+}
+
+// SetLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field.
+func (_u *SettingsUpdate) SetLateFeeDailyRatePct(v float64) *SettingsUpdate {
+	_u.mutation.ResetLateFeeDailyRatePct()
+	_u.mutation.SetLateFeeDailyRatePct(v)
+	return _u
+}
+
+// SetNillableLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableLateFeeDailyRatePct(v *float64) *SettingsUpdate {
+	if v != nil {
+		_u.SetLateFeeDailyRatePct(*v)
+	}
+	return _u
+}
+
+// AddLateFeeDailyRatePct adds value to the "late_fee_daily_rate_pct" field.
+func (_u *SettingsUpdate) AddLateFeeDailyRatePct(v float64) *SettingsUpdate {
+	_u.mutation.AddLateFeeDailyRatePct(v)
+	return _u
+}
+
+// SetLateFeeGraceDays sets the "late_fee_grace_days" field.
+func (_u *SettingsUpdate) SetLateFeeGraceDays(v int) *SettingsUpdate {
+	_u.mutation.ResetLateFeeGraceDays()
+	_u.mutation.SetLateFeeGraceDays(v)
+	return _u
+}
+
+// SetNillableLateFeeGraceDays sets the "late_fee_grace_days" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableLateFeeGraceDays(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetLateFeeGraceDays(*v)
+	}
+	return _u
+}
+
+// AddLateFeeGraceDays adds value to the "late_fee_grace_days" field.
+func (_u *SettingsUpdate) AddLateFeeGraceDays(v int) *SettingsUpdate {
+	_u.mutation.AddLateFeeGraceDays(v)
+	return _u
+}
+
+// SetLateFeeCapCents sets the "late_fee_cap_cents" field.
+func (_u *SettingsUpdate) SetLateFeeCapCents(v int64) *SettingsUpdate {
+	_u.mutation.ResetLateFeeCapCents()
+	_u.mutation.SetLateFeeCapCents(v)
+	return _u
+}
+
+// SetNillableLateFeeCapCents sets the "late_fee_cap_cents" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableLateFeeCapCents(v *int64) *SettingsUpdate {
+	if v != nil {
+		_u.SetLateFeeCapCents(*v)
+	}
+	return _u
+}
+
+// AddLateFeeCapCents adds value to the "late_fee_cap_cents" field.
+func (_u *SettingsUpdate) AddLateFeeCapCents(v int64) *SettingsUpdate {
+	_u.mutation.AddLateFeeCapCents(v)
+	return _u
+}
+
+// SetExcusedAbsencePolicy sets the "excused_absence_policy" field.
+func (_u *SettingsUpdate) SetExcusedAbsencePolicy(v settings.ExcusedAbsencePolicy) *SettingsUpdate {
+	_u.mutation.SetExcusedAbsencePolicy(v)
+	return _u
+}
+
+// SetNillableExcusedAbsencePolicy sets the "excused_absence_policy" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableExcusedAbsencePolicy(v *settings.ExcusedAbsencePolicy) *SettingsUpdate {
+	if v != nil {
+		_u.SetExcusedAbsencePolicy(*v)
+	}
+	return _u
+}
+
+// SetMakeupWindowWeeks sets the "makeup_window_weeks" field.
+func (_u *SettingsUpdate) SetMakeupWindowWeeks(v int) *SettingsUpdate {
+	_u.mutation.ResetMakeupWindowWeeks()
+	_u.mutation.SetMakeupWindowWeeks(v)
+	return _u
+}
+
+// SetNillableMakeupWindowWeeks sets the "makeup_window_weeks" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableMakeupWindowWeeks(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetMakeupWindowWeeks(*v)
+	}
+	return _u
+}
+
+// AddMakeupWindowWeeks adds value to the "makeup_window_weeks" field.
+func (_u *SettingsUpdate) AddMakeupWindowWeeks(v int) *SettingsUpdate {
+	_u.mutation.AddMakeupWindowWeeks(v)
+	return _u
+}
+
+// SetWaitlistOfferDays sets the "waitlist_offer_days" field.
+func (_u *SettingsUpdate) SetWaitlistOfferDays(v int) *SettingsUpdate {
+	_u.mutation.ResetWaitlistOfferDays()
+	_u.mutation.SetWaitlistOfferDays(v)
+	return _u
+}
+
+// SetNillableWaitlistOfferDays sets the "waitlist_offer_days" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableWaitlistOfferDays(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetWaitlistOfferDays(*v)
+	}
+	return _u
+}
+
+// AddWaitlistOfferDays adds value to the "waitlist_offer_days" field.
+func (_u *SettingsUpdate) AddWaitlistOfferDays(v int) *SettingsUpdate {
+	_u.mutation.AddWaitlistOfferDays(v)
+	return _u
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (_u *SettingsUpdate) Mutation() *SettingsMutation {
 	return _u.mutation
@@ -248,7 +500,25 @@ func (_u *SettingsUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettingsUpdate) check() error {
+	if v, ok := _u.mutation.LateFeeMode(); ok {
+		if err := settings.LateFeeModeValidator(v); err != nil {
+			return &ValidationError{Name: "late_fee_mode", err: fmt.Errorf(`ent: validator failed for field "Settings.late_fee_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExcusedAbsencePolicy(); ok {
+		if err := settings.ExcusedAbsencePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "excused_absence_policy", err: fmt.Errorf(`ent: validator failed for field "Settings.excused_absence_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *SettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(settings.Table, settings.Columns, sqlgraph.NewFieldSpec(settings.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -299,9 +569,72 @@ func (_u *SettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.InvoiceReplyTo(); ok {
 		_spec.SetField(settings.FieldInvoiceReplyTo, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BankBeneficiaryName(); ok {
+		_spec.SetField(settings.FieldBankBeneficiaryName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(settings.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankBic(); ok {
+		_spec.SetField(settings.FieldBankBic, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankIban(); ok {
+		_spec.SetField(settings.FieldBankIban, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoicePaymentQrEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicePaymentQrEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VatEnabled(); ok {
+		_spec.SetField(settings.FieldVatEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VatNumber(); ok {
+		_spec.SetField(settings.FieldVatNumber, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.MoneyCentsMigrated(); ok {
 		_spec.SetField(settings.FieldMoneyCentsMigrated, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LateFeeMode(); ok {
+		_spec.SetField(settings.FieldLateFeeMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LateFeeFlatCents(); ok {
+		_spec.SetField(settings.FieldLateFeeFlatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeFlatCents(); ok {
+		_spec.AddField(settings.FieldLateFeeFlatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LateFeeDailyRatePct(); ok {
+		_spec.SetField(settings.FieldLateFeeDailyRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeDailyRatePct(); ok {
+		_spec.AddField(settings.FieldLateFeeDailyRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LateFeeGraceDays(); ok {
+		_spec.SetField(settings.FieldLateFeeGraceDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeGraceDays(); ok {
+		_spec.AddField(settings.FieldLateFeeGraceDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LateFeeCapCents(); ok {
+		_spec.SetField(settings.FieldLateFeeCapCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeCapCents(); ok {
+		_spec.AddField(settings.FieldLateFeeCapCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExcusedAbsencePolicy(); ok {
+		_spec.SetField(settings.FieldExcusedAbsencePolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MakeupWindowWeeks(); ok {
+		_spec.SetField(settings.FieldMakeupWindowWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMakeupWindowWeeks(); ok {
+		_spec.AddField(settings.FieldMakeupWindowWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WaitlistOfferDays(); ok {
+		_spec.SetField(settings.FieldWaitlistOfferDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaitlistOfferDays(); ok {
+		_spec.AddField(settings.FieldWaitlistOfferDays, field.TypeInt, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{settings.Label}
@@ -497,6 +830,104 @@ func (_u *SettingsUpdateOne) SetNillableInvoiceReplyTo(v *string) *SettingsUpdat
 	return _u
 }
 
+// SetBankBeneficiaryName sets the "bank_beneficiary_name" field.
+func (_u *SettingsUpdateOne) SetBankBeneficiaryName(v string) *SettingsUpdateOne {
+	_u.mutation.SetBankBeneficiaryName(v)
+	return _u
+}
+
+// SetNillableBankBeneficiaryName sets the "bank_beneficiary_name" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableBankBeneficiaryName(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetBankBeneficiaryName(*v)
+	}
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *SettingsUpdateOne) SetBankName(v string) *SettingsUpdateOne {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableBankName(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetBankBic sets the "bank_bic" field.
+func (_u *SettingsUpdateOne) SetBankBic(v string) *SettingsUpdateOne {
+	_u.mutation.SetBankBic(v)
+	return _u
+}
+
+// SetNillableBankBic sets the "bank_bic" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableBankBic(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetBankBic(*v)
+	}
+	return _u
+}
+
+// SetBankIban sets the "bank_iban" field.
+func (_u *SettingsUpdateOne) SetBankIban(v string) *SettingsUpdateOne {
+	_u.mutation.SetBankIban(v)
+	return _u
+}
+
+// SetNillableBankIban sets the "bank_iban" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableBankIban(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetBankIban(*v)
+	}
+	return _u
+}
+
+// SetInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field.
+func (_u *SettingsUpdateOne) SetInvoicePaymentQrEnabled(v bool) *SettingsUpdateOne {
+	_u.mutation.SetInvoicePaymentQrEnabled(v)
+	return _u
+}
+
+// SetNillableInvoicePaymentQrEnabled sets the "invoice_payment_qr_enabled" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoicePaymentQrEnabled(v *bool) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoicePaymentQrEnabled(*v)
+	}
+	return _u
+}
+
+// SetVatEnabled sets the "vat_enabled" field.
+func (_u *SettingsUpdateOne) SetVatEnabled(v bool) *SettingsUpdateOne {
+	_u.mutation.SetVatEnabled(v)
+	return _u
+}
+
+// SetNillableVatEnabled sets the "vat_enabled" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableVatEnabled(v *bool) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetVatEnabled(*v)
+	}
+	return _u
+}
+
+// SetVatNumber sets the "vat_number" field.
+func (_u *SettingsUpdateOne) SetVatNumber(v string) *SettingsUpdateOne {
+	_u.mutation.SetVatNumber(v)
+	return _u
+}
+
+// SetNillableVatNumber sets the "vat_number" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableVatNumber(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetVatNumber(*v)
+	}
+	return _u
+}
+
 // SetMoneyCentsMigrated sets the "money_cents_migrated" field.
 func (_u *SettingsUpdateOne) SetMoneyCentsMigrated(v bool) *SettingsUpdateOne {
 	_u.mutation.SetMoneyCentsMigrated(v)
@@ -511,6 +942,160 @@ func (_u *SettingsUpdateOne) SetNillableMoneyCentsMigrated(v *bool) *SettingsUpd
 	return _u
 }
 
+// SetLateFeeMode sets the "late_fee_mode" field.
+func (_u *SettingsUpdateOne) SetLateFeeMode(v settings.LateFeeMode) *SettingsUpdateOne {
+	_u.mutation.SetLateFeeMode(v)
+	return _u
+}
+
+// SetNillableLateFeeMode sets the "late_fee_mode" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableLateFeeMode(v *settings.LateFeeMode) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetLateFeeMode(*v)
+	}
+	return _u
+}
+
+// SetLateFeeFlatCents sets the "late_fee_flat_cents" field.
+func (_u *SettingsUpdateOne) SetLateFeeFlatCents(v int64) *SettingsUpdateOne {
+	_u.mutation.ResetLateFeeFlatCents()
+	_u.mutation.SetLateFeeFlatCents(v)
+	return _u
+}
+
+// SetNillableLateFeeFlatCents sets the "late_fee_flat_cents" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableLateFeeFlatCents(v *int64) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetLateFeeFlatCents(*v)
+	}
+	return _u
+}
+
+// AddLateFeeFlatCents adds value to the "late_fee_flat_cents" field.
+func (_u *SettingsUpdateOne) AddLateFeeFlatCents(v int64) *SettingsUpdateOne {
+	_u.mutation.AddLateFeeFlatCents(v)
+	return _u
+}
+
+// SetLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field.
+func (_u *SettingsUpdateOne) SetLateFeeDailyRatePct(v float64) *SettingsUpdateOne {
+	_u.mutation.ResetLateFeeDailyRatePct()
+	_u.mutation.SetLateFeeDailyRatePct(v)
+	return _u
+}
+
+// SetNillableLateFeeDailyRatePct sets the "late_fee_daily_rate_pct" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableLateFeeDailyRatePct(v *float64) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetLateFeeDailyRatePct(*v)
+	}
+	return _u
+}
+
+// AddLateFeeDailyRatePct adds value to the "late_fee_daily_rate_pct" field.
+func (_u *SettingsUpdateOne) AddLateFeeDailyRatePct(v float64) *SettingsUpdateOne {
+	_u.mutation.AddLateFeeDailyRatePct(v)
+	return _u
+}
+
+// SetLateFeeGraceDays sets the "late_fee_grace_days" field.
+func (_u *SettingsUpdateOne) SetLateFeeGraceDays(v int) *SettingsUpdateOne {
+	_u.mutation.ResetLateFeeGraceDays()
+	_u.mutation.SetLateFeeGraceDays(v)
+	return _u
+}
+
+// SetNillableLateFeeGraceDays sets the "late_fee_grace_days" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableLateFeeGraceDays(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetLateFeeGraceDays(*v)
+	}
+	return _u
+}
+
+// AddLateFeeGraceDays adds value to the "late_fee_grace_days" field.
+func (_u *SettingsUpdateOne) AddLateFeeGraceDays(v int) *SettingsUpdateOne {
+	_u.mutation.AddLateFeeGraceDays(v)
+	return _u
+}
+
+// SetLateFeeCapCents sets the "late_fee_cap_cents" field.
+func (_u *SettingsUpdateOne) SetLateFeeCapCents(v int64) *SettingsUpdateOne {
+	_u.mutation.ResetLateFeeCapCents()
+	_u.mutation.SetLateFeeCapCents(v)
+	return _u
+}
+
+// SetNillableLateFeeCapCents sets the "late_fee_cap_cents" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableLateFeeCapCents(v *int64) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetLateFeeCapCents(*v)
+	}
+	return _u
+}
+
+// AddLateFeeCapCents adds value to the "late_fee_cap_cents" field.
+func (_u *SettingsUpdateOne) AddLateFeeCapCents(v int64) *SettingsUpdateOne {
+	_u.mutation.AddLateFeeCapCents(v)
+	return _u
+}
+
+// SetExcusedAbsencePolicy sets the "excused_absence_policy" field.
+func (_u *SettingsUpdateOne) SetExcusedAbsencePolicy(v settings.ExcusedAbsencePolicy) *SettingsUpdateOne {
+	_u.mutation.SetExcusedAbsencePolicy(v)
+	return _u
+}
+
+// SetNillableExcusedAbsencePolicy sets the "excused_absence_policy" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableExcusedAbsencePolicy(v *settings.ExcusedAbsencePolicy) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetExcusedAbsencePolicy(*v)
+	}
+	return _u
+}
+
+// SetMakeupWindowWeeks sets the "makeup_window_weeks" field.
+func (_u *SettingsUpdateOne) SetMakeupWindowWeeks(v int) *SettingsUpdateOne {
+	_u.mutation.ResetMakeupWindowWeeks()
+	_u.mutation.SetMakeupWindowWeeks(v)
+	return _u
+}
+
+// SetNillableMakeupWindowWeeks sets the "makeup_window_weeks" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableMakeupWindowWeeks(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetMakeupWindowWeeks(*v)
+	}
+	return _u
+}
+
+// AddMakeupWindowWeeks adds value to the "makeup_window_weeks" field.
+func (_u *SettingsUpdateOne) AddMakeupWindowWeeks(v int) *SettingsUpdateOne {
+	_u.mutation.AddMakeupWindowWeeks(v)
+	return _u
+}
+
+// SetWaitlistOfferDays sets the "waitlist_offer_days" field.
+func (_u *SettingsUpdateOne) SetWaitlistOfferDays(v int) *SettingsUpdateOne {
+	_u.mutation.ResetWaitlistOfferDays()
+	_u.mutation.SetWaitlistOfferDays(v)
+	return _u
+}
+
+// SetNillableWaitlistOfferDays sets the "waitlist_offer_days" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableWaitlistOfferDays(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetWaitlistOfferDays(*v)
+	}
+	return _u
+}
+
+// AddWaitlistOfferDays adds value to the "waitlist_offer_days" field.
+func (_u *SettingsUpdateOne) AddWaitlistOfferDays(v int) *SettingsUpdateOne {
+	_u.mutation.AddWaitlistOfferDays(v)
+	return _u
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (_u *SettingsUpdateOne) Mutation() *SettingsMutation {
 	return _u.mutation
@@ -556,7 +1141,25 @@ func (_u *SettingsUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettingsUpdateOne) check() error {
+	if v, ok := _u.mutation.LateFeeMode(); ok {
+		if err := settings.LateFeeModeValidator(v); err != nil {
+			return &ValidationError{Name: "late_fee_mode", err: fmt.Errorf(`ent: validator failed for field "Settings.late_fee_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExcusedAbsencePolicy(); ok {
+		if err := settings.ExcusedAbsencePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "excused_absence_policy", err: fmt.Errorf(`ent: validator failed for field "Settings.excused_absence_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(settings.Table, settings.Columns, sqlgraph.NewFieldSpec(settings.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -624,9 +1227,72 @@ func (_u *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err
 	if value, ok := _u.mutation.InvoiceReplyTo(); ok {
 		_spec.SetField(settings.FieldInvoiceReplyTo, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BankBeneficiaryName(); ok {
+		_spec.SetField(settings.FieldBankBeneficiaryName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(settings.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankBic(); ok {
+		_spec.SetField(settings.FieldBankBic, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankIban(); ok {
+		_spec.SetField(settings.FieldBankIban, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoicePaymentQrEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicePaymentQrEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VatEnabled(); ok {
+		_spec.SetField(settings.FieldVatEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VatNumber(); ok {
+		_spec.SetField(settings.FieldVatNumber, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.MoneyCentsMigrated(); ok {
 		_spec.SetField(settings.FieldMoneyCentsMigrated, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LateFeeMode(); ok {
+		_spec.SetField(settings.FieldLateFeeMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LateFeeFlatCents(); ok {
+		_spec.SetField(settings.FieldLateFeeFlatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeFlatCents(); ok {
+		_spec.AddField(settings.FieldLateFeeFlatCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LateFeeDailyRatePct(); ok {
+		_spec.SetField(settings.FieldLateFeeDailyRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeDailyRatePct(); ok {
+		_spec.AddField(settings.FieldLateFeeDailyRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LateFeeGraceDays(); ok {
+		_spec.SetField(settings.FieldLateFeeGraceDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeGraceDays(); ok {
+		_spec.AddField(settings.FieldLateFeeGraceDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LateFeeCapCents(); ok {
+		_spec.SetField(settings.FieldLateFeeCapCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeCapCents(); ok {
+		_spec.AddField(settings.FieldLateFeeCapCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExcusedAbsencePolicy(); ok {
+		_spec.SetField(settings.FieldExcusedAbsencePolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MakeupWindowWeeks(); ok {
+		_spec.SetField(settings.FieldMakeupWindowWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMakeupWindowWeeks(); ok {
+		_spec.AddField(settings.FieldMakeupWindowWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WaitlistOfferDays(); ok {
+		_spec.SetField(settings.FieldWaitlistOfferDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaitlistOfferDays(); ok {
+		_spec.AddField(settings.FieldWaitlistOfferDays, field.TypeInt, value)
+	}
 	_node = &Settings{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -37,6 +37,20 @@ type Student struct {
 	PayerName string `json:"payer_name,omitempty"`
 	// PayerRole holds the value of the "payer_role" field.
 	PayerRole string `json:"payer_role,omitempty"`
+	// PayerPersonalCode holds the value of the "payer_personal_code" field.
+	PayerPersonalCode string `json:"payer_personal_code,omitempty"`
+	// PayerType holds the value of the "payer_type" field.
+	PayerType student.PayerType `json:"payer_type,omitempty"`
+	// PayerCompanyName holds the value of the "payer_company_name" field.
+	PayerCompanyName string `json:"payer_company_name,omitempty"`
+	// PayerRegNo holds the value of the "payer_reg_no" field.
+	PayerRegNo string `json:"payer_reg_no,omitempty"`
+	// PayerVatNumber holds the value of the "payer_vat_number" field.
+	PayerVatNumber string `json:"payer_vat_number,omitempty"`
+	// PayerLegalAddress holds the value of the "payer_legal_address" field.
+	PayerLegalAddress string `json:"payer_legal_address,omitempty"`
+	// PayerBillingEmail holds the value of the "payer_billing_email" field.
+	PayerBillingEmail string `json:"payer_billing_email,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -53,9 +67,25 @@ type StudentEdges struct {
 	Invoices []*Invoice `json:"invoices,omitempty"`
 	// Payments holds the value of the payments edge.
 	Payments []*Payment `json:"payments,omitempty"`
+	// CashReceipts holds the value of the cash_receipts edge.
+	CashReceipts []*CashReceipt `json:"cash_receipts,omitempty"`
+	// PaymentPlans holds the value of the payment_plans edge.
+	PaymentPlans []*PaymentPlan `json:"payment_plans,omitempty"`
+	// LateFees holds the value of the late_fees edge.
+	LateFees []*LateFee `json:"late_fees,omitempty"`
+	// Charges holds the value of the charges edge.
+	Charges []*StudentCharge `json:"charges,omitempty"`
+	// LessonPackages holds the value of the lesson_packages edge.
+	LessonPackages []*LessonPackage `json:"lesson_packages,omitempty"`
+	// ExcusedAbsences holds the value of the excused_absences edge.
+	ExcusedAbsences []*ExcusedAbsence `json:"excused_absences,omitempty"`
+	// WaitlistEntries holds the value of the waitlist_entries edge.
+	WaitlistEntries []*WaitlistEntry `json:"waitlist_entries,omitempty"`
+	// Leads holds the value of the leads edge.
+	Leads []*Lead `json:"leads,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [11]bool
 }
 
 // EnrollmentsOrErr returns the Enrollments value or an error if the edge
@@ -85,6 +115,78 @@ func (e StudentEdges) PaymentsOrErr() ([]*Payment, error) {
 	return nil, &NotLoadedError{edge: "payments"}
 }
 
+// CashReceiptsOrErr returns the CashReceipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) CashReceiptsOrErr() ([]*CashReceipt, error) {
+	if e.loadedTypes[3] {
+		return e.CashReceipts, nil
+	}
+	return nil, &NotLoadedError{edge: "cash_receipts"}
+}
+
+// PaymentPlansOrErr returns the PaymentPlans value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) PaymentPlansOrErr() ([]*PaymentPlan, error) {
+	if e.loadedTypes[4] {
+		return e.PaymentPlans, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_plans"}
+}
+
+// LateFeesOrErr returns the LateFees value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) LateFeesOrErr() ([]*LateFee, error) {
+	if e.loadedTypes[5] {
+		return e.LateFees, nil
+	}
+	return nil, &NotLoadedError{edge: "late_fees"}
+}
+
+// ChargesOrErr returns the Charges value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) ChargesOrErr() ([]*StudentCharge, error) {
+	if e.loadedTypes[6] {
+		return e.Charges, nil
+	}
+	return nil, &NotLoadedError{edge: "charges"}
+}
+
+// LessonPackagesOrErr returns the LessonPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) LessonPackagesOrErr() ([]*LessonPackage, error) {
+	if e.loadedTypes[7] {
+		return e.LessonPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "lesson_packages"}
+}
+
+// ExcusedAbsencesOrErr returns the ExcusedAbsences value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) ExcusedAbsencesOrErr() ([]*ExcusedAbsence, error) {
+	if e.loadedTypes[8] {
+		return e.ExcusedAbsences, nil
+	}
+	return nil, &NotLoadedError{edge: "excused_absences"}
+}
+
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[9] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist_entries"}
+}
+
+// LeadsOrErr returns the Leads value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentEdges) LeadsOrErr() ([]*Lead, error) {
+	if e.loadedTypes[10] {
+		return e.Leads, nil
+	}
+	return nil, &NotLoadedError{edge: "leads"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Student) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -94,7 +196,7 @@ func (*Student) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case student.FieldID, student.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case student.FieldFullName, student.FieldPersonalCode, student.FieldPhone, student.FieldEmail, student.FieldNote, student.FieldPayerName, student.FieldPayerRole:
+		case student.FieldFullName, student.FieldPersonalCode, student.FieldPhone, student.FieldEmail, student.FieldNote, student.FieldPayerName, student.FieldPayerRole, student.FieldPayerPersonalCode, student.FieldPayerType, student.FieldPayerCompanyName, student.FieldPayerRegNo, student.FieldPayerVatNumber, student.FieldPayerLegalAddress, student.FieldPayerBillingEmail:
 			values[i] = new(sql.NullString)
 		case student.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -180,6 +282,48 @@ func (_m *Student) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PayerRole = value.String
 			}
+		case student.FieldPayerPersonalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_personal_code", values[i])
+			} else if value.Valid {
+				_m.PayerPersonalCode = value.String
+			}
+		case student.FieldPayerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_type", values[i])
+			} else if value.Valid {
+				_m.PayerType = student.PayerType(value.String)
+			}
+		case student.FieldPayerCompanyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_company_name", values[i])
+			} else if value.Valid {
+				_m.PayerCompanyName = value.String
+			}
+		case student.FieldPayerRegNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_reg_no", values[i])
+			} else if value.Valid {
+				_m.PayerRegNo = value.String
+			}
+		case student.FieldPayerVatNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_vat_number", values[i])
+			} else if value.Valid {
+				_m.PayerVatNumber = value.String
+			}
+		case student.FieldPayerLegalAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_legal_address", values[i])
+			} else if value.Valid {
+				_m.PayerLegalAddress = value.String
+			}
+		case student.FieldPayerBillingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payer_billing_email", values[i])
+			} else if value.Valid {
+				_m.PayerBillingEmail = value.String
+			}
 		case student.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
@@ -214,6 +358,46 @@ func (_m *Student) QueryPayments() *PaymentQuery {
 	return NewStudentClient(_m.config).QueryPayments(_m)
 }
 
+// QueryCashReceipts queries the "cash_receipts" edge of the Student entity.
+func (_m *Student) QueryCashReceipts() *CashReceiptQuery {
+	return NewStudentClient(_m.config).QueryCashReceipts(_m)
+}
+
+// QueryPaymentPlans queries the "payment_plans" edge of the Student entity.
+func (_m *Student) QueryPaymentPlans() *PaymentPlanQuery {
+	return NewStudentClient(_m.config).QueryPaymentPlans(_m)
+}
+
+// QueryLateFees queries the "late_fees" edge of the Student entity.
+func (_m *Student) QueryLateFees() *LateFeeQuery {
+	return NewStudentClient(_m.config).QueryLateFees(_m)
+}
+
+// QueryCharges queries the "charges" edge of the Student entity.
+func (_m *Student) QueryCharges() *StudentChargeQuery {
+	return NewStudentClient(_m.config).QueryCharges(_m)
+}
+
+// QueryLessonPackages queries the "lesson_packages" edge of the Student entity.
+func (_m *Student) QueryLessonPackages() *LessonPackageQuery {
+	return NewStudentClient(_m.config).QueryLessonPackages(_m)
+}
+
+// QueryExcusedAbsences queries the "excused_absences" edge of the Student entity.
+func (_m *Student) QueryExcusedAbsences() *ExcusedAbsenceQuery {
+	return NewStudentClient(_m.config).QueryExcusedAbsences(_m)
+}
+
+// QueryWaitlistEntries queries the "waitlist_entries" edge of the Student entity.
+func (_m *Student) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return NewStudentClient(_m.config).QueryWaitlistEntries(_m)
+}
+
+// QueryLeads queries the "leads" edge of the Student entity.
+func (_m *Student) QueryLeads() *LeadQuery {
+	return NewStudentClient(_m.config).QueryLeads(_m)
+}
+
 // Update returns a builder for updating this Student.
 // Note that you need to call Student.Unwrap() before calling this method if this Student
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -269,6 +453,27 @@ func (_m *Student) String() string {
 	builder.WriteString("payer_role=")
 	builder.WriteString(_m.PayerRole)
 	builder.WriteString(", ")
+	builder.WriteString("payer_personal_code=")
+	builder.WriteString(_m.PayerPersonalCode)
+	builder.WriteString(", ")
+	builder.WriteString("payer_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PayerType))
+	builder.WriteString(", ")
+	builder.WriteString("payer_company_name=")
+	builder.WriteString(_m.PayerCompanyName)
+	builder.WriteString(", ")
+	builder.WriteString("payer_reg_no=")
+	builder.WriteString(_m.PayerRegNo)
+	builder.WriteString(", ")
+	builder.WriteString("payer_vat_number=")
+	builder.WriteString(_m.PayerVatNumber)
+	builder.WriteString(", ")
+	builder.WriteString("payer_legal_address=")
+	builder.WriteString(_m.PayerLegalAddress)
+	builder.WriteString(", ")
+	builder.WriteString("payer_billing_email=")
+	builder.WriteString(_m.PayerBillingEmail)
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteByte(')')
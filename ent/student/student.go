@@ -3,6 +3,7 @@
 package student
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -34,6 +35,20 @@ const (
 	FieldPayerName = "payer_name"
 	// FieldPayerRole holds the string denoting the payer_role field in the database.
 	FieldPayerRole = "payer_role"
+	// FieldPayerPersonalCode holds the string denoting the payer_personal_code field in the database.
+	FieldPayerPersonalCode = "payer_personal_code"
+	// FieldPayerType holds the string denoting the payer_type field in the database.
+	FieldPayerType = "payer_type"
+	// FieldPayerCompanyName holds the string denoting the payer_company_name field in the database.
+	FieldPayerCompanyName = "payer_company_name"
+	// FieldPayerRegNo holds the string denoting the payer_reg_no field in the database.
+	FieldPayerRegNo = "payer_reg_no"
+	// FieldPayerVatNumber holds the string denoting the payer_vat_number field in the database.
+	FieldPayerVatNumber = "payer_vat_number"
+	// FieldPayerLegalAddress holds the string denoting the payer_legal_address field in the database.
+	FieldPayerLegalAddress = "payer_legal_address"
+	// FieldPayerBillingEmail holds the string denoting the payer_billing_email field in the database.
+	FieldPayerBillingEmail = "payer_billing_email"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
 	// EdgeEnrollments holds the string denoting the enrollments edge name in mutations.
@@ -42,6 +57,22 @@ const (
 	EdgeInvoices = "invoices"
 	// EdgePayments holds the string denoting the payments edge name in mutations.
 	EdgePayments = "payments"
+	// EdgeCashReceipts holds the string denoting the cash_receipts edge name in mutations.
+	EdgeCashReceipts = "cash_receipts"
+	// EdgePaymentPlans holds the string denoting the payment_plans edge name in mutations.
+	EdgePaymentPlans = "payment_plans"
+	// EdgeLateFees holds the string denoting the late_fees edge name in mutations.
+	EdgeLateFees = "late_fees"
+	// EdgeCharges holds the string denoting the charges edge name in mutations.
+	EdgeCharges = "charges"
+	// EdgeLessonPackages holds the string denoting the lesson_packages edge name in mutations.
+	EdgeLessonPackages = "lesson_packages"
+	// EdgeExcusedAbsences holds the string denoting the excused_absences edge name in mutations.
+	EdgeExcusedAbsences = "excused_absences"
+	// EdgeWaitlistEntries holds the string denoting the waitlist_entries edge name in mutations.
+	EdgeWaitlistEntries = "waitlist_entries"
+	// EdgeLeads holds the string denoting the leads edge name in mutations.
+	EdgeLeads = "leads"
 	// Table holds the table name of the student in the database.
 	Table = "students"
 	// EnrollmentsTable is the table that holds the enrollments relation/edge.
@@ -65,6 +96,62 @@ const (
 	PaymentsInverseTable = "payments"
 	// PaymentsColumn is the table column denoting the payments relation/edge.
 	PaymentsColumn = "student_id"
+	// CashReceiptsTable is the table that holds the cash_receipts relation/edge.
+	CashReceiptsTable = "cash_receipts"
+	// CashReceiptsInverseTable is the table name for the CashReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "cashreceipt" package.
+	CashReceiptsInverseTable = "cash_receipts"
+	// CashReceiptsColumn is the table column denoting the cash_receipts relation/edge.
+	CashReceiptsColumn = "student_id"
+	// PaymentPlansTable is the table that holds the payment_plans relation/edge.
+	PaymentPlansTable = "payment_plans"
+	// PaymentPlansInverseTable is the table name for the PaymentPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentplan" package.
+	PaymentPlansInverseTable = "payment_plans"
+	// PaymentPlansColumn is the table column denoting the payment_plans relation/edge.
+	PaymentPlansColumn = "student_id"
+	// LateFeesTable is the table that holds the late_fees relation/edge.
+	LateFeesTable = "late_fees"
+	// LateFeesInverseTable is the table name for the LateFee entity.
+	// It exists in this package in order to avoid circular dependency with the "latefee" package.
+	LateFeesInverseTable = "late_fees"
+	// LateFeesColumn is the table column denoting the late_fees relation/edge.
+	LateFeesColumn = "student_id"
+	// ChargesTable is the table that holds the charges relation/edge.
+	ChargesTable = "student_charges"
+	// ChargesInverseTable is the table name for the StudentCharge entity.
+	// It exists in this package in order to avoid circular dependency with the "studentcharge" package.
+	ChargesInverseTable = "student_charges"
+	// ChargesColumn is the table column denoting the charges relation/edge.
+	ChargesColumn = "student_id"
+	// LessonPackagesTable is the table that holds the lesson_packages relation/edge.
+	LessonPackagesTable = "lesson_packages"
+	// LessonPackagesInverseTable is the table name for the LessonPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "lessonpackage" package.
+	LessonPackagesInverseTable = "lesson_packages"
+	// LessonPackagesColumn is the table column denoting the lesson_packages relation/edge.
+	LessonPackagesColumn = "student_id"
+	// ExcusedAbsencesTable is the table that holds the excused_absences relation/edge.
+	ExcusedAbsencesTable = "excused_absences"
+	// ExcusedAbsencesInverseTable is the table name for the ExcusedAbsence entity.
+	// It exists in this package in order to avoid circular dependency with the "excusedabsence" package.
+	ExcusedAbsencesInverseTable = "excused_absences"
+	// ExcusedAbsencesColumn is the table column denoting the excused_absences relation/edge.
+	ExcusedAbsencesColumn = "student_id"
+	// WaitlistEntriesTable is the table that holds the waitlist_entries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlist_entries relation/edge.
+	WaitlistEntriesColumn = "student_id"
+	// LeadsTable is the table that holds the leads relation/edge.
+	LeadsTable = "leads"
+	// LeadsInverseTable is the table name for the Lead entity.
+	// It exists in this package in order to avoid circular dependency with the "lead" package.
+	LeadsInverseTable = "leads"
+	// LeadsColumn is the table column denoting the leads relation/edge.
+	LeadsColumn = "student_id"
 )
 
 // Columns holds all SQL columns for student fields.
@@ -80,6 +167,13 @@ var Columns = []string{
 	FieldIsMinor,
 	FieldPayerName,
 	FieldPayerRole,
+	FieldPayerPersonalCode,
+	FieldPayerType,
+	FieldPayerCompanyName,
+	FieldPayerRegNo,
+	FieldPayerVatNumber,
+	FieldPayerLegalAddress,
+	FieldPayerBillingEmail,
 	FieldIsActive,
 }
 
@@ -112,10 +206,48 @@ var (
 	DefaultPayerName string
 	// DefaultPayerRole holds the default value on creation for the "payer_role" field.
 	DefaultPayerRole string
+	// DefaultPayerPersonalCode holds the default value on creation for the "payer_personal_code" field.
+	DefaultPayerPersonalCode string
+	// DefaultPayerCompanyName holds the default value on creation for the "payer_company_name" field.
+	DefaultPayerCompanyName string
+	// DefaultPayerRegNo holds the default value on creation for the "payer_reg_no" field.
+	DefaultPayerRegNo string
+	// DefaultPayerVatNumber holds the default value on creation for the "payer_vat_number" field.
+	DefaultPayerVatNumber string
+	// DefaultPayerLegalAddress holds the default value on creation for the "payer_legal_address" field.
+	DefaultPayerLegalAddress string
+	// DefaultPayerBillingEmail holds the default value on creation for the "payer_billing_email" field.
+	DefaultPayerBillingEmail string
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 )
 
+// PayerType defines the type for the "payer_type" enum field.
+type PayerType string
+
+// PayerTypePerson is the default value of the PayerType enum.
+const DefaultPayerType = PayerTypePerson
+
+// PayerType values.
+const (
+	PayerTypePerson  PayerType = "person"
+	PayerTypeCompany PayerType = "company"
+)
+
+func (pt PayerType) String() string {
+	return string(pt)
+}
+
+// PayerTypeValidator is a validator for the "payer_type" field enum values. It is called by the builders before save.
+func PayerTypeValidator(pt PayerType) error {
+	switch pt {
+	case PayerTypePerson, PayerTypeCompany:
+		return nil
+	default:
+		return fmt.Errorf("student: invalid enum value for payer_type field: %q", pt)
+	}
+}
+
 // OrderOption defines the ordering options for the Student queries.
 type OrderOption func(*sql.Selector)
 
@@ -174,6 +306,41 @@ func ByPayerRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPayerRole, opts...).ToFunc()
 }
 
+// ByPayerPersonalCode orders the results by the payer_personal_code field.
+func ByPayerPersonalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerPersonalCode, opts...).ToFunc()
+}
+
+// ByPayerType orders the results by the payer_type field.
+func ByPayerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerType, opts...).ToFunc()
+}
+
+// ByPayerCompanyName orders the results by the payer_company_name field.
+func ByPayerCompanyName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerCompanyName, opts...).ToFunc()
+}
+
+// ByPayerRegNo orders the results by the payer_reg_no field.
+func ByPayerRegNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerRegNo, opts...).ToFunc()
+}
+
+// ByPayerVatNumber orders the results by the payer_vat_number field.
+func ByPayerVatNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerVatNumber, opts...).ToFunc()
+}
+
+// ByPayerLegalAddress orders the results by the payer_legal_address field.
+func ByPayerLegalAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerLegalAddress, opts...).ToFunc()
+}
+
+// ByPayerBillingEmail orders the results by the payer_billing_email field.
+func ByPayerBillingEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayerBillingEmail, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
@@ -220,6 +387,118 @@ func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCashReceiptsCount orders the results by cash_receipts count.
+func ByCashReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCashReceiptsStep(), opts...)
+	}
+}
+
+// ByCashReceipts orders the results by cash_receipts terms.
+func ByCashReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCashReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaymentPlansCount orders the results by payment_plans count.
+func ByPaymentPlansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentPlansStep(), opts...)
+	}
+}
+
+// ByPaymentPlans orders the results by payment_plans terms.
+func ByPaymentPlans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentPlansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLateFeesCount orders the results by late_fees count.
+func ByLateFeesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLateFeesStep(), opts...)
+	}
+}
+
+// ByLateFees orders the results by late_fees terms.
+func ByLateFees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLateFeesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChargesCount orders the results by charges count.
+func ByChargesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChargesStep(), opts...)
+	}
+}
+
+// ByCharges orders the results by charges terms.
+func ByCharges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChargesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLessonPackagesCount orders the results by lesson_packages count.
+func ByLessonPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLessonPackagesStep(), opts...)
+	}
+}
+
+// ByLessonPackages orders the results by lesson_packages terms.
+func ByLessonPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLessonPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExcusedAbsencesCount orders the results by excused_absences count.
+func ByExcusedAbsencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExcusedAbsencesStep(), opts...)
+	}
+}
+
+// ByExcusedAbsences orders the results by excused_absences terms.
+func ByExcusedAbsences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExcusedAbsencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistEntriesCount orders the results by waitlist_entries count.
+func ByWaitlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistEntriesStep(), opts...)
+	}
+}
+
+// ByWaitlistEntries orders the results by waitlist_entries terms.
+func ByWaitlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeadsCount orders the results by leads count.
+func ByLeadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadsStep(), opts...)
+	}
+}
+
+// ByLeads orders the results by leads terms.
+func ByLeads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnrollmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -241,3 +520,59 @@ func newPaymentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
 	)
 }
+func newCashReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CashReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CashReceiptsTable, CashReceiptsColumn),
+	)
+}
+func newPaymentPlansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentPlansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentPlansTable, PaymentPlansColumn),
+	)
+}
+func newLateFeesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LateFeesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LateFeesTable, LateFeesColumn),
+	)
+}
+func newChargesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChargesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChargesTable, ChargesColumn),
+	)
+}
+func newLessonPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LessonPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+	)
+}
+func newExcusedAbsencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExcusedAbsencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExcusedAbsencesTable, ExcusedAbsencesColumn),
+	)
+}
+func newWaitlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+	)
+}
+func newLeadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadsTable, LeadsColumn),
+	)
+}
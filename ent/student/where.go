@@ -105,6 +105,36 @@ func PayerRole(v string) predicate.Student {
 	return predicate.Student(sql.FieldEQ(FieldPayerRole, v))
 }
 
+// PayerPersonalCode applies equality check predicate on the "payer_personal_code" field. It's identical to PayerPersonalCodeEQ.
+func PayerPersonalCode(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerPersonalCode, v))
+}
+
+// PayerCompanyName applies equality check predicate on the "payer_company_name" field. It's identical to PayerCompanyNameEQ.
+func PayerCompanyName(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerCompanyName, v))
+}
+
+// PayerRegNo applies equality check predicate on the "payer_reg_no" field. It's identical to PayerRegNoEQ.
+func PayerRegNo(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerRegNo, v))
+}
+
+// PayerVatNumber applies equality check predicate on the "payer_vat_number" field. It's identical to PayerVatNumberEQ.
+func PayerVatNumber(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerVatNumber, v))
+}
+
+// PayerLegalAddress applies equality check predicate on the "payer_legal_address" field. It's identical to PayerLegalAddressEQ.
+func PayerLegalAddress(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerLegalAddress, v))
+}
+
+// PayerBillingEmail applies equality check predicate on the "payer_billing_email" field. It's identical to PayerBillingEmailEQ.
+func PayerBillingEmail(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerBillingEmail, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.Student {
 	return predicate.Student(sql.FieldEQ(FieldIsActive, v))
@@ -665,6 +695,416 @@ func PayerRoleContainsFold(v string) predicate.Student {
 	return predicate.Student(sql.FieldContainsFold(FieldPayerRole, v))
 }
 
+// PayerPersonalCodeEQ applies the EQ predicate on the "payer_personal_code" field.
+func PayerPersonalCodeEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeNEQ applies the NEQ predicate on the "payer_personal_code" field.
+func PayerPersonalCodeNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeIn applies the In predicate on the "payer_personal_code" field.
+func PayerPersonalCodeIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerPersonalCode, vs...))
+}
+
+// PayerPersonalCodeNotIn applies the NotIn predicate on the "payer_personal_code" field.
+func PayerPersonalCodeNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerPersonalCode, vs...))
+}
+
+// PayerPersonalCodeGT applies the GT predicate on the "payer_personal_code" field.
+func PayerPersonalCodeGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeGTE applies the GTE predicate on the "payer_personal_code" field.
+func PayerPersonalCodeGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeLT applies the LT predicate on the "payer_personal_code" field.
+func PayerPersonalCodeLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeLTE applies the LTE predicate on the "payer_personal_code" field.
+func PayerPersonalCodeLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeContains applies the Contains predicate on the "payer_personal_code" field.
+func PayerPersonalCodeContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeHasPrefix applies the HasPrefix predicate on the "payer_personal_code" field.
+func PayerPersonalCodeHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeHasSuffix applies the HasSuffix predicate on the "payer_personal_code" field.
+func PayerPersonalCodeHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeEqualFold applies the EqualFold predicate on the "payer_personal_code" field.
+func PayerPersonalCodeEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerPersonalCode, v))
+}
+
+// PayerPersonalCodeContainsFold applies the ContainsFold predicate on the "payer_personal_code" field.
+func PayerPersonalCodeContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerPersonalCode, v))
+}
+
+// PayerTypeEQ applies the EQ predicate on the "payer_type" field.
+func PayerTypeEQ(v PayerType) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerType, v))
+}
+
+// PayerTypeNEQ applies the NEQ predicate on the "payer_type" field.
+func PayerTypeNEQ(v PayerType) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerType, v))
+}
+
+// PayerTypeIn applies the In predicate on the "payer_type" field.
+func PayerTypeIn(vs ...PayerType) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerType, vs...))
+}
+
+// PayerTypeNotIn applies the NotIn predicate on the "payer_type" field.
+func PayerTypeNotIn(vs ...PayerType) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerType, vs...))
+}
+
+// PayerCompanyNameEQ applies the EQ predicate on the "payer_company_name" field.
+func PayerCompanyNameEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameNEQ applies the NEQ predicate on the "payer_company_name" field.
+func PayerCompanyNameNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameIn applies the In predicate on the "payer_company_name" field.
+func PayerCompanyNameIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerCompanyName, vs...))
+}
+
+// PayerCompanyNameNotIn applies the NotIn predicate on the "payer_company_name" field.
+func PayerCompanyNameNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerCompanyName, vs...))
+}
+
+// PayerCompanyNameGT applies the GT predicate on the "payer_company_name" field.
+func PayerCompanyNameGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameGTE applies the GTE predicate on the "payer_company_name" field.
+func PayerCompanyNameGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameLT applies the LT predicate on the "payer_company_name" field.
+func PayerCompanyNameLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameLTE applies the LTE predicate on the "payer_company_name" field.
+func PayerCompanyNameLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameContains applies the Contains predicate on the "payer_company_name" field.
+func PayerCompanyNameContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameHasPrefix applies the HasPrefix predicate on the "payer_company_name" field.
+func PayerCompanyNameHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameHasSuffix applies the HasSuffix predicate on the "payer_company_name" field.
+func PayerCompanyNameHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameEqualFold applies the EqualFold predicate on the "payer_company_name" field.
+func PayerCompanyNameEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerCompanyName, v))
+}
+
+// PayerCompanyNameContainsFold applies the ContainsFold predicate on the "payer_company_name" field.
+func PayerCompanyNameContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerCompanyName, v))
+}
+
+// PayerRegNoEQ applies the EQ predicate on the "payer_reg_no" field.
+func PayerRegNoEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerRegNo, v))
+}
+
+// PayerRegNoNEQ applies the NEQ predicate on the "payer_reg_no" field.
+func PayerRegNoNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerRegNo, v))
+}
+
+// PayerRegNoIn applies the In predicate on the "payer_reg_no" field.
+func PayerRegNoIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerRegNo, vs...))
+}
+
+// PayerRegNoNotIn applies the NotIn predicate on the "payer_reg_no" field.
+func PayerRegNoNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerRegNo, vs...))
+}
+
+// PayerRegNoGT applies the GT predicate on the "payer_reg_no" field.
+func PayerRegNoGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerRegNo, v))
+}
+
+// PayerRegNoGTE applies the GTE predicate on the "payer_reg_no" field.
+func PayerRegNoGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerRegNo, v))
+}
+
+// PayerRegNoLT applies the LT predicate on the "payer_reg_no" field.
+func PayerRegNoLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerRegNo, v))
+}
+
+// PayerRegNoLTE applies the LTE predicate on the "payer_reg_no" field.
+func PayerRegNoLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerRegNo, v))
+}
+
+// PayerRegNoContains applies the Contains predicate on the "payer_reg_no" field.
+func PayerRegNoContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerRegNo, v))
+}
+
+// PayerRegNoHasPrefix applies the HasPrefix predicate on the "payer_reg_no" field.
+func PayerRegNoHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerRegNo, v))
+}
+
+// PayerRegNoHasSuffix applies the HasSuffix predicate on the "payer_reg_no" field.
+func PayerRegNoHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerRegNo, v))
+}
+
+// PayerRegNoEqualFold applies the EqualFold predicate on the "payer_reg_no" field.
+func PayerRegNoEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerRegNo, v))
+}
+
+// PayerRegNoContainsFold applies the ContainsFold predicate on the "payer_reg_no" field.
+func PayerRegNoContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerRegNo, v))
+}
+
+// PayerVatNumberEQ applies the EQ predicate on the "payer_vat_number" field.
+func PayerVatNumberEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberNEQ applies the NEQ predicate on the "payer_vat_number" field.
+func PayerVatNumberNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberIn applies the In predicate on the "payer_vat_number" field.
+func PayerVatNumberIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerVatNumber, vs...))
+}
+
+// PayerVatNumberNotIn applies the NotIn predicate on the "payer_vat_number" field.
+func PayerVatNumberNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerVatNumber, vs...))
+}
+
+// PayerVatNumberGT applies the GT predicate on the "payer_vat_number" field.
+func PayerVatNumberGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberGTE applies the GTE predicate on the "payer_vat_number" field.
+func PayerVatNumberGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberLT applies the LT predicate on the "payer_vat_number" field.
+func PayerVatNumberLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberLTE applies the LTE predicate on the "payer_vat_number" field.
+func PayerVatNumberLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberContains applies the Contains predicate on the "payer_vat_number" field.
+func PayerVatNumberContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberHasPrefix applies the HasPrefix predicate on the "payer_vat_number" field.
+func PayerVatNumberHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberHasSuffix applies the HasSuffix predicate on the "payer_vat_number" field.
+func PayerVatNumberHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberEqualFold applies the EqualFold predicate on the "payer_vat_number" field.
+func PayerVatNumberEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerVatNumber, v))
+}
+
+// PayerVatNumberContainsFold applies the ContainsFold predicate on the "payer_vat_number" field.
+func PayerVatNumberContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerVatNumber, v))
+}
+
+// PayerLegalAddressEQ applies the EQ predicate on the "payer_legal_address" field.
+func PayerLegalAddressEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressNEQ applies the NEQ predicate on the "payer_legal_address" field.
+func PayerLegalAddressNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressIn applies the In predicate on the "payer_legal_address" field.
+func PayerLegalAddressIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerLegalAddress, vs...))
+}
+
+// PayerLegalAddressNotIn applies the NotIn predicate on the "payer_legal_address" field.
+func PayerLegalAddressNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerLegalAddress, vs...))
+}
+
+// PayerLegalAddressGT applies the GT predicate on the "payer_legal_address" field.
+func PayerLegalAddressGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressGTE applies the GTE predicate on the "payer_legal_address" field.
+func PayerLegalAddressGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressLT applies the LT predicate on the "payer_legal_address" field.
+func PayerLegalAddressLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressLTE applies the LTE predicate on the "payer_legal_address" field.
+func PayerLegalAddressLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressContains applies the Contains predicate on the "payer_legal_address" field.
+func PayerLegalAddressContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressHasPrefix applies the HasPrefix predicate on the "payer_legal_address" field.
+func PayerLegalAddressHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressHasSuffix applies the HasSuffix predicate on the "payer_legal_address" field.
+func PayerLegalAddressHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressEqualFold applies the EqualFold predicate on the "payer_legal_address" field.
+func PayerLegalAddressEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerLegalAddress, v))
+}
+
+// PayerLegalAddressContainsFold applies the ContainsFold predicate on the "payer_legal_address" field.
+func PayerLegalAddressContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerLegalAddress, v))
+}
+
+// PayerBillingEmailEQ applies the EQ predicate on the "payer_billing_email" field.
+func PayerBillingEmailEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldEQ(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailNEQ applies the NEQ predicate on the "payer_billing_email" field.
+func PayerBillingEmailNEQ(v string) predicate.Student {
+	return predicate.Student(sql.FieldNEQ(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailIn applies the In predicate on the "payer_billing_email" field.
+func PayerBillingEmailIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldIn(FieldPayerBillingEmail, vs...))
+}
+
+// PayerBillingEmailNotIn applies the NotIn predicate on the "payer_billing_email" field.
+func PayerBillingEmailNotIn(vs ...string) predicate.Student {
+	return predicate.Student(sql.FieldNotIn(FieldPayerBillingEmail, vs...))
+}
+
+// PayerBillingEmailGT applies the GT predicate on the "payer_billing_email" field.
+func PayerBillingEmailGT(v string) predicate.Student {
+	return predicate.Student(sql.FieldGT(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailGTE applies the GTE predicate on the "payer_billing_email" field.
+func PayerBillingEmailGTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldGTE(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailLT applies the LT predicate on the "payer_billing_email" field.
+func PayerBillingEmailLT(v string) predicate.Student {
+	return predicate.Student(sql.FieldLT(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailLTE applies the LTE predicate on the "payer_billing_email" field.
+func PayerBillingEmailLTE(v string) predicate.Student {
+	return predicate.Student(sql.FieldLTE(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailContains applies the Contains predicate on the "payer_billing_email" field.
+func PayerBillingEmailContains(v string) predicate.Student {
+	return predicate.Student(sql.FieldContains(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailHasPrefix applies the HasPrefix predicate on the "payer_billing_email" field.
+func PayerBillingEmailHasPrefix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasPrefix(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailHasSuffix applies the HasSuffix predicate on the "payer_billing_email" field.
+func PayerBillingEmailHasSuffix(v string) predicate.Student {
+	return predicate.Student(sql.FieldHasSuffix(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailEqualFold applies the EqualFold predicate on the "payer_billing_email" field.
+func PayerBillingEmailEqualFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldEqualFold(FieldPayerBillingEmail, v))
+}
+
+// PayerBillingEmailContainsFold applies the ContainsFold predicate on the "payer_billing_email" field.
+func PayerBillingEmailContainsFold(v string) predicate.Student {
+	return predicate.Student(sql.FieldContainsFold(FieldPayerBillingEmail, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.Student {
 	return predicate.Student(sql.FieldEQ(FieldIsActive, v))
@@ -744,6 +1184,190 @@ func HasPaymentsWith(preds ...predicate.Payment) predicate.Student {
 	})
 }
 
+// HasCashReceipts applies the HasEdge predicate on the "cash_receipts" edge.
+func HasCashReceipts() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CashReceiptsTable, CashReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCashReceiptsWith applies the HasEdge predicate on the "cash_receipts" edge with a given conditions (other predicates).
+func HasCashReceiptsWith(preds ...predicate.CashReceipt) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newCashReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentPlans applies the HasEdge predicate on the "payment_plans" edge.
+func HasPaymentPlans() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentPlansTable, PaymentPlansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentPlansWith applies the HasEdge predicate on the "payment_plans" edge with a given conditions (other predicates).
+func HasPaymentPlansWith(preds ...predicate.PaymentPlan) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newPaymentPlansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLateFees applies the HasEdge predicate on the "late_fees" edge.
+func HasLateFees() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LateFeesTable, LateFeesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLateFeesWith applies the HasEdge predicate on the "late_fees" edge with a given conditions (other predicates).
+func HasLateFeesWith(preds ...predicate.LateFee) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newLateFeesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCharges applies the HasEdge predicate on the "charges" edge.
+func HasCharges() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChargesTable, ChargesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChargesWith applies the HasEdge predicate on the "charges" edge with a given conditions (other predicates).
+func HasChargesWith(preds ...predicate.StudentCharge) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newChargesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLessonPackages applies the HasEdge predicate on the "lesson_packages" edge.
+func HasLessonPackages() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LessonPackagesTable, LessonPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLessonPackagesWith applies the HasEdge predicate on the "lesson_packages" edge with a given conditions (other predicates).
+func HasLessonPackagesWith(preds ...predicate.LessonPackage) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newLessonPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExcusedAbsences applies the HasEdge predicate on the "excused_absences" edge.
+func HasExcusedAbsences() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExcusedAbsencesTable, ExcusedAbsencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExcusedAbsencesWith applies the HasEdge predicate on the "excused_absences" edge with a given conditions (other predicates).
+func HasExcusedAbsencesWith(preds ...predicate.ExcusedAbsence) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newExcusedAbsencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlistEntries applies the HasEdge predicate on the "waitlist_entries" edge.
+func HasWaitlistEntries() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistEntriesWith applies the HasEdge predicate on the "waitlist_entries" edge with a given conditions (other predicates).
+func HasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newWaitlistEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeads applies the HasEdge predicate on the "leads" edge.
+func HasLeads() predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadsTable, LeadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadsWith applies the HasEdge predicate on the "leads" edge with a given conditions (other predicates).
+func HasLeadsWith(preds ...predicate.Lead) predicate.Student {
+	return predicate.Student(func(s *sql.Selector) {
+		step := newLeadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Student) predicate.Student {
 	return predicate.Student(sql.AndPredicates(predicates...))
@@ -6,10 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"langschool/ent/waitlistentry"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -155,6 +163,104 @@ func (_c *StudentCreate) SetNillablePayerRole(v *string) *StudentCreate {
 	return _c
 }
 
+// SetPayerPersonalCode sets the "payer_personal_code" field.
+func (_c *StudentCreate) SetPayerPersonalCode(v string) *StudentCreate {
+	_c.mutation.SetPayerPersonalCode(v)
+	return _c
+}
+
+// SetNillablePayerPersonalCode sets the "payer_personal_code" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerPersonalCode(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerPersonalCode(*v)
+	}
+	return _c
+}
+
+// SetPayerType sets the "payer_type" field.
+func (_c *StudentCreate) SetPayerType(v student.PayerType) *StudentCreate {
+	_c.mutation.SetPayerType(v)
+	return _c
+}
+
+// SetNillablePayerType sets the "payer_type" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerType(v *student.PayerType) *StudentCreate {
+	if v != nil {
+		_c.SetPayerType(*v)
+	}
+	return _c
+}
+
+// SetPayerCompanyName sets the "payer_company_name" field.
+func (_c *StudentCreate) SetPayerCompanyName(v string) *StudentCreate {
+	_c.mutation.SetPayerCompanyName(v)
+	return _c
+}
+
+// SetNillablePayerCompanyName sets the "payer_company_name" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerCompanyName(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerCompanyName(*v)
+	}
+	return _c
+}
+
+// SetPayerRegNo sets the "payer_reg_no" field.
+func (_c *StudentCreate) SetPayerRegNo(v string) *StudentCreate {
+	_c.mutation.SetPayerRegNo(v)
+	return _c
+}
+
+// SetNillablePayerRegNo sets the "payer_reg_no" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerRegNo(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerRegNo(*v)
+	}
+	return _c
+}
+
+// SetPayerVatNumber sets the "payer_vat_number" field.
+func (_c *StudentCreate) SetPayerVatNumber(v string) *StudentCreate {
+	_c.mutation.SetPayerVatNumber(v)
+	return _c
+}
+
+// SetNillablePayerVatNumber sets the "payer_vat_number" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerVatNumber(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerVatNumber(*v)
+	}
+	return _c
+}
+
+// SetPayerLegalAddress sets the "payer_legal_address" field.
+func (_c *StudentCreate) SetPayerLegalAddress(v string) *StudentCreate {
+	_c.mutation.SetPayerLegalAddress(v)
+	return _c
+}
+
+// SetNillablePayerLegalAddress sets the "payer_legal_address" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerLegalAddress(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerLegalAddress(*v)
+	}
+	return _c
+}
+
+// SetPayerBillingEmail sets the "payer_billing_email" field.
+func (_c *StudentCreate) SetPayerBillingEmail(v string) *StudentCreate {
+	_c.mutation.SetPayerBillingEmail(v)
+	return _c
+}
+
+// SetNillablePayerBillingEmail sets the "payer_billing_email" field if the given value is not nil.
+func (_c *StudentCreate) SetNillablePayerBillingEmail(v *string) *StudentCreate {
+	if v != nil {
+		_c.SetPayerBillingEmail(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *StudentCreate) SetIsActive(v bool) *StudentCreate {
 	_c.mutation.SetIsActive(v)
@@ -214,6 +320,126 @@ func (_c *StudentCreate) AddPayments(v ...*Payment) *StudentCreate {
 	return _c.AddPaymentIDs(ids...)
 }
 
+// AddCashReceiptIDs adds the "cash_receipts" edge to the CashReceipt entity by IDs.
+func (_c *StudentCreate) AddCashReceiptIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddCashReceiptIDs(ids...)
+	return _c
+}
+
+// AddCashReceipts adds the "cash_receipts" edges to the CashReceipt entity.
+func (_c *StudentCreate) AddCashReceipts(v ...*CashReceipt) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCashReceiptIDs(ids...)
+}
+
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_c *StudentCreate) AddPaymentPlanIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddPaymentPlanIDs(ids...)
+	return _c
+}
+
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_c *StudentCreate) AddPaymentPlans(v ...*PaymentPlan) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_c *StudentCreate) AddLateFeeIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddLateFeeIDs(ids...)
+	return _c
+}
+
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_c *StudentCreate) AddLateFees(v ...*LateFee) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLateFeeIDs(ids...)
+}
+
+// AddChargeIDs adds the "charges" edge to the StudentCharge entity by IDs.
+func (_c *StudentCreate) AddChargeIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddChargeIDs(ids...)
+	return _c
+}
+
+// AddCharges adds the "charges" edges to the StudentCharge entity.
+func (_c *StudentCreate) AddCharges(v ...*StudentCharge) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChargeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_c *StudentCreate) AddLessonPackageIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddLessonPackageIDs(ids...)
+	return _c
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_c *StudentCreate) AddLessonPackages(v ...*LessonPackage) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLessonPackageIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_c *StudentCreate) AddExcusedAbsenceIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddExcusedAbsenceIDs(ids...)
+	return _c
+}
+
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_c *StudentCreate) AddExcusedAbsences(v ...*ExcusedAbsence) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_c *StudentCreate) AddWaitlistEntryIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddWaitlistEntryIDs(ids...)
+	return _c
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_c *StudentCreate) AddWaitlistEntries(v ...*WaitlistEntry) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_c *StudentCreate) AddLeadIDs(ids ...int) *StudentCreate {
+	_c.mutation.AddLeadIDs(ids...)
+	return _c
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_c *StudentCreate) AddLeads(v ...*Lead) *StudentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadIDs(ids...)
+}
+
 // Mutation returns the StudentMutation object of the builder.
 func (_c *StudentCreate) Mutation() *StudentMutation {
 	return _c.mutation
@@ -285,6 +511,34 @@ func (_c *StudentCreate) defaults() {
 		v := student.DefaultPayerRole
 		_c.mutation.SetPayerRole(v)
 	}
+	if _, ok := _c.mutation.PayerPersonalCode(); !ok {
+		v := student.DefaultPayerPersonalCode
+		_c.mutation.SetPayerPersonalCode(v)
+	}
+	if _, ok := _c.mutation.PayerType(); !ok {
+		v := student.DefaultPayerType
+		_c.mutation.SetPayerType(v)
+	}
+	if _, ok := _c.mutation.PayerCompanyName(); !ok {
+		v := student.DefaultPayerCompanyName
+		_c.mutation.SetPayerCompanyName(v)
+	}
+	if _, ok := _c.mutation.PayerRegNo(); !ok {
+		v := student.DefaultPayerRegNo
+		_c.mutation.SetPayerRegNo(v)
+	}
+	if _, ok := _c.mutation.PayerVatNumber(); !ok {
+		v := student.DefaultPayerVatNumber
+		_c.mutation.SetPayerVatNumber(v)
+	}
+	if _, ok := _c.mutation.PayerLegalAddress(); !ok {
+		v := student.DefaultPayerLegalAddress
+		_c.mutation.SetPayerLegalAddress(v)
+	}
+	if _, ok := _c.mutation.PayerBillingEmail(); !ok {
+		v := student.DefaultPayerBillingEmail
+		_c.mutation.SetPayerBillingEmail(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := student.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -320,6 +574,32 @@ func (_c *StudentCreate) check() error {
 	if _, ok := _c.mutation.PayerRole(); !ok {
 		return &ValidationError{Name: "payer_role", err: errors.New(`ent: missing required field "Student.payer_role"`)}
 	}
+	if _, ok := _c.mutation.PayerPersonalCode(); !ok {
+		return &ValidationError{Name: "payer_personal_code", err: errors.New(`ent: missing required field "Student.payer_personal_code"`)}
+	}
+	if _, ok := _c.mutation.PayerType(); !ok {
+		return &ValidationError{Name: "payer_type", err: errors.New(`ent: missing required field "Student.payer_type"`)}
+	}
+	if v, ok := _c.mutation.PayerType(); ok {
+		if err := student.PayerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payer_type", err: fmt.Errorf(`ent: validator failed for field "Student.payer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PayerCompanyName(); !ok {
+		return &ValidationError{Name: "payer_company_name", err: errors.New(`ent: missing required field "Student.payer_company_name"`)}
+	}
+	if _, ok := _c.mutation.PayerRegNo(); !ok {
+		return &ValidationError{Name: "payer_reg_no", err: errors.New(`ent: missing required field "Student.payer_reg_no"`)}
+	}
+	if _, ok := _c.mutation.PayerVatNumber(); !ok {
+		return &ValidationError{Name: "payer_vat_number", err: errors.New(`ent: missing required field "Student.payer_vat_number"`)}
+	}
+	if _, ok := _c.mutation.PayerLegalAddress(); !ok {
+		return &ValidationError{Name: "payer_legal_address", err: errors.New(`ent: missing required field "Student.payer_legal_address"`)}
+	}
+	if _, ok := _c.mutation.PayerBillingEmail(); !ok {
+		return &ValidationError{Name: "payer_billing_email", err: errors.New(`ent: missing required field "Student.payer_billing_email"`)}
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Student.is_active"`)}
 	}
@@ -389,6 +669,34 @@ func (_c *StudentCreate) createSpec() (*Student, *sqlgraph.CreateSpec) {
 		_spec.SetField(student.FieldPayerRole, field.TypeString, value)
 		_node.PayerRole = value
 	}
+	if value, ok := _c.mutation.PayerPersonalCode(); ok {
+		_spec.SetField(student.FieldPayerPersonalCode, field.TypeString, value)
+		_node.PayerPersonalCode = value
+	}
+	if value, ok := _c.mutation.PayerType(); ok {
+		_spec.SetField(student.FieldPayerType, field.TypeEnum, value)
+		_node.PayerType = value
+	}
+	if value, ok := _c.mutation.PayerCompanyName(); ok {
+		_spec.SetField(student.FieldPayerCompanyName, field.TypeString, value)
+		_node.PayerCompanyName = value
+	}
+	if value, ok := _c.mutation.PayerRegNo(); ok {
+		_spec.SetField(student.FieldPayerRegNo, field.TypeString, value)
+		_node.PayerRegNo = value
+	}
+	if value, ok := _c.mutation.PayerVatNumber(); ok {
+		_spec.SetField(student.FieldPayerVatNumber, field.TypeString, value)
+		_node.PayerVatNumber = value
+	}
+	if value, ok := _c.mutation.PayerLegalAddress(); ok {
+		_spec.SetField(student.FieldPayerLegalAddress, field.TypeString, value)
+		_node.PayerLegalAddress = value
+	}
+	if value, ok := _c.mutation.PayerBillingEmail(); ok {
+		_spec.SetField(student.FieldPayerBillingEmail, field.TypeString, value)
+		_node.PayerBillingEmail = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(student.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
@@ -441,6 +749,134 @@ func (_c *StudentCreate) createSpec() (*Student, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CashReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,11 +6,19 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"langschool/ent/waitlistentry"
 	"math"
 
 	"entgo.io/ent"
@@ -22,13 +30,21 @@ import (
 // StudentQuery is the builder for querying Student entities.
 type StudentQuery struct {
 	config
-	ctx             *QueryContext
-	order           []student.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Student
-	withEnrollments *EnrollmentQuery
-	withInvoices    *InvoiceQuery
-	withPayments    *PaymentQuery
+	ctx                 *QueryContext
+	order               []student.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Student
+	withEnrollments     *EnrollmentQuery
+	withInvoices        *InvoiceQuery
+	withPayments        *PaymentQuery
+	withCashReceipts    *CashReceiptQuery
+	withPaymentPlans    *PaymentPlanQuery
+	withLateFees        *LateFeeQuery
+	withCharges         *StudentChargeQuery
+	withLessonPackages  *LessonPackageQuery
+	withExcusedAbsences *ExcusedAbsenceQuery
+	withWaitlistEntries *WaitlistEntryQuery
+	withLeads           *LeadQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +147,182 @@ func (_q *StudentQuery) QueryPayments() *PaymentQuery {
 	return query
 }
 
+// QueryCashReceipts chains the current query on the "cash_receipts" edge.
+func (_q *StudentQuery) QueryCashReceipts() *CashReceiptQuery {
+	query := (&CashReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(cashreceipt.Table, cashreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.CashReceiptsTable, student.CashReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentPlans chains the current query on the "payment_plans" edge.
+func (_q *StudentQuery) QueryPaymentPlans() *PaymentPlanQuery {
+	query := (&PaymentPlanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(paymentplan.Table, paymentplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.PaymentPlansTable, student.PaymentPlansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLateFees chains the current query on the "late_fees" edge.
+func (_q *StudentQuery) QueryLateFees() *LateFeeQuery {
+	query := (&LateFeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(latefee.Table, latefee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LateFeesTable, student.LateFeesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCharges chains the current query on the "charges" edge.
+func (_q *StudentQuery) QueryCharges() *StudentChargeQuery {
+	query := (&StudentChargeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(studentcharge.Table, studentcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.ChargesTable, student.ChargesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLessonPackages chains the current query on the "lesson_packages" edge.
+func (_q *StudentQuery) QueryLessonPackages() *LessonPackageQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(lessonpackage.Table, lessonpackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LessonPackagesTable, student.LessonPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExcusedAbsences chains the current query on the "excused_absences" edge.
+func (_q *StudentQuery) QueryExcusedAbsences() *ExcusedAbsenceQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(excusedabsence.Table, excusedabsence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.ExcusedAbsencesTable, student.ExcusedAbsencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries chains the current query on the "waitlist_entries" edge.
+func (_q *StudentQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.WaitlistEntriesTable, student.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeads chains the current query on the "leads" edge.
+func (_q *StudentQuery) QueryLeads() *LeadQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(student.Table, student.FieldID, selector),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, student.LeadsTable, student.LeadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Student entity from the query.
 // Returns a *NotFoundError when no Student was found.
 func (_q *StudentQuery) First(ctx context.Context) (*Student, error) {
@@ -318,14 +510,22 @@ func (_q *StudentQuery) Clone() *StudentQuery {
 		return nil
 	}
 	return &StudentQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]student.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Student{}, _q.predicates...),
-		withEnrollments: _q.withEnrollments.Clone(),
-		withInvoices:    _q.withInvoices.Clone(),
-		withPayments:    _q.withPayments.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]student.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Student{}, _q.predicates...),
+		withEnrollments:     _q.withEnrollments.Clone(),
+		withInvoices:        _q.withInvoices.Clone(),
+		withPayments:        _q.withPayments.Clone(),
+		withCashReceipts:    _q.withCashReceipts.Clone(),
+		withPaymentPlans:    _q.withPaymentPlans.Clone(),
+		withLateFees:        _q.withLateFees.Clone(),
+		withCharges:         _q.withCharges.Clone(),
+		withLessonPackages:  _q.withLessonPackages.Clone(),
+		withExcusedAbsences: _q.withExcusedAbsences.Clone(),
+		withWaitlistEntries: _q.withWaitlistEntries.Clone(),
+		withLeads:           _q.withLeads.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +565,94 @@ func (_q *StudentQuery) WithPayments(opts ...func(*PaymentQuery)) *StudentQuery
 	return _q
 }
 
+// WithCashReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "cash_receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithCashReceipts(opts ...func(*CashReceiptQuery)) *StudentQuery {
+	query := (&CashReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCashReceipts = query
+	return _q
+}
+
+// WithPaymentPlans tells the query-builder to eager-load the nodes that are connected to
+// the "payment_plans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithPaymentPlans(opts ...func(*PaymentPlanQuery)) *StudentQuery {
+	query := (&PaymentPlanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaymentPlans = query
+	return _q
+}
+
+// WithLateFees tells the query-builder to eager-load the nodes that are connected to
+// the "late_fees" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithLateFees(opts ...func(*LateFeeQuery)) *StudentQuery {
+	query := (&LateFeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLateFees = query
+	return _q
+}
+
+// WithCharges tells the query-builder to eager-load the nodes that are connected to
+// the "charges" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithCharges(opts ...func(*StudentChargeQuery)) *StudentQuery {
+	query := (&StudentChargeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCharges = query
+	return _q
+}
+
+// WithLessonPackages tells the query-builder to eager-load the nodes that are connected to
+// the "lesson_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithLessonPackages(opts ...func(*LessonPackageQuery)) *StudentQuery {
+	query := (&LessonPackageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLessonPackages = query
+	return _q
+}
+
+// WithExcusedAbsences tells the query-builder to eager-load the nodes that are connected to
+// the "excused_absences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithExcusedAbsences(opts ...func(*ExcusedAbsenceQuery)) *StudentQuery {
+	query := (&ExcusedAbsenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExcusedAbsences = query
+	return _q
+}
+
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *StudentQuery {
+	query := (&WaitlistEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWaitlistEntries = query
+	return _q
+}
+
+// WithLeads tells the query-builder to eager-load the nodes that are connected to
+// the "leads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StudentQuery) WithLeads(opts ...func(*LeadQuery)) *StudentQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeads = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +731,18 @@ func (_q *StudentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Stud
 	var (
 		nodes       = []*Student{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [11]bool{
 			_q.withEnrollments != nil,
 			_q.withInvoices != nil,
 			_q.withPayments != nil,
+			_q.withCashReceipts != nil,
+			_q.withPaymentPlans != nil,
+			_q.withLateFees != nil,
+			_q.withCharges != nil,
+			_q.withLessonPackages != nil,
+			_q.withExcusedAbsences != nil,
+			_q.withWaitlistEntries != nil,
+			_q.withLeads != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +784,62 @@ func (_q *StudentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Stud
 			return nil, err
 		}
 	}
+	if query := _q.withCashReceipts; query != nil {
+		if err := _q.loadCashReceipts(ctx, query, nodes,
+			func(n *Student) { n.Edges.CashReceipts = []*CashReceipt{} },
+			func(n *Student, e *CashReceipt) { n.Edges.CashReceipts = append(n.Edges.CashReceipts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaymentPlans; query != nil {
+		if err := _q.loadPaymentPlans(ctx, query, nodes,
+			func(n *Student) { n.Edges.PaymentPlans = []*PaymentPlan{} },
+			func(n *Student, e *PaymentPlan) { n.Edges.PaymentPlans = append(n.Edges.PaymentPlans, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLateFees; query != nil {
+		if err := _q.loadLateFees(ctx, query, nodes,
+			func(n *Student) { n.Edges.LateFees = []*LateFee{} },
+			func(n *Student, e *LateFee) { n.Edges.LateFees = append(n.Edges.LateFees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCharges; query != nil {
+		if err := _q.loadCharges(ctx, query, nodes,
+			func(n *Student) { n.Edges.Charges = []*StudentCharge{} },
+			func(n *Student, e *StudentCharge) { n.Edges.Charges = append(n.Edges.Charges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLessonPackages; query != nil {
+		if err := _q.loadLessonPackages(ctx, query, nodes,
+			func(n *Student) { n.Edges.LessonPackages = []*LessonPackage{} },
+			func(n *Student, e *LessonPackage) { n.Edges.LessonPackages = append(n.Edges.LessonPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExcusedAbsences; query != nil {
+		if err := _q.loadExcusedAbsences(ctx, query, nodes,
+			func(n *Student) { n.Edges.ExcusedAbsences = []*ExcusedAbsence{} },
+			func(n *Student, e *ExcusedAbsence) { n.Edges.ExcusedAbsences = append(n.Edges.ExcusedAbsences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWaitlistEntries; query != nil {
+		if err := _q.loadWaitlistEntries(ctx, query, nodes,
+			func(n *Student) { n.Edges.WaitlistEntries = []*WaitlistEntry{} },
+			func(n *Student, e *WaitlistEntry) { n.Edges.WaitlistEntries = append(n.Edges.WaitlistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeads; query != nil {
+		if err := _q.loadLeads(ctx, query, nodes,
+			func(n *Student) { n.Edges.Leads = []*Lead{} },
+			func(n *Student, e *Lead) { n.Edges.Leads = append(n.Edges.Leads, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +933,249 @@ func (_q *StudentQuery) loadPayments(ctx context.Context, query *PaymentQuery, n
 	}
 	return nil
 }
+func (_q *StudentQuery) loadCashReceipts(ctx context.Context, query *CashReceiptQuery, nodes []*Student, init func(*Student), assign func(*Student, *CashReceipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cashreceipt.FieldStudentID)
+	}
+	query.Where(predicate.CashReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.CashReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadPaymentPlans(ctx context.Context, query *PaymentPlanQuery, nodes []*Student, init func(*Student), assign func(*Student, *PaymentPlan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(paymentplan.FieldStudentID)
+	}
+	query.Where(predicate.PaymentPlan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.PaymentPlansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadLateFees(ctx context.Context, query *LateFeeQuery, nodes []*Student, init func(*Student), assign func(*Student, *LateFee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(latefee.FieldStudentID)
+	}
+	query.Where(predicate.LateFee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.LateFeesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadCharges(ctx context.Context, query *StudentChargeQuery, nodes []*Student, init func(*Student), assign func(*Student, *StudentCharge)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(studentcharge.FieldStudentID)
+	}
+	query.Where(predicate.StudentCharge(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.ChargesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadLessonPackages(ctx context.Context, query *LessonPackageQuery, nodes []*Student, init func(*Student), assign func(*Student, *LessonPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lessonpackage.FieldStudentID)
+	}
+	query.Where(predicate.LessonPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.LessonPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadExcusedAbsences(ctx context.Context, query *ExcusedAbsenceQuery, nodes []*Student, init func(*Student), assign func(*Student, *ExcusedAbsence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(excusedabsence.FieldStudentID)
+	}
+	query.Where(predicate.ExcusedAbsence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.ExcusedAbsencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadWaitlistEntries(ctx context.Context, query *WaitlistEntryQuery, nodes []*Student, init func(*Student), assign func(*Student, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldStudentID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.WaitlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StudentQuery) loadLeads(ctx context.Context, query *LeadQuery, nodes []*Student, init func(*Student), assign func(*Student, *Lead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Student)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lead.FieldStudentID)
+	}
+	query.Where(predicate.Lead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(student.LeadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StudentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "student_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "student_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *StudentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,11 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"langschool/ent/cashreceipt"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/lead"
+	"langschool/ent/lessonpackage"
 	"langschool/ent/payment"
+	"langschool/ent/paymentplan"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"langschool/ent/waitlistentry"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -184,6 +192,104 @@ func (_u *StudentUpdate) SetNillablePayerRole(v *string) *StudentUpdate {
 	return _u
 }
 
+// SetPayerPersonalCode sets the "payer_personal_code" field.
+func (_u *StudentUpdate) SetPayerPersonalCode(v string) *StudentUpdate {
+	_u.mutation.SetPayerPersonalCode(v)
+	return _u
+}
+
+// SetNillablePayerPersonalCode sets the "payer_personal_code" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerPersonalCode(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerPersonalCode(*v)
+	}
+	return _u
+}
+
+// SetPayerType sets the "payer_type" field.
+func (_u *StudentUpdate) SetPayerType(v student.PayerType) *StudentUpdate {
+	_u.mutation.SetPayerType(v)
+	return _u
+}
+
+// SetNillablePayerType sets the "payer_type" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerType(v *student.PayerType) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerType(*v)
+	}
+	return _u
+}
+
+// SetPayerCompanyName sets the "payer_company_name" field.
+func (_u *StudentUpdate) SetPayerCompanyName(v string) *StudentUpdate {
+	_u.mutation.SetPayerCompanyName(v)
+	return _u
+}
+
+// SetNillablePayerCompanyName sets the "payer_company_name" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerCompanyName(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerCompanyName(*v)
+	}
+	return _u
+}
+
+// SetPayerRegNo sets the "payer_reg_no" field.
+func (_u *StudentUpdate) SetPayerRegNo(v string) *StudentUpdate {
+	_u.mutation.SetPayerRegNo(v)
+	return _u
+}
+
+// SetNillablePayerRegNo sets the "payer_reg_no" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerRegNo(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerRegNo(*v)
+	}
+	return _u
+}
+
+// SetPayerVatNumber sets the "payer_vat_number" field.
+func (_u *StudentUpdate) SetPayerVatNumber(v string) *StudentUpdate {
+	_u.mutation.SetPayerVatNumber(v)
+	return _u
+}
+
+// SetNillablePayerVatNumber sets the "payer_vat_number" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerVatNumber(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerVatNumber(*v)
+	}
+	return _u
+}
+
+// SetPayerLegalAddress sets the "payer_legal_address" field.
+func (_u *StudentUpdate) SetPayerLegalAddress(v string) *StudentUpdate {
+	_u.mutation.SetPayerLegalAddress(v)
+	return _u
+}
+
+// SetNillablePayerLegalAddress sets the "payer_legal_address" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerLegalAddress(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerLegalAddress(*v)
+	}
+	return _u
+}
+
+// SetPayerBillingEmail sets the "payer_billing_email" field.
+func (_u *StudentUpdate) SetPayerBillingEmail(v string) *StudentUpdate {
+	_u.mutation.SetPayerBillingEmail(v)
+	return _u
+}
+
+// SetNillablePayerBillingEmail sets the "payer_billing_email" field if the given value is not nil.
+func (_u *StudentUpdate) SetNillablePayerBillingEmail(v *string) *StudentUpdate {
+	if v != nil {
+		_u.SetPayerBillingEmail(*v)
+	}
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *StudentUpdate) SetIsActive(v bool) *StudentUpdate {
 	_u.mutation.SetIsActive(v)
@@ -243,6 +349,126 @@ func (_u *StudentUpdate) AddPayments(v ...*Payment) *StudentUpdate {
 	return _u.AddPaymentIDs(ids...)
 }
 
+// AddCashReceiptIDs adds the "cash_receipts" edge to the CashReceipt entity by IDs.
+func (_u *StudentUpdate) AddCashReceiptIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddCashReceiptIDs(ids...)
+	return _u
+}
+
+// AddCashReceipts adds the "cash_receipts" edges to the CashReceipt entity.
+func (_u *StudentUpdate) AddCashReceipts(v ...*CashReceipt) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCashReceiptIDs(ids...)
+}
+
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_u *StudentUpdate) AddPaymentPlanIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddPaymentPlanIDs(ids...)
+	return _u
+}
+
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_u *StudentUpdate) AddPaymentPlans(v ...*PaymentPlan) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_u *StudentUpdate) AddLateFeeIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddLateFeeIDs(ids...)
+	return _u
+}
+
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_u *StudentUpdate) AddLateFees(v ...*LateFee) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLateFeeIDs(ids...)
+}
+
+// AddChargeIDs adds the "charges" edge to the StudentCharge entity by IDs.
+func (_u *StudentUpdate) AddChargeIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddChargeIDs(ids...)
+	return _u
+}
+
+// AddCharges adds the "charges" edges to the StudentCharge entity.
+func (_u *StudentUpdate) AddCharges(v ...*StudentCharge) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChargeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *StudentUpdate) AddLessonPackageIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddLessonPackageIDs(ids...)
+	return _u
+}
+
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *StudentUpdate) AddLessonPackages(v ...*LessonPackage) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_u *StudentUpdate) AddExcusedAbsenceIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *StudentUpdate) AddExcusedAbsences(v ...*ExcusedAbsence) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_u *StudentUpdate) AddWaitlistEntryIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *StudentUpdate) AddWaitlistEntries(v ...*WaitlistEntry) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_u *StudentUpdate) AddLeadIDs(ids ...int) *StudentUpdate {
+	_u.mutation.AddLeadIDs(ids...)
+	return _u
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_u *StudentUpdate) AddLeads(v ...*Lead) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadIDs(ids...)
+}
+
 // Mutation returns the StudentMutation object of the builder.
 func (_u *StudentUpdate) Mutation() *StudentMutation {
 	return _u.mutation
@@ -311,6 +537,174 @@ func (_u *StudentUpdate) RemovePayments(v ...*Payment) *StudentUpdate {
 	return _u.RemovePaymentIDs(ids...)
 }
 
+// ClearCashReceipts clears all "cash_receipts" edges to the CashReceipt entity.
+func (_u *StudentUpdate) ClearCashReceipts() *StudentUpdate {
+	_u.mutation.ClearCashReceipts()
+	return _u
+}
+
+// RemoveCashReceiptIDs removes the "cash_receipts" edge to CashReceipt entities by IDs.
+func (_u *StudentUpdate) RemoveCashReceiptIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveCashReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveCashReceipts removes "cash_receipts" edges to CashReceipt entities.
+func (_u *StudentUpdate) RemoveCashReceipts(v ...*CashReceipt) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCashReceiptIDs(ids...)
+}
+
+// ClearPaymentPlans clears all "payment_plans" edges to the PaymentPlan entity.
+func (_u *StudentUpdate) ClearPaymentPlans() *StudentUpdate {
+	_u.mutation.ClearPaymentPlans()
+	return _u
+}
+
+// RemovePaymentPlanIDs removes the "payment_plans" edge to PaymentPlan entities by IDs.
+func (_u *StudentUpdate) RemovePaymentPlanIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemovePaymentPlanIDs(ids...)
+	return _u
+}
+
+// RemovePaymentPlans removes "payment_plans" edges to PaymentPlan entities.
+func (_u *StudentUpdate) RemovePaymentPlans(v ...*PaymentPlan) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentPlanIDs(ids...)
+}
+
+// ClearLateFees clears all "late_fees" edges to the LateFee entity.
+func (_u *StudentUpdate) ClearLateFees() *StudentUpdate {
+	_u.mutation.ClearLateFees()
+	return _u
+}
+
+// RemoveLateFeeIDs removes the "late_fees" edge to LateFee entities by IDs.
+func (_u *StudentUpdate) RemoveLateFeeIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveLateFeeIDs(ids...)
+	return _u
+}
+
+// RemoveLateFees removes "late_fees" edges to LateFee entities.
+func (_u *StudentUpdate) RemoveLateFees(v ...*LateFee) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLateFeeIDs(ids...)
+}
+
+// ClearCharges clears all "charges" edges to the StudentCharge entity.
+func (_u *StudentUpdate) ClearCharges() *StudentUpdate {
+	_u.mutation.ClearCharges()
+	return _u
+}
+
+// RemoveChargeIDs removes the "charges" edge to StudentCharge entities by IDs.
+func (_u *StudentUpdate) RemoveChargeIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveChargeIDs(ids...)
+	return _u
+}
+
+// RemoveCharges removes "charges" edges to StudentCharge entities.
+func (_u *StudentUpdate) RemoveCharges(v ...*StudentCharge) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChargeIDs(ids...)
+}
+
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *StudentUpdate) ClearLessonPackages() *StudentUpdate {
+	_u.mutation.ClearLessonPackages()
+	return _u
+}
+
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *StudentUpdate) RemoveLessonPackageIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *StudentUpdate) RemoveLessonPackages(v ...*LessonPackage) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLessonPackageIDs(ids...)
+}
+
+// ClearExcusedAbsences clears all "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *StudentUpdate) ClearExcusedAbsences() *StudentUpdate {
+	_u.mutation.ClearExcusedAbsences()
+	return _u
+}
+
+// RemoveExcusedAbsenceIDs removes the "excused_absences" edge to ExcusedAbsence entities by IDs.
+func (_u *StudentUpdate) RemoveExcusedAbsenceIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveExcusedAbsenceIDs(ids...)
+	return _u
+}
+
+// RemoveExcusedAbsences removes "excused_absences" edges to ExcusedAbsence entities.
+func (_u *StudentUpdate) RemoveExcusedAbsences(v ...*ExcusedAbsence) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcusedAbsenceIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *StudentUpdate) ClearWaitlistEntries() *StudentUpdate {
+	_u.mutation.ClearWaitlistEntries()
+	return _u
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (_u *StudentUpdate) RemoveWaitlistEntryIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveWaitlistEntryIDs(ids...)
+	return _u
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (_u *StudentUpdate) RemoveWaitlistEntries(v ...*WaitlistEntry) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearLeads clears all "leads" edges to the Lead entity.
+func (_u *StudentUpdate) ClearLeads() *StudentUpdate {
+	_u.mutation.ClearLeads()
+	return _u
+}
+
+// RemoveLeadIDs removes the "leads" edge to Lead entities by IDs.
+func (_u *StudentUpdate) RemoveLeadIDs(ids ...int) *StudentUpdate {
+	_u.mutation.RemoveLeadIDs(ids...)
+	return _u
+}
+
+// RemoveLeads removes "leads" edges to Lead entities.
+func (_u *StudentUpdate) RemoveLeads(v ...*Lead) *StudentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *StudentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -338,7 +732,20 @@ func (_u *StudentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *StudentUpdate) check() error {
+	if v, ok := _u.mutation.PayerType(); ok {
+		if err := student.PayerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payer_type", err: fmt.Errorf(`ent: validator failed for field "Student.payer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *StudentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(student.Table, student.Columns, sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -383,6 +790,27 @@ func (_u *StudentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PayerRole(); ok {
 		_spec.SetField(student.FieldPayerRole, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PayerPersonalCode(); ok {
+		_spec.SetField(student.FieldPayerPersonalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerType(); ok {
+		_spec.SetField(student.FieldPayerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PayerCompanyName(); ok {
+		_spec.SetField(student.FieldPayerCompanyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerRegNo(); ok {
+		_spec.SetField(student.FieldPayerRegNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerVatNumber(); ok {
+		_spec.SetField(student.FieldPayerVatNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerLegalAddress(); ok {
+		_spec.SetField(student.FieldPayerLegalAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerBillingEmail(); ok {
+		_spec.SetField(student.FieldPayerBillingEmail, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(student.FieldIsActive, field.TypeBool, value)
 	}
@@ -521,304 +949,1050 @@ func (_u *StudentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{student.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.CashReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// StudentUpdateOne is the builder for updating a single Student entity.
-type StudentUpdateOne struct {
-	config
+	if nodes := _u.mutation.RemovedCashReceiptsIDs(); len(nodes) > 0 && !_u.mutation.CashReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CashReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentPlansIDs(); len(nodes) > 0 && !_u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLateFeesIDs(); len(nodes) > 0 && !_u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChargesIDs(); len(nodes) > 0 && !_u.mutation.ChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcusedAbsencesIDs(); len(nodes) > 0 && !_u.mutation.ExcusedAbsencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !_u.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadsIDs(); len(nodes) > 0 && !_u.mutation.LeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{student.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StudentUpdateOne is the builder for updating a single Student entity.
+type StudentUpdateOne struct {
+	config
 	fields   []string
 	hooks    []Hook
 	mutation *StudentMutation
 }
 
-// SetVersion sets the "version" field.
-func (_u *StudentUpdateOne) SetVersion(v int) *StudentUpdateOne {
-	_u.mutation.ResetVersion()
-	_u.mutation.SetVersion(v)
+// SetVersion sets the "version" field.
+func (_u *StudentUpdateOne) SetVersion(v int) *StudentUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableVersion(v *int) *StudentUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *StudentUpdateOne) AddVersion(v int) *StudentUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetFullName sets the "full_name" field.
+func (_u *StudentUpdateOne) SetFullName(v string) *StudentUpdateOne {
+	_u.mutation.SetFullName(v)
+	return _u
+}
+
+// SetNillableFullName sets the "full_name" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableFullName(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetFullName(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StudentUpdateOne) SetCreatedAt(v time.Time) *StudentUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableCreatedAt(v *time.Time) *StudentUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *StudentUpdateOne) ClearCreatedAt() *StudentUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetPersonalCode sets the "personal_code" field.
+func (_u *StudentUpdateOne) SetPersonalCode(v string) *StudentUpdateOne {
+	_u.mutation.SetPersonalCode(v)
+	return _u
+}
+
+// SetNillablePersonalCode sets the "personal_code" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePersonalCode(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPersonalCode(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *StudentUpdateOne) SetPhone(v string) *StudentUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePhone(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *StudentUpdateOne) SetEmail(v string) *StudentUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableEmail(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *StudentUpdateOne) SetNote(v string) *StudentUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableNote(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetIsMinor sets the "is_minor" field.
+func (_u *StudentUpdateOne) SetIsMinor(v bool) *StudentUpdateOne {
+	_u.mutation.SetIsMinor(v)
+	return _u
+}
+
+// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableIsMinor(v *bool) *StudentUpdateOne {
+	if v != nil {
+		_u.SetIsMinor(*v)
+	}
+	return _u
+}
+
+// SetPayerName sets the "payer_name" field.
+func (_u *StudentUpdateOne) SetPayerName(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerName(v)
+	return _u
+}
+
+// SetNillablePayerName sets the "payer_name" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerName(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPayerName(*v)
+	}
+	return _u
+}
+
+// SetPayerRole sets the "payer_role" field.
+func (_u *StudentUpdateOne) SetPayerRole(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerRole(v)
+	return _u
+}
+
+// SetNillablePayerRole sets the "payer_role" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerRole(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPayerRole(*v)
+	}
+	return _u
+}
+
+// SetPayerPersonalCode sets the "payer_personal_code" field.
+func (_u *StudentUpdateOne) SetPayerPersonalCode(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerPersonalCode(v)
+	return _u
+}
+
+// SetNillablePayerPersonalCode sets the "payer_personal_code" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerPersonalCode(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPayerPersonalCode(*v)
+	}
+	return _u
+}
+
+// SetPayerType sets the "payer_type" field.
+func (_u *StudentUpdateOne) SetPayerType(v student.PayerType) *StudentUpdateOne {
+	_u.mutation.SetPayerType(v)
 	return _u
 }
 
-// SetNillableVersion sets the "version" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableVersion(v *int) *StudentUpdateOne {
+// SetNillablePayerType sets the "payer_type" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerType(v *student.PayerType) *StudentUpdateOne {
 	if v != nil {
-		_u.SetVersion(*v)
+		_u.SetPayerType(*v)
 	}
 	return _u
 }
 
-// AddVersion adds value to the "version" field.
-func (_u *StudentUpdateOne) AddVersion(v int) *StudentUpdateOne {
-	_u.mutation.AddVersion(v)
+// SetPayerCompanyName sets the "payer_company_name" field.
+func (_u *StudentUpdateOne) SetPayerCompanyName(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerCompanyName(v)
 	return _u
 }
 
-// SetFullName sets the "full_name" field.
-func (_u *StudentUpdateOne) SetFullName(v string) *StudentUpdateOne {
-	_u.mutation.SetFullName(v)
+// SetNillablePayerCompanyName sets the "payer_company_name" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerCompanyName(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPayerCompanyName(*v)
+	}
 	return _u
 }
 
-// SetNillableFullName sets the "full_name" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableFullName(v *string) *StudentUpdateOne {
+// SetPayerRegNo sets the "payer_reg_no" field.
+func (_u *StudentUpdateOne) SetPayerRegNo(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerRegNo(v)
+	return _u
+}
+
+// SetNillablePayerRegNo sets the "payer_reg_no" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerRegNo(v *string) *StudentUpdateOne {
 	if v != nil {
-		_u.SetFullName(*v)
+		_u.SetPayerRegNo(*v)
 	}
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *StudentUpdateOne) SetCreatedAt(v time.Time) *StudentUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// SetPayerVatNumber sets the "payer_vat_number" field.
+func (_u *StudentUpdateOne) SetPayerVatNumber(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerVatNumber(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableCreatedAt(v *time.Time) *StudentUpdateOne {
+// SetNillablePayerVatNumber sets the "payer_vat_number" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerVatNumber(v *string) *StudentUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetPayerVatNumber(*v)
 	}
 	return _u
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (_u *StudentUpdateOne) ClearCreatedAt() *StudentUpdateOne {
-	_u.mutation.ClearCreatedAt()
+// SetPayerLegalAddress sets the "payer_legal_address" field.
+func (_u *StudentUpdateOne) SetPayerLegalAddress(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerLegalAddress(v)
 	return _u
 }
 
-// SetPersonalCode sets the "personal_code" field.
-func (_u *StudentUpdateOne) SetPersonalCode(v string) *StudentUpdateOne {
-	_u.mutation.SetPersonalCode(v)
+// SetNillablePayerLegalAddress sets the "payer_legal_address" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerLegalAddress(v *string) *StudentUpdateOne {
+	if v != nil {
+		_u.SetPayerLegalAddress(*v)
+	}
 	return _u
 }
 
-// SetNillablePersonalCode sets the "personal_code" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillablePersonalCode(v *string) *StudentUpdateOne {
+// SetPayerBillingEmail sets the "payer_billing_email" field.
+func (_u *StudentUpdateOne) SetPayerBillingEmail(v string) *StudentUpdateOne {
+	_u.mutation.SetPayerBillingEmail(v)
+	return _u
+}
+
+// SetNillablePayerBillingEmail sets the "payer_billing_email" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillablePayerBillingEmail(v *string) *StudentUpdateOne {
 	if v != nil {
-		_u.SetPersonalCode(*v)
+		_u.SetPayerBillingEmail(*v)
 	}
 	return _u
 }
 
-// SetPhone sets the "phone" field.
-func (_u *StudentUpdateOne) SetPhone(v string) *StudentUpdateOne {
-	_u.mutation.SetPhone(v)
+// SetIsActive sets the "is_active" field.
+func (_u *StudentUpdateOne) SetIsActive(v bool) *StudentUpdateOne {
+	_u.mutation.SetIsActive(v)
 	return _u
 }
 
-// SetNillablePhone sets the "phone" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillablePhone(v *string) *StudentUpdateOne {
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *StudentUpdateOne) SetNillableIsActive(v *bool) *StudentUpdateOne {
 	if v != nil {
-		_u.SetPhone(*v)
+		_u.SetIsActive(*v)
 	}
 	return _u
 }
 
-// SetEmail sets the "email" field.
-func (_u *StudentUpdateOne) SetEmail(v string) *StudentUpdateOne {
-	_u.mutation.SetEmail(v)
+// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by IDs.
+func (_u *StudentUpdateOne) AddEnrollmentIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddEnrollmentIDs(ids...)
 	return _u
 }
 
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableEmail(v *string) *StudentUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+// AddEnrollments adds the "enrollments" edges to the Enrollment entity.
+func (_u *StudentUpdateOne) AddEnrollments(v ...*Enrollment) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddEnrollmentIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *StudentUpdateOne) AddInvoiceIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddInvoiceIDs(ids...)
 	return _u
 }
 
-// SetNote sets the "note" field.
-func (_u *StudentUpdateOne) SetNote(v string) *StudentUpdateOne {
-	_u.mutation.SetNote(v)
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *StudentUpdateOne) AddInvoices(v ...*Invoice) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (_u *StudentUpdateOne) AddPaymentIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
 	return _u
 }
 
-// SetNillableNote sets the "note" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableNote(v *string) *StudentUpdateOne {
-	if v != nil {
-		_u.SetNote(*v)
+// AddPayments adds the "payments" edges to the Payment entity.
+func (_u *StudentUpdateOne) AddPayments(v ...*Payment) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// AddCashReceiptIDs adds the "cash_receipts" edge to the CashReceipt entity by IDs.
+func (_u *StudentUpdateOne) AddCashReceiptIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddCashReceiptIDs(ids...)
 	return _u
 }
 
-// SetIsMinor sets the "is_minor" field.
-func (_u *StudentUpdateOne) SetIsMinor(v bool) *StudentUpdateOne {
-	_u.mutation.SetIsMinor(v)
+// AddCashReceipts adds the "cash_receipts" edges to the CashReceipt entity.
+func (_u *StudentUpdateOne) AddCashReceipts(v ...*CashReceipt) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCashReceiptIDs(ids...)
+}
+
+// AddPaymentPlanIDs adds the "payment_plans" edge to the PaymentPlan entity by IDs.
+func (_u *StudentUpdateOne) AddPaymentPlanIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddPaymentPlanIDs(ids...)
 	return _u
 }
 
-// SetNillableIsMinor sets the "is_minor" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableIsMinor(v *bool) *StudentUpdateOne {
-	if v != nil {
-		_u.SetIsMinor(*v)
+// AddPaymentPlans adds the "payment_plans" edges to the PaymentPlan entity.
+func (_u *StudentUpdateOne) AddPaymentPlans(v ...*PaymentPlan) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddPaymentPlanIDs(ids...)
+}
+
+// AddLateFeeIDs adds the "late_fees" edge to the LateFee entity by IDs.
+func (_u *StudentUpdateOne) AddLateFeeIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddLateFeeIDs(ids...)
 	return _u
 }
 
-// SetPayerName sets the "payer_name" field.
-func (_u *StudentUpdateOne) SetPayerName(v string) *StudentUpdateOne {
-	_u.mutation.SetPayerName(v)
+// AddLateFees adds the "late_fees" edges to the LateFee entity.
+func (_u *StudentUpdateOne) AddLateFees(v ...*LateFee) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLateFeeIDs(ids...)
+}
+
+// AddChargeIDs adds the "charges" edge to the StudentCharge entity by IDs.
+func (_u *StudentUpdateOne) AddChargeIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddChargeIDs(ids...)
 	return _u
 }
 
-// SetNillablePayerName sets the "payer_name" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillablePayerName(v *string) *StudentUpdateOne {
-	if v != nil {
-		_u.SetPayerName(*v)
+// AddCharges adds the "charges" edges to the StudentCharge entity.
+func (_u *StudentUpdateOne) AddCharges(v ...*StudentCharge) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddChargeIDs(ids...)
+}
+
+// AddLessonPackageIDs adds the "lesson_packages" edge to the LessonPackage entity by IDs.
+func (_u *StudentUpdateOne) AddLessonPackageIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddLessonPackageIDs(ids...)
 	return _u
 }
 
-// SetPayerRole sets the "payer_role" field.
-func (_u *StudentUpdateOne) SetPayerRole(v string) *StudentUpdateOne {
-	_u.mutation.SetPayerRole(v)
+// AddLessonPackages adds the "lesson_packages" edges to the LessonPackage entity.
+func (_u *StudentUpdateOne) AddLessonPackages(v ...*LessonPackage) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLessonPackageIDs(ids...)
+}
+
+// AddExcusedAbsenceIDs adds the "excused_absences" edge to the ExcusedAbsence entity by IDs.
+func (_u *StudentUpdateOne) AddExcusedAbsenceIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddExcusedAbsenceIDs(ids...)
 	return _u
 }
 
-// SetNillablePayerRole sets the "payer_role" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillablePayerRole(v *string) *StudentUpdateOne {
-	if v != nil {
-		_u.SetPayerRole(*v)
+// AddExcusedAbsences adds the "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *StudentUpdateOne) AddExcusedAbsences(v ...*ExcusedAbsence) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddExcusedAbsenceIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (_u *StudentUpdateOne) AddWaitlistEntryIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddWaitlistEntryIDs(ids...)
 	return _u
 }
 
-// SetIsActive sets the "is_active" field.
-func (_u *StudentUpdateOne) SetIsActive(v bool) *StudentUpdateOne {
-	_u.mutation.SetIsActive(v)
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *StudentUpdateOne) AddWaitlistEntries(v ...*WaitlistEntry) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWaitlistEntryIDs(ids...)
+}
+
+// AddLeadIDs adds the "leads" edge to the Lead entity by IDs.
+func (_u *StudentUpdateOne) AddLeadIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.AddLeadIDs(ids...)
+	return _u
+}
+
+// AddLeads adds the "leads" edges to the Lead entity.
+func (_u *StudentUpdateOne) AddLeads(v ...*Lead) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadIDs(ids...)
+}
+
+// Mutation returns the StudentMutation object of the builder.
+func (_u *StudentUpdateOne) Mutation() *StudentMutation {
+	return _u.mutation
+}
+
+// ClearEnrollments clears all "enrollments" edges to the Enrollment entity.
+func (_u *StudentUpdateOne) ClearEnrollments() *StudentUpdateOne {
+	_u.mutation.ClearEnrollments()
+	return _u
+}
+
+// RemoveEnrollmentIDs removes the "enrollments" edge to Enrollment entities by IDs.
+func (_u *StudentUpdateOne) RemoveEnrollmentIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveEnrollmentIDs(ids...)
+	return _u
+}
+
+// RemoveEnrollments removes "enrollments" edges to Enrollment entities.
+func (_u *StudentUpdateOne) RemoveEnrollments(v ...*Enrollment) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEnrollmentIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *StudentUpdateOne) ClearInvoices() *StudentUpdateOne {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *StudentUpdateOne) RemoveInvoiceIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *StudentUpdateOne) RemoveInvoices(v ...*Invoice) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (_u *StudentUpdateOne) ClearPayments() *StudentUpdateOne {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (_u *StudentUpdateOne) RemovePaymentIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (_u *StudentUpdateOne) RemovePayments(v ...*Payment) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearCashReceipts clears all "cash_receipts" edges to the CashReceipt entity.
+func (_u *StudentUpdateOne) ClearCashReceipts() *StudentUpdateOne {
+	_u.mutation.ClearCashReceipts()
+	return _u
+}
+
+// RemoveCashReceiptIDs removes the "cash_receipts" edge to CashReceipt entities by IDs.
+func (_u *StudentUpdateOne) RemoveCashReceiptIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveCashReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveCashReceipts removes "cash_receipts" edges to CashReceipt entities.
+func (_u *StudentUpdateOne) RemoveCashReceipts(v ...*CashReceipt) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCashReceiptIDs(ids...)
+}
+
+// ClearPaymentPlans clears all "payment_plans" edges to the PaymentPlan entity.
+func (_u *StudentUpdateOne) ClearPaymentPlans() *StudentUpdateOne {
+	_u.mutation.ClearPaymentPlans()
+	return _u
+}
+
+// RemovePaymentPlanIDs removes the "payment_plans" edge to PaymentPlan entities by IDs.
+func (_u *StudentUpdateOne) RemovePaymentPlanIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemovePaymentPlanIDs(ids...)
 	return _u
 }
 
-// SetNillableIsActive sets the "is_active" field if the given value is not nil.
-func (_u *StudentUpdateOne) SetNillableIsActive(v *bool) *StudentUpdateOne {
-	if v != nil {
-		_u.SetIsActive(*v)
+// RemovePaymentPlans removes "payment_plans" edges to PaymentPlan entities.
+func (_u *StudentUpdateOne) RemovePaymentPlans(v ...*PaymentPlan) *StudentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemovePaymentPlanIDs(ids...)
+}
+
+// ClearLateFees clears all "late_fees" edges to the LateFee entity.
+func (_u *StudentUpdateOne) ClearLateFees() *StudentUpdateOne {
+	_u.mutation.ClearLateFees()
 	return _u
 }
 
-// AddEnrollmentIDs adds the "enrollments" edge to the Enrollment entity by IDs.
-func (_u *StudentUpdateOne) AddEnrollmentIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.AddEnrollmentIDs(ids...)
+// RemoveLateFeeIDs removes the "late_fees" edge to LateFee entities by IDs.
+func (_u *StudentUpdateOne) RemoveLateFeeIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveLateFeeIDs(ids...)
 	return _u
 }
 
-// AddEnrollments adds the "enrollments" edges to the Enrollment entity.
-func (_u *StudentUpdateOne) AddEnrollments(v ...*Enrollment) *StudentUpdateOne {
+// RemoveLateFees removes "late_fees" edges to LateFee entities.
+func (_u *StudentUpdateOne) RemoveLateFees(v ...*LateFee) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddEnrollmentIDs(ids...)
+	return _u.RemoveLateFeeIDs(ids...)
 }
 
-// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
-func (_u *StudentUpdateOne) AddInvoiceIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.AddInvoiceIDs(ids...)
+// ClearCharges clears all "charges" edges to the StudentCharge entity.
+func (_u *StudentUpdateOne) ClearCharges() *StudentUpdateOne {
+	_u.mutation.ClearCharges()
 	return _u
 }
 
-// AddInvoices adds the "invoices" edges to the Invoice entity.
-func (_u *StudentUpdateOne) AddInvoices(v ...*Invoice) *StudentUpdateOne {
+// RemoveChargeIDs removes the "charges" edge to StudentCharge entities by IDs.
+func (_u *StudentUpdateOne) RemoveChargeIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveChargeIDs(ids...)
+	return _u
+}
+
+// RemoveCharges removes "charges" edges to StudentCharge entities.
+func (_u *StudentUpdateOne) RemoveCharges(v ...*StudentCharge) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInvoiceIDs(ids...)
+	return _u.RemoveChargeIDs(ids...)
 }
 
-// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
-func (_u *StudentUpdateOne) AddPaymentIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.AddPaymentIDs(ids...)
+// ClearLessonPackages clears all "lesson_packages" edges to the LessonPackage entity.
+func (_u *StudentUpdateOne) ClearLessonPackages() *StudentUpdateOne {
+	_u.mutation.ClearLessonPackages()
 	return _u
 }
 
-// AddPayments adds the "payments" edges to the Payment entity.
-func (_u *StudentUpdateOne) AddPayments(v ...*Payment) *StudentUpdateOne {
+// RemoveLessonPackageIDs removes the "lesson_packages" edge to LessonPackage entities by IDs.
+func (_u *StudentUpdateOne) RemoveLessonPackageIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveLessonPackageIDs(ids...)
+	return _u
+}
+
+// RemoveLessonPackages removes "lesson_packages" edges to LessonPackage entities.
+func (_u *StudentUpdateOne) RemoveLessonPackages(v ...*LessonPackage) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPaymentIDs(ids...)
-}
-
-// Mutation returns the StudentMutation object of the builder.
-func (_u *StudentUpdateOne) Mutation() *StudentMutation {
-	return _u.mutation
+	return _u.RemoveLessonPackageIDs(ids...)
 }
 
-// ClearEnrollments clears all "enrollments" edges to the Enrollment entity.
-func (_u *StudentUpdateOne) ClearEnrollments() *StudentUpdateOne {
-	_u.mutation.ClearEnrollments()
+// ClearExcusedAbsences clears all "excused_absences" edges to the ExcusedAbsence entity.
+func (_u *StudentUpdateOne) ClearExcusedAbsences() *StudentUpdateOne {
+	_u.mutation.ClearExcusedAbsences()
 	return _u
 }
 
-// RemoveEnrollmentIDs removes the "enrollments" edge to Enrollment entities by IDs.
-func (_u *StudentUpdateOne) RemoveEnrollmentIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.RemoveEnrollmentIDs(ids...)
+// RemoveExcusedAbsenceIDs removes the "excused_absences" edge to ExcusedAbsence entities by IDs.
+func (_u *StudentUpdateOne) RemoveExcusedAbsenceIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveExcusedAbsenceIDs(ids...)
 	return _u
 }
 
-// RemoveEnrollments removes "enrollments" edges to Enrollment entities.
-func (_u *StudentUpdateOne) RemoveEnrollments(v ...*Enrollment) *StudentUpdateOne {
+// RemoveExcusedAbsences removes "excused_absences" edges to ExcusedAbsence entities.
+func (_u *StudentUpdateOne) RemoveExcusedAbsences(v ...*ExcusedAbsence) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEnrollmentIDs(ids...)
+	return _u.RemoveExcusedAbsenceIDs(ids...)
 }
 
-// ClearInvoices clears all "invoices" edges to the Invoice entity.
-func (_u *StudentUpdateOne) ClearInvoices() *StudentUpdateOne {
-	_u.mutation.ClearInvoices()
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (_u *StudentUpdateOne) ClearWaitlistEntries() *StudentUpdateOne {
+	_u.mutation.ClearWaitlistEntries()
 	return _u
 }
 
-// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
-func (_u *StudentUpdateOne) RemoveInvoiceIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.RemoveInvoiceIDs(ids...)
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (_u *StudentUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveWaitlistEntryIDs(ids...)
 	return _u
 }
 
-// RemoveInvoices removes "invoices" edges to Invoice entities.
-func (_u *StudentUpdateOne) RemoveInvoices(v ...*Invoice) *StudentUpdateOne {
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (_u *StudentUpdateOne) RemoveWaitlistEntries(v ...*WaitlistEntry) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInvoiceIDs(ids...)
+	return _u.RemoveWaitlistEntryIDs(ids...)
 }
 
-// ClearPayments clears all "payments" edges to the Payment entity.
-func (_u *StudentUpdateOne) ClearPayments() *StudentUpdateOne {
-	_u.mutation.ClearPayments()
+// ClearLeads clears all "leads" edges to the Lead entity.
+func (_u *StudentUpdateOne) ClearLeads() *StudentUpdateOne {
+	_u.mutation.ClearLeads()
 	return _u
 }
 
-// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
-func (_u *StudentUpdateOne) RemovePaymentIDs(ids ...int) *StudentUpdateOne {
-	_u.mutation.RemovePaymentIDs(ids...)
+// RemoveLeadIDs removes the "leads" edge to Lead entities by IDs.
+func (_u *StudentUpdateOne) RemoveLeadIDs(ids ...int) *StudentUpdateOne {
+	_u.mutation.RemoveLeadIDs(ids...)
 	return _u
 }
 
-// RemovePayments removes "payments" edges to Payment entities.
-func (_u *StudentUpdateOne) RemovePayments(v ...*Payment) *StudentUpdateOne {
+// RemoveLeads removes "leads" edges to Lead entities.
+func (_u *StudentUpdateOne) RemoveLeads(v ...*Lead) *StudentUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePaymentIDs(ids...)
+	return _u.RemoveLeadIDs(ids...)
 }
 
 // Where appends a list predicates to the StudentUpdate builder.
@@ -859,95 +2033,489 @@ func (_u *StudentUpdateOne) ExecX(ctx context.Context) {
 	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
-}
-
-func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err error) {
-	_spec := sqlgraph.NewUpdateSpec(student.Table, student.Columns, sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Student.id" for update`)}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StudentUpdateOne) check() error {
+	if v, ok := _u.mutation.PayerType(); ok {
+		if err := student.PayerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payer_type", err: fmt.Errorf(`ent: validator failed for field "Student.payer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(student.Table, student.Columns, sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Student.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, student.FieldID)
+		for _, f := range fields {
+			if !student.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != student.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(student.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(student.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FullName(); ok {
+		_spec.SetField(student.FieldFullName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(student.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(student.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PersonalCode(); ok {
+		_spec.SetField(student.FieldPersonalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(student.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(student.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(student.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsMinor(); ok {
+		_spec.SetField(student.FieldIsMinor, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PayerName(); ok {
+		_spec.SetField(student.FieldPayerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerRole(); ok {
+		_spec.SetField(student.FieldPayerRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerPersonalCode(); ok {
+		_spec.SetField(student.FieldPayerPersonalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerType(); ok {
+		_spec.SetField(student.FieldPayerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PayerCompanyName(); ok {
+		_spec.SetField(student.FieldPayerCompanyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerRegNo(); ok {
+		_spec.SetField(student.FieldPayerRegNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerVatNumber(); ok {
+		_spec.SetField(student.FieldPayerVatNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerLegalAddress(); ok {
+		_spec.SetField(student.FieldPayerLegalAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayerBillingEmail(); ok {
+		_spec.SetField(student.FieldPayerBillingEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(student.FieldIsActive, field.TypeBool, value)
+	}
+	if _u.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.EnrollmentsTable,
+			Columns: []string{student.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.EnrollmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.EnrollmentsTable,
+			Columns: []string{student.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrollmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.EnrollmentsTable,
+			Columns: []string{student.EnrollmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.InvoicesTable,
+			Columns: []string{student.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.InvoicesTable,
+			Columns: []string{student.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.InvoicesTable,
+			Columns: []string{student.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentsTable,
+			Columns: []string{student.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentsTable,
+			Columns: []string{student.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentsTable,
+			Columns: []string{student.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, student.FieldID)
-		for _, f := range fields {
-			if !student.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != student.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if _u.mutation.CashReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RemovedCashReceiptsIDs(); len(nodes) > 0 && !_u.mutation.CashReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Version(); ok {
-		_spec.SetField(student.FieldVersion, field.TypeInt, value)
+	if nodes := _u.mutation.CashReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.CashReceiptsTable,
+			Columns: []string{student.CashReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cashreceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.AddedVersion(); ok {
-		_spec.AddField(student.FieldVersion, field.TypeInt, value)
+	if _u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.FullName(); ok {
-		_spec.SetField(student.FieldFullName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedPaymentPlansIDs(); len(nodes) > 0 && !_u.mutation.PaymentPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(student.FieldCreatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.PaymentPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.PaymentPlansTable,
+			Columns: []string{student.PaymentPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreatedAtCleared() {
-		_spec.ClearField(student.FieldCreatedAt, field.TypeTime)
+	if _u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.PersonalCode(); ok {
-		_spec.SetField(student.FieldPersonalCode, field.TypeString, value)
+	if nodes := _u.mutation.RemovedLateFeesIDs(); len(nodes) > 0 && !_u.mutation.LateFeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Phone(); ok {
-		_spec.SetField(student.FieldPhone, field.TypeString, value)
+	if nodes := _u.mutation.LateFeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LateFeesTable,
+			Columns: []string{student.LateFeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(latefee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(student.FieldEmail, field.TypeString, value)
+	if _u.mutation.ChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Note(); ok {
-		_spec.SetField(student.FieldNote, field.TypeString, value)
+	if nodes := _u.mutation.RemovedChargesIDs(); len(nodes) > 0 && !_u.mutation.ChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IsMinor(); ok {
-		_spec.SetField(student.FieldIsMinor, field.TypeBool, value)
+	if nodes := _u.mutation.ChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.ChargesTable,
+			Columns: []string{student.ChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.PayerName(); ok {
-		_spec.SetField(student.FieldPayerName, field.TypeString, value)
+	if _u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.PayerRole(); ok {
-		_spec.SetField(student.FieldPayerRole, field.TypeString, value)
+	if nodes := _u.mutation.RemovedLessonPackagesIDs(); len(nodes) > 0 && !_u.mutation.LessonPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IsActive(); ok {
-		_spec.SetField(student.FieldIsActive, field.TypeBool, value)
+	if nodes := _u.mutation.LessonPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   student.LessonPackagesTable,
+			Columns: []string{student.LessonPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lessonpackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EnrollmentsCleared() {
+	if _u.mutation.ExcusedAbsencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.EnrollmentsTable,
-			Columns: []string{student.EnrollmentsColumn},
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEnrollmentsIDs(); len(nodes) > 0 && !_u.mutation.EnrollmentsCleared() {
+	if nodes := _u.mutation.RemovedExcusedAbsencesIDs(); len(nodes) > 0 && !_u.mutation.ExcusedAbsencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.EnrollmentsTable,
-			Columns: []string{student.EnrollmentsColumn},
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -955,15 +2523,15 @@ func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EnrollmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExcusedAbsencesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.EnrollmentsTable,
-			Columns: []string{student.EnrollmentsColumn},
+			Table:   student.ExcusedAbsencesTable,
+			Columns: []string{student.ExcusedAbsencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(enrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(excusedabsence.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -971,28 +2539,28 @@ func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvoicesCleared() {
+	if _u.mutation.WaitlistEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.InvoicesTable,
-			Columns: []string{student.InvoicesColumn},
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+	if nodes := _u.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !_u.mutation.WaitlistEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.InvoicesTable,
-			Columns: []string{student.InvoicesColumn},
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1000,15 +2568,15 @@ func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.InvoicesTable,
-			Columns: []string{student.InvoicesColumn},
+			Table:   student.WaitlistEntriesTable,
+			Columns: []string{student.WaitlistEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1016,28 +2584,28 @@ func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PaymentsCleared() {
+	if _u.mutation.LeadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.PaymentsTable,
-			Columns: []string{student.PaymentsColumn},
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+	if nodes := _u.mutation.RemovedLeadsIDs(); len(nodes) > 0 && !_u.mutation.LeadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.PaymentsTable,
-			Columns: []string{student.PaymentsColumn},
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1045,15 +2613,15 @@ func (_u *StudentUpdateOne) sqlSave(ctx context.Context) (_node *Student, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   student.PaymentsTable,
-			Columns: []string{student.PaymentsColumn},
+			Table:   student.LeadsTable,
+			Columns: []string{student.LeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
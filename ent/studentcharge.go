@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// StudentCharge is the model entity for the StudentCharge schema.
+type StudentCharge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int `json:"student_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind studentcharge.Kind `json:"kind,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// AmountCents holds the value of the "amount_cents" field.
+	AmountCents int64 `json:"amount_cents,omitempty"`
+	// VatRatePct holds the value of the "vat_rate_pct" field.
+	VatRatePct float64 `json:"vat_rate_pct,omitempty"`
+	// DueYear holds the value of the "due_year" field.
+	DueYear int `json:"due_year,omitempty"`
+	// DueMonth holds the value of the "due_month" field.
+	DueMonth int `json:"due_month,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CanceledAt holds the value of the "canceled_at" field.
+	CanceledAt *time.Time `json:"canceled_at,omitempty"`
+	// CanceledBy holds the value of the "canceled_by" field.
+	CanceledBy string `json:"canceled_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StudentChargeQuery when eager-loading is set.
+	Edges        StudentChargeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StudentChargeEdges holds the relations/edges for other nodes in the graph.
+type StudentChargeEdges struct {
+	// Student holds the value of the student edge.
+	Student *Student `json:"student,omitempty"`
+	// InvoiceLines holds the value of the invoice_lines edge.
+	InvoiceLines []*InvoiceLine `json:"invoice_lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// StudentOrErr returns the Student value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StudentChargeEdges) StudentOrErr() (*Student, error) {
+	if e.Student != nil {
+		return e.Student, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: student.Label}
+	}
+	return nil, &NotLoadedError{edge: "student"}
+}
+
+// InvoiceLinesOrErr returns the InvoiceLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudentChargeEdges) InvoiceLinesOrErr() ([]*InvoiceLine, error) {
+	if e.loadedTypes[1] {
+		return e.InvoiceLines, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice_lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StudentCharge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case studentcharge.FieldVatRatePct:
+			values[i] = new(sql.NullFloat64)
+		case studentcharge.FieldID, studentcharge.FieldStudentID, studentcharge.FieldAmountCents, studentcharge.FieldDueYear, studentcharge.FieldDueMonth:
+			values[i] = new(sql.NullInt64)
+		case studentcharge.FieldKind, studentcharge.FieldDescription, studentcharge.FieldCreatedBy, studentcharge.FieldCanceledBy:
+			values[i] = new(sql.NullString)
+		case studentcharge.FieldCreatedAt, studentcharge.FieldCanceledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StudentCharge fields.
+func (_m *StudentCharge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case studentcharge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case studentcharge.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = int(value.Int64)
+			}
+		case studentcharge.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = studentcharge.Kind(value.String)
+			}
+		case studentcharge.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case studentcharge.FieldAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_cents", values[i])
+			} else if value.Valid {
+				_m.AmountCents = value.Int64
+			}
+		case studentcharge.FieldVatRatePct:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_rate_pct", values[i])
+			} else if value.Valid {
+				_m.VatRatePct = value.Float64
+			}
+		case studentcharge.FieldDueYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_year", values[i])
+			} else if value.Valid {
+				_m.DueYear = int(value.Int64)
+			}
+		case studentcharge.FieldDueMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_month", values[i])
+			} else if value.Valid {
+				_m.DueMonth = int(value.Int64)
+			}
+		case studentcharge.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case studentcharge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case studentcharge.FieldCanceledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field canceled_at", values[i])
+			} else if value.Valid {
+				_m.CanceledAt = new(time.Time)
+				*_m.CanceledAt = value.Time
+			}
+		case studentcharge.FieldCanceledBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field canceled_by", values[i])
+			} else if value.Valid {
+				_m.CanceledBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StudentCharge.
+// This includes values selected through modifiers, order, etc.
+func (_m *StudentCharge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStudent queries the "student" edge of the StudentCharge entity.
+func (_m *StudentCharge) QueryStudent() *StudentQuery {
+	return NewStudentChargeClient(_m.config).QueryStudent(_m)
+}
+
+// QueryInvoiceLines queries the "invoice_lines" edge of the StudentCharge entity.
+func (_m *StudentCharge) QueryInvoiceLines() *InvoiceLineQuery {
+	return NewStudentChargeClient(_m.config).QueryInvoiceLines(_m)
+}
+
+// Update returns a builder for updating this StudentCharge.
+// Note that you need to call StudentCharge.Unwrap() before calling this method if this StudentCharge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StudentCharge) Update() *StudentChargeUpdateOne {
+	return NewStudentChargeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StudentCharge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StudentCharge) Unwrap() *StudentCharge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StudentCharge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StudentCharge) String() string {
+	var builder strings.Builder
+	builder.WriteString("StudentCharge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
+	builder.WriteString(", ")
+	builder.WriteString("vat_rate_pct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatRatePct))
+	builder.WriteString(", ")
+	builder.WriteString("due_year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DueYear))
+	builder.WriteString(", ")
+	builder.WriteString("due_month=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DueMonth))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CanceledAt; v != nil {
+		builder.WriteString("canceled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("canceled_by=")
+	builder.WriteString(_m.CanceledBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StudentCharges is a parsable slice of StudentCharge.
+type StudentCharges []*StudentCharge
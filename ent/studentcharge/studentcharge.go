@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package studentcharge
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the studentcharge type in the database.
+	Label = "student_charge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldAmountCents holds the string denoting the amount_cents field in the database.
+	FieldAmountCents = "amount_cents"
+	// FieldVatRatePct holds the string denoting the vat_rate_pct field in the database.
+	FieldVatRatePct = "vat_rate_pct"
+	// FieldDueYear holds the string denoting the due_year field in the database.
+	FieldDueYear = "due_year"
+	// FieldDueMonth holds the string denoting the due_month field in the database.
+	FieldDueMonth = "due_month"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCanceledAt holds the string denoting the canceled_at field in the database.
+	FieldCanceledAt = "canceled_at"
+	// FieldCanceledBy holds the string denoting the canceled_by field in the database.
+	FieldCanceledBy = "canceled_by"
+	// EdgeStudent holds the string denoting the student edge name in mutations.
+	EdgeStudent = "student"
+	// EdgeInvoiceLines holds the string denoting the invoice_lines edge name in mutations.
+	EdgeInvoiceLines = "invoice_lines"
+	// Table holds the table name of the studentcharge in the database.
+	Table = "student_charges"
+	// StudentTable is the table that holds the student relation/edge.
+	StudentTable = "student_charges"
+	// StudentInverseTable is the table name for the Student entity.
+	// It exists in this package in order to avoid circular dependency with the "student" package.
+	StudentInverseTable = "students"
+	// StudentColumn is the table column denoting the student relation/edge.
+	StudentColumn = "student_id"
+	// InvoiceLinesTable is the table that holds the invoice_lines relation/edge.
+	InvoiceLinesTable = "invoice_lines"
+	// InvoiceLinesInverseTable is the table name for the InvoiceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoiceline" package.
+	InvoiceLinesInverseTable = "invoice_lines"
+	// InvoiceLinesColumn is the table column denoting the invoice_lines relation/edge.
+	InvoiceLinesColumn = "charge_id"
+)
+
+// Columns holds all SQL columns for studentcharge fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldKind,
+	FieldDescription,
+	FieldAmountCents,
+	FieldVatRatePct,
+	FieldDueYear,
+	FieldDueMonth,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldCanceledAt,
+	FieldCanceledBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVatRatePct holds the default value on creation for the "vat_rate_pct" field.
+	DefaultVatRatePct float64
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultCanceledBy holds the default value on creation for the "canceled_by" field.
+	DefaultCanceledBy string
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindOneOff Kind = "one_off"
+	KindYearly Kind = "yearly"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindOneOff, KindYearly:
+		return nil
+	default:
+		return fmt.Errorf("studentcharge: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the StudentCharge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByAmountCents orders the results by the amount_cents field.
+func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
+}
+
+// ByVatRatePct orders the results by the vat_rate_pct field.
+func ByVatRatePct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatRatePct, opts...).ToFunc()
+}
+
+// ByDueYear orders the results by the due_year field.
+func ByDueYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueYear, opts...).ToFunc()
+}
+
+// ByDueMonth orders the results by the due_month field.
+func ByDueMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueMonth, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCanceledAt orders the results by the canceled_at field.
+func ByCanceledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanceledAt, opts...).ToFunc()
+}
+
+// ByCanceledBy orders the results by the canceled_by field.
+func ByCanceledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanceledBy, opts...).ToFunc()
+}
+
+// ByStudentField orders the results by student field.
+func ByStudentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceLinesCount orders the results by invoice_lines count.
+func ByInvoiceLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoiceLinesStep(), opts...)
+	}
+}
+
+// ByInvoiceLines orders the results by invoice_lines terms.
+func ByInvoiceLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newStudentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+	)
+}
+func newInvoiceLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvoiceLinesTable, InvoiceLinesColumn),
+	)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package studentcharge
+
+import (
+	"langschool/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldStudentID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDescription, v))
+}
+
+// AmountCents applies equality check predicate on the "amount_cents" field. It's identical to AmountCentsEQ.
+func AmountCents(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// VatRatePct applies equality check predicate on the "vat_rate_pct" field. It's identical to VatRatePctEQ.
+func VatRatePct(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// DueYear applies equality check predicate on the "due_year" field. It's identical to DueYearEQ.
+func DueYear(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDueYear, v))
+}
+
+// DueMonth applies equality check predicate on the "due_month" field. It's identical to DueMonthEQ.
+func DueMonth(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDueMonth, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CanceledAt applies equality check predicate on the "canceled_at" field. It's identical to CanceledAtEQ.
+func CanceledAt(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CanceledBy applies equality check predicate on the "canceled_by" field. It's identical to CanceledByEQ.
+func CanceledBy(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCanceledBy, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AmountCentsEQ applies the EQ predicate on the "amount_cents" field.
+func AmountCentsEQ(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldAmountCents, v))
+}
+
+// AmountCentsNEQ applies the NEQ predicate on the "amount_cents" field.
+func AmountCentsNEQ(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldAmountCents, v))
+}
+
+// AmountCentsIn applies the In predicate on the "amount_cents" field.
+func AmountCentsIn(vs ...int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsNotIn applies the NotIn predicate on the "amount_cents" field.
+func AmountCentsNotIn(vs ...int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldAmountCents, vs...))
+}
+
+// AmountCentsGT applies the GT predicate on the "amount_cents" field.
+func AmountCentsGT(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldAmountCents, v))
+}
+
+// AmountCentsGTE applies the GTE predicate on the "amount_cents" field.
+func AmountCentsGTE(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldAmountCents, v))
+}
+
+// AmountCentsLT applies the LT predicate on the "amount_cents" field.
+func AmountCentsLT(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldAmountCents, v))
+}
+
+// AmountCentsLTE applies the LTE predicate on the "amount_cents" field.
+func AmountCentsLTE(v int64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldAmountCents, v))
+}
+
+// VatRatePctEQ applies the EQ predicate on the "vat_rate_pct" field.
+func VatRatePctEQ(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctNEQ applies the NEQ predicate on the "vat_rate_pct" field.
+func VatRatePctNEQ(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldVatRatePct, v))
+}
+
+// VatRatePctIn applies the In predicate on the "vat_rate_pct" field.
+func VatRatePctIn(vs ...float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctNotIn applies the NotIn predicate on the "vat_rate_pct" field.
+func VatRatePctNotIn(vs ...float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldVatRatePct, vs...))
+}
+
+// VatRatePctGT applies the GT predicate on the "vat_rate_pct" field.
+func VatRatePctGT(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldVatRatePct, v))
+}
+
+// VatRatePctGTE applies the GTE predicate on the "vat_rate_pct" field.
+func VatRatePctGTE(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldVatRatePct, v))
+}
+
+// VatRatePctLT applies the LT predicate on the "vat_rate_pct" field.
+func VatRatePctLT(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldVatRatePct, v))
+}
+
+// VatRatePctLTE applies the LTE predicate on the "vat_rate_pct" field.
+func VatRatePctLTE(v float64) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldVatRatePct, v))
+}
+
+// DueYearEQ applies the EQ predicate on the "due_year" field.
+func DueYearEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDueYear, v))
+}
+
+// DueYearNEQ applies the NEQ predicate on the "due_year" field.
+func DueYearNEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldDueYear, v))
+}
+
+// DueYearIn applies the In predicate on the "due_year" field.
+func DueYearIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldDueYear, vs...))
+}
+
+// DueYearNotIn applies the NotIn predicate on the "due_year" field.
+func DueYearNotIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldDueYear, vs...))
+}
+
+// DueYearGT applies the GT predicate on the "due_year" field.
+func DueYearGT(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldDueYear, v))
+}
+
+// DueYearGTE applies the GTE predicate on the "due_year" field.
+func DueYearGTE(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldDueYear, v))
+}
+
+// DueYearLT applies the LT predicate on the "due_year" field.
+func DueYearLT(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldDueYear, v))
+}
+
+// DueYearLTE applies the LTE predicate on the "due_year" field.
+func DueYearLTE(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldDueYear, v))
+}
+
+// DueMonthEQ applies the EQ predicate on the "due_month" field.
+func DueMonthEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldDueMonth, v))
+}
+
+// DueMonthNEQ applies the NEQ predicate on the "due_month" field.
+func DueMonthNEQ(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldDueMonth, v))
+}
+
+// DueMonthIn applies the In predicate on the "due_month" field.
+func DueMonthIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldDueMonth, vs...))
+}
+
+// DueMonthNotIn applies the NotIn predicate on the "due_month" field.
+func DueMonthNotIn(vs ...int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldDueMonth, vs...))
+}
+
+// DueMonthGT applies the GT predicate on the "due_month" field.
+func DueMonthGT(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldDueMonth, v))
+}
+
+// DueMonthGTE applies the GTE predicate on the "due_month" field.
+func DueMonthGTE(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldDueMonth, v))
+}
+
+// DueMonthLT applies the LT predicate on the "due_month" field.
+func DueMonthLT(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldDueMonth, v))
+}
+
+// DueMonthLTE applies the LTE predicate on the "due_month" field.
+func DueMonthLTE(v int) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldDueMonth, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CanceledAtEQ applies the EQ predicate on the "canceled_at" field.
+func CanceledAtEQ(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtNEQ applies the NEQ predicate on the "canceled_at" field.
+func CanceledAtNEQ(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtIn applies the In predicate on the "canceled_at" field.
+func CanceledAtIn(vs ...time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtNotIn applies the NotIn predicate on the "canceled_at" field.
+func CanceledAtNotIn(vs ...time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtGT applies the GT predicate on the "canceled_at" field.
+func CanceledAtGT(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldCanceledAt, v))
+}
+
+// CanceledAtGTE applies the GTE predicate on the "canceled_at" field.
+func CanceledAtGTE(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldCanceledAt, v))
+}
+
+// CanceledAtLT applies the LT predicate on the "canceled_at" field.
+func CanceledAtLT(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldCanceledAt, v))
+}
+
+// CanceledAtLTE applies the LTE predicate on the "canceled_at" field.
+func CanceledAtLTE(v time.Time) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldCanceledAt, v))
+}
+
+// CanceledAtIsNil applies the IsNil predicate on the "canceled_at" field.
+func CanceledAtIsNil() predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIsNull(FieldCanceledAt))
+}
+
+// CanceledAtNotNil applies the NotNil predicate on the "canceled_at" field.
+func CanceledAtNotNil() predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotNull(FieldCanceledAt))
+}
+
+// CanceledByEQ applies the EQ predicate on the "canceled_by" field.
+func CanceledByEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEQ(FieldCanceledBy, v))
+}
+
+// CanceledByNEQ applies the NEQ predicate on the "canceled_by" field.
+func CanceledByNEQ(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNEQ(FieldCanceledBy, v))
+}
+
+// CanceledByIn applies the In predicate on the "canceled_by" field.
+func CanceledByIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldIn(FieldCanceledBy, vs...))
+}
+
+// CanceledByNotIn applies the NotIn predicate on the "canceled_by" field.
+func CanceledByNotIn(vs ...string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldNotIn(FieldCanceledBy, vs...))
+}
+
+// CanceledByGT applies the GT predicate on the "canceled_by" field.
+func CanceledByGT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGT(FieldCanceledBy, v))
+}
+
+// CanceledByGTE applies the GTE predicate on the "canceled_by" field.
+func CanceledByGTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldGTE(FieldCanceledBy, v))
+}
+
+// CanceledByLT applies the LT predicate on the "canceled_by" field.
+func CanceledByLT(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLT(FieldCanceledBy, v))
+}
+
+// CanceledByLTE applies the LTE predicate on the "canceled_by" field.
+func CanceledByLTE(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldLTE(FieldCanceledBy, v))
+}
+
+// CanceledByContains applies the Contains predicate on the "canceled_by" field.
+func CanceledByContains(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContains(FieldCanceledBy, v))
+}
+
+// CanceledByHasPrefix applies the HasPrefix predicate on the "canceled_by" field.
+func CanceledByHasPrefix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasPrefix(FieldCanceledBy, v))
+}
+
+// CanceledByHasSuffix applies the HasSuffix predicate on the "canceled_by" field.
+func CanceledByHasSuffix(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldHasSuffix(FieldCanceledBy, v))
+}
+
+// CanceledByEqualFold applies the EqualFold predicate on the "canceled_by" field.
+func CanceledByEqualFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldEqualFold(FieldCanceledBy, v))
+}
+
+// CanceledByContainsFold applies the ContainsFold predicate on the "canceled_by" field.
+func CanceledByContainsFold(v string) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.FieldContainsFold(FieldCanceledBy, v))
+}
+
+// HasStudent applies the HasEdge predicate on the "student" edge.
+func HasStudent() predicate.StudentCharge {
+	return predicate.StudentCharge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StudentTable, StudentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudentWith applies the HasEdge predicate on the "student" edge with a given conditions (other predicates).
+func HasStudentWith(preds ...predicate.Student) predicate.StudentCharge {
+	return predicate.StudentCharge(func(s *sql.Selector) {
+		step := newStudentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoiceLines applies the HasEdge predicate on the "invoice_lines" edge.
+func HasInvoiceLines() predicate.StudentCharge {
+	return predicate.StudentCharge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoiceLinesTable, InvoiceLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceLinesWith applies the HasEdge predicate on the "invoice_lines" edge with a given conditions (other predicates).
+func HasInvoiceLinesWith(preds ...predicate.InvoiceLine) predicate.StudentCharge {
+	return predicate.StudentCharge(func(s *sql.Selector) {
+		step := newInvoiceLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StudentCharge) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StudentCharge) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StudentCharge) predicate.StudentCharge {
+	return predicate.StudentCharge(sql.NotPredicates(p))
+}
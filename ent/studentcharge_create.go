@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoiceline"
+	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StudentChargeCreate is the builder for creating a StudentCharge entity.
+type StudentChargeCreate struct {
+	config
+	mutation *StudentChargeMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *StudentChargeCreate) SetStudentID(v int) *StudentChargeCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *StudentChargeCreate) SetKind(v studentcharge.Kind) *StudentChargeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *StudentChargeCreate) SetDescription(v string) *StudentChargeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_c *StudentChargeCreate) SetAmountCents(v int64) *StudentChargeCreate {
+	_c.mutation.SetAmountCents(v)
+	return _c
+}
+
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_c *StudentChargeCreate) SetVatRatePct(v float64) *StudentChargeCreate {
+	_c.mutation.SetVatRatePct(v)
+	return _c
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_c *StudentChargeCreate) SetNillableVatRatePct(v *float64) *StudentChargeCreate {
+	if v != nil {
+		_c.SetVatRatePct(*v)
+	}
+	return _c
+}
+
+// SetDueYear sets the "due_year" field.
+func (_c *StudentChargeCreate) SetDueYear(v int) *StudentChargeCreate {
+	_c.mutation.SetDueYear(v)
+	return _c
+}
+
+// SetDueMonth sets the "due_month" field.
+func (_c *StudentChargeCreate) SetDueMonth(v int) *StudentChargeCreate {
+	_c.mutation.SetDueMonth(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *StudentChargeCreate) SetCreatedBy(v string) *StudentChargeCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *StudentChargeCreate) SetNillableCreatedBy(v *string) *StudentChargeCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StudentChargeCreate) SetCreatedAt(v time.Time) *StudentChargeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StudentChargeCreate) SetNillableCreatedAt(v *time.Time) *StudentChargeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_c *StudentChargeCreate) SetCanceledAt(v time.Time) *StudentChargeCreate {
+	_c.mutation.SetCanceledAt(v)
+	return _c
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_c *StudentChargeCreate) SetNillableCanceledAt(v *time.Time) *StudentChargeCreate {
+	if v != nil {
+		_c.SetCanceledAt(*v)
+	}
+	return _c
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_c *StudentChargeCreate) SetCanceledBy(v string) *StudentChargeCreate {
+	_c.mutation.SetCanceledBy(v)
+	return _c
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_c *StudentChargeCreate) SetNillableCanceledBy(v *string) *StudentChargeCreate {
+	if v != nil {
+		_c.SetCanceledBy(*v)
+	}
+	return _c
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *StudentChargeCreate) SetStudent(v *Student) *StudentChargeCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_c *StudentChargeCreate) AddInvoiceLineIDs(ids ...int) *StudentChargeCreate {
+	_c.mutation.AddInvoiceLineIDs(ids...)
+	return _c
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_c *StudentChargeCreate) AddInvoiceLines(v ...*InvoiceLine) *StudentChargeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the StudentChargeMutation object of the builder.
+func (_c *StudentChargeCreate) Mutation() *StudentChargeMutation {
+	return _c.mutation
+}
+
+// Save creates the StudentCharge in the database.
+func (_c *StudentChargeCreate) Save(ctx context.Context) (*StudentCharge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StudentChargeCreate) SaveX(ctx context.Context) *StudentCharge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StudentChargeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StudentChargeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StudentChargeCreate) defaults() {
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		v := studentcharge.DefaultVatRatePct
+		_c.mutation.SetVatRatePct(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := studentcharge.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := studentcharge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.CanceledBy(); !ok {
+		v := studentcharge.DefaultCanceledBy
+		_c.mutation.SetCanceledBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StudentChargeCreate) check() error {
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "StudentCharge.student_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "StudentCharge.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := studentcharge.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "StudentCharge.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "StudentCharge.description"`)}
+	}
+	if _, ok := _c.mutation.AmountCents(); !ok {
+		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "StudentCharge.amount_cents"`)}
+	}
+	if _, ok := _c.mutation.VatRatePct(); !ok {
+		return &ValidationError{Name: "vat_rate_pct", err: errors.New(`ent: missing required field "StudentCharge.vat_rate_pct"`)}
+	}
+	if _, ok := _c.mutation.DueYear(); !ok {
+		return &ValidationError{Name: "due_year", err: errors.New(`ent: missing required field "StudentCharge.due_year"`)}
+	}
+	if _, ok := _c.mutation.DueMonth(); !ok {
+		return &ValidationError{Name: "due_month", err: errors.New(`ent: missing required field "StudentCharge.due_month"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "StudentCharge.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StudentCharge.created_at"`)}
+	}
+	if _, ok := _c.mutation.CanceledBy(); !ok {
+		return &ValidationError{Name: "canceled_by", err: errors.New(`ent: missing required field "StudentCharge.canceled_by"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "StudentCharge.student"`)}
+	}
+	return nil
+}
+
+func (_c *StudentChargeCreate) sqlSave(ctx context.Context) (*StudentCharge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StudentChargeCreate) createSpec() (*StudentCharge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StudentCharge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(studentcharge.Table, sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(studentcharge.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(studentcharge.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.AmountCents(); ok {
+		_spec.SetField(studentcharge.FieldAmountCents, field.TypeInt64, value)
+		_node.AmountCents = value
+	}
+	if value, ok := _c.mutation.VatRatePct(); ok {
+		_spec.SetField(studentcharge.FieldVatRatePct, field.TypeFloat64, value)
+		_node.VatRatePct = value
+	}
+	if value, ok := _c.mutation.DueYear(); ok {
+		_spec.SetField(studentcharge.FieldDueYear, field.TypeInt, value)
+		_node.DueYear = value
+	}
+	if value, ok := _c.mutation.DueMonth(); ok {
+		_spec.SetField(studentcharge.FieldDueMonth, field.TypeInt, value)
+		_node.DueMonth = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(studentcharge.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(studentcharge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CanceledAt(); ok {
+		_spec.SetField(studentcharge.FieldCanceledAt, field.TypeTime, value)
+		_node.CanceledAt = &value
+	}
+	if value, ok := _c.mutation.CanceledBy(); ok {
+		_spec.SetField(studentcharge.FieldCanceledBy, field.TypeString, value)
+		_node.CanceledBy = value
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studentcharge.StudentTable,
+			Columns: []string{studentcharge.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StudentChargeCreateBulk is the builder for creating many StudentCharge entities in bulk.
+type StudentChargeCreateBulk struct {
+	config
+	err      error
+	builders []*StudentChargeCreate
+}
+
+// Save creates the StudentCharge entities in the database.
+func (_c *StudentChargeCreateBulk) Save(ctx context.Context) ([]*StudentCharge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StudentCharge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StudentChargeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StudentChargeCreateBulk) SaveX(ctx context.Context) []*StudentCharge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StudentChargeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StudentChargeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,918 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/invoiceline"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StudentChargeUpdate is the builder for updating StudentCharge entities.
+type StudentChargeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StudentChargeMutation
+}
+
+// Where appends a list predicates to the StudentChargeUpdate builder.
+func (_u *StudentChargeUpdate) Where(ps ...predicate.StudentCharge) *StudentChargeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *StudentChargeUpdate) SetStudentID(v int) *StudentChargeUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableStudentID(v *int) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *StudentChargeUpdate) SetKind(v studentcharge.Kind) *StudentChargeUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableKind(v *studentcharge.Kind) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StudentChargeUpdate) SetDescription(v string) *StudentChargeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableDescription(v *string) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *StudentChargeUpdate) SetAmountCents(v int64) *StudentChargeUpdate {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableAmountCents(v *int64) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *StudentChargeUpdate) AddAmountCents(v int64) *StudentChargeUpdate {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *StudentChargeUpdate) SetVatRatePct(v float64) *StudentChargeUpdate {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableVatRatePct(v *float64) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *StudentChargeUpdate) AddVatRatePct(v float64) *StudentChargeUpdate {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetDueYear sets the "due_year" field.
+func (_u *StudentChargeUpdate) SetDueYear(v int) *StudentChargeUpdate {
+	_u.mutation.ResetDueYear()
+	_u.mutation.SetDueYear(v)
+	return _u
+}
+
+// SetNillableDueYear sets the "due_year" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableDueYear(v *int) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetDueYear(*v)
+	}
+	return _u
+}
+
+// AddDueYear adds value to the "due_year" field.
+func (_u *StudentChargeUpdate) AddDueYear(v int) *StudentChargeUpdate {
+	_u.mutation.AddDueYear(v)
+	return _u
+}
+
+// SetDueMonth sets the "due_month" field.
+func (_u *StudentChargeUpdate) SetDueMonth(v int) *StudentChargeUpdate {
+	_u.mutation.ResetDueMonth()
+	_u.mutation.SetDueMonth(v)
+	return _u
+}
+
+// SetNillableDueMonth sets the "due_month" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableDueMonth(v *int) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetDueMonth(*v)
+	}
+	return _u
+}
+
+// AddDueMonth adds value to the "due_month" field.
+func (_u *StudentChargeUpdate) AddDueMonth(v int) *StudentChargeUpdate {
+	_u.mutation.AddDueMonth(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StudentChargeUpdate) SetCreatedBy(v string) *StudentChargeUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableCreatedBy(v *string) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StudentChargeUpdate) SetCreatedAt(v time.Time) *StudentChargeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableCreatedAt(v *time.Time) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *StudentChargeUpdate) SetCanceledAt(v time.Time) *StudentChargeUpdate {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableCanceledAt(v *time.Time) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *StudentChargeUpdate) ClearCanceledAt() *StudentChargeUpdate {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_u *StudentChargeUpdate) SetCanceledBy(v string) *StudentChargeUpdate {
+	_u.mutation.SetCanceledBy(v)
+	return _u
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_u *StudentChargeUpdate) SetNillableCanceledBy(v *string) *StudentChargeUpdate {
+	if v != nil {
+		_u.SetCanceledBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *StudentChargeUpdate) SetStudent(v *Student) *StudentChargeUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_u *StudentChargeUpdate) AddInvoiceLineIDs(ids ...int) *StudentChargeUpdate {
+	_u.mutation.AddInvoiceLineIDs(ids...)
+	return _u
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_u *StudentChargeUpdate) AddInvoiceLines(v ...*InvoiceLine) *StudentChargeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the StudentChargeMutation object of the builder.
+func (_u *StudentChargeUpdate) Mutation() *StudentChargeMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *StudentChargeUpdate) ClearStudent() *StudentChargeUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoiceLines clears all "invoice_lines" edges to the InvoiceLine entity.
+func (_u *StudentChargeUpdate) ClearInvoiceLines() *StudentChargeUpdate {
+	_u.mutation.ClearInvoiceLines()
+	return _u
+}
+
+// RemoveInvoiceLineIDs removes the "invoice_lines" edge to InvoiceLine entities by IDs.
+func (_u *StudentChargeUpdate) RemoveInvoiceLineIDs(ids ...int) *StudentChargeUpdate {
+	_u.mutation.RemoveInvoiceLineIDs(ids...)
+	return _u
+}
+
+// RemoveInvoiceLines removes "invoice_lines" edges to InvoiceLine entities.
+func (_u *StudentChargeUpdate) RemoveInvoiceLines(v ...*InvoiceLine) *StudentChargeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StudentChargeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StudentChargeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StudentChargeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StudentChargeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StudentChargeUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := studentcharge.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "StudentCharge.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StudentCharge.student"`)
+	}
+	return nil
+}
+
+func (_u *StudentChargeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studentcharge.Table, studentcharge.Columns, sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(studentcharge.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(studentcharge.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(studentcharge.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(studentcharge.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(studentcharge.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(studentcharge.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DueYear(); ok {
+		_spec.SetField(studentcharge.FieldDueYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueYear(); ok {
+		_spec.AddField(studentcharge.FieldDueYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueMonth(); ok {
+		_spec.SetField(studentcharge.FieldDueMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueMonth(); ok {
+		_spec.AddField(studentcharge.FieldDueMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(studentcharge.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(studentcharge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(studentcharge.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(studentcharge.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledBy(); ok {
+		_spec.SetField(studentcharge.FieldCanceledBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studentcharge.StudentTable,
+			Columns: []string{studentcharge.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studentcharge.StudentTable,
+			Columns: []string{studentcharge.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoiceLinesIDs(); len(nodes) > 0 && !_u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studentcharge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StudentChargeUpdateOne is the builder for updating a single StudentCharge entity.
+type StudentChargeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StudentChargeMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *StudentChargeUpdateOne) SetStudentID(v int) *StudentChargeUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableStudentID(v *int) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *StudentChargeUpdateOne) SetKind(v studentcharge.Kind) *StudentChargeUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableKind(v *studentcharge.Kind) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StudentChargeUpdateOne) SetDescription(v string) *StudentChargeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableDescription(v *string) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (_u *StudentChargeUpdateOne) SetAmountCents(v int64) *StudentChargeUpdateOne {
+	_u.mutation.ResetAmountCents()
+	_u.mutation.SetAmountCents(v)
+	return _u
+}
+
+// SetNillableAmountCents sets the "amount_cents" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableAmountCents(v *int64) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetAmountCents(*v)
+	}
+	return _u
+}
+
+// AddAmountCents adds value to the "amount_cents" field.
+func (_u *StudentChargeUpdateOne) AddAmountCents(v int64) *StudentChargeUpdateOne {
+	_u.mutation.AddAmountCents(v)
+	return _u
+}
+
+// SetVatRatePct sets the "vat_rate_pct" field.
+func (_u *StudentChargeUpdateOne) SetVatRatePct(v float64) *StudentChargeUpdateOne {
+	_u.mutation.ResetVatRatePct()
+	_u.mutation.SetVatRatePct(v)
+	return _u
+}
+
+// SetNillableVatRatePct sets the "vat_rate_pct" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableVatRatePct(v *float64) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetVatRatePct(*v)
+	}
+	return _u
+}
+
+// AddVatRatePct adds value to the "vat_rate_pct" field.
+func (_u *StudentChargeUpdateOne) AddVatRatePct(v float64) *StudentChargeUpdateOne {
+	_u.mutation.AddVatRatePct(v)
+	return _u
+}
+
+// SetDueYear sets the "due_year" field.
+func (_u *StudentChargeUpdateOne) SetDueYear(v int) *StudentChargeUpdateOne {
+	_u.mutation.ResetDueYear()
+	_u.mutation.SetDueYear(v)
+	return _u
+}
+
+// SetNillableDueYear sets the "due_year" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableDueYear(v *int) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetDueYear(*v)
+	}
+	return _u
+}
+
+// AddDueYear adds value to the "due_year" field.
+func (_u *StudentChargeUpdateOne) AddDueYear(v int) *StudentChargeUpdateOne {
+	_u.mutation.AddDueYear(v)
+	return _u
+}
+
+// SetDueMonth sets the "due_month" field.
+func (_u *StudentChargeUpdateOne) SetDueMonth(v int) *StudentChargeUpdateOne {
+	_u.mutation.ResetDueMonth()
+	_u.mutation.SetDueMonth(v)
+	return _u
+}
+
+// SetNillableDueMonth sets the "due_month" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableDueMonth(v *int) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetDueMonth(*v)
+	}
+	return _u
+}
+
+// AddDueMonth adds value to the "due_month" field.
+func (_u *StudentChargeUpdateOne) AddDueMonth(v int) *StudentChargeUpdateOne {
+	_u.mutation.AddDueMonth(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StudentChargeUpdateOne) SetCreatedBy(v string) *StudentChargeUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableCreatedBy(v *string) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StudentChargeUpdateOne) SetCreatedAt(v time.Time) *StudentChargeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableCreatedAt(v *time.Time) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *StudentChargeUpdateOne) SetCanceledAt(v time.Time) *StudentChargeUpdateOne {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableCanceledAt(v *time.Time) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *StudentChargeUpdateOne) ClearCanceledAt() *StudentChargeUpdateOne {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCanceledBy sets the "canceled_by" field.
+func (_u *StudentChargeUpdateOne) SetCanceledBy(v string) *StudentChargeUpdateOne {
+	_u.mutation.SetCanceledBy(v)
+	return _u
+}
+
+// SetNillableCanceledBy sets the "canceled_by" field if the given value is not nil.
+func (_u *StudentChargeUpdateOne) SetNillableCanceledBy(v *string) *StudentChargeUpdateOne {
+	if v != nil {
+		_u.SetCanceledBy(*v)
+	}
+	return _u
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *StudentChargeUpdateOne) SetStudent(v *Student) *StudentChargeUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// AddInvoiceLineIDs adds the "invoice_lines" edge to the InvoiceLine entity by IDs.
+func (_u *StudentChargeUpdateOne) AddInvoiceLineIDs(ids ...int) *StudentChargeUpdateOne {
+	_u.mutation.AddInvoiceLineIDs(ids...)
+	return _u
+}
+
+// AddInvoiceLines adds the "invoice_lines" edges to the InvoiceLine entity.
+func (_u *StudentChargeUpdateOne) AddInvoiceLines(v ...*InvoiceLine) *StudentChargeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceLineIDs(ids...)
+}
+
+// Mutation returns the StudentChargeMutation object of the builder.
+func (_u *StudentChargeUpdateOne) Mutation() *StudentChargeMutation {
+	return _u.mutation
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *StudentChargeUpdateOne) ClearStudent() *StudentChargeUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// ClearInvoiceLines clears all "invoice_lines" edges to the InvoiceLine entity.
+func (_u *StudentChargeUpdateOne) ClearInvoiceLines() *StudentChargeUpdateOne {
+	_u.mutation.ClearInvoiceLines()
+	return _u
+}
+
+// RemoveInvoiceLineIDs removes the "invoice_lines" edge to InvoiceLine entities by IDs.
+func (_u *StudentChargeUpdateOne) RemoveInvoiceLineIDs(ids ...int) *StudentChargeUpdateOne {
+	_u.mutation.RemoveInvoiceLineIDs(ids...)
+	return _u
+}
+
+// RemoveInvoiceLines removes "invoice_lines" edges to InvoiceLine entities.
+func (_u *StudentChargeUpdateOne) RemoveInvoiceLines(v ...*InvoiceLine) *StudentChargeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceLineIDs(ids...)
+}
+
+// Where appends a list predicates to the StudentChargeUpdate builder.
+func (_u *StudentChargeUpdateOne) Where(ps ...predicate.StudentCharge) *StudentChargeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StudentChargeUpdateOne) Select(field string, fields ...string) *StudentChargeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StudentCharge entity.
+func (_u *StudentChargeUpdateOne) Save(ctx context.Context) (*StudentCharge, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StudentChargeUpdateOne) SaveX(ctx context.Context) *StudentCharge {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StudentChargeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StudentChargeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StudentChargeUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := studentcharge.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "StudentCharge.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StudentCharge.student"`)
+	}
+	return nil
+}
+
+func (_u *StudentChargeUpdateOne) sqlSave(ctx context.Context) (_node *StudentCharge, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studentcharge.Table, studentcharge.Columns, sqlgraph.NewFieldSpec(studentcharge.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StudentCharge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, studentcharge.FieldID)
+		for _, f := range fields {
+			if !studentcharge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != studentcharge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(studentcharge.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(studentcharge.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AmountCents(); ok {
+		_spec.SetField(studentcharge.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountCents(); ok {
+		_spec.AddField(studentcharge.FieldAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VatRatePct(); ok {
+		_spec.SetField(studentcharge.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRatePct(); ok {
+		_spec.AddField(studentcharge.FieldVatRatePct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DueYear(); ok {
+		_spec.SetField(studentcharge.FieldDueYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueYear(); ok {
+		_spec.AddField(studentcharge.FieldDueYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueMonth(); ok {
+		_spec.SetField(studentcharge.FieldDueMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueMonth(); ok {
+		_spec.AddField(studentcharge.FieldDueMonth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(studentcharge.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(studentcharge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(studentcharge.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(studentcharge.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledBy(); ok {
+		_spec.SetField(studentcharge.FieldCanceledBy, field.TypeString, value)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studentcharge.StudentTable,
+			Columns: []string{studentcharge.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studentcharge.StudentTable,
+			Columns: []string{studentcharge.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoiceLinesIDs(); len(nodes) > 0 && !_u.mutation.InvoiceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studentcharge.InvoiceLinesTable,
+			Columns: []string{studentcharge.InvoiceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StudentCharge{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studentcharge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
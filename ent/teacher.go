@@ -30,9 +30,11 @@ type Teacher struct {
 type TeacherEdges struct {
 	// Courses holds the value of the courses edge.
 	Courses []*Course `json:"courses,omitempty"`
+	// ScheduleSlots holds the value of the schedule_slots edge.
+	ScheduleSlots []*ScheduleSlot `json:"schedule_slots,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // CoursesOrErr returns the Courses value or an error if the edge
@@ -44,6 +46,15 @@ func (e TeacherEdges) CoursesOrErr() ([]*Course, error) {
 	return nil, &NotLoadedError{edge: "courses"}
 }
 
+// ScheduleSlotsOrErr returns the ScheduleSlots value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeacherEdges) ScheduleSlotsOrErr() ([]*ScheduleSlot, error) {
+	if e.loadedTypes[1] {
+		return e.ScheduleSlots, nil
+	}
+	return nil, &NotLoadedError{edge: "schedule_slots"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Teacher) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -106,6 +117,11 @@ func (_m *Teacher) QueryCourses() *CourseQuery {
 	return NewTeacherClient(_m.config).QueryCourses(_m)
 }
 
+// QueryScheduleSlots queries the "schedule_slots" edge of the Teacher entity.
+func (_m *Teacher) QueryScheduleSlots() *ScheduleSlotQuery {
+	return NewTeacherClient(_m.config).QueryScheduleSlots(_m)
+}
+
 // Update returns a builder for updating this Teacher.
 // Note that you need to call Teacher.Unwrap() before calling this method if this Teacher
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -18,6 +18,8 @@ const (
 	FieldIsActive = "is_active"
 	// EdgeCourses holds the string denoting the courses edge name in mutations.
 	EdgeCourses = "courses"
+	// EdgeScheduleSlots holds the string denoting the schedule_slots edge name in mutations.
+	EdgeScheduleSlots = "schedule_slots"
 	// Table holds the table name of the teacher in the database.
 	Table = "teachers"
 	// CoursesTable is the table that holds the courses relation/edge.
@@ -27,6 +29,13 @@ const (
 	CoursesInverseTable = "courses"
 	// CoursesColumn is the table column denoting the courses relation/edge.
 	CoursesColumn = "teacher_id"
+	// ScheduleSlotsTable is the table that holds the schedule_slots relation/edge.
+	ScheduleSlotsTable = "schedule_slots"
+	// ScheduleSlotsInverseTable is the table name for the ScheduleSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduleslot" package.
+	ScheduleSlotsInverseTable = "schedule_slots"
+	// ScheduleSlotsColumn is the table column denoting the schedule_slots relation/edge.
+	ScheduleSlotsColumn = "teacher_id"
 )
 
 // Columns holds all SQL columns for teacher fields.
@@ -82,6 +91,20 @@ func ByCourses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCoursesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByScheduleSlotsCount orders the results by schedule_slots count.
+func ByScheduleSlotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduleSlotsStep(), opts...)
+	}
+}
+
+// ByScheduleSlots orders the results by schedule_slots terms.
+func ByScheduleSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduleSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCoursesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -89,3 +112,10 @@ func newCoursesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CoursesTable, CoursesColumn),
 	)
 }
+func newScheduleSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduleSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduleSlotsTable, ScheduleSlotsColumn),
+	)
+}
@@ -162,6 +162,29 @@ func HasCoursesWith(preds ...predicate.Course) predicate.Teacher {
 	})
 }
 
+// HasScheduleSlots applies the HasEdge predicate on the "schedule_slots" edge.
+func HasScheduleSlots() predicate.Teacher {
+	return predicate.Teacher(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduleSlotsTable, ScheduleSlotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleSlotsWith applies the HasEdge predicate on the "schedule_slots" edge with a given conditions (other predicates).
+func HasScheduleSlotsWith(preds ...predicate.ScheduleSlot) predicate.Teacher {
+	return predicate.Teacher(func(s *sql.Selector) {
+		step := newScheduleSlotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Teacher) predicate.Teacher {
 	return predicate.Teacher(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"langschool/ent/course"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -55,6 +56,21 @@ func (_c *TeacherCreate) AddCourses(v ...*Course) *TeacherCreate {
 	return _c.AddCourseIDs(ids...)
 }
 
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_c *TeacherCreate) AddScheduleSlotIDs(ids ...int) *TeacherCreate {
+	_c.mutation.AddScheduleSlotIDs(ids...)
+	return _c
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_c *TeacherCreate) AddScheduleSlots(v ...*ScheduleSlot) *TeacherCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the TeacherMutation object of the builder.
 func (_c *TeacherCreate) Mutation() *TeacherMutation {
 	return _c.mutation
@@ -154,6 +170,22 @@ func (_c *TeacherCreate) createSpec() (*Teacher, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
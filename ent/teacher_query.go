@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"langschool/ent/course"
 	"langschool/ent/predicate"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
 	"math"
 
@@ -20,11 +21,12 @@ import (
 // TeacherQuery is the builder for querying Teacher entities.
 type TeacherQuery struct {
 	config
-	ctx         *QueryContext
-	order       []teacher.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Teacher
-	withCourses *CourseQuery
+	ctx               *QueryContext
+	order             []teacher.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Teacher
+	withCourses       *CourseQuery
+	withScheduleSlots *ScheduleSlotQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *TeacherQuery) QueryCourses() *CourseQuery {
 	return query
 }
 
+// QueryScheduleSlots chains the current query on the "schedule_slots" edge.
+func (_q *TeacherQuery) QueryScheduleSlots() *ScheduleSlotQuery {
+	query := (&ScheduleSlotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teacher.Table, teacher.FieldID, selector),
+			sqlgraph.To(scheduleslot.Table, scheduleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, teacher.ScheduleSlotsTable, teacher.ScheduleSlotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Teacher entity from the query.
 // Returns a *NotFoundError when no Teacher was found.
 func (_q *TeacherQuery) First(ctx context.Context) (*Teacher, error) {
@@ -270,12 +294,13 @@ func (_q *TeacherQuery) Clone() *TeacherQuery {
 		return nil
 	}
 	return &TeacherQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]teacher.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Teacher{}, _q.predicates...),
-		withCourses: _q.withCourses.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]teacher.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Teacher{}, _q.predicates...),
+		withCourses:       _q.withCourses.Clone(),
+		withScheduleSlots: _q.withScheduleSlots.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *TeacherQuery) WithCourses(opts ...func(*CourseQuery)) *TeacherQuery {
 	return _q
 }
 
+// WithScheduleSlots tells the query-builder to eager-load the nodes that are connected to
+// the "schedule_slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TeacherQuery) WithScheduleSlots(opts ...func(*ScheduleSlotQuery)) *TeacherQuery {
+	query := (&ScheduleSlotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduleSlots = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *TeacherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Teac
 	var (
 		nodes       = []*Teacher{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withCourses != nil,
+			_q.withScheduleSlots != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (_q *TeacherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Teac
 			return nil, err
 		}
 	}
+	if query := _q.withScheduleSlots; query != nil {
+		if err := _q.loadScheduleSlots(ctx, query, nodes,
+			func(n *Teacher) { n.Edges.ScheduleSlots = []*ScheduleSlot{} },
+			func(n *Teacher, e *ScheduleSlot) { n.Edges.ScheduleSlots = append(n.Edges.ScheduleSlots, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +480,39 @@ func (_q *TeacherQuery) loadCourses(ctx context.Context, query *CourseQuery, nod
 	}
 	return nil
 }
+func (_q *TeacherQuery) loadScheduleSlots(ctx context.Context, query *ScheduleSlotQuery, nodes []*Teacher, init func(*Teacher), assign func(*Teacher, *ScheduleSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Teacher)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduleslot.FieldTeacherID)
+	}
+	query.Where(predicate.ScheduleSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(teacher.ScheduleSlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeacherID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "teacher_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "teacher_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TeacherQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"langschool/ent/course"
 	"langschool/ent/predicate"
+	"langschool/ent/scheduleslot"
 	"langschool/ent/teacher"
 
 	"entgo.io/ent/dialect/sql"
@@ -71,6 +72,21 @@ func (_u *TeacherUpdate) AddCourses(v ...*Course) *TeacherUpdate {
 	return _u.AddCourseIDs(ids...)
 }
 
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *TeacherUpdate) AddScheduleSlotIDs(ids ...int) *TeacherUpdate {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *TeacherUpdate) AddScheduleSlots(v ...*ScheduleSlot) *TeacherUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the TeacherMutation object of the builder.
 func (_u *TeacherUpdate) Mutation() *TeacherMutation {
 	return _u.mutation
@@ -97,6 +113,27 @@ func (_u *TeacherUpdate) RemoveCourses(v ...*Course) *TeacherUpdate {
 	return _u.RemoveCourseIDs(ids...)
 }
 
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *TeacherUpdate) ClearScheduleSlots() *TeacherUpdate {
+	_u.mutation.ClearScheduleSlots()
+	return _u
+}
+
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *TeacherUpdate) RemoveScheduleSlotIDs(ids ...int) *TeacherUpdate {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *TeacherUpdate) RemoveScheduleSlots(v ...*ScheduleSlot) *TeacherUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduleSlotIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TeacherUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -184,6 +221,51 @@ func (_u *TeacherUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{teacher.Label}
@@ -247,6 +329,21 @@ func (_u *TeacherUpdateOne) AddCourses(v ...*Course) *TeacherUpdateOne {
 	return _u.AddCourseIDs(ids...)
 }
 
+// AddScheduleSlotIDs adds the "schedule_slots" edge to the ScheduleSlot entity by IDs.
+func (_u *TeacherUpdateOne) AddScheduleSlotIDs(ids ...int) *TeacherUpdateOne {
+	_u.mutation.AddScheduleSlotIDs(ids...)
+	return _u
+}
+
+// AddScheduleSlots adds the "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *TeacherUpdateOne) AddScheduleSlots(v ...*ScheduleSlot) *TeacherUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduleSlotIDs(ids...)
+}
+
 // Mutation returns the TeacherMutation object of the builder.
 func (_u *TeacherUpdateOne) Mutation() *TeacherMutation {
 	return _u.mutation
@@ -273,6 +370,27 @@ func (_u *TeacherUpdateOne) RemoveCourses(v ...*Course) *TeacherUpdateOne {
 	return _u.RemoveCourseIDs(ids...)
 }
 
+// ClearScheduleSlots clears all "schedule_slots" edges to the ScheduleSlot entity.
+func (_u *TeacherUpdateOne) ClearScheduleSlots() *TeacherUpdateOne {
+	_u.mutation.ClearScheduleSlots()
+	return _u
+}
+
+// RemoveScheduleSlotIDs removes the "schedule_slots" edge to ScheduleSlot entities by IDs.
+func (_u *TeacherUpdateOne) RemoveScheduleSlotIDs(ids ...int) *TeacherUpdateOne {
+	_u.mutation.RemoveScheduleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveScheduleSlots removes "schedule_slots" edges to ScheduleSlot entities.
+func (_u *TeacherUpdateOne) RemoveScheduleSlots(v ...*ScheduleSlot) *TeacherUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduleSlotIDs(ids...)
+}
+
 // Where appends a list predicates to the TeacherUpdate builder.
 func (_u *TeacherUpdateOne) Where(ps ...predicate.Teacher) *TeacherUpdateOne {
 	_u.mutation.Where(ps...)
@@ -390,6 +508,51 @@ func (_u *TeacherUpdateOne) sqlSave(ctx context.Context) (_node *Teacher, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduleSlotsIDs(); len(nodes) > 0 && !_u.mutation.ScheduleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teacher.ScheduleSlotsTable,
+			Columns: []string{teacher.ScheduleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Teacher{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
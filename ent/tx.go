@@ -16,26 +16,62 @@ type Tx struct {
 	AttendanceMonth *AttendanceMonthClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BillingTerm is the client for interacting with the BillingTerm builders.
+	BillingTerm *BillingTermClient
+	// CashMovement is the client for interacting with the CashMovement builders.
+	CashMovement *CashMovementClient
+	// CashReceipt is the client for interacting with the CashReceipt builders.
+	CashReceipt *CashReceiptClient
+	// CashSession is the client for interacting with the CashSession builders.
+	CashSession *CashSessionClient
 	// Course is the client for interacting with the Course builders.
 	Course *CourseClient
 	// CourseMonthStat is the client for interacting with the CourseMonthStat builders.
 	CourseMonthStat *CourseMonthStatClient
+	// CoursePrice is the client for interacting with the CoursePrice builders.
+	CoursePrice *CoursePriceClient
 	// Enrollment is the client for interacting with the Enrollment builders.
 	Enrollment *EnrollmentClient
+	// EnrollmentPause is the client for interacting with the EnrollmentPause builders.
+	EnrollmentPause *EnrollmentPauseClient
+	// EnrollmentPrice is the client for interacting with the EnrollmentPrice builders.
+	EnrollmentPrice *EnrollmentPriceClient
+	// ExcusedAbsence is the client for interacting with the ExcusedAbsence builders.
+	ExcusedAbsence *ExcusedAbsenceClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// InvoiceLine is the client for interacting with the InvoiceLine builders.
 	InvoiceLine *InvoiceLineClient
+	// LateFee is the client for interacting with the LateFee builders.
+	LateFee *LateFeeClient
+	// Lead is the client for interacting with the Lead builders.
+	Lead *LeadClient
+	// LessonPackage is the client for interacting with the LessonPackage builders.
+	LessonPackage *LessonPackageClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// PaymentPlan is the client for interacting with the PaymentPlan builders.
+	PaymentPlan *PaymentPlanClient
+	// PaymentPlanInstalment is the client for interacting with the PaymentPlanInstalment builders.
+	PaymentPlanInstalment *PaymentPlanInstalmentClient
+	// Room is the client for interacting with the Room builders.
+	Room *RoomClient
+	// ScheduleSlot is the client for interacting with the ScheduleSlot builders.
+	ScheduleSlot *ScheduleSlotClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
 	// Student is the client for interacting with the Student builders.
 	Student *StudentClient
+	// StudentCharge is the client for interacting with the StudentCharge builders.
+	StudentCharge *StudentChargeClient
 	// Teacher is the client for interacting with the Teacher builders.
 	Teacher *TeacherClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 	// WebSession is the client for interacting with the WebSession builders.
 	WebSession *WebSessionClient
 
@@ -171,16 +207,34 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AttendanceMonth = NewAttendanceMonthClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BillingTerm = NewBillingTermClient(tx.config)
+	tx.CashMovement = NewCashMovementClient(tx.config)
+	tx.CashReceipt = NewCashReceiptClient(tx.config)
+	tx.CashSession = NewCashSessionClient(tx.config)
 	tx.Course = NewCourseClient(tx.config)
 	tx.CourseMonthStat = NewCourseMonthStatClient(tx.config)
+	tx.CoursePrice = NewCoursePriceClient(tx.config)
 	tx.Enrollment = NewEnrollmentClient(tx.config)
+	tx.EnrollmentPause = NewEnrollmentPauseClient(tx.config)
+	tx.EnrollmentPrice = NewEnrollmentPriceClient(tx.config)
+	tx.ExcusedAbsence = NewExcusedAbsenceClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
 	tx.Invoice = NewInvoiceClient(tx.config)
 	tx.InvoiceLine = NewInvoiceLineClient(tx.config)
+	tx.LateFee = NewLateFeeClient(tx.config)
+	tx.Lead = NewLeadClient(tx.config)
+	tx.LessonPackage = NewLessonPackageClient(tx.config)
 	tx.Payment = NewPaymentClient(tx.config)
+	tx.PaymentPlan = NewPaymentPlanClient(tx.config)
+	tx.PaymentPlanInstalment = NewPaymentPlanInstalmentClient(tx.config)
+	tx.Room = NewRoomClient(tx.config)
+	tx.ScheduleSlot = NewScheduleSlotClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
 	tx.Student = NewStudentClient(tx.config)
+	tx.StudentCharge = NewStudentChargeClient(tx.config)
 	tx.Teacher = NewTeacherClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
 	tx.WebSession = NewWebSessionClient(tx.config)
 }
 
@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/student"
+	"langschool/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryCreate is the builder for creating a WaitlistEntry entity.
+type WaitlistEntryCreate struct {
+	config
+	mutation *WaitlistEntryMutation
+	hooks    []Hook
+}
+
+// SetCourseID sets the "course_id" field.
+func (_c *WaitlistEntryCreate) SetCourseID(v int) *WaitlistEntryCreate {
+	_c.mutation.SetCourseID(v)
+	return _c
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *WaitlistEntryCreate) SetStudentID(v int) *WaitlistEntryCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetRank sets the "rank" field.
+func (_c *WaitlistEntryCreate) SetRank(v int) *WaitlistEntryCreate {
+	_c.mutation.SetRank(v)
+	return _c
+}
+
+// SetBillingMode sets the "billing_mode" field.
+func (_c *WaitlistEntryCreate) SetBillingMode(v waitlistentry.BillingMode) *WaitlistEntryCreate {
+	_c.mutation.SetBillingMode(v)
+	return _c
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableBillingMode(v *waitlistentry.BillingMode) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetBillingMode(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *WaitlistEntryCreate) SetNote(v string) *WaitlistEntryCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableNote(v *string) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WaitlistEntryCreate) SetStatus(v waitlistentry.Status) *WaitlistEntryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_c *WaitlistEntryCreate) SetOfferedAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetOfferedAt(v)
+	return _c
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableOfferedAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetOfferedAt(*v)
+	}
+	return _c
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_c *WaitlistEntryCreate) SetOfferExpiresAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetOfferExpiresAt(v)
+	return _c
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetOfferExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetOfferEmailedTo sets the "offer_emailed_to" field.
+func (_c *WaitlistEntryCreate) SetOfferEmailedTo(v string) *WaitlistEntryCreate {
+	_c.mutation.SetOfferEmailedTo(v)
+	return _c
+}
+
+// SetNillableOfferEmailedTo sets the "offer_emailed_to" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableOfferEmailedTo(v *string) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetOfferEmailedTo(*v)
+	}
+	return _c
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_c *WaitlistEntryCreate) SetEnrollmentID(v int) *WaitlistEntryCreate {
+	_c.mutation.SetEnrollmentID(v)
+	return _c
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableEnrollmentID(v *int) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetEnrollmentID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *WaitlistEntryCreate) SetCreatedBy(v string) *WaitlistEntryCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableCreatedBy(v *string) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WaitlistEntryCreate) SetCreatedAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableCreatedAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_c *WaitlistEntryCreate) SetCourse(v *Course) *WaitlistEntryCreate {
+	return _c.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_c *WaitlistEntryCreate) SetStudent(v *Student) *WaitlistEntryCreate {
+	return _c.SetStudentID(v.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_c *WaitlistEntryCreate) Mutation() *WaitlistEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the WaitlistEntry in the database.
+func (_c *WaitlistEntryCreate) Save(ctx context.Context) (*WaitlistEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WaitlistEntryCreate) SaveX(ctx context.Context) *WaitlistEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WaitlistEntryCreate) defaults() {
+	if _, ok := _c.mutation.BillingMode(); !ok {
+		v := waitlistentry.DefaultBillingMode
+		_c.mutation.SetBillingMode(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := waitlistentry.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := waitlistentry.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.OfferEmailedTo(); !ok {
+		v := waitlistentry.DefaultOfferEmailedTo
+		_c.mutation.SetOfferEmailedTo(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := waitlistentry.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := waitlistentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WaitlistEntryCreate) check() error {
+	if _, ok := _c.mutation.CourseID(); !ok {
+		return &ValidationError{Name: "course_id", err: errors.New(`ent: missing required field "WaitlistEntry.course_id"`)}
+	}
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "WaitlistEntry.student_id"`)}
+	}
+	if _, ok := _c.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "WaitlistEntry.rank"`)}
+	}
+	if _, ok := _c.mutation.BillingMode(); !ok {
+		return &ValidationError{Name: "billing_mode", err: errors.New(`ent: missing required field "WaitlistEntry.billing_mode"`)}
+	}
+	if v, ok := _c.mutation.BillingMode(); ok {
+		if err := waitlistentry.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.billing_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "WaitlistEntry.note"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaitlistEntry.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OfferEmailedTo(); !ok {
+		return &ValidationError{Name: "offer_emailed_to", err: errors.New(`ent: missing required field "WaitlistEntry.offer_emailed_to"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "WaitlistEntry.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistEntry.created_at"`)}
+	}
+	if len(_c.mutation.CourseIDs()) == 0 {
+		return &ValidationError{Name: "course", err: errors.New(`ent: missing required edge "WaitlistEntry.course"`)}
+	}
+	if len(_c.mutation.StudentIDs()) == 0 {
+		return &ValidationError{Name: "student", err: errors.New(`ent: missing required edge "WaitlistEntry.student"`)}
+	}
+	return nil
+}
+
+func (_c *WaitlistEntryCreate) sqlSave(ctx context.Context) (*WaitlistEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WaitlistEntryCreate) createSpec() (*WaitlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistentry.Table, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Rank(); ok {
+		_spec.SetField(waitlistentry.FieldRank, field.TypeInt, value)
+		_node.Rank = value
+	}
+	if value, ok := _c.mutation.BillingMode(); ok {
+		_spec.SetField(waitlistentry.FieldBillingMode, field.TypeEnum, value)
+		_node.BillingMode = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(waitlistentry.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+		_node.OfferedAt = &value
+	}
+	if value, ok := _c.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+		_node.OfferExpiresAt = &value
+	}
+	if value, ok := _c.mutation.OfferEmailedTo(); ok {
+		_spec.SetField(waitlistentry.FieldOfferEmailedTo, field.TypeString, value)
+		_node.OfferEmailedTo = value
+	}
+	if value, ok := _c.mutation.EnrollmentID(); ok {
+		_spec.SetField(waitlistentry.FieldEnrollmentID, field.TypeInt, value)
+		_node.EnrollmentID = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.CourseTable,
+			Columns: []string{waitlistentry.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CourseID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.StudentTable,
+			Columns: []string{waitlistentry.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StudentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WaitlistEntryCreateBulk is the builder for creating many WaitlistEntry entities in bulk.
+type WaitlistEntryCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistEntryCreate
+}
+
+// Save creates the WaitlistEntry entities in the database.
+func (_c *WaitlistEntryCreateBulk) Save(ctx context.Context) ([]*WaitlistEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WaitlistEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WaitlistEntryCreateBulk) SaveX(ctx context.Context) []*WaitlistEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,876 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"langschool/ent/course"
+	"langschool/ent/predicate"
+	"langschool/ent/student"
+	"langschool/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *WaitlistEntryUpdate) SetCourseID(v int) *WaitlistEntryUpdate {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableCourseID(v *int) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *WaitlistEntryUpdate) SetStudentID(v int) *WaitlistEntryUpdate {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableStudentID(v *int) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *WaitlistEntryUpdate) SetRank(v int) *WaitlistEntryUpdate {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableRank(v *int) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *WaitlistEntryUpdate) AddRank(v int) *WaitlistEntryUpdate {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// SetBillingMode sets the "billing_mode" field.
+func (_u *WaitlistEntryUpdate) SetBillingMode(v waitlistentry.BillingMode) *WaitlistEntryUpdate {
+	_u.mutation.SetBillingMode(v)
+	return _u
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableBillingMode(v *waitlistentry.BillingMode) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetBillingMode(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WaitlistEntryUpdate) SetNote(v string) *WaitlistEntryUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableNote(v *string) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdate) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_u *WaitlistEntryUpdate) SetOfferedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetOfferedAt(v)
+	return _u
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableOfferedAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetOfferedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (_u *WaitlistEntryUpdate) ClearOfferedAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearOfferedAt()
+	return _u
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdate) SetOfferExpiresAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetOfferExpiresAt(v)
+	return _u
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetOfferExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdate) ClearOfferExpiresAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearOfferExpiresAt()
+	return _u
+}
+
+// SetOfferEmailedTo sets the "offer_emailed_to" field.
+func (_u *WaitlistEntryUpdate) SetOfferEmailedTo(v string) *WaitlistEntryUpdate {
+	_u.mutation.SetOfferEmailedTo(v)
+	return _u
+}
+
+// SetNillableOfferEmailedTo sets the "offer_emailed_to" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableOfferEmailedTo(v *string) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetOfferEmailedTo(*v)
+	}
+	return _u
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *WaitlistEntryUpdate) SetEnrollmentID(v int) *WaitlistEntryUpdate {
+	_u.mutation.ResetEnrollmentID()
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableEnrollmentID(v *int) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// AddEnrollmentID adds value to the "enrollment_id" field.
+func (_u *WaitlistEntryUpdate) AddEnrollmentID(v int) *WaitlistEntryUpdate {
+	_u.mutation.AddEnrollmentID(v)
+	return _u
+}
+
+// ClearEnrollmentID clears the value of the "enrollment_id" field.
+func (_u *WaitlistEntryUpdate) ClearEnrollmentID() *WaitlistEntryUpdate {
+	_u.mutation.ClearEnrollmentID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *WaitlistEntryUpdate) SetCreatedBy(v string) *WaitlistEntryUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableCreatedBy(v *string) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WaitlistEntryUpdate) SetCreatedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableCreatedAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *WaitlistEntryUpdate) SetCourse(v *Course) *WaitlistEntryUpdate {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *WaitlistEntryUpdate) SetStudent(v *Student) *WaitlistEntryUpdate {
+	return _u.SetStudentID(v.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *WaitlistEntryUpdate) ClearCourse() *WaitlistEntryUpdate {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *WaitlistEntryUpdate) ClearStudent() *WaitlistEntryUpdate {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdate) check() error {
+	if v, ok := _u.mutation.BillingMode(); ok {
+		if err := waitlistentry.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.course"`)
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.student"`)
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(waitlistentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(waitlistentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BillingMode(); ok {
+		_spec.SetField(waitlistentry.FieldBillingMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(waitlistentry.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferEmailedTo(); ok {
+		_spec.SetField(waitlistentry.FieldOfferEmailedTo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnrollmentID(); ok {
+		_spec.SetField(waitlistentry.FieldEnrollmentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnrollmentID(); ok {
+		_spec.AddField(waitlistentry.FieldEnrollmentID, field.TypeInt, value)
+	}
+	if _u.mutation.EnrollmentIDCleared() {
+		_spec.ClearField(waitlistentry.FieldEnrollmentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.CourseTable,
+			Columns: []string{waitlistentry.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.CourseTable,
+			Columns: []string{waitlistentry.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.StudentTable,
+			Columns: []string{waitlistentry.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.StudentTable,
+			Columns: []string{waitlistentry.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetCourseID sets the "course_id" field.
+func (_u *WaitlistEntryUpdateOne) SetCourseID(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.SetCourseID(v)
+	return _u
+}
+
+// SetNillableCourseID sets the "course_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableCourseID(v *int) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetCourseID(*v)
+	}
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *WaitlistEntryUpdateOne) SetStudentID(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableStudentID(v *int) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *WaitlistEntryUpdateOne) SetRank(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableRank(v *int) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *WaitlistEntryUpdateOne) AddRank(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// SetBillingMode sets the "billing_mode" field.
+func (_u *WaitlistEntryUpdateOne) SetBillingMode(v waitlistentry.BillingMode) *WaitlistEntryUpdateOne {
+	_u.mutation.SetBillingMode(v)
+	return _u
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableBillingMode(v *waitlistentry.BillingMode) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetBillingMode(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WaitlistEntryUpdateOne) SetNote(v string) *WaitlistEntryUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableNote(v *string) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdateOne) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_u *WaitlistEntryUpdateOne) SetOfferedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetOfferedAt(v)
+	return _u
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableOfferedAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetOfferedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearOfferedAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearOfferedAt()
+	return _u
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdateOne) SetOfferExpiresAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetOfferExpiresAt(v)
+	return _u
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetOfferExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearOfferExpiresAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearOfferExpiresAt()
+	return _u
+}
+
+// SetOfferEmailedTo sets the "offer_emailed_to" field.
+func (_u *WaitlistEntryUpdateOne) SetOfferEmailedTo(v string) *WaitlistEntryUpdateOne {
+	_u.mutation.SetOfferEmailedTo(v)
+	return _u
+}
+
+// SetNillableOfferEmailedTo sets the "offer_emailed_to" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableOfferEmailedTo(v *string) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetOfferEmailedTo(*v)
+	}
+	return _u
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (_u *WaitlistEntryUpdateOne) SetEnrollmentID(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.ResetEnrollmentID()
+	_u.mutation.SetEnrollmentID(v)
+	return _u
+}
+
+// SetNillableEnrollmentID sets the "enrollment_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableEnrollmentID(v *int) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetEnrollmentID(*v)
+	}
+	return _u
+}
+
+// AddEnrollmentID adds value to the "enrollment_id" field.
+func (_u *WaitlistEntryUpdateOne) AddEnrollmentID(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.AddEnrollmentID(v)
+	return _u
+}
+
+// ClearEnrollmentID clears the value of the "enrollment_id" field.
+func (_u *WaitlistEntryUpdateOne) ClearEnrollmentID() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearEnrollmentID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *WaitlistEntryUpdateOne) SetCreatedBy(v string) *WaitlistEntryUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableCreatedBy(v *string) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WaitlistEntryUpdateOne) SetCreatedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableCreatedAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCourse sets the "course" edge to the Course entity.
+func (_u *WaitlistEntryUpdateOne) SetCourse(v *Course) *WaitlistEntryUpdateOne {
+	return _u.SetCourseID(v.ID)
+}
+
+// SetStudent sets the "student" edge to the Student entity.
+func (_u *WaitlistEntryUpdateOne) SetStudent(v *Student) *WaitlistEntryUpdateOne {
+	return _u.SetStudentID(v.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// ClearCourse clears the "course" edge to the Course entity.
+func (_u *WaitlistEntryUpdateOne) ClearCourse() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearCourse()
+	return _u
+}
+
+// ClearStudent clears the "student" edge to the Student entity.
+func (_u *WaitlistEntryUpdateOne) ClearStudent() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearStudent()
+	return _u
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (_u *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.BillingMode(); ok {
+		if err := waitlistentry.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _u.mutation.CourseCleared() && len(_u.mutation.CourseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.course"`)
+	}
+	if _u.mutation.StudentCleared() && len(_u.mutation.StudentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.student"`)
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(waitlistentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(waitlistentry.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BillingMode(); ok {
+		_spec.SetField(waitlistentry.FieldBillingMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(waitlistentry.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferEmailedTo(); ok {
+		_spec.SetField(waitlistentry.FieldOfferEmailedTo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnrollmentID(); ok {
+		_spec.SetField(waitlistentry.FieldEnrollmentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnrollmentID(); ok {
+		_spec.AddField(waitlistentry.FieldEnrollmentID, field.TypeInt, value)
+	}
+	if _u.mutation.EnrollmentIDCleared() {
+		_spec.ClearField(waitlistentry.FieldEnrollmentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CourseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.CourseTable,
+			Columns: []string{waitlistentry.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CourseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.CourseTable,
+			Columns: []string{waitlistentry.CourseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(course.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StudentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.StudentTable,
+			Columns: []string{waitlistentry.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StudentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.StudentTable,
+			Columns: []string{waitlistentry.StudentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(student.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
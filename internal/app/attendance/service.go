@@ -15,6 +15,7 @@ import (
 	"langschool/ent/invoiceline"
 	"langschool/ent/student"
 	"langschool/internal/app"
+	"langschool/internal/app/enrollperiod"
 	invsvc "langschool/internal/app/invoice"
 	"langschool/internal/app/utils"
 	"langschool/internal/apperrors"
@@ -72,6 +73,7 @@ func (s *Service) getMonthInvoiceStatus(ctx context.Context, studentID, y, m int
 			invoice.StudentIDEQ(studentID),
 			invoice.PeriodYearEQ(y),
 			invoice.PeriodMonthEQ(m),
+			invoice.KindEQ(invoice.KindMonthly),
 		).
 		Only(ctx)
 	if err != nil {
@@ -116,6 +118,7 @@ func canDeleteEnrollmentWithInvoiceHistory(ctx context.Context, db *ent.Client,
 func (s *Service) ListPerLesson(ctx context.Context, y, m int, courseID *int) ([]Row, error) {
 	q := s.db.Enrollment.
 		Query().
+		Where(enrollperiod.ActiveIn(y, m)).
 		WithStudent().
 		WithCourse()
 	if courseID != nil && *courseID > 0 {
@@ -160,9 +163,13 @@ func (s *Service) ListPerLesson(ctx context.Context, y, m int, courseID *int) ([
 			return nil, err
 		}
 
-		lessonPriceCents := c.LessonPriceCents
-		if e.BillingMode == enrollment.BillingModePerLesson && e.LessonPriceOverrideCents >= 0 {
-			lessonPriceCents = e.LessonPriceOverrideCents
+		lessonPriceCents, _, err := invsvc.CoursePricesFor(ctx, s.db, e, y, m)
+		if err != nil {
+			return nil, err
+		}
+		_, subscriptionLessonPriceCents, err := invsvc.EnrollmentPricesFor(ctx, s.db, e, y, m)
+		if err != nil {
+			return nil, err
 		}
 
 		rows = append(rows, Row{
@@ -175,7 +182,7 @@ func (s *Service) ListPerLesson(ctx context.Context, y, m int, courseID *int) ([
 			CourseType:              string(c.Type),
 			BillingMode:             string(e.BillingMode),
 			LessonPrice:             money.CentsToEuros(lessonPriceCents),
-			SubscriptionLessonPrice: utils.Round2(money.CentsToEuros(e.SubscriptionLessonPriceCents)),
+			SubscriptionLessonPrice: utils.Round2(money.CentsToEuros(subscriptionLessonPriceCents)),
 			Hours:                   hours,
 			HasRecord:               hasRecord,
 			CanDelete:               canDelete,
@@ -249,7 +256,7 @@ func (s *Service) AddOneForFilter(ctx context.Context, y, m int, courseID *int)
 
 func (s *Service) ListCourseMonthSubscriptions(ctx context.Context, y, m int, courseID *int) ([]CourseMonthSubscription, error) {
 	subscriptionEnrollments, err := s.db.Enrollment.Query().
-		Where(enrollment.BillingModeEQ(enrollment.BillingModeSubscription)).
+		Where(enrollment.BillingModeEQ(enrollment.BillingModeSubscription), enrollperiod.ActiveIn(y, m)).
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -339,6 +346,7 @@ func (s *Service) UpsertCourseMonthSubscription(ctx context.Context, courseID, y
 		Where(
 			invoice.PeriodYearEQ(y),
 			invoice.PeriodMonthEQ(m),
+			invoice.KindEQ(invoice.KindMonthly),
 			invoice.StatusNEQ(app.InvoiceStatusDraft),
 			invoice.StatusNEQ(app.InvoiceStatusCanceled),
 			invoice.HasStudentWith(student.HasEnrollmentsWith(
@@ -362,6 +370,7 @@ func (s *Service) UpsertCourseMonthSubscription(ctx context.Context, courseID, y
 		Where(
 			enrollment.CourseIDEQ(courseID),
 			enrollment.BillingModeEQ(enrollment.BillingModeSubscription),
+			enrollperiod.ActiveIn(y, m),
 		).
 		All(ctx)
 	if err != nil {
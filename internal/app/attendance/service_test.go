@@ -897,7 +897,7 @@ func TestDeleteEnrollmentRebuildsRemainingDraftInvoice(t *testing.T) {
 		t.Fatalf("invoice line count = %d, want 2", len(lines))
 	}
 	for _, line := range lines {
-		if line.EnrollmentID == enrA.ID {
+		if line.EnrollmentID != nil && *line.EnrollmentID == enrA.ID {
 			t.Fatalf("deleted enrollment line still present: %+v", line)
 		}
 	}
@@ -0,0 +1,596 @@
+// Package cashdesk reconciles the front-desk cash drawer. A session runs from
+// the opening float to the counted closing amount; cash receipts issued while
+// it is open, withdrawals and bank deposits make up the expected amount, and
+// the end-of-day Z-report shows the discrepancy.
+package cashdesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/cashmovement"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/cashsession"
+	"langschool/internal/money"
+	pdfgen "langschool/internal/pdf"
+)
+
+// Movement kinds.
+const (
+	MovementWithdrawal  = "withdrawal"   // cash taken out and spent
+	MovementBankDeposit = "bank_deposit" // cash taken to the bank
+	MovementRefund      = "refund"       // cash paid back to a payer; recorded by payment refunds
+)
+
+// Service manages cash sessions.
+type Service struct{ db *ent.Client }
+
+// New creates a cash desk service with the given database client.
+func New(db *ent.Client) *Service { return &Service{db: db} }
+
+// User is the person performing a cash desk action.
+type User struct {
+	ID   *int
+	Name string
+}
+
+// SessionDTO summarises a cash session.
+type SessionDTO struct {
+	ID           int      `json:"id"`
+	Status       string   `json:"status"` // "open" or "closed"
+	OpenedAt     string   `json:"openedAt"`
+	OpenedBy     string   `json:"openedBy"`
+	OpeningFloat float64  `json:"openingFloat"`
+	CashReceived float64  `json:"cashReceived"` // Receipts not voided
+	Withdrawals  float64  `json:"withdrawals"`
+	BankDeposits float64  `json:"bankDeposits"`
+	Refunds      float64  `json:"refunds"`
+	Expected     float64  `json:"expected"` // Cash that should be in the drawer
+	Counted      *float64 `json:"counted,omitempty"`
+	Discrepancy  *float64 `json:"discrepancy,omitempty"` // Counted minus expected; negative means cash is missing
+	ClosedAt     *string  `json:"closedAt,omitempty"`
+	ClosedBy     string   `json:"closedBy,omitempty"`
+	ReopenedAt   *string  `json:"reopenedAt,omitempty"`
+	ReopenedBy   string   `json:"reopenedBy,omitempty"`
+	Note         string   `json:"note"`
+}
+
+// UserTotalDTO is the cash one user took during a session.
+type UserTotalDTO struct {
+	ReceivedBy string  `json:"receivedBy"`
+	Count      int     `json:"count"`
+	Amount     float64 `json:"amount"`
+}
+
+// MovementDTO is a withdrawal or bank deposit.
+type MovementDTO struct {
+	ID        int     `json:"id"`
+	SessionID int     `json:"sessionId"`
+	Kind      string  `json:"kind"`
+	Amount    float64 `json:"amount"`
+	Note      string  `json:"note"`
+	PaymentID *int    `json:"paymentId,omitempty"` // Refund payment row
+	CreatedBy string  `json:"createdBy"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+// ReceiptDTO is a cash receipt taken during a session.
+type ReceiptDTO struct {
+	ID          int     `json:"id"`
+	Number      string  `json:"number"`
+	StudentID   int     `json:"studentId"`
+	StudentName string  `json:"studentName"`
+	Amount      float64 `json:"amount"`
+	CreatedAt   string  `json:"createdAt"`
+	ReceivedBy  string  `json:"receivedBy"`
+	Status      string  `json:"status"`
+}
+
+// ReportDTO is the Z-report of a session.
+type ReportDTO struct {
+	Session   SessionDTO     `json:"session"`
+	ByUser    []UserTotalDTO `json:"byUser"`
+	Movements []MovementDTO  `json:"movements"`
+	Receipts  []ReceiptDTO   `json:"receipts"`
+}
+
+// OpenSessionID returns the open session, if any. Cash receipts are filed
+// under it.
+func OpenSessionID(ctx context.Context, db *ent.Client) (*int, error) {
+	session, err := db.CashSession.Query().
+		Where(cashsession.StatusEQ(cashsession.StatusOpen)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &session.ID, nil
+}
+
+// EnsurePaymentsEditable rejects changes to cash taken in a closed session.
+func EnsurePaymentsEditable(ctx context.Context, db *ent.Client, sessionID *int) error {
+	if sessionID == nil {
+		return nil
+	}
+	session, err := db.CashSession.Get(ctx, *sessionID)
+	if err != nil {
+		return err
+	}
+	if session.Status == cashsession.StatusClosed {
+		return fmt.Errorf("cannot change cash payments of closed cash session %d; an admin must reopen it first", session.ID)
+	}
+	return nil
+}
+
+// RecordRefund files a cash refund under the open session, if any, so the
+// drawer's expected amount drops by the cash paid out.
+func RecordRefund(ctx context.Context, db *ent.Client, paymentID int, amountCents int64, note string, by User) error {
+	sessionID, err := OpenSessionID(ctx, db)
+	if err != nil || sessionID == nil {
+		return err
+	}
+	return db.CashMovement.Create().
+		SetSessionID(*sessionID).
+		SetKind(cashmovement.KindRefund).
+		SetAmountCents(amountCents).
+		SetNote(note).
+		SetPaymentID(paymentID).
+		SetNillableCreatedByUserID(by.ID).
+		SetCreatedBy(by.Name).
+		Exec(ctx)
+}
+
+// RemoveRefund deletes the drawer movement of a cash refund that is being
+// deleted. Refunds paid out in a closed session stay locked.
+func RemoveRefund(ctx context.Context, db *ent.Client, paymentID int) error {
+	movements, err := db.CashMovement.Query().
+		Where(cashmovement.PaymentIDEQ(paymentID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range movements {
+		if err := EnsurePaymentsEditable(ctx, db, &m.SessionID); err != nil {
+			return err
+		}
+		if err := db.CashMovement.DeleteOne(m).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Current returns the open session or nil when the drawer is closed.
+func (s *Service) Current(ctx context.Context) (*SessionDTO, error) {
+	id, err := OpenSessionID(ctx, s.db)
+	if err != nil || id == nil {
+		return nil, err
+	}
+	report, err := s.Report(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Session, nil
+}
+
+// List returns the most recent sessions first.
+func (s *Service) List(ctx context.Context, limit int) ([]SessionDTO, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	sessions, err := s.db.CashSession.Query().
+		Order(ent.Desc(cashsession.FieldOpenedAt), ent.Desc(cashsession.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		report, err := s.report(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, report.Session)
+	}
+	return out, nil
+}
+
+// Open starts a session with the cash already in the drawer. Only one
+// session can be open at a time.
+func (s *Service) Open(ctx context.Context, openingFloat float64, note string, by User) (*SessionDTO, error) {
+	floatCents := money.EurosToCents(openingFloat)
+	if floatCents < 0 {
+		return nil, errors.New("opening float must be zero or more")
+	}
+	var id int
+	err := s.withTx(ctx, func(db *ent.Client) error {
+		open, err := OpenSessionID(ctx, db)
+		if err != nil {
+			return err
+		}
+		if open != nil {
+			return fmt.Errorf("cash session %d is already open", *open)
+		}
+		session, err := db.CashSession.Create().
+			SetNillableOpenedByUserID(by.ID).
+			SetOpenedBy(by.Name).
+			SetOpeningFloatCents(floatCents).
+			SetNote(strings.TrimSpace(note)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		id = session.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.Report(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Session, nil
+}
+
+// AddMovement records cash taken out of an open session's drawer.
+func (s *Service) AddMovement(ctx context.Context, sessionID int, kind string, amount float64, note string, by User) (*MovementDTO, error) {
+	if kind != MovementWithdrawal && kind != MovementBankDeposit {
+		return nil, fmt.Errorf("kind must be '%s' or '%s'", MovementWithdrawal, MovementBankDeposit)
+	}
+	amountCents := money.EurosToCents(amount)
+	if amountCents <= 0 {
+		return nil, errors.New("amount must be greater than 0")
+	}
+	session, err := s.db.CashSession.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != cashsession.StatusOpen {
+		return nil, fmt.Errorf("cannot add cash movements to closed cash session %d", session.ID)
+	}
+	movement, err := s.db.CashMovement.Create().
+		SetSessionID(session.ID).
+		SetKind(cashmovement.Kind(kind)).
+		SetAmountCents(amountCents).
+		SetNote(strings.TrimSpace(note)).
+		SetNillableCreatedByUserID(by.ID).
+		SetCreatedBy(by.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toMovementDTO(movement)
+	return &dto, nil
+}
+
+// Close records the counted cash and locks the session's payments.
+func (s *Service) Close(ctx context.Context, sessionID int, counted float64, note string, by User) (*ReportDTO, error) {
+	countedCents := money.EurosToCents(counted)
+	if countedCents < 0 {
+		return nil, errors.New("counted amount must be zero or more")
+	}
+	session, err := s.db.CashSession.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != cashsession.StatusOpen {
+		return nil, fmt.Errorf("cash session %d is already closed", session.ID)
+	}
+	update := session.Update().
+		SetStatus(cashsession.StatusClosed).
+		SetClosedAt(time.Now()).
+		SetClosedBy(by.Name).
+		SetCountedCents(countedCents)
+	if note = strings.TrimSpace(note); note != "" {
+		update = update.SetNote(note)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, err
+	}
+	return s.Report(ctx, sessionID)
+}
+
+// Reopen makes a closed session editable again. The counted amount is kept
+// until the session is closed again.
+func (s *Service) Reopen(ctx context.Context, sessionID int, by User) (*SessionDTO, error) {
+	err := s.withTx(ctx, func(db *ent.Client) error {
+		session, err := db.CashSession.Get(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session.Status == cashsession.StatusOpen {
+			return fmt.Errorf("cash session %d is already open", session.ID)
+		}
+		open, err := OpenSessionID(ctx, db)
+		if err != nil {
+			return err
+		}
+		if open != nil {
+			return fmt.Errorf("cannot reopen cash session %d while session %d is open", session.ID, *open)
+		}
+		_, err = session.Update().
+			SetStatus(cashsession.StatusOpen).
+			SetReopenedAt(time.Now()).
+			SetReopenedBy(by.Name).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.Report(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Session, nil
+}
+
+// Report builds the Z-report of a session.
+func (s *Service) Report(ctx context.Context, sessionID int) (*ReportDTO, error) {
+	session, err := s.db.CashSession.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.report(ctx, session)
+}
+
+func (s *Service) report(ctx context.Context, session *ent.CashSession) (*ReportDTO, error) {
+	receipts, err := s.db.CashReceipt.Query().
+		Where(cashreceipt.CashSessionIDEQ(session.ID)).
+		WithStudent().
+		Order(ent.Asc(cashreceipt.FieldSeq)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	movements, err := s.db.CashMovement.Query().
+		Where(cashmovement.SessionIDEQ(session.ID)).
+		Order(ent.Asc(cashmovement.FieldCreatedAt), ent.Asc(cashmovement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReportDTO{
+		ByUser:    []UserTotalDTO{},
+		Movements: make([]MovementDTO, 0, len(movements)),
+		Receipts:  make([]ReceiptDTO, 0, len(receipts)),
+	}
+	var receivedCents, withdrawalCents, depositCents, refundCents int64
+	byUser := map[string]*UserTotalDTO{}
+	userCents := map[string]int64{}
+	for _, r := range receipts {
+		item := ReceiptDTO{
+			ID:         r.ID,
+			Number:     r.Number,
+			StudentID:  r.StudentID,
+			Amount:     money.CentsToEuros(r.AmountCents),
+			CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+			ReceivedBy: r.ReceivedBy,
+			Status:     string(r.Status),
+		}
+		if r.Edges.Student != nil {
+			item.StudentName = r.Edges.Student.FullName
+		}
+		report.Receipts = append(report.Receipts, item)
+		if r.Status == cashreceipt.StatusVoided {
+			continue
+		}
+		receivedCents += r.AmountCents
+		total, ok := byUser[r.ReceivedBy]
+		if !ok {
+			total = &UserTotalDTO{ReceivedBy: r.ReceivedBy}
+			byUser[r.ReceivedBy] = total
+		}
+		total.Count++
+		userCents[r.ReceivedBy] += r.AmountCents
+	}
+	for name, total := range byUser {
+		total.Amount = money.CentsToEuros(userCents[name])
+		report.ByUser = append(report.ByUser, *total)
+	}
+	sort.Slice(report.ByUser, func(i, j int) bool { return report.ByUser[i].ReceivedBy < report.ByUser[j].ReceivedBy })
+	for _, m := range movements {
+		report.Movements = append(report.Movements, toMovementDTO(m))
+		switch m.Kind {
+		case cashmovement.KindBankDeposit:
+			depositCents += m.AmountCents
+		case cashmovement.KindRefund:
+			refundCents += m.AmountCents
+		default:
+			withdrawalCents += m.AmountCents
+		}
+	}
+
+	expectedCents := session.OpeningFloatCents + receivedCents - withdrawalCents - depositCents - refundCents
+	dto := SessionDTO{
+		ID:           session.ID,
+		Status:       string(session.Status),
+		OpenedAt:     session.OpenedAt.Format(time.RFC3339),
+		OpenedBy:     session.OpenedBy,
+		OpeningFloat: money.CentsToEuros(session.OpeningFloatCents),
+		CashReceived: money.CentsToEuros(receivedCents),
+		Withdrawals:  money.CentsToEuros(withdrawalCents),
+		BankDeposits: money.CentsToEuros(depositCents),
+		Refunds:      money.CentsToEuros(refundCents),
+		Expected:     money.CentsToEuros(expectedCents),
+		ClosedBy:     session.ClosedBy,
+		ReopenedBy:   session.ReopenedBy,
+		Note:         session.Note,
+	}
+	if session.CountedCents != nil {
+		counted := money.CentsToEuros(*session.CountedCents)
+		discrepancy := money.CentsToEuros(*session.CountedCents - expectedCents)
+		dto.Counted = &counted
+		dto.Discrepancy = &discrepancy
+	}
+	dto.ClosedAt = formatOptionalTime(session.ClosedAt)
+	dto.ReopenedAt = formatOptionalTime(session.ReopenedAt)
+	report.Session = dto
+	return report, nil
+}
+
+// ReportPDF renders the Z-report.
+func (s *Service) ReportPDF(ctx context.Context, report *ReportDTO, fontsDir string) ([]byte, string, error) {
+	session := report.Session
+	doc := pdfgen.CashSessionReport{
+		SessionID:        session.ID,
+		OpenedAt:         parseTime(session.OpenedAt),
+		OpenedBy:         session.OpenedBy,
+		ClosedBy:         session.ClosedBy,
+		OpeningCents:     money.EurosToCents(session.OpeningFloat),
+		ReceivedCents:    money.EurosToCents(session.CashReceived),
+		WithdrawalCents:  money.EurosToCents(session.Withdrawals),
+		BankDepositCents: money.EurosToCents(session.BankDeposits),
+		RefundCents:      money.EurosToCents(session.Refunds),
+		ExpectedCents:    money.EurosToCents(session.Expected),
+	}
+	if session.ClosedAt != nil {
+		closedAt := parseTime(*session.ClosedAt)
+		doc.ClosedAt = &closedAt
+	}
+	if session.Counted != nil {
+		counted := money.EurosToCents(*session.Counted)
+		doc.CountedCents = &counted
+	}
+	for _, u := range report.ByUser {
+		doc.ByUser = append(doc.ByUser, pdfgen.CashSessionUserTotal{Name: u.ReceivedBy, Count: u.Count, Cents: money.EurosToCents(u.Amount)})
+	}
+	for _, m := range report.Movements {
+		doc.Movements = append(doc.Movements, pdfgen.CashSessionMovement{
+			Time:  parseTime(m.CreatedAt),
+			Kind:  movementLabel(m.Kind),
+			Note:  m.Note,
+			By:    m.CreatedBy,
+			Cents: money.EurosToCents(m.Amount),
+		})
+	}
+	for _, r := range report.Receipts {
+		doc.Receipts = append(doc.Receipts, pdfgen.CashSessionReceipt{
+			Number:  r.Number,
+			Time:    parseTime(r.CreatedAt),
+			Student: r.StudentName,
+			By:      r.ReceivedBy,
+			Cents:   money.EurosToCents(r.Amount),
+			Voided:  r.Status == string(cashreceipt.StatusVoided),
+		})
+	}
+	return pdfgen.GenerateCashSessionReportPDF(ctx, s.db, doc, pdfgen.Options{FontsDir: fontsDir})
+}
+
+// ReportCSV exports the Z-report: the totals followed by every receipt and
+// movement, one per row.
+func ReportCSV(report *ReportDTO) ([]byte, string, error) {
+	session := report.Session
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	amount := func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+	rows := [][]string{
+		{"section", "date", "document", "description", "user", "amount"},
+		{"opening", session.OpenedAt, "", "Opening float", session.OpenedBy, amount(session.OpeningFloat)},
+	}
+	for _, r := range report.Receipts {
+		description := r.StudentName
+		if r.Status == string(cashreceipt.StatusVoided) {
+			description += " (voided)"
+		}
+		value := amount(r.Amount)
+		if r.Status == string(cashreceipt.StatusVoided) {
+			value = amount(0)
+		}
+		rows = append(rows, []string{"receipt", r.CreatedAt, r.Number, description, r.ReceivedBy, value})
+	}
+	for _, m := range report.Movements {
+		rows = append(rows, []string{m.Kind, m.CreatedAt, "", m.Note, m.CreatedBy, amount(-m.Amount)})
+	}
+	rows = append(rows, []string{"expected", "", "", "Expected cash", "", amount(session.Expected)})
+	if session.Counted != nil {
+		closedAt := ""
+		if session.ClosedAt != nil {
+			closedAt = *session.ClosedAt
+		}
+		rows = append(rows,
+			[]string{"counted", closedAt, "", "Counted cash", session.ClosedBy, amount(*session.Counted)},
+			[]string{"discrepancy", "", "", "Counted minus expected", "", amount(*session.Discrepancy)},
+		)
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), fmt.Sprintf("z-report-%d.csv", session.ID), nil
+}
+
+func (s *Service) withTx(ctx context.Context, fn func(db *ent.Client) error) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return fn(s.db)
+		}
+		return err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx.Client()); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+func toMovementDTO(m *ent.CashMovement) MovementDTO {
+	return MovementDTO{
+		ID:        m.ID,
+		SessionID: m.SessionID,
+		Kind:      string(m.Kind),
+		Amount:    money.CentsToEuros(m.AmountCents),
+		Note:      m.Note,
+		PaymentID: m.PaymentID,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func movementLabel(kind string) string {
+	switch kind {
+	case MovementBankDeposit:
+		return "Iemaksa bankā"
+	case MovementRefund:
+		return "Atmaksa"
+	default:
+		return "Izņemts no kases"
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	value := t.Format(time.RFC3339)
+	return &value
+}
+
+func parseTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339, value)
+	return t
+}
@@ -0,0 +1,100 @@
+package cashdesk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent/cashreceipt"
+	"langschool/ent/enttest"
+)
+
+func TestSessionExpectedAmountAndLock(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:cashdesk?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	anna := User{Name: "anna"}
+	session, err := svc.Open(ctx, 100, "", anna)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if _, err := svc.Open(ctx, 0, "", anna); err == nil {
+		t.Fatal("expected error opening a second session")
+	}
+
+	st, err := client.Student.Create().SetFullName("Cash Student").Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	receipt := func(seq int, cents int64, by string, status cashreceipt.Status) {
+		t.Helper()
+		if _, err := client.CashReceipt.Create().
+			SetSeq(seq).
+			SetNumber(fmt.Sprintf("KI-%06d", seq)).
+			SetStudentID(st.ID).
+			SetAmountCents(cents).
+			SetPaidAt(time.Now()).
+			SetReceivedBy(by).
+			SetStatus(status).
+			SetCashSessionID(session.ID).
+			Save(ctx); err != nil {
+			t.Fatalf("create receipt: %v", err)
+		}
+	}
+	receipt(1, 4000, "anna", cashreceipt.StatusIssued)
+	receipt(2, 2500, "peter", cashreceipt.StatusIssued)
+	receipt(3, 9900, "anna", cashreceipt.StatusVoided)
+
+	if _, err := svc.AddMovement(ctx, session.ID, MovementWithdrawal, 10, "paper", anna); err != nil {
+		t.Fatalf("AddMovement: %v", err)
+	}
+	if _, err := svc.AddMovement(ctx, session.ID, MovementBankDeposit, 80, "", anna); err != nil {
+		t.Fatalf("AddMovement: %v", err)
+	}
+	if _, err := svc.AddMovement(ctx, session.ID, "refund", 1, "", anna); err == nil {
+		t.Fatal("expected error for unknown movement kind")
+	}
+
+	report, err := svc.Close(ctx, session.ID, 76, "", anna)
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	got := report.Session
+	if got.CashReceived != 65 || got.Expected != 75 || got.Counted == nil || *got.Discrepancy != 1 {
+		t.Fatalf("session = %+v", got)
+	}
+	if len(report.ByUser) != 2 || report.ByUser[0].ReceivedBy != "anna" || report.ByUser[0].Amount != 40 || report.ByUser[0].Count != 1 {
+		t.Fatalf("by user = %+v", report.ByUser)
+	}
+	if len(report.Receipts) != 3 || len(report.Movements) != 2 {
+		t.Fatalf("report = %+v", report)
+	}
+
+	if err := EnsurePaymentsEditable(ctx, client, &session.ID); err == nil {
+		t.Fatal("expected closed session to lock its payments")
+	}
+	if _, err := svc.AddMovement(ctx, session.ID, MovementWithdrawal, 1, "", anna); err == nil {
+		t.Fatal("expected error adding a movement to a closed session")
+	}
+	if id, err := OpenSessionID(ctx, client); err != nil || id != nil {
+		t.Fatalf("OpenSessionID = %v, %v; want none", id, err)
+	}
+
+	if _, err := svc.Reopen(ctx, session.ID, User{Name: "admin"}); err != nil {
+		t.Fatalf("Reopen: %v", err)
+	}
+	if err := EnsurePaymentsEditable(ctx, client, &session.ID); err != nil {
+		t.Fatalf("EnsurePaymentsEditable after reopen: %v", err)
+	}
+
+	data, filename, err := ReportCSV(report)
+	if err != nil || filename == "" || len(data) == 0 {
+		t.Fatalf("ReportCSV = %q, %v", filename, err)
+	}
+}
@@ -1,6 +1,8 @@
 // Package app contains shared constants and utilities used across the application.
 package app
 
+import "time"
+
 // Course types define the type of course offering.
 const (
 	CourseTypeGroup      = "group"      // Group course: multiple students attend together
@@ -11,6 +13,14 @@ const (
 const (
 	BillingModeSubscription = "subscription" // Monthly subscription billing
 	BillingModePerLesson    = "per_lesson"   // Pay per lesson attended
+	BillingModePackage      = "package"      // Prepaid block of lessons drawn down by attendance
+)
+
+// Billing periods define how often a course is invoiced.
+const (
+	BillingPeriodMonthly = "monthly" // One invoice per calendar month from attendance
+	BillingPeriodTerm    = "term"    // One invoice per school term, up front
+	BillingPeriodCustom  = "custom"  // One invoice per course-specific term, up front
 )
 
 // Invoice statuses represent the lifecycle state of an invoice.
@@ -51,6 +61,19 @@ func InvoiceStatusAllowsPayments(status string) bool {
 	return InvoiceStatusIsIssuedFamily(status) || InvoiceStatusIsPaidFamily(status)
 }
 
+// InvoicePaymentTermDays is how many days after issue an invoice is due.
+const InvoicePaymentTermDays = 14
+
+// InvoiceDueDate returns the due date of an invoice. Legacy invoices without
+// an issue time are treated as issued on the first day after their period.
+func InvoiceDueDate(issuedAt *time.Time, periodYear, periodMonth int) time.Time {
+	issued := time.Date(periodYear, time.Month(periodMonth)+1, 1, 0, 0, 0, 0, time.Local)
+	if issuedAt != nil {
+		issued = *issuedAt
+	}
+	return issued.AddDate(0, 0, InvoicePaymentTermDays)
+}
+
 // Payment methods define how payments are made.
 const (
 	PaymentMethodCash = "cash" // Cash payment
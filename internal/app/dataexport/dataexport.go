@@ -0,0 +1,678 @@
+// Package dataexport builds a full export of the school's records in open
+// formats: one CSV file per table plus a single JSON document with the same
+// data, packed into a ZIP archive.
+//
+// The format is versioned by FormatVersion. Columns and JSON keys are only
+// ever added within a version; renaming or removing one needs a new version.
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/attendancemonth"
+	"langschool/ent/course"
+	"langschool/ent/courseprice"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentprice"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+	invsvc "langschool/internal/app/invoice"
+	"langschool/internal/app/recipient"
+)
+
+// FormatVersion is the version of the export layout written to export.json.
+const FormatVersion = 1
+
+// JSONFileName is the name of the JSON document inside the archive.
+const JSONFileName = "export.json"
+
+// Money is an amount in cents written as a decimal number of euros, e.g.
+// 1234 as 12.34, in both the CSV files and the JSON document.
+type Money int64
+
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON writes the amount as a JSON number without float rounding.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Export is the whole export. Every record carries its database ID so that
+// the tables can be joined; times are RFC 3339 in UTC and dates YYYY-MM-DD.
+type Export struct {
+	FormatVersion    int               `json:"formatVersion"`
+	ExportedAt       string            `json:"exportedAt"`
+	Currency         string            `json:"currency"`
+	Students         []Student         `json:"students"`
+	Payers           []Payer           `json:"payers"`
+	Courses          []Course          `json:"courses"`
+	CoursePrices     []CoursePrice     `json:"coursePrices"`
+	Enrollments      []Enrollment      `json:"enrollments"`
+	EnrollmentPrices []EnrollmentPrice `json:"enrollmentPrices"`
+	Attendance       []Attendance      `json:"attendance"`
+	Invoices         []Invoice         `json:"invoices"`
+	Payments         []Payment         `json:"payments"`
+	Allocations      []Allocation      `json:"allocations"`
+}
+
+type Student struct {
+	ID           int    `json:"id" csv:"id"`
+	FullName     string `json:"fullName" csv:"full_name"`
+	PersonalCode string `json:"personalCode" csv:"personal_code"`
+	Phone        string `json:"phone" csv:"phone"`
+	Email        string `json:"email" csv:"email"`
+	IsMinor      bool   `json:"isMinor" csv:"is_minor"`
+	IsActive     bool   `json:"isActive" csv:"is_active"`
+	PayerKey     string `json:"payerKey" csv:"payer_key"`
+	Note         string `json:"note" csv:"note"`
+	CreatedAt    string `json:"createdAt" csv:"created_at"`
+}
+
+// Payer is whoever receives a student's invoices: the adult student, the
+// parent of a minor or a company. Students with the same payer share one
+// row, keyed as recipient.PayerKey does.
+type Payer struct {
+	Key          string `json:"key" csv:"payer_key"`
+	Type         string `json:"type" csv:"type"` // person or company
+	Name         string `json:"name" csv:"name"`
+	Role         string `json:"role" csv:"role"` // the parent's role for a minor
+	PersonalCode string `json:"personalCode" csv:"personal_code"`
+	Phone        string `json:"phone" csv:"phone"`
+	Email        string `json:"email" csv:"email"`
+	RegNo        string `json:"regNo" csv:"reg_no"`
+	VATNumber    string `json:"vatNumber" csv:"vat_number"`
+	LegalAddress string `json:"legalAddress" csv:"legal_address"`
+	StudentIDs   []int  `json:"studentIds" csv:"student_ids"` // space separated in CSV
+}
+
+// Course carries the prices in force in the export month; CoursePrice rows
+// hold the whole price history.
+type Course struct {
+	ID                     int     `json:"id" csv:"id"`
+	Name                   string  `json:"name" csv:"name"`
+	Type                   string  `json:"type" csv:"type"`
+	BillingPeriod          string  `json:"billingPeriod" csv:"billing_period"`
+	TeacherID              *int    `json:"teacherId" csv:"teacher_id"`
+	TeacherName            string  `json:"teacherName" csv:"teacher_name"`
+	LessonPriceCents       int64   `json:"lessonPriceCents" csv:"lesson_price_cents"`
+	LessonPrice            Money   `json:"lessonPrice" csv:"lesson_price"`
+	SubscriptionPriceCents int64   `json:"subscriptionPriceCents" csv:"subscription_price_cents"`
+	SubscriptionPrice      Money   `json:"subscriptionPrice" csv:"subscription_price"`
+	VATRatePct             float64 `json:"vatRatePct" csv:"vat_rate_pct"`
+	VATExemptNote          string  `json:"vatExemptNote" csv:"vat_exempt_note"`
+	MaxStudents            int     `json:"maxStudents" csv:"max_students"`
+	IsActive               bool    `json:"isActive" csv:"is_active"`
+}
+
+// CoursePrice is a change of a course's prices, in force from its month until
+// the next change. The entry without a month holds the prices the course had
+// before its first change.
+type CoursePrice struct {
+	ID                     int    `json:"id" csv:"id"`
+	CourseID               int    `json:"courseId" csv:"course_id"`
+	EffectiveYear          *int   `json:"effectiveYear" csv:"effective_year"`
+	EffectiveMonth         *int   `json:"effectiveMonth" csv:"effective_month"`
+	LessonPriceCents       int64  `json:"lessonPriceCents" csv:"lesson_price_cents"`
+	LessonPrice            Money  `json:"lessonPrice" csv:"lesson_price"`
+	SubscriptionPriceCents int64  `json:"subscriptionPriceCents" csv:"subscription_price_cents"`
+	SubscriptionPrice      Money  `json:"subscriptionPrice" csv:"subscription_price"`
+	CreatedBy              string `json:"createdBy" csv:"created_by"`
+	CreatedAt              string `json:"createdAt" csv:"created_at"`
+}
+
+// Enrollment carries its own prices in force in the export month;
+// EnrollmentPrice rows hold the whole price history.
+type Enrollment struct {
+	ID                           int     `json:"id" csv:"id"`
+	StudentID                    int     `json:"studentId" csv:"student_id"`
+	CourseID                     int     `json:"courseId" csv:"course_id"`
+	BillingMode                  string  `json:"billingMode" csv:"billing_mode"`
+	ChargeMaterials              bool    `json:"chargeMaterials" csv:"charge_materials"`
+	DiscountPct                  float64 `json:"discountPct" csv:"discount_pct"`
+	LessonPriceOverrideCents     *int64  `json:"lessonPriceOverrideCents" csv:"lesson_price_override_cents"`
+	LessonPriceOverride          *Money  `json:"lessonPriceOverride" csv:"lesson_price_override"`
+	SubscriptionLessonPriceCents int64   `json:"subscriptionLessonPriceCents" csv:"subscription_lesson_price_cents"`
+	SubscriptionLessonPrice      Money   `json:"subscriptionLessonPrice" csv:"subscription_lesson_price"`
+	StartsOn                     string  `json:"startsOn" csv:"starts_on"`
+	EndsOn                       string  `json:"endsOn" csv:"ends_on"`
+	Note                         string  `json:"note" csv:"note"`
+	CreatedAt                    string  `json:"createdAt" csv:"created_at"`
+}
+
+// EnrollmentPrice is a change of an enrollment's own prices, in force from
+// its month until the next change.
+type EnrollmentPrice struct {
+	ID                           int    `json:"id" csv:"id"`
+	EnrollmentID                 int    `json:"enrollmentId" csv:"enrollment_id"`
+	EffectiveYear                int    `json:"effectiveYear" csv:"effective_year"`
+	EffectiveMonth               int    `json:"effectiveMonth" csv:"effective_month"`
+	LessonPriceOverrideCents     *int64 `json:"lessonPriceOverrideCents" csv:"lesson_price_override_cents"`
+	LessonPriceOverride          *Money `json:"lessonPriceOverride" csv:"lesson_price_override"`
+	SubscriptionLessonPriceCents int64  `json:"subscriptionLessonPriceCents" csv:"subscription_lesson_price_cents"`
+	SubscriptionLessonPrice      Money  `json:"subscriptionLessonPrice" csv:"subscription_lesson_price"`
+	CreatedBy                    string `json:"createdBy" csv:"created_by"`
+	CreatedAt                    string `json:"createdAt" csv:"created_at"`
+}
+
+// Attendance is the number of lessons a student attended in a course in a
+// month. For subscription courses it is the course's lesson count.
+type Attendance struct {
+	StudentID int     `json:"studentId" csv:"student_id"`
+	CourseID  int     `json:"courseId" csv:"course_id"`
+	Year      int     `json:"year" csv:"year"`
+	Month     int     `json:"month" csv:"month"`
+	Lessons   float64 `json:"lessons" csv:"lessons"`
+}
+
+// Invoice carries its lines in the JSON document; the CSV files keep them
+// in invoice_lines.csv.
+type Invoice struct {
+	ID          int           `json:"id" csv:"id"`
+	Number      string        `json:"number" csv:"number"`
+	StudentID   int           `json:"studentId" csv:"student_id"`
+	PayerKey    string        `json:"payerKey" csv:"payer_key"`
+	Kind        string        `json:"kind" csv:"kind"`
+	Status      string        `json:"status" csv:"status"`
+	PeriodYear  int           `json:"periodYear" csv:"period_year"`
+	PeriodMonth int           `json:"periodMonth" csv:"period_month"`
+	IssuedAt    string        `json:"issuedAt" csv:"issued_at"`
+	TotalCents  int64         `json:"totalCents" csv:"total_cents"`
+	Total       Money         `json:"total" csv:"total"`
+	VATCents    int64         `json:"vatCents" csv:"vat_cents"`
+	VAT         Money         `json:"vat" csv:"vat"`
+	PaidCents   int64         `json:"paidCents" csv:"paid_cents"`
+	Paid        Money         `json:"paid" csv:"paid"`
+	CreatedAt   string        `json:"createdAt" csv:"created_at"`
+	Lines       []InvoiceLine `json:"lines" csv:"-"`
+}
+
+type InvoiceLine struct {
+	ID             int     `json:"id" csv:"id"`
+	InvoiceID      int     `json:"invoiceId" csv:"invoice_id"`
+	EnrollmentID   *int    `json:"enrollmentId" csv:"enrollment_id"`
+	Description    string  `json:"description" csv:"description"`
+	Qty            float64 `json:"qty" csv:"qty"`
+	UnitPriceCents int64   `json:"unitPriceCents" csv:"unit_price_cents"`
+	UnitPrice      Money   `json:"unitPrice" csv:"unit_price"`
+	AmountCents    int64   `json:"amountCents" csv:"amount_cents"`
+	Amount         Money   `json:"amount" csv:"amount"`
+	VATRatePct     float64 `json:"vatRatePct" csv:"vat_rate_pct"`
+	VATExemptNote  string  `json:"vatExemptNote" csv:"vat_exempt_note"`
+}
+
+// Payment is one payment row. Refunds and outgoing credit transfers are
+// negative; a row without an invoice is the student's credit.
+type Payment struct {
+	ID               int    `json:"id" csv:"id"`
+	StudentID        int    `json:"studentId" csv:"student_id"`
+	InvoiceID        *int   `json:"invoiceId" csv:"invoice_id"`
+	CashReceiptID    *int   `json:"cashReceiptId" csv:"cash_receipt_id"`
+	PaidAt           string `json:"paidAt" csv:"paid_at"`
+	Method           string `json:"method" csv:"method"`
+	Kind             string `json:"kind" csv:"kind"`
+	AmountCents      int64  `json:"amountCents" csv:"amount_cents"`
+	Amount           Money  `json:"amount" csv:"amount"`
+	RelatedPaymentID *int   `json:"relatedPaymentId" csv:"related_payment_id"`
+	Note             string `json:"note" csv:"note"`
+	CreatedAt        string `json:"createdAt" csv:"created_at"`
+}
+
+// Allocation is the part of a payment settling an invoice. A payment split
+// across invoices is stored as one payment row per invoice, sharing the
+// cash receipt.
+type Allocation struct {
+	PaymentID     int    `json:"paymentId" csv:"payment_id"`
+	InvoiceID     int    `json:"invoiceId" csv:"invoice_id"`
+	InvoiceNumber string `json:"invoiceNumber" csv:"invoice_number"`
+	StudentID     int    `json:"studentId" csv:"student_id"`
+	AmountCents   int64  `json:"amountCents" csv:"amount_cents"`
+	Amount        Money  `json:"amount" csv:"amount"`
+}
+
+// FileName names the archive after the export time.
+func FileName(exportedAt time.Time) string {
+	return "langschool-export-" + exportedAt.Format("20060102-150405") + ".zip"
+}
+
+// Build reads every exported record. Run it on a transaction client or a
+// database snapshot so that the tables agree with each other.
+func Build(ctx context.Context, db *ent.Client, exportedAt time.Time) (*Export, error) {
+	out := &Export{
+		FormatVersion:    FormatVersion,
+		ExportedAt:       formatTime(exportedAt),
+		Currency:         "EUR",
+		Students:         []Student{},
+		Payers:           []Payer{},
+		Courses:          []Course{},
+		CoursePrices:     []CoursePrice{},
+		Enrollments:      []Enrollment{},
+		EnrollmentPrices: []EnrollmentPrice{},
+		Attendance:       []Attendance{},
+		Invoices:         []Invoice{},
+		Payments:         []Payment{},
+		Allocations:      []Allocation{},
+	}
+	year, month := exportedAt.Year(), int(exportedAt.Month())
+
+	students, err := db.Student.Query().Order(ent.Asc(student.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payerKeys := make(map[int]string, len(students))
+	payerIndex := make(map[string]int)
+	for _, st := range students {
+		key := recipient.PayerKey(st)
+		payerKeys[st.ID] = key
+		created := ""
+		if st.CreatedAt != nil {
+			created = formatTime(*st.CreatedAt)
+		}
+		out.Students = append(out.Students, Student{
+			ID:           st.ID,
+			FullName:     st.FullName,
+			PersonalCode: st.PersonalCode,
+			Phone:        st.Phone,
+			Email:        st.Email,
+			IsMinor:      st.IsMinor,
+			IsActive:     st.IsActive,
+			PayerKey:     key,
+			Note:         st.Note,
+			CreatedAt:    created,
+		})
+		if i, ok := payerIndex[key]; ok {
+			out.Payers[i].StudentIDs = append(out.Payers[i].StudentIDs, st.ID)
+			continue
+		}
+		payerIndex[key] = len(out.Payers)
+		out.Payers = append(out.Payers, toPayer(key, st))
+	}
+
+	courses, err := db.Course.Query().
+		WithPrices(func(q *ent.CoursePriceQuery) {
+			q.Order(ent.Asc(courseprice.FieldEffectiveYear), ent.Asc(courseprice.FieldEffectiveMonth))
+		}).
+		Order(ent.Asc(course.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range courses {
+		lessonPriceCents, subscriptionPriceCents := invsvc.CoursePricesAt(c, c.Edges.Prices, year, month)
+		out.Courses = append(out.Courses, Course{
+			ID:                     c.ID,
+			Name:                   c.Name,
+			Type:                   string(c.Type),
+			BillingPeriod:          string(c.BillingPeriod),
+			TeacherID:              c.TeacherID,
+			TeacherName:            c.TeacherName,
+			LessonPriceCents:       lessonPriceCents,
+			LessonPrice:            Money(lessonPriceCents),
+			SubscriptionPriceCents: subscriptionPriceCents,
+			SubscriptionPrice:      Money(subscriptionPriceCents),
+			VATRatePct:             c.VatRatePct,
+			VATExemptNote:          c.VatExemptNote,
+			MaxStudents:            c.MaxStudents,
+			IsActive:               c.IsActive,
+		})
+		for _, p := range c.Edges.Prices {
+			out.CoursePrices = append(out.CoursePrices, CoursePrice{
+				ID:                     p.ID,
+				CourseID:               p.CourseID,
+				EffectiveYear:          p.EffectiveYear,
+				EffectiveMonth:         p.EffectiveMonth,
+				LessonPriceCents:       p.LessonPriceCents,
+				LessonPrice:            Money(p.LessonPriceCents),
+				SubscriptionPriceCents: p.SubscriptionPriceCents,
+				SubscriptionPrice:      Money(p.SubscriptionPriceCents),
+				CreatedBy:              p.CreatedBy,
+				CreatedAt:              formatTime(p.CreatedAt),
+			})
+		}
+	}
+
+	enrollments, err := db.Enrollment.Query().
+		WithPrices(func(q *ent.EnrollmentPriceQuery) {
+			q.Order(ent.Asc(enrollmentprice.FieldEffectiveYear), ent.Asc(enrollmentprice.FieldEffectiveMonth))
+		}).
+		Order(ent.Asc(enrollment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range enrollments {
+		lessonPriceOverrideCents, subscriptionLessonPriceCents := invsvc.EnrollmentPricesAt(e, e.Edges.Prices, year, month)
+		item := Enrollment{
+			ID:                           e.ID,
+			StudentID:                    e.StudentID,
+			CourseID:                     e.CourseID,
+			BillingMode:                  string(e.BillingMode),
+			ChargeMaterials:              e.ChargeMaterials,
+			DiscountPct:                  e.DiscountPct,
+			SubscriptionLessonPriceCents: subscriptionLessonPriceCents,
+			SubscriptionLessonPrice:      Money(subscriptionLessonPriceCents),
+			StartsOn:                     formatOptionalDate(e.StartsOn),
+			EndsOn:                       formatOptionalDate(e.EndsOn),
+			Note:                         e.Note,
+		}
+		item.LessonPriceOverrideCents, item.LessonPriceOverride = lessonPriceOverride(lessonPriceOverrideCents)
+		if e.CreatedAt != nil {
+			item.CreatedAt = formatTime(*e.CreatedAt)
+		}
+		out.Enrollments = append(out.Enrollments, item)
+		for _, p := range e.Edges.Prices {
+			price := EnrollmentPrice{
+				ID:                           p.ID,
+				EnrollmentID:                 p.EnrollmentID,
+				EffectiveYear:                p.EffectiveYear,
+				EffectiveMonth:               p.EffectiveMonth,
+				SubscriptionLessonPriceCents: p.SubscriptionLessonPriceCents,
+				SubscriptionLessonPrice:      Money(p.SubscriptionLessonPriceCents),
+				CreatedBy:                    p.CreatedBy,
+				CreatedAt:                    formatTime(p.CreatedAt),
+			}
+			price.LessonPriceOverrideCents, price.LessonPriceOverride = lessonPriceOverride(p.LessonPriceOverrideCents)
+			out.EnrollmentPrices = append(out.EnrollmentPrices, price)
+		}
+	}
+
+	attendance, err := db.AttendanceMonth.Query().
+		Order(
+			ent.Asc(attendancemonth.FieldYear),
+			ent.Asc(attendancemonth.FieldMonth),
+			ent.Asc(attendancemonth.FieldStudentID),
+			ent.Asc(attendancemonth.FieldCourseID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range attendance {
+		out.Attendance = append(out.Attendance, Attendance{
+			StudentID: a.StudentID,
+			CourseID:  a.CourseID,
+			Year:      a.Year,
+			Month:     a.Month,
+			Lessons:   a.Hours,
+		})
+	}
+
+	payments, err := db.Payment.Query().Order(ent.Asc(payment.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paidCents := make(map[int]int64)
+	for _, p := range payments {
+		if p.InvoiceID != nil {
+			paidCents[*p.InvoiceID] += p.AmountCents
+		}
+	}
+
+	invoices, err := db.Invoice.Query().Order(ent.Asc(invoice.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := db.InvoiceLine.Query().Order(ent.Asc(invoiceline.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	linesByInvoice := make(map[int][]InvoiceLine, len(invoices))
+	for _, l := range lines {
+		linesByInvoice[l.InvoiceID] = append(linesByInvoice[l.InvoiceID], InvoiceLine{
+			ID:             l.ID,
+			InvoiceID:      l.InvoiceID,
+			EnrollmentID:   l.EnrollmentID,
+			Description:    l.Description,
+			Qty:            l.Qty,
+			UnitPriceCents: l.UnitPriceCents,
+			UnitPrice:      Money(l.UnitPriceCents),
+			AmountCents:    l.AmountCents,
+			Amount:         Money(l.AmountCents),
+			VATRatePct:     l.VatRatePct,
+			VATExemptNote:  l.VatExemptNote,
+		})
+	}
+	numbers := make(map[int]string, len(invoices))
+	for _, inv := range invoices {
+		number := ""
+		if inv.Number != nil {
+			number = *inv.Number
+		}
+		numbers[inv.ID] = number
+		item := Invoice{
+			ID:          inv.ID,
+			Number:      number,
+			StudentID:   inv.StudentID,
+			PayerKey:    payerKeys[inv.StudentID],
+			Kind:        string(inv.Kind),
+			Status:      string(inv.Status),
+			PeriodYear:  inv.PeriodYear,
+			PeriodMonth: inv.PeriodMonth,
+			IssuedAt:    formatOptionalTime(inv.IssuedAt),
+			TotalCents:  inv.TotalAmountCents,
+			Total:       Money(inv.TotalAmountCents),
+			VATCents:    inv.VatAmountCents,
+			VAT:         Money(inv.VatAmountCents),
+			PaidCents:   paidCents[inv.ID],
+			Paid:        Money(paidCents[inv.ID]),
+			CreatedAt:   formatOptionalTime(inv.CreatedAt),
+			Lines:       linesByInvoice[inv.ID],
+		}
+		if item.Lines == nil {
+			item.Lines = []InvoiceLine{}
+		}
+		out.Invoices = append(out.Invoices, item)
+	}
+
+	for _, p := range payments {
+		out.Payments = append(out.Payments, Payment{
+			ID:               p.ID,
+			StudentID:        p.StudentID,
+			InvoiceID:        p.InvoiceID,
+			CashReceiptID:    p.CashReceiptID,
+			PaidAt:           formatTime(p.PaidAt),
+			Method:           string(p.Method),
+			Kind:             string(p.Kind),
+			AmountCents:      p.AmountCents,
+			Amount:           Money(p.AmountCents),
+			RelatedPaymentID: p.RelatedPaymentID,
+			Note:             p.Note,
+			CreatedAt:        formatTime(p.CreatedAt),
+		})
+		if p.InvoiceID != nil {
+			out.Allocations = append(out.Allocations, Allocation{
+				PaymentID:     p.ID,
+				InvoiceID:     *p.InvoiceID,
+				InvoiceNumber: numbers[*p.InvoiceID],
+				StudentID:     p.StudentID,
+				AmountCents:   p.AmountCents,
+				Amount:        Money(p.AmountCents),
+			})
+		}
+	}
+	return out, nil
+}
+
+// lessonPriceOverride leaves a negative override, meaning that the course's
+// lesson price applies, empty.
+func lessonPriceOverride(cents int64) (*int64, *Money) {
+	if cents < 0 {
+		return nil, nil
+	}
+	price := Money(cents)
+	return &cents, &price
+}
+
+func toPayer(key string, st *ent.Student) Payer {
+	info := recipient.FromStudent(st)
+	p := Payer{
+		Key:          key,
+		Type:         "person",
+		Name:         info.RecipientName,
+		PersonalCode: info.PayerPersonalCode,
+		Phone:        info.RecipientPhone,
+		Email:        info.RecipientEmail,
+		StudentIDs:   []int{st.ID},
+	}
+	switch {
+	case info.IsCompany:
+		p.Type = "company"
+		p.PersonalCode = ""
+		p.RegNo = info.CompanyRegNo
+		p.VATNumber = info.CompanyVATNumber
+		p.LegalAddress = info.CompanyAddress
+	case st.IsMinor:
+		p.Role = st.PayerRole
+	}
+	return p
+}
+
+// WriteZIP writes the CSV files and export.json into one archive.
+func WriteZIP(w io.Writer, e *Export) error {
+	modified, err := time.Parse(time.RFC3339, e.ExportedAt)
+	if err != nil {
+		modified = time.Now()
+	}
+	zw := zip.NewWriter(w)
+	create := func(name string) (io.Writer, error) {
+		return zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	}
+
+	lines := make([]InvoiceLine, 0)
+	for _, inv := range e.Invoices {
+		lines = append(lines, inv.Lines...)
+	}
+	tables := []struct {
+		name string
+		rows any
+	}{
+		{"students.csv", e.Students},
+		{"payers.csv", e.Payers},
+		{"courses.csv", e.Courses},
+		{"course_prices.csv", e.CoursePrices},
+		{"enrollments.csv", e.Enrollments},
+		{"enrollment_prices.csv", e.EnrollmentPrices},
+		{"attendance.csv", e.Attendance},
+		{"invoices.csv", e.Invoices},
+		{"invoice_lines.csv", lines},
+		{"payments.csv", e.Payments},
+		{"payment_allocations.csv", e.Allocations},
+	}
+	for _, table := range tables {
+		fw, err := create(table.name)
+		if err != nil {
+			return err
+		}
+		if err := writeCSV(fw, table.rows); err != nil {
+			return fmt.Errorf("%s: %w", table.name, err)
+		}
+	}
+
+	fw, err := create(JSONFileName)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(fw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(e); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// writeCSV writes a slice of structs with a header row made of the fields'
+// csv tags, in field order.
+func writeCSV(w io.Writer, rows any) error {
+	v := reflect.ValueOf(rows)
+	t := v.Type().Elem()
+	var header []string
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		if tag := t.Field(i).Tag.Get("csv"); tag != "" && tag != "-" {
+			header = append(header, tag)
+			fields = append(fields, i)
+		}
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(fields))
+	for i := 0; i < v.Len(); i++ {
+		row := v.Index(i)
+		for j, field := range fields {
+			record[j] = csvValue(row.Field(field))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvValue(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if m, ok := v.Interface().(Money); ok {
+		return m.String()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = csvValue(v.Index(i))
+		}
+		return strings.Join(parts, " ")
+	default:
+		panic(fmt.Sprintf("dataexport: unsupported CSV field type %s", v.Type()))
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTime(*t)
+}
+
+func formatOptionalDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
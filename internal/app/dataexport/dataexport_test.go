@@ -0,0 +1,245 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+)
+
+func TestMoneyString(t *testing.T) {
+	for cents, want := range map[int64]string{0: "0.00", 5: "0.05", 1234: "12.34", -1999: "-19.99", -7: "-0.07"} {
+		if got := Money(cents).String(); got != want {
+			t.Errorf("Money(%d) = %q, want %q", cents, got, want)
+		}
+	}
+}
+
+func TestBuildAndWriteZIP(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:dataexport-build?mode=memory&_fk=1")
+	defer client.Close()
+
+	anna, err := client.Student.Create().SetFullName("Anna Bērziņa").SetIsMinor(true).
+		SetPayerName("Ilze Bērziņa").SetPayerRole("mother").SetPayerPersonalCode("010180-12345").Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create anna: %v", err)
+	}
+	janis, err := client.Student.Create().SetFullName("Jānis Bērziņš").SetIsMinor(true).
+		SetPayerName("Ilze Bērziņa").SetPayerRole("mother").SetPayerPersonalCode("010180-12345").Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create janis: %v", err)
+	}
+	employee, err := client.Student.Create().SetFullName("Olga Ivanova").SetPayerType(student.PayerTypeCompany).
+		SetPayerCompanyName("SIA Piemērs").SetPayerRegNo("40003000000").Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create employee: %v", err)
+	}
+	crs, err := client.Course.Create().SetName("English, A1").SetType(course.TypeGroup).SetLessonPriceCents(1550).Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	// The course opened at 15.50, was raised to 17.00 in October and a raise
+	// to 19.00 is scheduled for December; the course row holds the price in
+	// force in October.
+	if _, err := client.CoursePrice.Create().SetCourseID(crs.ID).
+		SetLessonPriceCents(1550).SetSubscriptionPriceCents(0).Save(ctx); err != nil {
+		t.Fatalf("CoursePrice.Create: %v", err)
+	}
+	for _, p := range []struct {
+		y, m  int
+		cents int64
+	}{{2026, 10, 1700}, {2026, 12, 1900}} {
+		if _, err := client.CoursePrice.Create().SetCourseID(crs.ID).SetEffectiveYear(p.y).SetEffectiveMonth(p.m).
+			SetLessonPriceCents(p.cents).SetSubscriptionPriceCents(0).Save(ctx); err != nil {
+			t.Fatalf("CoursePrice.Create: %v", err)
+		}
+	}
+	if crs, err = crs.Update().SetLessonPriceCents(1700).Save(ctx); err != nil {
+		t.Fatalf("Course.Update: %v", err)
+	}
+	starts := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	enr, err := client.Enrollment.Create().SetStudentID(anna.ID).SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).SetLessonPriceOverrideCents(1200).SetStartsOn(starts).Save(ctx)
+	if err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	// Anna's override goes up to 13.00 from November.
+	for _, p := range []struct {
+		y, m  int
+		cents int64
+	}{{2026, 9, 1200}, {2026, 11, 1300}} {
+		if _, err := client.EnrollmentPrice.Create().SetEnrollmentID(enr.ID).SetEffectiveYear(p.y).SetEffectiveMonth(p.m).
+			SetLessonPriceOverrideCents(p.cents).SetSubscriptionLessonPriceCents(0).Save(ctx); err != nil {
+			t.Fatalf("EnrollmentPrice.Create: %v", err)
+		}
+	}
+	if enr, err = enr.Update().SetLessonPriceOverrideCents(1300).Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Update: %v", err)
+	}
+	if _, err := client.Enrollment.Create().SetStudentID(janis.ID).SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).SetLessonPriceOverrideCents(-1).Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create janis: %v", err)
+	}
+	if _, err := client.AttendanceMonth.Create().SetStudentID(anna.ID).SetCourseID(crs.ID).SetYear(2026).SetMonth(9).SetHours(3).Save(ctx); err != nil {
+		t.Fatalf("AttendanceMonth.Create: %v", err)
+	}
+	inv, err := client.Invoice.Create().SetStudentID(anna.ID).SetPeriodYear(2026).SetPeriodMonth(9).
+		SetStatus(invoice.StatusIssued).SetNumber("LS-2026-0001").SetTotalAmountCents(3600).Save(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Create: %v", err)
+	}
+	if _, err := client.InvoiceLine.Create().SetInvoiceID(inv.ID).SetEnrollmentID(enr.ID).
+		SetDescription("English, A1 — 3 nodarbības").SetQty(3).SetUnitPriceCents(1200).SetAmountCents(3600).Save(ctx); err != nil {
+		t.Fatalf("InvoiceLine.Create: %v", err)
+	}
+	paid, err := client.Payment.Create().SetStudentID(anna.ID).SetInvoiceID(inv.ID).SetAmountCents(2000).SetMethod(payment.MethodBank).Save(ctx)
+	if err != nil {
+		t.Fatalf("Payment.Create: %v", err)
+	}
+	if _, err := client.Payment.Create().SetStudentID(employee.ID).SetAmountCents(505).SetMethod(payment.MethodCash).Save(ctx); err != nil {
+		t.Fatalf("Payment.Create credit: %v", err)
+	}
+
+	exportedAt := time.Date(2026, 10, 18, 21, 30, 0, 0, time.FixedZone("EEST", 3*3600))
+	exp, err := Build(ctx, client, exportedAt)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if exp.FormatVersion != FormatVersion || exp.ExportedAt != "2026-10-18T18:30:00Z" {
+		t.Fatalf("export header = %d %q", exp.FormatVersion, exp.ExportedAt)
+	}
+	if len(exp.Payers) != 2 || len(exp.Payers[0].StudentIDs) != 2 || exp.Payers[0].Name != "Ilze Bērziņa" || exp.Payers[0].Role != "mother" {
+		t.Fatalf("payers = %+v", exp.Payers)
+	}
+	if company := exp.Payers[1]; company.Type != "company" || company.RegNo != "40003000000" || company.Key != exp.Students[2].PayerKey {
+		t.Fatalf("company payer = %+v", company)
+	}
+	if c := exp.Courses[0]; c.LessonPriceCents != 1700 || c.LessonPrice != 1700 {
+		t.Fatalf("course = %+v, want the October price 17.00", c)
+	}
+	if len(exp.CoursePrices) != 3 || exp.CoursePrices[0].EffectiveYear != nil ||
+		*exp.CoursePrices[2].EffectiveMonth != 12 || exp.CoursePrices[2].LessonPriceCents != 1900 {
+		t.Fatalf("course prices = %+v", exp.CoursePrices)
+	}
+	if len(exp.EnrollmentPrices) != 2 || *exp.EnrollmentPrices[1].LessonPriceOverride != 1300 {
+		t.Fatalf("enrollment prices = %+v", exp.EnrollmentPrices)
+	}
+	if e := exp.Enrollments[0]; e.LessonPriceOverride == nil || *e.LessonPriceOverride != 1200 || e.StartsOn != "2026-09-01" {
+		t.Fatalf("enrollment = %+v", e)
+	}
+	if e := exp.Enrollments[1]; e.LessonPriceOverrideCents != nil {
+		t.Fatalf("enrollment without override = %+v", e)
+	}
+	if i := exp.Invoices[0]; i.PaidCents != 2000 || len(i.Lines) != 1 || i.PayerKey != exp.Payers[0].Key {
+		t.Fatalf("invoice = %+v", i)
+	}
+	if len(exp.Payments) != 2 || len(exp.Allocations) != 1 || exp.Allocations[0].PaymentID != paid.ID || exp.Allocations[0].InvoiceNumber != "LS-2026-0001" {
+		t.Fatalf("payments = %+v allocations = %+v", exp.Payments, exp.Allocations)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteZIP(&buf, exp); err != nil {
+		t.Fatalf("WriteZIP: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	files := map[string][]byte{}
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = data
+		names = append(names, f.Name)
+	}
+	wantNames := []string{"students.csv", "payers.csv", "courses.csv", "course_prices.csv", "enrollments.csv", "enrollment_prices.csv", "attendance.csv", "invoices.csv", "invoice_lines.csv", "payments.csv", "payment_allocations.csv", "export.json"}
+	if len(names) != len(wantNames) {
+		t.Fatalf("archive files = %v, want %v", names, wantNames)
+	}
+	for i, name := range wantNames {
+		if names[i] != name {
+			t.Fatalf("archive files = %v, want %v", names, wantNames)
+		}
+	}
+
+	lines := readCSV(t, files["invoice_lines.csv"])
+	wantHeader := "id,invoice_id,enrollment_id,description,qty,unit_price_cents,unit_price,amount_cents,amount,vat_rate_pct,vat_exempt_note"
+	if got := join(lines[0]); got != wantHeader {
+		t.Fatalf("invoice_lines.csv header = %s", got)
+	}
+	if row := lines[1]; row[3] != "English, A1 — 3 nodarbības" || row[4] != "3" || row[6] != "12.00" || row[7] != "3600" || row[8] != "36.00" {
+		t.Fatalf("invoice_lines.csv row = %q", row)
+	}
+	courses := readCSV(t, files["courses.csv"])
+	if row := courses[1]; row[6] != "1700" || row[7] != "17.00" {
+		t.Fatalf("courses.csv row = %q", row)
+	}
+	coursePrices := readCSV(t, files["course_prices.csv"])
+	wantHeader = "id,course_id,effective_year,effective_month,lesson_price_cents,lesson_price,subscription_price_cents,subscription_price,created_by,created_at"
+	if got := join(coursePrices[0]); got != wantHeader || len(coursePrices) != 4 || coursePrices[1][2] != "" || coursePrices[1][4] != "1550" {
+		t.Fatalf("course_prices.csv = %q", coursePrices)
+	}
+	enrollmentPrices := readCSV(t, files["enrollment_prices.csv"])
+	if len(enrollmentPrices) != 3 || enrollmentPrices[2][5] != "13.00" {
+		t.Fatalf("enrollment_prices.csv = %q", enrollmentPrices)
+	}
+	payers := readCSV(t, files["payers.csv"])
+	if payers[1][len(payers[1])-1] != "1 2" {
+		t.Fatalf("payers.csv row = %q", payers[1])
+	}
+	payments := readCSV(t, files["payments.csv"])
+	if payments[2][2] != "" || payments[2][8] != "5.05" {
+		t.Fatalf("payments.csv credit row = %q", payments[2])
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(files["export.json"], &doc); err != nil {
+		t.Fatalf("export.json: %v", err)
+	}
+	if doc["formatVersion"] != float64(FormatVersion) || doc["exportedAt"] != "2026-10-18T18:30:00Z" || doc["currency"] != "EUR" {
+		t.Fatalf("export.json header = %v %v %v", doc["formatVersion"], doc["exportedAt"], doc["currency"])
+	}
+	if !bytes.Contains(files["export.json"], []byte(`"total": 36.00`)) || !bytes.Contains(files["export.json"], []byte(`"totalCents": 3600`)) {
+		t.Fatalf("export.json money fields missing:\n%s", files["export.json"])
+	}
+}
+
+func readCSV(t *testing.T, data []byte) [][]string {
+	t.Helper()
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("read CSV: %v", err)
+	}
+	return rows
+}
+
+func join(row []string) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(row)
+	w.Flush()
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,74 @@
+// Package enrollperiod works out in which months an enrollment is active.
+// Enrollments may start or end mid-month and may be paused; their dates are
+// stored as UTC midnights, and unset dates leave the enrollment open-ended.
+package enrollperiod
+
+import (
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/predicate"
+)
+
+func monthBounds(y, m int) (time.Time, time.Time) {
+	start := time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// ActiveIn matches enrollments active on at least one day of the month and
+// not paused for all of it.
+func ActiveIn(y, m int) predicate.Enrollment {
+	start, end := monthBounds(y, m)
+	return enrollment.And(
+		enrollment.Or(enrollment.StartsOnIsNil(), enrollment.StartsOnLT(end)),
+		enrollment.Or(enrollment.EndsOnIsNil(), enrollment.EndsOnGTE(start)),
+		enrollment.Not(PausedIn(y, m)),
+	)
+}
+
+// PausedIn matches enrollments with a pause covering the whole month.
+func PausedIn(y, m int) predicate.Enrollment {
+	start, end := monthBounds(y, m)
+	return enrollment.HasPausesWith(
+		enrollmentpause.StartsOnLTE(start),
+		enrollmentpause.EndsOnGTE(end.AddDate(0, 0, -1)),
+	)
+}
+
+// MonthShare returns the share of the month's days the enrollment is active,
+// from 0 to 1. Paused days are left out when the enrollment's pauses are
+// loaded; pauses never overlap.
+func MonthShare(en *ent.Enrollment, y, m int) float64 {
+	start, end := monthBounds(y, m)
+	from, to := clip(start, end, en.StartsOn, en.EndsOn)
+	active := to.Sub(from)
+	for _, p := range en.Edges.Pauses {
+		pausedFrom, pausedTo := clip(from, to, &p.StartsOn, &p.EndsOn)
+		active -= pausedTo.Sub(pausedFrom)
+	}
+	if active <= 0 {
+		return 0
+	}
+	return active.Hours() / end.Sub(start).Hours()
+}
+
+// clip narrows the span from start to end to the days from first to last,
+// both inclusive and optional. An empty result has from equal to to.
+func clip(start, end time.Time, first, last *time.Time) (time.Time, time.Time) {
+	from, to := start, end
+	if first != nil && first.After(from) {
+		from = *first
+	}
+	if last != nil {
+		// The last day is included.
+		if next := last.AddDate(0, 0, 1); next.Before(to) {
+			to = next
+		}
+	}
+	if !to.After(from) {
+		return from, from
+	}
+	return from, to
+}
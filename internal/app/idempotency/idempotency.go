@@ -0,0 +1,126 @@
+// Package idempotency stores the responses to POST requests sent with an
+// Idempotency-Key header. A retry with the same key and body gets the stored
+// response back instead of running the mutation a second time.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/idempotencykey"
+	"langschool/internal/apperrors"
+)
+
+const (
+	// Retention is how long a key and its response are kept.
+	Retention = 24 * time.Hour
+	// MaxKeyLength is the longest key accepted.
+	MaxKeyLength = 255
+	// MaxStoredBody is the largest response body that is stored. Bigger
+	// responses (file downloads) are not kept and the key is released.
+	MaxStoredBody = 1 << 20
+)
+
+// Service manages idempotency keys.
+type Service struct {
+	db  *ent.Client
+	now func() time.Time
+}
+
+// New creates an idempotency service with the given database client.
+func New(db *ent.Client) *Service { return &Service{db: db, now: time.Now} }
+
+// Request identifies a keyed request.
+type Request struct {
+	UserID int
+	Key    string
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Response is a stored response to replay.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Hash returns the fingerprint a reused key must match.
+func (r Request) Hash() string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.Path))
+	h.Write([]byte{0})
+	h.Write(r.Body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Begin claims the key for the request. It returns the claim id when the
+// request should be handled, or the stored response when it was already
+// handled. A key reused with a different request, or one whose original
+// request is still running, is a conflict.
+func (s *Service) Begin(ctx context.Context, req Request) (int, *Response, error) {
+	if _, err := s.db.IdempotencyKey.Delete().
+		Where(idempotencykey.CreatedAtLT(s.now().Add(-Retention))).
+		Exec(ctx); err != nil {
+		return 0, nil, err
+	}
+
+	hash := req.Hash()
+	row, err := s.db.IdempotencyKey.Create().
+		SetUserID(req.UserID).
+		SetKey(req.Key).
+		SetMethod(req.Method).
+		SetPath(req.Path).
+		SetRequestHash(hash).
+		SetCreatedAt(s.now()).
+		Save(ctx)
+	if err == nil {
+		return row.ID, nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return 0, nil, err
+	}
+
+	existing, err := s.db.IdempotencyKey.Query().
+		Where(idempotencykey.UserIDEQ(req.UserID), idempotencykey.KeyEQ(req.Key)).
+		Only(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if existing.RequestHash != hash {
+		return 0, nil, apperrors.Conflict("Idempotency-Key was already used for a different request")
+	}
+	if existing.StatusCode == 0 {
+		return 0, nil, apperrors.Conflict("a request with this Idempotency-Key is still in progress")
+	}
+	return 0, &Response{
+		StatusCode:  existing.StatusCode,
+		ContentType: existing.ContentType,
+		Body:        existing.ResponseBody,
+	}, nil
+}
+
+// Complete stores the response for a claimed key.
+func (s *Service) Complete(ctx context.Context, id int, resp Response) error {
+	return s.db.IdempotencyKey.UpdateOneID(id).
+		SetStatusCode(resp.StatusCode).
+		SetContentType(resp.ContentType).
+		SetResponseBody(resp.Body).
+		Exec(ctx)
+}
+
+// Release forgets a claimed key so the request can be retried, e.g. after a
+// server error.
+func (s *Service) Release(ctx context.Context, id int) error {
+	err := s.db.IdempotencyKey.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent/enttest"
+	"langschool/internal/apperrors"
+)
+
+func TestBeginReplaysAndRejectsReusedKeys(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:idempotency?mode=memory&_fk=1")
+	defer client.Close()
+
+	now := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+	svc := &Service{db: client, now: func() time.Time { return now }}
+	req := Request{UserID: 1, Key: "k1", Method: "POST", Path: "/api/payments", Body: []byte(`{"amount":10}`)}
+
+	id, stored, err := svc.Begin(ctx, req)
+	if err != nil || stored != nil || id == 0 {
+		t.Fatalf("first Begin = %d, %+v, %v", id, stored, err)
+	}
+	if _, _, err := svc.Begin(ctx, req); !apperrors.IsConflict(err) {
+		t.Fatalf("Begin while in progress err = %v, want conflict", err)
+	}
+	if err := svc.Complete(ctx, id, Response{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"id":7}`)}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	_, stored, err = svc.Begin(ctx, req)
+	if err != nil || stored == nil || stored.StatusCode != 201 || string(stored.Body) != `{"id":7}` {
+		t.Fatalf("replay = %+v, %v", stored, err)
+	}
+
+	changed := req
+	changed.Body = []byte(`{"amount":20}`)
+	if _, _, err := svc.Begin(ctx, changed); !apperrors.IsConflict(err) {
+		t.Fatalf("Begin with different body err = %v, want conflict", err)
+	}
+	otherUser := req
+	otherUser.UserID = 2
+	if id, stored, err := svc.Begin(ctx, otherUser); err != nil || stored != nil || id == 0 {
+		t.Fatalf("Begin for another user = %d, %+v, %v", id, stored, err)
+	}
+
+	now = now.Add(Retention + time.Minute)
+	if id, stored, err := svc.Begin(ctx, changed); err != nil || stored != nil || id == 0 {
+		t.Fatalf("Begin after retention = %d, %+v, %v", id, stored, err)
+	}
+
+	released, _, err := svc.Begin(ctx, Request{UserID: 1, Key: "k2", Method: "POST", Path: "/api/payments"})
+	if err != nil {
+		t.Fatalf("Begin k2: %v", err)
+	}
+	if err := svc.Release(ctx, released); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if _, stored, err := svc.Begin(ctx, Request{UserID: 1, Key: "k2", Method: "POST", Path: "/api/payments"}); err != nil || stored != nil {
+		t.Fatalf("Begin after release = %+v, %v", stored, err)
+	}
+}
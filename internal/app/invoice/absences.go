@@ -0,0 +1,470 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/ent/settings"
+	"langschool/internal/app"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// What an excused absence yields.
+const (
+	AbsenceMakeup = "makeup" // A makeup lesson in another group within the makeup window
+	AbsenceCredit = "credit" // One lesson credited on the next draft
+)
+
+// Excused absence statuses.
+const (
+	AbsenceStatusOpen     = "open"     // Makeup not attended yet, window still open
+	AbsenceStatusMadeUp   = "made_up"  // Makeup attended
+	AbsenceStatusExpired  = "expired"  // Makeup window passed unused
+	AbsenceStatusPending  = "pending"  // Credit waiting for the next draft
+	AbsenceStatusCredited = "credited" // Credit on an invoice
+)
+
+// AbsencePolicy is the school policy for excused absences.
+type AbsencePolicy struct {
+	Mode        string
+	MakeupWeeks int
+}
+
+// AbsenceInput records an excused absence from a subscription lesson.
+type AbsenceInput struct {
+	StudentID  int    `json:"studentId"`
+	CourseID   int    `json:"courseId"`
+	LessonDate string `json:"lessonDate"` // YYYY-MM-DD
+	Reason     string `json:"reason"`
+}
+
+// AbsenceDTO is an excused absence with its makeup or credit.
+type AbsenceDTO struct {
+	ID                  int      `json:"id"`
+	StudentID           int      `json:"studentId"`
+	StudentName         string   `json:"studentName"`
+	CourseID            int      `json:"courseId"`
+	CourseName          string   `json:"courseName"`
+	LessonDate          string   `json:"lessonDate"`
+	Reason              string   `json:"reason"`
+	Resolution          string   `json:"resolution"`
+	Status              string   `json:"status"`
+	MakeupDueOn         string   `json:"makeupDueOn,omitempty"`
+	MakeupCourseID      *int     `json:"makeupCourseId,omitempty"`
+	MakeupCourseName    string   `json:"makeupCourseName,omitempty"`
+	MadeUpOn            string   `json:"madeUpOn,omitempty"`
+	Credit              *float64 `json:"credit,omitempty"` // deducted on the invoice
+	BilledInvoiceID     *int     `json:"billedInvoiceId,omitempty"`
+	BilledInvoiceStatus string   `json:"billedInvoiceStatus,omitempty"`
+	CreatedBy           string   `json:"createdBy"`
+	CreatedAt           string   `json:"createdAt"`
+}
+
+// AbsencePolicyFromSettings reads the excused-absence policy.
+func (s *Service) AbsencePolicyFromSettings(ctx context.Context) (AbsencePolicy, error) {
+	st, err := s.db.Settings.Query().Where(settings.SingletonIDEQ(app.SettingsSingletonID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return AbsencePolicy{Mode: AbsenceCredit, MakeupWeeks: 4}, nil
+		}
+		return AbsencePolicy{}, err
+	}
+	return AbsencePolicy{
+		Mode:        string(st.ExcusedAbsencePolicy),
+		MakeupWeeks: st.MakeupWindowWeeks,
+	}, nil
+}
+
+// Validate checks the policy before it is saved.
+func (p AbsencePolicy) Validate() error {
+	if p.Mode != AbsenceMakeup && p.Mode != AbsenceCredit {
+		return fmt.Errorf("invalid excused absence policy %q", p.Mode)
+	}
+	if p.MakeupWeeks < 1 || p.MakeupWeeks > 52 {
+		return errors.New("makeup window must be between 1 and 52 weeks")
+	}
+	return nil
+}
+
+// CreateAbsence records an excused absence from a subscription lesson. The
+// current policy decides whether it yields a makeup lesson or a credit; a
+// credit goes on the student's first draft from the lesson's month on.
+func (s *Service) CreateAbsence(ctx context.Context, in AbsenceInput, createdBy string) (*AbsenceDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.createAbsenceInStore(ctx, in, createdBy)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).createAbsenceInStore(ctx, in, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) createAbsenceInStore(ctx context.Context, in AbsenceInput, createdBy string) (*AbsenceDTO, error) {
+	lessonDate, err := time.Parse("2006-01-02", strings.TrimSpace(in.LessonDate))
+	if err != nil {
+		return nil, errors.New("некорректная дата урока")
+	}
+	subscribed, err := s.db.Enrollment.Query().
+		Where(
+			enrollment.StudentIDEQ(in.StudentID),
+			enrollment.CourseIDEQ(in.CourseID),
+			enrollment.BillingModeEQ(enrollment.BillingModeSubscription),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !subscribed {
+		return nil, errors.New("ученик должен быть записан на курс по абонементу")
+	}
+	exists, err := s.db.ExcusedAbsence.Query().
+		Where(
+			excusedabsence.StudentIDEQ(in.StudentID),
+			excusedabsence.CourseIDEQ(in.CourseID),
+			excusedabsence.LessonDateEQ(lessonDate),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apperrors.Conflict("пропуск этого урока уже отмечен")
+	}
+	policy, err := s.AbsencePolicyFromSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.db.ExcusedAbsence.Create().
+		SetStudentID(in.StudentID).
+		SetCourseID(in.CourseID).
+		SetLessonDate(lessonDate).
+		SetReason(strings.TrimSpace(in.Reason)).
+		SetResolution(excusedabsence.Resolution(policy.Mode)).
+		SetCreatedBy(createdBy).
+		SetCreatedAt(currentTime())
+	if policy.Mode == AbsenceMakeup {
+		create.SetMakeupDueOn(lessonDate.AddDate(0, 0, 7*policy.MakeupWeeks))
+	}
+	row, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if row.Resolution == excusedabsence.ResolutionCredit {
+		if err := s.rebuildDraftsFromInStore(ctx, row.StudentID, lessonDate.Year(), int(lessonDate.Month())); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetAbsence(ctx, row.ID)
+}
+
+// RecordMakeup marks the makeup lesson of an absence as attended in another
+// group.
+func (s *Service) RecordMakeup(ctx context.Context, id, courseID int, date string) (*AbsenceDTO, error) {
+	row, err := s.db.ExcusedAbsence.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if row.Resolution != excusedabsence.ResolutionMakeup {
+		return nil, apperrors.Conflict("за этот пропуск начисляется кредит, а не отработка")
+	}
+	if row.MadeUpOn != nil {
+		return nil, apperrors.Conflict("отработка уже отмечена")
+	}
+	madeUpOn, err := time.Parse("2006-01-02", strings.TrimSpace(date))
+	if err != nil {
+		return nil, errors.New("некорректная дата отработки")
+	}
+	if courseID == row.CourseID {
+		return nil, errors.New("отработка должна проходить в другой группе")
+	}
+	if _, err := s.db.Course.Get(ctx, courseID); err != nil {
+		return nil, err
+	}
+	if madeUpOn.Before(row.LessonDate) || madeUpOn.After(*row.MakeupDueOn) {
+		return nil, fmt.Errorf("отработка должна пройти с %s по %s", row.LessonDate.Format("02.01.2006"), row.MakeupDueOn.Format("02.01.2006"))
+	}
+	if _, err := row.Update().
+		SetMakeupCourseID(courseID).
+		SetMadeUpOn(madeUpOn).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetAbsence(ctx, id)
+}
+
+// DeleteAbsence removes an absence recorded by mistake. A credit already on
+// an issued invoice stays.
+func (s *Service) DeleteAbsence(ctx context.Context, id int) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.deleteAbsenceInStore(ctx, id)
+		}
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).deleteAbsenceInStore(ctx, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+func (s *Service) deleteAbsenceInStore(ctx context.Context, id int) error {
+	row, err := s.db.ExcusedAbsence.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	issued, err := s.db.InvoiceLine.Query().
+		Where(
+			invoiceline.AbsenceIDEQ(id),
+			invoiceline.HasInvoiceWith(invoice.StatusNEQ(invoice.StatusDraft)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if issued {
+		return apperrors.Conflict("кредит за пропуск уже выставлен в счёте")
+	}
+	if _, err := s.db.InvoiceLine.Delete().Where(invoiceline.AbsenceIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if err := s.db.ExcusedAbsence.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	if row.Resolution == excusedabsence.ResolutionCredit {
+		return s.rebuildDraftsFromInStore(ctx, row.StudentID, row.LessonDate.Year(), int(row.LessonDate.Month()))
+	}
+	return nil
+}
+
+// rebuildDraftsFromInStore rebuilds the student's monthly drafts from y/m on,
+// oldest first, so a credit lands on the earliest of them.
+func (s *Service) rebuildDraftsFromInStore(ctx context.Context, studentID, y, m int) error {
+	drafts, err := s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDEQ(studentID),
+			invoice.StatusEQ(invoice.StatusDraft),
+			invoice.KindEQ(invoice.KindMonthly),
+		).
+		Order(ent.Asc(invoice.FieldPeriodYear), ent.Asc(invoice.FieldPeriodMonth)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, iv := range drafts {
+		if monthIndex(iv.PeriodYear, iv.PeriodMonth) < monthIndex(y, m) {
+			continue
+		}
+		if _, err := s.rebuildDraftForStudentInStore(ctx, studentID, iv.PeriodYear, iv.PeriodMonth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAbsence returns one excused absence.
+func (s *Service) GetAbsence(ctx context.Context, id int) (*AbsenceDTO, error) {
+	row, err := s.db.ExcusedAbsence.Query().
+		Where(excusedabsence.IDEQ(id)).
+		WithStudent().
+		WithCourse().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.absenceDTO(ctx, row)
+}
+
+// ListAbsences returns excused absences, latest lesson first. Zero IDs do not
+// filter.
+func (s *Service) ListAbsences(ctx context.Context, studentID, courseID int) ([]AbsenceDTO, error) {
+	q := s.db.ExcusedAbsence.Query().
+		WithStudent().
+		WithCourse().
+		Order(ent.Desc(excusedabsence.FieldLessonDate), ent.Desc(excusedabsence.FieldID))
+	if studentID > 0 {
+		q = q.Where(excusedabsence.StudentIDEQ(studentID))
+	}
+	if courseID > 0 {
+		q = q.Where(excusedabsence.CourseIDEQ(courseID))
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]AbsenceDTO, 0, len(rows))
+	for _, row := range rows {
+		dto, err := s.absenceDTO(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *dto)
+	}
+	return out, nil
+}
+
+func (s *Service) absenceDTO(ctx context.Context, row *ent.ExcusedAbsence) (*AbsenceDTO, error) {
+	dto := &AbsenceDTO{
+		ID:             row.ID,
+		StudentID:      row.StudentID,
+		CourseID:       row.CourseID,
+		LessonDate:     row.LessonDate.Format("2006-01-02"),
+		Reason:         row.Reason,
+		Resolution:     string(row.Resolution),
+		MakeupCourseID: row.MakeupCourseID,
+		CreatedBy:      row.CreatedBy,
+		CreatedAt:      row.CreatedAt.Format(time.RFC3339),
+	}
+	if st := row.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	if c := row.Edges.Course; c != nil {
+		dto.CourseName = c.Name
+	}
+
+	if row.Resolution == excusedabsence.ResolutionMakeup {
+		if row.MakeupDueOn != nil {
+			dto.MakeupDueOn = row.MakeupDueOn.Format("2006-01-02")
+		}
+		switch {
+		case row.MadeUpOn != nil:
+			dto.Status = AbsenceStatusMadeUp
+			dto.MadeUpOn = row.MadeUpOn.Format("2006-01-02")
+			if c, err := s.db.Course.Get(ctx, *row.MakeupCourseID); err == nil {
+				dto.MakeupCourseName = c.Name
+			}
+		case row.MakeupDueOn != nil && currentTime().After(row.MakeupDueOn.AddDate(0, 0, 1)):
+			dto.Status = AbsenceStatusExpired
+		default:
+			dto.Status = AbsenceStatusOpen
+		}
+		return dto, nil
+	}
+
+	dto.Status = AbsenceStatusPending
+	line, err := s.db.InvoiceLine.Query().
+		Where(
+			invoiceline.AbsenceIDEQ(row.ID),
+			invoiceline.HasInvoiceWith(invoice.StatusNEQ(invoice.StatusCanceled)),
+		).
+		WithInvoice().
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if line != nil {
+		credit := money.CentsToEuros(-line.AmountCents)
+		dto.Status = AbsenceStatusCredited
+		dto.Credit = &credit
+		dto.BilledInvoiceID = &line.InvoiceID
+		if iv := line.Edges.Invoice; iv != nil {
+			dto.BilledInvoiceStatus = string(iv.Status)
+		}
+	}
+	return dto, nil
+}
+
+// dueAbsenceCredits returns the student's credited absences to deduct on the
+// y/m draft: those from lessons up to that month not credited on another of
+// the student's invoices. Lines on canceled invoices do not count, so such
+// credits are given again.
+func (s *Service) dueAbsenceCredits(ctx context.Context, studentID, y, m int) ([]*ent.ExcusedAbsence, error) {
+	monthEnd := time.Date(y, time.Month(m)+1, 1, 0, 0, 0, 0, time.UTC)
+	return s.db.ExcusedAbsence.Query().
+		Where(
+			excusedabsence.StudentIDEQ(studentID),
+			excusedabsence.ResolutionEQ(excusedabsence.ResolutionCredit),
+			excusedabsence.LessonDateLT(monthEnd),
+			excusedabsence.Not(excusedabsence.HasInvoiceLinesWith(
+				invoiceline.HasInvoiceWith(
+					invoice.StatusNEQ(invoice.StatusCanceled),
+					invoice.Or(invoice.PeriodYearNEQ(y), invoice.PeriodMonthNEQ(m)),
+				),
+			)),
+		).
+		WithCourse().
+		Order(ent.Asc(excusedabsence.FieldLessonDate)).
+		All(ctx)
+}
+
+// absenceCreditLine builds the draft line deducting one lesson for an
+// excused absence, at the subscription price of the lesson's month. It
+// returns nil when the student is no longer enrolled in the course.
+func (s *Service) absenceCreditLine(ctx context.Context, ab *ent.ExcusedAbsence) (*ent.InvoiceLineCreate, int64, error) {
+	en, err := s.db.Enrollment.Query().
+		Where(enrollment.StudentIDEQ(ab.StudentID), enrollment.CourseIDEQ(ab.CourseID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	y, m := ab.LessonDate.Year(), int(ab.LessonDate.Month())
+	lessonPriceCents, _, err := CoursePricesFor(ctx, s.db, en, y, m)
+	if err != nil {
+		return nil, 0, err
+	}
+	_, unitPriceCents, err := EnrollmentPricesFor(ctx, s.db, en, y, m)
+	if err != nil {
+		return nil, 0, err
+	}
+	if unitPriceCents < 0 {
+		unitPriceCents = lessonPriceCents
+	}
+	if unitPriceCents <= 0 {
+		return nil, 0, nil
+	}
+	courseName := ""
+	if ab.Edges.Course != nil {
+		courseName = ab.Edges.Course.Name
+	}
+	line := s.db.InvoiceLine.Create().
+		SetAbsenceID(ab.ID).
+		SetDescription(absenceCreditLineDescription(courseName, ab.LessonDate)).
+		SetQty(-1). // e-invoices allow negative quantities, not prices
+		SetUnitPriceCents(unitPriceCents).
+		SetAmountCents(-unitPriceCents)
+	return line, -unitPriceCents, nil
+}
+
+func absenceCreditLineDescription(courseName string, lessonDate time.Time) string {
+	return fmt.Sprintf("Atlaide par attaisnotu kavējumu %s (%s)", lessonDate.Format("02.01.2006"), courseName)
+}
@@ -0,0 +1,233 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/settings"
+	"langschool/internal/app"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+func TestExcusedAbsencesAreCreditedOnTheNextDraft(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-absence-credit?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetExcusedAbsencePolicy(settings.ExcusedAbsencePolicyCredit).
+		SetMakeupWindowWeeks(4).
+		Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Excused Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Gleznošana").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(money.EurosToCents(80)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModeSubscription).
+		SetSubscriptionLessonPriceCents(money.EurosToCents(20)).
+		SetChargeMaterials(false).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	// Four lessons held in April and in May.
+	for _, m := range []int{4, 5} {
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(2026).
+			SetMonth(m).
+			SetHours(4).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+
+	draft := func(m int) *ent.Invoice {
+		t.Helper()
+		if _, err := svc.GenerateDrafts(ctx, 2026, m); err != nil {
+			t.Fatalf("GenerateDrafts: %v", err)
+		}
+		iv, err := client.Invoice.Query().
+			Where(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(2026), invoice.PeriodMonthEQ(m)).
+			WithLines().
+			Only(ctx)
+		if err != nil {
+			t.Fatalf("Invoice.Query: %v", err)
+		}
+		return iv
+	}
+
+	april := draft(4)
+	first, err := svc.CreateAbsence(ctx, AbsenceInput{StudentID: st.ID, CourseID: crs.ID, LessonDate: "2026-04-14", Reason: "slimība"}, "admin")
+	if err != nil {
+		t.Fatalf("CreateAbsence: %v", err)
+	}
+	if first.Resolution != AbsenceCredit || first.Status != AbsenceStatusCredited || first.Credit == nil || *first.Credit != 20 ||
+		first.BilledInvoiceID == nil || *first.BilledInvoiceID != april.ID {
+		t.Fatalf("absence = %+v, want credited on the April draft", first)
+	}
+	april = draft(4)
+	if april.TotalAmountCents != money.EurosToCents(60) || len(april.Edges.Lines) != 2 ||
+		april.Edges.Lines[1].Description != "Atlaide par attaisnotu kavējumu 14.04.2026 (Gleznošana)" ||
+		april.Edges.Lines[1].AmountCents != -money.EurosToCents(20) {
+		t.Fatalf("April draft = %+v, lines = %+v", april, april.Edges.Lines)
+	}
+	if _, err := svc.CreateAbsence(ctx, AbsenceInput{StudentID: st.ID, CourseID: crs.ID, LessonDate: "2026-04-14"}, "admin"); !apperrors.IsConflict(err) {
+		t.Fatalf("duplicate absence error = %v, want conflict", err)
+	}
+
+	if _, err := svc.issueOne(ctx, april.ID); err != nil {
+		t.Fatalf("issueOne: %v", err)
+	}
+	if err := svc.DeleteAbsence(ctx, first.ID); !apperrors.IsConflict(err) {
+		t.Fatalf("delete issued credit error = %v, want conflict", err)
+	}
+
+	// April is issued, so a later April absence goes on the May draft.
+	second, err := svc.CreateAbsence(ctx, AbsenceInput{StudentID: st.ID, CourseID: crs.ID, LessonDate: "2026-04-21"}, "admin")
+	if err != nil {
+		t.Fatalf("CreateAbsence: %v", err)
+	}
+	if second.Status != AbsenceStatusPending {
+		t.Fatalf("absence = %+v, want pending until the next draft", second)
+	}
+	may := draft(5)
+	if may.TotalAmountCents != money.EurosToCents(60) {
+		t.Fatalf("May total = %d, want 4 lessons less one credit", may.TotalAmountCents)
+	}
+	if err := svc.DeleteAbsence(ctx, second.ID); err != nil {
+		t.Fatalf("DeleteAbsence: %v", err)
+	}
+	may = draft(5)
+	if may.TotalAmountCents != money.EurosToCents(80) {
+		t.Fatalf("May total after deleting the credit = %d, want 80.00", may.TotalAmountCents)
+	}
+}
+
+func TestExcusedAbsencesYieldMakeupLessons(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-absence-makeup?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetExcusedAbsencePolicy(settings.ExcusedAbsencePolicyMakeup).
+		SetMakeupWindowWeeks(2).
+		Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Excused Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Gleznošana").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(money.EurosToCents(80)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModeSubscription).
+		SetSubscriptionLessonPriceCents(money.EurosToCents(20)).
+		SetChargeMaterials(false).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	// Four lessons held in April and in May.
+	for _, m := range []int{4, 5} {
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(2026).
+			SetMonth(m).
+			SetHours(4).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+
+	draft := func(m int) *ent.Invoice {
+		t.Helper()
+		if _, err := svc.GenerateDrafts(ctx, 2026, m); err != nil {
+			t.Fatalf("GenerateDrafts: %v", err)
+		}
+		iv, err := client.Invoice.Query().
+			Where(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(2026), invoice.PeriodMonthEQ(m)).
+			WithLines().
+			Only(ctx)
+		if err != nil {
+			t.Fatalf("Invoice.Query: %v", err)
+		}
+		return iv
+	}
+	setInvoiceCurrentTime(t, time.Date(2026, 4, 20, 12, 0, 0, 0, time.UTC))
+
+	other, err := client.Course.Create().
+		SetName("Gleznošana (otrdienas grupa)").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	ab, err := svc.CreateAbsence(ctx, AbsenceInput{StudentID: st.ID, CourseID: crs.ID, LessonDate: "2026-04-14"}, "admin")
+	if err != nil {
+		t.Fatalf("CreateAbsence: %v", err)
+	}
+	if ab.Resolution != AbsenceMakeup || ab.Status != AbsenceStatusOpen || ab.MakeupDueOn != "2026-04-28" {
+		t.Fatalf("absence = %+v, want an open makeup due in two weeks", ab)
+	}
+	if april := draft(4); april.TotalAmountCents != money.EurosToCents(80) {
+		t.Fatalf("April total = %d, want no credit for a makeup", april.TotalAmountCents)
+	}
+	if _, err := svc.RecordMakeup(ctx, ab.ID, other.ID, "2026-05-05"); err == nil {
+		t.Fatal("RecordMakeup after the window succeeded, want error")
+	}
+	if _, err := svc.RecordMakeup(ctx, ab.ID, crs.ID, "2026-04-22"); err == nil {
+		t.Fatal("RecordMakeup in the same group succeeded, want error")
+	}
+	ab, err = svc.RecordMakeup(ctx, ab.ID, other.ID, "2026-04-22")
+	if err != nil {
+		t.Fatalf("RecordMakeup: %v", err)
+	}
+	if ab.Status != AbsenceStatusMadeUp || ab.MakeupCourseName != other.Name || ab.MadeUpOn != "2026-04-22" {
+		t.Fatalf("absence = %+v, want made up", ab)
+	}
+
+	unused, err := svc.CreateAbsence(ctx, AbsenceInput{StudentID: st.ID, CourseID: crs.ID, LessonDate: "2026-04-07"}, "admin")
+	if err != nil {
+		t.Fatalf("CreateAbsence: %v", err)
+	}
+	setInvoiceCurrentTime(t, time.Date(2026, 4, 23, 12, 0, 0, 0, time.UTC))
+	if unused, err = svc.GetAbsence(ctx, unused.ID); err != nil || unused.Status != AbsenceStatusExpired {
+		t.Fatalf("GetAbsence = %+v, %v; want expired", unused, err)
+	}
+}
@@ -0,0 +1,379 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/enrollment"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/ent/studentcharge"
+	"langschool/internal/app/vat"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// Student charge kinds.
+const (
+	ChargeKindOneOff = "one_off" // Billed once, e.g. a registration fee
+	ChargeKindYearly = "yearly"  // Billed every year in the same month, e.g. a membership fee
+)
+
+// ChargeInput describes a new student charge. A one-off charge without a due
+// month is due in the month the student first enrolled; a yearly charge
+// without a first year starts this year.
+type ChargeInput struct {
+	StudentID   int     `json:"studentId"`
+	Kind        string  `json:"kind"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+	VATRate     float64 `json:"vatRate"`
+	DueYear     int     `json:"dueYear"`
+	DueMonth    int     `json:"dueMonth"`
+}
+
+// ChargeBillingDTO is the invoice an occurrence of a charge is billed on.
+type ChargeBillingDTO struct {
+	Year          int     `json:"year"` // occurrence; the due year for one-off charges
+	InvoiceID     int     `json:"invoiceId"`
+	InvoiceNumber *string `json:"invoiceNumber,omitempty"`
+	InvoiceStatus string  `json:"invoiceStatus"`
+	PeriodYear    int     `json:"periodYear"`
+	PeriodMonth   int     `json:"periodMonth"`
+}
+
+// ChargeDTO is a student charge with the invoices it is billed on.
+type ChargeDTO struct {
+	ID          int                `json:"id"`
+	StudentID   int                `json:"studentId"`
+	StudentName string             `json:"studentName"`
+	Kind        string             `json:"kind"`
+	Description string             `json:"description"`
+	Amount      float64            `json:"amount"`
+	VATRate     float64            `json:"vatRate"`
+	DueYear     int                `json:"dueYear"`
+	DueMonth    int                `json:"dueMonth"`
+	CreatedBy   string             `json:"createdBy"`
+	CreatedAt   string             `json:"createdAt"`
+	CanceledAt  *string            `json:"canceledAt,omitempty"`
+	CanceledBy  string             `json:"canceledBy,omitempty"`
+	Billed      []ChargeBillingDTO `json:"billed"`
+}
+
+// chargeOccurrence is one due occurrence of a charge.
+type chargeOccurrence struct {
+	charge *ent.StudentCharge
+	year   int
+}
+
+// CreateCharge adds a one-off or yearly charge for a student.
+func (s *Service) CreateCharge(ctx context.Context, in ChargeInput, createdBy string) (*ChargeDTO, error) {
+	if _, err := s.db.Student.Get(ctx, in.StudentID); err != nil {
+		return nil, err
+	}
+	description := strings.TrimSpace(in.Description)
+	if description == "" {
+		return nil, errors.New("описание начисления должно быть указано")
+	}
+	amountCents := money.EurosToCents(in.Amount)
+	if amountCents <= 0 {
+		return nil, errors.New("сумма начисления должна быть больше нуля")
+	}
+	if err := vat.ValidateRate(in.VATRate); err != nil {
+		return nil, err
+	}
+	if in.DueMonth < 0 || in.DueMonth > 12 {
+		return nil, errors.New("месяц начисления должен быть от 1 до 12")
+	}
+
+	now := currentTime()
+	dueYear, dueMonth := in.DueYear, in.DueMonth
+	switch in.Kind {
+	case ChargeKindOneOff:
+		if dueYear == 0 || dueMonth == 0 {
+			first, err := s.firstEnrollmentMonth(ctx, in.StudentID)
+			if err != nil {
+				return nil, err
+			}
+			if first.IsZero() {
+				first = now
+			}
+			dueYear, dueMonth = first.Year(), int(first.Month())
+		}
+	case ChargeKindYearly:
+		if dueMonth == 0 {
+			return nil, errors.New("месяц ежегодного начисления должен быть указан")
+		}
+		if dueYear == 0 {
+			dueYear = now.Year()
+		}
+	default:
+		return nil, fmt.Errorf("некорректный тип начисления %q", in.Kind)
+	}
+
+	row, err := s.db.StudentCharge.Create().
+		SetStudentID(in.StudentID).
+		SetKind(studentcharge.Kind(in.Kind)).
+		SetDescription(description).
+		SetAmountCents(amountCents).
+		SetVatRatePct(in.VATRate).
+		SetDueYear(dueYear).
+		SetDueMonth(dueMonth).
+		SetCreatedBy(createdBy).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCharge(ctx, row.ID)
+}
+
+// firstEnrollmentMonth returns when the student's earliest enrollment was
+// made, or the zero time when there is none.
+func (s *Service) firstEnrollmentMonth(ctx context.Context, studentID int) (time.Time, error) {
+	en, err := s.db.Enrollment.Query().
+		Where(enrollment.StudentIDEQ(studentID), enrollment.CreatedAtNotNil()).
+		Order(ent.Asc(enrollment.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *en.CreatedAt, nil
+}
+
+// CancelCharge stops a charge from being billed again. Drafts that already
+// bill it are rebuilt without it; issued invoices are not touched.
+func (s *Service) CancelCharge(ctx context.Context, id int, canceledBy string) (*ChargeDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.cancelChargeInStore(ctx, id, canceledBy)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).cancelChargeInStore(ctx, id, canceledBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) cancelChargeInStore(ctx context.Context, id int, canceledBy string) (*ChargeDTO, error) {
+	charge, err := s.db.StudentCharge.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if charge.CanceledAt != nil {
+		return nil, apperrors.Conflict("начисление уже отменено")
+	}
+	if _, err := charge.Update().
+		SetCanceledAt(currentTime()).
+		SetCanceledBy(canceledBy).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	drafts, err := s.db.Invoice.Query().
+		Where(
+			invoice.StatusEQ(invoice.StatusDraft),
+			invoice.HasLinesWith(invoiceline.ChargeIDEQ(id)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, iv := range drafts {
+		if _, err := s.rebuildDraftForStudentInStore(ctx, iv.StudentID, iv.PeriodYear, iv.PeriodMonth); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetCharge(ctx, id)
+}
+
+// GetCharge returns one student charge.
+func (s *Service) GetCharge(ctx context.Context, id int) (*ChargeDTO, error) {
+	charge, err := s.db.StudentCharge.Query().
+		Where(studentcharge.IDEQ(id)).
+		WithStudent().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.chargeDTO(ctx, charge)
+}
+
+// ListCharges returns student charges, newest first. A studentID of 0 lists
+// the charges of all students.
+func (s *Service) ListCharges(ctx context.Context, studentID int) ([]ChargeDTO, error) {
+	q := s.db.StudentCharge.Query().
+		WithStudent().
+		Order(ent.Desc(studentcharge.FieldID))
+	if studentID > 0 {
+		q = q.Where(studentcharge.StudentIDEQ(studentID))
+	}
+	charges, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ChargeDTO, 0, len(charges))
+	for _, charge := range charges {
+		dto, err := s.chargeDTO(ctx, charge)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *dto)
+	}
+	return out, nil
+}
+
+func (s *Service) chargeDTO(ctx context.Context, charge *ent.StudentCharge) (*ChargeDTO, error) {
+	dto := &ChargeDTO{
+		ID:          charge.ID,
+		StudentID:   charge.StudentID,
+		Kind:        string(charge.Kind),
+		Description: charge.Description,
+		Amount:      money.CentsToEuros(charge.AmountCents),
+		VATRate:     charge.VatRatePct,
+		DueYear:     charge.DueYear,
+		DueMonth:    charge.DueMonth,
+		CreatedBy:   charge.CreatedBy,
+		CreatedAt:   charge.CreatedAt.Format(time.RFC3339),
+		CanceledBy:  charge.CanceledBy,
+		Billed:      []ChargeBillingDTO{},
+	}
+	if st := charge.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	if charge.CanceledAt != nil {
+		canceledAt := charge.CanceledAt.Format(time.RFC3339)
+		dto.CanceledAt = &canceledAt
+	}
+	lines, err := s.db.InvoiceLine.Query().
+		Where(invoiceline.ChargeIDEQ(charge.ID)).
+		WithInvoice().
+		Order(ent.Asc(invoiceline.FieldChargeYear), ent.Asc(invoiceline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		iv := line.Edges.Invoice
+		if iv == nil {
+			continue
+		}
+		dto.Billed = append(dto.Billed, ChargeBillingDTO{
+			Year:          line.ChargeYear,
+			InvoiceID:     iv.ID,
+			InvoiceNumber: iv.Number,
+			InvoiceStatus: string(iv.Status),
+			PeriodYear:    iv.PeriodYear,
+			PeriodMonth:   iv.PeriodMonth,
+		})
+	}
+	return dto, nil
+}
+
+// dueChargeOccurrences returns the occurrences of a student's charges to
+// bill on the y/m draft: those due by then and not billed on another of the
+// student's invoices. Lines on canceled invoices do not count, so such
+// charges are billed again.
+func (s *Service) dueChargeOccurrences(ctx context.Context, studentID, y, m int) ([]chargeOccurrence, error) {
+	charges, err := s.db.StudentCharge.Query().
+		Where(
+			studentcharge.StudentIDEQ(studentID),
+			studentcharge.CanceledAtIsNil(),
+		).
+		Order(ent.Asc(studentcharge.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []chargeOccurrence
+	for _, charge := range charges {
+		for _, year := range chargeYearsDue(charge, y, m) {
+			billedElsewhere, err := s.db.InvoiceLine.Query().
+				Where(
+					invoiceline.ChargeIDEQ(charge.ID),
+					invoiceline.ChargeYearEQ(year),
+					invoiceline.HasInvoiceWith(
+						invoice.StatusNEQ(invoice.StatusCanceled),
+						invoice.Or(invoice.PeriodYearNEQ(y), invoice.PeriodMonthNEQ(m)),
+					),
+				).
+				Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !billedElsewhere {
+				out = append(out, chargeOccurrence{charge: charge, year: year})
+			}
+		}
+	}
+	return out, nil
+}
+
+// chargeYearsDue lists the occurrences of a charge that are due by y/m.
+func chargeYearsDue(charge *ent.StudentCharge, y, m int) []int {
+	dueBy := func(year int) bool {
+		return year < y || (year == y && charge.DueMonth <= m)
+	}
+	if charge.Kind == studentcharge.KindOneOff {
+		if dueBy(charge.DueYear) {
+			return []int{charge.DueYear}
+		}
+		return nil
+	}
+	var years []int
+	for year := charge.DueYear; dueBy(year); year++ {
+		years = append(years, year)
+	}
+	return years
+}
+
+func chargeLineDescription(oc chargeOccurrence) string {
+	if oc.charge.Kind == studentcharge.KindYearly {
+		return fmt.Sprintf("%s %d", oc.charge.Description, oc.year)
+	}
+	return oc.charge.Description
+}
+
+// chargeLine builds the draft line for a charge occurrence.
+func (s *Service) chargeLine(oc chargeOccurrence) *ent.InvoiceLineCreate {
+	return s.db.InvoiceLine.Create().
+		SetChargeID(oc.charge.ID).
+		SetChargeYear(oc.year).
+		SetDescription(chargeLineDescription(oc)).
+		SetQty(1).
+		SetUnitPriceCents(oc.charge.AmountCents).
+		SetAmountCents(oc.charge.AmountCents)
+}
+
+// chargeVAT returns the VAT rate and exemption note of a charge, in the same
+// way as courseVAT does for lessons.
+func chargeVAT(charge *ent.StudentCharge, vatEnabled bool) (float64, string) {
+	if !vatEnabled {
+		return 0, ""
+	}
+	if charge.VatRatePct > 0 {
+		return charge.VatRatePct, ""
+	}
+	return 0, vat.DefaultExemptNote
+}
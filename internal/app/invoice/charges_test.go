@@ -0,0 +1,195 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/internal/app"
+)
+
+func chargeLinesOn(t *testing.T, client *ent.Client, studentID, y, m int) []*ent.InvoiceLine {
+	t.Helper()
+	lines, err := client.InvoiceLine.Query().
+		Where(
+			invoiceline.ChargeIDNotNil(),
+			invoiceline.HasInvoiceWith(
+				invoice.StudentIDEQ(studentID),
+				invoice.PeriodYearEQ(y),
+				invoice.PeriodMonthEQ(m),
+			),
+		).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("InvoiceLine.Query: %v", err)
+	}
+	return lines
+}
+
+func TestOneOffChargeIsBilledExactlyOnce(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-charge-once?mode=memory&_fk=1")
+	defer client.Close()
+	svc := New(client)
+
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("New Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+
+	setInvoiceCurrentTime(t, time.Date(2026, 9, 3, 10, 0, 0, 0, time.Local))
+	if _, err := svc.CreateCharge(ctx, ChargeInput{StudentID: st.ID, Kind: ChargeKindOneOff, Amount: 0}, "admin"); err == nil {
+		t.Fatal("CreateCharge without amount succeeded")
+	}
+	charge, err := svc.CreateCharge(ctx, ChargeInput{
+		StudentID:   st.ID,
+		Kind:        ChargeKindOneOff,
+		Description: "Reģistrācijas maksa",
+		Amount:      20,
+	}, "admin")
+	if err != nil {
+		t.Fatalf("CreateCharge: %v", err)
+	}
+	if charge.DueYear != 2026 || charge.DueMonth != 9 {
+		t.Fatalf("due = %d-%d, want 2026-9", charge.DueYear, charge.DueMonth)
+	}
+
+	// Not due yet in August; a student without lessons still gets a draft in September.
+	if _, err := svc.GenerateDrafts(ctx, 2026, 8); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 8); len(lines) != 0 {
+		t.Fatalf("August charge lines = %d, want 0", len(lines))
+	}
+	res, err := svc.GenerateDrafts(ctx, 2026, 9)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.Created != 1 {
+		t.Fatalf("Created = %d, want 1", res.Created)
+	}
+	if _, err := svc.RebuildStudentDraft(ctx, st.ID, 2026, 9); err != nil {
+		t.Fatalf("RebuildStudentDraft: %v", err)
+	}
+	lines := chargeLinesOn(t, client, st.ID, 2026, 9)
+	if len(lines) != 1 || lines[0].AmountCents != 2000 || lines[0].Description != "Reģistrācijas maksa" {
+		t.Fatalf("September charge lines = %+v, want one of 2000 cents", lines)
+	}
+
+	// Once issued, and again after reopening, the next month does not bill it.
+	september, err := client.Invoice.Query().
+		Where(invoice.StudentIDEQ(st.ID), invoice.PeriodMonthEQ(9)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Query: %v", err)
+	}
+	if _, err := svc.IssueOne(ctx, september.ID); err != nil {
+		t.Fatalf("IssueOne: %v", err)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 10); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 10); len(lines) != 0 {
+		t.Fatalf("October charge lines after issue = %d, want 0", len(lines))
+	}
+	if err := svc.ReopenDraft(ctx, september.ID, t.TempDir()); err != nil {
+		t.Fatalf("ReopenDraft: %v", err)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 9); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 10); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 9); len(lines) != 1 {
+		t.Fatalf("September charge lines after reopen = %d, want 1", len(lines))
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 10); len(lines) != 0 {
+		t.Fatalf("October charge lines after reopen = %d, want 0", len(lines))
+	}
+
+	// A deleted draft frees the charge for the next draft.
+	september, err = client.Invoice.Get(ctx, september.ID)
+	if err != nil {
+		t.Fatalf("Invoice.Get: %v", err)
+	}
+	if err := svc.DeleteDraftWithVersion(ctx, september.ID, september.Version); err != nil {
+		t.Fatalf("DeleteDraftWithVersion: %v", err)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 10); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 10); len(lines) != 1 {
+		t.Fatalf("October charge lines after delete = %d, want 1", len(lines))
+	}
+	got, err := svc.GetCharge(ctx, charge.ID)
+	if err != nil {
+		t.Fatalf("GetCharge: %v", err)
+	}
+	if len(got.Billed) != 1 || got.Billed[0].PeriodMonth != 10 {
+		t.Fatalf("billed = %+v, want October", got.Billed)
+	}
+}
+
+func TestYearlyChargeIsBilledEachYearUntilCanceled(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-charge-yearly?mode=memory&_fk=1")
+	defer client.Close()
+	svc := New(client)
+
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Member").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	charge, err := svc.CreateCharge(ctx, ChargeInput{
+		StudentID:   st.ID,
+		Kind:        ChargeKindYearly,
+		Description: "Biedra nauda",
+		Amount:      35,
+		DueYear:     2025,
+		DueMonth:    9,
+	}, "admin")
+	if err != nil {
+		t.Fatalf("CreateCharge: %v", err)
+	}
+
+	for _, period := range [][2]int{{2025, 9}, {2025, 10}, {2026, 9}} {
+		if _, err := svc.GenerateDrafts(ctx, period[0], period[1]); err != nil {
+			t.Fatalf("GenerateDrafts %v: %v", period, err)
+		}
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2025, 9); len(lines) != 1 || lines[0].Description != "Biedra nauda 2025" {
+		t.Fatalf("2025 lines = %+v", lines)
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2025, 10); len(lines) != 0 {
+		t.Fatalf("October 2025 lines = %d, want 0", len(lines))
+	}
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 9); len(lines) != 1 || lines[0].ChargeYear != 2026 {
+		t.Fatalf("2026 lines = %+v", lines)
+	}
+
+	canceled, err := svc.CancelCharge(ctx, charge.ID, "admin")
+	if err != nil {
+		t.Fatalf("CancelCharge: %v", err)
+	}
+	if canceled.CanceledAt == nil || canceled.CanceledBy != "admin" {
+		t.Fatalf("canceled charge = %+v", canceled)
+	}
+	// The drafts that billed it are rebuilt without it.
+	if lines := chargeLinesOn(t, client, st.ID, 2026, 9); len(lines) != 0 {
+		t.Fatalf("2026 lines after cancel = %d, want 0", len(lines))
+	}
+	if _, err := svc.CancelCharge(ctx, charge.ID, "admin"); err == nil {
+		t.Fatal("second CancelCharge succeeded")
+	}
+}
@@ -0,0 +1,174 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/internal/money"
+)
+
+func TestEnrollmentDatesLimitAndProrateBilling(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-enrollment-dates?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+
+	st, err := client.Student.Create().SetFullName("Dated Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	subscriptionCourse, err := client.Course.Create().
+		SetName("Gleznošana").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(money.EurosToCents(80)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	endedCourse, err := client.Course.Create().
+		SetName("Vācu valoda").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(15)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	// Joins halfway through April.
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(subscriptionCourse.ID).
+		SetBillingMode(enrollment.BillingModeSubscription).
+		SetSubscriptionLessonPriceCents(money.EurosToCents(20)).
+		SetChargeMaterials(false).
+		SetStartsOn(time.Date(2026, 4, 16, 0, 0, 0, 0, time.UTC)).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	// Left at the end of March.
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(endedCourse.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		SetChargeMaterials(false).
+		SetEndsOn(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	for _, c := range []*ent.Course{subscriptionCourse, endedCourse} {
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(c.ID).
+			SetYear(2026).
+			SetMonth(4).
+			SetHours(4).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+
+	if _, err := svc.GenerateDrafts(ctx, 2026, 4); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	iv, err := client.Invoice.Query().
+		Where(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(2026), invoice.PeriodMonthEQ(4)).
+		WithLines().
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Query: %v", err)
+	}
+	if len(iv.Edges.Lines) != 1 {
+		t.Fatalf("lines = %+v, want only the subscription line", iv.Edges.Lines)
+	}
+	if line := iv.Edges.Lines[0]; line.Qty != 2 || iv.TotalAmountCents != money.EurosToCents(40) {
+		t.Fatalf("subscription line = %+v, total = %d; want half of 4 lessons", line, iv.TotalAmountCents)
+	}
+
+	res, err := svc.GenerateDrafts(ctx, 2026, 3)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.Created != 0 {
+		t.Fatalf("March result = %+v, want no invoice before the subscription starts", res)
+	}
+}
+
+func TestEnrollmentPausesSkipAndProrateBilling(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-enrollment-pauses?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+
+	st, err := client.Student.Create().SetFullName("Paused Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Gleznošana").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(money.EurosToCents(80)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	en, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModeSubscription).
+		SetSubscriptionLessonPriceCents(money.EurosToCents(20)).
+		SetChargeMaterials(false).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	// Frozen for all of May and the first half of June.
+	if _, err := client.EnrollmentPause.Create().
+		SetEnrollmentID(en.ID).
+		SetStartsOn(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)).
+		SetEndsOn(time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)).
+		SetReason("slimība").
+		Save(ctx); err != nil {
+		t.Fatalf("EnrollmentPause.Create: %v", err)
+	}
+	for _, m := range []int{5, 6} {
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(2026).
+			SetMonth(m).
+			SetHours(4).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+
+	res, err := svc.GenerateDrafts(ctx, 2026, 5)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.Created != 0 {
+		t.Fatalf("May result = %+v, want the paused month skipped", res)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 6); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	iv, err := client.Invoice.Query().
+		Where(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(2026), invoice.PeriodMonthEQ(6)).
+		WithLines().
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Query: %v", err)
+	}
+	if len(iv.Edges.Lines) != 1 || iv.Edges.Lines[0].Qty != 2 || iv.TotalAmountCents != money.EurosToCents(40) {
+		t.Fatalf("June invoice = %+v, lines = %+v; want half of 4 lessons", iv, iv.Edges.Lines)
+	}
+}
@@ -0,0 +1,426 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/invoice"
+	"langschool/ent/latefee"
+	"langschool/ent/payment"
+	"langschool/ent/settings"
+	"langschool/internal/app"
+	paysvc "langschool/internal/app/payment"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// Late-fee modes.
+const (
+	LateFeeModeOff           = "off"            // No late fees
+	LateFeeModeFlat          = "flat"           // One flat fee per overdue invoice
+	LateFeeModeDailyInterest = "daily_interest" // A share of the unpaid amount per day late
+)
+
+// Late-fee statuses.
+const (
+	LateFeeStatusBilled = "billed" // On the draft of its period, or on the invoice issued from it
+	LateFeeStatusWaived = "waived" // Forgiven; not charged again
+)
+
+// Late fees are compensation rather than payment for a service, so they are
+// outside the scope of VAT.
+const lateFeeVATExemptNote = "Nav PVN objekts"
+
+// LateFeePolicy describes how late fees are charged.
+type LateFeePolicy struct {
+	Mode         string
+	FlatCents    int64
+	DailyRatePct float64
+	GraceDays    int
+	CapCents     int64 // per overdue invoice, across all fees; 0 = no cap
+}
+
+// LateFeeDTO is one late fee.
+type LateFeeDTO struct {
+	ID                  int     `json:"id"`
+	StudentID           int     `json:"studentId"`
+	StudentName         string  `json:"studentName"`
+	OverdueInvoiceID    int     `json:"overdueInvoiceId"`
+	OverdueInvoiceNo    string  `json:"overdueInvoiceNumber"`
+	PeriodYear          int     `json:"periodYear"` // period of the draft the fee is billed on
+	PeriodMonth         int     `json:"periodMonth"`
+	BilledInvoiceID     *int    `json:"billedInvoiceId,omitempty"`
+	BilledInvoiceStatus string  `json:"billedInvoiceStatus,omitempty"`
+	Amount              float64 `json:"amount"`
+	DaysLate            int     `json:"daysLate"`
+	Status              string  `json:"status"`
+	WaivedReason        string  `json:"waivedReason,omitempty"`
+	WaivedBy            string  `json:"waivedBy,omitempty"`
+	WaivedAt            string  `json:"waivedAt,omitempty"`
+	CreatedAt           string  `json:"createdAt"`
+}
+
+// lateFeeCharge is a fee computed for a draft but not yet stored.
+type lateFeeCharge struct {
+	invoiceID   int
+	number      string
+	amountCents int64
+	daysLate    int // days past the due date
+	newDays     int // days not covered by fees on earlier drafts
+}
+
+// LateFeePolicyFromSettings reads the late-fee policy from the settings
+// record. A missing record means no late fees.
+func (s *Service) LateFeePolicyFromSettings(ctx context.Context) (LateFeePolicy, error) {
+	st, err := s.db.Settings.Query().Where(settings.SingletonIDEQ(app.SettingsSingletonID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return LateFeePolicy{Mode: LateFeeModeOff}, nil
+		}
+		return LateFeePolicy{}, err
+	}
+	return LateFeePolicy{
+		Mode:         string(st.LateFeeMode),
+		FlatCents:    st.LateFeeFlatCents,
+		DailyRatePct: st.LateFeeDailyRatePct,
+		GraceDays:    st.LateFeeGraceDays,
+		CapCents:     st.LateFeeCapCents,
+	}, nil
+}
+
+// Validate checks the policy before it is saved.
+func (p LateFeePolicy) Validate() error {
+	switch p.Mode {
+	case LateFeeModeOff:
+	case LateFeeModeFlat:
+		if p.FlatCents <= 0 {
+			return errors.New("flat late fee must be greater than zero")
+		}
+	case LateFeeModeDailyInterest:
+		if p.DailyRatePct <= 0 || p.DailyRatePct > 100 {
+			return errors.New("daily interest rate must be between 0 and 100 percent")
+		}
+	default:
+		return fmt.Errorf("invalid late fee mode %q", p.Mode)
+	}
+	if p.FlatCents < 0 || p.DailyRatePct < 0 || p.CapCents < 0 {
+		return errors.New("late fee amounts must be non-negative")
+	}
+	if p.GraceDays < 0 {
+		return errors.New("grace days must be non-negative")
+	}
+	return nil
+}
+
+// lateFeeCharges works out the late fees to bill on a student's draft for
+// y/m. Every unpaid invoice of an earlier period that is past its due date
+// plus the grace period is charged, unless it is on a payment plan that is
+// being kept. A flat fee is charged once per invoice; interest is charged
+// for the days not yet covered by fees on earlier drafts. The cap limits
+// the billed fees of one invoice in total, and a fee waived on this draft is
+// not charged again.
+func (s *Service) lateFeeCharges(ctx context.Context, studentID, y, m int, now time.Time) ([]lateFeeCharge, error) {
+	policy, err := s.LateFeePolicyFromSettings(ctx)
+	if err != nil || policy.Mode == LateFeeModeOff {
+		return nil, err
+	}
+
+	invoices, err := s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDEQ(studentID),
+			invoice.StatusIn(invoice.StatusIssued, invoice.StatusIssuedPendingPdf),
+			invoice.Or(
+				invoice.PeriodYearLT(y),
+				invoice.And(invoice.PeriodYearEQ(y), invoice.PeriodMonthLT(m)),
+			),
+		).
+		Order(ent.Asc(invoice.FieldPeriodYear), ent.Asc(invoice.FieldPeriodMonth)).
+		All(ctx)
+	if err != nil || len(invoices) == 0 {
+		return nil, err
+	}
+	onPlan, err := paysvc.New(s.db).KeptPlanInvoices(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []lateFeeCharge
+	for _, iv := range invoices {
+		if onPlan[iv.ID] {
+			continue
+		}
+		days := daysBetween(app.InvoiceDueDate(iv.IssuedAt, iv.PeriodYear, iv.PeriodMonth), now)
+		if days <= policy.GraceDays {
+			continue
+		}
+		remaining, err := s.invoiceRemainingCents(ctx, iv)
+		if err != nil {
+			return nil, err
+		}
+		if remaining <= 0 {
+			continue
+		}
+
+		fees, err := s.db.LateFee.Query().Where(latefee.InvoiceIDEQ(iv.ID)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var earlierCents int64
+		earlierDays, earlier, waivedHere := 0, false, false
+		for _, fee := range fees {
+			if fee.PeriodYear == y && fee.PeriodMonth == m {
+				waivedHere = fee.Status == latefee.StatusWaived
+				continue
+			}
+			earlier = true
+			earlierDays = max(earlierDays, fee.DaysLate)
+			if fee.Status == latefee.StatusBilled {
+				earlierCents += fee.AmountCents
+			}
+		}
+		if waivedHere {
+			continue
+		}
+
+		var amount int64
+		switch policy.Mode {
+		case LateFeeModeFlat:
+			if earlier {
+				continue
+			}
+			amount = policy.FlatCents
+		case LateFeeModeDailyInterest:
+			amount = int64(math.Round(float64(remaining) * policy.DailyRatePct / 100 * float64(days-earlierDays)))
+		}
+		if policy.CapCents > 0 {
+			amount = min(amount, policy.CapCents-earlierCents)
+		}
+		if amount <= 0 {
+			continue
+		}
+		out = append(out, lateFeeCharge{
+			invoiceID:   iv.ID,
+			number:      optionalString(iv.Number),
+			amountCents: amount,
+			daysLate:    days,
+			newDays:     days - earlierDays,
+		})
+	}
+	return out, nil
+}
+
+func (s *Service) invoiceRemainingCents(ctx context.Context, iv *ent.Invoice) (int64, error) {
+	payments, err := s.db.Payment.Query().Where(payment.InvoiceIDEQ(iv.ID)).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	remaining := iv.TotalAmountCents
+	for _, p := range payments {
+		remaining -= p.AmountCents
+	}
+	return remaining, nil
+}
+
+// daysBetween counts calendar days from one date to a later one.
+func daysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	start := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	end := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
+
+func lateFeeLineDescription(charge lateFeeCharge, mode string) string {
+	if mode == LateFeeModeDailyInterest {
+		return fmt.Sprintf("Kavējuma procenti par rēķinu %s (%d dienas)", charge.number, charge.newDays)
+	}
+	return fmt.Sprintf("Kavējuma nauda par rēķinu %s", charge.number)
+}
+
+// lateFeeLine builds the draft line for a fee. It has no enrollment.
+func (s *Service) lateFeeLine(charge lateFeeCharge, mode string) *ent.InvoiceLineCreate {
+	return s.db.InvoiceLine.Create().
+		SetDescription(lateFeeLineDescription(charge, mode)).
+		SetQty(1).
+		SetUnitPriceCents(charge.amountCents).
+		SetAmountCents(charge.amountCents)
+}
+
+// storeLateFees replaces the billed fees of a student's y/m draft with the
+// ones just put on it. Waived fees are kept.
+func (s *Service) storeLateFees(ctx context.Context, studentID, y, m int, charges []lateFeeCharge) error {
+	if _, err := s.db.LateFee.Delete().
+		Where(
+			latefee.StudentIDEQ(studentID),
+			latefee.PeriodYearEQ(y),
+			latefee.PeriodMonthEQ(m),
+			latefee.StatusEQ(latefee.StatusBilled),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
+	for _, charge := range charges {
+		if _, err := s.db.LateFee.Create().
+			SetStudentID(studentID).
+			SetInvoiceID(charge.invoiceID).
+			SetPeriodYear(y).
+			SetPeriodMonth(m).
+			SetAmountCents(charge.amountCents).
+			SetDaysLate(charge.daysLate).
+			SetCreatedAt(currentTime()).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListLateFees returns late fees, newest first. A studentID of 0 lists the
+// fees of all students.
+func (s *Service) ListLateFees(ctx context.Context, studentID int) ([]LateFeeDTO, error) {
+	q := s.db.LateFee.Query().
+		WithStudent().
+		WithInvoice().
+		Order(ent.Desc(latefee.FieldPeriodYear), ent.Desc(latefee.FieldPeriodMonth), ent.Desc(latefee.FieldID))
+	if studentID > 0 {
+		q = q.Where(latefee.StudentIDEQ(studentID))
+	}
+	fees, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]LateFeeDTO, 0, len(fees))
+	for _, fee := range fees {
+		dto, err := s.lateFeeDTO(ctx, fee)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *dto)
+	}
+	return out, nil
+}
+
+// GetLateFee returns one late fee.
+func (s *Service) GetLateFee(ctx context.Context, id int) (*LateFeeDTO, error) {
+	fee, err := s.db.LateFee.Query().
+		Where(latefee.IDEQ(id)).
+		WithStudent().
+		WithInvoice().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.lateFeeDTO(ctx, fee)
+}
+
+func (s *Service) lateFeeDTO(ctx context.Context, fee *ent.LateFee) (*LateFeeDTO, error) {
+	dto := &LateFeeDTO{
+		ID:           fee.ID,
+		StudentID:    fee.StudentID,
+		PeriodYear:   fee.PeriodYear,
+		PeriodMonth:  fee.PeriodMonth,
+		Amount:       money.CentsToEuros(fee.AmountCents),
+		DaysLate:     fee.DaysLate,
+		Status:       string(fee.Status),
+		WaivedReason: fee.WaivedReason,
+		WaivedBy:     fee.WaivedBy,
+		WaivedAt:     optionalRFC3339(fee.WaivedAt),
+		CreatedAt:    fee.CreatedAt.Format(time.RFC3339),
+	}
+	if st := fee.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	dto.OverdueInvoiceID = fee.InvoiceID
+	if iv := fee.Edges.Invoice; iv != nil {
+		dto.OverdueInvoiceNo = optionalString(iv.Number)
+	}
+	billed, err := s.lateFeeDraft(ctx, fee)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if billed != nil {
+		dto.BilledInvoiceID = &billed.ID
+		dto.BilledInvoiceStatus = string(billed.Status)
+	}
+	return dto, nil
+}
+
+// lateFeeDraft returns the invoice of the period a fee is billed on.
+func (s *Service) lateFeeDraft(ctx context.Context, fee *ent.LateFee) (*ent.Invoice, error) {
+	return s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDEQ(fee.StudentID),
+			invoice.PeriodYearEQ(fee.PeriodYear),
+			invoice.PeriodMonthEQ(fee.PeriodMonth),
+			invoice.KindEQ(invoice.KindMonthly),
+		).
+		Only(ctx)
+}
+
+// WaiveLateFee forgives a fee that is still on a draft and rebuilds the draft
+// without it. The fee is kept as waived so it is not charged again.
+func (s *Service) WaiveLateFee(ctx context.Context, id int, reason, waivedBy string) (*LateFeeDTO, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("причина списания пени должна быть указана")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.waiveLateFeeInStore(ctx, id, reason, waivedBy)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).waiveLateFeeInStore(ctx, id, reason, waivedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) waiveLateFeeInStore(ctx context.Context, id int, reason, waivedBy string) (*LateFeeDTO, error) {
+	fee, err := s.db.LateFee.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if fee.Status == latefee.StatusWaived {
+		return nil, apperrors.Conflict("пеня уже списана")
+	}
+	billed, err := s.lateFeeDraft(ctx, fee)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if billed != nil && billed.Status != StatusDraft {
+		return nil, apperrors.Conflict("нельзя списать пеню: счёт с ней уже выставлен")
+	}
+
+	if _, err := fee.Update().
+		SetStatus(latefee.StatusWaived).
+		SetWaivedReason(reason).
+		SetWaivedBy(waivedBy).
+		SetWaivedAt(currentTime()).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.rebuildDraftForStudentInStore(ctx, fee.StudentID, fee.PeriodYear, fee.PeriodMonth); err != nil {
+		return nil, err
+	}
+	return s.GetLateFee(ctx, id)
+}
@@ -0,0 +1,396 @@
+package invoice
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/ent/latefee"
+	"langschool/ent/settings"
+	"langschool/internal/app"
+	"langschool/internal/money"
+)
+
+func TestGenerateDraftsChargesFlatLateFeeOnceAndHonoursWaiver(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:late-fee-flat?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetLateFeeMode(settings.LateFeeModeFlat).
+		SetLateFeeFlatCents(500).
+		SetLateFeeGraceDays(5).
+		Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Late Payer").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Keramika").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(25)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		SetChargeMaterials(false).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	// January's invoice of 100.00, 20.00 paid, due on 2026-02-15.
+	issuedAt := time.Date(2026, 2, 1, 10, 0, 0, 0, time.Local)
+	overdue, err := client.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2026).
+		SetPeriodMonth(1).
+		SetStatus(invoice.StatusIssued).
+		SetNumber("LS-202601-001").
+		SetIssuedAt(issuedAt).
+		SetTotalAmountCents(money.EurosToCents(100)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Create: %v", err)
+	}
+	if _, err := client.Payment.Create().
+		SetStudentID(st.ID).
+		SetInvoiceID(overdue.ID).
+		SetAmountCents(money.EurosToCents(20)).
+		SetMethod("bank").
+		SetPaidAt(issuedAt).
+		Save(ctx); err != nil {
+		t.Fatalf("Payment.Create: %v", err)
+	}
+
+	feeLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(
+				invoiceline.EnrollmentIDIsNil(),
+				invoiceline.HasInvoiceWith(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(y), invoice.PeriodMonthEQ(m)),
+			).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+
+	// Within the grace period nothing is charged.
+	setInvoiceCurrentTime(t, time.Date(2026, 2, 19, 12, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 2); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := feeLines(2026, 2); len(lines) != 0 {
+		t.Fatalf("fee lines within grace = %d, want 0", len(lines))
+	}
+
+	setInvoiceCurrentTime(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 2); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	lines := feeLines(2026, 2)
+	if len(lines) != 1 {
+		t.Fatalf("fee lines = %d, want 1", len(lines))
+	}
+	if lines[0].AmountCents != 500 || lines[0].Description != "Kavējuma nauda par rēķinu LS-202601-001" {
+		t.Fatalf("fee line = %d %q", lines[0].AmountCents, lines[0].Description)
+	}
+	draft, err := client.Invoice.Query().
+		Where(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(2026), invoice.PeriodMonthEQ(2)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Query: %v", err)
+	}
+	if draft.TotalAmountCents != 500 {
+		t.Fatalf("draft total = %d, want 500", draft.TotalAmountCents)
+	}
+
+	// Rebuilding the same draft does not duplicate the fee.
+	if _, err := svc.RebuildStudentDraft(ctx, st.ID, 2026, 2); err != nil {
+		t.Fatalf("RebuildStudentDraft: %v", err)
+	}
+	fees, err := svc.ListLateFees(ctx, st.ID)
+	if err != nil {
+		t.Fatalf("ListLateFees: %v", err)
+	}
+	if len(fees) != 1 || fees[0].Status != LateFeeStatusBilled || fees[0].OverdueInvoiceNo != "LS-202601-001" {
+		t.Fatalf("fees = %+v, want one billed fee for LS-202601-001", fees)
+	}
+	if fees[0].BilledInvoiceID == nil || *fees[0].BilledInvoiceID != draft.ID {
+		t.Fatalf("billed invoice = %v, want %d", fees[0].BilledInvoiceID, draft.ID)
+	}
+
+	if _, err := svc.WaiveLateFee(ctx, fees[0].ID, " ", "admin"); err == nil {
+		t.Fatal("WaiveLateFee without reason succeeded")
+	}
+	waived, err := svc.WaiveLateFee(ctx, fees[0].ID, "First time late", "admin")
+	if err != nil {
+		t.Fatalf("WaiveLateFee: %v", err)
+	}
+	if waived.Status != LateFeeStatusWaived || waived.WaivedReason != "First time late" || waived.WaivedBy != "admin" {
+		t.Fatalf("waived fee = %+v", waived)
+	}
+	// The draft held only the fee, so it is gone; regenerating does not bring it back.
+	if _, err := svc.GenerateDrafts(ctx, 2026, 2); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := feeLines(2026, 2); len(lines) != 0 {
+		t.Fatalf("fee lines after waiver = %d, want 0", len(lines))
+	}
+
+	// A flat fee is charged once per invoice, so the next month adds nothing either.
+	setInvoiceCurrentTime(t, time.Date(2026, 4, 1, 12, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 3); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := feeLines(2026, 3); len(lines) != 0 {
+		t.Fatalf("fee lines in March = %d, want 0", len(lines))
+	}
+}
+
+func TestGenerateDraftsChargesDailyInterestUpToCap(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:late-fee-interest?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetLateFeeMode(settings.LateFeeModeDailyInterest).
+		SetLateFeeDailyRatePct(0.1).
+		SetLateFeeCapCents(300).
+		Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Late Payer").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Keramika").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(25)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		SetChargeMaterials(false).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	// January's invoice of 100.00, 20.00 paid, due on 2026-02-15.
+	issuedAt := time.Date(2026, 2, 1, 10, 0, 0, 0, time.Local)
+	overdue, err := client.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2026).
+		SetPeriodMonth(1).
+		SetStatus(invoice.StatusIssued).
+		SetNumber("LS-202601-001").
+		SetIssuedAt(issuedAt).
+		SetTotalAmountCents(money.EurosToCents(100)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Create: %v", err)
+	}
+	if _, err := client.Payment.Create().
+		SetStudentID(st.ID).
+		SetInvoiceID(overdue.ID).
+		SetAmountCents(money.EurosToCents(20)).
+		SetMethod("bank").
+		SetPaidAt(issuedAt).
+		Save(ctx); err != nil {
+		t.Fatalf("Payment.Create: %v", err)
+	}
+
+	feeLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(
+				invoiceline.EnrollmentIDIsNil(),
+				invoiceline.HasInvoiceWith(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(y), invoice.PeriodMonthEQ(m)),
+			).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+
+	// 14 days late on 80.00 at 0.1% a day.
+	setInvoiceCurrentTime(t, time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 2); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	lines := feeLines(2026, 2)
+	if len(lines) != 1 || lines[0].AmountCents != 112 {
+		t.Fatalf("February fee lines = %+v, want one of 112 cents", lines)
+	}
+	if !strings.Contains(lines[0].Description, "LS-202601-001") || !strings.Contains(lines[0].Description, "14 dienas") {
+		t.Fatalf("description = %q", lines[0].Description)
+	}
+	if _, err := client.Invoice.Update().
+		Where(invoice.StudentIDEQ(st.ID), invoice.PeriodMonthEQ(2)).
+		SetStatus(invoice.StatusIssued).
+		SetNumber("LS-202602-001").
+		Save(ctx); err != nil {
+		t.Fatalf("issue February: %v", err)
+	}
+
+	// The next 31 days would be 248, but only 188 is left under the cap.
+	setInvoiceCurrentTime(t, time.Date(2026, 4, 1, 9, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 3); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	var janFees []*ent.InvoiceLine
+	for _, line := range feeLines(2026, 3) {
+		if strings.Contains(line.Description, "LS-202601-001") {
+			janFees = append(janFees, line)
+		}
+	}
+	if len(janFees) != 1 || janFees[0].AmountCents != 188 {
+		t.Fatalf("March fee lines for January = %+v, want one of 188 cents", janFees)
+	}
+	fee, err := client.LateFee.Query().
+		Where(latefee.InvoiceIDEQ(overdue.ID), latefee.PeriodMonthEQ(3)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("LateFee.Query: %v", err)
+	}
+	if fee.DaysLate != 45 {
+		t.Fatalf("days late = %d, want 45", fee.DaysLate)
+	}
+
+	// An issued fee can no longer be waived.
+	first, err := client.LateFee.Query().
+		Where(latefee.InvoiceIDEQ(overdue.ID), latefee.PeriodMonthEQ(2)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("LateFee.Query: %v", err)
+	}
+	if _, err := svc.WaiveLateFee(ctx, first.ID, "goodwill", "admin"); err == nil {
+		t.Fatal("WaiveLateFee on an issued invoice succeeded")
+	}
+}
+
+func TestDeleteDraftDropsItsLateFees(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:late-fee-delete?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetLateFeeMode(settings.LateFeeModeFlat).
+		SetLateFeeFlatCents(500).
+		Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Late Payer").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Keramika").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(25)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		SetChargeMaterials(false).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	// January's invoice of 100.00, 20.00 paid, due on 2026-02-15.
+	issuedAt := time.Date(2026, 2, 1, 10, 0, 0, 0, time.Local)
+	overdue, err := client.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2026).
+		SetPeriodMonth(1).
+		SetStatus(invoice.StatusIssued).
+		SetNumber("LS-202601-001").
+		SetIssuedAt(issuedAt).
+		SetTotalAmountCents(money.EurosToCents(100)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Invoice.Create: %v", err)
+	}
+	if _, err := client.Payment.Create().
+		SetStudentID(st.ID).
+		SetInvoiceID(overdue.ID).
+		SetAmountCents(money.EurosToCents(20)).
+		SetMethod("bank").
+		SetPaidAt(issuedAt).
+		Save(ctx); err != nil {
+		t.Fatalf("Payment.Create: %v", err)
+	}
+
+	feeLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(
+				invoiceline.EnrollmentIDIsNil(),
+				invoiceline.HasInvoiceWith(invoice.StudentIDEQ(st.ID), invoice.PeriodYearEQ(y), invoice.PeriodMonthEQ(m)),
+			).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+
+	setInvoiceCurrentTime(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local))
+	if _, err := svc.GenerateDrafts(ctx, 2026, 2); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	fees, err := svc.ListLateFees(ctx, st.ID)
+	if err != nil || len(fees) != 1 || fees[0].BilledInvoiceID == nil {
+		t.Fatalf("ListLateFees = %+v, %v", fees, err)
+	}
+	if err := svc.DeleteDraft(ctx, *fees[0].BilledInvoiceID); err != nil {
+		t.Fatalf("DeleteDraft: %v", err)
+	}
+	if fees, err := svc.ListLateFees(ctx, st.ID); err != nil || len(fees) != 0 {
+		t.Fatalf("ListLateFees after delete = %+v, %v", fees, err)
+	}
+
+	// With the fee gone, the next draft charges it instead.
+	if _, err := svc.GenerateDrafts(ctx, 2026, 3); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := feeLines(2026, 3); len(lines) != 1 || lines[0].AmountCents != 500 {
+		t.Fatalf("March fee lines = %+v, want one of 500 cents", lines)
+	}
+}
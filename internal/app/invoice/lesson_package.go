@@ -0,0 +1,610 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/attendancemonth"
+	"langschool/ent/enrollment"
+	"langschool/ent/invoice"
+	"langschool/ent/lessonpackage"
+	paysvc "langschool/internal/app/payment"
+	"langschool/internal/app/utils"
+	"langschool/internal/app/vat"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// Lesson package statuses.
+const (
+	PackageStatusOpen        = "open"         // Lessons can still be used
+	PackageStatusCarriedOver = "carried_over" // Unused lessons moved to a new package
+	PackageStatusRefunded    = "refunded"     // Unused lessons paid back
+)
+
+const (
+	PackageLowBalanceLessons = 2.0 // Warn when this many lessons or fewer are left
+	PackageExpiryWarningDays = 14  // Warn this many days before a package expires
+
+	packageValidityMonths = 6 // Validity of a package sold without an expiry date
+)
+
+// PackageInput describes a lesson package being sold.
+type PackageInput struct {
+	StudentID int     `json:"studentId"`
+	CourseID  int     `json:"courseId"`
+	Lessons   float64 `json:"lessons"`
+	Price     float64 `json:"price"`     // before VAT; 0 = the lessons at the course lesson price
+	ExpiresOn string  `json:"expiresOn"` // YYYY-MM-DD; empty = six months after the sale
+	Note      string  `json:"note"`
+}
+
+// PackageDTO is a lesson package with its balance. Attendance is drawn from
+// a student's packages of a course oldest first, month by month; lessons in
+// the month a package expires still count.
+type PackageDTO struct {
+	ID            int     `json:"id"`
+	StudentID     int     `json:"studentId"`
+	StudentName   string  `json:"studentName"`
+	CourseID      int     `json:"courseId"`
+	CourseName    string  `json:"courseName"`
+	Lessons       float64 `json:"lessons"`
+	Used          float64 `json:"used"`
+	Remaining     float64 `json:"remaining"` // 0 once closed
+	Price         float64 `json:"price"`     // gross value of the lessons
+	SoldAt        string  `json:"soldAt"`
+	ExpiresOn     string  `json:"expiresOn,omitempty"`
+	InvoiceID     *int    `json:"invoiceId,omitempty"`
+	InvoiceNumber *string `json:"invoiceNumber,omitempty"`
+	InvoiceStatus string  `json:"invoiceStatus,omitempty"`
+	CarriedFromID *int    `json:"carriedFromId,omitempty"`
+	Status        string  `json:"status"`
+	ClosedAt      string  `json:"closedAt,omitempty"`
+	UnusedLessons float64 `json:"unusedLessons"` // carried over or refunded at closing
+	Refund        float64 `json:"refund"`
+	Note          string  `json:"note"`
+	CreatedBy     string  `json:"createdBy"`
+	LowBalance    bool    `json:"lowBalance"`
+	ExpiresSoon   bool    `json:"expiresSoon"`
+	Expired       bool    `json:"expired"`
+}
+
+// packageUsage is how a student's attendance in one course is drawn from
+// their packages.
+type packageUsage struct {
+	used    map[int]float64 // lessons drawn per package
+	overage map[int]float64 // lessons per monthIndex not covered by a package
+}
+
+func monthIndex(y, m int) int {
+	return y*12 + m - 1
+}
+
+// packageWindow returns the first and last month a package's lessons can be
+// used in.
+func packageWindow(pkg *ent.LessonPackage) (first, last int) {
+	first = monthIndex(pkg.SoldAt.Year(), int(pkg.SoldAt.Month()))
+	last = math.MaxInt
+	if pkg.ExpiresOn != nil {
+		last = monthIndex(pkg.ExpiresOn.Year(), int(pkg.ExpiresOn.Month()))
+	}
+	if pkg.ClosedAt != nil {
+		last = min(last, monthIndex(pkg.ClosedAt.Year(), int(pkg.ClosedAt.Month())))
+	}
+	return first, last
+}
+
+// packageCapacity returns how many lessons can be drawn from a package. A
+// closed package keeps the lessons used before its unused ones were settled.
+func packageCapacity(pkg *ent.LessonPackage) float64 {
+	if pkg.Status != lessonpackage.StatusOpen {
+		return pkg.Lessons - pkg.UnusedLessons
+	}
+	return pkg.Lessons
+}
+
+// packageUsage allocates the student's attendance in a course to their
+// packages of it, oldest package first.
+func (s *Service) packageUsage(ctx context.Context, studentID, courseID int) (*packageUsage, error) {
+	pkgs, err := s.db.LessonPackage.Query().
+		Where(lessonpackage.StudentIDEQ(studentID), lessonpackage.CourseIDEQ(courseID)).
+		Order(ent.Asc(lessonpackage.FieldSoldAt), ent.Asc(lessonpackage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	months, err := s.db.AttendanceMonth.Query().
+		Where(
+			attendancemonth.StudentIDEQ(studentID),
+			attendancemonth.CourseIDEQ(courseID),
+			attendancemonth.HoursGT(0),
+		).
+		Order(ent.Asc(attendancemonth.FieldYear), ent.Asc(attendancemonth.FieldMonth)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &packageUsage{used: map[int]float64{}, overage: map[int]float64{}}
+	for _, am := range months {
+		idx := monthIndex(am.Year, am.Month)
+		left := am.Hours
+		for _, pkg := range pkgs {
+			if left <= 0 {
+				break
+			}
+			first, last := packageWindow(pkg)
+			if idx < first || idx > last {
+				continue
+			}
+			free := utils.Round2(packageCapacity(pkg) - usage.used[pkg.ID])
+			if free <= 0 {
+				continue
+			}
+			take := min(left, free)
+			usage.used[pkg.ID] = utils.Round2(usage.used[pkg.ID] + take)
+			left = utils.Round2(left - take)
+		}
+		if left > 0 {
+			usage.overage[idx] = left
+		}
+	}
+	return usage, nil
+}
+
+// buildPackageOverageLine bills the lessons of a package enrollment that no
+// package covers at the lesson price.
+func (s *Service) buildPackageOverageLine(ctx context.Context, en *ent.Enrollment, lessons float64, lessonPriceCents int64) (*ent.InvoiceLineCreate, int64) {
+	amountCents := money.MulFloatToCents(lessons, lessonPriceCents)
+	courseName := ""
+	if c, err := s.db.Course.Get(ctx, en.CourseID); err == nil {
+		courseName = c.Name
+	}
+	desc := buildCourseLineDescription(courseName) + " (virs paketes)"
+
+	line := s.db.InvoiceLine.Create().
+		SetEnrollmentID(en.ID).
+		SetDescription(desc).
+		SetQty(lessons).
+		SetUnitPriceCents(lessonPriceCents).
+		SetAmountCents(amountCents)
+
+	return line, amountCents
+}
+
+func packageLineDescription(courseName string, lessons float64) string {
+	desc := fmt.Sprintf("Nodarbību pakete (%g nodarbības)", lessons)
+	if courseName = strings.TrimSpace(courseName); courseName != "" {
+		desc += " — " + courseName
+	}
+	return desc
+}
+
+// packageExpiry parses an expiry date, defaulting to six months after from.
+func packageExpiry(value string, from time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		d := from.AddDate(0, packageValidityMonths, 0)
+		return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("некорректная дата окончания пакета %q", value)
+	}
+	if t.Format("2006-01-02") < from.Format("2006-01-02") {
+		return time.Time{}, errors.New("дата окончания пакета должна быть не раньше даты продажи")
+	}
+	return t, nil
+}
+
+// SellPackage sells a lesson package to a student enrolled in the course
+// with package billing. The package invoice is issued at once and the
+// student's credit is applied to it.
+func (s *Service) SellPackage(ctx context.Context, in PackageInput, soldBy string) (*PackageDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.sellPackageInStore(ctx, in, soldBy)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).sellPackageInStore(ctx, in, soldBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) sellPackageInStore(ctx context.Context, in PackageInput, soldBy string) (*PackageDTO, error) {
+	if in.Lessons <= 0 {
+		return nil, errors.New("количество занятий в пакете должно быть больше нуля")
+	}
+	en, err := s.db.Enrollment.Query().
+		Where(enrollment.StudentIDEQ(in.StudentID), enrollment.CourseIDEQ(in.CourseID)).
+		WithCourse().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("ученик должен быть записан на курс пакета")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if en.BillingMode != enrollment.BillingModePackage {
+		return nil, errors.New("запись на курс должна быть с оплатой пакетами")
+	}
+
+	now := currentTime()
+	netCents := money.EurosToCents(in.Price)
+	if netCents == 0 {
+		lp, _ := s.resolvePrices(ctx, en, now.Year(), int(now.Month()))
+		netCents = money.MulFloatToCents(in.Lessons, lp)
+	}
+	if netCents <= 0 {
+		return nil, errors.New("стоимость пакета должна быть больше нуля")
+	}
+	expiresOn, err := packageExpiry(in.ExpiresOn, now)
+	if err != nil {
+		return nil, err
+	}
+
+	vatEnabled, err := s.vatEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rate, note, err := s.courseVAT(ctx, en.CourseID, vatEnabled)
+	if err != nil {
+		return nil, err
+	}
+	_, vatCents := vat.Totals(vat.Breakdown([]vat.Line{{NetCents: netCents, RatePct: rate, ExemptNote: note}}))
+	iv, err := s.db.Invoice.Create().
+		SetStudentID(in.StudentID).
+		SetPeriodYear(now.Year()).
+		SetPeriodMonth(int(now.Month())).
+		SetKind(invoice.KindPackage).
+		SetStatus(StatusDraft).
+		SetTotalAmountCents(netCents + vatCents).
+		SetVatAmountCents(vatCents).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	courseName := ""
+	if en.Edges.Course != nil {
+		courseName = en.Edges.Course.Name
+	}
+	if _, err := s.db.InvoiceLine.Create().
+		SetInvoiceID(iv.ID).
+		SetEnrollmentID(en.ID).
+		SetDescription(packageLineDescription(courseName, in.Lessons)).
+		SetQty(1).
+		SetUnitPriceCents(netCents).
+		SetAmountCents(netCents).
+		SetVatRatePct(rate).
+		SetVatExemptNote(note).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	if _, _, err := s.issueOneInStore(ctx, iv.ID, iv.Version); err != nil {
+		return nil, err
+	}
+
+	pkg, err := s.db.LessonPackage.Create().
+		SetStudentID(in.StudentID).
+		SetCourseID(in.CourseID).
+		SetLessons(in.Lessons).
+		SetPriceCents(netCents + vatCents).
+		SetSoldAt(now).
+		SetExpiresOn(expiresOn).
+		SetInvoiceID(iv.ID).
+		SetNote(strings.TrimSpace(in.Note)).
+		SetCreatedBy(soldBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := paysvc.New(s.db).ApplyCreditToOldestInvoices(ctx, in.StudentID); err != nil {
+		return nil, err
+	}
+	return s.GetPackage(ctx, pkg.ID)
+}
+
+// openPackageRemaining returns an open package and its unused lessons.
+func (s *Service) openPackageRemaining(ctx context.Context, id int) (*ent.LessonPackage, float64, error) {
+	pkg, err := s.db.LessonPackage.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if pkg.Status != lessonpackage.StatusOpen {
+		return nil, 0, apperrors.Conflict("пакет уже закрыт")
+	}
+	usage, err := s.packageUsage(ctx, pkg.StudentID, pkg.CourseID)
+	if err != nil {
+		return nil, 0, err
+	}
+	remaining := utils.Round2(pkg.Lessons - usage.used[pkg.ID])
+	if remaining <= 0 {
+		return nil, 0, apperrors.Conflict("в пакете нет неиспользованных занятий")
+	}
+	return pkg, remaining, nil
+}
+
+// remainingValueCents is the share of a package's value its unused lessons
+// are worth.
+func remainingValueCents(pkg *ent.LessonPackage, remaining float64) int64 {
+	return money.MulFloatToCents(remaining/pkg.Lessons, pkg.PriceCents)
+}
+
+// CarryOverPackage closes a package and moves its unused lessons, with their
+// share of its value, to a new package of the same course.
+func (s *Service) CarryOverPackage(ctx context.Context, id int, expiresOn, by string) (*PackageDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.carryOverPackageInStore(ctx, id, expiresOn, by)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).carryOverPackageInStore(ctx, id, expiresOn, by)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) carryOverPackageInStore(ctx context.Context, id int, expiresOn, by string) (*PackageDTO, error) {
+	pkg, remaining, err := s.openPackageRemaining(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := currentTime()
+	expires, err := packageExpiry(expiresOn, now)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pkg.Update().
+		SetStatus(lessonpackage.StatusCarriedOver).
+		SetClosedAt(now).
+		SetUnusedLessons(remaining).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	next, err := s.db.LessonPackage.Create().
+		SetStudentID(pkg.StudentID).
+		SetCourseID(pkg.CourseID).
+		SetLessons(remaining).
+		SetPriceCents(remainingValueCents(pkg, remaining)).
+		SetSoldAt(now).
+		SetExpiresOn(expires).
+		SetCarriedFromID(pkg.ID).
+		SetCreatedBy(by).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPackage(ctx, next.ID)
+}
+
+// RefundPackage closes a package and pays back the value of its unused
+// lessons. The package, or the one its lessons were carried over from, must
+// have been paid.
+func (s *Service) RefundPackage(ctx context.Context, id int, method, note, by string) (*PackageDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.refundPackageInStore(ctx, id, method, note, by)
+		}
+		return nil, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	out, err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).refundPackageInStore(ctx, id, method, note, by)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return out, nil
+}
+
+func (s *Service) refundPackageInStore(ctx context.Context, id int, method, note, by string) (*PackageDTO, error) {
+	pkg, remaining, err := s.openPackageRemaining(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sold, err := s.packageInvoice(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	if sold.Status != invoice.StatusPaid && sold.Status != invoice.StatusPaidPendingPdf {
+		return nil, errors.New("нельзя вернуть деньги за пакет, счёт которого не оплачен")
+	}
+
+	refundCents := remainingValueCents(pkg, remaining)
+	if refundCents > 0 {
+		if strings.TrimSpace(note) == "" {
+			note = fmt.Sprintf("Paketes #%d neizmantotās nodarbības", pkg.ID)
+		}
+		if _, err := paysvc.New(s.db).RefundLessonCredit(ctx, pkg.StudentID, refundCents, method, note); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := pkg.Update().
+		SetStatus(lessonpackage.StatusRefunded).
+		SetClosedAt(currentTime()).
+		SetUnusedLessons(remaining).
+		SetRefundCents(refundCents).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetPackage(ctx, pkg.ID)
+}
+
+// packageInvoice returns the invoice a package, or the package its lessons
+// were carried over from, was sold with.
+func (s *Service) packageInvoice(ctx context.Context, pkg *ent.LessonPackage) (*ent.Invoice, error) {
+	for pkg.InvoiceID == nil {
+		if pkg.CarriedFromID == nil {
+			return nil, fmt.Errorf("пакет %d не связан со счётом", pkg.ID)
+		}
+		from, err := s.db.LessonPackage.Get(ctx, *pkg.CarriedFromID)
+		if err != nil {
+			return nil, err
+		}
+		pkg = from
+	}
+	return s.db.Invoice.Get(ctx, *pkg.InvoiceID)
+}
+
+// GetPackage returns one lesson package.
+func (s *Service) GetPackage(ctx context.Context, id int) (*PackageDTO, error) {
+	pkg, err := s.packageQuery().Where(lessonpackage.IDEQ(id)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.packageDTOs(ctx, []*ent.LessonPackage{pkg})
+	if err != nil {
+		return nil, err
+	}
+	return &out[0], nil
+}
+
+// ListPackages returns lesson packages, newest first. A studentID of 0 lists
+// the packages of all students.
+func (s *Service) ListPackages(ctx context.Context, studentID int) ([]PackageDTO, error) {
+	q := s.packageQuery().Order(ent.Desc(lessonpackage.FieldSoldAt), ent.Desc(lessonpackage.FieldID))
+	if studentID > 0 {
+		q = q.Where(lessonpackage.StudentIDEQ(studentID))
+	}
+	pkgs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.packageDTOs(ctx, pkgs)
+}
+
+// PackageWarnings returns the open packages that are running low, expire
+// soon, or have expired with lessons left, soonest expiry first.
+func (s *Service) PackageWarnings(ctx context.Context) ([]PackageDTO, error) {
+	pkgs, err := s.packageQuery().
+		Where(lessonpackage.StatusEQ(lessonpackage.StatusOpen)).
+		Order(ent.Asc(lessonpackage.FieldExpiresOn), ent.Asc(lessonpackage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all, err := s.packageDTOs(ctx, pkgs)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]PackageDTO, 0, len(all))
+	for _, dto := range all {
+		if dto.LowBalance || dto.ExpiresSoon || (dto.Expired && dto.Remaining > 0) {
+			out = append(out, dto)
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) packageQuery() *ent.LessonPackageQuery {
+	return s.db.LessonPackage.Query().
+		WithStudent().
+		WithCourse().
+		WithInvoice()
+}
+
+func (s *Service) packageDTOs(ctx context.Context, pkgs []*ent.LessonPackage) ([]PackageDTO, error) {
+	usages := map[[2]int]*packageUsage{}
+	now := currentTime()
+	out := make([]PackageDTO, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		key := [2]int{pkg.StudentID, pkg.CourseID}
+		usage, ok := usages[key]
+		if !ok {
+			var err error
+			usage, err = s.packageUsage(ctx, pkg.StudentID, pkg.CourseID)
+			if err != nil {
+				return nil, err
+			}
+			usages[key] = usage
+		}
+		out = append(out, packageDTO(pkg, usage.used[pkg.ID], now))
+	}
+	return out, nil
+}
+
+func packageDTO(pkg *ent.LessonPackage, used float64, now time.Time) PackageDTO {
+	dto := PackageDTO{
+		ID:            pkg.ID,
+		StudentID:     pkg.StudentID,
+		CourseID:      pkg.CourseID,
+		Lessons:       pkg.Lessons,
+		Used:          used,
+		Price:         money.CentsToEuros(pkg.PriceCents),
+		SoldAt:        pkg.SoldAt.Format(time.RFC3339),
+		InvoiceID:     pkg.InvoiceID,
+		CarriedFromID: pkg.CarriedFromID,
+		Status:        string(pkg.Status),
+		ClosedAt:      optionalRFC3339(pkg.ClosedAt),
+		UnusedLessons: pkg.UnusedLessons,
+		Refund:        money.CentsToEuros(pkg.RefundCents),
+		Note:          pkg.Note,
+		CreatedBy:     pkg.CreatedBy,
+	}
+	if st := pkg.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	if c := pkg.Edges.Course; c != nil {
+		dto.CourseName = c.Name
+	}
+	if iv := pkg.Edges.Invoice; iv != nil {
+		dto.InvoiceNumber = iv.Number
+		dto.InvoiceStatus = string(iv.Status)
+	}
+	if pkg.ExpiresOn != nil {
+		dto.ExpiresOn = pkg.ExpiresOn.Format("2006-01-02")
+	}
+	if pkg.Status != lessonpackage.StatusOpen {
+		return dto
+	}
+	dto.Remaining = utils.Round2(max(pkg.Lessons-used, 0))
+	if dto.ExpiresOn != "" {
+		dto.Expired = dto.ExpiresOn < now.Format("2006-01-02")
+		dto.ExpiresSoon = !dto.Expired && dto.ExpiresOn <= now.AddDate(0, 0, PackageExpiryWarningDays).Format("2006-01-02")
+	}
+	dto.LowBalance = !dto.Expired && dto.Remaining <= PackageLowBalanceLessons
+	return dto
+}
@@ -0,0 +1,401 @@
+package invoice
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/ent/payment"
+	"langschool/internal/app"
+	paysvc "langschool/internal/app/payment"
+	"langschool/internal/money"
+)
+
+func TestSellPackageIssuesInvoiceAndAttendanceDrawsItDown(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-package-drawdown?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Card Holder").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Angļu valoda").
+		SetType(course.TypeIndividual).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePackage).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	attend := func(y, m int, hours float64) {
+		t.Helper()
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(y).
+			SetMonth(m).
+			SetHours(hours).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+	monthlyLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(invoiceline.HasInvoiceWith(
+				invoice.StudentIDEQ(st.ID),
+				invoice.PeriodYearEQ(y),
+				invoice.PeriodMonthEQ(m),
+				invoice.KindEQ(invoice.KindMonthly),
+			)).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+	setInvoiceCurrentTime(t, time.Date(2026, 9, 1, 10, 0, 0, 0, time.Local))
+
+	if _, err := svc.SellPackage(ctx, PackageInput{StudentID: st.ID, CourseID: crs.ID}, "admin"); err == nil {
+		t.Fatal("SellPackage without lessons succeeded")
+	}
+	pkg, err := svc.SellPackage(ctx, PackageInput{StudentID: st.ID, CourseID: crs.ID, Lessons: 10}, "admin")
+	if err != nil {
+		t.Fatalf("SellPackage: %v", err)
+	}
+	if pkg.Price != 200 || pkg.Remaining != 10 || pkg.ExpiresOn != "2027-03-01" {
+		t.Fatalf("package = %+v, want 10 lessons for 200.00 expiring 2027-03-01", pkg)
+	}
+	if pkg.InvoiceID == nil || pkg.InvoiceNumber == nil || pkg.InvoiceStatus != StatusIssuedPendingPDF {
+		t.Fatalf("package invoice = %v %v %q, want issued", pkg.InvoiceID, pkg.InvoiceNumber, pkg.InvoiceStatus)
+	}
+	sold, err := svc.Get(ctx, *pkg.InvoiceID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if sold.Kind != "package" || len(sold.Lines) != 1 || !strings.HasPrefix(sold.Lines[0].Description, "Nodarbību pakete (10 nodarbības)") {
+		t.Fatalf("package invoice = %+v", sold)
+	}
+
+	// September is covered by the package; October runs one lesson over it.
+	attend(2026, 9, 6)
+	attend(2026, 10, 5)
+	res, err := svc.GenerateDrafts(ctx, 2026, 9)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.Created != 0 || len(monthlyLines(2026, 9)) != 0 {
+		t.Fatalf("September drafts = %+v, want none", res)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2026, 10); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	lines := monthlyLines(2026, 10)
+	if len(lines) != 1 || lines[0].Qty != 1 || lines[0].AmountCents != 2000 || !strings.HasSuffix(lines[0].Description, "(virs paketes)") {
+		t.Fatalf("October lines = %+v, want one overage lesson of 2000 cents", lines)
+	}
+
+	got, err := svc.GetPackage(ctx, pkg.ID)
+	if err != nil {
+		t.Fatalf("GetPackage: %v", err)
+	}
+	if got.Used != 10 || got.Remaining != 0 || !got.LowBalance {
+		t.Fatalf("package after October = %+v, want used up with a low balance", got)
+	}
+}
+
+func TestSellPackageRequiresPackageEnrollment(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-package-enrollment?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Card Holder").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Angļu valoda").
+		SetType(course.TypeIndividual).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+	if _, err := svc.SellPackage(ctx, PackageInput{StudentID: st.ID, CourseID: crs.ID, Lessons: 10}, "admin"); err == nil {
+		t.Fatal("SellPackage for a per-lesson enrollment succeeded")
+	}
+}
+
+func TestExpiredPackageIsCarriedOver(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-package-carry-over?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Card Holder").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Angļu valoda").
+		SetType(course.TypeIndividual).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePackage).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	attend := func(y, m int, hours float64) {
+		t.Helper()
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(y).
+			SetMonth(m).
+			SetHours(hours).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+	monthlyLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(invoiceline.HasInvoiceWith(
+				invoice.StudentIDEQ(st.ID),
+				invoice.PeriodYearEQ(y),
+				invoice.PeriodMonthEQ(m),
+				invoice.KindEQ(invoice.KindMonthly),
+			)).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+	setInvoiceCurrentTime(t, time.Date(2026, 9, 1, 10, 0, 0, 0, time.Local))
+	pkg, err := svc.SellPackage(ctx, PackageInput{
+		StudentID: st.ID,
+		CourseID:  crs.ID,
+		Lessons:   10,
+		Price:     180,
+		ExpiresOn: "2026-10-15",
+	}, "admin")
+	if err != nil {
+		t.Fatalf("SellPackage: %v", err)
+	}
+	attend(2026, 9, 4)
+
+	setInvoiceCurrentTime(t, time.Date(2026, 10, 5, 10, 0, 0, 0, time.Local))
+	warnings, err := svc.PackageWarnings(ctx)
+	if err != nil {
+		t.Fatalf("PackageWarnings: %v", err)
+	}
+	if len(warnings) != 1 || !warnings[0].ExpiresSoon || warnings[0].Expired || warnings[0].LowBalance {
+		t.Fatalf("warnings in October = %+v, want one expiring soon", warnings)
+	}
+
+	// After expiry, November lessons are billed rather than drawn down.
+	setInvoiceCurrentTime(t, time.Date(2026, 11, 2, 10, 0, 0, 0, time.Local))
+	attend(2026, 11, 2)
+	if _, err := svc.GenerateDrafts(ctx, 2026, 11); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := monthlyLines(2026, 11); len(lines) != 1 || lines[0].Qty != 2 {
+		t.Fatalf("November lines = %+v, want two overage lessons", lines)
+	}
+	warnings, err = svc.PackageWarnings(ctx)
+	if err != nil {
+		t.Fatalf("PackageWarnings: %v", err)
+	}
+	if len(warnings) != 1 || !warnings[0].Expired || warnings[0].Remaining != 6 {
+		t.Fatalf("warnings in November = %+v, want one expired with 6 lessons", warnings)
+	}
+
+	next, err := svc.CarryOverPackage(ctx, pkg.ID, "", "admin")
+	if err != nil {
+		t.Fatalf("CarryOverPackage: %v", err)
+	}
+	if next.Lessons != 6 || next.Price != 108 || next.CarriedFromID == nil || *next.CarriedFromID != pkg.ID || next.InvoiceID != nil {
+		t.Fatalf("carried package = %+v, want 6 lessons worth 108.00", next)
+	}
+	old, err := svc.GetPackage(ctx, pkg.ID)
+	if err != nil {
+		t.Fatalf("GetPackage: %v", err)
+	}
+	if old.Status != PackageStatusCarriedOver || old.UnusedLessons != 6 || old.Used != 4 {
+		t.Fatalf("old package = %+v, want carried over after 4 lessons", old)
+	}
+	if _, err := svc.CarryOverPackage(ctx, pkg.ID, "", "admin"); err == nil {
+		t.Fatal("second CarryOverPackage succeeded")
+	}
+
+	// The carried lessons now cover November.
+	if _, err := svc.GenerateDrafts(ctx, 2026, 11); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := monthlyLines(2026, 11); len(lines) != 0 {
+		t.Fatalf("November lines after carry-over = %+v, want none", lines)
+	}
+	next, err = svc.GetPackage(ctx, next.ID)
+	if err != nil {
+		t.Fatalf("GetPackage: %v", err)
+	}
+	if next.Used != 2 || next.Remaining != 4 {
+		t.Fatalf("carried package = %+v, want 2 used and 4 left", next)
+	}
+}
+
+func TestRefundPackagePaysBackUnusedLessons(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-package-refund?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if _, err := client.Settings.Create().SetSingletonID(app.SettingsSingletonID).Save(ctx); err != nil {
+		t.Fatalf("Settings.Create: %v", err)
+	}
+	st, err := client.Student.Create().SetFullName("Card Holder").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("Angļu valoda").
+		SetType(course.TypeIndividual).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(0).
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePackage).
+		Save(ctx); err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	attend := func(y, m int, hours float64) {
+		t.Helper()
+		if _, err := client.AttendanceMonth.Create().
+			SetStudentID(st.ID).
+			SetCourseID(crs.ID).
+			SetYear(y).
+			SetMonth(m).
+			SetHours(hours).
+			Save(ctx); err != nil {
+			t.Fatalf("AttendanceMonth.Create: %v", err)
+		}
+	}
+	monthlyLines := func(y, m int) []*ent.InvoiceLine {
+		t.Helper()
+		lines, err := client.InvoiceLine.Query().
+			Where(invoiceline.HasInvoiceWith(
+				invoice.StudentIDEQ(st.ID),
+				invoice.PeriodYearEQ(y),
+				invoice.PeriodMonthEQ(m),
+				invoice.KindEQ(invoice.KindMonthly),
+			)).
+			All(ctx)
+		if err != nil {
+			t.Fatalf("InvoiceLine.Query: %v", err)
+		}
+		return lines
+	}
+	setInvoiceCurrentTime(t, time.Date(2026, 9, 1, 10, 0, 0, 0, time.Local))
+	pkg, err := svc.SellPackage(ctx, PackageInput{StudentID: st.ID, CourseID: crs.ID, Lessons: 10}, "admin")
+	if err != nil {
+		t.Fatalf("SellPackage: %v", err)
+	}
+	attend(2026, 9, 3)
+
+	if _, err := svc.RefundPackage(ctx, pkg.ID, app.PaymentMethodBank, "", "admin"); err == nil {
+		t.Fatal("RefundPackage of an unpaid package succeeded")
+	}
+	pays := paysvc.New(client)
+	if _, err := pays.Create(ctx, st.ID, pkg.InvoiceID, 200, app.PaymentMethodBank, "2026-09-02", ""); err != nil {
+		t.Fatalf("payment Create: %v", err)
+	}
+
+	refunded, err := svc.RefundPackage(ctx, pkg.ID, app.PaymentMethodBank, "", "admin")
+	if err != nil {
+		t.Fatalf("RefundPackage: %v", err)
+	}
+	if refunded.Status != PackageStatusRefunded || refunded.UnusedLessons != 7 || refunded.Refund != 140 {
+		t.Fatalf("refunded package = %+v, want 7 lessons refunded for 140.00", refunded)
+	}
+	credit, err := client.Payment.Query().
+		Where(payment.StudentIDEQ(st.ID), payment.KindEQ(payment.KindLessonCredit)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("lesson credit: %v", err)
+	}
+	if err := pays.Delete(ctx, credit.ID); err == nil {
+		t.Fatal("deleting the lesson credit succeeded")
+	}
+
+	bal, err := pays.StudentBalance(ctx, st.ID)
+	if err != nil {
+		t.Fatalf("StudentBalance: %v", err)
+	}
+	if bal.TotalPaid != 200 || bal.Refunded != 140 || bal.LessonCredit != 140 || bal.Balance != 0 || bal.Credit != 0 {
+		t.Fatalf("balance = %+v, want 140.00 paid back and nothing owed", bal)
+	}
+
+	// Later lessons are no longer drawn from the refunded package.
+	attend(2026, 10, 1)
+	if _, err := svc.GenerateDrafts(ctx, 2026, 10); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if lines := monthlyLines(2026, 10); len(lines) != 1 || lines[0].Qty != 1 {
+		t.Fatalf("October lines = %+v, want one overage lesson", lines)
+	}
+}
@@ -0,0 +1,92 @@
+package invoice
+
+import (
+	"context"
+
+	"langschool/ent"
+	"langschool/ent/courseprice"
+	"langschool/ent/enrollmentprice"
+)
+
+// CoursePricesAt returns the lesson and subscription prices of a course in
+// force for a billing period: those of the latest change effective by then,
+// else the opening prices, or the course's own prices when it has no history.
+func CoursePricesAt(c *ent.Course, changes []*ent.CoursePrice, y, m int) (lessonPriceCents, subscriptionPriceCents int64) {
+	lessonPriceCents, subscriptionPriceCents = c.LessonPriceCents, c.SubscriptionPriceCents
+	dated := make([]*ent.CoursePrice, 0, len(changes))
+	for _, ch := range changes {
+		if ch.EffectiveYear == nil || ch.EffectiveMonth == nil {
+			lessonPriceCents, subscriptionPriceCents = ch.LessonPriceCents, ch.SubscriptionPriceCents
+			continue
+		}
+		dated = append(dated, ch)
+	}
+	if ch := latestEffective(dated, y, m, func(ch *ent.CoursePrice) (int, int) {
+		return *ch.EffectiveYear, *ch.EffectiveMonth
+	}); ch != nil {
+		lessonPriceCents, subscriptionPriceCents = ch.LessonPriceCents, ch.SubscriptionPriceCents
+	}
+	return lessonPriceCents, subscriptionPriceCents
+}
+
+// EnrollmentPricesAt returns the enrollment's lesson price override and
+// subscription lesson price in force for a billing period.
+func EnrollmentPricesAt(en *ent.Enrollment, history []*ent.EnrollmentPrice, y, m int) (lessonPriceOverrideCents, subscriptionLessonPriceCents int64) {
+	lessonPriceOverrideCents, subscriptionLessonPriceCents = en.LessonPriceOverrideCents, en.SubscriptionLessonPriceCents
+	if ep := latestEffective(history, y, m, func(ep *ent.EnrollmentPrice) (int, int) {
+		return ep.EffectiveYear, ep.EffectiveMonth
+	}); ep != nil {
+		lessonPriceOverrideCents, subscriptionLessonPriceCents = ep.LessonPriceOverrideCents, ep.SubscriptionLessonPriceCents
+	}
+	return lessonPriceOverrideCents, subscriptionLessonPriceCents
+}
+
+// latestEffective picks the entry with the latest effective month not after
+// the period, or nil when every entry starts later.
+func latestEffective[T any](entries []T, y, m int, period func(T) (int, int)) T {
+	var found T
+	best := -1
+	for _, entry := range entries {
+		idx := monthIndex(period(entry))
+		if idx <= monthIndex(y, m) && idx > best {
+			found, best = entry, idx
+		}
+	}
+	return found
+}
+
+// CoursePricesFor loads the course of an enrollment with its price changes
+// and resolves the enrollment's lesson and subscription prices for the
+// period. A per-lesson enrollment's override replaces the lesson price.
+func CoursePricesFor(ctx context.Context, db *ent.Client, en *ent.Enrollment, y, m int) (lessonPriceCents, subscriptionPriceCents int64, err error) {
+	c, err := db.Course.Get(ctx, en.CourseID)
+	if err != nil {
+		return 0, 0, err
+	}
+	changes, err := db.CoursePrice.Query().Where(courseprice.CourseIDEQ(en.CourseID)).All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	lessonPriceCents, subscriptionPriceCents = CoursePricesAt(c, changes, y, m)
+	if en.BillingMode == BillingPerLesson {
+		override, _, err := EnrollmentPricesFor(ctx, db, en, y, m)
+		if err != nil {
+			return 0, 0, err
+		}
+		if override >= 0 {
+			lessonPriceCents = override
+		}
+	}
+	return lessonPriceCents, subscriptionPriceCents, nil
+}
+
+// EnrollmentPricesFor resolves the enrollment's own prices for the period
+// from its price history.
+func EnrollmentPricesFor(ctx context.Context, db *ent.Client, en *ent.Enrollment, y, m int) (int64, int64, error) {
+	history, err := db.EnrollmentPrice.Query().Where(enrollmentprice.EnrollmentIDEQ(en.ID)).All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	override, subscription := EnrollmentPricesAt(en, history, y, m)
+	return override, subscription, nil
+}
@@ -0,0 +1,148 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/internal/money"
+)
+
+func TestResolvePricesUsesPricesInForceForPeriod(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-price-history?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+
+	st, err := client.Student.Create().SetFullName("History Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	crs, err := client.Course.Create().
+		SetName("History Course").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetSubscriptionPriceCents(money.EurosToCents(60)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	if _, err := client.CoursePrice.Create().
+		SetCourseID(crs.ID).
+		SetEffectiveYear(2026).
+		SetEffectiveMonth(9).
+		SetLessonPriceCents(money.EurosToCents(25)).
+		SetSubscriptionPriceCents(money.EurosToCents(70)).
+		Save(ctx); err != nil {
+		t.Fatalf("CoursePrice.Create: %v", err)
+	}
+	enr, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetBillingMode(enrollment.BillingModePerLesson).
+		SetChargeMaterials(false).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Enrollment.Create: %v", err)
+	}
+
+	for _, tc := range []struct {
+		y, m                 int
+		lesson, subscription float64
+	}{
+		{2026, 8, 20, 60},
+		{2026, 9, 25, 70},
+		{2027, 1, 25, 70},
+	} {
+		lp, sp := svc.resolvePrices(ctx, enr, tc.y, tc.m)
+		if lp != money.EurosToCents(tc.lesson) || sp != money.EurosToCents(tc.subscription) {
+			t.Fatalf("%04d-%02d prices = %d/%d, want %.2f/%.2f", tc.y, tc.m, lp, sp, tc.lesson, tc.subscription)
+		}
+	}
+
+	// The override changed from 12.50 to 15.00 in September; the enrollment
+	// row holds the latest value.
+	if _, err := client.EnrollmentPrice.Create().
+		SetEnrollmentID(enr.ID).
+		SetEffectiveYear(2026).
+		SetEffectiveMonth(1).
+		SetLessonPriceOverrideCents(money.EurosToCents(12.5)).
+		SetSubscriptionLessonPriceCents(0).
+		Save(ctx); err != nil {
+		t.Fatalf("EnrollmentPrice.Create: %v", err)
+	}
+	if _, err := client.EnrollmentPrice.Create().
+		SetEnrollmentID(enr.ID).
+		SetEffectiveYear(2026).
+		SetEffectiveMonth(9).
+		SetLessonPriceOverrideCents(money.EurosToCents(15)).
+		SetSubscriptionLessonPriceCents(0).
+		Save(ctx); err != nil {
+		t.Fatalf("EnrollmentPrice.Create: %v", err)
+	}
+	enr, err = enr.Update().SetLessonPriceOverrideCents(money.EurosToCents(15)).Save(ctx)
+	if err != nil {
+		t.Fatalf("Enrollment.Update: %v", err)
+	}
+	if lp, _ := svc.resolvePrices(ctx, enr, 2026, 8); lp != money.EurosToCents(12.5) {
+		t.Fatalf("August lesson price = %d, want 1250", lp)
+	}
+	if lp, _ := svc.resolvePrices(ctx, enr, 2026, 9); lp != money.EurosToCents(15) {
+		t.Fatalf("September lesson price = %d, want 1500", lp)
+	}
+
+	if _, err := client.AttendanceMonth.Create().
+		SetStudentID(st.ID).
+		SetCourseID(crs.ID).
+		SetYear(2026).
+		SetMonth(8).
+		SetHours(2).
+		Save(ctx); err != nil {
+		t.Fatalf("AttendanceMonth.Create: %v", err)
+	}
+	if _, err := svc.RebuildStudentDraft(ctx, st.ID, 2026, 8); err != nil {
+		t.Fatalf("RebuildStudentDraft: %v", err)
+	}
+	line, err := client.InvoiceLine.Query().
+		Where(invoiceline.HasInvoiceWith(invoice.StudentIDEQ(st.ID), invoice.PeriodMonthEQ(8))).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("InvoiceLine.Query: %v", err)
+	}
+	if line.UnitPriceCents != money.EurosToCents(12.5) || line.AmountCents != money.EurosToCents(25) {
+		t.Fatalf("August line = %d x %g = %d, want 1250 x 2 = 2500", line.UnitPriceCents, line.Qty, line.AmountCents)
+	}
+}
+
+func TestCoursePricesAtUsesOpeningPricesBeforeFirstChange(t *testing.T) {
+	september := 9
+	year := 2026
+	// The row holds prices in force when they last changed, which periods
+	// before the first change must not use.
+	crs := &ent.Course{LessonPriceCents: 3000, SubscriptionPriceCents: 9000}
+	changes := []*ent.CoursePrice{
+		{EffectiveYear: &year, EffectiveMonth: &september, LessonPriceCents: 2500, SubscriptionPriceCents: 7000},
+		{LessonPriceCents: 2000, SubscriptionPriceCents: 6000},
+	}
+	for _, tc := range []struct {
+		y, m                 int
+		lesson, subscription int64
+	}{
+		{2020, 1, 2000, 6000},
+		{2026, 8, 2000, 6000},
+		{2026, 9, 2500, 7000},
+	} {
+		if lp, sp := CoursePricesAt(crs, changes, tc.y, tc.m); lp != tc.lesson || sp != tc.subscription {
+			t.Fatalf("%04d-%02d prices = %d/%d, want %d/%d", tc.y, tc.m, lp, sp, tc.lesson, tc.subscription)
+		}
+	}
+	if lp, sp := CoursePricesAt(crs, nil, 2026, 8); lp != 3000 || sp != 9000 {
+		t.Fatalf("prices without history = %d/%d, want the row's 3000/9000", lp, sp)
+	}
+}
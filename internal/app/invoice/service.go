@@ -5,8 +5,10 @@ package invoice
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,9 +20,14 @@ import (
 	"langschool/ent/payment"
 	"langschool/ent/settings"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
 	"langschool/internal/app"
+	"langschool/internal/app/enrollperiod"
 	paysvc "langschool/internal/app/payment"
 	"langschool/internal/app/recipient"
+	"langschool/internal/app/reference"
+	"langschool/internal/app/utils"
+	"langschool/internal/app/vat"
 	"langschool/internal/apperrors"
 	"langschool/internal/money"
 	pdfgen "langschool/internal/pdf"
@@ -37,6 +44,7 @@ const (
 
 	BillingPerLesson    = app.BillingModePerLesson    // Per-lesson billing mode
 	BillingSubscription = app.BillingModeSubscription // Subscription billing mode
+	BillingPackage      = app.BillingModePackage      // Prepaid lesson package billing mode
 
 	materialsLineDescription = "Mācību materiāli"
 	materialsLineAmountCents = int64(500)
@@ -71,6 +79,7 @@ type ListItem struct {
 	Month                    int     `json:"month"`            // Invoice period month
 	Total                    float64 `json:"total"`            // Total invoice amount
 	Status                   string  `json:"status"`           // Invoice status
+	Kind                     string  `json:"kind"`             // monthly, package for a lesson package sale, or term
 	PDFReady                 bool    `json:"pdfReady"`         // Whether canonical PDF is ready
 	LinesCount               int     `json:"linesCount"`       // Number of line items
 	Number                   *string `json:"number,omitempty"` // Invoice number (nil for drafts)
@@ -83,36 +92,56 @@ type ListItem struct {
 
 // LineDTO represents a single line item in an invoice.
 type LineDTO struct {
-	EnrollmentID int     `json:"enrollmentId"` // ID of the enrollment this line is for
-	Description  string  `json:"description"`  // Line item description
-	Qty          float64 `json:"qty"`          // Quantity (e.g., number of hours)
-	UnitPrice    float64 `json:"unitPrice"`    // Price per unit
-	Amount       float64 `json:"amount"`       // Total amount for this line (qty * unitPrice)
+	EnrollmentID int     `json:"enrollmentId"`       // ID of the enrollment this line is for; 0 for fees
+	ChargeID     int     `json:"chargeId,omitempty"` // ID of the student charge this line bills, if any
+	Description  string  `json:"description"`        // Line item description
+	Qty          float64 `json:"qty"`                // Quantity (e.g., number of hours)
+	UnitPrice    float64 `json:"unitPrice"`          // Price per unit
+	Amount       float64 `json:"amount"`             // Total amount for this line (qty * unitPrice), net of VAT
+	VATRate      float64 `json:"vatRate"`            // VAT rate in percent
+}
+
+// VATGroupDTO is one row of an invoice's VAT breakdown.
+type VATGroupDTO struct {
+	Rate       float64 `json:"rate"`                 // VAT rate in percent
+	ExemptNote string  `json:"exemptNote,omitempty"` // Exemption reason for zero-rated lines
+	Net        float64 `json:"net"`                  // Taxable amount
+	VAT        float64 `json:"vat"`                  // VAT amount
+	Gross      float64 `json:"gross"`                // Net + VAT
 }
 
 // InvoiceDTO represents a complete invoice with all line items.
 type InvoiceDTO struct {
-	ID                       int       `json:"id"`                  // Invoice ID
-	Version                  int       `json:"version"`             // Optimistic-lock revision
-	StudentID                int       `json:"studentId"`           // Student ID
-	StudentName              string    `json:"studentName"`         // Student's full name
-	RecipientName            string    `json:"recipientName"`       // Visible invoice recipient
-	RecipientPhone           string    `json:"recipientPhone"`      // Optional recipient phone
-	RecipientEmail           string    `json:"recipientEmail"`      // Optional recipient email
-	ChildName                string    `json:"childName"`           // Child/student name
-	StudentPersonalCode      string    `json:"studentPersonalCode"` // Student's own personal code
-	IsMinor                  bool      `json:"isMinor"`             // Whether invoice is for a minor student
-	Year                     int       `json:"year"`                // Invoice period year
-	Month                    int       `json:"month"`               // Invoice period month
-	Total                    float64   `json:"total"`               // Total invoice amount
-	Status                   string    `json:"status"`              // Invoice status
-	PDFReady                 bool      `json:"pdfReady"`            // Whether canonical PDF is ready
-	Number                   *string   `json:"number,omitempty"`    // Invoice number (nil for drafts)
-	LastEmailedAt            string    `json:"lastEmailedAt,omitempty"`
-	LastEmailedTo            string    `json:"lastEmailedTo,omitempty"`
-	EmailCommunicationStatus string    `json:"emailCommunicationStatus"`
-	LastEmailError           string    `json:"lastEmailError,omitempty"`
-	Lines                    []LineDTO `json:"lines"` // All line items in the invoice
+	ID                       int           `json:"id"`                  // Invoice ID
+	Version                  int           `json:"version"`             // Optimistic-lock revision
+	StudentID                int           `json:"studentId"`           // Student ID
+	StudentName              string        `json:"studentName"`         // Student's full name
+	RecipientName            string        `json:"recipientName"`       // Visible invoice recipient
+	RecipientPhone           string        `json:"recipientPhone"`      // Optional recipient phone
+	RecipientEmail           string        `json:"recipientEmail"`      // Optional recipient email
+	ChildName                string        `json:"childName"`           // Child/student name
+	StudentPersonalCode      string        `json:"studentPersonalCode"` // Student's own personal code
+	IsMinor                  bool          `json:"isMinor"`             // Whether invoice is for a minor student
+	IsCompany                bool          `json:"isCompany"`           // Whether a legal entity pays
+	RecipientRegNo           string        `json:"recipientRegNo,omitempty"`
+	RecipientVATNumber       string        `json:"recipientVatNumber,omitempty"`
+	RecipientAddress         string        `json:"recipientAddress,omitempty"`
+	Year                     int           `json:"year"`             // Invoice period year
+	Month                    int           `json:"month"`            // Invoice period month
+	Total                    float64       `json:"total"`            // Total invoice amount, VAT included
+	Net                      float64       `json:"net"`              // Total net of VAT
+	VAT                      float64       `json:"vat"`              // VAT amount
+	Status                   string        `json:"status"`           // Invoice status
+	Kind                     string        `json:"kind"`             // monthly, package for a lesson package sale, or term
+	PDFReady                 bool          `json:"pdfReady"`         // Whether canonical PDF is ready
+	Number                   *string       `json:"number,omitempty"` // Invoice number (nil for drafts)
+	PaymentReference         string        `json:"paymentReference"` // ISO 11649 creditor reference for bank transfers
+	LastEmailedAt            string        `json:"lastEmailedAt,omitempty"`
+	LastEmailedTo            string        `json:"lastEmailedTo,omitempty"`
+	EmailCommunicationStatus string        `json:"emailCommunicationStatus"`
+	LastEmailError           string        `json:"lastEmailError,omitempty"`
+	Lines                    []LineDTO     `json:"lines"`                  // All line items in the invoice
+	VATBreakdown             []VATGroupDTO `json:"vatBreakdown,omitempty"` // Present for invoices of a VAT payer
 }
 
 // GenerateResult contains statistics about invoice generation.
@@ -148,6 +177,13 @@ func optionalString(value *string) string {
 	return strings.TrimSpace(*value)
 }
 
+func optionalInt(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
 func emailCommunicationStatus(iv *ent.Invoice) string {
 	if iv == nil {
 		return app.InvoiceEmailStatusNotSent
@@ -213,8 +249,8 @@ func (s *Service) buildPerLessonLine(ctx context.Context, en *ent.Enrollment, y,
 
 // buildSubscriptionLine creates an invoice line for subscription billing
 func (s *Service) buildSubscriptionLine(ctx context.Context, en *ent.Enrollment, y, m int, lessonPriceCents int64, lessonsHeld float64) (*ent.InvoiceLineCreate, int64) {
-	unitPriceCents := en.SubscriptionLessonPriceCents
-	if unitPriceCents < 0 {
+	_, unitPriceCents, err := EnrollmentPricesFor(ctx, s.db, en, y, m)
+	if err != nil || unitPriceCents < 0 {
 		unitPriceCents = lessonPriceCents
 	}
 	amountCents := money.MulFloatToCents(lessonsHeld, unitPriceCents)
@@ -235,8 +271,7 @@ func (s *Service) buildSubscriptionLine(ctx context.Context, en *ent.Enrollment,
 }
 
 // buildMaterialsLine creates a fixed invoice line for monthly learning materials.
-// InvoiceLine requires an enrollment reference, so this line is attached to one of
-// the student's enrollments for the billing period.
+// The line is attached to one of the student's enrollments for the billing period.
 func (s *Service) buildMaterialsLine(enrollmentID int) (*ent.InvoiceLineCreate, int64) {
 	line := s.db.InvoiceLine.Create().
 		SetEnrollmentID(enrollmentID).
@@ -278,22 +313,48 @@ func (s *Service) getSettingsOrDefaults(ctx context.Context) (string, int, bool,
 	return prefix, seq, true, nil
 }
 
+// vatEnabled reports whether the organization is a registered VAT payer.
+// vatEnabled reports whether the school is a VAT payer. A school without
+// saved settings is not.
+func (s *Service) vatEnabled(ctx context.Context) (bool, error) {
+	st, err := s.db.Settings.Query().Where(settings.SingletonIDEQ(app.SettingsSingletonID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return st.VatEnabled, nil
+}
+
+// courseVAT returns the VAT rate and, for zero-rated courses, the exemption
+// note snapshotted onto invoice lines. Non-VAT payers get no VAT data at all.
+func (s *Service) courseVAT(ctx context.Context, courseID int, vatEnabled bool) (float64, string, error) {
+	if !vatEnabled {
+		return 0, "", nil
+	}
+	c, err := s.db.Course.Get(ctx, courseID)
+	if err != nil {
+		return 0, "", err
+	}
+	if c.VatRatePct > 0 {
+		return c.VatRatePct, "", nil
+	}
+	if note := strings.TrimSpace(c.VatExemptNote); note != "" {
+		return 0, note, nil
+	}
+	return 0, vat.DefaultExemptNote, nil
+}
+
 // resolvePrices determines the effective prices for an enrollment in a given period.
-// It uses the course prices and applies the enrollment discount, if any.
+// It uses the course prices in force for the period and applies the
+// enrollment's lesson price override, if any.
 // Returns both lesson price and subscription price.
 func (s *Service) resolvePrices(ctx context.Context, en *ent.Enrollment, y, m int) (lessonPriceCents, subscriptionPriceCents int64) {
-	lessonPriceCents, subscriptionPriceCents = 0, 0
-
-	// Base prices
-	c, err := s.db.Enrollment.Query().Where(enrollment.IDEQ(en.ID)).QueryCourse().Only(ctx)
-	if err == nil && c != nil {
-		lp, sp := c.LessonPriceCents, c.SubscriptionPriceCents
-		if en.BillingMode == BillingPerLesson && en.LessonPriceOverrideCents >= 0 {
-			lp = en.LessonPriceOverrideCents
-		}
-		lessonPriceCents, subscriptionPriceCents = lp, sp
+	lessonPriceCents, subscriptionPriceCents, err := CoursePricesFor(ctx, s.db, en, y, m)
+	if err != nil {
+		return 0, 0
 	}
-
 	return lessonPriceCents, subscriptionPriceCents
 }
 
@@ -319,6 +380,9 @@ func (s *Service) hasAnyLessonsInMonth(ctx context.Context, ens []*ent.Enrollmen
 			if s.subscriptionLessonsHeld(ctx, en.StudentID, en.CourseID, y, m) > 0 {
 				return true
 			}
+		case BillingPackage:
+			// Materials of package students are part of the package price.
+			continue
 		default:
 			count, err := s.db.AttendanceMonth.Query().
 				Where(
@@ -339,7 +403,7 @@ func (s *Service) hasAnyLessonsInMonth(ctx context.Context, ens []*ent.Enrollmen
 
 func firstMaterialsEnrollment(ens []*ent.Enrollment) *ent.Enrollment {
 	for _, en := range ens {
-		if en.ChargeMaterials {
+		if en.ChargeMaterials && en.BillingMode != BillingPackage {
 			return en
 		}
 	}
@@ -401,18 +465,46 @@ func (s *Service) rebuildDraftForStudentInStore(ctx context.Context, studentID,
 	res := rebuildInvoiceResult{}
 
 	ens, err := s.db.Enrollment.Query().
-		Where(enrollment.StudentIDEQ(studentID)).
+		Where(enrollment.StudentIDEQ(studentID), enrollperiod.ActiveIn(y, m)).
+		WithPauses().
 		All(ctx)
 	if err != nil {
 		return res, err
 	}
+	// Enrollments billed per term in this month go on the term invoice.
+	ens, terms, err := s.splitTermBilled(ctx, ens, y, m)
+	if err != nil {
+		return res, err
+	}
+	for _, te := range terms {
+		termStats, err := s.rebuildTermDraftInStore(ctx, studentID, te)
+		if err != nil {
+			return res, err
+		}
+		mergeGenerateResult(&res.stats, termStats)
+	}
 
 	lineCapacity := len(ens) + 1
 	if lineCapacity < 1 {
 		lineCapacity = 1
 	}
 	lines := make([]*ent.InvoiceLineCreate, 0, lineCapacity)
+	lineVAT := make([]vat.Line, 0, lineCapacity)
 	var totalCents int64
+	vatEnabled, err := s.vatEnabled(ctx)
+	if err != nil {
+		return res, err
+	}
+	addLine := func(line *ent.InvoiceLineCreate, amount int64, courseID int) error {
+		rate, note, err := s.courseVAT(ctx, courseID, vatEnabled)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line.SetVatRatePct(rate).SetVatExemptNote(note))
+		lineVAT = append(lineVAT, vat.Line{NetCents: amount, RatePct: rate, ExemptNote: note})
+		totalCents += amount
+		return nil
+	}
 
 	for _, en := range ens {
 		lp, _ := s.resolvePrices(ctx, en, y, m)
@@ -420,33 +512,107 @@ func (s *Service) rebuildDraftForStudentInStore(ctx context.Context, studentID,
 		switch en.BillingMode {
 		case BillingPerLesson:
 			line, amount := s.buildPerLessonLine(ctx, en, y, m, lp)
-			lines = append(lines, line)
-			totalCents += amount
+			if err := addLine(line, amount, en.CourseID); err != nil {
+				return res, err
+			}
 
 		case BillingSubscription:
+			// Lessons are counted per course, so a student enrolled for part
+			// of the month pays for the share of days they were enrolled.
 			lessonsHeld := s.subscriptionLessonsHeld(ctx, en.StudentID, en.CourseID, y, m)
+			lessonsHeld = utils.Round2(lessonsHeld * enrollperiod.MonthShare(en, y, m))
 			if lp <= 0 || lessonsHeld <= 0 {
 				continue
 			}
 			line, amount := s.buildSubscriptionLine(ctx, en, y, m, lp, lessonsHeld)
-			lines = append(lines, line)
-			totalCents += amount
+			if err := addLine(line, amount, en.CourseID); err != nil {
+				return res, err
+			}
+
+		case BillingPackage:
+			// Package lessons are prepaid; only those no package covers are billed.
+			usage, err := s.packageUsage(ctx, en.StudentID, en.CourseID)
+			if err != nil {
+				return res, err
+			}
+			overage := usage.overage[monthIndex(y, m)]
+			if lp <= 0 || overage <= 0 {
+				continue
+			}
+			line, amount := s.buildPackageOverageLine(ctx, en, overage, lp)
+			if err := addLine(line, amount, en.CourseID); err != nil {
+				return res, err
+			}
 
 		default:
 			fmt.Printf("Unexpected billing mode: %s\n", en.BillingMode)
 		}
 	}
 
+	// Excused absences credited under the school policy; credits that
+	// would leave nothing to pay wait for a later draft.
+	credits, err := s.dueAbsenceCredits(ctx, studentID, y, m)
+	if err != nil {
+		return res, err
+	}
+	for _, ab := range credits {
+		line, amount, err := s.absenceCreditLine(ctx, ab)
+		if err != nil {
+			return res, err
+		}
+		if line != nil {
+			if err := addLine(line, amount, ab.CourseID); err != nil {
+				return res, err
+			}
+		}
+	}
+
 	if materialsEnrollment := firstMaterialsEnrollment(ens); materialsEnrollment != nil && s.hasAnyLessonsInMonth(ctx, ens, y, m) {
 		materialsLine, materialsAmount := s.buildMaterialsLine(materialsEnrollment.ID)
-		lines = append(lines, materialsLine)
-		totalCents += materialsAmount
+		if err := addLine(materialsLine, materialsAmount, materialsEnrollment.CourseID); err != nil {
+			return res, err
+		}
+	}
+
+	occurrences, err := s.dueChargeOccurrences(ctx, studentID, y, m)
+	if err != nil {
+		return res, err
+	}
+	for _, oc := range occurrences {
+		rate, note := chargeVAT(oc.charge, vatEnabled)
+		lines = append(lines, s.chargeLine(oc).SetVatRatePct(rate).SetVatExemptNote(note))
+		lineVAT = append(lineVAT, vat.Line{NetCents: oc.charge.AmountCents, RatePct: rate, ExemptNote: note})
+		totalCents += oc.charge.AmountCents
+	}
+
+	lateFees, err := s.lateFeeCharges(ctx, studentID, y, m, currentTime())
+	if err != nil {
+		return res, err
+	}
+	if len(lateFees) > 0 {
+		policy, err := s.LateFeePolicyFromSettings(ctx)
+		if err != nil {
+			return res, err
+		}
+		note := ""
+		if vatEnabled {
+			note = lateFeeVATExemptNote
+		}
+		for _, charge := range lateFees {
+			lines = append(lines, s.lateFeeLine(charge, policy.Mode).SetVatRatePct(0).SetVatExemptNote(note))
+			lineVAT = append(lineVAT, vat.Line{NetCents: charge.amountCents, ExemptNote: note})
+			totalCents += charge.amountCents
+		}
 	}
 
+	// totalCents is the net amount so far; the invoice total includes VAT.
+	_, vatCents := vat.Totals(vat.Breakdown(lineVAT))
+
 	existing, err := s.db.Invoice.Query().Where(
 		invoice.StudentIDEQ(studentID),
 		invoice.PeriodYearEQ(y),
 		invoice.PeriodMonthEQ(m),
+		invoice.KindEQ(invoice.KindMonthly),
 	).Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return res, err
@@ -456,6 +622,10 @@ func (s *Service) rebuildDraftForStudentInStore(ctx context.Context, studentID,
 		return res, nil
 	}
 
+	if err := s.storeLateFees(ctx, studentID, y, m, lateFees); err != nil {
+		return res, err
+	}
+
 	if totalCents <= 0 {
 		if err == nil && existing.Status == StatusDraft {
 			if _, err := s.db.InvoiceLine.Delete().Where(invoiceline.InvoiceIDEQ(existing.ID)).Exec(ctx); err != nil {
@@ -476,7 +646,8 @@ func (s *Service) rebuildDraftForStudentInStore(ctx context.Context, studentID,
 			SetPeriodYear(y).
 			SetPeriodMonth(m).
 			SetStatus(StatusDraft).
-			SetTotalAmountCents(totalCents).
+			SetTotalAmountCents(totalCents + vatCents).
+			SetVatAmountCents(vatCents).
 			Save(ctx)
 		if createErr != nil {
 			return res, createErr
@@ -497,7 +668,7 @@ func (s *Service) rebuildDraftForStudentInStore(ctx context.Context, studentID,
 				return res, saveErr
 			}
 		}
-		if _, err := existing.Update().SetTotalAmountCents(totalCents).Save(ctx); err != nil {
+		if _, err := existing.Update().SetTotalAmountCents(totalCents + vatCents).SetVatAmountCents(vatCents).Save(ctx); err != nil {
 			return res, err
 		}
 		res.stats.Updated++
@@ -577,7 +748,16 @@ func (s *Service) GenerateDrafts(ctx context.Context, y, m int) (GenerateResult,
 			return res, err
 		}
 		if len(ens) == 0 {
-			continue
+			// Students without lessons may still owe a registration or membership fee.
+			hasCharges, err := s.db.StudentCharge.Query().
+				Where(studentcharge.StudentIDEQ(st.ID), studentcharge.CanceledAtIsNil()).
+				Exist(ctx)
+			if err != nil {
+				return res, err
+			}
+			if !hasCharges {
+				continue
+			}
 		}
 		studentRes, rebuildErr := s.rebuildDraftForStudent(ctx, st.ID, y, m)
 		if rebuildErr != nil {
@@ -615,7 +795,7 @@ func (s *Service) ListDrafts(ctx context.Context, y, m int) ([]ListItem, error)
 		items = append(items, ListItem{
 			ID: iv.ID, StudentID: iv.StudentID, StudentName: getStudentName(iv),
 			Year: iv.PeriodYear, Month: iv.PeriodMonth,
-			Total: money.CentsToEuros(iv.TotalAmountCents), Status: string(iv.Status), PDFReady: CanonicalPDFReady(iv), LinesCount: count, Number: iv.Number,
+			Total: money.CentsToEuros(iv.TotalAmountCents), Status: string(iv.Status), Kind: string(iv.Kind), PDFReady: CanonicalPDFReady(iv), LinesCount: count, Number: iv.Number,
 			EventDate:                invoiceEventDate(iv),
 			LastEmailedAt:            optionalRFC3339(iv.LastEmailedAt),
 			LastEmailedTo:            optionalString(iv.LastEmailedTo),
@@ -652,12 +832,20 @@ func (s *Service) Get(ctx context.Context, id int) (*InvoiceDTO, error) {
 		ChildName:                recipientInfo.ChildName,
 		StudentPersonalCode:      recipientInfo.StudentPersonalCode,
 		IsMinor:                  recipientInfo.IsMinor,
+		IsCompany:                recipientInfo.IsCompany,
+		RecipientRegNo:           recipientInfo.CompanyRegNo,
+		RecipientVATNumber:       recipientInfo.CompanyVATNumber,
+		RecipientAddress:         recipientInfo.CompanyAddress,
 		Year:                     iv.PeriodYear,
 		Month:                    iv.PeriodMonth,
 		Total:                    money.CentsToEuros(iv.TotalAmountCents),
+		Net:                      money.CentsToEuros(iv.TotalAmountCents - iv.VatAmountCents),
+		VAT:                      money.CentsToEuros(iv.VatAmountCents),
 		Status:                   string(iv.Status),
+		Kind:                     string(iv.Kind),
 		PDFReady:                 CanonicalPDFReady(iv),
 		Number:                   iv.Number,
+		PaymentReference:         reference.ForInvoice(iv.ID),
 		LastEmailedAt:            optionalRFC3339(iv.LastEmailedAt),
 		LastEmailedTo:            optionalString(iv.LastEmailedTo),
 		EmailCommunicationStatus: emailCommunicationStatus(iv),
@@ -665,16 +853,33 @@ func (s *Service) Get(ctx context.Context, id int) (*InvoiceDTO, error) {
 	}
 	for _, l := range ls {
 		dto.Lines = append(dto.Lines, LineDTO{
-			EnrollmentID: l.EnrollmentID,
+			EnrollmentID: optionalInt(l.EnrollmentID),
+			ChargeID:     optionalInt(l.ChargeID),
 			Description:  l.Description,
 			Qty:          l.Qty,
 			UnitPrice:    money.CentsToEuros(l.UnitPriceCents),
 			Amount:       money.CentsToEuros(l.AmountCents),
+			VATRate:      l.VatRatePct,
 		})
 	}
+	if lineVAT := vat.FromInvoiceLines(ls); vat.Applies(lineVAT) {
+		for _, g := range vat.Breakdown(lineVAT) {
+			dto.VATBreakdown = append(dto.VATBreakdown, toVATGroupDTO(g))
+		}
+	}
 	return dto, nil
 }
 
+func toVATGroupDTO(g vat.Group) VATGroupDTO {
+	return VATGroupDTO{
+		Rate:       g.RatePct,
+		ExemptNote: g.ExemptNote,
+		Net:        money.CentsToEuros(g.NetCents),
+		VAT:        money.CentsToEuros(g.VATCents),
+		Gross:      money.CentsToEuros(g.GrossCents()),
+	}
+}
+
 // DeleteDraft deletes a draft invoice and all its line items.
 // Only draft invoices can be deleted; issued/paid/canceled invoices are protected.
 func (s *Service) DeleteDraft(ctx context.Context, id int) error {
@@ -710,6 +915,9 @@ func (s *Service) DeleteDraftWithVersion(ctx context.Context, id, version int) e
 	if _, err := tx.InvoiceLine.Delete().Where(invoiceline.InvoiceIDEQ(iv.ID)).Exec(ctx); err != nil {
 		return err
 	}
+	if err := (&Service{db: tx.Client()}).storeLateFees(ctx, iv.StudentID, iv.PeriodYear, iv.PeriodMonth, nil); err != nil {
+		return err
+	}
 	if err := tx.Invoice.DeleteOneID(iv.ID).Where(invoice.VersionEQ(version)).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return apperrors.StaleRevision()
@@ -756,6 +964,9 @@ func (s *Service) ReopenDraftWithVersion(ctx context.Context, id, version int, o
 	if iv.Status != StatusIssued && iv.Status != StatusIssuedPendingPDF {
 		return fmt.Errorf("вернуть в черновик можно только выставленные счета без оплат")
 	}
+	if iv.Kind == invoice.KindPackage {
+		return fmt.Errorf("нельзя вернуть в черновик счёт за пакет занятий")
+	}
 
 	paymentCount, err := tx.Payment.Query().Where(payment.InvoiceIDEQ(iv.ID)).Count(ctx)
 	if err != nil {
@@ -780,6 +991,7 @@ func (s *Service) ReopenDraftWithVersion(ctx context.Context, id, version int, o
 		SetStatus(StatusDraft).
 		SetEmailDeliveryStatus(invoice.EmailDeliveryStatusNotSent).
 		ClearNumber().
+		ClearIssuedAt().
 		ClearPdfFilename().
 		ClearPdfRevision().
 		ClearPdfGeneratedAt().
@@ -901,6 +1113,7 @@ func (s *Service) issueOneInStore(ctx context.Context, id, version int) (string,
 		Where(invoice.VersionEQ(version)).
 		SetVersion(version + 1).
 		SetNumber(number).
+		SetIssuedAt(currentTime()).
 		SetStatus(StatusIssuedPendingPDF).
 		Save(ctx); err != nil {
 		if ent.IsNotFound(err) {
@@ -972,6 +1185,16 @@ func (s *Service) Issue(ctx context.Context, id int, outBaseDir, fontsDir string
 	return number, p, nil
 }
 
+// ErrUBLBuyerEndpoint is returned by UBL when the payer has no electronic
+// address to receive e-invoices.
+var ErrUBLBuyerEndpoint = pdfgen.ErrUBLBuyerEndpoint
+
+// UBL renders an issued invoice as a Peppol BIS Billing 3.0 e-invoice.
+// Returns the XML document and its suggested file name.
+func (s *Service) UBL(ctx context.Context, id int) ([]byte, string, error) {
+	return pdfgen.GenerateInvoiceUBL(ctx, s.db, id, pdfgen.Options{})
+}
+
 // IssueAll issues all draft invoices for a given year and month.
 // Each invoice is assigned a number, marked as issued, and a PDF is generated.
 // Returns the count of issued invoices and paths to all generated PDFs.
@@ -1132,6 +1355,7 @@ func (s *Service) List(ctx context.Context, y, m int, status string) ([]ListItem
 			Month:                    iv.PeriodMonth,
 			Total:                    money.CentsToEuros(iv.TotalAmountCents),
 			Status:                   string(iv.Status),
+			Kind:                     string(iv.Kind),
 			PDFReady:                 CanonicalPDFReady(iv),
 			LinesCount:               cnt,
 			Number:                   iv.Number,
@@ -1145,6 +1369,68 @@ func (s *Service) List(ctx context.Context, y, m int, status string) ([]ListItem
 	return out, nil
 }
 
+// VATSummaryDTO totals the VAT of all issued invoices of a period.
+type VATSummaryDTO struct {
+	Year         int           `json:"year"`
+	Month        int           `json:"month"`
+	InvoiceCount int           `json:"invoiceCount"` // Issued invoices in the period
+	Net          float64       `json:"net"`
+	VAT          float64       `json:"vat"`
+	Gross        float64       `json:"gross"`
+	Groups       []VATGroupDTO `json:"groups"` // Per rate (and exemption note), highest rate first
+}
+
+// VATSummary sums the VAT breakdowns of the period's issued invoices. Drafts
+// and canceled invoices are left out; the tax of each invoice is taken as
+// printed, so the report matches the documents sent to customers.
+func (s *Service) VATSummary(ctx context.Context, y, m int) (*VATSummaryDTO, error) {
+	invs, err := s.db.Invoice.Query().
+		Where(
+			invoice.PeriodYearEQ(y),
+			invoice.PeriodMonthEQ(m),
+			invoice.StatusNotIn(invoice.StatusDraft, invoice.StatusCanceled),
+		).
+		WithLines().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type key struct {
+		rate int64
+		note string
+	}
+	index := map[key]int{}
+	var groups []vat.Group
+	for _, iv := range invs {
+		for _, g := range vat.Breakdown(vat.FromInvoiceLines(iv.Edges.Lines)) {
+			k := key{rate: int64(math.Round(g.RatePct * 100)), note: g.ExemptNote}
+			i, ok := index[k]
+			if !ok {
+				i = len(groups)
+				index[k] = i
+				groups = append(groups, vat.Group{RatePct: g.RatePct, ExemptNote: g.ExemptNote})
+			}
+			groups[i].NetCents += g.NetCents
+			groups[i].VATCents += g.VATCents
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].RatePct > groups[j].RatePct })
+	netCents, vatCents := vat.Totals(groups)
+	out := &VATSummaryDTO{
+		Year:         y,
+		Month:        m,
+		InvoiceCount: len(invs),
+		Net:          money.CentsToEuros(netCents),
+		VAT:          money.CentsToEuros(vatCents),
+		Gross:        money.CentsToEuros(netCents + vatCents),
+		Groups:       make([]VATGroupDTO, 0, len(groups)),
+	}
+	for _, g := range groups {
+		out.Groups = append(out.Groups, toVATGroupDTO(g))
+	}
+	return out, nil
+}
+
 func invoiceEventDate(iv *ent.Invoice) string {
 	if iv.UpdatedAt != nil && !iv.UpdatedAt.IsZero() {
 		return iv.UpdatedAt.Format(time.RFC3339)
@@ -252,8 +252,8 @@ func TestGenerateDraftsPerLessonDescriptionIsLatvian(t *testing.T) {
 	if len(lines) != 2 {
 		t.Fatalf("invoice line count = %d, want 2", len(lines))
 	}
-	if lines[0].EnrollmentID != enr.ID {
-		t.Fatalf("service enrollment_id = %d, want %d", lines[0].EnrollmentID, enr.ID)
+	if *lines[0].EnrollmentID != enr.ID {
+		t.Fatalf("service enrollment_id = %d, want %d", *lines[0].EnrollmentID, enr.ID)
 	}
 	if lines[0].Description != "Dalības maksa par Zīmēšana" {
 		t.Fatalf("service description = %q, want %q", lines[0].Description, "Dalības maksa par Zīmēšana")
@@ -342,8 +342,8 @@ func TestGenerateDraftsPerLessonChargeMaterialsFalseDoesNotAddMaterials(t *testi
 	if len(lines) != 1 {
 		t.Fatalf("invoice line count = %d, want 1", len(lines))
 	}
-	if lines[0].EnrollmentID != enr.ID {
-		t.Fatalf("service enrollment_id = %d, want %d", lines[0].EnrollmentID, enr.ID)
+	if *lines[0].EnrollmentID != enr.ID {
+		t.Fatalf("service enrollment_id = %d, want %d", *lines[0].EnrollmentID, enr.ID)
 	}
 }
 
@@ -422,8 +422,8 @@ func TestGenerateDraftsPerLessonSupportsFractionalHours(t *testing.T) {
 	if len(lines) != 2 {
 		t.Fatalf("invoice line count = %d, want 2", len(lines))
 	}
-	if lines[0].EnrollmentID != enr.ID {
-		t.Fatalf("service enrollment_id = %d, want %d", lines[0].EnrollmentID, enr.ID)
+	if *lines[0].EnrollmentID != enr.ID {
+		t.Fatalf("service enrollment_id = %d, want %d", *lines[0].EnrollmentID, enr.ID)
 	}
 	if lines[0].Qty != 1.5 {
 		t.Fatalf("service qty = %v, want 1.5", lines[0].Qty)
@@ -554,8 +554,8 @@ func TestGenerateDraftsMixedEnrollmentsAddsOneMaterialsLineForEligibleEnrollment
 	if len(materialsLines) != 1 {
 		t.Fatalf("materials line count = %d, want 1", len(materialsLines))
 	}
-	if materialsLines[0].EnrollmentID != offlineEnrollment.ID {
-		t.Fatalf("materials enrollment_id = %d, want %d", materialsLines[0].EnrollmentID, offlineEnrollment.ID)
+	if *materialsLines[0].EnrollmentID != offlineEnrollment.ID {
+		t.Fatalf("materials enrollment_id = %d, want %d", *materialsLines[0].EnrollmentID, offlineEnrollment.ID)
 	}
 	if onlineEnrollment.ID == offlineEnrollment.ID {
 		t.Fatalf("expected distinct enrollments for mixed test")
@@ -639,8 +639,8 @@ func TestGenerateDraftsSubscriptionLessonsAddMaterialsWithoutAttendanceRows(t *t
 	if len(lines) != 2 {
 		t.Fatalf("invoice line count = %d, want 2", len(lines))
 	}
-	if lines[0].EnrollmentID != enr.ID {
-		t.Fatalf("subscription enrollment_id = %d, want %d", lines[0].EnrollmentID, enr.ID)
+	if *lines[0].EnrollmentID != enr.ID {
+		t.Fatalf("subscription enrollment_id = %d, want %d", *lines[0].EnrollmentID, enr.ID)
 	}
 	if lines[1].Description != materialsLineDescription {
 		t.Fatalf("materials description = %q, want %q", lines[1].Description, materialsLineDescription)
@@ -764,8 +764,8 @@ func TestGenerateDraftsMixedEnrollmentsAddMaterialsFromSubscriptionLessons(t *te
 	if len(materialsLines) != 1 {
 		t.Fatalf("materials line count = %d, want 1", len(materialsLines))
 	}
-	if materialsLines[0].EnrollmentID != subscriptionEnrollment.ID {
-		t.Fatalf("materials enrollment_id = %d, want %d", materialsLines[0].EnrollmentID, subscriptionEnrollment.ID)
+	if *materialsLines[0].EnrollmentID != subscriptionEnrollment.ID {
+		t.Fatalf("materials enrollment_id = %d, want %d", *materialsLines[0].EnrollmentID, subscriptionEnrollment.ID)
 	}
 }
 
@@ -1163,8 +1163,8 @@ func TestRebuildStudentDraft(t *testing.T) {
 	if len(lines) != 2 {
 		t.Fatalf("invoice line count = %d, want 2", len(lines))
 	}
-	if lines[0].EnrollmentID != enr.ID {
-		t.Fatalf("service enrollment_id = %d, want %d", lines[0].EnrollmentID, enr.ID)
+	if *lines[0].EnrollmentID != enr.ID {
+		t.Fatalf("service enrollment_id = %d, want %d", *lines[0].EnrollmentID, enr.ID)
 	}
 
 	if _, err := client.AttendanceMonth.Update().
@@ -1414,7 +1414,7 @@ func TestRebuildStudentDraftUpdateRollsBackOnInvoiceLineError(t *testing.T) {
 		t.Fatalf("invoice line count after rollback = %d, want %d", len(afterLines), len(beforeLines))
 	}
 	for i := range beforeLines {
-		if afterLines[i].Description != beforeLines[i].Description || afterLines[i].AmountCents != beforeLines[i].AmountCents || afterLines[i].Qty != beforeLines[i].Qty || afterLines[i].EnrollmentID != enr.ID {
+		if afterLines[i].Description != beforeLines[i].Description || afterLines[i].AmountCents != beforeLines[i].AmountCents || afterLines[i].Qty != beforeLines[i].Qty || *afterLines[i].EnrollmentID != enr.ID {
 			t.Fatalf("invoice line %d changed after rollback: got %+v want %+v", i, afterLines[i], beforeLines[i])
 		}
 	}
@@ -2387,3 +2387,17 @@ func TestIssueOneRollsBackWhenSequenceUpdateFails(t *testing.T) {
 		t.Fatalf("NextSeq after rollback = %d, want 7", settingsItem.NextSeq)
 	}
 }
+
+func TestCourseVATReturnsLookupErrors(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-course-vat-error?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+	if rate, note, err := svc.courseVAT(ctx, 999, false); err != nil || rate != 0 || note != "" {
+		t.Fatalf("courseVAT without VAT = %v %q %v, want no VAT data", rate, note, err)
+	}
+	if _, _, err := svc.courseVAT(ctx, 999, true); !ent.IsNotFound(err) {
+		t.Fatalf("courseVAT for a missing course error = %v, want not found", err)
+	}
+}
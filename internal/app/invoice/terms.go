@@ -0,0 +1,447 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/billingterm"
+	"langschool/ent/course"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/internal/app"
+	"langschool/internal/app/vat"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// Course billing periods.
+const (
+	PeriodMonthly = app.BillingPeriodMonthly
+	PeriodTerm    = app.BillingPeriodTerm
+	PeriodCustom  = app.BillingPeriodCustom
+)
+
+// TermInput describes a billing term. A nil CourseID makes it a school term.
+type TermInput struct {
+	Name       string  `json:"name"`
+	StartYear  int     `json:"startYear"`
+	StartMonth int     `json:"startMonth"`
+	EndYear    int     `json:"endYear"`
+	EndMonth   int     `json:"endMonth"`
+	Lessons    float64 `json:"lessons"`
+	CourseID   *int    `json:"courseId,omitempty"`
+}
+
+// TermDTO is a billing term for the UI.
+type TermDTO struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	StartYear    int     `json:"startYear"`
+	StartMonth   int     `json:"startMonth"`
+	EndYear      int     `json:"endYear"`
+	EndMonth     int     `json:"endMonth"`
+	Lessons      float64 `json:"lessons"`
+	CourseID     *int    `json:"courseId,omitempty"`
+	CourseName   string  `json:"courseName,omitempty"`
+	InvoiceCount int     `json:"invoiceCount"`
+	CreatedBy    string  `json:"createdBy"`
+	CreatedAt    string  `json:"createdAt"`
+}
+
+// termEnrollments are a student's enrollments billed with one term invoice.
+type termEnrollments struct {
+	term        *ent.BillingTerm
+	enrollments []*ent.Enrollment
+}
+
+func termCovers(t *ent.BillingTerm, y, m int) bool {
+	idx := monthIndex(y, m)
+	return monthIndex(t.StartYear, t.StartMonth) <= idx && idx <= monthIndex(t.EndYear, t.EndMonth)
+}
+
+// courseTermAt returns the term a course is billed with in the month, or nil
+// when the month is billed monthly: the course is billed monthly or none of
+// its terms covers the month.
+func (s *Service) courseTermAt(ctx context.Context, courseID, y, m int) (*ent.BillingTerm, error) {
+	c, err := s.db.Course.Get(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	q := s.db.BillingTerm.Query()
+	switch string(c.BillingPeriod) {
+	case PeriodTerm:
+		q = q.Where(billingterm.CourseIDIsNil())
+	case PeriodCustom:
+		q = q.Where(billingterm.CourseIDEQ(courseID))
+	default:
+		return nil, nil
+	}
+	terms, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range terms {
+		if termCovers(t, y, m) {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+// splitTermBilled separates the enrollments billed by a term covering the
+// month from those billed on the monthly invoice. Package enrollments stay
+// monthly, where lessons not covered by a package are billed.
+func (s *Service) splitTermBilled(ctx context.Context, ens []*ent.Enrollment, y, m int) ([]*ent.Enrollment, []termEnrollments, error) {
+	monthly := make([]*ent.Enrollment, 0, len(ens))
+	byTerm := map[int]*termEnrollments{}
+	for _, en := range ens {
+		if en.BillingMode == BillingPackage {
+			monthly = append(monthly, en)
+			continue
+		}
+		t, err := s.courseTermAt(ctx, en.CourseID, y, m)
+		if err != nil {
+			return nil, nil, err
+		}
+		if t == nil {
+			monthly = append(monthly, en)
+			continue
+		}
+		if byTerm[t.ID] == nil {
+			byTerm[t.ID] = &termEnrollments{term: t}
+		}
+		byTerm[t.ID].enrollments = append(byTerm[t.ID].enrollments, en)
+	}
+	terms := make([]termEnrollments, 0, len(byTerm))
+	for _, te := range byTerm {
+		terms = append(terms, *te)
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].term.ID < terms[j].term.ID })
+	return monthly, terms, nil
+}
+
+func buildTermLineDescription(courseName, termName string) string {
+	return fmt.Sprintf("%s (%s)", buildCourseLineDescription(courseName), termName)
+}
+
+// rebuildTermDraftInStore builds or rebuilds the student's draft invoice for
+// a term: the term's lessons of every enrollment it covers, at the prices in
+// force in its first month.
+func (s *Service) rebuildTermDraftInStore(ctx context.Context, studentID int, te termEnrollments) (GenerateResult, error) {
+	res := GenerateResult{}
+	t := te.term
+
+	existing, err := s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDEQ(studentID),
+			invoice.KindEQ(invoice.KindTerm),
+			invoice.BillingTermIDEQ(t.ID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return res, err
+	}
+	if err == nil && existing.Status != StatusDraft {
+		res.SkippedHasInvoice++
+		return res, nil
+	}
+
+	lines := make([]*ent.InvoiceLineCreate, 0, len(te.enrollments))
+	lineVAT := make([]vat.Line, 0, len(te.enrollments))
+	var totalCents int64
+	vatEnabled, err := s.vatEnabled(ctx)
+	if err != nil {
+		return res, err
+	}
+	for _, en := range te.enrollments {
+		unitPriceCents, _ := s.resolvePrices(ctx, en, t.StartYear, t.StartMonth)
+		if en.BillingMode == BillingSubscription {
+			if _, subscriptionCents, err := EnrollmentPricesFor(ctx, s.db, en, t.StartYear, t.StartMonth); err == nil && subscriptionCents >= 0 {
+				unitPriceCents = subscriptionCents
+			}
+		}
+		if unitPriceCents <= 0 {
+			continue
+		}
+		courseName := ""
+		if c, err := s.db.Course.Get(ctx, en.CourseID); err == nil {
+			courseName = c.Name
+		}
+		amountCents := money.MulFloatToCents(t.Lessons, unitPriceCents)
+		rate, note, err := s.courseVAT(ctx, en.CourseID, vatEnabled)
+		if err != nil {
+			return res, err
+		}
+		lines = append(lines, s.db.InvoiceLine.Create().
+			SetEnrollmentID(en.ID).
+			SetDescription(buildTermLineDescription(courseName, t.Name)).
+			SetQty(t.Lessons).
+			SetUnitPriceCents(unitPriceCents).
+			SetAmountCents(amountCents).
+			SetVatRatePct(rate).
+			SetVatExemptNote(note))
+		lineVAT = append(lineVAT, vat.Line{NetCents: amountCents, RatePct: rate, ExemptNote: note})
+		totalCents += amountCents
+	}
+	_, vatCents := vat.Totals(vat.Breakdown(lineVAT))
+
+	if existing != nil {
+		if _, err := s.db.InvoiceLine.Delete().Where(invoiceline.InvoiceIDEQ(existing.ID)).Exec(ctx); err != nil {
+			return res, err
+		}
+	}
+	if totalCents <= 0 {
+		if existing != nil {
+			if err := s.db.Invoice.DeleteOneID(existing.ID).Exec(ctx); err != nil {
+				return res, err
+			}
+		}
+		res.SkippedNoLines++
+		return res, nil
+	}
+
+	invoiceID := 0
+	if existing != nil {
+		if _, err := existing.Update().SetTotalAmountCents(totalCents + vatCents).SetVatAmountCents(vatCents).Save(ctx); err != nil {
+			return res, err
+		}
+		invoiceID = existing.ID
+		res.Updated++
+	} else {
+		inv, err := s.db.Invoice.Create().
+			SetStudentID(studentID).
+			SetPeriodYear(t.StartYear).
+			SetPeriodMonth(t.StartMonth).
+			SetKind(invoice.KindTerm).
+			SetBillingTermID(t.ID).
+			SetStatus(StatusDraft).
+			SetTotalAmountCents(totalCents + vatCents).
+			SetVatAmountCents(vatCents).
+			Save(ctx)
+		if err != nil {
+			return res, err
+		}
+		invoiceID = inv.ID
+		res.Created++
+	}
+	for _, lc := range lines {
+		if _, err := lc.SetInvoiceID(invoiceID).Save(ctx); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// ----- Term management -----
+
+func (s *Service) ListTerms(ctx context.Context, courseID *int) ([]TermDTO, error) {
+	q := s.db.BillingTerm.Query().WithCourse()
+	if courseID != nil {
+		q = q.Where(billingterm.CourseIDEQ(*courseID))
+	}
+	items, err := q.Order(ent.Asc(billingterm.FieldStartYear), ent.Asc(billingterm.FieldStartMonth), ent.Asc(billingterm.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TermDTO, 0, len(items))
+	for _, item := range items {
+		dto, err := s.toTermDTO(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
+
+func (s *Service) GetTerm(ctx context.Context, id int) (*TermDTO, error) {
+	item, err := s.db.BillingTerm.Query().Where(billingterm.IDEQ(id)).WithCourse().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto, err := s.toTermDTO(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	return &dto, nil
+}
+
+// CreateTerm adds a school term, or a term of one course billed per its own
+// terms. Terms of the same scope must not overlap.
+func (s *Service) CreateTerm(ctx context.Context, in TermInput, createdBy string) (*TermDTO, error) {
+	if err := s.validateTerm(ctx, 0, &in); err != nil {
+		return nil, err
+	}
+	item, err := s.db.BillingTerm.Create().
+		SetName(in.Name).
+		SetStartYear(in.StartYear).
+		SetStartMonth(in.StartMonth).
+		SetEndYear(in.EndYear).
+		SetEndMonth(in.EndMonth).
+		SetLessons(in.Lessons).
+		SetNillableCourseID(in.CourseID).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetTerm(ctx, item.ID)
+}
+
+// UpdateTerm changes a term until an invoice for it is issued. Draft term
+// invoices pick the change up when they are rebuilt.
+func (s *Service) UpdateTerm(ctx context.Context, id int, in TermInput) (*TermDTO, error) {
+	if _, err := s.db.BillingTerm.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.ensureTermNotInvoiced(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.validateTerm(ctx, id, &in); err != nil {
+		return nil, err
+	}
+	update := s.db.BillingTerm.UpdateOneID(id).
+		SetName(in.Name).
+		SetStartYear(in.StartYear).
+		SetStartMonth(in.StartMonth).
+		SetEndYear(in.EndYear).
+		SetEndMonth(in.EndMonth).
+		SetLessons(in.Lessons)
+	if in.CourseID != nil {
+		update = update.SetCourseID(*in.CourseID)
+	} else {
+		update = update.ClearCourseID()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetTerm(ctx, id)
+}
+
+// DeleteTerm removes a term with no issued invoices, together with its draft
+// invoices.
+func (s *Service) DeleteTerm(ctx context.Context, id int) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.deleteTermInStore(ctx, id)
+		}
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+	if err := (&Service{db: tx.Client(), invoicesDir: s.invoicesDir}).deleteTermInStore(ctx, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+func (s *Service) deleteTermInStore(ctx context.Context, id int) error {
+	if _, err := s.db.BillingTerm.Get(ctx, id); err != nil {
+		return err
+	}
+	if err := s.ensureTermNotInvoiced(ctx, id); err != nil {
+		return err
+	}
+	drafts, err := s.db.Invoice.Query().Where(invoice.BillingTermIDEQ(id)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.InvoiceLine.Delete().Where(invoiceline.InvoiceIDIn(drafts...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := s.db.Invoice.Delete().Where(invoice.IDIn(drafts...)).Exec(ctx); err != nil {
+		return err
+	}
+	return s.db.BillingTerm.DeleteOneID(id).Exec(ctx)
+}
+
+func (s *Service) ensureTermNotInvoiced(ctx context.Context, id int) error {
+	issued, err := s.db.Invoice.Query().
+		Where(invoice.BillingTermIDEQ(id), invoice.StatusNEQ(invoice.StatusDraft)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if issued {
+		return apperrors.Conflict("по семестру уже выставлены счета, его нельзя изменить или удалить")
+	}
+	return nil
+}
+
+func (s *Service) validateTerm(ctx context.Context, id int, in *TermInput) error {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return errors.New("у семестра должно быть название")
+	}
+	if in.StartMonth < 1 || in.StartMonth > 12 || in.EndMonth < 1 || in.EndMonth > 12 {
+		return errors.New("месяц должен быть от 1 до 12")
+	}
+	if monthIndex(in.EndYear, in.EndMonth) < monthIndex(in.StartYear, in.StartMonth) {
+		return errors.New("семестр должен заканчиваться не раньше, чем начинается")
+	}
+	if in.Lessons <= 0 {
+		return errors.New("количество занятий должно быть больше нуля")
+	}
+	q := s.db.BillingTerm.Query().Where(billingterm.IDNEQ(id))
+	if in.CourseID != nil {
+		c, err := s.db.Course.Get(ctx, *in.CourseID)
+		if err != nil {
+			return err
+		}
+		if c.BillingPeriod != course.BillingPeriodCustom {
+			return errors.New("для своих семестров курс должен оплачиваться по своим периодам")
+		}
+		q = q.Where(billingterm.CourseIDEQ(*in.CourseID))
+	} else {
+		q = q.Where(billingterm.CourseIDIsNil())
+	}
+	others, err := q.All(ctx)
+	if err != nil {
+		return err
+	}
+	start, end := monthIndex(in.StartYear, in.StartMonth), monthIndex(in.EndYear, in.EndMonth)
+	for _, o := range others {
+		if start <= monthIndex(o.EndYear, o.EndMonth) && monthIndex(o.StartYear, o.StartMonth) <= end {
+			return apperrors.Conflict(fmt.Sprintf("семестр пересекается с семестром «%s»", o.Name))
+		}
+	}
+	return nil
+}
+
+func (s *Service) toTermDTO(ctx context.Context, t *ent.BillingTerm) (TermDTO, error) {
+	count, err := s.db.Invoice.Query().Where(invoice.BillingTermIDEQ(t.ID)).Count(ctx)
+	if err != nil {
+		return TermDTO{}, err
+	}
+	dto := TermDTO{
+		ID:           t.ID,
+		Name:         t.Name,
+		StartYear:    t.StartYear,
+		StartMonth:   t.StartMonth,
+		EndYear:      t.EndYear,
+		EndMonth:     t.EndMonth,
+		Lessons:      t.Lessons,
+		CourseID:     t.CourseID,
+		InvoiceCount: count,
+		CreatedBy:    t.CreatedBy,
+		CreatedAt:    t.CreatedAt.Format(time.RFC3339),
+	}
+	if t.Edges.Course != nil {
+		dto.CourseName = t.Edges.Course.Name
+	}
+	return dto, nil
+}
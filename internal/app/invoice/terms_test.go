@@ -0,0 +1,150 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	"langschool/ent/invoice"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+func TestTermBilledCoursesGetOneInvoicePerTerm(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:invoice-terms?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := New(client)
+
+	st, err := client.Student.Create().SetFullName("Term Student").SetIsActive(true).Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	termCourse, err := client.Course.Create().
+		SetName("Angļu valoda").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(20)).
+		SetBillingPeriod(course.BillingPeriodTerm).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	monthlyCourse, err := client.Course.Create().
+		SetName("Vācu valoda").
+		SetType(course.TypeGroup).
+		SetLessonPriceCents(money.EurosToCents(15)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Course.Create: %v", err)
+	}
+	for _, c := range []*ent.Course{termCourse, monthlyCourse} {
+		if _, err := client.Enrollment.Create().
+			SetStudentID(st.ID).
+			SetCourseID(c.ID).
+			SetBillingMode(enrollment.BillingModePerLesson).
+			SetChargeMaterials(false).
+			Save(ctx); err != nil {
+			t.Fatalf("Enrollment.Create: %v", err)
+		}
+		for _, m := range []int{9, 10} {
+			if _, err := client.AttendanceMonth.Create().
+				SetStudentID(st.ID).
+				SetCourseID(c.ID).
+				SetYear(2026).
+				SetMonth(m).
+				SetHours(4).
+				Save(ctx); err != nil {
+				t.Fatalf("AttendanceMonth.Create: %v", err)
+			}
+		}
+	}
+
+	term, err := svc.CreateTerm(ctx, TermInput{Name: "Rudens semestris", StartYear: 2026, StartMonth: 9, EndYear: 2026, EndMonth: 12, Lessons: 16}, "admin")
+	if err != nil {
+		t.Fatalf("CreateTerm: %v", err)
+	}
+	_, err = svc.CreateTerm(ctx, TermInput{Name: "Overlap", StartYear: 2026, StartMonth: 12, EndYear: 2027, EndMonth: 1, Lessons: 4}, "admin")
+	if !apperrors.IsConflict(err) {
+		t.Fatalf("overlapping term error = %v, want conflict", err)
+	}
+
+	res, err := svc.GenerateDrafts(ctx, 2026, 9)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.Created != 2 {
+		t.Fatalf("September result = %+v, want a term and a monthly invoice", res)
+	}
+	termInvoice, err := client.Invoice.Query().Where(invoice.KindEQ(invoice.KindTerm)).WithLines().Only(ctx)
+	if err != nil {
+		t.Fatalf("term invoice: %v", err)
+	}
+	if termInvoice.BillingTermID == nil || *termInvoice.BillingTermID != term.ID ||
+		termInvoice.TotalAmountCents != money.EurosToCents(320) || len(termInvoice.Edges.Lines) != 1 ||
+		termInvoice.Edges.Lines[0].Description != "Dalības maksa par Angļu valoda (Rudens semestris)" {
+		t.Fatalf("term invoice = %+v, lines = %+v", termInvoice, termInvoice.Edges.Lines)
+	}
+	september, err := client.Invoice.Query().
+		Where(invoice.KindEQ(invoice.KindMonthly), invoice.PeriodMonthEQ(9)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("September invoice: %v", err)
+	}
+	if september.TotalAmountCents != money.EurosToCents(60) {
+		t.Fatalf("September monthly total = %d, want only the monthly course", september.TotalAmountCents)
+	}
+
+	if _, err := svc.issueOne(ctx, termInvoice.ID); err != nil {
+		t.Fatalf("issueOne: %v", err)
+	}
+	res, err = svc.GenerateDrafts(ctx, 2026, 10)
+	if err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	if res.SkippedHasInvoice != 1 || res.Created != 1 {
+		t.Fatalf("October result = %+v", res)
+	}
+	october, err := client.Invoice.Query().
+		Where(invoice.KindEQ(invoice.KindMonthly), invoice.PeriodMonthEQ(10)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("October invoice: %v", err)
+	}
+	if october.TotalAmountCents != money.EurosToCents(60) {
+		t.Fatalf("October monthly total = %d, term lessons must not be billed again", october.TotalAmountCents)
+	}
+
+	if _, err := svc.UpdateTerm(ctx, term.ID, TermInput{Name: "Rudens", StartYear: 2026, StartMonth: 9, EndYear: 2026, EndMonth: 12, Lessons: 12}); !apperrors.IsConflict(err) {
+		t.Fatalf("UpdateTerm of an invoiced term error = %v, want conflict", err)
+	}
+	if err := svc.DeleteTerm(ctx, term.ID); !apperrors.IsConflict(err) {
+		t.Fatalf("DeleteTerm of an invoiced term error = %v, want conflict", err)
+	}
+
+	// Months no term covers are billed monthly again.
+	if _, err := client.AttendanceMonth.Create().
+		SetStudentID(st.ID).
+		SetCourseID(termCourse.ID).
+		SetYear(2027).
+		SetMonth(1).
+		SetHours(2).
+		Save(ctx); err != nil {
+		t.Fatalf("AttendanceMonth.Create: %v", err)
+	}
+	if _, err := svc.GenerateDrafts(ctx, 2027, 1); err != nil {
+		t.Fatalf("GenerateDrafts: %v", err)
+	}
+	january, err := client.Invoice.Query().
+		Where(invoice.KindEQ(invoice.KindMonthly), invoice.PeriodYearEQ(2027), invoice.PeriodMonthEQ(1)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("January invoice: %v", err)
+	}
+	if january.TotalAmountCents != money.EurosToCents(40) {
+		t.Fatalf("January total = %d, want 4000", january.TotalAmountCents)
+	}
+}
@@ -0,0 +1,203 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"langschool/ent"
+	"langschool/ent/invoice"
+	"langschool/ent/payment"
+	"langschool/internal/app"
+	"langschool/internal/app/reference"
+	"langschool/internal/money"
+)
+
+// ErrBankPaymentUnmatched is returned when neither a creditor reference nor an
+// open invoice number can be found in the remittance text.
+var ErrBankPaymentUnmatched = errors.New("назначение платежа должно содержать RF-ссылку или номер открытого счёта")
+
+const (
+	BankMatchByReference     = "reference"
+	BankMatchByInvoiceNumber = "invoice_number"
+)
+
+// BankMatchDTO describes which invoice a bank transfer belongs to, based on
+// the remittance information the payer entered.
+type BankMatchDTO struct {
+	MatchedBy     string  `json:"matchedBy"`               // "reference", "invoice_number" or "" when nothing matched
+	InvoiceID     *int    `json:"invoiceId,omitempty"`     // Matched invoice
+	InvoiceNumber *string `json:"invoiceNumber,omitempty"` // Matched invoice number
+	Reference     string  `json:"reference,omitempty"`     // RF creditor reference of the matched invoice
+	StudentID     int     `json:"studentId,omitempty"`     // Owner of the matched invoice
+	StudentName   string  `json:"studentName,omitempty"`   // Owner name
+	Remaining     float64 `json:"remaining"`               // Amount still open on the matched invoice
+}
+
+// MatchBankRemittance resolves remittance text to an invoice. A valid RF
+// creditor reference always wins; the invoice number is only used as a
+// fallback because payers often mistype it.
+func (s *Service) MatchBankRemittance(ctx context.Context, remittance string) (*BankMatchDTO, error) {
+	iv, matchedBy, err := s.matchBankRemittance(ctx, remittance)
+	if err != nil {
+		return nil, err
+	}
+	if iv == nil {
+		return &BankMatchDTO{}, nil
+	}
+	_, _, remaining, err := s.invoiceBalanceCents(ctx, iv)
+	if err != nil {
+		return nil, err
+	}
+	invoiceID := iv.ID
+	dto := &BankMatchDTO{
+		MatchedBy:     matchedBy,
+		InvoiceID:     &invoiceID,
+		InvoiceNumber: iv.Number,
+		Reference:     reference.ForInvoice(iv.ID),
+		StudentID:     iv.StudentID,
+		Remaining:     money.CentsToEuros(remaining),
+	}
+	if iv.Edges.Student != nil {
+		dto.StudentName = iv.Edges.Student.FullName
+	}
+	return dto, nil
+}
+
+// RecordBankPayment books a bank transfer against the invoice matched from
+// its remittance text. Any amount above the invoice's open balance is
+// allocated to the student's other open invoices or kept as credit.
+func (s *Service) RecordBankPayment(ctx context.Context, remittance string, amount float64, paidAt string, note string) (*PaymentDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.recordBankPaymentInStore(ctx, remittance, amount, paidAt, note)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).recordBankPaymentInStore(ctx, remittance, amount, paidAt, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) recordBankPaymentInStore(ctx context.Context, remittance string, amount float64, paidAt string, note string) (*PaymentDTO, error) {
+	amountCents := money.EurosToCents(amount)
+	if amountCents <= 0 {
+		return nil, errors.New("сумма должна быть больше 0")
+	}
+	t, err := parseDate(paidAt)
+	if err != nil {
+		return nil, fmt.Errorf("некорректная дата оплаты paidAt: %w", err)
+	}
+	iv, _, err := s.matchBankRemittance(ctx, remittance)
+	if err != nil {
+		return nil, err
+	}
+	if iv == nil {
+		return nil, ErrBankPaymentUnmatched
+	}
+	if strings.TrimSpace(note) == "" {
+		note = strings.TrimSpace(remittance)
+	}
+
+	_, _, invoiceRemaining, err := s.invoiceBalanceCents(ctx, iv)
+	if err != nil {
+		return nil, err
+	}
+	linked := min(amountCents, invoiceRemaining)
+	if linked <= 0 {
+		return s.allocateToOldestInvoices(ctx, iv.StudentID, amountCents, app.PaymentMethodBank, t, note)
+	}
+
+	invoiceID := iv.ID
+	p, err := s.db.Payment.Create().
+		SetStudentID(iv.StudentID).
+		SetAmountCents(linked).
+		SetMethod(payment.MethodBank).
+		SetPaidAt(t).
+		SetNote(note).
+		SetNillableInvoiceID(&invoiceID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recomputeInvoiceStatus(ctx, iv.ID); err != nil {
+		return nil, err
+	}
+	if surplus := amountCents - linked; surplus > 0 {
+		if _, err := s.allocateToOldestInvoices(ctx, iv.StudentID, surplus, app.PaymentMethodBank, t, note); err != nil {
+			return nil, err
+		}
+	}
+	return toDTO(p), nil
+}
+
+func (s *Service) matchBankRemittance(ctx context.Context, remittance string) (*ent.Invoice, string, error) {
+	for _, id := range reference.FindInvoiceIDs(remittance) {
+		iv, err := s.db.Invoice.Query().
+			Where(invoice.IDEQ(id), invoice.StatusIn(openInvoiceStatuses()...)).
+			WithStudent().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return iv, BankMatchByReference, nil
+	}
+
+	text := normalizeRemittance(remittance)
+	if text == "" {
+		return nil, "", nil
+	}
+	candidates, err := s.db.Invoice.Query().
+		Where(invoice.NumberNotNil(), invoice.StatusIn(openInvoiceStatuses()...)).
+		WithStudent().
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	// Prefer the longest matching number so "LS-202503-1" never shadows "LS-202503-12".
+	var best *ent.Invoice
+	bestLen := 0
+	for _, iv := range candidates {
+		number := normalizeRemittance(*iv.Number)
+		if number == "" || !strings.Contains(text, number) || len(number) <= bestLen {
+			continue
+		}
+		best, bestLen = iv, len(number)
+	}
+	if best == nil {
+		return nil, "", nil
+	}
+	return best, BankMatchByInvoiceNumber, nil
+}
+
+// normalizeRemittance keeps only letters and digits so "LS 202503/0042" and
+// "LS-202503-0042" compare equal.
+func normalizeRemittance(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,301 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/cashreceipt"
+	"langschool/ent/payment"
+	"langschool/internal/app/cashdesk"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+	pdfgen "langschool/internal/pdf"
+)
+
+// CashReceiptPrefix starts every cash receipt number. Receipts have their own
+// sequence, independent of invoice numbers.
+const CashReceiptPrefix = "KI"
+
+// CashReceiptDTO represents a cash receipt in the frontend.
+type CashReceiptDTO struct {
+	ID          int                        `json:"id"`
+	Number      string                     `json:"number"` // e.g. KI-000042
+	StudentID   int                        `json:"studentId"`
+	StudentName string                     `json:"studentName"`
+	PayerName   string                     `json:"payerName"`
+	Amount      float64                    `json:"amount"`
+	PaidAt      string                     `json:"paidAt"`
+	ReceivedBy  string                     `json:"receivedBy"`
+	Note        string                     `json:"note"`
+	Status      string                     `json:"status"` // "issued" or "voided"
+	VoidedAt    *string                    `json:"voidedAt,omitempty"`
+	VoidedBy    string                     `json:"voidedBy,omitempty"`
+	Allocations []CashReceiptAllocationDTO `json:"allocations"`
+	Credit      float64                    `json:"credit"` // Part kept as student credit
+}
+
+// CashReceiptAllocationDTO is the part of a receipt applied to one invoice.
+type CashReceiptAllocationDTO struct {
+	InvoiceID     int     `json:"invoiceId"`
+	InvoiceNumber *string `json:"invoiceNumber,omitempty"`
+	Year          int     `json:"year"`
+	Month         int     `json:"month"`
+	Amount        float64 `json:"amount"`
+}
+
+type receivedByKey struct{}
+
+type receivedBy struct {
+	userID *int
+	name   string
+}
+
+// WithReceivedBy records who is taking cash so that receipts issued within
+// ctx name them.
+func WithReceivedBy(ctx context.Context, userID *int, name string) context.Context {
+	return context.WithValue(ctx, receivedByKey{}, receivedBy{userID: userID, name: strings.TrimSpace(name)})
+}
+
+func receivedByFromContext(ctx context.Context) receivedBy {
+	value, _ := ctx.Value(receivedByKey{}).(receivedBy)
+	return value
+}
+
+// FormatCashReceiptNumber formats a receipt number from its sequence.
+func FormatCashReceiptNumber(seq int) string {
+	return fmt.Sprintf("%s-%06d", CashReceiptPrefix, seq)
+}
+
+// issueCashReceiptInStore numbers a receipt for a cash payment and links the
+// payment rows it was split into. The receipt is counted in the open cash
+// session, if any.
+func (s *Service) issueCashReceiptInStore(ctx context.Context, studentID int, amountCents int64, paidAt time.Time, note string, rows []*ent.Payment) (*ent.CashReceipt, error) {
+	last, err := s.db.CashReceipt.Query().
+		Order(ent.Desc(cashreceipt.FieldSeq)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	seq := 1
+	if last != nil {
+		seq = last.Seq + 1
+	}
+	sessionID, err := cashdesk.OpenSessionID(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	by := receivedByFromContext(ctx)
+	receipt, err := s.db.CashReceipt.Create().
+		SetSeq(seq).
+		SetNumber(FormatCashReceiptNumber(seq)).
+		SetStudentID(studentID).
+		SetAmountCents(amountCents).
+		SetPaidAt(paidAt).
+		SetNillableReceivedByUserID(by.userID).
+		SetReceivedBy(by.name).
+		SetNote(note).
+		SetNillableCashSessionID(sessionID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+		row.CashReceiptID = &receipt.ID
+	}
+	if err := s.db.Payment.Update().
+		Where(payment.IDIn(ids...)).
+		SetCashReceiptID(receipt.ID).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// voidCashReceiptInStore marks a receipt voided and removes every payment
+// row it covers, so the whole cash amount is reversed at once. Receipts of a
+// closed cash session stay untouched until the session is reopened.
+func (s *Service) voidCashReceiptInStore(ctx context.Context, receiptID int) error {
+	receipt, err := s.db.CashReceipt.Get(ctx, receiptID)
+	if err != nil {
+		return err
+	}
+	if receipt.Status == cashreceipt.StatusVoided {
+		return fmt.Errorf("квитанция %s уже аннулирована", receipt.Number)
+	}
+	if err := cashdesk.EnsurePaymentsEditable(ctx, s.db, receipt.CashSessionID); err != nil {
+		return err
+	}
+	rows, err := s.db.Payment.Query().
+		Where(payment.CashReceiptIDEQ(receiptID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	if err := s.ensureNotRefunded(ctx, ids...); err != nil {
+		return err
+	}
+	if _, err := s.db.Payment.Delete().
+		Where(payment.CashReceiptIDEQ(receiptID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := receipt.Update().
+		SetStatus(cashreceipt.StatusVoided).
+		SetVoidedAt(time.Now()).
+		SetVoidedBy(receivedByFromContext(ctx).name).
+		Save(ctx); err != nil {
+		return err
+	}
+	recomputed := map[int]bool{}
+	for _, row := range rows {
+		if row.InvoiceID == nil || recomputed[*row.InvoiceID] {
+			continue
+		}
+		recomputed[*row.InvoiceID] = true
+		if err := s.recomputeInvoiceStatus(ctx, *row.InvoiceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCashReceipt returns a receipt with its current allocation to invoices.
+func (s *Service) GetCashReceipt(ctx context.Context, id int) (*CashReceiptDTO, error) {
+	receipt, err := s.db.CashReceipt.Query().
+		Where(cashreceipt.IDEQ(id)).
+		WithStudent().
+		WithPayments(func(q *ent.PaymentQuery) { q.WithInvoice() }).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toCashReceiptDTO(receipt), nil
+}
+
+// ListCashReceipts returns receipts newest first, optionally for a single
+// student (studentID > 0).
+func (s *Service) ListCashReceipts(ctx context.Context, studentID int) ([]CashReceiptDTO, error) {
+	query := s.db.CashReceipt.Query().
+		WithStudent().
+		WithPayments(func(q *ent.PaymentQuery) { q.WithInvoice() })
+	if studentID > 0 {
+		query = query.Where(cashreceipt.StudentIDEQ(studentID))
+	}
+	receipts, err := query.Order(ent.Desc(cashreceipt.FieldSeq)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]CashReceiptDTO, 0, len(receipts))
+	for _, receipt := range receipts {
+		out = append(out, *toCashReceiptDTO(receipt))
+	}
+	return out, nil
+}
+
+// CashReceiptPDF renders a receipt.
+func (s *Service) CashReceiptPDF(ctx context.Context, id int, fontsDir string) ([]byte, string, error) {
+	receipt, err := s.db.CashReceipt.Query().
+		Where(cashreceipt.IDEQ(id)).
+		WithStudent().
+		WithPayments(func(q *ent.PaymentQuery) { q.WithInvoice() }).
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	dto := toCashReceiptDTO(receipt)
+	doc := pdfgen.CashReceipt{
+		Number:      receipt.Number,
+		PaidAt:      receipt.PaidAt,
+		Recipient:   recipient.FromStudent(receipt.Edges.Student),
+		StudentName: receipt.Edges.Student.FullName,
+		AmountCents: receipt.AmountCents,
+		ReceivedBy:  receipt.ReceivedBy,
+		Note:        receipt.Note,
+		CreditCents: money.EurosToCents(dto.Credit),
+		VoidedAt:    receipt.VoidedAt,
+	}
+	for _, a := range dto.Allocations {
+		number := ""
+		if a.InvoiceNumber != nil {
+			number = *a.InvoiceNumber
+		}
+		doc.Allocations = append(doc.Allocations, pdfgen.CashReceiptAllocation{
+			InvoiceNumber: number,
+			Period:        fmt.Sprintf("%02d.%d", a.Month, a.Year),
+			AmountCents:   money.EurosToCents(a.Amount),
+		})
+	}
+	return pdfgen.GenerateCashReceiptPDF(ctx, s.db, doc, pdfgen.Options{FontsDir: fontsDir})
+}
+
+// toCashReceiptDTO expects the student and payments (with invoices) edges
+// to be loaded. Voided receipts have no payments left, so their allocation
+// is empty.
+func toCashReceiptDTO(receipt *ent.CashReceipt) *CashReceiptDTO {
+	dto := &CashReceiptDTO{
+		ID:          receipt.ID,
+		Number:      receipt.Number,
+		StudentID:   receipt.StudentID,
+		Amount:      money.CentsToEuros(receipt.AmountCents),
+		PaidAt:      receipt.PaidAt.Format(time.RFC3339),
+		ReceivedBy:  receipt.ReceivedBy,
+		Note:        receipt.Note,
+		Status:      string(receipt.Status),
+		VoidedBy:    receipt.VoidedBy,
+		Allocations: []CashReceiptAllocationDTO{},
+	}
+	if receipt.VoidedAt != nil {
+		voidedAt := receipt.VoidedAt.Format(time.RFC3339)
+		dto.VoidedAt = &voidedAt
+	}
+	if st := receipt.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+		dto.PayerName = strings.TrimSpace(recipient.FromStudent(st).RecipientName)
+	}
+	byInvoice := map[int]*CashReceiptAllocationDTO{}
+	allocatedCents := map[int]int64{}
+	var creditCents int64
+	for _, row := range receipt.Edges.Payments {
+		if row.InvoiceID == nil {
+			creditCents += row.AmountCents
+			continue
+		}
+		allocatedCents[*row.InvoiceID] += row.AmountCents
+		if _, ok := byInvoice[*row.InvoiceID]; ok {
+			continue
+		}
+		a := &CashReceiptAllocationDTO{InvoiceID: *row.InvoiceID}
+		if iv := row.Edges.Invoice; iv != nil {
+			a.InvoiceNumber = iv.Number
+			a.Year = iv.PeriodYear
+			a.Month = iv.PeriodMonth
+		}
+		byInvoice[*row.InvoiceID] = a
+	}
+	for id, a := range byInvoice {
+		a.Amount = money.CentsToEuros(allocatedCents[id])
+		dto.Allocations = append(dto.Allocations, *a)
+	}
+	sort.Slice(dto.Allocations, func(i, j int) bool {
+		ai, aj := dto.Allocations[i], dto.Allocations[j]
+		if ai.Year != aj.Year {
+			return ai.Year < aj.Year
+		}
+		if ai.Month != aj.Month {
+			return ai.Month < aj.Month
+		}
+		return ai.InvoiceID < aj.InvoiceID
+	})
+	dto.Credit = money.CentsToEuros(creditCents)
+	return dto
+}
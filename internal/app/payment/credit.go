@@ -0,0 +1,552 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+	"langschool/internal/app"
+	"langschool/internal/app/cashdesk"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+)
+
+// Credit ledger scopes.
+const (
+	CreditScopeStudent = "student" // only the requested student
+	CreditScopePayer   = "payer"   // all students sharing the student's payer
+)
+
+// Credit ledger entry kinds.
+const (
+	CreditEntryOverpayment  = "overpayment"   // payment not allocated to an invoice
+	CreditEntryApplied      = "applied"       // credit used to pay an invoice
+	CreditEntryRefund       = "refund"        // credit paid back
+	CreditEntryTransferIn   = "transfer_in"   // credit received from another student
+	CreditEntryTransferOut  = "transfer_out"  // credit moved to another student
+	CreditEntryLessonCredit = "lesson_credit" // value of unused package lessons
+)
+
+// CreditEntryDTO is one movement of a student's credit. Amounts are signed:
+// positive entries add credit, negative entries use it up.
+type CreditEntryDTO struct {
+	Date             string  `json:"date"` // RFC3339
+	Kind             string  `json:"kind"`
+	StudentID        int     `json:"studentId"`
+	StudentName      string  `json:"studentName"`
+	PaymentID        int     `json:"paymentId"`
+	RelatedPaymentID *int    `json:"relatedPaymentId,omitempty"`
+	CounterpartName  string  `json:"counterpartName,omitempty"` // Other student of a transfer
+	InvoiceID        *int    `json:"invoiceId,omitempty"`
+	InvoiceNumber    *string `json:"invoiceNumber,omitempty"`
+	Method           string  `json:"method"`
+	Note             string  `json:"note"`
+	Amount           float64 `json:"amount"`
+	Balance          float64 `json:"balance"` // Running credit
+}
+
+// CreditLedgerDTO lists how the credit of a student, or of every student
+// sharing a payer, was built up and used.
+type CreditLedgerDTO struct {
+	Scope       string           `json:"scope"`
+	StudentIDs  []int            `json:"studentIds"`
+	Credit      float64          `json:"credit"`      // Credit available now
+	Refunded    float64          `json:"refunded"`    // Total paid back
+	Transferred float64          `json:"transferred"` // Net credit received from other students
+	Entries     []CreditEntryDTO `json:"entries"`
+}
+
+// CreditTransferDTO holds both halves of a credit transfer.
+type CreditTransferDTO struct {
+	Out PaymentDTO `json:"out"`
+	In  PaymentDTO `json:"in"`
+}
+
+// Refund pays back part of a student's credit. The refund is linked to the
+// unallocated payment it comes from; cash refunds are taken out of the open
+// cash session.
+func (s *Service) Refund(ctx context.Context, paymentID int, amount float64, method, refundedAt, note string) (*PaymentDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.refundInStore(ctx, paymentID, amount, method, refundedAt, note)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).refundInStore(ctx, paymentID, amount, method, refundedAt, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) refundInStore(ctx context.Context, paymentID int, amount float64, method, refundedAt, note string) (*PaymentDTO, error) {
+	amountCents := money.EurosToCents(amount)
+	if amountCents <= 0 {
+		return nil, errors.New("сумма возврата должна быть больше 0")
+	}
+	if method != app.PaymentMethodCash && method != app.PaymentMethodBank {
+		return nil, errors.New("способ возврата должен быть 'cash' или 'bank'")
+	}
+	t := time.Now()
+	if strings.TrimSpace(refundedAt) != "" {
+		parsed, err := parseDate(refundedAt)
+		if err != nil {
+			return nil, fmt.Errorf("некорректная дата возврата refundedAt: %w", err)
+		}
+		t = parsed
+	}
+
+	orig, err := s.db.Payment.Get(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if orig.Kind != payment.KindPayment && orig.Kind != payment.KindTransferIn && orig.Kind != payment.KindLessonCredit {
+		return nil, fmt.Errorf("нельзя оформить возврат по записи %d: это не оплата", orig.ID)
+	}
+	if orig.InvoiceID != nil {
+		return nil, errors.New("нельзя вернуть оплату, привязанную к счёту; вернуть можно только переплату")
+	}
+	refunds, err := s.db.Payment.Query().
+		Where(payment.KindEQ(payment.KindRefund), payment.RelatedPaymentIDEQ(orig.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := orig.AmountCents
+	for _, r := range refunds {
+		limit += r.AmountCents
+	}
+	available, err := s.creditCents(ctx, orig.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	if available < limit {
+		limit = available
+	}
+	if amountCents > limit {
+		return nil, fmt.Errorf("сумма возврата не должна превышать %.2f", money.CentsToEuros(max(limit, 0)))
+	}
+
+	row, err := s.db.Payment.Create().
+		SetStudentID(orig.StudentID).
+		SetAmountCents(-amountCents).
+		SetMethod(payment.Method(method)).
+		SetPaidAt(t).
+		SetNote(strings.TrimSpace(note)).
+		SetKind(payment.KindRefund).
+		SetRelatedPaymentID(orig.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if method == app.PaymentMethodCash {
+		by := receivedByFromContext(ctx)
+		if err := cashdesk.RecordRefund(ctx, s.db, row.ID, amountCents, row.Note, cashdesk.User{ID: by.userID, Name: by.name}); err != nil {
+			return nil, err
+		}
+	}
+	return toDTO(row), nil
+}
+
+// RefundLessonCredit pays back the value of a package's unused lessons. The
+// value is booked as lesson credit and refunded at once, so it never pays
+// other invoices.
+func (s *Service) RefundLessonCredit(ctx context.Context, studentID int, amountCents int64, method, note string) (*PaymentDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.refundLessonCreditInStore(ctx, studentID, amountCents, method, note)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).refundLessonCreditInStore(ctx, studentID, amountCents, method, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) refundLessonCreditInStore(ctx context.Context, studentID int, amountCents int64, method, note string) (*PaymentDTO, error) {
+	if amountCents <= 0 {
+		return nil, errors.New("сумма возврата должна быть больше 0")
+	}
+	if method != app.PaymentMethodCash && method != app.PaymentMethodBank {
+		return nil, errors.New("способ возврата должен быть 'cash' или 'bank'")
+	}
+	credit, err := s.db.Payment.Create().
+		SetStudentID(studentID).
+		SetAmountCents(amountCents).
+		SetMethod(payment.Method(method)).
+		SetPaidAt(time.Now()).
+		SetNote(strings.TrimSpace(note)).
+		SetKind(payment.KindLessonCredit).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.refundInStore(ctx, credit.ID, money.CentsToEuros(amountCents), method, "", note)
+}
+
+// TransferCredit moves credit from one student to another, typically between
+// siblings, and applies it to the receiving student's open invoices.
+func (s *Service) TransferCredit(ctx context.Context, fromStudentID, toStudentID int, amount float64, note string) (*CreditTransferDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.transferCreditInStore(ctx, fromStudentID, toStudentID, amount, note)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).transferCreditInStore(ctx, fromStudentID, toStudentID, amount, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) transferCreditInStore(ctx context.Context, fromStudentID, toStudentID int, amount float64, note string) (*CreditTransferDTO, error) {
+	amountCents := money.EurosToCents(amount)
+	if amountCents <= 0 {
+		return nil, errors.New("сумма переноса должна быть больше 0")
+	}
+	if fromStudentID == toStudentID {
+		return nil, errors.New("нельзя перенести кредит тому же ученику")
+	}
+	if _, err := s.db.Student.Get(ctx, fromStudentID); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.Student.Get(ctx, toStudentID); err != nil {
+		return nil, err
+	}
+	available, err := s.creditCents(ctx, fromStudentID)
+	if err != nil {
+		return nil, err
+	}
+	if amountCents > available {
+		return nil, fmt.Errorf("сумма переноса не должна превышать кредит ученика %.2f", money.CentsToEuros(max(available, 0)))
+	}
+
+	// Transfers keep the method of the newest credit they move.
+	method := payment.MethodBank
+	last, err := s.db.Payment.Query().
+		Where(
+			payment.StudentIDEQ(fromStudentID),
+			payment.InvoiceIDIsNil(),
+			payment.AmountCentsGT(0),
+		).
+		Order(ent.Desc(payment.FieldPaidAt), ent.Desc(payment.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		method = last.Method
+	}
+
+	now := time.Now()
+	note = strings.TrimSpace(note)
+	out, err := s.db.Payment.Create().
+		SetStudentID(fromStudentID).
+		SetAmountCents(-amountCents).
+		SetMethod(method).
+		SetPaidAt(now).
+		SetNote(note).
+		SetKind(payment.KindTransferOut).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := s.db.Payment.Create().
+		SetStudentID(toStudentID).
+		SetAmountCents(amountCents).
+		SetMethod(method).
+		SetPaidAt(now).
+		SetNote(note).
+		SetKind(payment.KindTransferIn).
+		SetRelatedPaymentID(out.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out, err = out.Update().SetRelatedPaymentID(in.ID).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyCreditToOldestInvoicesInStore(ctx, toStudentID); err != nil {
+		return nil, err
+	}
+	return &CreditTransferDTO{Out: *toDTO(out), In: *toDTO(in)}, nil
+}
+
+// deleteTransferInStore removes both halves of a transfer. Credit the
+// receiving student has already used cannot be moved back.
+func (s *Service) deleteTransferInStore(ctx context.Context, p *ent.Payment) error {
+	var related *ent.Payment
+	if p.RelatedPaymentID != nil {
+		found, err := s.db.Payment.Get(ctx, *p.RelatedPaymentID)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		related = found
+	}
+	out, in := p, related
+	if p.Kind == payment.KindTransferIn {
+		out, in = related, p
+	}
+	if out == nil || in == nil || in.AmountCents != -out.AmountCents {
+		return fmt.Errorf("нельзя удалить перенос кредита %d: кредит уже использован", p.ID)
+	}
+	if err := s.ensureNotRefunded(ctx, in.ID); err != nil {
+		return err
+	}
+	if _, err := s.db.Payment.Delete().
+		Where(payment.IDIn(out.ID, in.ID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return s.ensureCreditNotNegative(ctx, in.StudentID, p.ID)
+}
+
+// CreditLedger returns the credit history of a student, or with
+// CreditScopePayer of every student sharing the student's payer.
+func (s *Service) CreditLedger(ctx context.Context, studentID int, scope string) (*CreditLedgerDTO, error) {
+	if scope == "" {
+		scope = CreditScopeStudent
+	}
+	if scope != CreditScopeStudent && scope != CreditScopePayer {
+		return nil, fmt.Errorf("scope must be '%s' or '%s'", CreditScopeStudent, CreditScopePayer)
+	}
+	primary, err := s.db.Student.Get(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	students := []*ent.Student{primary}
+	if scope == CreditScopePayer {
+		all, err := s.db.Student.Query().Order(ent.Asc(student.FieldID)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key := recipient.PayerKey(primary)
+		students = students[:0]
+		for _, st := range all {
+			if recipient.PayerKey(st) == key {
+				students = append(students, st)
+			}
+		}
+	}
+	ids := make([]int, 0, len(students))
+	names := map[int]string{}
+	for _, st := range students {
+		ids = append(ids, st.ID)
+		names[st.ID] = st.FullName
+	}
+
+	rows, err := s.db.Payment.Query().
+		Where(
+			payment.StudentIDIn(ids...),
+			payment.Or(payment.InvoiceIDIsNil(), payment.KindEQ(payment.KindCreditApplied)),
+		).
+		WithInvoice().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Transfers name the other student.
+	var relatedIDs []int
+	for _, row := range rows {
+		if row.RelatedPaymentID != nil {
+			relatedIDs = append(relatedIDs, *row.RelatedPaymentID)
+		}
+	}
+	counterparts := map[int]string{}
+	if len(relatedIDs) > 0 {
+		related, err := s.db.Payment.Query().
+			Where(payment.IDIn(relatedIDs...)).
+			WithStudent().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range related {
+			if r.Edges.Student != nil {
+				counterparts[r.ID] = r.Edges.Student.FullName
+			}
+		}
+	}
+
+	type ledgerEntry struct {
+		at    time.Time
+		dto   CreditEntryDTO
+		cents int64
+	}
+	var entries []ledgerEntry
+	add := func(at time.Time, kind string, row *ent.Payment, cents int64) {
+		dto := CreditEntryDTO{
+			Date:             at.Format(time.RFC3339),
+			Kind:             kind,
+			StudentID:        row.StudentID,
+			StudentName:      names[row.StudentID],
+			PaymentID:        row.ID,
+			RelatedPaymentID: row.RelatedPaymentID,
+			Method:           string(row.Method),
+			Note:             row.Note,
+			Amount:           money.CentsToEuros(cents),
+		}
+		if kind == CreditEntryTransferIn || kind == CreditEntryTransferOut {
+			if row.RelatedPaymentID != nil {
+				dto.CounterpartName = counterparts[*row.RelatedPaymentID]
+			}
+		}
+		if kind == CreditEntryApplied {
+			dto.InvoiceID = row.InvoiceID
+			if row.Edges.Invoice != nil {
+				dto.InvoiceNumber = row.Edges.Invoice.Number
+			}
+		}
+		entries = append(entries, ledgerEntry{at: at, dto: dto, cents: cents})
+	}
+
+	var refundedCents, transferredCents int64
+	for _, row := range rows {
+		switch row.Kind {
+		case payment.KindCreditApplied:
+			// The applied part was credit first; a related payment means it
+			// came from a transfer.
+			source := CreditEntryOverpayment
+			if row.RelatedPaymentID != nil {
+				source = CreditEntryTransferIn
+			}
+			add(row.PaidAt, source, row, row.AmountCents)
+			add(row.CreatedAt, CreditEntryApplied, row, -row.AmountCents)
+		case payment.KindRefund:
+			refundedCents -= row.AmountCents
+			add(row.PaidAt, CreditEntryRefund, row, row.AmountCents)
+		case payment.KindTransferIn:
+			add(row.PaidAt, CreditEntryTransferIn, row, row.AmountCents)
+		case payment.KindTransferOut:
+			add(row.PaidAt, CreditEntryTransferOut, row, row.AmountCents)
+		case payment.KindLessonCredit:
+			add(row.PaidAt, CreditEntryLessonCredit, row, row.AmountCents)
+		default:
+			add(row.PaidAt, CreditEntryOverpayment, row, row.AmountCents)
+		}
+		if row.Kind == payment.KindTransferOut {
+			transferredCents += row.AmountCents
+		}
+		if row.Kind == payment.KindTransferIn || (row.Kind == payment.KindCreditApplied && row.RelatedPaymentID != nil) {
+			transferredCents += row.AmountCents
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].at.Equal(entries[j].at) {
+			return entries[i].at.Before(entries[j].at)
+		}
+		// Credit is added before it is used on the same instant.
+		if (entries[i].cents >= 0) != (entries[j].cents >= 0) {
+			return entries[i].cents >= 0
+		}
+		return entries[i].dto.PaymentID < entries[j].dto.PaymentID
+	})
+
+	out := &CreditLedgerDTO{
+		Scope:       scope,
+		StudentIDs:  ids,
+		Refunded:    money.CentsToEuros(refundedCents),
+		Transferred: money.CentsToEuros(transferredCents),
+		Entries:     make([]CreditEntryDTO, 0, len(entries)),
+	}
+	var balance int64
+	for _, e := range entries {
+		balance += e.cents
+		e.dto.Balance = money.CentsToEuros(balance)
+		out.Entries = append(out.Entries, e.dto)
+	}
+	out.Credit = money.CentsToEuros(balance)
+	return out, nil
+}
+
+// creditCents is the credit a student can use: unallocated payments less
+// refunds and outgoing transfers.
+func (s *Service) creditCents(ctx context.Context, studentID int) (int64, error) {
+	rows, err := s.db.Payment.Query().
+		Where(payment.StudentIDEQ(studentID), payment.InvoiceIDIsNil()).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var sum int64
+	for _, row := range rows {
+		sum += row.AmountCents
+	}
+	return sum, nil
+}
+
+func (s *Service) ensureCreditNotNegative(ctx context.Context, studentID, paymentID int) error {
+	credit, err := s.creditCents(ctx, studentID)
+	if err != nil {
+		return err
+	}
+	if credit < 0 {
+		return fmt.Errorf("нельзя удалить платёж %d: его переплата уже возвращена или перенесена", paymentID)
+	}
+	return nil
+}
+
+func (s *Service) ensureNotRefunded(ctx context.Context, paymentIDs ...int) error {
+	if len(paymentIDs) == 0 {
+		return nil
+	}
+	refund, err := s.db.Payment.Query().
+		Where(payment.KindEQ(payment.KindRefund), payment.RelatedPaymentIDIn(paymentIDs...)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return fmt.Errorf("нельзя удалить платёж %d: по нему оформлен возврат; сначала удалите возврат", *refund.RelatedPaymentID)
+}
@@ -0,0 +1,455 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/invoice"
+	"langschool/ent/paymentplan"
+	"langschool/ent/paymentplaninstalment"
+	"langschool/internal/app"
+	"langschool/internal/money"
+)
+
+// Payment plan and instalment statuses.
+const (
+	PlanStatusOnTrack   = "on_track"  // every instalment due so far is paid
+	PlanStatusMissed    = "missed"    // an instalment is past its due date and not fully paid
+	PlanStatusCompleted = "completed" // the whole planned amount is paid
+	PlanStatusCanceled  = "canceled"
+
+	InstalmentStatusPaid          = "paid"
+	InstalmentStatusPartiallyPaid = "partially_paid"
+	InstalmentStatusPending       = "pending" // not due yet
+	InstalmentStatusMissed        = "missed"
+)
+
+// InstalmentInput is one scheduled amount when creating a plan.
+type InstalmentInput struct {
+	DueOn  string  `json:"dueOn"` // YYYY-MM-DD
+	Amount float64 `json:"amount"`
+}
+
+// PlanInput describes a new payment plan. Instalments may be left empty and
+// generated instead: Count equal monthly instalments from FirstDueOn.
+type PlanInput struct {
+	StudentID   int               `json:"studentId"`
+	InvoiceIDs  []int             `json:"invoiceIds"`
+	Instalments []InstalmentInput `json:"instalments"`
+	Count       int               `json:"count"`
+	FirstDueOn  string            `json:"firstDueOn"`
+	Note        string            `json:"note"`
+}
+
+// InstalmentDTO is one instalment with its payment status.
+type InstalmentDTO struct {
+	Seq    int     `json:"seq"`
+	DueOn  string  `json:"dueOn"`
+	Amount float64 `json:"amount"`
+	Paid   float64 `json:"paid"`
+	Status string  `json:"status"`
+}
+
+// PlanDTO is a payment plan with progress.
+type PlanDTO struct {
+	ID                int             `json:"id"`
+	StudentID         int             `json:"studentId"`
+	StudentName       string          `json:"studentName"`
+	InvoiceIDs        []int           `json:"invoiceIds"`
+	Total             float64         `json:"total"`
+	Paid              float64         `json:"paid"` // Paid on the invoices since the plan was agreed
+	Remaining         float64         `json:"remaining"`
+	Status            string          `json:"status"`
+	MissedInstalments int             `json:"missedInstalments"`
+	NextDueOn         *string         `json:"nextDueOn,omitempty"`
+	NextDueAmount     float64         `json:"nextDueAmount"`
+	Note              string          `json:"note"`
+	CreatedAt         string          `json:"createdAt"`
+	CreatedBy         string          `json:"createdBy"`
+	CanceledAt        *string         `json:"canceledAt,omitempty"`
+	CanceledBy        string          `json:"canceledBy,omitempty"`
+	Instalments       []InstalmentDTO `json:"instalments"`
+}
+
+// Kept reports whether the plan currently excuses its invoices from being
+// overdue.
+func (p *PlanDTO) Kept() bool {
+	return p.Status == PlanStatusOnTrack
+}
+
+// CreatePlan agrees a payment plan for open invoices of a student. The
+// instalments must add up to what is still owed on the invoices, and an
+// invoice can be on one active plan only.
+func (s *Service) CreatePlan(ctx context.Context, in PlanInput) (*PlanDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.createPlanInStore(ctx, in)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).createPlanInStore(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) createPlanInStore(ctx context.Context, in PlanInput) (*PlanDTO, error) {
+	if in.StudentID <= 0 {
+		return nil, errors.New("studentID должен быть больше 0")
+	}
+	if len(in.InvoiceIDs) == 0 {
+		return nil, errors.New("план платежей должен включать хотя бы один счёт")
+	}
+	if _, err := s.db.Student.Get(ctx, in.StudentID); err != nil {
+		return nil, err
+	}
+
+	invoiceIDs := uniqueInts(in.InvoiceIDs)
+	var totalCents, baselineCents int64
+	for _, invoiceID := range invoiceIDs {
+		iv, err := s.db.Invoice.Get(ctx, invoiceID)
+		if err != nil {
+			return nil, err
+		}
+		if iv.StudentID != in.StudentID {
+			return nil, errors.New("счёт не принадлежит этому ученику")
+		}
+		if !app.InvoiceStatusIsIssuedFamily(string(iv.Status)) {
+			return nil, fmt.Errorf("нельзя включить в план счёт %d: он не выставлен или уже оплачен", iv.ID)
+		}
+		_, paid, remaining, err := s.invoiceBalanceCents(ctx, iv)
+		if err != nil {
+			return nil, err
+		}
+		if remaining <= 0 {
+			return nil, fmt.Errorf("нельзя включить в план счёт %d: он уже оплачен", iv.ID)
+		}
+		totalCents += remaining
+		baselineCents += min(paid, iv.TotalAmountCents)
+	}
+	onPlans, err := s.db.PaymentPlan.Query().
+		Where(
+			paymentplan.CanceledAtIsNil(),
+			paymentplan.HasInvoicesWith(invoice.IDIn(invoiceIDs...)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, onPlan := range onPlans {
+		state, err := s.planDTO(ctx, onPlan, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if state.Status != PlanStatusCompleted {
+			return nil, fmt.Errorf("нельзя создать план: счёт уже входит в план платежей %d", onPlan.ID)
+		}
+	}
+
+	schedule, err := planSchedule(in, totalCents)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.db.PaymentPlan.Create().
+		SetStudentID(in.StudentID).
+		SetTotalCents(totalCents).
+		SetBaselinePaidCents(baselineCents).
+		SetNote(strings.TrimSpace(in.Note)).
+		SetCreatedBy(receivedByFromContext(ctx).name).
+		AddInvoiceIDs(invoiceIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range schedule {
+		if _, err := s.db.PaymentPlanInstalment.Create().
+			SetPlanID(plan.ID).
+			SetSeq(i + 1).
+			SetDueOn(item.dueOn).
+			SetAmountCents(item.cents).
+			Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetPlan(ctx, plan.ID)
+}
+
+type scheduledInstalment struct {
+	dueOn time.Time
+	cents int64
+}
+
+// planSchedule validates the given instalments, or splits totalCents into
+// equal monthly ones. The last generated instalment takes the rounding.
+func planSchedule(in PlanInput, totalCents int64) ([]scheduledInstalment, error) {
+	var out []scheduledInstalment
+	if len(in.Instalments) == 0 {
+		if in.Count <= 0 {
+			return nil, errors.New("должен быть указан график платежей или количество платежей")
+		}
+		first, err := time.Parse("2006-01-02", strings.TrimSpace(in.FirstDueOn))
+		if err != nil {
+			return nil, fmt.Errorf("некорректная дата первого платежа firstDueOn: %w", err)
+		}
+		part := totalCents / int64(in.Count)
+		if part <= 0 {
+			return nil, errors.New("количество платежей должно быть меньше суммы долга в центах")
+		}
+		for i := 0; i < in.Count; i++ {
+			cents := part
+			if i == in.Count-1 {
+				cents = totalCents - part*int64(in.Count-1)
+			}
+			out = append(out, scheduledInstalment{dueOn: addMonthsClamped(first, i), cents: cents})
+		}
+		return out, nil
+	}
+
+	var sum int64
+	for _, item := range in.Instalments {
+		dueOn, err := time.Parse("2006-01-02", strings.TrimSpace(item.DueOn))
+		if err != nil {
+			return nil, fmt.Errorf("некорректная дата платежа dueOn: %w", err)
+		}
+		cents := money.EurosToCents(item.Amount)
+		if cents <= 0 {
+			return nil, errors.New("сумма платежа по графику должна быть больше 0")
+		}
+		sum += cents
+		out = append(out, scheduledInstalment{dueOn: dueOn, cents: cents})
+	}
+	if sum != totalCents {
+		return nil, fmt.Errorf("сумма платежей по графику %.2f должна быть равна долгу по счетам %.2f",
+			money.CentsToEuros(sum), money.CentsToEuros(totalCents))
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].dueOn.Before(out[j].dueOn) })
+	return out, nil
+}
+
+// CancelPlan ends a plan; its invoices are judged as overdue again.
+func (s *Service) CancelPlan(ctx context.Context, planID int) (*PlanDTO, error) {
+	plan, err := s.db.PaymentPlan.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.CanceledAt != nil {
+		return nil, errors.New("нельзя отменить план платежей: он уже отменён")
+	}
+	if err := plan.Update().
+		SetCanceledAt(time.Now()).
+		SetCanceledBy(receivedByFromContext(ctx).name).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetPlan(ctx, planID)
+}
+
+// GetPlan returns a plan with its instalment statuses as of today.
+func (s *Service) GetPlan(ctx context.Context, planID int) (*PlanDTO, error) {
+	plan, err := s.db.PaymentPlan.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	return s.planDTO(ctx, plan, time.Now())
+}
+
+// ListPlans returns plans, newest first. studentID 0 lists every student;
+// activeOnly leaves out canceled and completed plans.
+func (s *Service) ListPlans(ctx context.Context, studentID int, activeOnly bool) ([]PlanDTO, error) {
+	query := s.db.PaymentPlan.Query().Order(ent.Desc(paymentplan.FieldCreatedAt), ent.Desc(paymentplan.FieldID))
+	if studentID > 0 {
+		query = query.Where(paymentplan.StudentIDEQ(studentID))
+	}
+	if activeOnly {
+		query = query.Where(paymentplan.CanceledAtIsNil())
+	}
+	plans, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	out := make([]PlanDTO, 0, len(plans))
+	for _, plan := range plans {
+		dto, err := s.planDTO(ctx, plan, now)
+		if err != nil {
+			return nil, err
+		}
+		if activeOnly && dto.Status == PlanStatusCompleted {
+			continue
+		}
+		out = append(out, *dto)
+	}
+	return out, nil
+}
+
+// activePlansByInvoice returns the active (not canceled or completed) plan
+// of every invoice on one.
+func (s *Service) activePlansByInvoice(ctx context.Context, now time.Time) (map[int]*PlanDTO, error) {
+	plans, err := s.db.PaymentPlan.Query().
+		Where(paymentplan.CanceledAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]*PlanDTO)
+	for _, plan := range plans {
+		dto, err := s.planDTO(ctx, plan, now)
+		if err != nil {
+			return nil, err
+		}
+		if dto.Status == PlanStatusCompleted {
+			continue
+		}
+		for _, invoiceID := range dto.InvoiceIDs {
+			out[invoiceID] = dto
+		}
+	}
+	return out, nil
+}
+
+// KeptPlanInvoices returns the ids of the invoices on a payment plan that is
+// being kept. Such invoices are not treated as overdue.
+func (s *Service) KeptPlanInvoices(ctx context.Context, now time.Time) (map[int]bool, error) {
+	plans, err := s.activePlansByInvoice(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]bool, len(plans))
+	for invoiceID, plan := range plans {
+		if plan.Kept() {
+			out[invoiceID] = true
+		}
+	}
+	return out, nil
+}
+
+// planDTO works out a plan's progress. What was paid on its invoices since
+// the plan was agreed fills the instalments in due-date order; an
+// instalment not filled by the end of its due date is missed.
+func (s *Service) planDTO(ctx context.Context, plan *ent.PaymentPlan, now time.Time) (*PlanDTO, error) {
+	st, err := s.db.Student.Get(ctx, plan.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := plan.QueryInvoices().Order(ent.Asc(invoice.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instalments, err := plan.QueryInstalments().Order(ent.Asc(paymentplaninstalment.FieldSeq)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paidCents int64
+	invoiceIDs := make([]int, 0, len(invoices))
+	for _, iv := range invoices {
+		invoiceIDs = append(invoiceIDs, iv.ID)
+		_, paid, _, err := s.invoiceBalanceCents(ctx, iv)
+		if err != nil {
+			return nil, err
+		}
+		paidCents += min(paid, iv.TotalAmountCents)
+	}
+	paidCents -= plan.BaselinePaidCents
+	paidCents = max(min(paidCents, plan.TotalCents), 0)
+
+	dto := &PlanDTO{
+		ID:          plan.ID,
+		StudentID:   plan.StudentID,
+		StudentName: st.FullName,
+		InvoiceIDs:  invoiceIDs,
+		Total:       money.CentsToEuros(plan.TotalCents),
+		Paid:        money.CentsToEuros(paidCents),
+		Remaining:   money.CentsToEuros(plan.TotalCents - paidCents),
+		Status:      PlanStatusOnTrack,
+		Note:        plan.Note,
+		CreatedAt:   plan.CreatedAt.Format(time.RFC3339),
+		CreatedBy:   plan.CreatedBy,
+		CanceledBy:  plan.CanceledBy,
+		Instalments: make([]InstalmentDTO, 0, len(instalments)),
+	}
+	if plan.CanceledAt != nil {
+		canceledAt := plan.CanceledAt.Format(time.RFC3339)
+		dto.CanceledAt = &canceledAt
+	}
+
+	today := now.Format("2006-01-02")
+	left := paidCents
+	for _, item := range instalments {
+		covered := min(left, item.AmountCents)
+		left -= covered
+		dueOn := item.DueOn.Format("2006-01-02")
+		status := InstalmentStatusPending
+		switch {
+		case covered == item.AmountCents:
+			status = InstalmentStatusPaid
+		case dueOn < today:
+			status = InstalmentStatusMissed
+			dto.MissedInstalments++
+		case covered > 0:
+			status = InstalmentStatusPartiallyPaid
+		}
+		if status != InstalmentStatusPaid && dto.NextDueOn == nil {
+			dto.NextDueOn = &dueOn
+			dto.NextDueAmount = money.CentsToEuros(item.AmountCents - covered)
+		}
+		dto.Instalments = append(dto.Instalments, InstalmentDTO{
+			Seq:    item.Seq,
+			DueOn:  dueOn,
+			Amount: money.CentsToEuros(item.AmountCents),
+			Paid:   money.CentsToEuros(covered),
+			Status: status,
+		})
+	}
+
+	switch {
+	case plan.CanceledAt != nil:
+		dto.Status = PlanStatusCanceled
+	case paidCents >= plan.TotalCents:
+		dto.Status = PlanStatusCompleted
+	case dto.MissedInstalments > 0:
+		dto.Status = PlanStatusMissed
+	}
+	return dto, nil
+}
+
+// addMonthsClamped adds months keeping the day of month where it exists, so
+// a plan started on the 31st falls due on the last day of shorter months.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(t.Day(), lastDay), 0, 0, 0, 0, t.Location())
+}
+
+func uniqueInts(values []int) []int {
+	seen := make(map[int]struct{}, len(values))
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
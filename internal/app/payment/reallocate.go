@@ -0,0 +1,225 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"langschool/ent"
+	"langschool/ent/payment"
+	"langschool/internal/app"
+	"langschool/internal/money"
+)
+
+// ReallocationDTO is the result of moving a payment between invoices.
+type ReallocationDTO struct {
+	Moved       PaymentDTO         `json:"moved"`                 // Row now allocated to the target invoice
+	Remaining   *PaymentDTO        `json:"remaining,omitempty"`   // Part left where it was, if any
+	FromInvoice *InvoiceSummaryDTO `json:"fromInvoice,omitempty"` // Nil when the amount came from credit
+	ToInvoice   InvoiceSummaryDTO  `json:"toInvoice"`
+}
+
+// Reallocate moves amount (or the whole row when amount is 0) of a payment
+// to another invoice of the same student. Unallocated credit can be moved
+// onto an invoice the same way. Both invoices get their status recomputed.
+func (s *Service) Reallocate(ctx context.Context, paymentID, toInvoiceID int, amount float64) (*ReallocationDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.reallocateInStore(ctx, paymentID, toInvoiceID, amount)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).reallocateInStore(ctx, paymentID, toInvoiceID, amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) reallocateInStore(ctx context.Context, paymentID, toInvoiceID int, amount float64) (*ReallocationDTO, error) {
+	p, err := s.db.Payment.Get(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	switch p.Kind {
+	case payment.KindRefund, payment.KindTransferOut:
+		return nil, errors.New("нельзя перераспределить возврат или перенос кредита")
+	case payment.KindLessonCredit:
+		return nil, errors.New("нельзя перераспределить возврат неиспользованных занятий пакета")
+	case payment.KindTransferIn:
+		if p.InvoiceID != nil {
+			return nil, errors.New("нельзя перераспределить перенос кредита")
+		}
+	}
+	amountCents := p.AmountCents
+	if amount != 0 {
+		amountCents = money.EurosToCents(amount)
+	}
+	if amountCents <= 0 {
+		return nil, errors.New("сумма должна быть больше 0")
+	}
+	if amountCents > p.AmountCents {
+		return nil, fmt.Errorf("сумма не должна превышать сумму платежа %.2f", money.CentsToEuros(p.AmountCents))
+	}
+	if p.InvoiceID != nil && *p.InvoiceID == toInvoiceID {
+		return nil, errors.New("нельзя перенести платёж на тот же счёт")
+	}
+
+	target, err := s.db.Invoice.Get(ctx, toInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if target.StudentID != p.StudentID {
+		return nil, errors.New("счёт не принадлежит этому ученику")
+	}
+	if target.Status == app.InvoiceStatusDraft {
+		return nil, errors.New("нельзя привязать оплату к черновику счёта; сначала выставьте счёт")
+	}
+	if target.Status == app.InvoiceStatusCanceled {
+		return nil, errors.New("нельзя привязать оплату к отменённому счёту")
+	}
+	_, _, remaining, err := s.invoiceBalanceCents(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if remaining <= 0 {
+		return nil, errors.New("нельзя перенести оплату: счёт уже закрыт")
+	}
+	if amountCents > remaining {
+		return nil, errors.New("сумма оплаты не должна превышать остаток по счёту")
+	}
+
+	var moved, left *ent.Payment
+	if p.InvoiceID == nil {
+		moved, left, err = s.applyCreditRowInStore(ctx, p, toInvoiceID, amountCents)
+	} else {
+		moved, left, err = s.moveLinkedRowInStore(ctx, p, toInvoiceID, amountCents)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReallocationDTO{Moved: *toDTO(moved)}
+	if left != nil {
+		result.Remaining = toDTO(left)
+	}
+	if p.InvoiceID != nil {
+		if err := s.recomputeInvoiceStatus(ctx, *p.InvoiceID); err != nil {
+			return nil, err
+		}
+		from, err := s.InvoiceSummary(ctx, *p.InvoiceID)
+		if err != nil {
+			return nil, err
+		}
+		result.FromInvoice = from
+	} else {
+		credit, err := s.creditCents(ctx, p.StudentID)
+		if err != nil {
+			return nil, err
+		}
+		if credit < 0 {
+			return nil, errors.New("сумма превышает доступный кредит ученика: часть переплаты уже возвращена или перенесена")
+		}
+	}
+	if err := s.recomputeInvoiceStatus(ctx, toInvoiceID); err != nil {
+		return nil, err
+	}
+	to, err := s.InvoiceSummary(ctx, toInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	result.ToInvoice = *to
+	return result, nil
+}
+
+// moveLinkedRowInStore moves a row, or the given part of it, to another
+// invoice. The moved part keeps its kind, date, method and cash receipt.
+func (s *Service) moveLinkedRowInStore(ctx context.Context, p *ent.Payment, toInvoiceID int, amountCents int64) (*ent.Payment, *ent.Payment, error) {
+	if amountCents == p.AmountCents {
+		moved, err := p.Update().SetInvoiceID(toInvoiceID).Save(ctx)
+		return moved, nil, err
+	}
+	left, err := p.Update().SetAmountCents(p.AmountCents - amountCents).Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	moved, err := s.db.Payment.Create().
+		SetStudentID(p.StudentID).
+		SetInvoiceID(toInvoiceID).
+		SetAmountCents(amountCents).
+		SetMethod(p.Method).
+		SetPaidAt(p.PaidAt).
+		SetNote(p.Note).
+		SetNillableCashReceiptID(p.CashReceiptID).
+		SetKind(p.Kind).
+		SetNillableRelatedPaymentID(p.RelatedPaymentID).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return moved, left, nil
+}
+
+// applyCreditRowInStore applies part of an unallocated row to an invoice,
+// the same way ApplyCreditToOldestInvoices does. Refunded parts stay on the
+// row.
+func (s *Service) applyCreditRowInStore(ctx context.Context, p *ent.Payment, toInvoiceID int, amountCents int64) (*ent.Payment, *ent.Payment, error) {
+	refunds, err := s.db.Payment.Query().
+		Where(payment.KindEQ(payment.KindRefund), payment.RelatedPaymentIDEQ(p.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	free := p.AmountCents
+	for _, r := range refunds {
+		free += r.AmountCents
+	}
+	if amountCents > free {
+		return nil, nil, fmt.Errorf("сумма не должна превышать невозвращённую часть платежа %.2f", money.CentsToEuros(max(free, 0)))
+	}
+
+	note := p.Note
+	if note != "" {
+		note = fmt.Sprintf("%s (applied from credit)", note)
+	} else {
+		note = "Applied from student credit"
+	}
+	moved, err := s.db.Payment.Create().
+		SetStudentID(p.StudentID).
+		SetInvoiceID(toInvoiceID).
+		SetAmountCents(amountCents).
+		SetMethod(p.Method).
+		SetPaidAt(p.PaidAt).
+		SetNote(note).
+		SetNillableCashReceiptID(p.CashReceiptID).
+		SetKind(payment.KindCreditApplied).
+		SetNillableRelatedPaymentID(p.RelatedPaymentID).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if amountCents == p.AmountCents {
+		if err := s.db.Payment.DeleteOneID(p.ID).Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+		return moved, nil, nil
+	}
+	left, err := p.Update().SetAmountCents(p.AmountCents - amountCents).Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return moved, left, nil
+}
@@ -18,6 +18,8 @@ import (
 	"langschool/ent/payment"
 	"langschool/ent/student"
 	"langschool/internal/app"
+	"langschool/internal/app/cashdesk"
+	"langschool/internal/app/enrollperiod"
 	"langschool/internal/money"
 )
 
@@ -61,6 +63,10 @@ type PaymentDTO struct {
 	Method    string  `json:"method"`              // Payment method: "cash" or "bank"
 	Note      string  `json:"note"`                // Optional notes about the payment
 	CreatedAt string  `json:"createdAt"`           // Record creation date in RFC3339 format
+
+	CashReceiptID    *int   `json:"cashReceiptId,omitempty"`    // Receipt issued for a cash payment
+	Kind             string `json:"kind"`                       // payment, credit_applied, refund, transfer_in or transfer_out
+	RelatedPaymentID *int   `json:"relatedPaymentId,omitempty"` // Refunded payment or the other half of a transfer
 }
 
 // BalanceDTO represents a student's financial balance.
@@ -68,9 +74,13 @@ type BalanceDTO struct {
 	StudentID     int     `json:"studentId"`     // Student ID
 	StudentName   string  `json:"studentName"`   // Student's full name
 	TotalInvoiced float64 `json:"totalInvoiced"` // Total amount invoiced (issued + paid invoices)
-	TotalPaid     float64 `json:"totalPaid"`     // Total amount paid (all payments)
-	Balance       float64 `json:"balance"`       // Balance: paid - invoiced (negative => student owes)
+	TotalPaid     float64 `json:"totalPaid"`     // Total amount received (refunds and transfers excluded)
+	Refunded      float64 `json:"refunded"`      // Total paid back to the payer
+	Transferred   float64 `json:"transferred"`   // Net credit received from (positive) or moved to (negative) other students
+	LessonCredit  float64 `json:"lessonCredit"`  // Value of unused package lessons given back
+	Balance       float64 `json:"balance"`       // Balance: paid - refunded + transferred + lesson credit - invoiced (negative => student owes)
 	Debt          float64 `json:"debt"`          // Debt: max(0, -balance), amount student owes
+	Credit        float64 `json:"credit"`        // Credit not yet applied to invoices
 }
 
 // DebtorDTO represents a student with outstanding debt.
@@ -80,6 +90,15 @@ type DebtorDTO struct {
 	Debt          float64 `json:"debt"`          // Amount owed
 	TotalInvoiced float64 `json:"totalInvoiced"` // Total amount invoiced
 	TotalPaid     float64 `json:"totalPaid"`     // Total amount paid
+	Refunded      float64 `json:"refunded"`      // Total paid back
+	Credit        float64 `json:"credit"`        // Credit not yet applied, e.g. prepaid for invoices not issued yet
+
+	// Overdue is set when unpaid invoices from past months are not covered
+	// by a payment plan that is being kept, or a plan instalment is missed.
+	Overdue           bool   `json:"overdue"`
+	PaymentPlanID     *int   `json:"paymentPlanId,omitempty"`
+	PaymentPlanStatus string `json:"paymentPlanStatus,omitempty"` // on_track or missed
+	MissedInstalments int    `json:"missedInstalments"`
 }
 
 // InvoiceSummaryDTO represents payment summary for a specific invoice.
@@ -112,6 +131,8 @@ type MonthOverviewDTO struct {
 	ActiveStudents int `json:"activeStudents"`
 	ActiveCourses  int `json:"activeCourses"`
 	Enrollments    int `json:"enrollments"`
+	// Enrollments frozen for the whole month, not counted above.
+	PausedEnrollments int `json:"pausedEnrollments"`
 
 	PerLessonEnrollments       int `json:"perLessonEnrollments"`
 	AttendanceFilled           int `json:"attendanceFilled"`
@@ -132,6 +153,10 @@ type MonthOverviewDTO struct {
 	EmailedInvoices    int `json:"emailedInvoices"`
 	NotEmailedInvoices int `json:"notEmailedInvoices"`
 	OverdueInvoices    int `json:"overdueInvoicesCount"`
+	// Past-month invoices not counted as overdue because their payment plan
+	// is being kept, and instalments missed across all plans.
+	PaymentPlanInvoices int `json:"paymentPlanInvoicesCount"`
+	MissedInstalments   int `json:"missedInstalmentsCount"`
 
 	RequiredStepsTotal      int    `json:"requiredStepsTotal"`
 	RequiredStepsDone       int    `json:"requiredStepsDone"`
@@ -144,6 +169,7 @@ type MonthOverviewDTO struct {
 	PaymentsMonthTotal     float64 `json:"paymentsMonthTotal"`
 	PaymentsMonthCashTotal float64 `json:"paymentsMonthCashTotal"`
 	PaymentsMonthBankTotal float64 `json:"paymentsMonthBankTotal"`
+	RefundsMonthTotal      float64 `json:"refundsMonthTotal"`
 	UnlinkedCreditTotal    float64 `json:"unlinkedCreditTotal"`
 	MonthDebtTotal         float64 `json:"monthDebtTotal"`
 	HistoricalDebtTotal    float64 `json:"historicalDebtTotal"`
@@ -272,6 +298,11 @@ func (s *Service) createInStore(ctx context.Context, studentID int, invoiceID *i
 	if err != nil {
 		return nil, err
 	}
+	if method == app.PaymentMethodCash {
+		if _, err := s.issueCashReceiptInStore(ctx, studentID, amountCents, t, note, []*ent.Payment{p}); err != nil {
+			return nil, err
+		}
+	}
 
 	// Recompute invoice status if payment is linked to an invoice.
 	if invoiceID != nil {
@@ -301,7 +332,7 @@ func (s *Service) allocateToOldestInvoices(ctx context.Context, studentID int, a
 		return nil, err
 	}
 
-	var firstCreated *ent.Payment
+	var created []*ent.Payment
 
 	for _, iv := range invoices {
 		if remaining <= 0 {
@@ -333,9 +364,7 @@ func (s *Service) allocateToOldestInvoices(ctx context.Context, studentID int, a
 		if err != nil {
 			return nil, err
 		}
-		if firstCreated == nil {
-			firstCreated = p
-		}
+		created = append(created, p)
 
 		if err := s.recomputeInvoiceStatus(ctx, iv.ID); err != nil {
 			return nil, err
@@ -345,7 +374,7 @@ func (s *Service) allocateToOldestInvoices(ctx context.Context, studentID int, a
 	}
 
 	// If payment is larger than the current debt, keep the extra part as student credit.
-	if remaining > 0 || firstCreated == nil {
+	if remaining > 0 || len(created) == 0 {
 		creditAmount := remaining
 		if creditAmount <= 0 {
 			creditAmount = amountCents
@@ -360,12 +389,15 @@ func (s *Service) allocateToOldestInvoices(ctx context.Context, studentID int, a
 		if err != nil {
 			return nil, err
 		}
-		if firstCreated == nil {
-			firstCreated = p
-		}
+		created = append(created, p)
 	}
 
-	return toDTO(firstCreated), nil
+	if method == app.PaymentMethodCash {
+		if _, err := s.issueCashReceiptInStore(ctx, studentID, amountCents, paidAt, note, created); err != nil {
+			return nil, err
+		}
+	}
+	return toDTO(created[0]), nil
 }
 
 // ApplyCreditToOldestInvoices finds all unlinked payments (student credit) for
@@ -400,8 +432,9 @@ func (s *Service) ApplyCreditToOldestInvoices(ctx context.Context, studentID int
 }
 
 func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studentID int) error {
-	// Find all unlinked credit payments for this student, ordered oldest first.
-	credits, err := s.db.Payment.Query().
+	// Find all unlinked rows for this student, ordered oldest first. Refunds
+	// and outgoing transfers are negative and reduce the credit available.
+	rows, err := s.db.Payment.Query().
 		Where(
 			payment.StudentIDEQ(studentID),
 			payment.InvoiceIDIsNil(),
@@ -411,7 +444,26 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 	if err != nil {
 		return err
 	}
-	if len(credits) == 0 {
+	var available int64
+	refunded := map[int]int64{}
+	var credits []*ent.Payment
+	for _, row := range rows {
+		available += row.AmountCents
+		if row.Kind == payment.KindRefund && row.RelatedPaymentID != nil {
+			refunded[*row.RelatedPaymentID] -= row.AmountCents
+		}
+		if row.AmountCents > 0 {
+			credits = append(credits, row)
+		}
+	}
+	// Refunded parts stay on their payment so the refund keeps pointing at it.
+	free := func(cr *ent.Payment) int64 {
+		return cr.AmountCents - refunded[cr.ID]
+	}
+	for len(credits) > 0 && free(credits[0]) <= 0 {
+		credits = credits[1:]
+	}
+	if available <= 0 || len(credits) == 0 {
 		return nil
 	}
 
@@ -432,7 +484,7 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 	}
 
 	for _, iv := range invoices {
-		if len(credits) == 0 {
+		if len(credits) == 0 || available <= 0 {
 			break
 		}
 
@@ -445,12 +497,15 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 		}
 
 		toApply := invoiceRemaining
+		if available < toApply {
+			toApply = available
+		}
 
 		for len(credits) > 0 && toApply > 0 {
 			cr := credits[0]
 			creditRemaining := cr.AmountCents
 
-			applied := creditRemaining
+			applied := free(cr)
 			if toApply < applied {
 				applied = toApply
 			}
@@ -471,6 +526,9 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 				SetPaidAt(cr.PaidAt).
 				SetNote(note).
 				SetNillableInvoiceID(&invoiceID).
+				SetNillableCashReceiptID(cr.CashReceiptID).
+				SetKind(payment.KindCreditApplied).
+				SetNillableRelatedPaymentID(cr.RelatedPaymentID).
 				Save(ctx); err != nil {
 				return err
 			}
@@ -488,9 +546,13 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 					return err
 				}
 				credits[0] = updated
+				if free(updated) <= 0 {
+					credits = credits[1:]
+				}
 			}
 
 			toApply -= applied
+			available -= applied
 		}
 
 		if err := s.recomputeInvoiceStatus(ctx, iv.ID); err != nil {
@@ -503,7 +565,8 @@ func (s *Service) applyCreditToOldestInvoicesInStore(ctx context.Context, studen
 
 // Delete removes a payment record. If the payment was linked to an invoice,
 // the invoice status will be recomputed (e.g., from "paid" back to "issued"
-// if the invoice is no longer fully paid).
+// if the invoice is no longer fully paid). Deleting a cash payment voids its
+// receipt and removes every row the receipt covers.
 func (s *Service) Delete(ctx context.Context, paymentID int) error {
 	tx, err := s.db.Tx(ctx)
 	if err != nil {
@@ -535,6 +598,34 @@ func (s *Service) deleteInStore(ctx context.Context, paymentID int) error {
 	if err != nil {
 		return err
 	}
+	switch p.Kind {
+	case payment.KindRefund:
+		if p.RelatedPaymentID != nil {
+			source, err := s.db.Payment.Get(ctx, *p.RelatedPaymentID)
+			if err != nil && !ent.IsNotFound(err) {
+				return err
+			}
+			if source != nil && source.Kind == payment.KindLessonCredit {
+				return fmt.Errorf("нельзя удалить возврат %d неиспользованных занятий пакета", p.ID)
+			}
+		}
+		if err := cashdesk.RemoveRefund(ctx, s.db, p.ID); err != nil {
+			return err
+		}
+	case payment.KindTransferIn, payment.KindTransferOut:
+		return s.deleteTransferInStore(ctx, p)
+	case payment.KindLessonCredit:
+		return fmt.Errorf("нельзя удалить запись %d: это возврат неиспользованных занятий пакета", p.ID)
+	}
+	if p.CashReceiptID != nil {
+		if err := s.voidCashReceiptInStore(ctx, *p.CashReceiptID); err != nil {
+			return err
+		}
+		return s.ensureCreditNotNegative(ctx, p.StudentID, paymentID)
+	}
+	if err := s.ensureNotRefunded(ctx, paymentID); err != nil {
+		return err
+	}
 	var invID *int
 	if p.InvoiceID != nil {
 		id := *p.InvoiceID
@@ -546,7 +637,7 @@ func (s *Service) deleteInStore(ctx context.Context, paymentID int) error {
 	if invID != nil {
 		return s.recomputeInvoiceStatus(ctx, *invID)
 	}
-	return nil
+	return s.ensureCreditNotNegative(ctx, p.StudentID, paymentID)
 }
 
 // ListForStudent returns all payments for a specific student,
@@ -691,17 +782,31 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 		Where(
 			enrollment.HasStudentWith(student.IsActiveEQ(true)),
 			enrollment.HasCourseWith(course.IsActiveEQ(true)),
+			enrollperiod.ActiveIn(year, month),
 		).
 		Count(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	pausedEnrollments, err := s.db.Enrollment.Query().
+		Where(
+			enrollment.HasStudentWith(student.IsActiveEQ(true)),
+			enrollment.HasCourseWith(course.IsActiveEQ(true)),
+			enrollperiod.PausedIn(year, month),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Package lessons are drawn down from attendance like per-lesson ones.
 	perLessonEnrollments, err := s.db.Enrollment.Query().
 		Where(
-			enrollment.BillingModeEQ(enrollment.BillingModePerLesson),
+			enrollment.BillingModeIn(enrollment.BillingModePerLesson, enrollment.BillingModePackage),
 			enrollment.HasStudentWith(student.IsActiveEQ(true)),
 			enrollment.HasCourseWith(course.IsActiveEQ(true)),
+			enrollperiod.ActiveIn(year, month),
 		).
 		All(ctx)
 	if err != nil {
@@ -718,6 +823,7 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 			enrollment.BillingModeEQ(enrollment.BillingModeSubscription),
 			enrollment.HasStudentWith(student.IsActiveEQ(true)),
 			enrollment.HasCourseWith(course.IsActiveEQ(true)),
+			enrollperiod.ActiveIn(year, month),
 		).
 		All(ctx)
 	if err != nil {
@@ -892,8 +998,23 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 		return nil, err
 	}
 
+	plansByInvoice, err := s.activePlansByInvoice(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	missedInstalments := 0
+	seenPlans := make(map[int]struct{})
+	for _, plan := range plansByInvoice {
+		if _, ok := seenPlans[plan.ID]; ok {
+			continue
+		}
+		seenPlans[plan.ID] = struct{}{}
+		missedInstalments += plan.MissedInstalments
+	}
+
 	var historicalDebtCents int64
 	overdueInvoices := 0
+	paymentPlanInvoices := 0
 	for _, iv := range historicalInvoices {
 		if !isBeforePeriod(iv.PeriodYear, iv.PeriodMonth, year, month) {
 			continue
@@ -906,8 +1027,12 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 			continue
 		}
 		historicalDebtCents += remaining
-		overdueInvoices++
 		debtStudentIDs[iv.StudentID] = struct{}{}
+		if plan := plansByInvoice[iv.ID]; plan != nil && plan.Kept() {
+			paymentPlanInvoices++
+			continue
+		}
+		overdueInvoices++
 	}
 
 	rangeStart, rangeEnd := monthBounds(year, month)
@@ -925,7 +1050,15 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 	var paymentsMonthCents int64
 	var paymentsMonthCashCents int64
 	var paymentsMonthBankCents int64
+	var refundsMonthCents int64
 	for _, p := range monthPayments {
+		if p.Kind == payment.KindRefund {
+			refundsMonthCents -= p.AmountCents
+			continue
+		}
+		if !IsReceived(p) {
+			continue
+		}
 		paymentsMonthCents += p.AmountCents
 		switch p.Method {
 		case payment.MethodCash:
@@ -984,9 +1117,10 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 		Year:  year,
 		Month: month,
 
-		ActiveStudents: activeStudents,
-		ActiveCourses:  activeCourses,
-		Enrollments:    totalEnrollments,
+		ActiveStudents:    activeStudents,
+		ActiveCourses:     activeCourses,
+		Enrollments:       totalEnrollments,
+		PausedEnrollments: pausedEnrollments,
 
 		PerLessonEnrollments:       len(perLessonEnrollments),
 		AttendanceFilled:           attendanceFilled,
@@ -1007,6 +1141,8 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 		EmailedInvoices:         emailedInvoices,
 		NotEmailedInvoices:      notEmailedInvoices,
 		OverdueInvoices:         overdueInvoices,
+		PaymentPlanInvoices:     paymentPlanInvoices,
+		MissedInstalments:       missedInstalments,
 		RequiredStepsTotal:      requiredStepsTotal,
 		RequiredStepsDone:       requiredStepsDone,
 		MonthClosingProgressPct: monthClosingProgressPct,
@@ -1018,6 +1154,7 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 		PaymentsMonthTotal:     money.CentsToEuros(paymentsMonthCents),
 		PaymentsMonthCashTotal: money.CentsToEuros(paymentsMonthCashCents),
 		PaymentsMonthBankTotal: money.CentsToEuros(paymentsMonthBankCents),
+		RefundsMonthTotal:      money.CentsToEuros(refundsMonthCents),
 		UnlinkedCreditTotal:    money.CentsToEuros(unlinkedCreditCents),
 		MonthDebtTotal:         money.CentsToEuros(monthDebtCents),
 		HistoricalDebtTotal:    money.CentsToEuros(historicalDebtCents),
@@ -1029,9 +1166,9 @@ func (s *Service) MonthOverview(ctx context.Context, year, month int) (*MonthOve
 }
 
 // StudentBalance calculates the financial balance for a student, including
-// total invoiced amount (from issued and paid invoices), total paid amount
-// (all payments), current balance, and debt (if any). The balance is calculated
-// as paid - invoiced, so a negative balance means the student owes money.
+// total invoiced amount (from issued and paid invoices), total paid amount,
+// refunds, credit transfers, current balance, debt (if any) and unapplied
+// credit. A negative balance means the student owes money.
 func (s *Service) StudentBalance(ctx context.Context, studentID int) (*BalanceDTO, error) {
 	st, err := s.db.Student.Get(ctx, studentID)
 	if err != nil {
@@ -1042,14 +1179,14 @@ func (s *Service) StudentBalance(ctx context.Context, studentID int) (*BalanceDT
 	if err != nil {
 		return nil, err
 	}
-	paidCents, err := s.sumPaymentsForStudentCents(ctx, studentID)
+	totals, err := s.paymentTotalsForStudent(ctx, studentID)
 	if err != nil {
 		return nil, err
 	}
 
 	invoiced := money.CentsToEuros(invoicedCents)
-	paid := money.CentsToEuros(paidCents)
-	balanceCents := paidCents - invoicedCents
+	paid := money.CentsToEuros(totals.receivedCents)
+	balanceCents := totals.receivedCents - totals.refundedCents + totals.transferredCents + totals.lessonCredit - invoicedCents
 	bal := money.CentsToEuros(balanceCents)
 	debt := 0.0
 	if balanceCents < 0 {
@@ -1061,15 +1198,20 @@ func (s *Service) StudentBalance(ctx context.Context, studentID int) (*BalanceDT
 		StudentName:   st.FullName,
 		TotalInvoiced: invoiced,
 		TotalPaid:     paid,
+		Refunded:      money.CentsToEuros(totals.refundedCents),
+		Transferred:   money.CentsToEuros(totals.transferredCents),
+		LessonCredit:  money.CentsToEuros(totals.lessonCredit),
 		Balance:       bal,
 		Debt:          debt,
+		Credit:        money.CentsToEuros(totals.creditCents),
 	}, nil
 }
 
 // ListDebtors returns a list of all active students who have outstanding debt,
 // sorted by debt amount (highest first). Only students with debt greater than
 // the epsilon threshold (0.009) are included to account for floating-point
-// rounding errors.
+// rounding errors. A debtor is overdue when invoices from past months are
+// unpaid and not covered by a payment plan that is being kept.
 func (s *Service) ListDebtors(ctx context.Context) ([]DebtorDTO, error) {
 	studs, err := s.db.Student.Query().
 		Where(student.IsActiveEQ(true)).
@@ -1077,6 +1219,11 @@ func (s *Service) ListDebtors(ctx context.Context) ([]DebtorDTO, error) {
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	plansByInvoice, err := s.activePlansByInvoice(ctx, now)
+	if err != nil {
+		return nil, err
+	}
 	out := make([]DebtorDTO, 0, len(studs))
 	for _, st := range studs {
 		b, err := s.StudentBalance(ctx, st.ID)
@@ -1085,10 +1232,15 @@ func (s *Service) ListDebtors(ctx context.Context) ([]DebtorDTO, error) {
 			continue
 		}
 		if b.Debt > 0 {
-			out = append(out, DebtorDTO{
+			item := DebtorDTO{
 				StudentID: st.ID, StudentName: st.FullName,
 				Debt: b.Debt, TotalInvoiced: b.TotalInvoiced, TotalPaid: b.TotalPaid,
-			})
+				Refunded: b.Refunded, Credit: b.Credit,
+			}
+			if err := s.fillDebtorOverdue(ctx, &item, plansByInvoice, now); err != nil {
+				return nil, err
+			}
+			out = append(out, item)
 		}
 	}
 	// Sort by debt descending.
@@ -1098,10 +1250,77 @@ func (s *Service) ListDebtors(ctx context.Context) ([]DebtorDTO, error) {
 	return out, nil
 }
 
+func (s *Service) fillDebtorOverdue(ctx context.Context, item *DebtorDTO, plansByInvoice map[int]*PlanDTO, now time.Time) error {
+	invoices, err := s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDEQ(item.StudentID),
+			invoice.StatusIn(openInvoiceStatuses()...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	seenPlans := make(map[int]struct{})
+	for _, iv := range invoices {
+		if plan := plansByInvoice[iv.ID]; plan != nil {
+			if _, ok := seenPlans[plan.ID]; !ok {
+				seenPlans[plan.ID] = struct{}{}
+				item.PaymentPlanID = &plan.ID
+				item.PaymentPlanStatus = plan.Status
+				item.MissedInstalments += plan.MissedInstalments
+				if plan.Status == PlanStatusMissed {
+					item.Overdue = true
+				}
+			}
+			if plan.Kept() {
+				continue
+			}
+		}
+		if !isBeforePeriod(iv.PeriodYear, iv.PeriodMonth, now.Year(), int(now.Month())) {
+			continue
+		}
+		_, _, remaining, err := s.invoiceBalanceCents(ctx, iv)
+		if err != nil {
+			return err
+		}
+		if remaining > 0 {
+			item.Overdue = true
+		}
+	}
+	return nil
+}
+
 // QuickCash creates an unlinked cash payment for "lesson now".
 // If you want exact price-per-student later, we can resolve it via Enrollment/Course and overrides.
 // For this session we accept a direct amount from UI, but this helper exists for convenience.
 func (s *Service) QuickCash(ctx context.Context, studentID int, amount float64, note string) (*PaymentDTO, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return s.quickCashInStore(ctx, studentID, amount, note)
+		}
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	dto, err := (&Service{db: tx.Client()}).quickCashInStore(ctx, studentID, amount, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	return dto, nil
+}
+
+func (s *Service) quickCashInStore(ctx context.Context, studentID int, amount float64, note string) (*PaymentDTO, error) {
 	t := time.Now()
 	if amount <= 0 {
 		return nil, errors.New("сумма должна быть больше 0")
@@ -1120,6 +1339,9 @@ func (s *Service) QuickCash(ctx context.Context, studentID int, amount float64,
 	if err != nil {
 		return nil, err
 	}
+	if _, err := s.issueCashReceiptInStore(ctx, studentID, p.AmountCents, t, note, []*ent.Payment{p}); err != nil {
+		return nil, err
+	}
 	return toDTO(p), nil
 }
 
@@ -1204,20 +1426,46 @@ func (s *Service) sumPaymentsForInvoiceCents(ctx context.Context, invoiceID int)
 	return sum, nil
 }
 
-// sumPaymentsForStudent calculates the total amount of all payments
-// made by a specific student (both linked and unlinked to invoices).
-func (s *Service) sumPaymentsForStudentCents(ctx context.Context, studentID int) (int64, error) {
+type paymentTotals struct {
+	receivedCents    int64 // money paid in, including the parts applied from credit
+	refundedCents    int64 // money paid back, positive
+	transferredCents int64 // net credit moved in from other students
+	lessonCredit     int64 // value of unused package lessons given back
+	creditCents      int64 // unallocated rows, net of refunds and transfers
+}
+
+// IsReceived reports whether a row is money the school received, as opposed
+// to a refund or credit moved between students.
+func IsReceived(p *ent.Payment) bool {
+	return p.Kind == payment.KindPayment || (p.Kind == payment.KindCreditApplied && p.RelatedPaymentID == nil)
+}
+
+// paymentTotalsForStudent splits the payment rows of a student into money
+// received, refunds and transfers.
+func (s *Service) paymentTotalsForStudent(ctx context.Context, studentID int) (paymentTotals, error) {
 	ps, err := s.db.Payment.Query().
 		Where(payment.StudentIDEQ(studentID)).
 		All(ctx)
 	if err != nil {
-		return 0, err
+		return paymentTotals{}, err
 	}
-	var sum int64
+	var totals paymentTotals
 	for _, p := range ps {
-		sum += p.AmountCents
+		switch {
+		case IsReceived(p):
+			totals.receivedCents += p.AmountCents
+		case p.Kind == payment.KindRefund:
+			totals.refundedCents -= p.AmountCents
+		case p.Kind == payment.KindLessonCredit:
+			totals.lessonCredit += p.AmountCents
+		default:
+			totals.transferredCents += p.AmountCents
+		}
+		if p.InvoiceID == nil {
+			totals.creditCents += p.AmountCents
+		}
 	}
-	return sum, nil
+	return totals, nil
 }
 
 // sumInvoicesForStudent calculates the total amount of all financially active
@@ -1255,6 +1503,10 @@ func toDTO(p *ent.Payment) *PaymentDTO {
 		Method:    string(p.Method),
 		Note:      p.Note,
 		CreatedAt: p.CreatedAt.Format(time.RFC3339),
+
+		CashReceiptID:    p.CashReceiptID,
+		Kind:             string(p.Kind),
+		RelatedPaymentID: p.RelatedPaymentID,
 	}
 }
 
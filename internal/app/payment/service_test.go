@@ -16,6 +16,7 @@ import (
 	entinvoice "langschool/ent/invoice"
 	entpayment "langschool/ent/payment"
 	"langschool/internal/app"
+	"langschool/internal/app/reference"
 	"langschool/internal/money"
 )
 
@@ -880,6 +881,290 @@ func TestMonthOverviewEmailProgressDoesNotBlockReadyToClose(t *testing.T) {
 	assertEqual(t, got.MonthReadyToClose, true)
 }
 
+func TestMatchBankRemittancePrefersCreditorReference(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	first := createTestStudent(t, ctx, client, "Reference Payer")
+	second := createTestStudent(t, ctx, client, "Number Payer")
+	byReference := createTestInvoiceWithNumber(t, ctx, client, first.ID, 2026, 3, 40, app.InvoiceStatusIssued, "LS-202603-001")
+	byNumber := createTestInvoiceWithNumber(t, ctx, client, second.ID, 2026, 3, 25, app.InvoiceStatusIssued, "LS-202603-002")
+
+	// The payer mistyped the invoice number but copied the reference correctly.
+	match, err := svc.MatchBankRemittance(ctx, "Par LS-202603-002 "+reference.Format(reference.ForInvoice(byReference.ID)))
+	if err != nil {
+		t.Fatalf("match by reference: %v", err)
+	}
+	assertEqual(t, match.MatchedBy, BankMatchByReference)
+	if match.InvoiceID == nil || *match.InvoiceID != byReference.ID {
+		t.Fatalf("matched invoice = %v, want %d", match.InvoiceID, byReference.ID)
+	}
+	assertFloatEqual(t, match.Remaining, 40)
+
+	match, err = svc.MatchBankRemittance(ctx, "rekins ls 202603/002")
+	if err != nil {
+		t.Fatalf("match by number: %v", err)
+	}
+	assertEqual(t, match.MatchedBy, BankMatchByInvoiceNumber)
+	if match.InvoiceID == nil || *match.InvoiceID != byNumber.ID {
+		t.Fatalf("matched invoice = %v, want %d", match.InvoiceID, byNumber.ID)
+	}
+
+	match, err = svc.MatchBankRemittance(ctx, "RF00 0000 0001 mācību maksa")
+	if err != nil {
+		t.Fatalf("match unknown: %v", err)
+	}
+	if match.InvoiceID != nil || match.MatchedBy != "" {
+		t.Fatalf("unexpected match for invalid reference: %+v", match)
+	}
+}
+
+func TestRecordBankPaymentLinksInvoiceAndKeepsSurplusAsCredit(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	st := createTestStudent(t, ctx, client, "Bank Payer")
+	inv := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 4, 50, app.InvoiceStatusIssued, "LS-202604-001")
+
+	dto, err := svc.RecordBankPayment(ctx, reference.ForInvoice(inv.ID), 60, "2026-04-10", "")
+	if err != nil {
+		t.Fatalf("record bank payment: %v", err)
+	}
+	if dto.InvoiceID == nil || *dto.InvoiceID != inv.ID {
+		t.Fatalf("payment invoice = %v, want %d", dto.InvoiceID, inv.ID)
+	}
+	assertEqual(t, dto.Method, app.PaymentMethodBank)
+	assertFloatEqual(t, dto.Amount, 50)
+
+	summary, err := svc.InvoiceSummary(ctx, inv.ID)
+	if err != nil {
+		t.Fatalf("invoice summary: %v", err)
+	}
+	assertEqual(t, summary.Status, app.InvoiceStatusPaid)
+
+	credit, err := client.Payment.Query().
+		Where(entpayment.StudentIDEQ(st.ID), entpayment.InvoiceIDIsNil()).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("query credit: %v", err)
+	}
+	assertEqual(t, credit.AmountCents, int64(1000))
+
+	if _, err := svc.RecordBankPayment(ctx, "no reference here", 10, "2026-04-10", ""); !errors.Is(err, ErrBankPaymentUnmatched) {
+		t.Fatalf("unmatched error = %v, want ErrBankPaymentUnmatched", err)
+	}
+}
+
+func TestCashPaymentIssuesReceiptAndDeleteVoidsIt(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	st := createTestStudent(t, ctx, client, "Kira Lebedeva")
+	older := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 2, 30, app.InvoiceStatusIssued, "LS-202602-001")
+	newer := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 3, 40, app.InvoiceStatusIssued, "LS-202603-001")
+
+	cashierID := 7
+	cashCtx := WithReceivedBy(ctx, &cashierID, "cashier@example.com")
+	created, err := svc.Create(cashCtx, st.ID, nil, 80, app.PaymentMethodCash, "2026-03-20", "cash at desk")
+	if err != nil {
+		t.Fatalf("create cash payment: %v", err)
+	}
+	if created.CashReceiptID == nil {
+		t.Fatal("expected a cash receipt for the payment")
+	}
+	receipt, err := svc.GetCashReceipt(ctx, *created.CashReceiptID)
+	if err != nil {
+		t.Fatalf("get receipt: %v", err)
+	}
+	assertEqual(t, receipt.Number, "KI-000001")
+	assertEqual(t, receipt.ReceivedBy, "cashier@example.com")
+	assertFloatEqual(t, receipt.Amount, 80)
+	assertFloatEqual(t, receipt.Credit, 10)
+	if len(receipt.Allocations) != 2 || receipt.Allocations[0].InvoiceID != older.ID || receipt.Allocations[1].InvoiceID != newer.ID {
+		t.Fatalf("allocations = %+v", receipt.Allocations)
+	}
+	assertFloatEqual(t, receipt.Allocations[1].Amount, 40)
+
+	bank, err := svc.Create(ctx, st.ID, nil, 5, app.PaymentMethodBank, "2026-03-21", "")
+	if err != nil {
+		t.Fatalf("create bank payment: %v", err)
+	}
+	if bank.CashReceiptID != nil {
+		t.Fatal("bank payments must not get a cash receipt")
+	}
+	quick, err := svc.QuickCash(cashCtx, st.ID, 15, "lesson now")
+	if err != nil {
+		t.Fatalf("quick cash: %v", err)
+	}
+	quickReceipt, err := svc.GetCashReceipt(ctx, *quick.CashReceiptID)
+	if err != nil {
+		t.Fatalf("get quick cash receipt: %v", err)
+	}
+	assertEqual(t, quickReceipt.Number, "KI-000002")
+
+	// Credit applied to a later invoice stays on the receipt it came from.
+	later := createTestInvoice(t, ctx, client, st.ID, 2026, 4, 8, app.InvoiceStatusIssued)
+	if err := svc.ApplyCreditToOldestInvoices(ctx, st.ID); err != nil {
+		t.Fatalf("apply credit: %v", err)
+	}
+	receipt, err = svc.GetCashReceipt(ctx, *created.CashReceiptID)
+	if err != nil {
+		t.Fatalf("get receipt after credit: %v", err)
+	}
+	if len(receipt.Allocations) != 3 || receipt.Allocations[2].InvoiceID != later.ID {
+		t.Fatalf("allocations after credit = %+v", receipt.Allocations)
+	}
+
+	// Deleting any part of the cash payment voids the whole receipt.
+	if err := svc.Delete(cashCtx, created.ID); err != nil {
+		t.Fatalf("delete cash payment: %v", err)
+	}
+	receipt, err = svc.GetCashReceipt(ctx, *created.CashReceiptID)
+	if err != nil {
+		t.Fatalf("get voided receipt: %v", err)
+	}
+	assertEqual(t, receipt.Status, "voided")
+	assertEqual(t, receipt.VoidedBy, "cashier@example.com")
+	left, err := client.Payment.Query().Where(entpayment.CashReceiptIDEQ(receipt.ID)).Count(ctx)
+	if err != nil {
+		t.Fatalf("count receipt payments: %v", err)
+	}
+	assertEqual(t, left, 0)
+	for _, id := range []int{older.ID, newer.ID} {
+		iv, err := client.Invoice.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("reload invoice: %v", err)
+		}
+		assertEqual(t, string(iv.Status), app.InvoiceStatusIssued)
+	}
+
+	receipts, err := svc.ListCashReceipts(ctx, st.ID)
+	if err != nil {
+		t.Fatalf("list receipts: %v", err)
+	}
+	if len(receipts) != 2 || receipts[0].Number != "KI-000002" {
+		t.Fatalf("receipts = %+v", receipts)
+	}
+}
+
+func TestRefundsAndCreditTransfersKeepLedgerBalanced(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	anna := createTestStudent(t, ctx, client, "Anna Sister")
+	boris := createTestStudent(t, ctx, client, "Boris Brother")
+	createTestInvoiceWithNumber(t, ctx, client, anna.ID, 2026, 1, 30, app.InvoiceStatusIssued, "LS-202601-001")
+	borisInvoice := createTestInvoiceWithNumber(t, ctx, client, boris.ID, 2026, 1, 40, app.InvoiceStatusIssued, "LS-202601-002")
+
+	if _, err := svc.Create(ctx, anna.ID, nil, 100, app.PaymentMethodBank, "2026-01-10", "overpaid"); err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+	credit, err := client.Payment.Query().
+		Where(entpayment.StudentIDEQ(anna.ID), entpayment.InvoiceIDIsNil()).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("credit row: %v", err)
+	}
+	linked, err := client.Payment.Query().
+		Where(entpayment.StudentIDEQ(anna.ID), entpayment.InvoiceIDNotNil()).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("linked row: %v", err)
+	}
+
+	if _, err := svc.Refund(ctx, credit.ID, 80, app.PaymentMethodBank, "2026-01-12", ""); err == nil {
+		t.Fatal("expected error refunding more than the credit")
+	}
+	if _, err := svc.Refund(ctx, linked.ID, 5, app.PaymentMethodBank, "2026-01-12", ""); err == nil {
+		t.Fatal("expected error refunding a payment applied to an invoice")
+	}
+	refund, err := svc.Refund(ctx, credit.ID, 20, app.PaymentMethodBank, "2026-01-12", "paid back")
+	if err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+	assertEqual(t, refund.Kind, "refund")
+	assertFloatEqual(t, refund.Amount, -20)
+
+	balance, err := svc.StudentBalance(ctx, anna.ID)
+	if err != nil {
+		t.Fatalf("balance: %v", err)
+	}
+	assertFloatEqual(t, balance.TotalPaid, 100)
+	assertFloatEqual(t, balance.Refunded, 20)
+	assertFloatEqual(t, balance.Credit, 50)
+	assertFloatEqual(t, balance.Balance, 50)
+
+	if _, err := svc.TransferCredit(ctx, anna.ID, boris.ID, 60, ""); err == nil {
+		t.Fatal("expected error transferring more than the credit")
+	}
+	transfer, err := svc.TransferCredit(ctx, anna.ID, boris.ID, 45, "for Boris")
+	if err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+	iv, err := client.Invoice.Get(ctx, borisInvoice.ID)
+	if err != nil {
+		t.Fatalf("reload invoice: %v", err)
+	}
+	assertEqual(t, string(iv.Status), app.InvoiceStatusPaid)
+	if err := svc.Delete(ctx, transfer.Out.ID); err == nil {
+		t.Fatal("expected error deleting a transfer whose credit was used")
+	}
+	if err := svc.Delete(ctx, credit.ID); err == nil {
+		t.Fatal("expected error deleting a refunded payment")
+	}
+
+	borisLedger, err := svc.CreditLedger(ctx, boris.ID, CreditScopeStudent)
+	if err != nil {
+		t.Fatalf("boris ledger: %v", err)
+	}
+	assertFloatEqual(t, borisLedger.Credit, 5)
+	assertFloatEqual(t, borisLedger.Transferred, 45)
+	if len(borisLedger.Entries) != 3 || borisLedger.Entries[0].Kind != CreditEntryTransferIn || borisLedger.Entries[0].CounterpartName != "Anna Sister" {
+		t.Fatalf("boris ledger entries = %+v", borisLedger.Entries)
+	}
+
+	// The refund keeps its payment while the rest of the credit is used.
+	createTestInvoiceWithNumber(t, ctx, client, anna.ID, 2026, 2, 60, app.InvoiceStatusIssued, "LS-202602-001")
+	if err := svc.ApplyCreditToOldestInvoices(ctx, anna.ID); err != nil {
+		t.Fatalf("apply credit: %v", err)
+	}
+	credit, err = client.Payment.Get(ctx, credit.ID)
+	if err != nil {
+		t.Fatalf("refunded payment must survive applying credit: %v", err)
+	}
+	assertFloatEqual(t, money.CentsToEuros(credit.AmountCents), 65)
+
+	annaLedger, err := svc.CreditLedger(ctx, anna.ID, CreditScopePayer)
+	if err != nil {
+		t.Fatalf("anna ledger: %v", err)
+	}
+	assertFloatEqual(t, annaLedger.Refunded, 20)
+	if len(annaLedger.StudentIDs) != 1 {
+		t.Fatalf("payer scope students = %v", annaLedger.StudentIDs)
+	}
+	last := annaLedger.Entries[len(annaLedger.Entries)-1]
+	assertFloatEqual(t, last.Balance, 0)
+	assertFloatEqual(t, annaLedger.Credit, 0)
+
+	if err := svc.Delete(ctx, refund.ID); err != nil {
+		t.Fatalf("delete refund: %v", err)
+	}
+	balance, err = svc.StudentBalance(ctx, anna.ID)
+	if err != nil {
+		t.Fatalf("balance after refund delete: %v", err)
+	}
+	assertFloatEqual(t, balance.Credit, 20)
+	assertFloatEqual(t, balance.Refunded, 0)
+}
+
 func newTestClient(t *testing.T) *ent.Client {
 	t.Helper()
 	return enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
@@ -1063,3 +1348,205 @@ func assertStringPtrEqual(t *testing.T, got *string, want string) {
 		t.Fatalf("got %q, want %q", *got, want)
 	}
 }
+
+func TestReallocateMovesPaymentBetweenInvoices(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	st := createTestStudent(t, ctx, client, "Realloc Student")
+	other := createTestStudent(t, ctx, client, "Other Student")
+	jan := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 1, 50, app.InvoiceStatusIssued, "LS-202601-010")
+	feb := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 2, 30, app.InvoiceStatusIssued, "LS-202602-010")
+	foreign := createTestInvoiceWithNumber(t, ctx, client, other.ID, 2026, 1, 30, app.InvoiceStatusIssued, "LS-202601-011")
+
+	janID := jan.ID
+	paid, err := svc.Create(ctx, st.ID, &janID, 50, app.PaymentMethodCash, "2026-01-10", "january")
+	if err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+
+	if _, err := svc.Reallocate(ctx, paid.ID, jan.ID, 10); err == nil {
+		t.Fatal("expected error moving a payment to its own invoice")
+	}
+	if _, err := svc.Reallocate(ctx, paid.ID, foreign.ID, 10); err == nil {
+		t.Fatal("expected error moving a payment to another student's invoice")
+	}
+	if _, err := svc.Reallocate(ctx, paid.ID, feb.ID, 40); err == nil {
+		t.Fatal("expected error moving more than the target invoice remaining")
+	}
+
+	result, err := svc.Reallocate(ctx, paid.ID, feb.ID, 20)
+	if err != nil {
+		t.Fatalf("reallocate: %v", err)
+	}
+	assertFloatEqual(t, result.Moved.Amount, 20)
+	assertEqual(t, result.Moved.Method, app.PaymentMethodCash)
+	if result.Moved.InvoiceID == nil || *result.Moved.InvoiceID != feb.ID {
+		t.Fatalf("moved invoice = %v, want %d", result.Moved.InvoiceID, feb.ID)
+	}
+	if result.Remaining == nil || result.Remaining.ID != paid.ID {
+		t.Fatalf("remaining = %+v, want original row", result.Remaining)
+	}
+	assertFloatEqual(t, result.Remaining.Amount, 30)
+	if result.FromInvoice == nil {
+		t.Fatal("expected source invoice summary")
+	}
+	assertFloatEqual(t, result.FromInvoice.Remaining, 20)
+	assertEqual(t, result.FromInvoice.Status, app.InvoiceStatusIssued)
+	assertFloatEqual(t, result.ToInvoice.Remaining, 10)
+
+	result, err = svc.Reallocate(ctx, result.Moved.ID, jan.ID, 0)
+	if err != nil {
+		t.Fatalf("move back: %v", err)
+	}
+	if result.Remaining != nil {
+		t.Fatalf("remaining after full move = %+v, want nil", result.Remaining)
+	}
+	assertFloatEqual(t, result.ToInvoice.Remaining, 0)
+	assertEqual(t, result.ToInvoice.Status, app.InvoiceStatusPaid)
+	assertFloatEqual(t, result.FromInvoice.Paid, 0)
+
+	balance, err := svc.StudentBalance(ctx, st.ID)
+	if err != nil {
+		t.Fatalf("balance: %v", err)
+	}
+	assertFloatEqual(t, balance.TotalPaid, 50)
+	assertFloatEqual(t, balance.Credit, 0)
+
+	// 30 goes to February, 15 stays as credit.
+	if _, err := svc.Create(ctx, st.ID, nil, 45, app.PaymentMethodBank, "2026-02-10", "advance"); err != nil {
+		t.Fatalf("create credit: %v", err)
+	}
+	credit, err := client.Payment.Query().
+		Where(entpayment.StudentIDEQ(st.ID), entpayment.InvoiceIDIsNil()).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("credit row: %v", err)
+	}
+	assertEqual(t, credit.AmountCents, int64(1500))
+	mar := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2026, 3, 40, app.InvoiceStatusIssued, "LS-202603-010")
+	result, err = svc.Reallocate(ctx, credit.ID, mar.ID, 10)
+	if err != nil {
+		t.Fatalf("reallocate credit: %v", err)
+	}
+	assertEqual(t, result.Moved.Kind, "credit_applied")
+	if result.FromInvoice != nil {
+		t.Fatalf("from invoice = %+v, want nil for credit", result.FromInvoice)
+	}
+	assertFloatEqual(t, result.Remaining.Amount, 5)
+	assertFloatEqual(t, result.ToInvoice.Remaining, 30)
+}
+
+func TestPaymentPlanKeepsDebtorFromBeingOverdue(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	defer client.Close()
+
+	svc := New(client)
+	st := createTestStudent(t, ctx, client, "Plan Student")
+	jan := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2025, 1, 100, app.InvoiceStatusIssued, "LS-202501-020")
+	feb := createTestInvoiceWithNumber(t, ctx, client, st.ID, 2025, 2, 50, app.InvoiceStatusIssued, "LS-202502-020")
+	janID := jan.ID
+	if _, err := svc.Create(ctx, st.ID, &janID, 20, app.PaymentMethodBank, "2025-01-20", "before plan"); err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+
+	today := time.Now()
+	day := func(offset int) string { return today.AddDate(0, 0, offset).Format("2006-01-02") }
+	if _, err := svc.CreatePlan(ctx, PlanInput{
+		StudentID:   st.ID,
+		InvoiceIDs:  []int{jan.ID, feb.ID},
+		Instalments: []InstalmentInput{{DueOn: day(-10), Amount: 50}, {DueOn: day(20), Amount: 70}},
+	}); err == nil {
+		t.Fatal("expected error when instalments do not add up to the debt")
+	}
+	plan, err := svc.CreatePlan(ctx, PlanInput{
+		StudentID:   st.ID,
+		InvoiceIDs:  []int{jan.ID, feb.ID},
+		Instalments: []InstalmentInput{{DueOn: day(20), Amount: 80}, {DueOn: day(-10), Amount: 50}},
+	})
+	if err != nil {
+		t.Fatalf("create plan: %v", err)
+	}
+	assertFloatEqual(t, plan.Total, 130)
+	assertEqual(t, plan.Status, PlanStatusMissed)
+	assertEqual(t, plan.Instalments[0].DueOn, day(-10))
+	assertEqual(t, plan.Instalments[0].Status, InstalmentStatusMissed)
+	if _, err := svc.CreatePlan(ctx, PlanInput{StudentID: st.ID, InvoiceIDs: []int{feb.ID}, Count: 1, FirstDueOn: day(5)}); err == nil {
+		t.Fatal("expected error putting an invoice on a second plan")
+	}
+
+	debtor := func() DebtorDTO {
+		t.Helper()
+		debtors, err := svc.ListDebtors(ctx)
+		if err != nil {
+			t.Fatalf("list debtors: %v", err)
+		}
+		for _, d := range debtors {
+			if d.StudentID == st.ID {
+				return d
+			}
+		}
+		t.Fatal("student missing from debtors")
+		return DebtorDTO{}
+	}
+	d := debtor()
+	if !d.Overdue || d.PaymentPlanID == nil || *d.PaymentPlanID != plan.ID || d.MissedInstalments != 1 {
+		t.Fatalf("debtor with missed instalment = %+v", d)
+	}
+
+	if _, err := svc.Create(ctx, st.ID, &janID, 50, app.PaymentMethodBank, day(0), "instalment 1"); err != nil {
+		t.Fatalf("pay instalment: %v", err)
+	}
+	plan, err = svc.GetPlan(ctx, plan.ID)
+	if err != nil {
+		t.Fatalf("get plan: %v", err)
+	}
+	assertEqual(t, plan.Status, PlanStatusOnTrack)
+	assertFloatEqual(t, plan.Paid, 50)
+	assertEqual(t, plan.Instalments[0].Status, InstalmentStatusPaid)
+	if plan.NextDueOn == nil || *plan.NextDueOn != day(20) {
+		t.Fatalf("next due = %v, want %s", plan.NextDueOn, day(20))
+	}
+	assertFloatEqual(t, plan.NextDueAmount, 80)
+
+	d = debtor()
+	if d.Overdue || d.PaymentPlanStatus != PlanStatusOnTrack || d.MissedInstalments != 0 {
+		t.Fatalf("debtor keeping plan = %+v", d)
+	}
+	assertFloatEqual(t, d.Debt, 80)
+	overview, err := svc.MonthOverview(ctx, today.Year(), int(today.Month()))
+	if err != nil {
+		t.Fatalf("month overview: %v", err)
+	}
+	assertEqual(t, overview.OverdueInvoices, 0)
+	assertEqual(t, overview.PaymentPlanInvoices, 2)
+
+	if _, err := svc.CancelPlan(ctx, plan.ID); err != nil {
+		t.Fatalf("cancel plan: %v", err)
+	}
+	if d := debtor(); !d.Overdue || d.PaymentPlanID != nil {
+		t.Fatalf("debtor after cancel = %+v", d)
+	}
+	overview, err = svc.MonthOverview(ctx, today.Year(), int(today.Month()))
+	if err != nil {
+		t.Fatalf("month overview: %v", err)
+	}
+	assertEqual(t, overview.OverdueInvoices, 2)
+}
+
+func TestPlanScheduleSplitsEvenly(t *testing.T) {
+	schedule, err := planSchedule(PlanInput{Count: 3, FirstDueOn: "2026-01-31"}, 10000)
+	if err != nil {
+		t.Fatalf("planSchedule: %v", err)
+	}
+	if len(schedule) != 3 {
+		t.Fatalf("instalments = %d, want 3", len(schedule))
+	}
+	assertEqual(t, schedule[0].cents, int64(3333))
+	assertEqual(t, schedule[2].cents, int64(3334))
+	assertEqual(t, schedule[1].dueOn.Format("2006-01-02"), "2026-02-28")
+	assertEqual(t, schedule[2].dueOn.Format("2006-01-02"), "2026-03-31")
+}
@@ -2,6 +2,7 @@ package recipient
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"langschool/ent"
@@ -16,13 +17,22 @@ type Info struct {
 	ChildName           string
 	StudentPersonalCode string
 	IsMinor             bool
+	// PayerPersonalCode identifies the private person paying: the parent of
+	// a minor, otherwise the student.
+	PayerPersonalCode string
+
+	// Set when a legal entity (e.g. the student's employer) pays.
+	IsCompany        bool
+	CompanyRegNo     string
+	CompanyVATNumber string
+	CompanyAddress   string
 }
 
 // InvoiceSubjectName returns the student-facing name that should appear in an
-// invoice title or file name. For minors, prefer the child's name; otherwise
-// use the visible recipient/adult name.
+// invoice title or file name. For minors and company-paid students, prefer
+// the student's name; otherwise use the visible recipient/adult name.
 func (i Info) InvoiceSubjectName() string {
-	if i.IsMinor && strings.TrimSpace(i.ChildName) != "" {
+	if (i.IsMinor || i.IsCompany) && strings.TrimSpace(i.ChildName) != "" {
 		return strings.TrimSpace(i.ChildName)
 	}
 	if strings.TrimSpace(i.RecipientName) != "" {
@@ -31,6 +41,27 @@ func (i Info) InvoiceSubjectName() string {
 	return strings.TrimSpace(i.ChildName)
 }
 
+// PayerKey identifies who pays for a student so that siblings invoiced to
+// the same parent, or employees of the same company, can be grouped. Parents
+// are matched by personal code, or by name when no code is recorded; adults
+// paying for themselves are keyed by student.
+func PayerKey(st *ent.Student) string {
+	info := FromStudent(st)
+	if info.IsCompany {
+		if regNo := strings.TrimSpace(info.CompanyRegNo); regNo != "" {
+			return "company:" + regNo
+		}
+		return "company:" + strings.ToLower(strings.TrimSpace(info.RecipientName))
+	}
+	if code := strings.TrimSpace(info.PayerPersonalCode); code != "" {
+		return "person:" + code
+	}
+	if st.IsMinor {
+		return "name:" + strings.ToLower(strings.TrimSpace(info.RecipientName))
+	}
+	return "student:" + strconv.Itoa(st.ID)
+}
+
 // ResolveInvoiceRecipient determines the visible invoice recipient for a student.
 // Invoices still belong to students in the database; this helper only affects display output.
 func ResolveInvoiceRecipient(ctx context.Context, db *ent.Client, studentID int) (Info, error) {
@@ -41,6 +72,11 @@ func ResolveInvoiceRecipient(ctx context.Context, db *ent.Client, studentID int)
 		return Info{}, err
 	}
 
+	return FromStudent(st), nil
+}
+
+// FromStudent resolves the recipient of an already loaded student.
+func FromStudent(st *ent.Student) Info {
 	info := Info{
 		RecipientName:       st.FullName,
 		RecipientPhone:      st.Phone,
@@ -48,12 +84,25 @@ func ResolveInvoiceRecipient(ctx context.Context, db *ent.Client, studentID int)
 		ChildName:           st.FullName,
 		StudentPersonalCode: st.PersonalCode,
 		IsMinor:             st.IsMinor,
+		PayerPersonalCode:   st.PersonalCode,
+	}
+	if st.PayerType == student.PayerTypeCompany && strings.TrimSpace(st.PayerCompanyName) != "" {
+		info.IsCompany = true
+		info.RecipientName = st.PayerCompanyName
+		info.CompanyRegNo = st.PayerRegNo
+		info.CompanyVATNumber = st.PayerVatNumber
+		info.CompanyAddress = st.PayerLegalAddress
+		if email := strings.TrimSpace(st.PayerBillingEmail); email != "" {
+			info.RecipientEmail = email
+		}
+		return info
 	}
 	if !st.IsMinor {
-		return info, nil
+		return info
 	}
 	if st.PayerName != "" {
 		info.RecipientName = st.PayerName
 	}
-	return info, nil
+	info.PayerPersonalCode = st.PayerPersonalCode
+	return info
 }
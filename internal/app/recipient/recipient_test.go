@@ -8,6 +8,7 @@ import (
 	_ "github.com/ncruces/go-sqlite3/embed"
 
 	"langschool/ent/enttest"
+	"langschool/ent/student"
 )
 
 func TestResolveInvoiceRecipient(t *testing.T) {
@@ -46,6 +47,9 @@ func TestResolveInvoiceRecipient(t *testing.T) {
 		if got.IsMinor {
 			t.Fatalf("IsMinor = true, want false")
 		}
+		if got.PayerPersonalCode != "111111-11111" {
+			t.Fatalf("PayerPersonalCode = %q, want the student's own code", got.PayerPersonalCode)
+		}
 		if got.InvoiceSubjectName() != "Adult Student" {
 			t.Fatalf("InvoiceSubjectName = %q, want %q", got.InvoiceSubjectName(), "Adult Student")
 		}
@@ -60,6 +64,7 @@ func TestResolveInvoiceRecipient(t *testing.T) {
 			SetIsMinor(true).
 			SetPayerName("Payer Adult").
 			SetPayerRole("mother").
+			SetPayerPersonalCode("050585-12345").
 			Save(ctx)
 		if err != nil {
 			t.Fatalf("Student.Create: %v", err)
@@ -84,6 +89,9 @@ func TestResolveInvoiceRecipient(t *testing.T) {
 		if !got.IsMinor {
 			t.Fatalf("IsMinor = false, want true")
 		}
+		if got.PayerPersonalCode != "050585-12345" {
+			t.Fatalf("PayerPersonalCode = %q, want the parent's code", got.PayerPersonalCode)
+		}
 		if got.InvoiceSubjectName() != "Child One" {
 			t.Fatalf("InvoiceSubjectName = %q, want %q", got.InvoiceSubjectName(), "Child One")
 		}
@@ -115,4 +123,38 @@ func TestResolveInvoiceRecipient(t *testing.T) {
 			t.Fatalf("InvoiceSubjectName = %q, want %q", got.InvoiceSubjectName(), "Child Three")
 		}
 	})
+
+	t.Run("company payer uses legal details", func(t *testing.T) {
+		st, err := client.Student.Create().
+			SetFullName("Employee Four").
+			SetPersonalCode("444444-44444").
+			SetEmail("employee@example.com").
+			SetPayerType(student.PayerTypeCompany).
+			SetPayerCompanyName("SIA Piemērs").
+			SetPayerRegNo("40003000000").
+			SetPayerVatNumber("LV40003000000").
+			SetPayerLegalAddress("Brīvības iela 1, Rīga, LV-1010").
+			SetPayerBillingEmail("invoices@piemers.lv").
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("Student.Create: %v", err)
+		}
+
+		got, err := ResolveInvoiceRecipient(ctx, client, st.ID)
+		if err != nil {
+			t.Fatalf("ResolveInvoiceRecipient: %v", err)
+		}
+		if !got.IsCompany || got.RecipientName != "SIA Piemērs" {
+			t.Fatalf("recipient = %+v, want company SIA Piemērs", got)
+		}
+		if got.CompanyRegNo != "40003000000" || got.CompanyVATNumber != "LV40003000000" || got.CompanyAddress != "Brīvības iela 1, Rīga, LV-1010" {
+			t.Fatalf("company details = %+v", got)
+		}
+		if got.RecipientEmail != "invoices@piemers.lv" {
+			t.Fatalf("RecipientEmail = %q, want billing email", got.RecipientEmail)
+		}
+		if got.InvoiceSubjectName() != "Employee Four" {
+			t.Fatalf("InvoiceSubjectName = %q, want %q", got.InvoiceSubjectName(), "Employee Four")
+		}
+	})
 }
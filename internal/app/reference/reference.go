@@ -0,0 +1,114 @@
+// Package reference builds and parses ISO 11649 ("RF") structured creditor
+// references. Every invoice gets a reference derived from its database ID, so
+// incoming bank payments can be matched to invoices without relying on how
+// the payer typed the invoice number.
+package reference
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// invoiceDigits is the zero-padded width of the invoice ID inside the reference.
+const invoiceDigits = 8
+
+var candidatePattern = regexp.MustCompile(`(?i)R\s?F\s?\d\s?\d(?:\s?[0-9A-Z]){1,21}`)
+
+// ForInvoice returns the electronic (unspaced) RF reference for an invoice.
+func ForInvoice(invoiceID int) string {
+	body := fmt.Sprintf("%0*d", invoiceDigits, invoiceID)
+	return fmt.Sprintf("RF%02d%s", checkDigits(body), body)
+}
+
+// Format groups an electronic reference into blocks of four characters, the
+// form ISO 11649 recommends for paper documents.
+func Format(ref string) string {
+	ref = Normalize(ref)
+	var b strings.Builder
+	for i, r := range ref {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Normalize strips whitespace and upper-cases a reference.
+func Normalize(ref string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(ref), ""))
+}
+
+// Valid reports whether ref is a well-formed RF reference with correct check digits.
+func Valid(ref string) bool {
+	ref = Normalize(ref)
+	if len(ref) < 5 || len(ref) > 25 || !strings.HasPrefix(ref, "RF") {
+		return false
+	}
+	for _, r := range ref[4:] {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	if ref[2] < '0' || ref[2] > '9' || ref[3] < '0' || ref[3] > '9' {
+		return false
+	}
+	return mod97(ref[4:]+ref[:4]) == 1
+}
+
+// InvoiceID extracts the invoice ID from a reference produced by ForInvoice.
+func InvoiceID(ref string) (int, bool) {
+	ref = Normalize(ref)
+	if !Valid(ref) || len(ref) != 4+invoiceDigits {
+		return 0, false
+	}
+	id, err := strconv.Atoi(ref[4:])
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// FindInvoiceIDs scans free-form remittance text for valid invoice references
+// and returns the invoice IDs in order of appearance.
+func FindInvoiceIDs(text string) []int {
+	var ids []int
+	seen := map[int]struct{}{}
+	for _, candidate := range candidatePattern.FindAllString(text, -1) {
+		normalized := Normalize(candidate)
+		// Greedy matching may swallow characters that follow the reference
+		// without a separator; shrink until the check digits agree.
+		for n := len(normalized); n >= 4+invoiceDigits; n-- {
+			id, ok := InvoiceID(normalized[:n])
+			if !ok {
+				continue
+			}
+			if _, dup := seen[id]; !dup {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+			break
+		}
+	}
+	return ids
+}
+
+func checkDigits(body string) int {
+	return 98 - mod97(body+"RF00")
+}
+
+// mod97 computes the ISO 7064 MOD 97-10 remainder, mapping letters A-Z to 10-35.
+func mod97(s string) int {
+	remainder := 0
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		}
+	}
+	return remainder
+}
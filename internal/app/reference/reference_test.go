@@ -0,0 +1,48 @@
+package reference
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	for _, ref := range []string{"RF18539007547034", "RF18 5390 0754 7034", "rf712348231"} {
+		if !Valid(ref) {
+			t.Errorf("Valid(%q) = false, want true", ref)
+		}
+	}
+	for _, ref := range []string{"", "RF19539007547034", "RF1", "XX18539007547034", "RF18-5390"} {
+		if Valid(ref) {
+			t.Errorf("Valid(%q) = true, want false", ref)
+		}
+	}
+}
+
+func TestForInvoiceRoundTrip(t *testing.T) {
+	for _, id := range []int{1, 42, 1234567, 99999999} {
+		ref := ForInvoice(id)
+		if !Valid(ref) {
+			t.Fatalf("ForInvoice(%d) = %q is not valid", id, ref)
+		}
+		got, ok := InvoiceID(Format(ref))
+		if !ok || got != id {
+			t.Fatalf("InvoiceID(%q) = %d, %v; want %d", Format(ref), got, ok, id)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Format("rf18539007547034"); got != "RF18 5390 0754 7034" {
+		t.Fatalf("Format() = %q", got)
+	}
+}
+
+func TestFindInvoiceIDs(t *testing.T) {
+	text := "Rēķins LS-202503-0042, atsauce " + Format(ForInvoice(42)) + "; arī " + ForInvoice(7) + "X un " + ForInvoice(42)
+	if got := FindInvoiceIDs(text); !reflect.DeepEqual(got, []int{42, 7}) {
+		t.Fatalf("FindInvoiceIDs() = %v, want [42 7]", got)
+	}
+	if got := FindInvoiceIDs("RF00 0000 0042"); len(got) != 0 {
+		t.Fatalf("FindInvoiceIDs() with bad check digits = %v, want none", got)
+	}
+}
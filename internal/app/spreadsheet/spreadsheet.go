@@ -0,0 +1,295 @@
+// Package spreadsheet reads the rows of an uploaded CSV file or of the first
+// worksheet of an XLSX workbook as text cells.
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedFormat is returned for files that are neither CSV nor XLSX.
+var ErrUnsupportedFormat = errors.New("file must be CSV or XLSX")
+
+// Read returns the rows of a CSV or XLSX file, with cells trimmed and
+// trailing empty rows dropped. The format is taken from the file name and
+// falls back to the content.
+func Read(name string, data []byte) ([][]string, error) {
+	var rows [][]string
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(name)); {
+	case ext == ".xlsx" || (ext == "" && bytes.HasPrefix(data, []byte("PK\x03\x04"))):
+		rows, err = readXLSX(data)
+	case ext == ".csv" || ext == ".txt" || ext == "":
+		rows, err = readCSV(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		for j, cell := range row {
+			row[j] = strings.TrimSpace(cell)
+		}
+		rows[i] = row
+	}
+	for len(rows) > 0 && isEmptyRow(rows[len(rows)-1]) {
+		rows = rows[:len(rows)-1]
+	}
+	return rows, nil
+}
+
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// readCSV accepts comma, semicolon (as exported by a Latvian-locale Excel)
+// and tab separated files, guessing the separator from the first line.
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = ','
+	best := bytes.Count(firstLine, []byte(","))
+	for _, sep := range []rune{';', '\t'} {
+		if n := bytes.Count(firstLine, []byte(string(sep))); n > best {
+			r.Comma, best = sep, n
+		}
+	}
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV: %w", err)
+	}
+	return rows, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Items []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.T)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxStyles struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Style  int      `xml:"s,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("read XLSX: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	decode := func(name string, v any) (bool, error) {
+		f := files[name]
+		if f == nil {
+			return false, nil
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return false, err
+		}
+		defer rc.Close()
+		if err := xml.NewDecoder(io.LimitReader(rc, 64<<20)).Decode(v); err != nil {
+			return false, fmt.Errorf("read XLSX %s: %w", name, err)
+		}
+		return true, nil
+	}
+
+	sheetPath, err := firstSheetPath(decode)
+	if err != nil {
+		return nil, err
+	}
+	var shared xlsxSharedStrings
+	if _, err := decode("xl/sharedStrings.xml", &shared); err != nil {
+		return nil, err
+	}
+	var styles xlsxStyles
+	if _, err := decode("xl/styles.xml", &styles); err != nil {
+		return nil, err
+	}
+	dateStyles := make(map[int]bool, len(styles.CellXfs))
+	customFormats := make(map[int]string, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		customFormats[f.ID] = f.Code
+	}
+	for i, xf := range styles.CellXfs {
+		dateStyles[i] = isDateFormat(xf.NumFmtID, customFormats[xf.NumFmtID])
+	}
+	var sheet xlsxSheet
+	found, err := decode(sheetPath, &sheet)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("read XLSX: the workbook has no worksheet")
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		cells := make([]string, 0, len(row.Cells))
+		for i, c := range row.Cells {
+			col := i
+			if c.Ref != "" {
+				col = columnIndex(c.Ref)
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+			switch c.Type {
+			case "s":
+				idx, err := strconv.Atoi(c.Value)
+				if err != nil || idx < 0 || idx >= len(shared.Items) {
+					return nil, fmt.Errorf("read XLSX: cell %s has an invalid shared string", c.Ref)
+				}
+				cells[col] = shared.Items[idx].String()
+			case "inlineStr":
+				cells[col] = c.Inline.String()
+			case "b":
+				cells[col] = map[string]string{"1": "TRUE", "0": "FALSE"}[c.Value]
+			default:
+				cells[col] = c.Value
+				if c.Type == "" || c.Type == "n" {
+					if dateStyles[c.Style] {
+						cells[col] = serialDate(c.Value)
+					}
+				}
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+// firstSheetPath finds the part holding the workbook's first worksheet.
+func firstSheetPath(decode func(string, any) (bool, error)) (string, error) {
+	const fallback = "xl/worksheets/sheet1.xml"
+	var wb xlsxWorkbook
+	if found, err := decode("xl/workbook.xml", &wb); err != nil || !found || len(wb.Sheets) == 0 {
+		return fallback, err
+	}
+	var rels xlsxRelationships
+	if found, err := decode("xl/_rels/workbook.xml.rels", &rels); err != nil || !found {
+		return fallback, err
+	}
+	for _, rel := range rels.Items {
+		if rel.ID != wb.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return fallback, nil
+}
+
+// columnIndex turns a cell reference such as "C12" into a zero-based column.
+func columnIndex(ref string) int {
+	col := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+	}
+	return col - 1
+}
+
+// isDateFormat reports whether a number format shows a date: one of the
+// built-in date formats, or a custom one with day, month or year parts.
+func isDateFormat(id int, code string) bool {
+	if (id >= 14 && id <= 22) || (id >= 45 && id <= 47) {
+		return true
+	}
+	if code == "" {
+		return false
+	}
+	inQuotes := false
+	for _, r := range strings.ToLower(code) {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && (r == 'd' || r == 'm' || r == 'y'):
+			return true
+		}
+	}
+	return false
+}
+
+// serialDate turns an Excel date serial number into YYYY-MM-DD.
+func serialDate(value string) string {
+	serial, err := strconv.ParseFloat(value, 64)
+	if err != nil || serial < 1 {
+		return value
+	}
+	epoch := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	return epoch.AddDate(0, 0, int(math.Floor(serial))).Format("2006-01-02")
+}
@@ -0,0 +1,98 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReadCSVDetectsSemicolonAndStripsBOM(t *testing.T) {
+	data := []byte("\xef\xbb\xbfVārds;Personas kods;E-pasts\n Anna Bērziņa ;010203-12345;anna@example.com\n\"Jānis; jr.\";;\n\n")
+	rows, err := Read("students.csv", data)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	want := [][]string{
+		{"Vārds", "Personas kods", "E-pasts"},
+		{"Anna Bērziņa", "010203-12345", "anna@example.com"},
+		{"Jānis; jr.", "", ""},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestReadXLSXFirstSheet(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Reģistrācija" sheetId="1" r:id="rId7"/><sheet name="Other" sheetId="2" r:id="rId8"/></sheets>
+</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId8" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId7" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Name</t></si><si><t>Starts</t></si><si><t>Phone</t></si><si><r><t>Anna </t></r><r><t>Bērziņa</t></r></si>
+</sst>`,
+		"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts count="1"><numFmt numFmtId="164" formatCode="dd.mm.yyyy"/></numFmts>
+<cellXfs count="3"><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/></cellXfs>
+</styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="inlineStr"><is><t>wrong sheet</t></is></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="s"><v>2</v></c></row>
+<row r="2"><c r="A2" t="s"><v>3</v></c><c r="B2" s="1"><v>45901</v></c><c r="C2" t="inlineStr"><is><t>x</t></is></c><c r="D2" s="0"><v>37120000000</v></c></row>
+<row r="3"><c r="B3" s="2"><v>45902</v></c></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t> </t></is></c></row>
+</sheetData></worksheet>`,
+	})
+	rows, err := Read("registration.xlsx", data)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	want := [][]string{
+		{"Name", "Starts", "", "Phone"},
+		{"Anna Bērziņa", "2025-09-01", "x", "37120000000"},
+		{"", "2025-09-02"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestReadRejectsUnknownFormat(t *testing.T) {
+	if _, err := Read("students.pdf", []byte("%PDF")); err != ErrUnsupportedFormat {
+		t.Fatalf("Read pdf err = %v, want ErrUnsupportedFormat", err)
+	}
+	if _, err := Read("students.xlsx", []byte("not a zip")); err == nil {
+		t.Fatalf("Read broken xlsx: expected error")
+	}
+}
+
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("zip write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,339 @@
+// Package statement builds statements of account: every issued invoice and
+// every payment of a student, or of all students sharing a payer, over a
+// period with opening, running and closing balances.
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/invoice"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+	"langschool/internal/app"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+	pdfgen "langschool/internal/pdf"
+)
+
+// Statement scopes.
+const (
+	ScopeStudent = "student" // only the requested student
+	ScopePayer   = "payer"   // all students invoiced to the same payer
+)
+
+// Service builds statements from invoices and payments.
+type Service struct{ db *ent.Client }
+
+// New creates a statement service with the given database client.
+func New(db *ent.Client) *Service { return &Service{db: db} }
+
+// EntryDTO is one invoice or payment line of a statement.
+type EntryDTO struct {
+	Date        string  `json:"date"` // YYYY-MM-DD
+	Kind        string  `json:"kind"` // invoice, payment, credit (unallocated payment), refund or transfer
+	StudentID   int     `json:"studentId"`
+	StudentName string  `json:"studentName"`
+	InvoiceID   *int    `json:"invoiceId,omitempty"`
+	PaymentID   *int    `json:"paymentId,omitempty"`
+	Document    string  `json:"document"` // Invoice number
+	Description string  `json:"description"`
+	Method      string  `json:"method,omitempty"`
+	Charge      float64 `json:"charge"`
+	Paid        float64 `json:"paid"`
+	Balance     float64 `json:"balance"` // Running amount due; negative means paid in advance
+}
+
+// DTO is a statement of account.
+type DTO struct {
+	Scope          string     `json:"scope"`
+	From           string     `json:"from"`
+	To             string     `json:"to"`
+	StudentIDs     []int      `json:"studentIds"`
+	RecipientName  string     `json:"recipientName"`
+	RecipientEmail string     `json:"recipientEmail"`
+	Opening        float64    `json:"opening"`
+	Charged        float64    `json:"charged"`
+	Paid           float64    `json:"paid"`
+	Closing        float64    `json:"closing"`
+	Entries        []EntryDTO `json:"entries"`
+}
+
+// Result holds a statement both for the API and for PDF rendering.
+type Result struct {
+	DTO      DTO
+	Document pdfgen.AccountStatement
+}
+
+type entry struct {
+	date        time.Time
+	kind        string
+	studentID   int
+	invoiceID   *int
+	paymentID   *int
+	id          int
+	document    string
+	description string
+	method      string
+	chargeCents int64
+	paidCents   int64
+}
+
+// Build collects the statement of a student for the inclusive date range.
+// With ScopePayer the statement covers every student sharing the student's
+// payer (see recipient.PayerKey).
+func (s *Service) Build(ctx context.Context, studentID int, scope string, from, to time.Time) (*Result, error) {
+	if scope == "" {
+		scope = ScopeStudent
+	}
+	if scope != ScopeStudent && scope != ScopePayer {
+		return nil, fmt.Errorf("scope must be '%s' or '%s'", ScopeStudent, ScopePayer)
+	}
+	from = dateOnly(from)
+	to = dateOnly(to)
+	if to.Before(from) {
+		return nil, errors.New("from must be before to")
+	}
+	end := to.AddDate(0, 0, 1)
+
+	primary, err := s.db.Student.Get(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	students := []*ent.Student{primary}
+	if scope == ScopePayer {
+		students, err = s.samePayer(ctx, primary)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(students))
+	names := map[int]string{}
+	studentNames := make([]string, 0, len(students))
+	for _, st := range students {
+		ids = append(ids, st.ID)
+		names[st.ID] = st.FullName
+		studentNames = append(studentNames, st.FullName)
+	}
+	multi := len(students) > 1
+
+	invs, err := s.db.Invoice.Query().
+		Where(
+			invoice.StudentIDIn(ids...),
+			invoice.StatusNotIn(invoice.Status(app.InvoiceStatusDraft), invoice.Status(app.InvoiceStatusCanceled)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pays, err := s.db.Payment.Query().
+		Where(payment.StudentIDIn(ids...), payment.PaidAtLT(end)).
+		WithInvoice().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var openingCents int64
+	var entries []entry
+	for _, iv := range invs {
+		date := invoiceDate(iv)
+		if !date.Before(end) {
+			continue
+		}
+		if date.Before(from) {
+			openingCents += iv.TotalAmountCents
+			continue
+		}
+		id := iv.ID
+		desc := fmt.Sprintf("Rēķins par %02d.%d", iv.PeriodMonth, iv.PeriodYear)
+		if multi {
+			desc += " — " + names[iv.StudentID]
+		}
+		entries = append(entries, entry{
+			date:        date,
+			kind:        pdfgen.StatementEntryInvoice,
+			studentID:   iv.StudentID,
+			invoiceID:   &id,
+			id:          iv.ID,
+			document:    optional(iv.Number),
+			description: desc,
+			chargeCents: iv.TotalAmountCents,
+		})
+	}
+	for _, p := range pays {
+		if p.PaidAt.Before(from) {
+			openingCents -= p.AmountCents
+			continue
+		}
+		id := p.ID
+		kind := pdfgen.StatementEntryPayment
+		desc := "Maksājums — " + methodLabel(string(p.Method))
+		document := ""
+		switch {
+		case p.Kind == payment.KindRefund:
+			kind = pdfgen.StatementEntryRefund
+			desc = "Atmaksa — " + methodLabel(string(p.Method))
+		case p.Kind == payment.KindTransferIn || p.Kind == payment.KindTransferOut:
+			kind = pdfgen.StatementEntryTransfer
+			desc = "Priekšapmaksas pārnesums"
+		case p.Kind == payment.KindLessonCredit:
+			kind = pdfgen.StatementEntryCredit
+			desc = "Neizmantoto nodarbību vērtība"
+		case p.InvoiceID == nil:
+			kind = pdfgen.StatementEntryCredit
+			desc = "Priekšapmaksa — " + methodLabel(string(p.Method))
+		case p.Edges.Invoice != nil:
+			document = optional(p.Edges.Invoice.Number)
+		}
+		if multi {
+			desc += " — " + names[p.StudentID]
+		}
+		entries = append(entries, entry{
+			date:        p.PaidAt,
+			kind:        kind,
+			studentID:   p.StudentID,
+			invoiceID:   p.InvoiceID,
+			paymentID:   &id,
+			id:          p.ID,
+			document:    document,
+			description: desc,
+			method:      string(p.Method),
+			paidCents:   p.AmountCents,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		di, dj := dateOnly(entries[i].date), dateOnly(entries[j].date)
+		if !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		// Charges first, so a same-day payment reads as settling the invoice.
+		if (entries[i].chargeCents > 0) != (entries[j].chargeCents > 0) {
+			return entries[i].chargeCents > 0
+		}
+		return entries[i].id < entries[j].id
+	})
+
+	info := recipient.FromStudent(primary)
+	result := &Result{
+		DTO: DTO{
+			Scope:          scope,
+			From:           from.Format("2006-01-02"),
+			To:             to.Format("2006-01-02"),
+			StudentIDs:     ids,
+			RecipientName:  info.RecipientName,
+			RecipientEmail: strings.TrimSpace(info.RecipientEmail),
+			Opening:        money.CentsToEuros(openingCents),
+			Entries:        make([]EntryDTO, 0, len(entries)),
+		},
+		Document: pdfgen.AccountStatement{
+			From:         from,
+			To:           to,
+			Recipient:    info,
+			StudentNames: studentNames,
+			OpeningCents: openingCents,
+		},
+	}
+	balance := openingCents
+	var chargedCents, paidCents int64
+	for _, e := range entries {
+		balance += e.chargeCents - e.paidCents
+		chargedCents += e.chargeCents
+		paidCents += e.paidCents
+		result.DTO.Entries = append(result.DTO.Entries, EntryDTO{
+			Date:        e.date.Format("2006-01-02"),
+			Kind:        e.kind,
+			StudentID:   e.studentID,
+			StudentName: names[e.studentID],
+			InvoiceID:   e.invoiceID,
+			PaymentID:   e.paymentID,
+			Document:    e.document,
+			Description: e.description,
+			Method:      e.method,
+			Charge:      money.CentsToEuros(e.chargeCents),
+			Paid:        money.CentsToEuros(e.paidCents),
+			Balance:     money.CentsToEuros(balance),
+		})
+		result.Document.Entries = append(result.Document.Entries, pdfgen.AccountStatementEntry{
+			Date:         e.date,
+			Kind:         e.kind,
+			Document:     e.document,
+			Description:  e.description,
+			ChargeCents:  e.chargeCents,
+			PaidCents:    e.paidCents,
+			BalanceCents: balance,
+		})
+	}
+	result.DTO.Charged = money.CentsToEuros(chargedCents)
+	result.DTO.Paid = money.CentsToEuros(paidCents)
+	result.DTO.Closing = money.CentsToEuros(balance)
+	result.Document.ClosingCents = balance
+	return result, nil
+}
+
+// FileName returns the name of the statement's PDF.
+func (r *Result) FileName() string {
+	return pdfgen.AccountStatementFileName(r.Document.Recipient.InvoiceSubjectName(), r.Document.From, r.Document.To)
+}
+
+// PDF renders a statement.
+func (s *Service) PDF(ctx context.Context, result *Result, fontsDir string) ([]byte, string, error) {
+	return pdfgen.GenerateAccountStatementPDF(ctx, s.db, result.Document, pdfgen.Options{FontsDir: fontsDir})
+}
+
+func (s *Service) samePayer(ctx context.Context, primary *ent.Student) ([]*ent.Student, error) {
+	all, err := s.db.Student.Query().Order(ent.Asc(student.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := recipient.PayerKey(primary)
+	var out []*ent.Student
+	for _, st := range all {
+		if recipient.PayerKey(st) == key {
+			out = append(out, st)
+		}
+	}
+	return out, nil
+}
+
+// invoiceDate is when an invoice became payable. Invoices issued before the
+// issue time was recorded fall back to their PDF or creation time.
+func invoiceDate(iv *ent.Invoice) time.Time {
+	switch {
+	case iv.IssuedAt != nil:
+		return *iv.IssuedAt
+	case iv.PdfGeneratedAt != nil:
+		return *iv.PdfGeneratedAt
+	case iv.CreatedAt != nil:
+		return *iv.CreatedAt
+	default:
+		return time.Date(iv.PeriodYear, time.Month(iv.PeriodMonth), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func methodLabel(method string) string {
+	switch method {
+	case app.PaymentMethodCash:
+		return "skaidrā naudā"
+	case app.PaymentMethodBank:
+		return "bankas pārskaitījums"
+	default:
+		return method
+	}
+}
+
+func optional(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
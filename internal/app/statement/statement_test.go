@@ -0,0 +1,104 @@
+package statement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent/enttest"
+	entinvoice "langschool/ent/invoice"
+	entpayment "langschool/ent/payment"
+)
+
+func TestBuildRunningBalance(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:statement?mode=memory&_fk=1")
+	defer client.Close()
+
+	st, err := client.Student.Create().SetFullName("Balance Student").SetEmail("student@example.com").Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	invoiceAt := func(month int, number string, status entinvoice.Status, cents int64, issuedAt time.Time) int {
+		t.Helper()
+		iv, err := client.Invoice.Create().
+			SetStudentID(st.ID).
+			SetPeriodYear(2025).
+			SetPeriodMonth(month).
+			SetTotalAmountCents(cents).
+			SetStatus(status).
+			SetNumber(number).
+			SetIssuedAt(issuedAt).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("create invoice: %v", err)
+		}
+		return iv.ID
+	}
+	pay := func(invoiceID *int, cents int64, method entpayment.Method, paidAt time.Time) {
+		t.Helper()
+		if _, err := client.Payment.Create().
+			SetStudentID(st.ID).
+			SetNillableInvoiceID(invoiceID).
+			SetAmountCents(cents).
+			SetMethod(method).
+			SetPaidAt(paidAt).
+			Save(ctx); err != nil {
+			t.Fatalf("create payment: %v", err)
+		}
+	}
+
+	jan := time.Date(2025, time.January, 31, 9, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC)
+	old := invoiceAt(1, "LS-202501-001", entinvoice.StatusPaid, 5000, jan)
+	pay(&old, 5000, entpayment.MethodBank, jan)
+	current := invoiceAt(2, "LS-202502-001", entinvoice.StatusIssued, 6000, feb)
+	invoiceAt(3, "LS-202502-002", entinvoice.StatusCanceled, 9999, feb)
+	pay(&current, 2000, entpayment.MethodCash, feb)
+	pay(nil, 1500, entpayment.MethodBank, feb.AddDate(0, 0, 5))
+	invoiceAt(4, "LS-202504-001", entinvoice.StatusIssued, 7000, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+
+	svc := New(client)
+	result, err := svc.Build(ctx, st.ID, "", time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	dto := result.DTO
+	if dto.Scope != ScopeStudent || dto.Opening != 0 || dto.Charged != 60 || dto.Paid != 35 || dto.Closing != 25 {
+		t.Fatalf("statement = %+v", dto)
+	}
+	if len(dto.Entries) != 3 {
+		t.Fatalf("entries = %+v, want invoice, payment and credit", dto.Entries)
+	}
+	invoiceEntry, paymentEntry, creditEntry := dto.Entries[0], dto.Entries[1], dto.Entries[2]
+	if invoiceEntry.Kind != "invoice" || invoiceEntry.Document != "LS-202502-001" || invoiceEntry.Balance != 60 {
+		t.Fatalf("invoice entry = %+v", invoiceEntry)
+	}
+	if paymentEntry.Kind != "payment" || paymentEntry.Method != "cash" || paymentEntry.Document != "LS-202502-001" || paymentEntry.Balance != 40 {
+		t.Fatalf("payment entry = %+v", paymentEntry)
+	}
+	if creditEntry.Kind != "credit" || creditEntry.Balance != 25 {
+		t.Fatalf("credit entry = %+v", creditEntry)
+	}
+	if result.Document.ClosingCents != 2500 || len(result.Document.Entries) != 3 {
+		t.Fatalf("document = %+v", result.Document)
+	}
+
+	later, err := svc.Build(ctx, st.ID, ScopeStudent, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Build later: %v", err)
+	}
+	if later.DTO.Opening != 25 || later.DTO.Closing != 95 {
+		t.Fatalf("later statement = %+v", later.DTO)
+	}
+
+	if _, err := svc.Build(ctx, st.ID, "family", jan, feb); err == nil {
+		t.Fatal("expected error for unknown scope")
+	}
+	if _, err := svc.Build(ctx, st.ID, ScopeStudent, feb, jan); err == nil {
+		t.Fatal("expected error for reversed range")
+	}
+}
@@ -0,0 +1,231 @@
+// Package taxcert builds the annual education-expense certificates that
+// private payers attach to their Latvian income declaration. Amounts are the
+// payments actually received during the calendar year, not invoiced sums.
+package taxcert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/payment"
+	"langschool/ent/student"
+	paysvc "langschool/internal/app/payment"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+	pdfgen "langschool/internal/pdf"
+)
+
+// Service computes certificate data from recorded payments.
+type Service struct{ db *ent.Client }
+
+// New creates a certificate service with the given database client.
+func New(db *ent.Client) *Service { return &Service{db: db} }
+
+// StudentDTO is the amount one payer paid for one student.
+type StudentDTO struct {
+	StudentID    int     `json:"studentId"`
+	Name         string  `json:"name"`
+	PersonalCode string  `json:"personalCode"`
+	Amount       float64 `json:"amount"`
+}
+
+// PayerDTO summarises what a payer paid during the year.
+type PayerDTO struct {
+	Key                 string       `json:"key"` // Stable identifier used to request a single certificate
+	Name                string       `json:"name"`
+	PersonalCode        string       `json:"personalCode"`
+	MissingPersonalCode bool         `json:"missingPersonalCode"` // The certificate will have a blank personal code
+	Email               string       `json:"email"`
+	Total               float64      `json:"total"`
+	Students            []StudentDTO `json:"students"`
+}
+
+// Certificate is a payer's certificate together with the address it can be
+// emailed to.
+type Certificate struct {
+	pdfgen.EducationCertificate
+	Key   string
+	Email string
+}
+
+type payerTotals struct {
+	key          string
+	name         string
+	personalCode string
+	email        string
+	students     []StudentDTO
+	cents        []int64
+}
+
+// yearBounds matches the UTC month bounds used for payment reports.
+func yearBounds(year int) (time.Time, time.Time) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, 0)
+}
+
+// collect groups the year's payments by payer (see recipient.PayerKey), so
+// siblings paid by the same parent share one certificate. Company-paid
+// students get no certificate. Money received (see payment.IsReceived) and
+// refunds count; credit moved between students counts for the student who
+// paid it in.
+func (s *Service) collect(ctx context.Context, year int) ([]*payerTotals, error) {
+	if year < 2000 || year > 2100 {
+		return nil, errors.New("year is invalid")
+	}
+	start, end := yearBounds(year)
+	payments, err := s.db.Payment.Query().
+		Where(
+			payment.PaidAtGTE(start),
+			payment.PaidAtLT(end),
+			payment.KindNotIn(payment.KindTransferIn, payment.KindTransferOut),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paidByStudent := map[int]int64{}
+	for _, p := range payments {
+		if paysvc.IsReceived(p) || p.Kind == payment.KindRefund {
+			paidByStudent[p.StudentID] += p.AmountCents
+		}
+	}
+	if len(paidByStudent) == 0 {
+		return nil, nil
+	}
+	studentIDs := make([]int, 0, len(paidByStudent))
+	for id := range paidByStudent {
+		studentIDs = append(studentIDs, id)
+	}
+	students, err := s.db.Student.Query().
+		Where(student.IDIn(studentIDs...)).
+		Order(ent.Asc(student.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index := map[string]*payerTotals{}
+	var out []*payerTotals
+	for _, st := range students {
+		cents := paidByStudent[st.ID]
+		if cents <= 0 {
+			continue
+		}
+		info := recipient.FromStudent(st)
+		if info.IsCompany {
+			continue
+		}
+		code := strings.TrimSpace(info.PayerPersonalCode)
+		groupKey := recipient.PayerKey(st)
+		p, ok := index[groupKey]
+		if !ok {
+			key := code
+			if key == "" {
+				key = "s" + strconv.Itoa(st.ID)
+			}
+			p = &payerTotals{key: key, name: strings.TrimSpace(info.RecipientName), personalCode: code}
+			index[groupKey] = p
+			out = append(out, p)
+		}
+		if p.email == "" {
+			p.email = strings.TrimSpace(info.RecipientEmail)
+		}
+		p.students = append(p.students, StudentDTO{
+			StudentID:    st.ID,
+			Name:         st.FullName,
+			PersonalCode: st.PersonalCode,
+			Amount:       money.CentsToEuros(cents),
+		})
+		p.cents = append(p.cents, cents)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return strings.ToLower(out[i].name) < strings.ToLower(out[j].name)
+	})
+	return out, nil
+}
+
+// List returns every private payer with payments received during the year.
+func (s *Service) List(ctx context.Context, year int) ([]PayerDTO, error) {
+	payers, err := s.collect(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]PayerDTO, 0, len(payers))
+	for _, p := range payers {
+		var total int64
+		for _, c := range p.cents {
+			total += c
+		}
+		out = append(out, PayerDTO{
+			Key:                 p.key,
+			Name:                p.name,
+			PersonalCode:        p.personalCode,
+			MissingPersonalCode: p.personalCode == "",
+			Email:               p.email,
+			Total:               money.CentsToEuros(total),
+			Students:            p.students,
+		})
+	}
+	return out, nil
+}
+
+// Certificates returns the certificates of all payers for the year.
+func (s *Service) Certificates(ctx context.Context, year int, issuedAt time.Time) ([]Certificate, error) {
+	payers, err := s.collect(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Certificate, 0, len(payers))
+	for _, p := range payers {
+		out = append(out, p.certificate(year, issuedAt))
+	}
+	return out, nil
+}
+
+// Certificate returns the certificate of the payer with the given key.
+func (s *Service) Certificate(ctx context.Context, year int, key string, issuedAt time.Time) (*Certificate, error) {
+	payers, err := s.collect(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range payers {
+		if p.key == key {
+			cert := p.certificate(year, issuedAt)
+			return &cert, nil
+		}
+	}
+	return nil, fmt.Errorf("payer %s has no payments in %d: %w", key, year, os.ErrNotExist)
+}
+
+// PDF renders a certificate.
+func (s *Service) PDF(ctx context.Context, cert Certificate, fontsDir string) ([]byte, string, error) {
+	return pdfgen.GenerateEducationCertificatePDF(ctx, s.db, cert.EducationCertificate, pdfgen.Options{FontsDir: fontsDir})
+}
+
+func (p *payerTotals) certificate(year int, issuedAt time.Time) Certificate {
+	cert := Certificate{
+		EducationCertificate: pdfgen.EducationCertificate{
+			Year:              year,
+			PayerName:         p.name,
+			PayerPersonalCode: p.personalCode,
+			IssuedAt:          issuedAt,
+		},
+		Key:   p.key,
+		Email: p.email,
+	}
+	for i, st := range p.students {
+		cert.Students = append(cert.Students, pdfgen.EducationCertificateStudent{
+			Name:         st.Name,
+			PersonalCode: st.PersonalCode,
+			AmountCents:  p.cents[i],
+		})
+	}
+	return cert
+}
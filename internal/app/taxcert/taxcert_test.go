@@ -0,0 +1,211 @@
+package taxcert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent"
+	"langschool/ent/enttest"
+	entinvoice "langschool/ent/invoice"
+	entpayment "langschool/ent/payment"
+	"langschool/ent/student"
+	paysvc "langschool/internal/app/payment"
+)
+
+func TestListGroupsPaymentsByPayer(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:taxcert?mode=memory&_fk=1")
+	defer client.Close()
+
+	newStudent := func(name string, configure func(*ent.StudentCreate)) *ent.Student {
+		t.Helper()
+		create := client.Student.Create().SetFullName(name)
+		if configure != nil {
+			configure(create)
+		}
+		st, err := create.Save(ctx)
+		if err != nil {
+			t.Fatalf("create student %s: %v", name, err)
+		}
+		return st
+	}
+	pay := func(st *ent.Student, cents int64, paidAt time.Time) {
+		t.Helper()
+		if _, err := client.Payment.Create().
+			SetStudentID(st.ID).
+			SetAmountCents(cents).
+			SetMethod(entpayment.MethodBank).
+			SetPaidAt(paidAt).
+			Save(ctx); err != nil {
+			t.Fatalf("create payment: %v", err)
+		}
+	}
+
+	parent := func(c *ent.StudentCreate) {
+		c.SetIsMinor(true).SetPayerName("Anna Ozola").SetPayerRole("mother").SetPayerPersonalCode("010180-10000").SetEmail("anna@example.com")
+	}
+	childA := newStudent("Jānis Ozols", func(c *ent.StudentCreate) { parent(c); c.SetPersonalCode("010112-20000") })
+	childB := newStudent("Līga Ozola", parent)
+	adult := newStudent("Pēteris Kalns", func(c *ent.StudentCreate) { c.SetPersonalCode("020290-30000") })
+	employee := newStudent("Company Employee", func(c *ent.StudentCreate) {
+		c.SetPayerType(student.PayerTypeCompany).SetPayerCompanyName("Employer SIA")
+	})
+
+	inYear := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	pay(childA, 5000, inYear)
+	pay(childA, 2500, time.Date(2025, time.December, 31, 23, 0, 0, 0, time.UTC))
+	pay(childB, 4000, inYear)
+	pay(adult, 9000, inYear)
+	pay(adult, 1000, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	pay(employee, 7000, inYear)
+
+	svc := New(client)
+	payers, err := svc.List(ctx, 2025)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(payers) != 2 {
+		t.Fatalf("payers = %+v, want parent and adult student", payers)
+	}
+	mother, self := payers[0], payers[1]
+	if mother.Name != "Anna Ozola" || mother.Key != "010180-10000" || mother.Total != 115 || len(mother.Students) != 2 {
+		t.Fatalf("parent payer = %+v", mother)
+	}
+	if mother.Email != "anna@example.com" {
+		t.Fatalf("parent email = %q", mother.Email)
+	}
+	if self.Name != "Pēteris Kalns" || self.PersonalCode != "020290-30000" || self.Total != 90 {
+		t.Fatalf("adult payer = %+v, want only payments of 2025", self)
+	}
+
+	cert, err := svc.Certificate(ctx, 2025, mother.Key, inYear)
+	if err != nil {
+		t.Fatalf("Certificate: %v", err)
+	}
+	if cert.TotalCents() != 11500 || cert.Students[0].PersonalCode != "010112-20000" {
+		t.Fatalf("certificate = %+v", cert)
+	}
+	if _, err := svc.Certificate(ctx, 2025, "unknown", inYear); err == nil {
+		t.Fatal("expected error for unknown payer")
+	}
+	if _, err := svc.List(ctx, 0); err == nil {
+		t.Fatal("expected error for invalid year")
+	}
+}
+
+func TestListIgnoresCreditTransfers(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:taxcert-transfer?mode=memory&_fk=1")
+	defer client.Close()
+
+	from, err := client.Student.Create().SetFullName("Anna Ozola").SetPersonalCode("010180-10000").Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	to, err := client.Student.Create().SetFullName("Pēteris Kalns").SetPersonalCode("020290-30000").Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	paidAt := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	if _, err := client.Payment.Create().
+		SetStudentID(from.ID).
+		SetAmountCents(10000).
+		SetMethod(entpayment.MethodBank).
+		SetPaidAt(paidAt).
+		Save(ctx); err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+	// A transfer of 40.00 as TransferCredit books it, applied by the
+	// receiving student to an invoice.
+	out, err := client.Payment.Create().
+		SetStudentID(from.ID).
+		SetAmountCents(-4000).
+		SetMethod(entpayment.MethodBank).
+		SetPaidAt(paidAt.AddDate(0, 1, 0)).
+		SetKind(entpayment.KindTransferOut).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create transfer out: %v", err)
+	}
+	in, err := client.Payment.Create().
+		SetStudentID(to.ID).
+		SetAmountCents(4000).
+		SetMethod(entpayment.MethodBank).
+		SetPaidAt(paidAt.AddDate(0, 1, 0)).
+		SetKind(entpayment.KindTransferIn).
+		SetRelatedPaymentID(out.ID).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create transfer in: %v", err)
+	}
+	if _, err := out.Update().SetRelatedPaymentID(in.ID).Save(ctx); err != nil {
+		t.Fatalf("link transfer: %v", err)
+	}
+	if _, err := client.Invoice.Create().
+		SetStudentID(to.ID).
+		SetPeriodYear(2025).
+		SetPeriodMonth(4).
+		SetStatus(entinvoice.StatusIssued).
+		SetTotalAmountCents(4000).
+		Save(ctx); err != nil {
+		t.Fatalf("create invoice: %v", err)
+	}
+	if err := paysvc.New(client).ApplyCreditToOldestInvoices(ctx, to.ID); err != nil {
+		t.Fatalf("ApplyCreditToOldestInvoices: %v", err)
+	}
+
+	payers, err := New(client).List(ctx, 2025)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(payers) != 1 || payers[0].Name != "Anna Ozola" || payers[0].Total != 100 {
+		t.Fatalf("payers = %+v, want only Anna Ozola with the 100.00 she paid", payers)
+	}
+}
+
+func TestListCountsCreditAppliedToInvoices(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:taxcert-credit-applied?mode=memory&_fk=1")
+	defer client.Close()
+
+	st, err := client.Student.Create().SetFullName("Pēteris Kalns").SetPersonalCode("020290-30000").Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	if _, err := client.Payment.Create().
+		SetStudentID(st.ID).
+		SetAmountCents(10000).
+		SetMethod(entpayment.MethodBank).
+		SetPaidAt(time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)).
+		Save(ctx); err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+	if _, err := client.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2025).
+		SetPeriodMonth(4).
+		SetStatus(entinvoice.StatusIssued).
+		SetTotalAmountCents(6000).
+		Save(ctx); err != nil {
+		t.Fatalf("create invoice: %v", err)
+	}
+	if err := paysvc.New(client).ApplyCreditToOldestInvoices(ctx, st.ID); err != nil {
+		t.Fatalf("ApplyCreditToOldestInvoices: %v", err)
+	}
+	applied, err := client.Payment.Query().Where(entpayment.KindEQ(entpayment.KindCreditApplied)).Count(ctx)
+	if err != nil || applied != 1 {
+		t.Fatalf("credit_applied rows = %d, %v, want 1", applied, err)
+	}
+
+	payers, err := New(client).List(ctx, 2025)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(payers) != 1 || payers[0].Total != 100 {
+		t.Fatalf("payers = %+v, want the 100.00 paid before the credit was applied", payers)
+	}
+}
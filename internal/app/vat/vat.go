@@ -0,0 +1,111 @@
+// Package vat computes the VAT breakdown of an invoice. Line amounts are
+// stored net of VAT; the tax is calculated once per rate, as EN 16931
+// requires, so the printed breakdown and the e-invoice always agree.
+package vat
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"langschool/ent"
+	"langschool/internal/money"
+)
+
+// DefaultExemptNote is printed for zero-rated lines of a VAT payer when the
+// course has no specific exemption note.
+const DefaultExemptNote = "Atbrīvots no PVN"
+
+// Line is the VAT-relevant part of an invoice line.
+type Line struct {
+	NetCents   int64
+	RatePct    float64
+	ExemptNote string
+}
+
+// FromInvoiceLines extracts the VAT-relevant fields of stored invoice lines.
+func FromInvoiceLines(lines []*ent.InvoiceLine) []Line {
+	out := make([]Line, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, Line{NetCents: l.AmountCents, RatePct: l.VatRatePct, ExemptNote: l.VatExemptNote})
+	}
+	return out
+}
+
+// Group is one row of the VAT breakdown: all lines sharing a rate (and, for
+// zero-rated lines, an exemption note).
+type Group struct {
+	RatePct    float64
+	ExemptNote string
+	NetCents   int64
+	VATCents   int64
+}
+
+// GrossCents returns the group amount including VAT.
+func (g Group) GrossCents() int64 { return g.NetCents + g.VATCents }
+
+// Amount returns the VAT on a net amount, rounded to whole cents.
+func Amount(netCents int64, ratePct float64) int64 {
+	return money.MulFloatToCents(ratePct/100, netCents)
+}
+
+// Breakdown groups lines by rate, highest rate first.
+func Breakdown(lines []Line) []Group {
+	type key struct {
+		rate int64
+		note string
+	}
+	index := map[key]int{}
+	var groups []Group
+	for _, ln := range lines {
+		note := ""
+		if ln.RatePct == 0 {
+			note = strings.TrimSpace(ln.ExemptNote)
+		}
+		k := key{rate: int64(math.Round(ln.RatePct * 100)), note: note}
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, Group{RatePct: ln.RatePct, ExemptNote: note})
+		}
+		groups[i].NetCents += ln.NetCents
+	}
+	for i := range groups {
+		groups[i].VATCents = Amount(groups[i].NetCents, groups[i].RatePct)
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].RatePct > groups[j].RatePct })
+	return groups
+}
+
+// Totals sums a breakdown into net and VAT amounts.
+func Totals(groups []Group) (netCents, vatCents int64) {
+	for _, g := range groups {
+		netCents += g.NetCents
+		vatCents += g.VATCents
+	}
+	return netCents, vatCents
+}
+
+// Applies reports whether any line carries VAT information, i.e. whether the
+// invoice was issued by a VAT payer and must show a breakdown.
+func Applies(lines []Line) bool {
+	for _, ln := range lines {
+		if ln.RatePct > 0 || strings.TrimSpace(ln.ExemptNote) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRate checks a VAT rate in percent.
+func ValidateRate(ratePct float64) error {
+	if math.IsNaN(ratePct) || ratePct < 0 || ratePct > 100 {
+		return errors.New("vatRate must be between 0 and 100")
+	}
+	if math.Abs(ratePct*100-math.Round(ratePct*100)) > 1e-9 {
+		return errors.New("vatRate must have at most 2 decimals")
+	}
+	return nil
+}
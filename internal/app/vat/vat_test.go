@@ -0,0 +1,51 @@
+package vat
+
+import "testing"
+
+func TestBreakdownGroupsByRateAndRoundsPerGroup(t *testing.T) {
+	groups := Breakdown([]Line{
+		{NetCents: 333, RatePct: 21},
+		{NetCents: 333, RatePct: 21},
+		{NetCents: 500, RatePct: 0, ExemptNote: "PVN likuma 52. pants"},
+		{NetCents: 1000, RatePct: 12},
+	})
+	if len(groups) != 3 {
+		t.Fatalf("groups = %+v, want 3", groups)
+	}
+	// 6.66 × 21% = 1.3986 → 1.40; rounding per line would give 0.70 + 0.70.
+	if g := groups[0]; g.RatePct != 21 || g.NetCents != 666 || g.VATCents != 140 || g.GrossCents() != 806 {
+		t.Fatalf("21%% group = %+v", g)
+	}
+	if g := groups[1]; g.RatePct != 12 || g.VATCents != 120 {
+		t.Fatalf("12%% group = %+v", g)
+	}
+	if g := groups[2]; g.RatePct != 0 || g.VATCents != 0 || g.ExemptNote != "PVN likuma 52. pants" {
+		t.Fatalf("0%% group = %+v", g)
+	}
+	net, tax := Totals(groups)
+	if net != 2166 || tax != 260 {
+		t.Fatalf("totals = %d/%d, want 2166/260", net, tax)
+	}
+}
+
+func TestApplies(t *testing.T) {
+	if Applies([]Line{{NetCents: 100}}) {
+		t.Fatal("plain lines should not need a VAT breakdown")
+	}
+	if !Applies([]Line{{NetCents: 100, ExemptNote: DefaultExemptNote}}) {
+		t.Fatal("exempt lines of a VAT payer need a breakdown")
+	}
+}
+
+func TestValidateRate(t *testing.T) {
+	for _, rate := range []float64{0, 5, 12, 21, 9.5} {
+		if err := ValidateRate(rate); err != nil {
+			t.Fatalf("ValidateRate(%v) error = %v", rate, err)
+		}
+	}
+	for _, rate := range []float64{-1, 101, 21.005} {
+		if err := ValidateRate(rate); err == nil {
+			t.Fatalf("ValidateRate(%v) expected error", rate)
+		}
+	}
+}
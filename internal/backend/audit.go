@@ -10,6 +10,7 @@ import (
 	"langschool/ent"
 	"langschool/ent/invoice"
 	auditsvc "langschool/internal/app/audit"
+	paysvc "langschool/internal/app/payment"
 	"langschool/internal/auth"
 	"langschool/internal/money"
 )
@@ -31,10 +32,11 @@ type auditInvoiceMeta struct {
 }
 
 type auditPaymentMeta struct {
-	StudentID   int
-	StudentName string
-	Amount      float64
-	InvoiceID   *int
+	StudentID     int
+	StudentName   string
+	Amount        float64
+	InvoiceID     *int
+	CashReceiptID *int
 }
 
 type backendStudentRef struct {
@@ -165,11 +167,19 @@ func (s *Service) auditPaymentDeleteSnapshot(ctx context.Context, paymentID int)
 		paymentInvoiceID = intPtr(*paymentItem.InvoiceID)
 	}
 	before["deletedPayment"] = toAuditPaymentDTO(paymentItem)
+	if paymentItem.CashReceiptID != nil {
+		receipt, err := s.rt.Payment.GetCashReceipt(ctx, *paymentItem.CashReceiptID)
+		if err != nil {
+			return nil, auditPaymentMeta{}, err
+		}
+		before["cashReceipt"] = receipt
+	}
 	return before, auditPaymentMeta{
-		StudentID:   paymentItem.StudentID,
-		StudentName: studentMeta.StudentName,
-		Amount:      money.CentsToEuros(paymentItem.AmountCents),
-		InvoiceID:   paymentInvoiceID,
+		StudentID:     paymentItem.StudentID,
+		StudentName:   studentMeta.StudentName,
+		Amount:        money.CentsToEuros(paymentItem.AmountCents),
+		InvoiceID:     paymentInvoiceID,
+		CashReceiptID: paymentItem.CashReceiptID,
 	}, nil
 }
 
@@ -232,7 +242,11 @@ type contextKey string
 
 const actorKey contextKey = "auditActor"
 
+// WithActor attributes audit entries, and cash receipts, to the user.
 func WithActor(ctx context.Context, currentUser *auth.UserInfo) context.Context {
+	if currentUser != nil {
+		ctx = paysvc.WithReceivedBy(ctx, intPtr(currentUser.ID), currentUser.Username)
+	}
 	return context.WithValue(ctx, actorKey, currentUser)
 }
 
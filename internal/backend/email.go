@@ -16,6 +16,7 @@ import (
 	auditsvc "langschool/internal/app/audit"
 	invsvc "langschool/internal/app/invoice"
 	"langschool/internal/app/recipient"
+	"langschool/internal/app/reference"
 	"langschool/internal/email"
 	appruntime "langschool/internal/runtime"
 )
@@ -27,6 +28,7 @@ var invoiceEmailAvailablePlaceholders = []string{
 	"{year}",
 	"{amount}",
 	"{org_name}",
+	"{payment_reference}",
 }
 
 type InvoiceEmailPreviewResult struct {
@@ -283,12 +285,13 @@ func renderInvoiceEmailTemplate(template string, dto *InvoiceDTO, orgName string
 		recipientName = strings.TrimSpace(dto.StudentName)
 	}
 	replacements := map[string]string{
-		"{recipient_name}": recipientName,
-		"{invoice_number}": number,
-		"{month_name}":     lvInvoiceMonthName(dto.Month),
-		"{year}":           strconv.Itoa(dto.Year),
-		"{amount}":         fmt.Sprintf("%.2f", dto.Total),
-		"{org_name}":       strings.TrimSpace(orgName),
+		"{recipient_name}":    recipientName,
+		"{invoice_number}":    number,
+		"{month_name}":        lvInvoiceMonthName(dto.Month),
+		"{year}":              strconv.Itoa(dto.Year),
+		"{amount}":            fmt.Sprintf("%.2f", dto.Total),
+		"{org_name}":          strings.TrimSpace(orgName),
+		"{payment_reference}": reference.Format(dto.PaymentReference),
 	}
 	out := template
 	for placeholder, value := range replacements {
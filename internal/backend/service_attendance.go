@@ -44,13 +44,31 @@ func (s *Service) AttendanceAddOne(ctx context.Context, year, month int, courseI
 	return s.rt.Attendance.AddOneForFilter(ctx, year, month, courseID)
 }
 
+// EnrollmentDelete removes an enrollment and offers the freed seat to the
+// course's waiting list.
 func (s *Service) EnrollmentDelete(ctx context.Context, enrollmentID int) error {
-	return s.rt.Attendance.DeleteEnrollment(ctx, enrollmentID)
+	item, err := s.rt.DB.Ent.Enrollment.Get(ctx, enrollmentID)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.Attendance.DeleteEnrollment(ctx, enrollmentID); err != nil {
+		return err
+	}
+	_, err = s.promoteWaitlist(ctx, item.CourseID)
+	return err
 }
 
 func (s *Service) EnrollmentDeleteWithVersion(ctx context.Context, enrollmentID, version int) error {
 	if err := validateVersion(version); err != nil {
 		return err
 	}
-	return s.rt.Attendance.DeleteEnrollmentWithVersion(ctx, enrollmentID, version)
+	item, err := s.rt.DB.Ent.Enrollment.Get(ctx, enrollmentID)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.Attendance.DeleteEnrollmentWithVersion(ctx, enrollmentID, version); err != nil {
+		return err
+	}
+	_, err = s.promoteWaitlist(ctx, item.CourseID)
+	return err
 }
@@ -3,9 +3,12 @@ package backend
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/app/dataexport"
 	"langschool/internal/auth"
 	appruntime "langschool/internal/runtime"
 )
@@ -86,6 +89,29 @@ func (s *Service) FullBackupNow() (string, error) {
 	return path, nil
 }
 
+// DataExport collects the open-format export of every record from one read
+// transaction, so that the tables agree with each other.
+func (s *Service) DataExport(ctx context.Context) (*dataexport.Export, error) {
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	export, err := dataexport.Build(ctx, tx.Client(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "export",
+		Action:     "data.export",
+		Summary:    fmt.Sprintf("Exported all data: %d students, %d invoices, %d payments", len(export.Students), len(export.Invoices), len(export.Payments)),
+	})
+	return export, nil
+}
+
 func (s *Service) UserList(ctx context.Context) ([]UserDTO, error) {
 	return s.rt.Auth.ListUsers(ctx)
 }
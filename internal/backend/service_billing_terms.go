@@ -0,0 +1,71 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	auditsvc "langschool/internal/app/audit"
+)
+
+func (s *Service) BillingTermList(ctx context.Context, courseID *int) ([]BillingTermDTO, error) {
+	return s.rt.Invoice.ListTerms(ctx, courseID)
+}
+
+func (s *Service) BillingTermGet(ctx context.Context, id int) (*BillingTermDTO, error) {
+	return s.rt.Invoice.GetTerm(ctx, id)
+}
+
+func (s *Service) BillingTermCreate(ctx context.Context, in BillingTermInput) (*BillingTermDTO, error) {
+	item, err := s.rt.Invoice.CreateTerm(ctx, in, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "billing_term",
+		EntityID:   intPtr(item.ID),
+		Action:     "billing_term.create",
+		Summary:    fmt.Sprintf("Created billing term %s, %04d-%02d to %04d-%02d, %g lessons", item.Name, item.StartYear, item.StartMonth, item.EndYear, item.EndMonth, item.Lessons),
+		After:      item,
+	})
+	return item, nil
+}
+
+// BillingTermUpdate changes a term that has no issued invoices yet.
+func (s *Service) BillingTermUpdate(ctx context.Context, id int, in BillingTermInput) (*BillingTermDTO, error) {
+	before, err := s.rt.Invoice.GetTerm(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.UpdateTerm(ctx, id, in)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "billing_term",
+		EntityID:   intPtr(item.ID),
+		Action:     "billing_term.update",
+		Summary:    fmt.Sprintf("Updated billing term %s", item.Name),
+		Before:     before,
+		After:      item,
+	})
+	return item, nil
+}
+
+// BillingTermDelete removes a term with no issued invoices and its drafts.
+func (s *Service) BillingTermDelete(ctx context.Context, id int) error {
+	before, err := s.rt.Invoice.GetTerm(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.Invoice.DeleteTerm(ctx, id); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "billing_term",
+		EntityID:   intPtr(id),
+		Action:     "billing_term.delete",
+		Summary:    fmt.Sprintf("Deleted billing term %s", before.Name),
+		Before:     before,
+	})
+	return nil
+}
@@ -0,0 +1,118 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/app/cashdesk"
+	appruntime "langschool/internal/runtime"
+)
+
+func cashDeskUser(ctx context.Context) cashdesk.User {
+	currentUser := actorFromContext(ctx)
+	if currentUser == nil {
+		return cashdesk.User{}
+	}
+	return cashdesk.User{ID: intPtr(currentUser.ID), Name: currentUser.Username}
+}
+
+func (s *Service) CashSessionList(ctx context.Context, limit int) ([]CashSessionDTO, error) {
+	return s.rt.CashDesk.List(ctx, limit)
+}
+
+// CashSessionCurrent returns the open cash session, or nil when none is open.
+func (s *Service) CashSessionCurrent(ctx context.Context) (*CashSessionDTO, error) {
+	return s.rt.CashDesk.Current(ctx)
+}
+
+func (s *Service) CashSessionReport(ctx context.Context, id int) (*CashSessionReportDTO, error) {
+	return s.rt.CashDesk.Report(ctx, id)
+}
+
+func (s *Service) CashSessionOpen(ctx context.Context, openingFloat float64, note string) (*CashSessionDTO, error) {
+	item, err := s.rt.CashDesk.Open(ctx, openingFloat, note, cashDeskUser(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "cash_session",
+		EntityID:   intPtr(item.ID),
+		Action:     "cash_session.open",
+		Summary:    fmt.Sprintf("Opened cash session %d with float %.2f", item.ID, item.OpeningFloat),
+		After:      item,
+	})
+	return item, nil
+}
+
+func (s *Service) CashSessionAddMovement(ctx context.Context, id int, kind string, amount float64, note string) (*CashMovementDTO, error) {
+	item, err := s.rt.CashDesk.AddMovement(ctx, id, kind, amount, note, cashDeskUser(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "cash_session",
+		EntityID:   intPtr(id),
+		Action:     "cash_session.movement",
+		Summary:    fmt.Sprintf("Recorded %s of %.2f in cash session %d", item.Kind, item.Amount, id),
+		After:      item,
+	})
+	return item, nil
+}
+
+// CashSessionClose records the counted cash and returns the Z-report.
+func (s *Service) CashSessionClose(ctx context.Context, id int, counted float64, note string) (*CashSessionReportDTO, error) {
+	report, err := s.rt.CashDesk.Close(ctx, id, counted, note, cashDeskUser(ctx))
+	if err != nil {
+		return nil, err
+	}
+	session := report.Session
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "cash_session",
+		EntityID:   intPtr(id),
+		Action:     "cash_session.close",
+		Summary:    fmt.Sprintf("Closed cash session %d: expected %.2f, counted %.2f", id, session.Expected, *session.Counted),
+		After:      session,
+	})
+	return report, nil
+}
+
+func (s *Service) CashSessionReopen(ctx context.Context, id int) (*CashSessionDTO, error) {
+	before, err := s.rt.CashDesk.Report(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.CashDesk.Reopen(ctx, id, cashDeskUser(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "cash_session",
+		EntityID:   intPtr(id),
+		Action:     "cash_session.reopen",
+		Summary:    fmt.Sprintf("Reopened cash session %d", id),
+		Before:     before.Session,
+		After:      item,
+	})
+	return item, nil
+}
+
+func (s *Service) CashSessionReportPDF(ctx context.Context, id int) ([]byte, string, error) {
+	report, err := s.rt.CashDesk.Report(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.rt.CashDesk.ReportPDF(ctx, report, fonts)
+}
+
+func (s *Service) CashSessionReportCSV(ctx context.Context, id int) ([]byte, string, error) {
+	report, err := s.rt.CashDesk.Report(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	return cashdesk.ReportCSV(report)
+}
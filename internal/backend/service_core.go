@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"html"
@@ -17,6 +18,7 @@ import (
 	"langschool/ent"
 	sharedapp "langschool/internal/app"
 	"langschool/internal/app/attendance"
+	"langschool/internal/app/cashdesk"
 	invsvc "langschool/internal/app/invoice"
 	paysvc "langschool/internal/app/payment"
 	"langschool/internal/apperrors"
@@ -28,6 +30,9 @@ import (
 var (
 	personalCodePattern = regexp.MustCompile(`^\d{6}-\d{5}$`)
 	phonePattern        = regexp.MustCompile(`^[+\d][\d\s().-]*$`)
+	ibanPattern         = regexp.MustCompile(`^[A-Z]{2}\d{2}[A-Z0-9]+$`)
+	bicPattern          = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	vatNumberPattern    = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{2,13}$`)
 )
 
 const (
@@ -35,28 +40,47 @@ const (
 	CourseTypeIndividual    = sharedapp.CourseTypeIndividual
 	BillingModeSubscription = sharedapp.BillingModeSubscription
 	BillingModePerLesson    = sharedapp.BillingModePerLesson
+	BillingModePackage      = sharedapp.BillingModePackage
+	BillingPeriodMonthly    = sharedapp.BillingPeriodMonthly
+	BillingPeriodTerm       = sharedapp.BillingPeriodTerm
+	BillingPeriodCustom     = sharedapp.BillingPeriodCustom
 
 	invoiceArchivePDFStatusReady    = invsvc.PDFStatusReady
 	invoiceArchivePDFStatusMissing  = invsvc.PDFStatusMissing
 	invoiceArchivePDFStatusOutdated = invsvc.PDFStatusOutdated
 	invoiceArchivePDFStatusError    = invsvc.PDFStatusError
+
+	invoiceLateFeeModeOff = invsvc.LateFeeModeOff
 )
 
 type StudentDTO struct {
-	ID           int     `json:"id"`
-	Version      int     `json:"version"`
-	FullName     string  `json:"fullName"`
-	CreatedAt    string  `json:"createdAt"`
-	PersonalCode string  `json:"personalCode"`
-	Phone        string  `json:"phone"`
-	Email        string  `json:"email"`
-	Note         string  `json:"note"`
-	IsMinor      bool    `json:"isMinor"`
-	PayerName    string  `json:"payerName"`
-	PayerRole    string  `json:"payerRole"`
-	IsActive     bool    `json:"isActive"`
-	Balance      float64 `json:"balance"`
-	Debt         float64 `json:"debt"`
+	ID                int              `json:"id"`
+	Version           int              `json:"version"`
+	FullName          string           `json:"fullName"`
+	CreatedAt         string           `json:"createdAt"`
+	PersonalCode      string           `json:"personalCode"`
+	Phone             string           `json:"phone"`
+	Email             string           `json:"email"`
+	Note              string           `json:"note"`
+	IsMinor           bool             `json:"isMinor"`
+	PayerName         string           `json:"payerName"`
+	PayerRole         string           `json:"payerRole"`
+	PayerPersonalCode string           `json:"payerPersonalCode"`
+	PayerType         string           `json:"payerType"`
+	CompanyPayer      *CompanyPayerDTO `json:"companyPayer,omitempty"`
+	IsActive          bool             `json:"isActive"`
+	Balance           float64          `json:"balance"`
+	Debt              float64          `json:"debt"`
+}
+
+// CompanyPayerDTO holds the legal details of an employer or other legal
+// entity paying for a student.
+type CompanyPayerDTO struct {
+	CompanyName  string `json:"companyName"`
+	RegNo        string `json:"regNo"`
+	VATNumber    string `json:"vatNumber"`
+	LegalAddress string `json:"legalAddress"`
+	BillingEmail string `json:"billingEmail"`
 }
 
 type StudentDuplicateCheckResult struct {
@@ -71,26 +95,78 @@ type CourseDTO struct {
 	TeacherID         *int    `json:"teacherId,omitempty"`
 	TeacherName       string  `json:"teacherName"`
 	Type              string  `json:"type"`
+	BillingPeriod     string  `json:"billingPeriod"` // monthly, term or custom
+	LessonPrice       float64 `json:"lessonPrice"`
+	SubscriptionPrice float64 `json:"subscriptionPrice"`
+	// Price changes scheduled for later months, earliest first.
+	UpcomingPrices []CoursePriceDTO `json:"upcomingPrices"`
+	VATRate        float64          `json:"vatRate"`
+	VATExemptNote  string           `json:"vatExemptNote"`
+	MaxStudents    int              `json:"maxStudents"` // 0 = no limit
+	// Set after a teacher change when the new teacher is double-booked by
+	// the course's future lessons.
+	ScheduleConflicts []ScheduleConflictDTO `json:"scheduleConflicts,omitempty"`
+}
+
+// CoursePriceDTO is one entry of a course's price history. The opening
+// entry, with no effective month, holds the prices that apply before the
+// first change.
+type CoursePriceDTO struct {
+	ID                int     `json:"id,omitempty"`
+	CourseID          int     `json:"courseId"`
+	EffectiveYear     int     `json:"effectiveYear,omitempty"`
+	EffectiveMonth    int     `json:"effectiveMonth,omitempty"`
+	LessonPrice       float64 `json:"lessonPrice"`
+	SubscriptionPrice float64 `json:"subscriptionPrice"`
+	Status            string  `json:"status"` // past, current or upcoming
+	CreatedBy         string  `json:"createdBy,omitempty"`
+	CreatedAt         string  `json:"createdAt,omitempty"`
+}
+
+// CoursePriceInput schedules course prices from a billing month onward.
+type CoursePriceInput struct {
+	Year              int     `json:"year"`
+	Month             int     `json:"month"`
 	LessonPrice       float64 `json:"lessonPrice"`
 	SubscriptionPrice float64 `json:"subscriptionPrice"`
 }
 
 type EnrollmentDTO struct {
-	ID                      int     `json:"id"`
-	Version                 int     `json:"version"`
-	StudentID               int     `json:"studentId"`
-	StudentName             string  `json:"studentName"`
-	CourseID                int     `json:"courseId"`
-	CourseName              string  `json:"courseName"`
-	CourseType              string  `json:"courseType"`
-	TeacherID               *int    `json:"teacherId,omitempty"`
-	TeacherName             string  `json:"teacherName"`
-	BillingMode             string  `json:"billingMode"`
-	ChargeMaterials         bool    `json:"chargeMaterials"`
-	LessonPriceOverride     float64 `json:"lessonPriceOverride"`
-	SubscriptionLessonPrice float64 `json:"subscriptionLessonPrice"`
-	Note                    string  `json:"note"`
-	CreatedAt               string  `json:"createdAt"`
+	ID                      int                  `json:"id"`
+	Version                 int                  `json:"version"`
+	StudentID               int                  `json:"studentId"`
+	StudentName             string               `json:"studentName"`
+	CourseID                int                  `json:"courseId"`
+	CourseName              string               `json:"courseName"`
+	CourseType              string               `json:"courseType"`
+	TeacherID               *int                 `json:"teacherId,omitempty"`
+	TeacherName             string               `json:"teacherName"`
+	BillingMode             string               `json:"billingMode"`
+	ChargeMaterials         bool                 `json:"chargeMaterials"`
+	LessonPriceOverride     float64              `json:"lessonPriceOverride"`
+	SubscriptionLessonPrice float64              `json:"subscriptionLessonPrice"`
+	Note                    string               `json:"note"`
+	StartsOn                string               `json:"startsOn,omitempty"` // first day, YYYY-MM-DD
+	EndsOn                  string               `json:"endsOn,omitempty"`   // last day, YYYY-MM-DD
+	Pauses                  []EnrollmentPauseDTO `json:"pauses"`
+	CreatedAt               string               `json:"createdAt"`
+}
+
+// EnrollmentPauseDTO is a period in which an enrollment is frozen.
+type EnrollmentPauseDTO struct {
+	ID           int    `json:"id"`
+	EnrollmentID int    `json:"enrollmentId"`
+	StartsOn     string `json:"startsOn"` // YYYY-MM-DD
+	EndsOn       string `json:"endsOn"`   // YYYY-MM-DD
+	Reason       string `json:"reason"`
+	CreatedBy    string `json:"createdBy"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type EnrollmentPauseInput struct {
+	StartsOn string
+	EndsOn   string
+	Reason   string
 }
 
 type StudentCreateInput struct {
@@ -111,6 +187,8 @@ type EnrollmentCreateInput struct {
 	LessonPriceOverride     float64
 	SubscriptionLessonPrice float64
 	Note                    string
+	StartsOn                string
+	EndsOn                  string
 }
 
 type StudentOnboardingResult struct {
@@ -119,6 +197,44 @@ type StudentOnboardingResult struct {
 	Enrollment  *EnrollmentDTO  `json:"enrollment,omitempty"`
 }
 
+// StudentImportPreviewDTO is a validated student import sheet. Mapping maps
+// column headers to StudentImportField* names.
+type StudentImportPreviewDTO struct {
+	Columns      []string              `json:"columns"`
+	Mapping      map[string]string     `json:"mapping"`
+	Fields       []string              `json:"fields"`
+	MappingError string                `json:"mappingError,omitempty"`
+	Rows         []StudentImportRowDTO `json:"rows"`
+	ValidRows    int                   `json:"validRows"`
+	InvalidRows  int                   `json:"invalidRows"`
+}
+
+// StudentImportRowDTO is one sheet row as it would be onboarded. Line is the
+// spreadsheet line number, counting the header as line 1.
+type StudentImportRowDTO struct {
+	Line                    int      `json:"line"`
+	FullName                string   `json:"fullName"`
+	PersonalCode            string   `json:"personalCode"`
+	Phone                   string   `json:"phone"`
+	Email                   string   `json:"email"`
+	Note                    string   `json:"note"`
+	IsMinor                 bool     `json:"isMinor"`
+	PayerName               string   `json:"payerName"`
+	PayerRole               string   `json:"payerRole"`
+	Courses                 []string `json:"courses"`
+	BillingMode             string   `json:"billingMode"`
+	StartsOn                string   `json:"startsOn"`
+	EndsOn                  string   `json:"endsOn"`
+	SubscriptionLessonPrice float64  `json:"subscriptionLessonPrice"`
+	Errors                  []string `json:"errors"`
+	Warnings                []string `json:"warnings"`
+}
+
+type StudentImportResult struct {
+	Students    []StudentOnboardingResult `json:"students"`
+	Enrollments int                       `json:"enrollments"`
+}
+
 type EnrollmentBulkCreateResult struct {
 	Enrollments      []EnrollmentDTO `json:"enrollments"`
 	SkippedCourseIDs []int           `json:"skippedCourseIds"`
@@ -139,6 +255,18 @@ type TeacherDTO struct {
 
 type InvoiceListItem = invsvc.ListItem
 type InvoiceDTO = invsvc.InvoiceDTO
+type VATSummaryDTO = invsvc.VATSummaryDTO
+type LateFeeDTO = invsvc.LateFeeDTO
+type LateFeePolicy = invsvc.LateFeePolicy
+type StudentChargeDTO = invsvc.ChargeDTO
+type StudentChargeInput = invsvc.ChargeInput
+type LessonPackageDTO = invsvc.PackageDTO
+type LessonPackageInput = invsvc.PackageInput
+type BillingTermDTO = invsvc.TermDTO
+type BillingTermInput = invsvc.TermInput
+type ExcusedAbsenceDTO = invsvc.AbsenceDTO
+type ExcusedAbsenceInput = invsvc.AbsenceInput
+type AbsencePolicy = invsvc.AbsencePolicy
 type PaymentDTO = paysvc.PaymentDTO
 type BalanceDTO = paysvc.BalanceDTO
 type DebtorDTO = paysvc.DebtorDTO
@@ -146,6 +274,16 @@ type InvoiceSummaryDTO = paysvc.InvoiceSummaryDTO
 type DebtInvoiceDTO = paysvc.DebtInvoiceDTO
 type MonthOverviewDTO = paysvc.MonthOverviewDTO
 type RecentPaymentDTO = paysvc.RecentPaymentDTO
+type BankMatchDTO = paysvc.BankMatchDTO
+type CashReceiptDTO = paysvc.CashReceiptDTO
+type CreditLedgerDTO = paysvc.CreditLedgerDTO
+type CreditTransferDTO = paysvc.CreditTransferDTO
+type ReallocationDTO = paysvc.ReallocationDTO
+type PaymentPlanDTO = paysvc.PlanDTO
+type PaymentPlanInput = paysvc.PlanInput
+type CashSessionDTO = cashdesk.SessionDTO
+type CashMovementDTO = cashdesk.MovementDTO
+type CashSessionReportDTO = cashdesk.ReportDTO
 type AttendanceRow = attendance.Row
 
 type IssueResult struct {
@@ -209,6 +347,237 @@ type InvoiceEmailSettingsDTO struct {
 	AvailablePlaceholders []string `json:"availablePlaceholders"`
 }
 
+type PaymentDetailsSettingsDTO struct {
+	BeneficiaryName string `json:"beneficiaryName"`
+	BankName        string `json:"bankName"`
+	BIC             string `json:"bic"`
+	IBAN            string `json:"iban"`
+	PaymentQR       bool   `json:"paymentQr"`
+}
+
+type VATSettingsDTO struct {
+	Enabled   bool   `json:"enabled"`
+	VATNumber string `json:"vatNumber"`
+}
+
+type LateFeeSettingsDTO struct {
+	Mode         string  `json:"mode"` // off, flat or daily_interest
+	FlatFee      float64 `json:"flatFee"`
+	DailyRatePct float64 `json:"dailyRatePct"`
+	GraceDays    int     `json:"graceDays"`
+	Cap          float64 `json:"cap"` // per overdue invoice; 0 = no cap
+}
+
+type ExcusedAbsenceSettingsDTO struct {
+	Policy      string `json:"policy"`      // makeup or credit
+	MakeupWeeks int    `json:"makeupWeeks"` // how long a makeup lesson may be taken
+}
+
+type WaitlistSettingsDTO struct {
+	OfferDays int `json:"offerDays"` // how long a seat offer stays open; 0 = enroll directly
+}
+
+// WaitlistEntryDTO is a student waiting for a seat in a full course.
+type WaitlistEntryDTO struct {
+	ID             int    `json:"id"`
+	CourseID       int    `json:"courseId"`
+	CourseName     string `json:"courseName"`
+	StudentID      int    `json:"studentId"`
+	StudentName    string `json:"studentName"`
+	Rank           int    `json:"rank,omitempty"` // place in line while waiting
+	BillingMode    string `json:"billingMode"`
+	Note           string `json:"note"`
+	Status         string `json:"status"` // waiting, offered, enrolled, declined or expired
+	OfferedAt      string `json:"offeredAt,omitempty"`
+	OfferExpiresAt string `json:"offerExpiresAt,omitempty"`
+	OfferEmailedTo string `json:"offerEmailedTo,omitempty"`
+	EnrollmentID   *int   `json:"enrollmentId,omitempty"`
+	CreatedBy      string `json:"createdBy"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+type WaitlistEntryInput struct {
+	CourseID    int    `json:"courseId"`
+	StudentID   int    `json:"studentId"`
+	BillingMode string `json:"billingMode"`
+	Note        string `json:"note"`
+}
+
+// LeadDTO is a prospective student in the trial-lesson pipeline.
+type LeadDTO struct {
+	ID            int     `json:"id"`
+	Version       int     `json:"version"`
+	FullName      string  `json:"fullName"`
+	PersonalCode  string  `json:"personalCode"`
+	Phone         string  `json:"phone"`
+	Email         string  `json:"email"`
+	IsMinor       bool    `json:"isMinor"`
+	PayerName     string  `json:"payerName"`
+	PayerRole     string  `json:"payerRole"`
+	Source        string  `json:"source"`
+	Note          string  `json:"note"`
+	Status        string  `json:"status"` // new, trial_scheduled, trial_done, converted or lost
+	CourseID      *int    `json:"courseId,omitempty"`
+	CourseName    string  `json:"courseName"`
+	TrialAt       string  `json:"trialAt,omitempty"` // YYYY-MM-DDTHH:MM
+	TrialPrice    float64 `json:"trialPrice"`        // 0 = free trial
+	TrialOutcome  string  `json:"trialOutcome,omitempty"`
+	OutcomeNote   string  `json:"outcomeNote"`
+	LostReason    string  `json:"lostReason"`
+	StudentID     *int    `json:"studentId,omitempty"`
+	StudentName   string  `json:"studentName"`
+	ConvertedAt   string  `json:"convertedAt,omitempty"`
+	TrialChargeID *int    `json:"trialChargeId,omitempty"`
+	CreatedBy     string  `json:"createdBy"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+type LeadInput struct {
+	FullName     string `json:"fullName"`
+	PersonalCode string `json:"personalCode"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+	IsMinor      bool   `json:"isMinor"`
+	PayerName    string `json:"payerName"`
+	PayerRole    string `json:"payerRole"`
+	Source       string `json:"source"`
+	Note         string `json:"note"`
+	CourseID     int    `json:"courseId"` // 0 = not decided yet
+}
+
+type LeadTrialInput struct {
+	CourseID   int     `json:"courseId"`
+	TrialAt    string  `json:"trialAt"` // YYYY-MM-DD or YYYY-MM-DDTHH:MM
+	TrialPrice float64 `json:"trialPrice"`
+}
+
+// LeadConvertInput turns a lead into a student. An empty student takes the
+// lead's details; StudentID converts the lead into an existing student.
+type LeadConvertInput struct {
+	Student          StudentCreateInput
+	Enrollments      []EnrollmentCreateInput
+	StudentID        int
+	IgnoreDuplicates bool
+}
+
+type LeadConversionResult struct {
+	Lead        LeadDTO           `json:"lead"`
+	Student     StudentDTO        `json:"student"`
+	Enrollments []EnrollmentDTO   `json:"enrollments"`
+	TrialCharge *StudentChargeDTO `json:"trialCharge,omitempty"`
+}
+
+// LeadFunnelRow counts the leads of a course or a month by how far they
+// got. ConversionRate is the percentage of leads that became students.
+type LeadFunnelRow struct {
+	CourseID       *int    `json:"courseId,omitempty"`
+	CourseName     string  `json:"courseName,omitempty"`
+	Month          int     `json:"month,omitempty"`
+	Leads          int     `json:"leads"`
+	Trials         int     `json:"trials"`
+	Attended       int     `json:"attended"`
+	Converted      int     `json:"converted"`
+	Lost           int     `json:"lost"`
+	ConversionRate float64 `json:"conversionRate"`
+}
+
+// LeadFunnelDTO reports on the leads created in a year.
+type LeadFunnelDTO struct {
+	Year     int             `json:"year"`
+	ByCourse []LeadFunnelRow `json:"byCourse"`
+	ByMonth  []LeadFunnelRow `json:"byMonth"`
+	Total    LeadFunnelRow   `json:"total"`
+}
+
+type RoomDTO struct {
+	ID        int    `json:"id"`
+	Version   int    `json:"version"`
+	Name      string `json:"name"`
+	Capacity  int    `json:"capacity"` // 0 = not recorded
+	Note      string `json:"note"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type RoomInput struct {
+	Name     string `json:"name"`
+	Capacity int    `json:"capacity"`
+	Note     string `json:"note"`
+}
+
+// ScheduleSlotDTO is a weekly lesson of a course. TeacherID is the teacher
+// giving it: the course's teacher unless TeacherOverride is set.
+type ScheduleSlotDTO struct {
+	ID              int    `json:"id"`
+	Version         int    `json:"version"`
+	CourseID        int    `json:"courseId"`
+	CourseName      string `json:"courseName"`
+	RoomID          *int   `json:"roomId,omitempty"`
+	RoomName        string `json:"roomName"`
+	TeacherID       *int   `json:"teacherId,omitempty"`
+	TeacherName     string `json:"teacherName"`
+	TeacherOverride bool   `json:"teacherOverride"`
+	Weekday         int    `json:"weekday"`  // 1 = Monday
+	StartsAt        string `json:"startsAt"` // HH:MM
+	EndsAt          string `json:"endsAt"`   // HH:MM
+	ValidFrom       string `json:"validFrom"`
+	ValidUntil      string `json:"validUntil,omitempty"`
+	Note            string `json:"note"`
+	CreatedBy       string `json:"createdBy"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+// ScheduleSlotInput places a weekly lesson. Zero RoomID leaves the room
+// open and zero TeacherID means the course's teacher. A slot that
+// double-books a room or teacher is refused unless AllowConflicts is set.
+type ScheduleSlotInput struct {
+	CourseID       int    `json:"courseId"`
+	RoomID         int    `json:"roomId"`
+	TeacherID      int    `json:"teacherId"`
+	Weekday        int    `json:"weekday"`
+	StartsAt       string `json:"startsAt"`
+	EndsAt         string `json:"endsAt"`
+	ValidFrom      string `json:"validFrom"`  // YYYY-MM-DD; defaults to today
+	ValidUntil     string `json:"validUntil"` // YYYY-MM-DD; empty = open-ended
+	Note           string `json:"note"`
+	AllowConflicts bool   `json:"allowConflicts"`
+}
+
+// ScheduleConflictDTO is a room or teacher booked by two overlapping slots
+// from a date on.
+type ScheduleConflictDTO struct {
+	Kind            string `json:"kind"` // room or teacher
+	Resource        string `json:"resource"`
+	SlotID          int    `json:"slotId"`
+	CourseName      string `json:"courseName"`
+	OtherSlotID     int    `json:"otherSlotId"`
+	OtherCourseName string `json:"otherCourseName"`
+	Weekday         int    `json:"weekday"`
+	StartsAt        string `json:"startsAt"` // of the other slot
+	EndsAt          string `json:"endsAt"`
+	From            string `json:"from"` // first week both slots run
+}
+
+type ScheduleSlotResult struct {
+	Slot      ScheduleSlotDTO       `json:"slot"`
+	Conflicts []ScheduleConflictDTO `json:"conflicts"`
+}
+
+// RoomOccupancyDTO lists the lessons in a room in a week, Monday first.
+type RoomOccupancyDTO struct {
+	RoomID        int                   `json:"roomId"`
+	RoomName      string                `json:"roomName"`
+	WeekStart     string                `json:"weekStart"`
+	Days          []RoomOccupancyDayDTO `json:"days"`
+	BookedMinutes int                   `json:"bookedMinutes"`
+}
+
+type RoomOccupancyDayDTO struct {
+	Date          string            `json:"date"`
+	Weekday       int               `json:"weekday"`
+	Slots         []ScheduleSlotDTO `json:"slots"`
+	BookedMinutes int               `json:"bookedMinutes"`
+}
+
 type InvoiceArchiveInvoiceDTO struct {
 	InvoiceID     int     `json:"invoiceId"`
 	Year          int     `json:"year"`
@@ -311,6 +680,32 @@ func validateVersion(version int) error {
 	return nil
 }
 
+// inTx runs fn with a transaction client, or with the service's own client
+// when it already belongs to a transaction.
+func (s *Service) inTx(ctx context.Context, fn func(client *ent.Client) error) error {
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		if err == ent.ErrTxStarted {
+			return fn(s.rt.DB.Ent)
+		}
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+	if err := fn(tx.Client()); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
 func staleOnNotFound(err error) error {
 	if ent.IsNotFound(err) {
 		return apperrors.StaleRevision()
@@ -332,6 +727,13 @@ func validatePrices(lessonPrice, subscriptionPrice float64) error {
 	return nil
 }
 
+func validateBillingPeriod(period string) error {
+	if period != BillingPeriodMonthly && period != BillingPeriodTerm && period != BillingPeriodCustom {
+		return fmt.Errorf("billingPeriod must be '%s', '%s' or '%s'", BillingPeriodMonthly, BillingPeriodTerm, BillingPeriodCustom)
+	}
+	return nil
+}
+
 func validateCourseType(courseType string) error {
 	if courseType != CourseTypeGroup && courseType != CourseTypeIndividual {
 		return fmt.Errorf("courseType must be '%s' or '%s'", CourseTypeGroup, CourseTypeIndividual)
@@ -340,8 +742,8 @@ func validateCourseType(courseType string) error {
 }
 
 func validateBillingMode(billingMode string) error {
-	if billingMode != BillingModeSubscription && billingMode != BillingModePerLesson {
-		return fmt.Errorf("billingMode must be '%s' or '%s'", BillingModeSubscription, BillingModePerLesson)
+	if billingMode != BillingModeSubscription && billingMode != BillingModePerLesson && billingMode != BillingModePackage {
+		return fmt.Errorf("billingMode must be '%s', '%s' or '%s'", BillingModeSubscription, BillingModePerLesson, BillingModePackage)
 	}
 	return nil
 }
@@ -461,6 +863,50 @@ func validateEmail(value string) error {
 	return nil
 }
 
+func validateIBAN(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) < 15 || len(value) > 34 || !ibanPattern.MatchString(value) {
+		return errors.New("iban is invalid")
+	}
+	rearranged := value[4:] + value[:4]
+	remainder := 0
+	for _, r := range rearranged {
+		digit := int(r - '0')
+		if r >= 'A' && r <= 'Z' {
+			digit = int(r-'A') + 10
+			remainder = (remainder*100 + digit) % 97
+			continue
+		}
+		remainder = (remainder*10 + digit) % 97
+	}
+	if remainder != 1 {
+		return errors.New("iban is invalid")
+	}
+	return nil
+}
+
+func validateVATNumber(value string) error {
+	if value == "" {
+		return nil
+	}
+	if !vatNumberPattern.MatchString(value) {
+		return errors.New("vatNumber must start with a country code, e.g. LV40003000000")
+	}
+	return nil
+}
+
+func validateBIC(value string) error {
+	if value == "" {
+		return nil
+	}
+	if !bicPattern.MatchString(value) {
+		return errors.New("bic must be 8 or 11 characters")
+	}
+	return nil
+}
+
 func validateUILocale(locale string) error {
 	switch strings.TrimSpace(locale) {
 	case "lv-LV", "ru-RU", "en-US":
@@ -502,23 +948,27 @@ const (
 	CapabilityDeletePayments = "deletePayments"
 	CapabilityDeleteStudents = "deleteStudents"
 	CapabilityDeleteCourses  = "deleteCourses"
+	// CapabilityReopenCashSessions allows unlocking the payments of a closed
+	// cash session.
+	CapabilityReopenCashSessions = "reopenCashSessions"
 )
 
 func CapabilitiesForRole(role string) map[string]bool {
 	isAdmin := role == auth.RoleAdmin
 	isOperationalUser := isAdmin || role == auth.RoleStaff
 	return map[string]bool{
-		CapabilityBackups:        isOperationalUser,
-		"emailSend":              true,
-		"invoiceArchive":         true,
-		"pdfDownload":            true,
-		"pdfGenerate":            true,
-		CapabilityManageUsers:    isAdmin,
-		CapabilityManageSettings: isOperationalUser,
-		CapabilityViewAuditLog:   isAdmin,
-		CapabilityDeletePayments: isOperationalUser,
-		CapabilityDeleteStudents: isOperationalUser,
-		CapabilityDeleteCourses:  isOperationalUser,
+		CapabilityBackups:            isOperationalUser,
+		"emailSend":                  true,
+		"invoiceArchive":             true,
+		"pdfDownload":                true,
+		"pdfGenerate":                true,
+		CapabilityManageUsers:        isAdmin,
+		CapabilityManageSettings:     isOperationalUser,
+		CapabilityViewAuditLog:       isAdmin,
+		CapabilityDeletePayments:     isOperationalUser,
+		CapabilityDeleteStudents:     isOperationalUser,
+		CapabilityDeleteCourses:      isOperationalUser,
+		CapabilityReopenCashSessions: isAdmin,
 	}
 }
 
@@ -0,0 +1,283 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/courseprice"
+	auditsvc "langschool/internal/app/audit"
+	invsvc "langschool/internal/app/invoice"
+	"langschool/internal/money"
+)
+
+var courseCurrentTime = time.Now
+
+func currentCoursePricePeriod() (int, int) {
+	now := courseCurrentTime()
+	return now.Year(), int(now.Month())
+}
+
+func pricePeriodIndex(year, month int) int {
+	return year*12 + month - 1
+}
+
+// coursePricePeriodIndex orders a course's opening prices before every change.
+func coursePricePeriodIndex(ch *ent.CoursePrice) int {
+	if ch.EffectiveYear == nil || ch.EffectiveMonth == nil {
+		return -1
+	}
+	return pricePeriodIndex(*ch.EffectiveYear, *ch.EffectiveMonth)
+}
+
+// CoursePriceHistory lists a course's prices from the opening prices to the
+// last scheduled change, each marked past, current or upcoming.
+func (s *Service) CoursePriceHistory(ctx context.Context, courseID int) ([]CoursePriceDTO, error) {
+	c, err := s.rt.DB.Ent.Course.Query().Where(course.IDEQ(courseID)).WithPrices().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	year, month := currentCoursePricePeriod()
+	now := pricePeriodIndex(year, month)
+	changes := sortedCoursePrices(c.Edges.Prices)
+
+	out := make([]CoursePriceDTO, 0, len(changes)+1)
+	if len(changes) == 0 {
+		out = append(out, CoursePriceDTO{
+			CourseID:          c.ID,
+			LessonPrice:       money.CentsToEuros(c.LessonPriceCents),
+			SubscriptionPrice: money.CentsToEuros(c.SubscriptionPriceCents),
+			Status:            "current",
+		})
+	}
+	for _, ch := range changes {
+		dto := toCoursePriceDTO(ch, now)
+		if dto.Status != "upcoming" {
+			// Only the latest change in force is current.
+			for i := range out {
+				if out[i].Status == "current" {
+					out[i].Status = "past"
+				}
+			}
+			dto.Status = "current"
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
+
+// CoursePriceSchedule sets a course's prices from a billing month onward,
+// replacing any change already scheduled for that month. Months already past
+// keep their prices so rebuilt drafts of them are not repriced.
+func (s *Service) CoursePriceSchedule(ctx context.Context, courseID int, in CoursePriceInput) ([]CoursePriceDTO, error) {
+	if in.Month < 1 || in.Month > 12 {
+		return nil, errors.New("month must be between 1 and 12")
+	}
+	if err := validatePrices(in.LessonPrice, in.SubscriptionPrice); err != nil {
+		return nil, err
+	}
+	year, month := currentCoursePricePeriod()
+	if pricePeriodIndex(in.Year, in.Month) < pricePeriodIndex(year, month) {
+		return nil, errors.New("нельзя менять цены прошедших месяцев")
+	}
+	var event auditsvc.RecordEvent
+	if err := s.inTx(ctx, func(client *ent.Client) error {
+		var err error
+		event, err = setCoursePriceInStore(ctx, client, courseID, in)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, event)
+	return s.CoursePriceHistory(ctx, courseID)
+}
+
+// CoursePriceDelete cancels a price change that has not come into force yet.
+func (s *Service) CoursePriceDelete(ctx context.Context, courseID, priceID int) ([]CoursePriceDTO, error) {
+	item, err := s.rt.DB.Ent.CoursePrice.Query().
+		Where(courseprice.IDEQ(priceID), courseprice.CourseIDEQ(courseID)).
+		WithCourse().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	year, month := currentCoursePricePeriod()
+	if coursePricePeriodIndex(item) <= pricePeriodIndex(year, month) {
+		return nil, errors.New("нельзя отменить цену, которая уже действует")
+	}
+	if err := s.inTx(ctx, func(client *ent.Client) error {
+		if err := client.CoursePrice.DeleteOneID(item.ID).Exec(ctx); err != nil {
+			return err
+		}
+		return syncCourseRowPricesInStore(ctx, client, courseID)
+	}); err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "course",
+		EntityID:   intPtr(courseID),
+		Action:     "course.price_cancel",
+		Summary:    fmt.Sprintf("Canceled the %04d-%02d price change of %s", *item.EffectiveYear, *item.EffectiveMonth, item.Edges.Course.Name),
+		Before:     toCoursePriceDTO(item, pricePeriodIndex(year, month)),
+	})
+	return s.CoursePriceHistory(ctx, courseID)
+}
+
+// changeCurrentCoursePricesInStore records prices edited on the course itself
+// as a change from the current month, leaving earlier months at their prices.
+// It returns the audit event of the change, or nil when the prices are
+// unchanged.
+func changeCurrentCoursePricesInStore(ctx context.Context, client *ent.Client, courseID int, lessonPrice, subscriptionPrice float64) (*auditsvc.RecordEvent, error) {
+	c, err := client.Course.Query().Where(course.IDEQ(courseID)).WithPrices().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	year, month := currentCoursePricePeriod()
+	lessonPriceCents, subscriptionPriceCents := invsvc.CoursePricesAt(c, c.Edges.Prices, year, month)
+	if lessonPriceCents == money.EurosToCents(lessonPrice) && subscriptionPriceCents == money.EurosToCents(subscriptionPrice) {
+		return nil, nil
+	}
+	event, err := setCoursePriceInStore(ctx, client, courseID, CoursePriceInput{
+		Year:              year,
+		Month:             month,
+		LessonPrice:       lessonPrice,
+		SubscriptionPrice: subscriptionPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// setCoursePriceInStore saves a course price change and returns its audit
+// event for the caller to record once the change is committed. The first
+// change also records the course's previous prices as its opening prices, so
+// earlier months keep them.
+func setCoursePriceInStore(ctx context.Context, client *ent.Client, courseID int, in CoursePriceInput) (auditsvc.RecordEvent, error) {
+	c, err := client.Course.Get(ctx, courseID)
+	if err != nil {
+		return auditsvc.RecordEvent{}, err
+	}
+	hasOpening, err := client.CoursePrice.Query().
+		Where(
+			courseprice.CourseIDEQ(courseID),
+			courseprice.EffectiveYearIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return auditsvc.RecordEvent{}, err
+	}
+	if !hasOpening {
+		if _, err := client.CoursePrice.Create().
+			SetCourseID(courseID).
+			SetLessonPriceCents(c.LessonPriceCents).
+			SetSubscriptionPriceCents(c.SubscriptionPriceCents).
+			SetCreatedBy(actorUsername(ctx)).
+			Save(ctx); err != nil {
+			return auditsvc.RecordEvent{}, err
+		}
+	}
+	lessonPriceCents := money.EurosToCents(in.LessonPrice)
+	subscriptionPriceCents := money.EurosToCents(in.SubscriptionPrice)
+	existing, err := client.CoursePrice.Query().
+		Where(
+			courseprice.CourseIDEQ(courseID),
+			courseprice.EffectiveYearEQ(in.Year),
+			courseprice.EffectiveMonthEQ(in.Month),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		_, err = client.CoursePrice.Create().
+			SetCourseID(courseID).
+			SetEffectiveYear(in.Year).
+			SetEffectiveMonth(in.Month).
+			SetLessonPriceCents(lessonPriceCents).
+			SetSubscriptionPriceCents(subscriptionPriceCents).
+			SetCreatedBy(actorUsername(ctx)).
+			Save(ctx)
+	case err == nil:
+		_, err = existing.Update().
+			SetLessonPriceCents(lessonPriceCents).
+			SetSubscriptionPriceCents(subscriptionPriceCents).
+			SetCreatedBy(actorUsername(ctx)).
+			SetCreatedAt(time.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		return auditsvc.RecordEvent{}, err
+	}
+	if err := syncCourseRowPricesInStore(ctx, client, courseID); err != nil {
+		return auditsvc.RecordEvent{}, err
+	}
+	return auditsvc.RecordEvent{
+		EntityType: "course",
+		EntityID:   intPtr(courseID),
+		Action:     "course.price_change",
+		Summary:    fmt.Sprintf("Set prices of %s from %04d-%02d to %.2f per lesson, %.2f subscription", c.Name, in.Year, in.Month, in.LessonPrice, in.SubscriptionPrice),
+		After:      in,
+	}, nil
+}
+
+// syncCourseRowPricesInStore sets the course row to the prices in force this
+// month; a change scheduled for a coming month leaves it as it is.
+func syncCourseRowPricesInStore(ctx context.Context, client *ent.Client, courseID int) error {
+	c, err := client.Course.Query().Where(course.IDEQ(courseID)).WithPrices().Only(ctx)
+	if err != nil {
+		return err
+	}
+	year, month := currentCoursePricePeriod()
+	lessonPriceCents, subscriptionPriceCents := invsvc.CoursePricesAt(c, c.Edges.Prices, year, month)
+	return c.Update().
+		SetLessonPriceCents(lessonPriceCents).
+		SetSubscriptionPriceCents(subscriptionPriceCents).
+		Exec(ctx)
+}
+
+// upcomingCoursePrices lists the price changes after the given month.
+func upcomingCoursePrices(changes []*ent.CoursePrice, year, month int) []CoursePriceDTO {
+	now := pricePeriodIndex(year, month)
+	out := make([]CoursePriceDTO, 0)
+	for _, ch := range sortedCoursePrices(changes) {
+		if dto := toCoursePriceDTO(ch, now); dto.Status == "upcoming" {
+			out = append(out, dto)
+		}
+	}
+	return out
+}
+
+func sortedCoursePrices(changes []*ent.CoursePrice) []*ent.CoursePrice {
+	out := append([]*ent.CoursePrice(nil), changes...)
+	sort.Slice(out, func(i, j int) bool {
+		return coursePricePeriodIndex(out[i]) < coursePricePeriodIndex(out[j])
+	})
+	return out
+}
+
+// toCoursePriceDTO marks a change upcoming when it starts after the month
+// with the given index, and past otherwise; CoursePriceHistory picks the
+// current one.
+func toCoursePriceDTO(ch *ent.CoursePrice, now int) CoursePriceDTO {
+	status := "past"
+	if coursePricePeriodIndex(ch) > now {
+		status = "upcoming"
+	}
+	createdAt := ch.CreatedAt
+	dto := CoursePriceDTO{
+		ID:                ch.ID,
+		CourseID:          ch.CourseID,
+		LessonPrice:       money.CentsToEuros(ch.LessonPriceCents),
+		SubscriptionPrice: money.CentsToEuros(ch.SubscriptionPriceCents),
+		Status:            status,
+		CreatedBy:         ch.CreatedBy,
+		CreatedAt:         formatOptionalTime(&createdAt),
+	}
+	if ch.EffectiveYear != nil && ch.EffectiveMonth != nil {
+		dto.EffectiveYear, dto.EffectiveMonth = *ch.EffectiveYear, *ch.EffectiveMonth
+	}
+	return dto
+}
@@ -3,12 +3,16 @@ package backend
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"langschool/ent"
 	"langschool/ent/course"
 	"langschool/ent/enrollment"
 	"langschool/ent/teacher"
+	auditsvc "langschool/internal/app/audit"
+	invsvc "langschool/internal/app/invoice"
+	"langschool/internal/app/vat"
 	"langschool/internal/money"
 )
 
@@ -52,7 +56,7 @@ func (s *Service) TeacherCreate(ctx context.Context, fullName string) (*TeacherD
 
 func (s *Service) CourseList(ctx context.Context, q string) ([]CourseDTO, error) {
 	q = strings.TrimSpace(q)
-	query := s.rt.DB.Ent.Course.Query().WithTeacher()
+	query := s.rt.DB.Ent.Course.Query().WithTeacher().WithPrices()
 	if q != "" {
 		query = query.Where(course.Or(
 			course.NameContainsFold(q),
@@ -72,7 +76,7 @@ func (s *Service) CourseList(ctx context.Context, q string) ([]CourseDTO, error)
 }
 
 func (s *Service) CourseGet(ctx context.Context, id int) (*CourseDTO, error) {
-	item, err := s.rt.DB.Ent.Course.Query().Where(course.IDEQ(id)).WithTeacher().Only(ctx)
+	item, err := s.rt.DB.Ent.Course.Query().Where(course.IDEQ(id)).WithTeacher().WithPrices().Only(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +114,7 @@ func (s *Service) CourseCreate(ctx context.Context, name string, teacherID *int,
 	if err != nil {
 		return nil, err
 	}
-	item, err = s.rt.DB.Ent.Course.Query().Where(course.IDEQ(item.ID)).WithTeacher().Only(ctx)
+	item, err = s.rt.DB.Ent.Course.Query().Where(course.IDEQ(item.ID)).WithTeacher().WithPrices().Only(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -134,27 +138,7 @@ func (s *Service) CourseUpdate(ctx context.Context, id int, name string, teacher
 	if err != nil {
 		return nil, err
 	}
-	update := s.rt.DB.Ent.Course.UpdateOneID(id).
-		AddVersion(1).
-		SetName(name).
-		SetType(course.Type(courseType)).
-		SetLessonPriceCents(money.EurosToCents(lessonPrice)).
-		SetSubscriptionPriceCents(money.EurosToCents(subscriptionPrice))
-	if selectedTeacher != nil {
-		update = update.SetTeacherID(selectedTeacher.ID).SetTeacherName(selectedTeacher.FullName)
-	} else {
-		update = update.ClearTeacher().SetTeacherName("")
-	}
-	item, err := update.Save(ctx)
-	if err != nil {
-		return nil, err
-	}
-	item, err = s.rt.DB.Ent.Course.Query().Where(course.IDEQ(item.ID)).WithTeacher().Only(ctx)
-	if err != nil {
-		return nil, err
-	}
-	dto := toCourseDTO(item)
-	return &dto, nil
+	return s.courseUpdate(ctx, id, 0, name, selectedTeacher, courseType, lessonPrice, subscriptionPrice)
 }
 
 func (s *Service) CourseUpdateWithVersion(ctx context.Context, id, version int, name string, teacherID *int, courseType string, lessonPrice, subscriptionPrice float64) (*CourseDTO, error) {
@@ -176,28 +160,65 @@ func (s *Service) CourseUpdateWithVersion(ctx context.Context, id, version int,
 	if err != nil {
 		return nil, err
 	}
-	update := s.rt.DB.Ent.Course.UpdateOneID(id).
-		Where(course.VersionEQ(version)).
-		SetVersion(version + 1).
+	return s.courseUpdate(ctx, id, version, name, selectedTeacher, courseType, lessonPrice, subscriptionPrice)
+}
+
+// courseUpdate saves the course and its edited prices in one transaction, so
+// a failed price change does not leave the rest of the edit behind.
+func (s *Service) courseUpdate(ctx context.Context, id, version int, name string, selectedTeacher *ent.Teacher, courseType string, lessonPrice, subscriptionPrice float64) (*CourseDTO, error) {
+	previous, err := s.rt.DB.Ent.Course.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var priceEvent *auditsvc.RecordEvent
+	if err := s.inTx(ctx, func(client *ent.Client) error {
+		var err error
+		priceEvent, err = courseUpdateInStore(ctx, client, id, version, name, selectedTeacher, courseType, lessonPrice, subscriptionPrice)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if priceEvent != nil {
+		s.recordAudit(ctx, *priceEvent)
+	}
+
+	item, err := s.rt.DB.Ent.Course.Query().Where(course.IDEQ(id)).WithTeacher().WithPrices().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toCourseDTO(item)
+	if !sameTeacher(previous.TeacherID, item.TeacherID) {
+		if dto.ScheduleConflicts, err = s.courseTeacherConflicts(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return &dto, nil
+}
+
+// courseUpdateInStore saves the course row and records edited prices as a
+// change from the current month. A version of 0 skips the stale check. It
+// returns the audit event of the price change, if any.
+func courseUpdateInStore(ctx context.Context, client *ent.Client, id, version int, name string, selectedTeacher *ent.Teacher, courseType string, lessonPrice, subscriptionPrice float64) (*auditsvc.RecordEvent, error) {
+	update := client.Course.UpdateOneID(id).
 		SetName(name).
-		SetType(course.Type(courseType)).
-		SetLessonPriceCents(money.EurosToCents(lessonPrice)).
-		SetSubscriptionPriceCents(money.EurosToCents(subscriptionPrice))
+		SetType(course.Type(courseType))
+	if version > 0 {
+		update = update.Where(course.VersionEQ(version)).SetVersion(version + 1)
+	} else {
+		update = update.AddVersion(1)
+	}
 	if selectedTeacher != nil {
 		update = update.SetTeacherID(selectedTeacher.ID).SetTeacherName(selectedTeacher.FullName)
 	} else {
 		update = update.ClearTeacher().SetTeacherName("")
 	}
-	item, err := update.Save(ctx)
-	if err != nil {
-		return nil, staleOnNotFound(err)
-	}
-	item, err = s.rt.DB.Ent.Course.Query().Where(course.IDEQ(item.ID)).WithTeacher().Only(ctx)
-	if err != nil {
+	if _, err := update.Save(ctx); err != nil {
+		if version > 0 {
+			return nil, staleOnNotFound(err)
+		}
 		return nil, err
 	}
-	dto := toCourseDTO(item)
-	return &dto, nil
+	return changeCurrentCoursePricesInStore(ctx, client, id, lessonPrice, subscriptionPrice)
 }
 
 func (s *Service) CourseDelete(ctx context.Context, id int) error {
@@ -239,6 +260,68 @@ func (s *Service) CourseDeleteWithVersion(ctx context.Context, id, version int)
 	return nil
 }
 
+// CourseSetVAT sets the VAT rate charged on the course's invoice lines. A
+// zero rate may carry the exemption note printed on the invoice; it only has
+// an effect once VAT is enabled in the settings.
+func (s *Service) CourseSetVAT(ctx context.Context, id, version int, ratePct float64, exemptNote string) (*CourseDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	if err := vat.ValidateRate(ratePct); err != nil {
+		return nil, err
+	}
+	exemptNote = sanitizeInput(exemptNote)
+	if ratePct > 0 {
+		exemptNote = ""
+	}
+	_, err := s.rt.DB.Ent.Course.UpdateOneID(id).
+		Where(course.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetVatRatePct(ratePct).
+		SetVatExemptNote(exemptNote).
+		Save(ctx)
+	if err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	return s.CourseGet(ctx, id)
+}
+
+// CourseSetBillingPeriod sets whether the course is invoiced monthly, per
+// school term or per its own terms. Months no term covers stay monthly.
+func (s *Service) CourseSetBillingPeriod(ctx context.Context, id, version int, period string) (*CourseDTO, error) {
+	period = strings.TrimSpace(period)
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	if err := validateBillingPeriod(period); err != nil {
+		return nil, err
+	}
+	before, err := s.CourseGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.rt.DB.Ent.Course.UpdateOneID(id).
+		Where(course.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetBillingPeriod(course.BillingPeriod(period)).
+		Save(ctx); err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	after, err := s.CourseGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "course",
+		EntityID:   intPtr(id),
+		Action:     "course.billing_period",
+		Summary:    fmt.Sprintf("Changed billing of %s from %s to %s", after.Name, before.BillingPeriod, after.BillingPeriod),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
 func (s *Service) resolveTeacher(ctx context.Context, teacherID *int) (*ent.Teacher, error) {
 	if teacherID == nil {
 		return nil, nil
@@ -249,15 +332,31 @@ func (s *Service) resolveTeacher(ctx context.Context, teacherID *int) (*ent.Teac
 	return s.rt.DB.Ent.Teacher.Get(ctx, *teacherID)
 }
 
+func sameTeacher(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// toCourseDTO shows the prices in force this month; c.Edges.Prices must be
+// loaded for scheduled price changes to be taken into account.
 func toCourseDTO(c *ent.Course) CourseDTO {
+	year, month := currentCoursePricePeriod()
+	lessonPriceCents, subscriptionPriceCents := invsvc.CoursePricesAt(c, c.Edges.Prices, year, month)
 	dto := CourseDTO{
 		ID:                c.ID,
 		Version:           c.Version,
 		Name:              c.Name,
 		TeacherName:       c.TeacherName,
 		Type:              string(c.Type),
-		LessonPrice:       money.CentsToEuros(c.LessonPriceCents),
-		SubscriptionPrice: money.CentsToEuros(c.SubscriptionPriceCents),
+		BillingPeriod:     string(c.BillingPeriod),
+		LessonPrice:       money.CentsToEuros(lessonPriceCents),
+		SubscriptionPrice: money.CentsToEuros(subscriptionPriceCents),
+		UpcomingPrices:    upcomingCoursePrices(c.Edges.Prices, year, month),
+		VATRate:           c.VatRatePct,
+		VATExemptNote:     c.VatExemptNote,
+		MaxStudents:       c.MaxStudents,
 	}
 	if c.TeacherID != nil {
 		id := *c.TeacherID
@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"langschool/ent"
+	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/apperrors"
+)
+
+func (s *Service) EnrollmentPauseList(ctx context.Context, enrollmentID int) ([]EnrollmentPauseDTO, error) {
+	if _, err := s.rt.DB.Ent.Enrollment.Get(ctx, enrollmentID); err != nil {
+		return nil, err
+	}
+	items, err := s.rt.DB.Ent.EnrollmentPause.Query().
+		Where(enrollmentpause.EnrollmentIDEQ(enrollmentID)).
+		Order(ent.Asc(enrollmentpause.FieldStartsOn)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EnrollmentPauseDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, toEnrollmentPauseDTO(item))
+	}
+	return out, nil
+}
+
+// EnrollmentPauseCreate freezes an enrollment for a date range. Months the
+// pause covers entirely are not billed and drop off the attendance sheets;
+// subscriptions paused for part of a month pay for the remaining days.
+func (s *Service) EnrollmentPauseCreate(ctx context.Context, enrollmentID int, in EnrollmentPauseInput) (*EnrollmentPauseDTO, error) {
+	if strings.TrimSpace(in.StartsOn) == "" || strings.TrimSpace(in.EndsOn) == "" {
+		return nil, errors.New("startsOn and endsOn are required")
+	}
+	starts, ends, err := parseEnrollmentDates(in.StartsOn, in.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+	en, err := s.rt.DB.Ent.Enrollment.Query().
+		Where(enrollment.IDEQ(enrollmentID)).
+		WithStudent().
+		WithCourse().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overlaps, err := s.rt.DB.Ent.EnrollmentPause.Query().
+		Where(
+			enrollmentpause.EnrollmentIDEQ(enrollmentID),
+			enrollmentpause.StartsOnLTE(*ends),
+			enrollmentpause.EndsOnGTE(*starts),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if overlaps {
+		return nil, apperrors.Conflict("pause overlaps another pause of this enrollment")
+	}
+	item, err := s.rt.DB.Ent.EnrollmentPause.Create().
+		SetEnrollmentID(enrollmentID).
+		SetStartsOn(*starts).
+		SetEndsOn(*ends).
+		SetReason(sanitizeInput(in.Reason)).
+		SetCreatedBy(actorUsername(ctx)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rebuildStudentDrafts(ctx, en.StudentID); err != nil {
+		return nil, err
+	}
+	dto := toEnrollmentPauseDTO(item)
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "enrollment",
+		EntityID:   intPtr(enrollmentID),
+		Action:     "enrollment.pause",
+		Summary:    fmt.Sprintf("Paused %s in %s from %s to %s", en.Edges.Student.FullName, en.Edges.Course.Name, dto.StartsOn, dto.EndsOn),
+		After:      dto,
+		StudentID:  intPtr(en.StudentID),
+	})
+	return &dto, nil
+}
+
+// EnrollmentPauseDelete lifts a pause; draft invoices bill its days again.
+func (s *Service) EnrollmentPauseDelete(ctx context.Context, enrollmentID, pauseID int) error {
+	item, err := s.rt.DB.Ent.EnrollmentPause.Query().
+		Where(enrollmentpause.IDEQ(pauseID), enrollmentpause.EnrollmentIDEQ(enrollmentID)).
+		WithEnrollment(func(eq *ent.EnrollmentQuery) { eq.WithStudent().WithCourse() }).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.DB.Ent.EnrollmentPause.DeleteOneID(item.ID).Exec(ctx); err != nil {
+		return err
+	}
+	en := item.Edges.Enrollment
+	if err := s.rebuildStudentDrafts(ctx, en.StudentID); err != nil {
+		return err
+	}
+	dto := toEnrollmentPauseDTO(item)
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "enrollment",
+		EntityID:   intPtr(enrollmentID),
+		Action:     "enrollment.pause_cancel",
+		Summary:    fmt.Sprintf("Lifted the pause of %s in %s from %s to %s", en.Edges.Student.FullName, en.Edges.Course.Name, dto.StartsOn, dto.EndsOn),
+		Before:     dto,
+		StudentID:  intPtr(en.StudentID),
+	})
+	return nil
+}
+
+func toEnrollmentPauseDTO(p *ent.EnrollmentPause) EnrollmentPauseDTO {
+	createdAt := p.CreatedAt
+	return EnrollmentPauseDTO{
+		ID:           p.ID,
+		EnrollmentID: p.EnrollmentID,
+		StartsOn:     p.StartsOn.Format("2006-01-02"),
+		EndsOn:       p.EndsOn.Format("2006-01-02"),
+		Reason:       p.Reason,
+		CreatedBy:    p.CreatedBy,
+		CreatedAt:    formatOptionalTime(&createdAt),
+	}
+}
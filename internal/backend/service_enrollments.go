@@ -3,22 +3,45 @@ package backend
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"langschool/ent"
 	"langschool/ent/enrollment"
+	"langschool/ent/enrollmentpause"
+	"langschool/ent/enrollmentprice"
 	entinvoice "langschool/ent/invoice"
+	"langschool/ent/predicate"
+	"langschool/internal/app/enrollperiod"
+	invsvc "langschool/internal/app/invoice"
 	"langschool/internal/money"
 )
 
 var enrollmentCurrentTime = time.Now
 
 func (s *Service) EnrollmentList(ctx context.Context, studentID *int, courseID *int) ([]EnrollmentDTO, error) {
+	return s.enrollmentList(ctx, studentID, courseID)
+}
+
+// EnrollmentListInMonth lists the enrollments active on at least one day of
+// the month.
+func (s *Service) EnrollmentListInMonth(ctx context.Context, studentID *int, courseID *int, year, month int) ([]EnrollmentDTO, error) {
+	if month < 1 || month > 12 {
+		return nil, errors.New("month must be between 1 and 12")
+	}
+	return s.enrollmentList(ctx, studentID, courseID, enrollperiod.ActiveIn(year, month))
+}
+
+func (s *Service) enrollmentList(ctx context.Context, studentID *int, courseID *int, where ...predicate.Enrollment) ([]EnrollmentDTO, error) {
 	q := s.rt.DB.Ent.Enrollment.Query().
+		Where(where...).
 		WithStudent().
 		WithCourse(func(cq *ent.CourseQuery) {
-			cq.WithTeacher()
+			cq.WithTeacher().WithPrices()
+		}).
+		WithPauses(func(pq *ent.EnrollmentPauseQuery) {
+			pq.Order(ent.Asc(enrollmentpause.FieldStartsOn))
 		})
 	if studentID != nil {
 		q = q.Where(enrollment.StudentIDEQ(*studentID))
@@ -41,7 +64,39 @@ func (s *Service) EnrollmentCreate(ctx context.Context, studentID, courseID int,
 	return enrollmentCreateInStore(ctx, s.rt.DB.Ent, studentID, courseID, billingMode, chargeMaterials, lessonPriceOverride, subscriptionLessonPrice, note)
 }
 
+// EnrollmentCreateWithDates creates an enrollment limited to the input's
+// start and end dates.
+func (s *Service) EnrollmentCreateWithDates(ctx context.Context, studentID int, in EnrollmentCreateInput) (*EnrollmentDTO, error) {
+	if _, _, err := parseEnrollmentDates(in.StartsOn, in.EndsOn); err != nil {
+		return nil, err
+	}
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	client := tx.Client()
+	created, err := enrollmentCreateInStore(ctx, client, studentID, in.CourseID, in.BillingMode, in.ChargeMaterials, in.LessonPriceOverride, in.SubscriptionLessonPrice, in.Note)
+	if err != nil {
+		return nil, err
+	}
+	if created, err = enrollmentSetDatesInStore(ctx, client, created.ID, in.StartsOn, in.EndsOn); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
 func enrollmentCreateInStore(ctx context.Context, client *ent.Client, studentID, courseID int, billingMode string, chargeMaterials bool, lessonPriceOverride, subscriptionLessonPrice float64, note string) (*EnrollmentDTO, error) {
+	return enrollmentCreateForEntry(ctx, client, 0, studentID, courseID, billingMode, chargeMaterials, lessonPriceOverride, subscriptionLessonPrice, note)
+}
+
+// enrollmentCreateForEntry creates an enrollment when the course has a free
+// seat. waitlistEntryID, when set, is the accepted waiting-list offer whose
+// held seat the enrollment takes.
+func enrollmentCreateForEntry(ctx context.Context, client *ent.Client, waitlistEntryID, studentID, courseID int, billingMode string, chargeMaterials bool, lessonPriceOverride, subscriptionLessonPrice float64, note string) (*EnrollmentDTO, error) {
 	if studentID <= 0 || courseID <= 0 {
 		return nil, errors.New("studentID and courseID must be > 0")
 	}
@@ -82,6 +137,9 @@ func enrollmentCreateInStore(ctx context.Context, client *ent.Client, studentID,
 	if exists {
 		return nil, errors.New("an enrollment for this student and course already exists")
 	}
+	if err := ensureCourseSeat(ctx, client, courseID, waitlistEntryID); err != nil {
+		return nil, err
+	}
 	item, err := client.Enrollment.Create().
 		SetStudentID(studentID).
 		SetCourseID(courseID).
@@ -97,7 +155,8 @@ func enrollmentCreateInStore(ctx context.Context, client *ent.Client, studentID,
 	item, err = client.Enrollment.Query().
 		Where(enrollment.IDEQ(item.ID)).
 		WithStudent().
-		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher() }).
+		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher().WithPrices() }).
+		WithPauses(func(pq *ent.EnrollmentPauseQuery) { pq.Order(ent.Asc(enrollmentpause.FieldStartsOn)) }).
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -139,10 +198,14 @@ func (s *Service) EnrollmentUpdate(ctx context.Context, enrollmentID int, billin
 		Save(ctx); err != nil {
 		return nil, err
 	}
+	if err := s.recordEnrollmentPriceChange(ctx, before, billingMode, lessonPriceOverride, subscriptionLessonPrice); err != nil {
+		return nil, err
+	}
 	item, err := s.rt.DB.Ent.Enrollment.Query().
 		Where(enrollment.IDEQ(enrollmentID)).
 		WithStudent().
-		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher() }).
+		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher().WithPrices() }).
+		WithPauses(func(pq *ent.EnrollmentPauseQuery) { pq.Order(ent.Asc(enrollmentpause.FieldStartsOn)) }).
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -191,10 +254,14 @@ func (s *Service) EnrollmentUpdateWithVersion(ctx context.Context, enrollmentID,
 		Save(ctx); err != nil {
 		return nil, staleOnNotFound(err)
 	}
+	if err := s.recordEnrollmentPriceChange(ctx, before, billingMode, lessonPriceOverride, subscriptionLessonPrice); err != nil {
+		return nil, err
+	}
 	item, err := s.rt.DB.Ent.Enrollment.Query().
 		Where(enrollment.IDEQ(enrollmentID)).
 		WithStudent().
-		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher() }).
+		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher().WithPrices() }).
+		WithPauses(func(pq *ent.EnrollmentPauseQuery) { pq.Order(ent.Asc(enrollmentpause.FieldStartsOn)) }).
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -206,6 +273,122 @@ func (s *Service) EnrollmentUpdateWithVersion(ctx context.Context, enrollmentID,
 	return &dto, nil
 }
 
+// EnrollmentSetDates sets the first and last day of an enrollment; an empty
+// date leaves that end open. Draft invoices of the student are rebuilt so
+// they bill only the months, and for subscriptions the days, it covers.
+func (s *Service) EnrollmentSetDates(ctx context.Context, enrollmentID, version int, startsOn, endsOn string) (*EnrollmentDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	starts, ends, err := parseEnrollmentDates(startsOn, endsOn)
+	if err != nil {
+		return nil, err
+	}
+	update := s.rt.DB.Ent.Enrollment.UpdateOneID(enrollmentID).
+		Where(enrollment.VersionEQ(version)).
+		SetVersion(version + 1)
+	if starts != nil {
+		update.SetStartsOn(*starts)
+	} else {
+		update.ClearStartsOn()
+	}
+	if ends != nil {
+		update.SetEndsOn(*ends)
+	} else {
+		update.ClearEndsOn()
+	}
+	item, err := update.Save(ctx)
+	if err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	if err := s.rebuildStudentDrafts(ctx, item.StudentID); err != nil {
+		return nil, err
+	}
+	// An end date may free the seat for the waiting list.
+	if _, err := s.promoteWaitlist(ctx, item.CourseID); err != nil {
+		return nil, err
+	}
+	return s.enrollmentGet(ctx, s.rt.DB.Ent, enrollmentID)
+}
+
+func enrollmentSetDatesInStore(ctx context.Context, client *ent.Client, enrollmentID int, startsOn, endsOn string) (*EnrollmentDTO, error) {
+	starts, ends, err := parseEnrollmentDates(startsOn, endsOn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Enrollment.UpdateOneID(enrollmentID).
+		SetNillableStartsOn(starts).
+		SetNillableEndsOn(ends).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return (&Service{}).enrollmentGet(ctx, client, enrollmentID)
+}
+
+func (s *Service) enrollmentGet(ctx context.Context, client *ent.Client, enrollmentID int) (*EnrollmentDTO, error) {
+	item, err := client.Enrollment.Query().
+		Where(enrollment.IDEQ(enrollmentID)).
+		WithStudent().
+		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher().WithPrices() }).
+		WithPauses(func(pq *ent.EnrollmentPauseQuery) { pq.Order(ent.Asc(enrollmentpause.FieldStartsOn)) }).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toEnrollmentDTO(item)
+	return &dto, nil
+}
+
+// parseEnrollmentDates parses optional YYYY-MM-DD enrollment dates.
+func parseEnrollmentDates(startsOn, endsOn string) (*time.Time, *time.Time, error) {
+	parse := func(value, name string) (*time.Time, error) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, nil
+		}
+		t, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be YYYY-MM-DD", name)
+		}
+		return &t, nil
+	}
+	starts, err := parse(startsOn, "startsOn")
+	if err != nil {
+		return nil, nil, err
+	}
+	ends, err := parse(endsOn, "endsOn")
+	if err != nil {
+		return nil, nil, err
+	}
+	if starts != nil && ends != nil && ends.Before(*starts) {
+		return nil, nil, errors.New("endsOn must be on or after startsOn")
+	}
+	return starts, ends, nil
+}
+
+// rebuildStudentDrafts rebuilds every draft monthly invoice of the student.
+func (s *Service) rebuildStudentDrafts(ctx context.Context, studentID int) error {
+	if s.rt == nil || s.rt.Invoice == nil {
+		return nil
+	}
+	drafts, err := s.rt.DB.Ent.Invoice.Query().
+		Where(
+			entinvoice.StudentIDEQ(studentID),
+			entinvoice.StatusEQ(entinvoice.StatusDraft),
+			entinvoice.KindEQ(entinvoice.KindMonthly),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, iv := range drafts {
+		if _, err := s.rt.Invoice.RebuildStudentDraft(ctx, studentID, iv.PeriodYear, iv.PeriodMonth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Service) rebuildInvoiceForEnrollmentChange(ctx context.Context, before *ent.Enrollment, billingMode string, chargeMaterials bool, lessonPriceOverride, subscriptionLessonPrice float64) error {
 	if before == nil || !enrollmentInvoiceAffectingFieldsChanged(before, billingMode, chargeMaterials, lessonPriceOverride, subscriptionLessonPrice) {
 		return nil
@@ -222,6 +405,7 @@ func (s *Service) rebuildInvoiceForEnrollmentChange(ctx context.Context, before
 			entinvoice.StudentIDEQ(before.StudentID),
 			entinvoice.PeriodYearEQ(year),
 			entinvoice.PeriodMonthEQ(month),
+			entinvoice.KindEQ(entinvoice.KindMonthly),
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
@@ -248,6 +432,7 @@ func (s *Service) resolveEnrollmentInvoiceRebuildMonth(ctx context.Context, stud
 			entinvoice.StudentIDEQ(studentID),
 			entinvoice.PeriodYearEQ(year),
 			entinvoice.PeriodMonthEQ(month),
+			entinvoice.KindEQ(entinvoice.KindMonthly),
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
@@ -263,6 +448,72 @@ func (s *Service) resolveEnrollmentInvoiceRebuildMonth(ctx context.Context, stud
 	return year, month, nil
 }
 
+// recordEnrollmentPriceChange adds the enrollment's new prices to its price
+// history from the month enrollment changes apply to. The first change also
+// records the previous prices from the month the enrollment was created, so
+// earlier months keep them.
+func (s *Service) recordEnrollmentPriceChange(ctx context.Context, before *ent.Enrollment, billingMode string, lessonPriceOverride, subscriptionLessonPrice float64) error {
+	nextLessonPriceOverrideCents := normalizedLessonPriceOverrideCents(billingMode, lessonPriceOverride)
+	nextSubscriptionLessonPriceCents := normalizedSubscriptionLessonPriceCents(billingMode, subscriptionLessonPrice)
+	if before.LessonPriceOverrideCents == nextLessonPriceOverrideCents &&
+		before.SubscriptionLessonPriceCents == nextSubscriptionLessonPriceCents {
+		return nil
+	}
+	year, month, err := s.resolveEnrollmentInvoiceRebuildMonth(ctx, before.StudentID)
+	if err != nil {
+		return err
+	}
+	hasHistory, err := s.rt.DB.Ent.EnrollmentPrice.Query().
+		Where(enrollmentprice.EnrollmentIDEQ(before.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !hasHistory {
+		openingYear, openingMonth := 1, 1
+		if before.CreatedAt != nil {
+			openingYear, openingMonth = before.CreatedAt.Year(), int(before.CreatedAt.Month())
+		}
+		if pricePeriodIndex(openingYear, openingMonth) < pricePeriodIndex(year, month) {
+			if err := s.setEnrollmentPrice(ctx, before.ID, openingYear, openingMonth, before.LessonPriceOverrideCents, before.SubscriptionLessonPriceCents); err != nil {
+				return err
+			}
+		}
+	}
+	return s.setEnrollmentPrice(ctx, before.ID, year, month, nextLessonPriceOverrideCents, nextSubscriptionLessonPriceCents)
+}
+
+func (s *Service) setEnrollmentPrice(ctx context.Context, enrollmentID, year, month int, lessonPriceOverrideCents, subscriptionLessonPriceCents int64) error {
+	existing, err := s.rt.DB.Ent.EnrollmentPrice.Query().
+		Where(
+			enrollmentprice.EnrollmentIDEQ(enrollmentID),
+			enrollmentprice.EffectiveYearEQ(year),
+			enrollmentprice.EffectiveMonthEQ(month),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		_, err = s.rt.DB.Ent.EnrollmentPrice.Create().
+			SetEnrollmentID(enrollmentID).
+			SetEffectiveYear(year).
+			SetEffectiveMonth(month).
+			SetLessonPriceOverrideCents(lessonPriceOverrideCents).
+			SetSubscriptionLessonPriceCents(subscriptionLessonPriceCents).
+			SetCreatedBy(actorUsername(ctx)).
+			Save(ctx)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	_, err = existing.Update().
+		SetLessonPriceOverrideCents(lessonPriceOverrideCents).
+		SetSubscriptionLessonPriceCents(subscriptionLessonPriceCents).
+		SetCreatedBy(actorUsername(ctx)).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
 func enrollmentInvoiceAffectingFieldsChanged(before *ent.Enrollment, billingMode string, chargeMaterials bool, lessonPriceOverride, subscriptionLessonPrice float64) bool {
 	if before == nil {
 		return false
@@ -312,7 +563,9 @@ func toEnrollmentDTO(e *ent.Enrollment) EnrollmentDTO {
 		if e.LessonPriceOverrideCents >= 0 {
 			lessonPriceOverride = money.CentsToEuros(e.LessonPriceOverrideCents)
 		} else if e.Edges.Course != nil {
-			lessonPriceOverride = money.CentsToEuros(e.Edges.Course.LessonPriceCents)
+			year, month := currentCoursePricePeriod()
+			lessonPriceCents, _ := invsvc.CoursePricesAt(e.Edges.Course, e.Edges.Course.Edges.Prices, year, month)
+			lessonPriceOverride = money.CentsToEuros(lessonPriceCents)
 		}
 	}
 	dto := EnrollmentDTO{
@@ -327,6 +580,16 @@ func toEnrollmentDTO(e *ent.Enrollment) EnrollmentDTO {
 		Note:                    e.Note,
 		CreatedAt:               formatOptionalTime(e.CreatedAt),
 	}
+	if e.StartsOn != nil {
+		dto.StartsOn = e.StartsOn.Format("2006-01-02")
+	}
+	if e.EndsOn != nil {
+		dto.EndsOn = e.EndsOn.Format("2006-01-02")
+	}
+	dto.Pauses = make([]EnrollmentPauseDTO, 0, len(e.Edges.Pauses))
+	for _, p := range e.Edges.Pauses {
+		dto.Pauses = append(dto.Pauses, toEnrollmentPauseDTO(p))
+	}
 	if e.Edges.Student != nil {
 		dto.StudentName = e.Edges.Student.FullName
 	}
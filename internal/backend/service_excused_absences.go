@@ -0,0 +1,99 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"langschool/ent/settings"
+	sharedapp "langschool/internal/app"
+	auditsvc "langschool/internal/app/audit"
+)
+
+func (s *Service) SettingsGetExcusedAbsences(ctx context.Context) (*ExcusedAbsenceSettingsDTO, error) {
+	policy, err := s.rt.Invoice.AbsencePolicyFromSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ExcusedAbsenceSettingsDTO{Policy: policy.Mode, MakeupWeeks: policy.MakeupWeeks}, nil
+}
+
+// SettingsSetExcusedAbsences changes what new excused absences yield.
+// Absences already recorded keep their makeup or credit.
+func (s *Service) SettingsSetExcusedAbsences(ctx context.Context, input ExcusedAbsenceSettingsDTO) (*ExcusedAbsenceSettingsDTO, error) {
+	policy := AbsencePolicy{Mode: input.Policy, MakeupWeeks: input.MakeupWeeks}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	_, err := s.rt.DB.Ent.Settings.
+		Update().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		SetExcusedAbsencePolicy(settings.ExcusedAbsencePolicy(policy.Mode)).
+		SetMakeupWindowWeeks(policy.MakeupWeeks).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SettingsGetExcusedAbsences(ctx)
+}
+
+func (s *Service) ExcusedAbsenceList(ctx context.Context, studentID, courseID int) ([]ExcusedAbsenceDTO, error) {
+	return s.rt.Invoice.ListAbsences(ctx, studentID, courseID)
+}
+
+func (s *Service) ExcusedAbsenceCreate(ctx context.Context, in ExcusedAbsenceInput) (*ExcusedAbsenceDTO, error) {
+	in.Reason = sanitizeInput(in.Reason)
+	item, err := s.rt.Invoice.CreateAbsence(ctx, in, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "excused_absence",
+		EntityID:   intPtr(item.ID),
+		Action:     "excused_absence.create",
+		Summary:    fmt.Sprintf("Excused %s from %s on %s (%s)", item.StudentName, item.CourseName, item.LessonDate, item.Resolution),
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
+
+// ExcusedAbsenceMakeup records the makeup lesson taken for an absence.
+func (s *Service) ExcusedAbsenceMakeup(ctx context.Context, id, courseID int, date string) (*ExcusedAbsenceDTO, error) {
+	before, err := s.rt.Invoice.GetAbsence(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.RecordMakeup(ctx, id, courseID, date)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "excused_absence",
+		EntityID:   intPtr(item.ID),
+		Action:     "excused_absence.makeup",
+		Summary:    fmt.Sprintf("%s made up the %s lesson of %s in %s on %s", item.StudentName, item.CourseName, item.LessonDate, item.MakeupCourseName, item.MadeUpOn),
+		Before:     before,
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
+
+func (s *Service) ExcusedAbsenceDelete(ctx context.Context, id int) error {
+	before, err := s.rt.Invoice.GetAbsence(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.Invoice.DeleteAbsence(ctx, id); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "excused_absence",
+		EntityID:   intPtr(id),
+		Action:     "excused_absence.delete",
+		Summary:    fmt.Sprintf("Removed the excused absence of %s from %s on %s", before.StudentName, before.CourseName, before.LessonDate),
+		Before:     before,
+		StudentID:  intPtr(before.StudentID),
+	})
+	return nil
+}
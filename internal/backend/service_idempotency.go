@@ -0,0 +1,23 @@
+package backend
+
+import (
+	"context"
+
+	"langschool/internal/app/idempotency"
+)
+
+type IdempotentRequest = idempotency.Request
+type IdempotentResponse = idempotency.Response
+
+// IdempotencyBegin claims an Idempotency-Key. See idempotency.Service.Begin.
+func (s *Service) IdempotencyBegin(ctx context.Context, req IdempotentRequest) (int, *IdempotentResponse, error) {
+	return s.rt.Idempotency.Begin(ctx, req)
+}
+
+func (s *Service) IdempotencyComplete(ctx context.Context, id int, resp IdempotentResponse) error {
+	return s.rt.Idempotency.Complete(ctx, id, resp)
+}
+
+func (s *Service) IdempotencyRelease(ctx context.Context, id int) error {
+	return s.rt.Idempotency.Release(ctx, id)
+}
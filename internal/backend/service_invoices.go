@@ -173,6 +173,15 @@ func (s *Service) InvoiceList(ctx context.Context, year, month int, status strin
 	return s.rt.Invoice.List(ctx, year, month, status)
 }
 
+// VATSummary reports the net, VAT and gross totals per rate of the period's
+// issued invoices.
+func (s *Service) VATSummary(ctx context.Context, year, month int) (*VATSummaryDTO, error) {
+	if month < 1 || month > 12 {
+		return nil, errors.New("month must be between 1 and 12")
+	}
+	return s.rt.Invoice.VATSummary(ctx, year, month)
+}
+
 func (s *Service) InvoiceIssue(ctx context.Context, id int) (IssueResult, error) {
 	before, meta, err := s.auditStudentFinanceSnapshotByInvoice(ctx, id)
 	if err != nil {
@@ -367,6 +376,18 @@ func (s *Service) InvoiceEnsurePDF(ctx context.Context, id int) (string, error)
 	return path, nil
 }
 
+// InvoiceUBL returns the Peppol BIS Billing 3.0 XML for an issued invoice.
+func (s *Service) InvoiceUBL(ctx context.Context, id int) ([]byte, string, error) {
+	iv, err := s.rt.DB.Ent.Invoice.Get(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if iv.Number == nil || strings.TrimSpace(*iv.Number) == "" {
+		return nil, "", fmt.Errorf("счёт ещё не выставлен")
+	}
+	return s.rt.Invoice.UBL(ctx, id)
+}
+
 func (s *Service) InvoiceEnsurePDFAll(ctx context.Context, year, month int) (EnsureAllPDFsResult, error) {
 	result := EnsureAllPDFsResult{
 		Year:  year,
@@ -645,9 +666,12 @@ func (s *Service) InvoiceArchiveFilePath(year, month int, filename string) (stri
 	return fullPath, nil
 }
 
+// InvoiceArchiveZIPEntry is a file in the monthly archive ZIP, read from Path
+// on disk or, for generated documents, taken from Data.
 type InvoiceArchiveZIPEntry struct {
 	Name string
 	Path string
+	Data []byte
 }
 
 func (s *Service) InvoiceArchiveZIPEntries(ctx context.Context, year, month int) ([]InvoiceArchiveZIPEntry, string, error) {
@@ -681,6 +705,16 @@ func (s *Service) InvoiceArchiveZIPEntries(ctx context.Context, year, month int)
 			Name: info.Filename,
 			Path: info.Path,
 		})
+		data, name, err := s.rt.Invoice.UBL(ctx, iv.ID)
+		switch {
+		case errors.Is(err, invsvc.ErrUBLBuyerEndpoint):
+			// Payers without an e-mail have no Peppol endpoint; their PDF is
+			// still archived.
+		case err != nil:
+			return nil, "", err
+		default:
+			entries = append(entries, InvoiceArchiveZIPEntry{Name: name, Data: data})
+		}
 	}
 	if len(entries) == 0 {
 		return nil, "", errors.New("invalid archive zip: no ready pdfs for this month")
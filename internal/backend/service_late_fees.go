@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"langschool/ent/settings"
+	sharedapp "langschool/internal/app"
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/money"
+)
+
+func (s *Service) SettingsGetLateFees(ctx context.Context) (*LateFeeSettingsDTO, error) {
+	policy, err := s.rt.Invoice.LateFeePolicyFromSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &LateFeeSettingsDTO{
+		Mode:         policy.Mode,
+		FlatFee:      money.CentsToEuros(policy.FlatCents),
+		DailyRatePct: policy.DailyRatePct,
+		GraceDays:    policy.GraceDays,
+		Cap:          money.CentsToEuros(policy.CapCents),
+	}, nil
+}
+
+// SettingsSetLateFees changes the late-fee policy. Fees already on issued
+// invoices are not touched; drafts pick up the new policy when rebuilt.
+func (s *Service) SettingsSetLateFees(ctx context.Context, input LateFeeSettingsDTO) (*LateFeeSettingsDTO, error) {
+	if input.Mode == "" {
+		input.Mode = invoiceLateFeeModeOff
+	}
+	policy := LateFeePolicy{
+		Mode:         input.Mode,
+		FlatCents:    money.EurosToCents(input.FlatFee),
+		DailyRatePct: input.DailyRatePct,
+		GraceDays:    input.GraceDays,
+		CapCents:     money.EurosToCents(input.Cap),
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	_, err := s.rt.DB.Ent.Settings.
+		Update().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		SetLateFeeMode(settings.LateFeeMode(policy.Mode)).
+		SetLateFeeFlatCents(policy.FlatCents).
+		SetLateFeeDailyRatePct(policy.DailyRatePct).
+		SetLateFeeGraceDays(policy.GraceDays).
+		SetLateFeeCapCents(policy.CapCents).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SettingsGetLateFees(ctx)
+}
+
+func (s *Service) LateFeeList(ctx context.Context, studentID int) ([]LateFeeDTO, error) {
+	return s.rt.Invoice.ListLateFees(ctx, studentID)
+}
+
+// LateFeeWaive forgives a late fee that has not been issued yet.
+func (s *Service) LateFeeWaive(ctx context.Context, id int, reason string) (*LateFeeDTO, error) {
+	before, err := s.rt.Invoice.GetLateFee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.WaiveLateFee(ctx, id, reason, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "late_fee",
+		EntityID:   intPtr(item.ID),
+		Action:     "late_fee.waive",
+		Summary:    fmt.Sprintf("Waived late fee %.2f on invoice %s for %s: %s", item.Amount, item.OverdueInvoiceNo, item.StudentName, item.WaivedReason),
+		Before:     before,
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+		InvoiceID:  item.BilledInvoiceID,
+	})
+	return item, nil
+}
@@ -0,0 +1,592 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/lead"
+	auditsvc "langschool/internal/app/audit"
+	invsvc "langschool/internal/app/invoice"
+	"langschool/internal/apperrors"
+	"langschool/internal/money"
+)
+
+// Lead statuses.
+const (
+	LeadStatusNew            = "new"
+	LeadStatusTrialScheduled = "trial_scheduled"
+	LeadStatusTrialDone      = "trial_done"
+	LeadStatusConverted      = "converted"
+	LeadStatusLost           = "lost"
+)
+
+const leadTrialChargeDescription = "Izmēģinājuma nodarbība %s (%s)"
+
+var leadCurrentTime = time.Now
+
+func (s *Service) LeadList(ctx context.Context, status string, courseID int) ([]LeadDTO, error) {
+	q := s.rt.DB.Ent.Lead.Query().
+		WithCourse().
+		WithStudent().
+		Order(ent.Desc(lead.FieldCreatedAt), ent.Desc(lead.FieldID))
+	if status = strings.TrimSpace(status); status != "" {
+		if err := lead.StatusValidator(lead.Status(status)); err != nil {
+			return nil, fmt.Errorf("invalid lead status %q", status)
+		}
+		q = q.Where(lead.StatusEQ(lead.Status(status)))
+	}
+	if courseID > 0 {
+		q = q.Where(lead.CourseIDEQ(courseID))
+	}
+	items, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]LeadDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, toLeadDTO(item))
+	}
+	return out, nil
+}
+
+func (s *Service) LeadGet(ctx context.Context, id int) (*LeadDTO, error) {
+	item, err := s.rt.DB.Ent.Lead.Query().
+		Where(lead.IDEQ(id)).
+		WithCourse().
+		WithStudent().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toLeadDTO(item)
+	return &dto, nil
+}
+
+// LeadCreate records an inquiry from a prospective student.
+func (s *Service) LeadCreate(ctx context.Context, in LeadInput) (*LeadDTO, error) {
+	in, err := s.normalizeLeadInput(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	create := s.rt.DB.Ent.Lead.Create().
+		SetFullName(in.FullName).
+		SetPersonalCode(in.PersonalCode).
+		SetPhone(in.Phone).
+		SetEmail(in.Email).
+		SetIsMinor(in.IsMinor).
+		SetPayerName(in.PayerName).
+		SetPayerRole(in.PayerRole).
+		SetSource(in.Source).
+		SetNote(in.Note).
+		SetCreatedBy(actorUsername(ctx)).
+		SetCreatedAt(leadCurrentTime())
+	if in.CourseID > 0 {
+		create.SetCourseID(in.CourseID)
+	}
+	item, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto, err := s.LeadGet(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(dto.ID),
+		Action:     "lead.create",
+		Summary:    fmt.Sprintf("Added lead %s", dto.FullName),
+		After:      dto,
+	})
+	return dto, nil
+}
+
+// LeadUpdate edits the details of a lead that has not been converted.
+func (s *Service) LeadUpdate(ctx context.Context, id, version int, in LeadInput) (*LeadDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	before, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status == LeadStatusConverted {
+		return nil, apperrors.Conflict("the lead is already converted; edit the student instead")
+	}
+	in, err = s.normalizeLeadInput(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	update := s.rt.DB.Ent.Lead.UpdateOneID(id).
+		Where(lead.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetFullName(in.FullName).
+		SetPersonalCode(in.PersonalCode).
+		SetPhone(in.Phone).
+		SetEmail(in.Email).
+		SetIsMinor(in.IsMinor).
+		SetPayerName(in.PayerName).
+		SetPayerRole(in.PayerRole).
+		SetSource(in.Source).
+		SetNote(in.Note)
+	if in.CourseID > 0 {
+		update.SetCourseID(in.CourseID)
+	} else {
+		update.ClearCourseID()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	after, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.update",
+		Summary:    fmt.Sprintf("Updated lead %s", after.FullName),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// LeadScheduleTrial books a trial lesson for a lead. A trial can be
+// rescheduled, also after a no-show or for a lead that was lost.
+func (s *Service) LeadScheduleTrial(ctx context.Context, id int, in LeadTrialInput) (*LeadDTO, error) {
+	before, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status == LeadStatusConverted {
+		return nil, apperrors.Conflict("the lead is already converted")
+	}
+	if in.CourseID <= 0 {
+		return nil, errors.New("courseId is required")
+	}
+	if _, err := s.rt.DB.Ent.Course.Get(ctx, in.CourseID); err != nil {
+		return nil, err
+	}
+	trialAt, err := parseLeadTrialAt(in.TrialAt)
+	if err != nil {
+		return nil, err
+	}
+	priceCents := money.EurosToCents(in.TrialPrice)
+	if priceCents < 0 {
+		return nil, errors.New("trialPrice must be >= 0")
+	}
+	if _, err := s.rt.DB.Ent.Lead.UpdateOneID(id).
+		SetStatus(lead.StatusTrialScheduled).
+		SetCourseID(in.CourseID).
+		SetTrialAt(trialAt).
+		SetTrialPriceCents(priceCents).
+		ClearTrialOutcome().
+		SetOutcomeNote("").
+		SetLostReason("").
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	after, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.trial",
+		Summary:    fmt.Sprintf("Scheduled a trial lesson in %s on %s for %s", after.CourseName, after.TrialAt, after.FullName),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// LeadRecordOutcome records whether the lead came to the trial lesson.
+func (s *Service) LeadRecordOutcome(ctx context.Context, id int, outcome, note string) (*LeadDTO, error) {
+	before, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status != LeadStatusTrialScheduled && before.Status != LeadStatusTrialDone {
+		return nil, apperrors.Conflict("the lead has no trial lesson scheduled")
+	}
+	outcome = strings.TrimSpace(outcome)
+	if err := lead.TrialOutcomeValidator(lead.TrialOutcome(outcome)); err != nil {
+		return nil, errors.New("outcome must be attended or no_show")
+	}
+	if _, err := s.rt.DB.Ent.Lead.UpdateOneID(id).
+		SetStatus(lead.StatusTrialDone).
+		SetTrialOutcome(lead.TrialOutcome(outcome)).
+		SetOutcomeNote(sanitizeInput(note)).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	after, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.outcome",
+		Summary:    fmt.Sprintf("Recorded the trial lesson of %s as %s", after.FullName, outcome),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// LeadMarkLost closes a lead that will not become a student.
+func (s *Service) LeadMarkLost(ctx context.Context, id int, reason string) (*LeadDTO, error) {
+	before, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status == LeadStatusConverted {
+		return nil, apperrors.Conflict("the lead is already converted")
+	}
+	if _, err := s.rt.DB.Ent.Lead.UpdateOneID(id).
+		SetStatus(lead.StatusLost).
+		SetLostReason(sanitizeInput(reason)).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	after, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.lost",
+		Summary:    fmt.Sprintf("Marked lead %s as lost", after.FullName),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// LeadConvert turns a lead into a student through the onboarding flow,
+// after the same duplicate check as the student form. A paid trial that was
+// attended is charged to the student as a one-off charge in the trial month.
+func (s *Service) LeadConvert(ctx context.Context, id int, in LeadConvertInput) (*LeadConversionResult, error) {
+	item, err := s.rt.DB.Ent.Lead.Query().Where(lead.IDEQ(id)).WithCourse().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status == lead.StatusConverted {
+		return nil, apperrors.Conflict("the lead is already converted")
+	}
+	if err := validateUniqueEnrollmentCourses(in.Enrollments); err != nil {
+		return nil, err
+	}
+	studentInput := in.Student
+	if strings.TrimSpace(studentInput.FullName) == "" {
+		studentInput = StudentCreateInput{
+			FullName:     item.FullName,
+			PersonalCode: item.PersonalCode,
+			Phone:        item.Phone,
+			Email:        item.Email,
+			Note:         item.Note,
+			IsMinor:      item.IsMinor,
+			PayerName:    item.PayerName,
+			PayerRole:    item.PayerRole,
+		}
+	}
+	if in.StudentID == 0 {
+		duplicates, err := s.StudentDuplicateCheck(ctx, studentInput.FullName, studentInput.PersonalCode, studentInput.Phone, studentInput.Email)
+		if err != nil {
+			return nil, err
+		}
+		if duplicates.ExactMatch != nil {
+			return nil, apperrors.Conflict(fmt.Sprintf("student %s (ID %d) already has this personal code; convert the lead into that student", duplicates.ExactMatch.FullName, duplicates.ExactMatch.ID))
+		}
+		if len(duplicates.PossibleMatches) > 0 && !in.IgnoreDuplicates {
+			names := make([]string, 0, len(duplicates.PossibleMatches))
+			for _, match := range duplicates.PossibleMatches {
+				names = append(names, fmt.Sprintf("%s (ID %d)", match.FullName, match.ID))
+			}
+			return nil, apperrors.Conflict("possible duplicate of student " + strings.Join(names, ", ") + "; convert into that student or confirm a new one")
+		}
+	}
+
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	client := tx.Client()
+
+	result := &LeadConversionResult{Enrollments: make([]EnrollmentDTO, 0, len(in.Enrollments))}
+	if in.StudentID == 0 {
+		onboarded, err := studentOnboardInStore(ctx, client, studentInput, in.Enrollments)
+		if err != nil {
+			return nil, err
+		}
+		result.Student = onboarded.Student
+		result.Enrollments = onboarded.Enrollments
+	} else {
+		st, err := client.Student.Get(ctx, in.StudentID)
+		if err != nil {
+			return nil, err
+		}
+		result.Student = toStudentDTO(st, studentBalanceSummary{})
+		for _, input := range in.Enrollments {
+			created, err := enrollmentCreateInStore(ctx, client, st.ID, input.CourseID, input.BillingMode, input.ChargeMaterials, input.LessonPriceOverride, input.SubscriptionLessonPrice, input.Note)
+			if err != nil {
+				return nil, err
+			}
+			if created, err = enrollmentSetDatesInStore(ctx, client, created.ID, input.StartsOn, input.EndsOn); err != nil {
+				return nil, err
+			}
+			result.Enrollments = append(result.Enrollments, *created)
+		}
+	}
+
+	update := client.Lead.UpdateOneID(id).
+		SetStatus(lead.StatusConverted).
+		SetStudentID(result.Student.ID).
+		SetConvertedAt(leadCurrentTime())
+	if item.TrialPriceCents > 0 && item.TrialAt != nil && item.TrialOutcome != nil && *item.TrialOutcome == lead.TrialOutcomeAttended {
+		courseName, vatRate := "", 0.0
+		if c := item.Edges.Course; c != nil {
+			courseName, vatRate = c.Name, c.VatRatePct
+		}
+		charge, err := invsvc.New(client).CreateCharge(ctx, StudentChargeInput{
+			StudentID:   result.Student.ID,
+			Kind:        invsvc.ChargeKindOneOff,
+			Description: fmt.Sprintf(leadTrialChargeDescription, item.TrialAt.Format("02.01.2006"), courseName),
+			Amount:      money.CentsToEuros(item.TrialPriceCents),
+			VATRate:     vatRate,
+			DueYear:     item.TrialAt.Year(),
+			DueMonth:    int(item.TrialAt.Month()),
+		}, actorUsername(ctx))
+		if err != nil {
+			return nil, err
+		}
+		result.TrialCharge = charge
+		update.SetTrialChargeID(charge.ID)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	dto, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result.Lead = *dto
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.convert",
+		Summary:    fmt.Sprintf("Converted lead %s into a student", dto.FullName),
+		After:      result,
+		StudentID:  intPtr(result.Student.ID),
+	})
+	return result, nil
+}
+
+// LeadDelete removes a lead that has not been converted; converted leads
+// stay for the funnel report.
+func (s *Service) LeadDelete(ctx context.Context, id int) error {
+	before, err := s.LeadGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	if before.Status == LeadStatusConverted {
+		return apperrors.Conflict("a converted lead cannot be deleted")
+	}
+	if err := s.rt.DB.Ent.Lead.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lead",
+		EntityID:   intPtr(id),
+		Action:     "lead.delete",
+		Summary:    fmt.Sprintf("Deleted lead %s", before.FullName),
+		Before:     before,
+	})
+	return nil
+}
+
+// LeadFunnel counts the leads created in a year per course of interest and
+// per month, with the share of them that became students.
+func (s *Service) LeadFunnel(ctx context.Context, year int) (*LeadFunnelDTO, error) {
+	if year < 2000 || year > 2100 {
+		return nil, errors.New("year must be between 2000 and 2100")
+	}
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	items, err := s.rt.DB.Ent.Lead.Query().
+		Where(lead.CreatedAtGTE(from), lead.CreatedAtLT(from.AddDate(1, 0, 0))).
+		WithCourse().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &LeadFunnelDTO{
+		Year:     year,
+		ByCourse: make([]LeadFunnelRow, 0),
+		ByMonth:  make([]LeadFunnelRow, 0),
+	}
+	byCourse := map[int]*LeadFunnelRow{}
+	byMonth := map[int]*LeadFunnelRow{}
+	for _, item := range items {
+		courseKey := 0
+		if item.CourseID != nil {
+			courseKey = *item.CourseID
+		}
+		courseRow := byCourse[courseKey]
+		if courseRow == nil {
+			courseRow = &LeadFunnelRow{}
+			if c := item.Edges.Course; c != nil {
+				id := c.ID
+				courseRow.CourseID = &id
+				courseRow.CourseName = c.Name
+			}
+			byCourse[courseKey] = courseRow
+		}
+		month := int(item.CreatedAt.In(time.Local).Month())
+		monthRow := byMonth[month]
+		if monthRow == nil {
+			monthRow = &LeadFunnelRow{Month: month}
+			byMonth[month] = monthRow
+		}
+		for _, row := range []*LeadFunnelRow{courseRow, monthRow, &out.Total} {
+			countLead(row, item)
+		}
+	}
+	for _, row := range byCourse {
+		out.ByCourse = append(out.ByCourse, finishFunnelRow(*row))
+	}
+	sort.Slice(out.ByCourse, func(i, j int) bool {
+		a, b := out.ByCourse[i], out.ByCourse[j]
+		if (a.CourseID == nil) != (b.CourseID == nil) {
+			return b.CourseID == nil
+		}
+		return a.CourseName < b.CourseName
+	})
+	for month := 1; month <= 12; month++ {
+		if row := byMonth[month]; row != nil {
+			out.ByMonth = append(out.ByMonth, finishFunnelRow(*row))
+		}
+	}
+	out.Total = finishFunnelRow(out.Total)
+	return out, nil
+}
+
+func countLead(row *LeadFunnelRow, item *ent.Lead) {
+	row.Leads++
+	if item.TrialAt != nil {
+		row.Trials++
+	}
+	if item.TrialOutcome != nil && *item.TrialOutcome == lead.TrialOutcomeAttended {
+		row.Attended++
+	}
+	switch item.Status {
+	case lead.StatusConverted:
+		row.Converted++
+	case lead.StatusLost:
+		row.Lost++
+	}
+}
+
+func finishFunnelRow(row LeadFunnelRow) LeadFunnelRow {
+	if row.Leads > 0 {
+		row.ConversionRate = math.Round(float64(row.Converted)*1000/float64(row.Leads)) / 10
+	}
+	return row
+}
+
+func (s *Service) normalizeLeadInput(ctx context.Context, in LeadInput) (LeadInput, error) {
+	in.FullName = sanitizeInput(normalizePersonNameInput(in.FullName))
+	if err := validatePersonName(in.FullName, "fullName", true); err != nil {
+		return in, err
+	}
+	in.PersonalCode = sanitizeInput(in.PersonalCode)
+	in.Phone = sanitizeInput(in.Phone)
+	in.Email = sanitizeInput(in.Email)
+	in.PayerName = sanitizeInput(normalizePersonNameInput(in.PayerName))
+	in.PayerRole = normalizePayerRole(in.PayerRole)
+	in.Source = sanitizeInput(in.Source)
+	in.Note = sanitizeInput(in.Note)
+	if err := validatePersonalCode(in.PersonalCode); err != nil {
+		return in, err
+	}
+	if err := validatePhone(in.Phone); err != nil {
+		return in, err
+	}
+	if err := validateEmail(in.Email); err != nil {
+		return in, err
+	}
+	if err := validatePersonName(in.PayerName, "payerName", false); err != nil {
+		return in, err
+	}
+	if in.CourseID > 0 {
+		if _, err := s.rt.DB.Ent.Course.Get(ctx, in.CourseID); err != nil {
+			return in, err
+		}
+	}
+	return in, nil
+}
+
+func parseLeadTrialAt(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("trialAt must be YYYY-MM-DD or YYYY-MM-DDTHH:MM")
+}
+
+func toLeadDTO(item *ent.Lead) LeadDTO {
+	dto := LeadDTO{
+		ID:            item.ID,
+		Version:       item.Version,
+		FullName:      item.FullName,
+		PersonalCode:  item.PersonalCode,
+		Phone:         item.Phone,
+		Email:         item.Email,
+		IsMinor:       item.IsMinor,
+		PayerName:     item.PayerName,
+		PayerRole:     item.PayerRole,
+		Source:        item.Source,
+		Note:          item.Note,
+		Status:        string(item.Status),
+		CourseID:      item.CourseID,
+		TrialPrice:    money.CentsToEuros(item.TrialPriceCents),
+		OutcomeNote:   item.OutcomeNote,
+		LostReason:    item.LostReason,
+		StudentID:     item.StudentID,
+		TrialChargeID: item.TrialChargeID,
+		CreatedBy:     item.CreatedBy,
+		CreatedAt:     formatOptionalTime(&item.CreatedAt),
+	}
+	if item.TrialAt != nil {
+		dto.TrialAt = item.TrialAt.Format("2006-01-02T15:04")
+	}
+	if item.TrialOutcome != nil {
+		dto.TrialOutcome = string(*item.TrialOutcome)
+	}
+	if item.ConvertedAt != nil {
+		dto.ConvertedAt = formatOptionalTime(item.ConvertedAt)
+	}
+	if c := item.Edges.Course; c != nil {
+		dto.CourseName = c.Name
+	}
+	if st := item.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	return dto
+}
@@ -0,0 +1,104 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	auditsvc "langschool/internal/app/audit"
+)
+
+func (s *Service) LessonPackageList(ctx context.Context, studentID int) ([]LessonPackageDTO, error) {
+	return s.rt.Invoice.ListPackages(ctx, studentID)
+}
+
+func (s *Service) LessonPackageGet(ctx context.Context, id int) (*LessonPackageDTO, error) {
+	return s.rt.Invoice.GetPackage(ctx, id)
+}
+
+// LessonPackageWarnings lists open packages that run low or expire.
+func (s *Service) LessonPackageWarnings(ctx context.Context) ([]LessonPackageDTO, error) {
+	return s.rt.Invoice.PackageWarnings(ctx)
+}
+
+// LessonPackageSell sells a package and issues its invoice with a PDF.
+func (s *Service) LessonPackageSell(ctx context.Context, in LessonPackageInput) (*LessonPackageDTO, error) {
+	before, _, err := s.auditStudentFinanceSnapshot(ctx, in.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.SellPackage(ctx, in, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ensureIssuedInvoicePDF(ctx, *item.InvoiceID); err != nil {
+		return nil, err
+	}
+	if item, err = s.rt.Invoice.GetPackage(ctx, item.ID); err != nil {
+		return nil, err
+	}
+	after, _, err := s.auditStudentFinanceSnapshot(ctx, item.StudentID)
+	if err == nil {
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "lesson_package",
+			EntityID:   intPtr(item.ID),
+			Action:     "lesson_package.sell",
+			Summary:    fmt.Sprintf("Sold a package of %g lessons of %s to %s for %.2f", item.Lessons, item.CourseName, item.StudentName, item.Price),
+			Before:     before,
+			After:      after,
+			StudentID:  intPtr(item.StudentID),
+			InvoiceID:  item.InvoiceID,
+		})
+	}
+	return item, nil
+}
+
+// LessonPackageCarryOver moves a package's unused lessons to a new package.
+func (s *Service) LessonPackageCarryOver(ctx context.Context, id int, expiresOn string) (*LessonPackageDTO, error) {
+	before, err := s.rt.Invoice.GetPackage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.CarryOverPackage(ctx, id, expiresOn, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "lesson_package",
+		EntityID:   intPtr(before.ID),
+		Action:     "lesson_package.carry_over",
+		Summary:    fmt.Sprintf("Carried %g unused lessons of %s for %s over to package %d", item.Lessons, item.CourseName, item.StudentName, item.ID),
+		Before:     before,
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
+
+// LessonPackageRefund pays back the value of a package's unused lessons.
+func (s *Service) LessonPackageRefund(ctx context.Context, id int, method, note string) (*LessonPackageDTO, error) {
+	pkg, err := s.rt.Invoice.GetPackage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before, _, err := s.auditStudentFinanceSnapshot(ctx, pkg.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.RefundPackage(ctx, id, method, note, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	after, _, err := s.auditStudentFinanceSnapshot(ctx, item.StudentID)
+	if err == nil {
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "lesson_package",
+			EntityID:   intPtr(item.ID),
+			Action:     "lesson_package.refund",
+			Summary:    fmt.Sprintf("Refunded %.2f (%s) for %g unused lessons of %s to %s", item.Refund, method, item.UnusedLessons, item.CourseName, item.StudentName),
+			Before:     before,
+			After:      after,
+			StudentID:  intPtr(item.StudentID),
+		})
+	}
+	return item, nil
+}
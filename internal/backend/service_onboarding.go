@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"langschool/ent"
 	"langschool/ent/enrollment"
 	"langschool/ent/student"
 )
@@ -40,7 +41,19 @@ func (s *Service) StudentOnboardMany(ctx context.Context, studentInput StudentCr
 	}
 	defer tx.Rollback()
 
-	client := tx.Client()
+	result, err := studentOnboardInStore(ctx, tx.Client(), studentInput, enrollmentInputs)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// studentOnboardInStore creates a student with their enrollments using the
+// caller's transaction client.
+func studentOnboardInStore(ctx context.Context, client *ent.Client, studentInput StudentCreateInput, enrollmentInputs []EnrollmentCreateInput) (*StudentOnboardingResult, error) {
 	studentDTO, err := studentCreateInStore(
 		ctx,
 		client,
@@ -76,16 +89,15 @@ func (s *Service) StudentOnboardMany(ctx context.Context, studentInput StudentCr
 		if err != nil {
 			return nil, err
 		}
+		if enrollmentDTO, err = enrollmentSetDatesInStore(ctx, client, enrollmentDTO.ID, enrollmentInput.StartsOn, enrollmentInput.EndsOn); err != nil {
+			return nil, err
+		}
 		result.Enrollments = append(result.Enrollments, *enrollmentDTO)
 	}
 	if len(result.Enrollments) > 0 {
 		first := result.Enrollments[0]
 		result.Enrollment = &first
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
 	return result, nil
 }
 
@@ -152,6 +164,9 @@ func (s *Service) EnrollmentCreateMany(ctx context.Context, studentID int, input
 		if err != nil {
 			return nil, err
 		}
+		if created, err = enrollmentSetDatesInStore(ctx, client, created.ID, input.StartsOn, input.EndsOn); err != nil {
+			return nil, err
+		}
 		result.Enrollments = append(result.Enrollments, *created)
 	}
 
@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	auditsvc "langschool/internal/app/audit"
+)
+
+func (s *Service) PaymentPlanList(ctx context.Context, studentID int, activeOnly bool) ([]PaymentPlanDTO, error) {
+	return s.rt.Payment.ListPlans(ctx, studentID, activeOnly)
+}
+
+func (s *Service) PaymentPlanGet(ctx context.Context, id int) (*PaymentPlanDTO, error) {
+	return s.rt.Payment.GetPlan(ctx, id)
+}
+
+func (s *Service) PaymentPlanCreate(ctx context.Context, in PaymentPlanInput) (*PaymentPlanDTO, error) {
+	item, err := s.rt.Payment.CreatePlan(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "payment_plan",
+		EntityID:   intPtr(item.ID),
+		Action:     "payment_plan.create",
+		Summary:    fmt.Sprintf("Agreed payment plan %d for %s: %.2f in %d instalments", item.ID, item.StudentName, item.Total, len(item.Instalments)),
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
+
+func (s *Service) PaymentPlanCancel(ctx context.Context, id int) (*PaymentPlanDTO, error) {
+	before, err := s.rt.Payment.GetPlan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Payment.CancelPlan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "payment_plan",
+		EntityID:   intPtr(item.ID),
+		Action:     "payment_plan.cancel",
+		Summary:    fmt.Sprintf("Canceled payment plan %d for %s with %.2f unpaid", item.ID, item.StudentName, item.Remaining),
+		Before:     before,
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	auditsvc "langschool/internal/app/audit"
+	paysvc "langschool/internal/app/payment"
+	"langschool/internal/money"
+	appruntime "langschool/internal/runtime"
 )
 
 func (s *Service) PaymentCreate(ctx context.Context, studentID int, invoiceID *int, amount float64, method string, paidAt string, note string) (*PaymentDTO, error) {
@@ -42,6 +45,42 @@ func (s *Service) PaymentCreate(ctx context.Context, studentID int, invoiceID *i
 	return item, nil
 }
 
+func (s *Service) PaymentMatchBank(ctx context.Context, remittance string) (*BankMatchDTO, error) {
+	return s.rt.Payment.MatchBankRemittance(ctx, remittance)
+}
+
+func (s *Service) PaymentRecordBank(ctx context.Context, remittance string, amount float64, paidAt string, note string) (*PaymentDTO, error) {
+	match, err := s.rt.Payment.MatchBankRemittance(ctx, remittance)
+	if err != nil {
+		return nil, err
+	}
+	if match.InvoiceID == nil {
+		return nil, paysvc.ErrBankPaymentUnmatched
+	}
+	before, _, err := s.auditStudentFinanceSnapshot(ctx, match.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Payment.RecordBankPayment(ctx, remittance, amount, paidAt, note)
+	if err != nil {
+		return nil, err
+	}
+	after, studentMeta, err := s.auditStudentFinanceSnapshot(ctx, item.StudentID)
+	if err == nil {
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "payment",
+			EntityID:   intPtr(item.ID),
+			Action:     "payment.bank_match",
+			Summary:    fmt.Sprintf("Recorded bank payment of %.2f for %s matched by %s", amount, studentMeta.StudentName, match.MatchedBy),
+			Before:     before,
+			After:      after,
+			StudentID:  intPtr(item.StudentID),
+			InvoiceID:  match.InvoiceID,
+		})
+	}
+	return item, nil
+}
+
 func (s *Service) PaymentDelete(ctx context.Context, paymentID int) error {
 	before, meta, err := s.auditPaymentDeleteSnapshot(ctx, paymentID)
 	if err != nil {
@@ -63,9 +102,171 @@ func (s *Service) PaymentDelete(ctx context.Context, paymentID int) error {
 			InvoiceID:  meta.InvoiceID,
 		})
 	}
+	if meta.CashReceiptID != nil {
+		if receipt, err := s.rt.Payment.GetCashReceipt(ctx, *meta.CashReceiptID); err == nil {
+			s.recordAudit(ctx, auditsvc.RecordEvent{
+				EntityType: "cash_receipt",
+				EntityID:   intPtr(receipt.ID),
+				Action:     "cash_receipt.void",
+				Summary:    fmt.Sprintf("Voided cash receipt %s for %s, amount %.2f", receipt.Number, receipt.StudentName, receipt.Amount),
+				Before:     before["cashReceipt"],
+				After:      receipt,
+				StudentID:  intPtr(meta.StudentID),
+			})
+		}
+	}
 	return nil
 }
 
+// PaymentRefund pays back part of the credit left by an unallocated payment.
+func (s *Service) PaymentRefund(ctx context.Context, paymentID int, amount float64, method, refundedAt, note string) (*PaymentDTO, error) {
+	original, err := s.rt.DB.Ent.Payment.Get(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	before, _, err := s.auditStudentFinanceSnapshot(ctx, original.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Payment.Refund(ctx, paymentID, amount, method, refundedAt, note)
+	if err != nil {
+		return nil, err
+	}
+	after, studentMeta, err := s.auditStudentFinanceSnapshot(ctx, item.StudentID)
+	if err == nil {
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "payment",
+			EntityID:   intPtr(item.ID),
+			Action:     "payment.refund",
+			Summary:    fmt.Sprintf("Refunded %.2f (%s) to %s from payment %d", -item.Amount, item.Method, studentMeta.StudentName, paymentID),
+			Before:     before,
+			After:      after,
+			StudentID:  intPtr(item.StudentID),
+		})
+	}
+	return item, nil
+}
+
+// CreditTransfer moves credit from one student to another.
+func (s *Service) CreditTransfer(ctx context.Context, fromStudentID, toStudentID int, amount float64, note string) (*CreditTransferDTO, error) {
+	fromBefore, fromMeta, err := s.auditStudentFinanceSnapshot(ctx, fromStudentID)
+	if err != nil {
+		return nil, err
+	}
+	toBefore, toMeta, err := s.auditStudentFinanceSnapshot(ctx, toStudentID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Payment.TransferCredit(ctx, fromStudentID, toStudentID, amount, note)
+	if err != nil {
+		return nil, err
+	}
+	fromAfter, _, fromErr := s.auditStudentFinanceSnapshot(ctx, fromStudentID)
+	toAfter, _, toErr := s.auditStudentFinanceSnapshot(ctx, toStudentID)
+	if fromErr == nil && toErr == nil {
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "payment",
+			EntityID:   intPtr(item.Out.ID),
+			Action:     "payment.credit_transfer",
+			Summary:    fmt.Sprintf("Transferred credit of %.2f from %s to %s", item.In.Amount, fromMeta.StudentName, toMeta.StudentName),
+			Before:     map[string]any{"from": fromBefore, "to": toBefore},
+			After:      map[string]any{"from": fromAfter, "to": toAfter},
+			StudentID:  intPtr(fromStudentID),
+		})
+	}
+	return item, nil
+}
+
+// PaymentReallocate moves all or part of a payment to another invoice of the
+// same student and records the allocations before and after the move.
+func (s *Service) PaymentReallocate(ctx context.Context, paymentID, toInvoiceID int, amount float64) (*ReallocationDTO, error) {
+	original, err := s.rt.DB.Ent.Payment.Get(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	before, _, err := s.auditStudentFinanceSnapshot(ctx, original.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	fromBefore, toBefore, err := s.auditReallocationInvoices(ctx, original.InvoiceID, toInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Payment.Reallocate(ctx, paymentID, toInvoiceID, amount)
+	if err != nil {
+		return nil, err
+	}
+	after, studentMeta, err := s.auditStudentFinanceSnapshot(ctx, original.StudentID)
+	if err == nil {
+		from := "credit"
+		if original.InvoiceID != nil {
+			from = fmt.Sprintf("invoice %d", *original.InvoiceID)
+		}
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "payment",
+			EntityID:   intPtr(paymentID),
+			Action:     "payment.reallocate",
+			Summary:    fmt.Sprintf("Reallocated %.2f of payment %d for %s from %s to invoice %d", item.Moved.Amount, paymentID, studentMeta.StudentName, from, toInvoiceID),
+			Before: map[string]any{
+				"allocation": map[string]any{
+					"paymentId": original.ID,
+					"invoiceId": original.InvoiceID,
+					"amount":    money.CentsToEuros(original.AmountCents),
+				},
+				"fromInvoice": fromBefore,
+				"toInvoice":   toBefore,
+				"student":     before,
+			},
+			After: map[string]any{
+				"moved":       item.Moved,
+				"remaining":   item.Remaining,
+				"fromInvoice": item.FromInvoice,
+				"toInvoice":   item.ToInvoice,
+				"student":     after,
+			},
+			StudentID: intPtr(original.StudentID),
+			InvoiceID: intPtr(toInvoiceID),
+		})
+	}
+	return item, nil
+}
+
+func (s *Service) auditReallocationInvoices(ctx context.Context, fromInvoiceID *int, toInvoiceID int) (*InvoiceSummaryDTO, *InvoiceSummaryDTO, error) {
+	var from *InvoiceSummaryDTO
+	if fromInvoiceID != nil {
+		summary, err := s.rt.Payment.InvoiceSummary(ctx, *fromInvoiceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		from = summary
+	}
+	to, err := s.rt.Payment.InvoiceSummary(ctx, toInvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return from, to, nil
+}
+
+func (s *Service) CreditLedger(ctx context.Context, studentID int, scope string) (*CreditLedgerDTO, error) {
+	return s.rt.Payment.CreditLedger(ctx, studentID, scope)
+}
+
+func (s *Service) CashReceiptList(ctx context.Context, studentID int) ([]CashReceiptDTO, error) {
+	return s.rt.Payment.ListCashReceipts(ctx, studentID)
+}
+
+func (s *Service) CashReceiptGet(ctx context.Context, id int) (*CashReceiptDTO, error) {
+	return s.rt.Payment.GetCashReceipt(ctx, id)
+}
+
+func (s *Service) CashReceiptPDF(ctx context.Context, id int) ([]byte, string, error) {
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.rt.Payment.CashReceiptPDF(ctx, id, fonts)
+}
+
 func (s *Service) PaymentListForStudent(ctx context.Context, studentID int) ([]PaymentDTO, error) {
 	return s.rt.Payment.ListForStudent(ctx, studentID)
 }
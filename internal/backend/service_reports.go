@@ -0,0 +1,159 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/app/taxcert"
+	"langschool/internal/email"
+	"langschool/internal/money"
+	appruntime "langschool/internal/runtime"
+)
+
+const (
+	educationCertificateEmailSubject = "Izziņa par izglītības izdevumiem %d. gadā"
+	educationCertificateEmailBody    = "Labdien!\n\nPielikumā nosūtām izziņu par %d. gadā samaksātajiem izglītības izdevumiem, ko var pievienot gada ienākumu deklarācijai.\n\nAr cieņu,\n%s"
+)
+
+type EducationExpensePayerDTO = taxcert.PayerDTO
+
+// ReportFile is a generated document kept in memory, e.g. one entry of a
+// bulk ZIP download.
+type ReportFile struct {
+	Name string
+	Data []byte
+}
+
+type EducationCertificateEmailItem struct {
+	Key       string `json:"key"`
+	PayerName string `json:"payerName"`
+	To        string `json:"to,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type EducationCertificateEmailResult struct {
+	Sent    []EducationCertificateEmailItem `json:"sent"`
+	Skipped []EducationCertificateEmailItem `json:"skipped"` // Payers without an email address
+	Failed  []EducationCertificateEmailItem `json:"failed"`
+}
+
+// EducationExpenses lists the private payers with payments received during
+// the year, the basis of the education-expense certificates.
+func (s *Service) EducationExpenses(ctx context.Context, year int) ([]EducationExpensePayerDTO, error) {
+	return s.rt.TaxCert.List(ctx, year)
+}
+
+func (s *Service) EducationCertificatePDF(ctx context.Context, year int, key string) ([]byte, string, error) {
+	cert, err := s.rt.TaxCert.Certificate(ctx, year, strings.TrimSpace(key), time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.rt.TaxCert.PDF(ctx, *cert, fonts)
+}
+
+// EducationCertificatesZIP renders the certificates of all payers for a
+// bulk download.
+func (s *Service) EducationCertificatesZIP(ctx context.Context, year int) ([]ReportFile, string, error) {
+	certs, err := s.rt.TaxCert.Certificates(ctx, year, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, "", err
+	}
+	files := make([]ReportFile, 0, len(certs))
+	used := map[string]bool{}
+	for _, cert := range certs {
+		data, name, err := s.rt.TaxCert.PDF(ctx, cert, fonts)
+		if err != nil {
+			return nil, "", err
+		}
+		// Two parents may share a name; keep both files.
+		if used[name] {
+			name = strings.TrimSuffix(name, ".pdf") + " (" + cert.Key + ").pdf"
+		}
+		used[name] = true
+		files = append(files, ReportFile{Name: name, Data: data})
+	}
+	return files, fmt.Sprintf("izglitibas-izzinas-%d.zip", year), nil
+}
+
+// EducationCertificatesSendEmail emails each payer their certificate. An
+// empty key list sends to every payer with an email address; payers without
+// one are reported as skipped.
+func (s *Service) EducationCertificatesSendEmail(ctx context.Context, year int, keys []string) (*EducationCertificateEmailResult, error) {
+	if s.emailSender == nil {
+		return nil, fmt.Errorf(email.ErrNotConfiguredText)
+	}
+	certs, err := s.rt.TaxCert.Certificates(ctx, year, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	wanted := map[string]bool{}
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			wanted[key] = true
+		}
+	}
+	templateSettings, err := s.invoiceEmailSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EducationCertificateEmailResult{
+		Sent:    []EducationCertificateEmailItem{},
+		Skipped: []EducationCertificateEmailItem{},
+		Failed:  []EducationCertificateEmailItem{},
+	}
+	for _, cert := range certs {
+		if len(wanted) > 0 && !wanted[cert.Key] {
+			continue
+		}
+		item := EducationCertificateEmailItem{Key: cert.Key, PayerName: cert.PayerName, To: cert.Email}
+		if cert.Email == "" {
+			result.Skipped = append(result.Skipped, item)
+			continue
+		}
+		data, filename, err := s.rt.TaxCert.PDF(ctx, cert, fonts)
+		if err == nil {
+			err = s.emailSender.Send(ctx, email.Message{
+				To:                 cert.Email,
+				Subject:            fmt.Sprintf(educationCertificateEmailSubject, year),
+				Body:               fmt.Sprintf(educationCertificateEmailBody, year, templateSettings.OrganizationName),
+				ReplyTo:            templateSettings.ReplyTo,
+				AttachmentFilename: filename,
+				AttachmentData:     data,
+			})
+		}
+		if err != nil {
+			item.Error = strings.TrimSpace(err.Error())
+			result.Failed = append(result.Failed, item)
+			continue
+		}
+		result.Sent = append(result.Sent, item)
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "education_certificate",
+			Action:     "education_certificate.send_email",
+			Summary:    fmt.Sprintf("Sent %d education-expense certificate of %s to %s", year, cert.PayerName, cert.Email),
+			After: map[string]any{
+				"year":               year,
+				"to":                 cert.Email,
+				"total":              money.CentsToEuros(cert.TotalCents()),
+				"attachmentFilename": filename,
+			},
+		})
+	}
+	return result, nil
+}
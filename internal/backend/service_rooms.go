@@ -0,0 +1,147 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"langschool/ent"
+	"langschool/ent/room"
+	"langschool/ent/scheduleslot"
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/apperrors"
+)
+
+func (s *Service) RoomList(ctx context.Context) ([]RoomDTO, error) {
+	items, err := s.rt.DB.Ent.Room.Query().Order(ent.Asc(room.FieldName)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RoomDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, toRoomDTO(item))
+	}
+	return out, nil
+}
+
+func (s *Service) RoomGet(ctx context.Context, id int) (*RoomDTO, error) {
+	item, err := s.rt.DB.Ent.Room.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dto := toRoomDTO(item)
+	return &dto, nil
+}
+
+func (s *Service) RoomCreate(ctx context.Context, in RoomInput) (*RoomDTO, error) {
+	in, err := normalizeRoomInput(in)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.DB.Ent.Room.Create().
+		SetName(in.Name).
+		SetCapacity(in.Capacity).
+		SetNote(in.Note).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrors.Conflict("a room with this name already exists")
+		}
+		return nil, err
+	}
+	dto := toRoomDTO(item)
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "room",
+		EntityID:   intPtr(dto.ID),
+		Action:     "room.create",
+		Summary:    fmt.Sprintf("Added room %s", dto.Name),
+		After:      dto,
+	})
+	return &dto, nil
+}
+
+func (s *Service) RoomUpdate(ctx context.Context, id, version int, in RoomInput) (*RoomDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	in, err := normalizeRoomInput(in)
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.RoomGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.DB.Ent.Room.UpdateOneID(id).
+		Where(room.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetName(in.Name).
+		SetCapacity(in.Capacity).
+		SetNote(in.Note).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrors.Conflict("a room with this name already exists")
+		}
+		return nil, staleOnNotFound(err)
+	}
+	after := toRoomDTO(item)
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "room",
+		EntityID:   intPtr(id),
+		Action:     "room.update",
+		Summary:    fmt.Sprintf("Updated room %s", after.Name),
+		Before:     before,
+		After:      after,
+	})
+	return &after, nil
+}
+
+// RoomDelete removes a room that no schedule slot uses.
+func (s *Service) RoomDelete(ctx context.Context, id int) error {
+	before, err := s.RoomGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	used, err := s.rt.DB.Ent.ScheduleSlot.Query().Where(scheduleslot.RoomIDEQ(id)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if used {
+		return errors.New("cannot delete room: it is used by schedule slots")
+	}
+	if err := s.rt.DB.Ent.Room.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "room",
+		EntityID:   intPtr(id),
+		Action:     "room.delete",
+		Summary:    fmt.Sprintf("Deleted room %s", before.Name),
+		Before:     before,
+	})
+	return nil
+}
+
+func normalizeRoomInput(in RoomInput) (RoomInput, error) {
+	in.Name = sanitizeInput(in.Name)
+	in.Note = sanitizeInput(in.Note)
+	if err := validateNonEmpty(in.Name, "name"); err != nil {
+		return in, err
+	}
+	if in.Capacity < 0 {
+		return in, errors.New("capacity must be >= 0")
+	}
+	return in, nil
+}
+
+func toRoomDTO(r *ent.Room) RoomDTO {
+	return RoomDTO{
+		ID:        r.ID,
+		Version:   r.Version,
+		Name:      r.Name,
+		Capacity:  r.Capacity,
+		Note:      r.Note,
+		CreatedAt: formatOptionalTime(&r.CreatedAt),
+	}
+}
@@ -0,0 +1,547 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/scheduleslot"
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/apperrors"
+)
+
+const (
+	ScheduleConflictRoom    = "room"
+	ScheduleConflictTeacher = "teacher"
+)
+
+var scheduleCurrentTime = time.Now
+
+// slotSpan is a schedule slot, or one about to be saved, with the room and
+// the teacher it actually uses.
+type slotSpan struct {
+	id          int
+	courseName  string
+	roomID      *int
+	roomName    string
+	teacherID   *int
+	teacherName string
+	weekday     int
+	start, end  int
+	from        time.Time
+	until       *time.Time
+}
+
+func scheduleToday() time.Time {
+	y, m, d := scheduleCurrentTime().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *Service) ScheduleSlotList(ctx context.Context, courseID, roomID, teacherID int) ([]ScheduleSlotDTO, error) {
+	q := scheduleSlotQuery(s.rt.DB.Ent)
+	if courseID > 0 {
+		q = q.Where(scheduleslot.CourseIDEQ(courseID))
+	}
+	if roomID > 0 {
+		q = q.Where(scheduleslot.RoomIDEQ(roomID))
+	}
+	items, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ScheduleSlotDTO, 0, len(items))
+	for _, item := range items {
+		dto := toScheduleSlotDTO(item)
+		if teacherID > 0 && (dto.TeacherID == nil || *dto.TeacherID != teacherID) {
+			continue
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
+
+func (s *Service) ScheduleSlotGet(ctx context.Context, id int) (*ScheduleSlotDTO, error) {
+	item, err := scheduleSlotQuery(s.rt.DB.Ent).Where(scheduleslot.IDEQ(id)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toScheduleSlotDTO(item)
+	return &dto, nil
+}
+
+// ScheduleSlotCreate adds a weekly lesson. It is refused when it double-books
+// a room or teacher from today on, unless the caller allows conflicts; the
+// conflicts are returned either way.
+func (s *Service) ScheduleSlotCreate(ctx context.Context, in ScheduleSlotInput) (*ScheduleSlotResult, error) {
+	span, err := s.scheduleSlotSpan(ctx, 0, in)
+	if err != nil {
+		return nil, err
+	}
+	var id int
+	var conflicts []ScheduleConflictDTO
+	if err := s.inTx(ctx, func(client *ent.Client) error {
+		var err error
+		id, conflicts, err = scheduleSlotCreateInStore(ctx, client, span, in)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	dto, err := s.ScheduleSlotGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "schedule_slot",
+		EntityID:   intPtr(dto.ID),
+		Action:     "schedule_slot.create",
+		Summary:    fmt.Sprintf("Scheduled %s on %s %s–%s", dto.CourseName, weekdayName(dto.Weekday), dto.StartsAt, dto.EndsAt),
+		After:      dto,
+	})
+	return &ScheduleSlotResult{Slot: *dto, Conflicts: conflicts}, nil
+}
+
+// scheduleSlotCreateInStore checks the slot for conflicts and saves it with
+// the same client, so no other slot can take the room or teacher in between.
+func scheduleSlotCreateInStore(ctx context.Context, client *ent.Client, span slotSpan, in ScheduleSlotInput) (int, []ScheduleConflictDTO, error) {
+	conflicts, err := scheduleConflictsFor(ctx, client, span)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(conflicts) > 0 && !in.AllowConflicts {
+		return 0, nil, scheduleConflictError(conflicts)
+	}
+	create := client.ScheduleSlot.Create().
+		SetCourseID(in.CourseID).
+		SetWeekday(span.weekday).
+		SetStartMinute(span.start).
+		SetEndMinute(span.end).
+		SetValidFrom(span.from).
+		SetNillableValidUntil(span.until).
+		SetNote(sanitizeInput(in.Note)).
+		SetCreatedBy(actorUsername(ctx))
+	if in.RoomID > 0 {
+		create.SetRoomID(in.RoomID)
+	}
+	if in.TeacherID > 0 {
+		create.SetTeacherID(in.TeacherID)
+	}
+	item, err := create.Save(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := range conflicts {
+		conflicts[i].SlotID = item.ID
+	}
+	return item.ID, conflicts, nil
+}
+
+func (s *Service) ScheduleSlotUpdate(ctx context.Context, id, version int, in ScheduleSlotInput) (*ScheduleSlotResult, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	before, err := s.ScheduleSlotGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	span, err := s.scheduleSlotSpan(ctx, id, in)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []ScheduleConflictDTO
+	if err := s.inTx(ctx, func(client *ent.Client) error {
+		var err error
+		conflicts, err = scheduleSlotUpdateInStore(ctx, client, id, version, span, in)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	after, err := s.ScheduleSlotGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "schedule_slot",
+		EntityID:   intPtr(id),
+		Action:     "schedule_slot.update",
+		Summary:    fmt.Sprintf("Moved %s to %s %s–%s", after.CourseName, weekdayName(after.Weekday), after.StartsAt, after.EndsAt),
+		Before:     before,
+		After:      after,
+	})
+	return &ScheduleSlotResult{Slot: *after, Conflicts: conflicts}, nil
+}
+
+// scheduleSlotUpdateInStore checks the moved slot for conflicts and saves it
+// with the same client, like scheduleSlotCreateInStore.
+func scheduleSlotUpdateInStore(ctx context.Context, client *ent.Client, id, version int, span slotSpan, in ScheduleSlotInput) ([]ScheduleConflictDTO, error) {
+	conflicts, err := scheduleConflictsFor(ctx, client, span)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 && !in.AllowConflicts {
+		return nil, scheduleConflictError(conflicts)
+	}
+	update := client.ScheduleSlot.UpdateOneID(id).
+		Where(scheduleslot.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetCourseID(in.CourseID).
+		SetWeekday(span.weekday).
+		SetStartMinute(span.start).
+		SetEndMinute(span.end).
+		SetValidFrom(span.from).
+		SetNote(sanitizeInput(in.Note))
+	if span.until != nil {
+		update.SetValidUntil(*span.until)
+	} else {
+		update.ClearValidUntil()
+	}
+	if in.RoomID > 0 {
+		update.SetRoomID(in.RoomID)
+	} else {
+		update.ClearRoomID()
+	}
+	if in.TeacherID > 0 {
+		update.SetTeacherID(in.TeacherID)
+	} else {
+		update.ClearTeacherID()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	return conflicts, nil
+}
+
+func (s *Service) ScheduleSlotDelete(ctx context.Context, id int) error {
+	before, err := s.ScheduleSlotGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.rt.DB.Ent.ScheduleSlot.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "schedule_slot",
+		EntityID:   intPtr(id),
+		Action:     "schedule_slot.delete",
+		Summary:    fmt.Sprintf("Removed %s on %s %s–%s from the schedule", before.CourseName, weekdayName(before.Weekday), before.StartsAt, before.EndsAt),
+		Before:     before,
+	})
+	return nil
+}
+
+// ScheduleConflicts lists every double-booking of a room or teacher from
+// today on, e.g. those allowed on purpose or caused by a teacher change.
+func (s *Service) ScheduleConflicts(ctx context.Context) ([]ScheduleConflictDTO, error) {
+	spans, err := futureSlotSpans(ctx, s.rt.DB.Ent, 0)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ScheduleConflictDTO, 0)
+	for i, a := range spans {
+		out = append(out, slotConflicts(a, spans[i+1:], scheduleToday())...)
+	}
+	return out, nil
+}
+
+// courseTeacherConflicts re-checks the future lessons a course's own teacher
+// gives after the teacher has changed.
+func (s *Service) courseTeacherConflicts(ctx context.Context, courseID int) ([]ScheduleConflictDTO, error) {
+	items, err := scheduleSlotQuery(s.rt.DB.Ent).
+		Where(
+			scheduleslot.CourseIDEQ(courseID),
+			scheduleslot.TeacherIDIsNil(),
+			scheduleslot.Or(scheduleslot.ValidUntilIsNil(), scheduleslot.ValidUntilGTE(scheduleToday())),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ScheduleConflictDTO, 0)
+	for _, item := range items {
+		conflicts, err := scheduleConflictsFor(ctx, s.rt.DB.Ent, toSlotSpan(item))
+		if err != nil {
+			return nil, err
+		}
+		for _, conflict := range conflicts {
+			if conflict.Kind == ScheduleConflictTeacher {
+				out = append(out, conflict)
+			}
+		}
+	}
+	return out, nil
+}
+
+// RoomOccupancy lists the lessons held in a room in the week of weekOf
+// (YYYY-MM-DD, default this week).
+func (s *Service) RoomOccupancy(ctx context.Context, roomID int, weekOf string) (*RoomOccupancyDTO, error) {
+	r, err := s.rt.DB.Ent.Room.Get(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	day := scheduleToday()
+	if weekOf = strings.TrimSpace(weekOf); weekOf != "" {
+		if day, err = time.Parse("2006-01-02", weekOf); err != nil {
+			return nil, errors.New("week must be YYYY-MM-DD")
+		}
+	}
+	monday := day.AddDate(0, 0, -(isoWeekday(day) - 1))
+	items, err := scheduleSlotQuery(s.rt.DB.Ent).
+		Where(
+			scheduleslot.RoomIDEQ(roomID),
+			scheduleslot.ValidFromLTE(monday.AddDate(0, 0, 6)),
+			scheduleslot.Or(scheduleslot.ValidUntilIsNil(), scheduleslot.ValidUntilGTE(monday)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &RoomOccupancyDTO{
+		RoomID:    r.ID,
+		RoomName:  r.Name,
+		WeekStart: monday.Format("2006-01-02"),
+		Days:      make([]RoomOccupancyDayDTO, 0, 7),
+	}
+	for i := 0; i < 7; i++ {
+		date := monday.AddDate(0, 0, i)
+		dayDTO := RoomOccupancyDayDTO{
+			Date:    date.Format("2006-01-02"),
+			Weekday: i + 1,
+			Slots:   make([]ScheduleSlotDTO, 0),
+		}
+		for _, item := range items {
+			if item.Weekday != i+1 || item.ValidFrom.After(date) || (item.ValidUntil != nil && item.ValidUntil.Before(date)) {
+				continue
+			}
+			dayDTO.Slots = append(dayDTO.Slots, toScheduleSlotDTO(item))
+			dayDTO.BookedMinutes += item.EndMinute - item.StartMinute
+		}
+		out.BookedMinutes += dayDTO.BookedMinutes
+		out.Days = append(out.Days, dayDTO)
+	}
+	return out, nil
+}
+
+// scheduleSlotSpan validates an input and resolves the room and teacher the
+// slot would use.
+func (s *Service) scheduleSlotSpan(ctx context.Context, id int, in ScheduleSlotInput) (slotSpan, error) {
+	span := slotSpan{id: id, weekday: in.Weekday}
+	if in.CourseID <= 0 {
+		return span, errors.New("courseId is required")
+	}
+	if in.Weekday < 1 || in.Weekday > 7 {
+		return span, errors.New("weekday must be between 1 (Monday) and 7 (Sunday)")
+	}
+	var err error
+	if span.start, err = parseClock(in.StartsAt, "startsAt"); err != nil {
+		return span, err
+	}
+	if span.end, err = parseClock(in.EndsAt, "endsAt"); err != nil {
+		return span, err
+	}
+	if span.end <= span.start {
+		return span, errors.New("endsAt must be after startsAt")
+	}
+	span.from = scheduleToday()
+	if value := strings.TrimSpace(in.ValidFrom); value != "" {
+		if span.from, err = time.Parse("2006-01-02", value); err != nil {
+			return span, errors.New("validFrom must be YYYY-MM-DD")
+		}
+	}
+	if value := strings.TrimSpace(in.ValidUntil); value != "" {
+		until, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return span, errors.New("validUntil must be YYYY-MM-DD")
+		}
+		if until.Before(span.from) {
+			return span, errors.New("validUntil must be on or after validFrom")
+		}
+		span.until = &until
+	}
+	c, err := s.rt.DB.Ent.Course.Query().Where(course.IDEQ(in.CourseID)).WithTeacher().Only(ctx)
+	if err != nil {
+		return span, err
+	}
+	span.courseName = c.Name
+	if in.RoomID > 0 {
+		r, err := s.rt.DB.Ent.Room.Get(ctx, in.RoomID)
+		if err != nil {
+			return span, err
+		}
+		span.roomID, span.roomName = &r.ID, r.Name
+	}
+	if in.TeacherID > 0 {
+		t, err := s.rt.DB.Ent.Teacher.Get(ctx, in.TeacherID)
+		if err != nil {
+			return span, err
+		}
+		span.teacherID, span.teacherName = &t.ID, t.FullName
+	} else if c.Edges.Teacher != nil {
+		span.teacherID, span.teacherName = &c.Edges.Teacher.ID, c.Edges.Teacher.FullName
+	}
+	return span, nil
+}
+
+func scheduleConflictsFor(ctx context.Context, client *ent.Client, span slotSpan) ([]ScheduleConflictDTO, error) {
+	others, err := futureSlotSpans(ctx, client, span.weekday)
+	if err != nil {
+		return nil, err
+	}
+	return slotConflicts(span, others, scheduleToday()), nil
+}
+
+// futureSlotSpans loads the slots still running today or later, of one
+// weekday when weekday is set.
+func futureSlotSpans(ctx context.Context, client *ent.Client, weekday int) ([]slotSpan, error) {
+	q := scheduleSlotQuery(client).
+		Where(scheduleslot.Or(scheduleslot.ValidUntilIsNil(), scheduleslot.ValidUntilGTE(scheduleToday())))
+	if weekday > 0 {
+		q = q.Where(scheduleslot.WeekdayEQ(weekday))
+	}
+	items, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spans := make([]slotSpan, 0, len(items))
+	for _, item := range items {
+		spans = append(spans, toSlotSpan(item))
+	}
+	return spans, nil
+}
+
+// slotConflicts returns the room and teacher double-bookings between a and
+// the other slots, counted from today.
+func slotConflicts(a slotSpan, others []slotSpan, today time.Time) []ScheduleConflictDTO {
+	out := make([]ScheduleConflictDTO, 0)
+	for _, b := range others {
+		if b.id == a.id || b.weekday != a.weekday || b.start >= a.end || a.start >= b.end {
+			continue
+		}
+		from := today
+		for _, start := range []time.Time{a.from, b.from} {
+			if start.After(from) {
+				from = start
+			}
+		}
+		if (a.until != nil && a.until.Before(from)) || (b.until != nil && b.until.Before(from)) {
+			continue
+		}
+		conflict := ScheduleConflictDTO{
+			SlotID:          a.id,
+			CourseName:      a.courseName,
+			OtherSlotID:     b.id,
+			OtherCourseName: b.courseName,
+			Weekday:         b.weekday,
+			StartsAt:        formatClock(b.start),
+			EndsAt:          formatClock(b.end),
+			From:            from.Format("2006-01-02"),
+		}
+		if a.roomID != nil && b.roomID != nil && *a.roomID == *b.roomID {
+			conflict.Kind, conflict.Resource = ScheduleConflictRoom, a.roomName
+			out = append(out, conflict)
+		}
+		if a.teacherID != nil && b.teacherID != nil && *a.teacherID == *b.teacherID {
+			conflict.Kind, conflict.Resource = ScheduleConflictTeacher, a.teacherName
+			out = append(out, conflict)
+		}
+	}
+	return out
+}
+
+func scheduleConflictError(conflicts []ScheduleConflictDTO) error {
+	first := conflicts[0]
+	msg := fmt.Sprintf("%s %s is already booked by %s on %s %s–%s", first.Kind, first.Resource, first.OtherCourseName, weekdayName(first.Weekday), first.StartsAt, first.EndsAt)
+	if len(conflicts) > 1 {
+		msg += fmt.Sprintf(" (and %d more conflicts)", len(conflicts)-1)
+	}
+	return apperrors.Conflict(msg)
+}
+
+func scheduleSlotQuery(client *ent.Client) *ent.ScheduleSlotQuery {
+	return client.ScheduleSlot.Query().
+		WithCourse(func(cq *ent.CourseQuery) { cq.WithTeacher() }).
+		WithRoom().
+		WithTeacher().
+		Order(
+			ent.Asc(scheduleslot.FieldWeekday),
+			ent.Asc(scheduleslot.FieldStartMinute),
+			ent.Asc(scheduleslot.FieldID),
+		)
+}
+
+func toSlotSpan(item *ent.ScheduleSlot) slotSpan {
+	dto := toScheduleSlotDTO(item)
+	return slotSpan{
+		id:          item.ID,
+		courseName:  dto.CourseName,
+		roomID:      dto.RoomID,
+		roomName:    dto.RoomName,
+		teacherID:   dto.TeacherID,
+		teacherName: dto.TeacherName,
+		weekday:     item.Weekday,
+		start:       item.StartMinute,
+		end:         item.EndMinute,
+		from:        item.ValidFrom,
+		until:       item.ValidUntil,
+	}
+}
+
+func toScheduleSlotDTO(item *ent.ScheduleSlot) ScheduleSlotDTO {
+	dto := ScheduleSlotDTO{
+		ID:              item.ID,
+		Version:         item.Version,
+		CourseID:        item.CourseID,
+		RoomID:          item.RoomID,
+		TeacherOverride: item.TeacherID != nil,
+		Weekday:         item.Weekday,
+		StartsAt:        formatClock(item.StartMinute),
+		EndsAt:          formatClock(item.EndMinute),
+		ValidFrom:       item.ValidFrom.Format("2006-01-02"),
+		Note:            item.Note,
+		CreatedBy:       item.CreatedBy,
+		CreatedAt:       formatOptionalTime(&item.CreatedAt),
+	}
+	if item.ValidUntil != nil {
+		dto.ValidUntil = item.ValidUntil.Format("2006-01-02")
+	}
+	if r := item.Edges.Room; r != nil {
+		dto.RoomName = r.Name
+	}
+	if c := item.Edges.Course; c != nil {
+		dto.CourseName = c.Name
+		if t := c.Edges.Teacher; t != nil && item.TeacherID == nil {
+			id := t.ID
+			dto.TeacherID, dto.TeacherName = &id, t.FullName
+		}
+	}
+	if t := item.Edges.Teacher; t != nil {
+		id := t.ID
+		dto.TeacherID, dto.TeacherName = &id, t.FullName
+	}
+	return dto
+}
+
+func parseClock(value, name string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%s must be HH:MM", name)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func formatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+func weekdayName(weekday int) string {
+	return time.Weekday(weekday % 7).String()
+}
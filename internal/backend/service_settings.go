@@ -2,6 +2,9 @@ package backend
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
 
 	"langschool/ent/settings"
 	sharedapp "langschool/internal/app"
@@ -29,3 +32,87 @@ func (s *Service) SettingsGetLocale(ctx context.Context) (string, error) {
 	}
 	return st.Locale, nil
 }
+
+func (s *Service) SettingsGetPaymentDetails(ctx context.Context) (*PaymentDetailsSettingsDTO, error) {
+	st, err := s.rt.DB.Ent.Settings.
+		Query().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentDetailsSettingsDTO{
+		BeneficiaryName: st.BankBeneficiaryName,
+		BankName:        st.BankName,
+		BIC:             st.BankBic,
+		IBAN:            st.BankIban,
+		PaymentQR:       st.InvoicePaymentQrEnabled,
+	}, nil
+}
+
+// SettingsSetPaymentDetails stores the bank details printed on invoices and
+// encoded into the payment QR code. Empty values fall back to the built-in
+// organization details.
+func (s *Service) SettingsSetPaymentDetails(ctx context.Context, input PaymentDetailsSettingsDTO) (*PaymentDetailsSettingsDTO, error) {
+	beneficiaryName := sanitizeInput(input.BeneficiaryName)
+	bankName := sanitizeInput(input.BankName)
+	bic := strings.ToUpper(strings.Join(strings.Fields(input.BIC), ""))
+	iban := strings.ToUpper(strings.Join(strings.Fields(input.IBAN), ""))
+	if err := validateBIC(bic); err != nil {
+		return nil, err
+	}
+	if err := validateIBAN(iban); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(beneficiaryName) > 70 {
+		return nil, errors.New("beneficiaryName must be at most 70 characters")
+	}
+
+	_, err := s.rt.DB.Ent.Settings.
+		Update().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		SetBankBeneficiaryName(beneficiaryName).
+		SetBankName(bankName).
+		SetBankBic(bic).
+		SetBankIban(iban).
+		SetInvoicePaymentQrEnabled(input.PaymentQR).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SettingsGetPaymentDetails(ctx)
+}
+
+func (s *Service) SettingsGetVAT(ctx context.Context) (*VATSettingsDTO, error) {
+	st, err := s.rt.DB.Ent.Settings.
+		Query().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &VATSettingsDTO{Enabled: st.VatEnabled, VATNumber: st.VatNumber}, nil
+}
+
+// SettingsSetVAT registers the school as a VAT payer. While enabled, new
+// drafts charge each course's VAT rate and invoices print the VAT number and
+// a net/VAT/gross breakdown; already issued invoices are not touched.
+func (s *Service) SettingsSetVAT(ctx context.Context, input VATSettingsDTO) (*VATSettingsDTO, error) {
+	vatNumber := strings.ToUpper(strings.Join(strings.Fields(input.VATNumber), ""))
+	if err := validateVATNumber(vatNumber); err != nil {
+		return nil, err
+	}
+	if input.Enabled && vatNumber == "" {
+		return nil, errors.New("vatNumber is required when VAT is enabled")
+	}
+	_, err := s.rt.DB.Ent.Settings.
+		Update().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		SetVatEnabled(input.Enabled).
+		SetVatNumber(vatNumber).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SettingsGetVAT(ctx)
+}
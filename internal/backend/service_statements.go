@@ -0,0 +1,172 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/app/statement"
+	"langschool/internal/email"
+	"langschool/internal/money"
+	appruntime "langschool/internal/runtime"
+)
+
+const (
+	statementEmailSubject = "Konta izraksts %s–%s"
+	statementEmailBody    = "Labdien!\n\nPielikumā nosūtām konta izrakstu par periodu no %s līdz %s.\n%s\n\nAr cieņu,\n%s"
+)
+
+type StatementDTO = statement.DTO
+
+// StatementQuery selects the students and period of a statement. Empty dates
+// default to the current year up to today.
+type StatementQuery struct {
+	Scope string
+	From  string
+	To    string
+}
+
+func (q StatementQuery) bounds(now time.Time) (time.Time, time.Time, error) {
+	from := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if value := strings.TrimSpace(q.From); value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from must be YYYY-MM-DD")
+		}
+		from = parsed
+	}
+	if value := strings.TrimSpace(q.To); value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to must be YYYY-MM-DD")
+		}
+		to = parsed
+	}
+	return from, to, nil
+}
+
+func (s *Service) studentStatement(ctx context.Context, id int, q StatementQuery) (*statement.Result, error) {
+	from, to, err := q.bounds(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.rt.Statement.Build(ctx, id, strings.TrimSpace(q.Scope), from, to)
+}
+
+// StudentStatement lists the invoices and payments of a student, or of every
+// student sharing the student's payer, with running balances.
+func (s *Service) StudentStatement(ctx context.Context, id int, q StatementQuery) (*StatementDTO, error) {
+	result, err := s.studentStatement(ctx, id, q)
+	if err != nil {
+		return nil, err
+	}
+	return &result.DTO, nil
+}
+
+func (s *Service) StudentStatementPDF(ctx context.Context, id int, q StatementQuery) ([]byte, string, error) {
+	result, err := s.studentStatement(ctx, id, q)
+	if err != nil {
+		return nil, "", err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.rt.Statement.PDF(ctx, result, fonts)
+}
+
+func (s *Service) StudentStatementEmailPreview(ctx context.Context, id int, q StatementQuery) (*InvoiceEmailPreviewResult, error) {
+	result, err := s.studentStatement(ctx, id, q)
+	if err != nil {
+		return nil, err
+	}
+	templateSettings, err := s.invoiceEmailSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := result.Document.From.Format("02.01.2006")
+	to := result.Document.To.Format("02.01.2006")
+	closing := result.Document.ClosingCents
+	balance := fmt.Sprintf("Apmaksājamā summa: %.2f EUR.", money.CentsToEuros(closing))
+	if closing < 0 {
+		balance = fmt.Sprintf("Priekšapmaksa: %.2f EUR.", money.CentsToEuros(-closing))
+	}
+	return &InvoiceEmailPreviewResult{
+		To:                 result.DTO.RecipientEmail,
+		Subject:            fmt.Sprintf(statementEmailSubject, from, to),
+		Body:               fmt.Sprintf(statementEmailBody, from, to, balance, templateSettings.OrganizationName),
+		AttachmentFilename: result.FileName(),
+	}, nil
+}
+
+// StudentStatementSendEmail emails the statement PDF with the invoice email
+// sender and reply-to address.
+func (s *Service) StudentStatementSendEmail(ctx context.Context, id int, q StatementQuery, to, subject, body string) (*InvoiceSendEmailResult, error) {
+	to = strings.TrimSpace(to)
+	subject = strings.TrimSpace(subject)
+	body = strings.TrimSpace(body)
+	if to == "" {
+		return nil, fmt.Errorf("recipient email is required")
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("email subject is required")
+	}
+	if body == "" {
+		return nil, fmt.Errorf("email body is required")
+	}
+	if s.emailSender == nil {
+		return nil, fmt.Errorf(email.ErrNotConfiguredText)
+	}
+	result, err := s.studentStatement(ctx, id, q)
+	if err != nil {
+		return nil, err
+	}
+	templateSettings, err := s.invoiceEmailSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fonts, err := appruntime.ResolveFontsDir(s.rt.Config, s.rt.Dirs)
+	if err != nil {
+		return nil, err
+	}
+	data, filename, err := s.rt.Statement.PDF(ctx, result, fonts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.emailSender.Send(ctx, email.Message{
+		To:                 to,
+		Subject:            subject,
+		Body:               body,
+		ReplyTo:            templateSettings.ReplyTo,
+		AttachmentFilename: filename,
+		AttachmentData:     data,
+	}); err != nil {
+		return nil, err
+	}
+
+	sentAt := time.Now().UTC().Format(time.RFC3339)
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "student",
+		EntityID:   intPtr(id),
+		StudentID:  intPtr(id),
+		Action:     "student.statement_send_email",
+		Summary:    fmt.Sprintf("Sent statement of account %s–%s to %s", result.DTO.From, result.DTO.To, to),
+		After: map[string]any{
+			"scope":              result.DTO.Scope,
+			"periodFrom":         result.DTO.From,
+			"periodTo":           result.DTO.To,
+			"to":                 to,
+			"closing":            result.DTO.Closing,
+			"attachmentFilename": filename,
+		},
+	})
+	return &InvoiceSendEmailResult{
+		To:                 to,
+		Subject:            subject,
+		AttachmentFilename: filename,
+		SentAt:             sentAt,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	auditsvc "langschool/internal/app/audit"
+)
+
+func actorUsername(ctx context.Context) string {
+	if currentUser := actorFromContext(ctx); currentUser != nil {
+		return currentUser.Username
+	}
+	return ""
+}
+
+func (s *Service) StudentChargeList(ctx context.Context, studentID int) ([]StudentChargeDTO, error) {
+	return s.rt.Invoice.ListCharges(ctx, studentID)
+}
+
+func (s *Service) StudentChargeGet(ctx context.Context, id int) (*StudentChargeDTO, error) {
+	return s.rt.Invoice.GetCharge(ctx, id)
+}
+
+func (s *Service) StudentChargeCreate(ctx context.Context, in StudentChargeInput) (*StudentChargeDTO, error) {
+	item, err := s.rt.Invoice.CreateCharge(ctx, in, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "student_charge",
+		EntityID:   intPtr(item.ID),
+		Action:     "student_charge.create",
+		Summary:    fmt.Sprintf("Added %s charge %q of %.2f for %s", item.Kind, item.Description, item.Amount, item.StudentName),
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
+
+// StudentChargeCancel stops a charge from being billed again.
+func (s *Service) StudentChargeCancel(ctx context.Context, id int) (*StudentChargeDTO, error) {
+	before, err := s.rt.Invoice.GetCharge(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.Invoice.CancelCharge(ctx, id, actorUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "student_charge",
+		EntityID:   intPtr(item.ID),
+		Action:     "student_charge.cancel",
+		Summary:    fmt.Sprintf("Canceled charge %q for %s", item.Description, item.StudentName),
+		Before:     before,
+		After:      item,
+		StudentID:  intPtr(item.StudentID),
+	})
+	return item, nil
+}
@@ -0,0 +1,518 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"langschool/ent"
+	auditsvc "langschool/internal/app/audit"
+	"langschool/internal/app/spreadsheet"
+)
+
+// Student import fields a spreadsheet column can be mapped to. Several
+// columns may map to StudentImportFieldCourse; every other field takes one.
+const (
+	StudentImportFieldFullName                = "fullName"
+	StudentImportFieldPersonalCode            = "personalCode"
+	StudentImportFieldPhone                   = "phone"
+	StudentImportFieldEmail                   = "email"
+	StudentImportFieldNote                    = "note"
+	StudentImportFieldIsMinor                 = "isMinor"
+	StudentImportFieldPayerName               = "payerName"
+	StudentImportFieldPayerRole               = "payerRole"
+	StudentImportFieldCourse                  = "course"
+	StudentImportFieldBillingMode             = "billingMode"
+	StudentImportFieldStartsOn                = "startsOn"
+	StudentImportFieldEndsOn                  = "endsOn"
+	StudentImportFieldSubscriptionLessonPrice = "subscriptionLessonPrice"
+)
+
+const studentImportMaxRows = 2000
+
+var studentImportFields = []string{
+	StudentImportFieldFullName,
+	StudentImportFieldPersonalCode,
+	StudentImportFieldPhone,
+	StudentImportFieldEmail,
+	StudentImportFieldNote,
+	StudentImportFieldIsMinor,
+	StudentImportFieldPayerName,
+	StudentImportFieldPayerRole,
+	StudentImportFieldCourse,
+	StudentImportFieldBillingMode,
+	StudentImportFieldStartsOn,
+	StudentImportFieldEndsOn,
+	StudentImportFieldSubscriptionLessonPrice,
+}
+
+// studentImportHeaderAliases maps normalized header texts, in English and in
+// Latvian as on the registration form, to fields.
+var studentImportHeaderAliases = map[string]string{
+	"fullname":                StudentImportFieldFullName,
+	"name":                    StudentImportFieldFullName,
+	"student":                 StudentImportFieldFullName,
+	"vārdsuzvārds":            StudentImportFieldFullName,
+	"vārds":                   StudentImportFieldFullName,
+	"skolēns":                 StudentImportFieldFullName,
+	"personalcode":            StudentImportFieldPersonalCode,
+	"personaskods":            StudentImportFieldPersonalCode,
+	"phone":                   StudentImportFieldPhone,
+	"telefons":                StudentImportFieldPhone,
+	"tālrunis":                StudentImportFieldPhone,
+	"email":                   StudentImportFieldEmail,
+	"epasts":                  StudentImportFieldEmail,
+	"note":                    StudentImportFieldNote,
+	"piezīme":                 StudentImportFieldNote,
+	"piezīmes":                StudentImportFieldNote,
+	"isminor":                 StudentImportFieldIsMinor,
+	"minor":                   StudentImportFieldIsMinor,
+	"nepilngadīgs":            StudentImportFieldIsMinor,
+	"payername":               StudentImportFieldPayerName,
+	"payer":                   StudentImportFieldPayerName,
+	"maksātājs":               StudentImportFieldPayerName,
+	"payerrole":               StudentImportFieldPayerRole,
+	"maksātājaloma":           StudentImportFieldPayerRole,
+	"course":                  StudentImportFieldCourse,
+	"courses":                 StudentImportFieldCourse,
+	"kurss":                   StudentImportFieldCourse,
+	"kursi":                   StudentImportFieldCourse,
+	"billingmode":             StudentImportFieldBillingMode,
+	"norēķinuveids":           StudentImportFieldBillingMode,
+	"startson":                StudentImportFieldStartsOn,
+	"startdate":               StudentImportFieldStartsOn,
+	"sākumadatums":            StudentImportFieldStartsOn,
+	"endson":                  StudentImportFieldEndsOn,
+	"enddate":                 StudentImportFieldEndsOn,
+	"beigudatums":             StudentImportFieldEndsOn,
+	"subscriptionlessonprice": StudentImportFieldSubscriptionLessonPrice,
+}
+
+// studentImportRow is a parsed spreadsheet row with the inputs it onboards.
+type studentImportRow struct {
+	dto         StudentImportRowDTO
+	student     StudentCreateInput
+	enrollments []EnrollmentCreateInput
+}
+
+// StudentImportPreview parses a CSV or XLSX registration sheet and validates
+// every row the way onboarding would, without saving anything. mapping maps
+// column headers to fields; when it is empty the columns are mapped by their
+// headers. A mapping that cannot be used is reported in MappingError
+// together with the file's columns so that it can be corrected.
+func (s *Service) StudentImportPreview(ctx context.Context, fileName string, data []byte, mapping map[string]string) (*StudentImportPreviewDTO, error) {
+	preview, _, err := s.studentImportParse(ctx, fileName, data, mapping)
+	if preview != nil && preview.MappingError != "" {
+		return preview, nil
+	}
+	return preview, err
+}
+
+// StudentImport onboards every row of the sheet with its enrollments in one
+// transaction. Nothing is saved unless every row is valid.
+func (s *Service) StudentImport(ctx context.Context, fileName string, data []byte, mapping map[string]string) (*StudentImportResult, error) {
+	preview, rows, err := s.studentImportParse(ctx, fileName, data, mapping)
+	if err != nil {
+		return nil, err
+	}
+	if preview.InvalidRows > 0 {
+		return nil, fmt.Errorf("%d of %d rows have errors that must be fixed before importing", preview.InvalidRows, len(rows))
+	}
+
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	result := &StudentImportResult{Students: make([]StudentOnboardingResult, 0, len(rows))}
+	for _, row := range rows {
+		onboarded, err := studentOnboardInStore(ctx, tx.Client(), row.student, row.enrollments)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", row.dto.Line, err)
+		}
+		result.Students = append(result.Students, *onboarded)
+		result.Enrollments += len(onboarded.Enrollments)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	studentIDs := make([]int, 0, len(result.Students))
+	for _, item := range result.Students {
+		studentIDs = append(studentIDs, item.Student.ID)
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "student",
+		Action:     "student.import",
+		Summary:    fmt.Sprintf("Imported %d students with %d enrollments from %s", len(result.Students), result.Enrollments, fileName),
+		After: map[string]any{
+			"file":        fileName,
+			"studentIds":  studentIDs,
+			"enrollments": result.Enrollments,
+		},
+	})
+	return result, nil
+}
+
+func (s *Service) studentImportParse(ctx context.Context, fileName string, data []byte, mapping map[string]string) (*StudentImportPreviewDTO, []studentImportRow, error) {
+	table, err := spreadsheet.Read(fileName, data)
+	if err != nil {
+		if errors.Is(err, spreadsheet.ErrUnsupportedFormat) {
+			return nil, nil, errors.New("invalid import file: it must be CSV or XLSX")
+		}
+		return nil, nil, fmt.Errorf("invalid import file: %w", err)
+	}
+	if len(table) == 0 {
+		return nil, nil, errors.New("invalid import file: it has no header row")
+	}
+	if len(table)-1 > studentImportMaxRows {
+		return nil, nil, fmt.Errorf("invalid import file: more than %d rows", studentImportMaxRows)
+	}
+	columns := studentImportColumns(table[0])
+	if len(mapping) == 0 {
+		mapping = studentImportAutoMapping(columns)
+	}
+
+	courses, err := s.rt.DB.Ent.Course.Query().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	coursesByName := make(map[string]*ent.Course, len(courses))
+	for _, c := range courses {
+		coursesByName[strings.ToLower(normalizePersonNameInput(c.Name))] = c
+	}
+
+	preview := &StudentImportPreviewDTO{
+		Columns: columns,
+		Mapping: mapping,
+		Fields:  studentImportFields,
+		Rows:    []StudentImportRowDTO{},
+	}
+	fieldColumns, err := studentImportFieldColumns(columns, mapping)
+	if err != nil {
+		preview.MappingError = err.Error()
+		return preview, nil, err
+	}
+	rows := make([]studentImportRow, 0, len(table)-1)
+	for i, cells := range table[1:] {
+		cell := func(field string) string {
+			cols := fieldColumns[field]
+			if len(cols) == 0 || cols[0] >= len(cells) {
+				return ""
+			}
+			return cells[cols[0]]
+		}
+		var courseNames []string
+		for _, col := range fieldColumns[StudentImportFieldCourse] {
+			if col >= len(cells) {
+				continue
+			}
+			for _, name := range strings.Split(cells[col], ";") {
+				if name = normalizePersonNameInput(name); name != "" {
+					courseNames = append(courseNames, name)
+				}
+			}
+		}
+		row := parseStudentImportRow(i+2, cell, courseNames, coursesByName)
+		if row == nil {
+			continue
+		}
+		rows = append(rows, *row)
+	}
+	if len(rows) == 0 {
+		return nil, nil, errors.New("invalid import file: it has no student rows")
+	}
+
+	if err := s.checkStudentImportDuplicates(ctx, rows); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkStudentImportSeats(ctx, rows); err != nil {
+		return nil, nil, err
+	}
+	for _, row := range rows {
+		if len(row.dto.Errors) > 0 {
+			preview.InvalidRows++
+		} else {
+			preview.ValidRows++
+		}
+		preview.Rows = append(preview.Rows, row.dto)
+	}
+	return preview, rows, nil
+}
+
+// parseStudentImportRow validates one row with the student and enrollment
+// validators. It returns nil for rows whose mapped cells are all empty.
+func parseStudentImportRow(line int, cell func(string) string, courseNames []string, coursesByName map[string]*ent.Course) *studentImportRow {
+	empty := len(courseNames) == 0
+	for _, field := range studentImportFields {
+		if field != StudentImportFieldCourse && cell(field) != "" {
+			empty = false
+		}
+	}
+	if empty {
+		return nil
+	}
+
+	row := &studentImportRow{dto: StudentImportRowDTO{
+		Line:         line,
+		FullName:     sanitizeInput(normalizePersonNameInput(cell(StudentImportFieldFullName))),
+		PersonalCode: sanitizeInput(cell(StudentImportFieldPersonalCode)),
+		Phone:        sanitizeInput(cell(StudentImportFieldPhone)),
+		Email:        sanitizeInput(cell(StudentImportFieldEmail)),
+		Note:         sanitizeInput(cell(StudentImportFieldNote)),
+		PayerName:    sanitizeInput(normalizePersonNameInput(cell(StudentImportFieldPayerName))),
+		PayerRole:    normalizePayerRole(cell(StudentImportFieldPayerRole)),
+		Courses:      []string{},
+		BillingMode:  strings.ToLower(strings.TrimSpace(cell(StudentImportFieldBillingMode))),
+		Errors:       []string{},
+		Warnings:     []string{},
+	}}
+	dto := &row.dto
+	addError := func(err error) {
+		if err != nil && !slices.Contains(dto.Errors, err.Error()) {
+			dto.Errors = append(dto.Errors, err.Error())
+		}
+	}
+
+	isMinor, err := parseStudentImportBool(cell(StudentImportFieldIsMinor))
+	if err != nil {
+		addError(fmt.Errorf("isMinor %w", err))
+	}
+	dto.IsMinor = isMinor
+	addError(validatePersonName(dto.FullName, "fullName", true))
+	addError(validatePersonalCode(dto.PersonalCode))
+	addError(validatePhone(dto.Phone))
+	addError(validateEmail(dto.Email))
+	addError(validatePersonName(dto.PayerName, "payerName", dto.IsMinor))
+	addError(validateMinorPayer(dto.IsMinor, dto.PayerName, dto.PayerRole))
+	row.student = StudentCreateInput{
+		FullName:     dto.FullName,
+		PersonalCode: dto.PersonalCode,
+		Phone:        dto.Phone,
+		Email:        dto.Email,
+		Note:         dto.Note,
+		IsMinor:      dto.IsMinor,
+		PayerName:    dto.PayerName,
+		PayerRole:    dto.PayerRole,
+	}
+
+	if dto.BillingMode == "" {
+		dto.BillingMode = BillingModePerLesson
+	}
+	addError(validateBillingMode(dto.BillingMode))
+	dto.StartsOn = studentImportDate(cell(StudentImportFieldStartsOn))
+	dto.EndsOn = studentImportDate(cell(StudentImportFieldEndsOn))
+	_, _, err = parseEnrollmentDates(dto.StartsOn, dto.EndsOn)
+	addError(err)
+	if raw := strings.TrimSpace(cell(StudentImportFieldSubscriptionLessonPrice)); raw != "" {
+		price, err := strconv.ParseFloat(strings.ReplaceAll(raw, ",", "."), 64)
+		if err != nil {
+			addError(errors.New("subscriptionLessonPrice must be a number"))
+		} else if err := validateSubscriptionLessonPrice(price); err != nil {
+			addError(err)
+		} else {
+			dto.SubscriptionLessonPrice = price
+		}
+	}
+
+	seen := make(map[int]bool, len(courseNames))
+	for _, name := range courseNames {
+		c := coursesByName[strings.ToLower(name)]
+		if c == nil {
+			addError(fmt.Errorf("course %q does not exist", name))
+			continue
+		}
+		if seen[c.ID] {
+			addError(fmt.Errorf("course %s is listed more than once", c.Name))
+			continue
+		}
+		seen[c.ID] = true
+		dto.Courses = append(dto.Courses, c.Name)
+		row.enrollments = append(row.enrollments, EnrollmentCreateInput{
+			CourseID:                c.ID,
+			BillingMode:             dto.BillingMode,
+			SubscriptionLessonPrice: dto.SubscriptionLessonPrice,
+			StartsOn:                dto.StartsOn,
+			EndsOn:                  dto.EndsOn,
+		})
+	}
+	return row
+}
+
+// checkStudentImportDuplicates runs the duplicate check on every row: a
+// student already saved with the same personal code, or a personal code
+// repeated in the file, is an error; a name and contact match is a warning.
+func (s *Service) checkStudentImportDuplicates(ctx context.Context, rows []studentImportRow) error {
+	linesByCode := make(map[string]int, len(rows))
+	for i := range rows {
+		dto := &rows[i].dto
+		if dto.FullName == "" {
+			continue
+		}
+		if code := strings.ToLower(dto.PersonalCode); code != "" {
+			if first, ok := linesByCode[code]; ok {
+				dto.Errors = append(dto.Errors, fmt.Sprintf("personalCode repeats line %d", first))
+			} else {
+				linesByCode[code] = dto.Line
+			}
+		}
+		check, err := s.StudentDuplicateCheck(ctx, dto.FullName, dto.PersonalCode, dto.Phone, dto.Email)
+		if err != nil {
+			return err
+		}
+		if check.ExactMatch != nil {
+			dto.Errors = append(dto.Errors, fmt.Sprintf("student with this personal code already exists: %s (#%d)", check.ExactMatch.FullName, check.ExactMatch.ID))
+		}
+		for _, match := range check.PossibleMatches {
+			dto.Warnings = append(dto.Warnings, fmt.Sprintf("possible duplicate of %s (#%d)", match.FullName, match.ID))
+		}
+	}
+	return nil
+}
+
+// checkStudentImportSeats marks the rows that would overfill a course with
+// a seat limit, in file order.
+func (s *Service) checkStudentImportSeats(ctx context.Context, rows []studentImportRow) error {
+	free := make(map[int]int)
+	for i := range rows {
+		for j, in := range rows[i].enrollments {
+			left, ok := free[in.CourseID]
+			if !ok {
+				c, err := s.rt.DB.Ent.Course.Get(ctx, in.CourseID)
+				if err != nil {
+					return err
+				}
+				left = -1
+				if c.MaxStudents > 0 {
+					held, err := courseSeatsHeld(ctx, s.rt.DB.Ent, c.ID, 0)
+					if err != nil {
+						return err
+					}
+					left = max(c.MaxStudents-held, 0)
+				}
+			}
+			switch {
+			case left < 0:
+			case left == 0:
+				rows[i].dto.Errors = append(rows[i].dto.Errors, fmt.Sprintf("course %s is full; add the student to the waiting list", rows[i].dto.Courses[j]))
+			default:
+				left--
+			}
+			free[in.CourseID] = left
+		}
+	}
+	return nil
+}
+
+// studentImportColumns names the header cells, numbering empty ones.
+func studentImportColumns(header []string) []string {
+	columns := make([]string, len(header))
+	for i, name := range header {
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		columns[i] = name
+	}
+	return columns
+}
+
+// studentImportAutoMapping maps the columns whose headers match a known
+// alias; a second column with the same field is left unmapped unless the
+// field is course.
+func studentImportAutoMapping(columns []string) map[string]string {
+	mapping := make(map[string]string, len(columns))
+	taken := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		field := studentImportHeaderAliases[normalizeStudentImportHeader(column)]
+		if field == "" {
+			for _, known := range studentImportFields {
+				if strings.EqualFold(column, known) {
+					field = known
+				}
+			}
+		}
+		if field == "" || (taken[field] && field != StudentImportFieldCourse) {
+			continue
+		}
+		taken[field] = true
+		mapping[column] = field
+	}
+	return mapping
+}
+
+func normalizeStudentImportHeader(header string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// studentImportFieldColumns resolves a column-to-field mapping into the
+// column indexes of each field. Columns mapped to "" are ignored.
+func studentImportFieldColumns(columns []string, mapping map[string]string) (map[string][]int, error) {
+	index := make(map[string]int, len(columns))
+	for i, column := range columns {
+		if _, ok := index[column]; !ok {
+			index[column] = i
+		}
+	}
+	known := make(map[string]bool, len(studentImportFields))
+	for _, field := range studentImportFields {
+		known[field] = true
+	}
+	out := make(map[string][]int, len(mapping))
+	for column, field := range mapping {
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			return nil, fmt.Errorf("invalid mapping: unknown field %q", field)
+		}
+		col, ok := index[column]
+		if !ok {
+			return nil, fmt.Errorf("invalid mapping: column %q is not in the file", column)
+		}
+		if len(out[field]) > 0 && field != StudentImportFieldCourse {
+			return nil, fmt.Errorf("invalid mapping: field %s is mapped to more than one column", field)
+		}
+		out[field] = append(out[field], col)
+	}
+	if len(out[StudentImportFieldFullName]) == 0 {
+		return nil, errors.New("invalid mapping: a column must be mapped to fullName")
+	}
+	for _, cols := range out {
+		slices.Sort(cols)
+	}
+	return out, nil
+}
+
+// parseStudentImportBool reads yes/no cells, including the Latvian jā/nē and
+// a tick mark.
+func parseStudentImportBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "no", "n", "false", "0", "nē", "ne":
+		return false, nil
+	case "yes", "y", "true", "1", "jā", "ja", "j", "x", "✓":
+		return true, nil
+	default:
+		return false, errors.New("must be yes or no")
+	}
+}
+
+// studentImportDate accepts the DD.MM.YYYY dates of a Latvian spreadsheet
+// besides YYYY-MM-DD, which parseEnrollmentDates validates.
+func studentImportDate(value string) string {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse("2.1.2006", value); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return value
+}
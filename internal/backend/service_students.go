@@ -8,11 +8,14 @@ import (
 	"langschool/ent"
 	"langschool/ent/attendancemonth"
 	"langschool/ent/enrollment"
+	"langschool/ent/excusedabsence"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
 	"langschool/ent/payment"
 	"langschool/ent/predicate"
 	"langschool/ent/student"
+	"langschool/ent/studentcharge"
+	"langschool/ent/waitlistentry"
 	sharedapp "langschool/internal/app"
 	"langschool/internal/apperrors"
 	"langschool/internal/money"
@@ -384,6 +387,68 @@ func (s *Service) StudentSetActiveWithVersion(ctx context.Context, id, version i
 	return staleOnNotFound(err)
 }
 
+// StudentSetPayer switches who is invoiced for a student: the student or a
+// parent ("person"), or a legal entity ("company") whose details are printed
+// in the invoice recipient block. Switching back to "person" clears the
+// company details. payerPersonalCode is the paying parent's personal code
+// used on education-expense certificates; company payers have none.
+func (s *Service) StudentSetPayer(ctx context.Context, id, version int, payerType, payerPersonalCode string, company CompanyPayerDTO) (*StudentDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	payerType = strings.TrimSpace(payerType)
+	if payerType != student.PayerTypePerson.String() && payerType != student.PayerTypeCompany.String() {
+		return nil, errors.New("payerType must be 'person' or 'company'")
+	}
+	if payerType == student.PayerTypePerson.String() {
+		company = CompanyPayerDTO{}
+	} else {
+		payerPersonalCode = ""
+	}
+	payerPersonalCode = sanitizeInput(payerPersonalCode)
+	if err := validatePersonalCode(payerPersonalCode); err != nil {
+		return nil, err
+	}
+	company.CompanyName = sanitizeInput(strings.Join(strings.Fields(company.CompanyName), " "))
+	company.RegNo = strings.ToUpper(strings.Join(strings.Fields(company.RegNo), ""))
+	company.VATNumber = strings.ToUpper(strings.Join(strings.Fields(company.VATNumber), ""))
+	company.LegalAddress = sanitizeInput(strings.Join(strings.Fields(company.LegalAddress), " "))
+	company.BillingEmail = sanitizeInput(company.BillingEmail)
+	if payerType == student.PayerTypeCompany.String() {
+		if err := validateNonEmpty(company.CompanyName, "companyName"); err != nil {
+			return nil, err
+		}
+		if err := validateNonEmpty(company.RegNo, "regNo"); err != nil {
+			return nil, err
+		}
+		if err := validateNonEmpty(company.LegalAddress, "legalAddress"); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateVATNumber(company.VATNumber); err != nil {
+		return nil, err
+	}
+	if err := validateEmail(company.BillingEmail); err != nil {
+		return nil, err
+	}
+	item, err := s.rt.DB.Ent.Student.UpdateOneID(id).
+		Where(student.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetPayerType(student.PayerType(payerType)).
+		SetPayerPersonalCode(payerPersonalCode).
+		SetPayerCompanyName(company.CompanyName).
+		SetPayerRegNo(company.RegNo).
+		SetPayerVatNumber(company.VATNumber).
+		SetPayerLegalAddress(company.LegalAddress).
+		SetPayerBillingEmail(company.BillingEmail).
+		Save(ctx)
+	if err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	dto := toStudentDTO(item, s.studentBalanceSummary(ctx, item.ID))
+	return &dto, nil
+}
+
 func (s *Service) StudentDelete(ctx context.Context, id int) error {
 	st, err := s.rt.DB.Ent.Student.Get(ctx, id)
 	if err != nil {
@@ -435,6 +500,15 @@ func (s *Service) StudentDelete(ctx context.Context, id int) error {
 	if _, err := tx.Enrollment.Delete().Where(enrollment.StudentIDEQ(id)).Exec(ctx); err != nil {
 		return err
 	}
+	if _, err := tx.StudentCharge.Delete().Where(studentcharge.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ExcusedAbsence.Delete().Where(excusedabsence.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.WaitlistEntry.Delete().Where(waitlistentry.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
 	if err := tx.Student.DeleteOneID(id).Exec(ctx); err != nil {
 		return err
 	}
@@ -521,6 +595,15 @@ func (s *Service) StudentDeleteWithVersion(ctx context.Context, id, version int)
 	if _, err := tx.Enrollment.Delete().Where(enrollment.StudentIDEQ(id)).Exec(ctx); err != nil {
 		return err
 	}
+	if _, err := tx.StudentCharge.Delete().Where(studentcharge.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ExcusedAbsence.Delete().Where(excusedabsence.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.WaitlistEntry.Delete().Where(waitlistentry.StudentIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
 	if err := tx.Student.DeleteOneID(id).Where(student.VersionEQ(version)).Exec(ctx); err != nil {
 		return staleOnNotFound(err)
 	}
@@ -529,19 +612,35 @@ func (s *Service) StudentDeleteWithVersion(ctx context.Context, id, version int)
 
 func toStudentDTO(s *ent.Student, summary studentBalanceSummary) StudentDTO {
 	return StudentDTO{
-		ID:           s.ID,
-		Version:      s.Version,
-		FullName:     s.FullName,
-		CreatedAt:    formatOptionalTime(s.CreatedAt),
-		PersonalCode: s.PersonalCode,
-		Phone:        s.Phone,
-		Email:        s.Email,
-		Note:         s.Note,
-		IsMinor:      s.IsMinor,
-		PayerName:    s.PayerName,
-		PayerRole:    s.PayerRole,
-		IsActive:     s.IsActive,
-		Balance:      summary.Balance,
-		Debt:         summary.Debt,
+		ID:                s.ID,
+		Version:           s.Version,
+		FullName:          s.FullName,
+		CreatedAt:         formatOptionalTime(s.CreatedAt),
+		PersonalCode:      s.PersonalCode,
+		Phone:             s.Phone,
+		Email:             s.Email,
+		Note:              s.Note,
+		IsMinor:           s.IsMinor,
+		PayerName:         s.PayerName,
+		PayerRole:         s.PayerRole,
+		PayerPersonalCode: s.PayerPersonalCode,
+		PayerType:         string(s.PayerType),
+		CompanyPayer:      toCompanyPayerDTO(s),
+		IsActive:          s.IsActive,
+		Balance:           summary.Balance,
+		Debt:              summary.Debt,
+	}
+}
+
+func toCompanyPayerDTO(s *ent.Student) *CompanyPayerDTO {
+	if s.PayerType != student.PayerTypeCompany {
+		return nil
+	}
+	return &CompanyPayerDTO{
+		CompanyName:  s.PayerCompanyName,
+		RegNo:        s.PayerRegNo,
+		VATNumber:    s.PayerVatNumber,
+		LegalAddress: s.PayerLegalAddress,
+		BillingEmail: s.PayerBillingEmail,
 	}
 }
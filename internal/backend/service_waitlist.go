@@ -0,0 +1,648 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/course"
+	"langschool/ent/enrollment"
+	"langschool/ent/predicate"
+	"langschool/ent/settings"
+	"langschool/ent/student"
+	"langschool/ent/waitlistentry"
+	sharedapp "langschool/internal/app"
+	auditsvc "langschool/internal/app/audit"
+	invsvc "langschool/internal/app/invoice"
+	"langschool/internal/app/recipient"
+	"langschool/internal/apperrors"
+	"langschool/internal/email"
+	"langschool/internal/money"
+)
+
+// Waiting-list entry statuses.
+const (
+	WaitlistStatusWaiting  = "waiting"
+	WaitlistStatusOffered  = "offered"
+	WaitlistStatusEnrolled = "enrolled"
+	WaitlistStatusDeclined = "declined"
+	WaitlistStatusExpired  = "expired"
+)
+
+const (
+	waitlistOfferEmailSubject = "Vieta kursā %s"
+	waitlistOfferEmailBody    = "Labdien!\n\nKursā %s ir atbrīvojusies vieta, un %s ir nākamais gaidītāju sarakstā. Lūdzu, apstipriniet dalību līdz %s, citādi vietu piedāvāsim nākamajam.\n\nAr cieņu,\n%s"
+)
+
+var waitlistCurrentTime = time.Now
+
+// ensureCourseSeat refuses a new enrollment when a course with a seat limit
+// is full. Open waiting-list offers hold their seats, except the one being
+// accepted.
+func ensureCourseSeat(ctx context.Context, client *ent.Client, courseID, acceptedEntryID int) error {
+	c, err := client.Course.Get(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if c.MaxStudents == 0 {
+		return nil
+	}
+	held, err := courseSeatsHeld(ctx, client, courseID, acceptedEntryID)
+	if err != nil {
+		return err
+	}
+	if held >= c.MaxStudents {
+		return apperrors.Conflict(fmt.Sprintf("course %s is full (%d seats); add the student to the waiting list", c.Name, c.MaxStudents))
+	}
+	return nil
+}
+
+// courseSeatsHeld counts the seats taken by enrollments plus those held by
+// open waiting-list offers other than acceptedEntryID.
+func courseSeatsHeld(ctx context.Context, client *ent.Client, courseID, acceptedEntryID int) (int, error) {
+	taken, err := courseSeatsTaken(ctx, client, courseID)
+	if err != nil {
+		return 0, err
+	}
+	offers, err := client.WaitlistEntry.Query().
+		Where(openWaitlistOffers(courseID), waitlistentry.IDNEQ(acceptedEntryID)).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return taken + offers, nil
+}
+
+// courseSeatsTaken counts the enrollments that have not ended. Paused
+// enrollments keep their seat.
+func courseSeatsTaken(ctx context.Context, client *ent.Client, courseID int) (int, error) {
+	return client.Enrollment.Query().
+		Where(
+			enrollment.CourseIDEQ(courseID),
+			enrollment.Or(enrollment.EndsOnIsNil(), enrollment.EndsOnGTE(waitlistToday())),
+		).
+		Count(ctx)
+}
+
+func waitlistToday() time.Time {
+	y, m, d := waitlistCurrentTime().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func openWaitlistOffers(courseID int) predicate.WaitlistEntry {
+	return waitlistentry.And(
+		waitlistentry.CourseIDEQ(courseID),
+		waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+		waitlistentry.OfferExpiresAtGT(waitlistCurrentTime()),
+	)
+}
+
+// CourseSetCapacity limits the number of students in a course; 0 removes
+// the limit. Raising it promotes the waiting list into the new seats.
+func (s *Service) CourseSetCapacity(ctx context.Context, id, version, maxStudents int) (*CourseDTO, error) {
+	if err := validateVersion(version); err != nil {
+		return nil, err
+	}
+	if maxStudents < 0 {
+		return nil, errors.New("maxStudents must be >= 0")
+	}
+	before, err := s.CourseGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.rt.DB.Ent.Course.UpdateOneID(id).
+		Where(course.VersionEQ(version)).
+		SetVersion(version + 1).
+		SetMaxStudents(maxStudents).
+		Save(ctx); err != nil {
+		return nil, staleOnNotFound(err)
+	}
+	if _, err := s.promoteWaitlist(ctx, id); err != nil {
+		return nil, err
+	}
+	after, err := s.CourseGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "course",
+		EntityID:   intPtr(id),
+		Action:     "course.capacity",
+		Summary:    fmt.Sprintf("Changed the seats of %s from %d to %d", after.Name, before.MaxStudents, after.MaxStudents),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+func (s *Service) SettingsGetWaitlist(ctx context.Context) (*WaitlistSettingsDTO, error) {
+	days, err := s.waitlistOfferDays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &WaitlistSettingsDTO{OfferDays: days}, nil
+}
+
+// SettingsSetWaitlist sets how many days a freed seat is offered to the next
+// waiting student before moving on; 0 enrolls them directly.
+func (s *Service) SettingsSetWaitlist(ctx context.Context, input WaitlistSettingsDTO) (*WaitlistSettingsDTO, error) {
+	if input.OfferDays < 0 || input.OfferDays > 60 {
+		return nil, errors.New("offerDays must be between 0 and 60")
+	}
+	if _, err := s.rt.DB.Ent.Settings.
+		Update().
+		Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).
+		SetWaitlistOfferDays(input.OfferDays).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return s.SettingsGetWaitlist(ctx)
+}
+
+func (s *Service) waitlistOfferDays(ctx context.Context) (int, error) {
+	st, err := s.rt.DB.Ent.Settings.Query().Where(settings.SingletonIDEQ(sharedapp.SettingsSingletonID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return st.WaitlistOfferDays, nil
+}
+
+// WaitlistList lists waiting-list entries, those still waiting first by
+// rank. Zero IDs do not filter.
+func (s *Service) WaitlistList(ctx context.Context, courseID, studentID int) ([]WaitlistEntryDTO, error) {
+	q := s.rt.DB.Ent.WaitlistEntry.Query().
+		WithCourse().
+		WithStudent().
+		Order(ent.Asc(waitlistentry.FieldRank), ent.Asc(waitlistentry.FieldID))
+	if courseID > 0 {
+		q = q.Where(waitlistentry.CourseIDEQ(courseID))
+	}
+	if studentID > 0 {
+		q = q.Where(waitlistentry.StudentIDEQ(studentID))
+	}
+	items, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]WaitlistEntryDTO, 0, len(items))
+	for _, item := range items {
+		if item.Status == waitlistentry.StatusWaiting {
+			out = append(out, toWaitlistEntryDTO(item))
+		}
+	}
+	for _, item := range items {
+		if item.Status != waitlistentry.StatusWaiting {
+			out = append(out, toWaitlistEntryDTO(item))
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) WaitlistGet(ctx context.Context, id int) (*WaitlistEntryDTO, error) {
+	item, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(waitlistentry.IDEQ(id)).
+		WithCourse().
+		WithStudent().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto := toWaitlistEntryDTO(item)
+	return &dto, nil
+}
+
+// WaitlistAdd puts a student at the end of a course's waiting list.
+func (s *Service) WaitlistAdd(ctx context.Context, in WaitlistEntryInput) (*WaitlistEntryDTO, error) {
+	in.BillingMode = strings.TrimSpace(in.BillingMode)
+	if in.BillingMode == "" {
+		in.BillingMode = BillingModePerLesson
+	}
+	if err := validateBillingMode(in.BillingMode); err != nil {
+		return nil, err
+	}
+	st, err := s.rt.DB.Ent.Student.Get(ctx, in.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	if !st.IsActive {
+		return nil, errors.New("cannot add a deactivated student to a waiting list")
+	}
+	if _, err := s.rt.DB.Ent.Course.Get(ctx, in.CourseID); err != nil {
+		return nil, err
+	}
+	enrolled, err := s.rt.DB.Ent.Enrollment.Query().
+		Where(enrollment.StudentIDEQ(in.StudentID), enrollment.CourseIDEQ(in.CourseID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if enrolled {
+		return nil, apperrors.Conflict("student is already enrolled in this course")
+	}
+	listed, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(
+			waitlistentry.CourseIDEQ(in.CourseID),
+			waitlistentry.StudentIDEQ(in.StudentID),
+			waitlistentry.StatusIn(waitlistentry.StatusWaiting, waitlistentry.StatusOffered),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if listed {
+		return nil, apperrors.Conflict("student is already on the waiting list of this course")
+	}
+	waiting, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(waitlistentry.CourseIDEQ(in.CourseID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.rt.DB.Ent.WaitlistEntry.Create().
+		SetCourseID(in.CourseID).
+		SetStudentID(in.StudentID).
+		SetRank(waiting + 1).
+		SetBillingMode(waitlistentry.BillingMode(in.BillingMode)).
+		SetNote(sanitizeInput(in.Note)).
+		SetCreatedBy(actorUsername(ctx)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dto, err := s.WaitlistGet(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "waitlist_entry",
+		EntityID:   intPtr(dto.ID),
+		Action:     "waitlist_entry.create",
+		Summary:    fmt.Sprintf("Added %s to the waiting list of %s at place %d", dto.StudentName, dto.CourseName, dto.Rank),
+		After:      dto,
+		StudentID:  intPtr(dto.StudentID),
+	})
+	return dto, nil
+}
+
+// WaitlistMove moves a waiting student to another place in line.
+func (s *Service) WaitlistMove(ctx context.Context, id, rank int) (*WaitlistEntryDTO, error) {
+	item, err := s.rt.DB.Ent.WaitlistEntry.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != waitlistentry.StatusWaiting {
+		return nil, apperrors.Conflict("only waiting students can be moved")
+	}
+	if rank < 1 {
+		return nil, errors.New("rank must be >= 1")
+	}
+	waiting, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(
+			waitlistentry.CourseIDEQ(item.CourseID),
+			waitlistentry.StatusEQ(waitlistentry.StatusWaiting),
+			waitlistentry.IDNEQ(id),
+		).
+		Order(ent.Asc(waitlistentry.FieldRank), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rank > len(waiting)+1 {
+		rank = len(waiting) + 1
+	}
+	ordered := make([]*ent.WaitlistEntry, 0, len(waiting)+1)
+	ordered = append(ordered, waiting[:rank-1]...)
+	ordered = append(ordered, item)
+	ordered = append(ordered, waiting[rank-1:]...)
+	for i, entry := range ordered {
+		if entry.Rank == i+1 && entry.ID != id {
+			continue
+		}
+		if _, err := s.rt.DB.Ent.WaitlistEntry.UpdateOneID(entry.ID).SetRank(i + 1).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	dto, err := s.WaitlistGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "waitlist_entry",
+		EntityID:   intPtr(id),
+		Action:     "waitlist_entry.move",
+		Summary:    fmt.Sprintf("Moved %s from place %d to %d on the waiting list of %s", dto.StudentName, item.Rank, dto.Rank, dto.CourseName),
+		StudentID:  intPtr(dto.StudentID),
+	})
+	return dto, nil
+}
+
+// WaitlistRemove takes a student off a waiting list. A withdrawn offer frees
+// its seat for the next student.
+func (s *Service) WaitlistRemove(ctx context.Context, id int) error {
+	before, err := s.WaitlistGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	if before.Status == WaitlistStatusEnrolled {
+		return apperrors.Conflict("the student is enrolled; delete the enrollment instead")
+	}
+	if err := s.rt.DB.Ent.WaitlistEntry.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	if err := s.renumberWaitlist(ctx, before.CourseID); err != nil {
+		return err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "waitlist_entry",
+		EntityID:   intPtr(id),
+		Action:     "waitlist_entry.delete",
+		Summary:    fmt.Sprintf("Removed %s from the waiting list of %s", before.StudentName, before.CourseName),
+		Before:     before,
+		StudentID:  intPtr(before.StudentID),
+	})
+	if before.Status == WaitlistStatusOffered {
+		_, err = s.promoteWaitlist(ctx, before.CourseID)
+	}
+	return err
+}
+
+// WaitlistAccept enrolls a student who was offered a seat.
+func (s *Service) WaitlistAccept(ctx context.Context, id int) (*WaitlistEntryDTO, error) {
+	item, err := s.rt.DB.Ent.WaitlistEntry.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != waitlistentry.StatusOffered {
+		return nil, apperrors.Conflict("the student has no open seat offer")
+	}
+	if item.OfferExpiresAt != nil && !item.OfferExpiresAt.After(waitlistCurrentTime()) {
+		return nil, apperrors.Conflict("the seat offer has expired")
+	}
+	if err := s.enrollFromWaitlist(ctx, item); err != nil {
+		return nil, err
+	}
+	dto, err := s.WaitlistGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "waitlist_entry",
+		EntityID:   intPtr(id),
+		Action:     "waitlist_entry.accept",
+		Summary:    fmt.Sprintf("%s accepted the seat in %s", dto.StudentName, dto.CourseName),
+		After:      dto,
+		StudentID:  intPtr(dto.StudentID),
+	})
+	return dto, nil
+}
+
+// WaitlistDecline records that a student turned down a seat offer; the seat
+// goes to the next student.
+func (s *Service) WaitlistDecline(ctx context.Context, id int) (*WaitlistEntryDTO, error) {
+	item, err := s.rt.DB.Ent.WaitlistEntry.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != waitlistentry.StatusOffered {
+		return nil, apperrors.Conflict("the student has no open seat offer")
+	}
+	if _, err := item.Update().SetStatus(waitlistentry.StatusDeclined).Save(ctx); err != nil {
+		return nil, err
+	}
+	dto, err := s.WaitlistGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditsvc.RecordEvent{
+		EntityType: "waitlist_entry",
+		EntityID:   intPtr(id),
+		Action:     "waitlist_entry.decline",
+		Summary:    fmt.Sprintf("%s declined the seat in %s", dto.StudentName, dto.CourseName),
+		After:      dto,
+		StudentID:  intPtr(dto.StudentID),
+	})
+	if _, err := s.promoteWaitlist(ctx, item.CourseID); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// WaitlistPromote fills a course's free seats from its waiting list, e.g.
+// after offers have expired or enrollments have ended.
+func (s *Service) WaitlistPromote(ctx context.Context, courseID int) ([]WaitlistEntryDTO, error) {
+	if _, err := s.rt.DB.Ent.Course.Get(ctx, courseID); err != nil {
+		return nil, err
+	}
+	return s.promoteWaitlist(ctx, courseID)
+}
+
+// promoteWaitlist expires lapsed offers and then gives each free seat to the
+// next waiting student: enrolled directly, or offered the seat when offers
+// are enabled. It returns the promoted entries.
+func (s *Service) promoteWaitlist(ctx context.Context, courseID int) ([]WaitlistEntryDTO, error) {
+	now := waitlistCurrentTime()
+	if _, err := s.rt.DB.Ent.WaitlistEntry.Update().
+		Where(
+			waitlistentry.CourseIDEQ(courseID),
+			waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+			waitlistentry.OfferExpiresAtLTE(now),
+		).
+		SetStatus(waitlistentry.StatusExpired).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	c, err := s.rt.DB.Ent.Course.Get(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	promoted := make([]WaitlistEntryDTO, 0)
+	if c.MaxStudents == 0 {
+		return promoted, nil
+	}
+	offerDays, err := s.waitlistOfferDays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	taken, err := courseSeatsTaken(ctx, s.rt.DB.Ent, courseID)
+	if err != nil {
+		return nil, err
+	}
+	offers, err := s.rt.DB.Ent.WaitlistEntry.Query().Where(openWaitlistOffers(courseID)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	free := c.MaxStudents - taken - offers
+	if free <= 0 {
+		return promoted, nil
+	}
+	next, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(
+			waitlistentry.CourseIDEQ(courseID),
+			waitlistentry.StatusEQ(waitlistentry.StatusWaiting),
+			waitlistentry.HasStudentWith(student.IsActiveEQ(true)),
+		).
+		Order(ent.Asc(waitlistentry.FieldRank), ent.Asc(waitlistentry.FieldID)).
+		Limit(free).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range next {
+		if offerDays == 0 {
+			if err := s.enrollFromWaitlist(ctx, item); err != nil {
+				return nil, err
+			}
+		} else if err := s.offerWaitlistSeat(ctx, item, now.AddDate(0, 0, offerDays)); err != nil {
+			return nil, err
+		}
+		dto, err := s.WaitlistGet(ctx, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, *dto)
+		s.recordAudit(ctx, auditsvc.RecordEvent{
+			EntityType: "waitlist_entry",
+			EntityID:   intPtr(dto.ID),
+			Action:     "waitlist_entry.promote",
+			Summary:    fmt.Sprintf("Promoted %s from the waiting list of %s (%s)", dto.StudentName, dto.CourseName, dto.Status),
+			After:      dto,
+			StudentID:  intPtr(dto.StudentID),
+		})
+	}
+	if len(next) > 0 {
+		if err := s.renumberWaitlist(ctx, courseID); err != nil {
+			return nil, err
+		}
+	}
+	return promoted, nil
+}
+
+// enrollFromWaitlist enrolls the student of an entry at the course's
+// current prices.
+func (s *Service) enrollFromWaitlist(ctx context.Context, item *ent.WaitlistEntry) error {
+	tx, err := s.rt.DB.Ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	client := tx.Client()
+
+	c, err := client.Course.Query().Where(course.IDEQ(item.CourseID)).WithPrices().Only(ctx)
+	if err != nil {
+		return err
+	}
+	year, month := currentCoursePricePeriod()
+	lessonPriceCents, _ := invsvc.CoursePricesAt(c, c.Edges.Prices, year, month)
+	subscriptionLessonPrice := 0.0
+	if string(item.BillingMode) == BillingModeSubscription {
+		subscriptionLessonPrice = money.CentsToEuros(lessonPriceCents)
+	}
+	created, err := enrollmentCreateForEntry(ctx, client, item.ID, item.StudentID, item.CourseID, string(item.BillingMode), true, 0, subscriptionLessonPrice, item.Note)
+	if err != nil {
+		return err
+	}
+	if _, err := client.WaitlistEntry.UpdateOneID(item.ID).
+		SetStatus(waitlistentry.StatusEnrolled).
+		SetEnrollmentID(created.ID).
+		Save(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// offerWaitlistSeat holds a seat for the student of an entry until expiresAt
+// and emails the offer to the payer when email is set up. A failed email
+// does not withdraw the offer.
+func (s *Service) offerWaitlistSeat(ctx context.Context, item *ent.WaitlistEntry, expiresAt time.Time) error {
+	update := item.Update().
+		SetStatus(waitlistentry.StatusOffered).
+		SetOfferedAt(waitlistCurrentTime()).
+		SetOfferExpiresAt(expiresAt)
+
+	st, err := s.rt.DB.Ent.Student.Get(ctx, item.StudentID)
+	if err != nil {
+		return err
+	}
+	c, err := s.rt.DB.Ent.Course.Get(ctx, item.CourseID)
+	if err != nil {
+		return err
+	}
+	to := strings.TrimSpace(recipient.FromStudent(st).RecipientEmail)
+	if s.emailSender != nil && to != "" {
+		templateSettings, err := s.invoiceEmailSettings(ctx)
+		if err != nil {
+			return err
+		}
+		err = s.emailSender.Send(ctx, email.Message{
+			To:      to,
+			Subject: fmt.Sprintf(waitlistOfferEmailSubject, c.Name),
+			Body:    fmt.Sprintf(waitlistOfferEmailBody, c.Name, st.FullName, expiresAt.Format("02.01.2006"), templateSettings.OrganizationName),
+			ReplyTo: templateSettings.ReplyTo,
+		})
+		if err == nil {
+			update.SetOfferEmailedTo(to)
+		}
+	}
+	_, err = update.Save(ctx)
+	return err
+}
+
+// renumberWaitlist closes the gaps left in a course's line.
+func (s *Service) renumberWaitlist(ctx context.Context, courseID int) error {
+	waiting, err := s.rt.DB.Ent.WaitlistEntry.Query().
+		Where(waitlistentry.CourseIDEQ(courseID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+		Order(ent.Asc(waitlistentry.FieldRank), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for i, entry := range waiting {
+		if entry.Rank == i+1 {
+			continue
+		}
+		if _, err := entry.Update().SetRank(i + 1).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toWaitlistEntryDTO(item *ent.WaitlistEntry) WaitlistEntryDTO {
+	dto := WaitlistEntryDTO{
+		ID:             item.ID,
+		CourseID:       item.CourseID,
+		StudentID:      item.StudentID,
+		BillingMode:    string(item.BillingMode),
+		Note:           item.Note,
+		Status:         string(item.Status),
+		OfferEmailedTo: item.OfferEmailedTo,
+		EnrollmentID:   item.EnrollmentID,
+		CreatedBy:      item.CreatedBy,
+		CreatedAt:      formatOptionalTime(&item.CreatedAt),
+	}
+	if item.Status == waitlistentry.StatusWaiting {
+		dto.Rank = item.Rank
+	}
+	if item.Status == waitlistentry.StatusOffered && item.OfferExpiresAt != nil && !item.OfferExpiresAt.After(waitlistCurrentTime()) {
+		dto.Status = WaitlistStatusExpired
+	}
+	if item.OfferedAt != nil {
+		dto.OfferedAt = formatOptionalTime(item.OfferedAt)
+	}
+	if item.OfferExpiresAt != nil {
+		dto.OfferExpiresAt = formatOptionalTime(item.OfferExpiresAt)
+	}
+	if c := item.Edges.Course; c != nil {
+		dto.CourseName = c.Name
+	}
+	if st := item.Edges.Student; st != nil {
+		dto.StudentName = st.FullName
+	}
+	return dto
+}
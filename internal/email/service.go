@@ -135,8 +135,9 @@ func (s *Service) validateMessage(msg Message) error {
 	if strings.TrimSpace(msg.Body) == "" {
 		return fmt.Errorf("email body is required")
 	}
-	if strings.TrimSpace(msg.AttachmentFilename) == "" || len(msg.AttachmentData) == 0 {
-		return fmt.Errorf("invoice PDF attachment is required")
+	// The attachment is optional, but its name and content go together.
+	if (strings.TrimSpace(msg.AttachmentFilename) == "") != (len(msg.AttachmentData) == 0) {
+		return fmt.Errorf("attachment filename and content are both required")
 	}
 	return nil
 }
@@ -182,6 +183,13 @@ func buildMessage(cfg Config, msg Message) ([]byte, error) {
 		return nil, fmt.Errorf("close email body writer: %w", err)
 	}
 
+	if len(msg.AttachmentData) == 0 {
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("close email multipart writer: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
 	escapedFilename := url.PathEscape(msg.AttachmentFilename)
 	attachmentHeader := textproto.MIMEHeader{}
 	attachmentHeader.Set("Content-Type", "application/pdf")
@@ -9,6 +9,7 @@ import (
 
 	"langschool/ent/attendancemonth"
 	"langschool/ent/enrollment"
+	"langschool/ent/invoice"
 
 	_ "github.com/ncruces/go-sqlite3/driver"
 	_ "github.com/ncruces/go-sqlite3/embed"
@@ -371,3 +372,57 @@ func TestOpenAllowsMultipleEmptyStudentPersonalCodes(t *testing.T) {
 		}
 	}
 }
+
+func TestOpenLimitsInvoicePeriodIndexToMonthlyInvoices(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "invoice-period-index.sqlite")
+
+	db, err := Open(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	db.Ent.Close()
+
+	// Databases created before lesson packages have the index on every invoice.
+	legacyDB, err := sql.Open("sqlite3", buildDSN(dbPath))
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	for _, stmt := range []string{
+		`DROP INDEX invoice_student_id_period_year_period_month`,
+		`CREATE UNIQUE INDEX invoice_student_id_period_year_period_month ON invoices (student_id, period_year, period_month)`,
+	} {
+		if _, err := legacyDB.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("legacy index setup failed for %q: %v", stmt, err)
+		}
+	}
+	legacyDB.Close()
+
+	db, err = Open(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Ent.Close()
+
+	st, err := db.Ent.Student.Create().SetFullName("Package Student").Save(ctx)
+	if err != nil {
+		t.Fatalf("Student.Create: %v", err)
+	}
+	for _, kind := range []invoice.Kind{invoice.KindMonthly, invoice.KindPackage, invoice.KindPackage} {
+		if _, err := db.Ent.Invoice.Create().
+			SetStudentID(st.ID).
+			SetPeriodYear(2026).
+			SetPeriodMonth(9).
+			SetKind(kind).
+			Save(ctx); err != nil {
+			t.Fatalf("Invoice.Create %s: %v", kind, err)
+		}
+	}
+	if _, err := db.Ent.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2026).
+		SetPeriodMonth(9).
+		Save(ctx); err == nil {
+		t.Fatal("expected a second monthly invoice for the period to fail")
+	}
+}
@@ -0,0 +1,204 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"langschool/ent"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+)
+
+// Statement entry kinds.
+const (
+	StatementEntryInvoice  = "invoice"
+	StatementEntryPayment  = "payment"
+	StatementEntryCredit   = "credit"   // payment not yet allocated to an invoice
+	StatementEntryRefund   = "refund"   // prepayment paid back, negative
+	StatementEntryTransfer = "transfer" // prepayment moved between students
+)
+
+// AccountStatement lists what a payer was charged and paid over a period.
+// Balances are amounts due: positive means the payer owes, negative means
+// the payer has paid in advance.
+type AccountStatement struct {
+	From, To     time.Time
+	Recipient    recipient.Info
+	StudentNames []string
+	OpeningCents int64
+	ClosingCents int64
+	Entries      []AccountStatementEntry
+	IssuedAt     time.Time
+}
+
+// AccountStatementEntry is one invoice or payment of a statement.
+type AccountStatementEntry struct {
+	Date         time.Time
+	Kind         string
+	Document     string // invoice number
+	Description  string
+	ChargeCents  int64
+	PaidCents    int64
+	BalanceCents int64 // running amount due after this entry
+}
+
+// AccountStatementFileName returns the file name used for a statement.
+func AccountStatementFileName(subjectName string, from, to time.Time) string {
+	return safeFileName(fmt.Sprintf("Konta izraksts %s-%s - %s", from.Format("02.01.2006"), to.Format("02.01.2006"), subjectName)) + ".pdf"
+}
+
+// GenerateAccountStatementPDF renders a statement of account in the invoice
+// layout.
+func GenerateAccountStatementPDF(ctx context.Context, db *ent.Client, st AccountStatement, opt Options) ([]byte, string, error) {
+	provider := resolveProvider(ctx, db, opt)
+	fontsDir, err := normalizePath(opt.FontsDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("FontsDir: %w", err)
+	}
+	issuedAt := st.IssuedAt
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+	subjectName := st.Recipient.InvoiceSubjectName()
+
+	p := fpdf.New("P", "mm", "A4", fontsDir)
+	p.SetTitle(fmt.Sprintf("Konta izraksts - %s", subjectName), true)
+	p.SetAuthor(provider.DisplayName, false)
+	p.SetMargins(10, 10, 10)
+	p.SetAutoPageBreak(true, 18)
+	p.AliasNbPages("")
+	if err := addArtLabFonts(p, fontsDir); err != nil {
+		return nil, "", err
+	}
+	p.SetFooterFunc(func() {
+		p.SetY(-13)
+		p.SetDrawColor(220, 220, 220)
+		p.Line(10, p.GetY(), 200, p.GetY())
+		p.Ln(2)
+
+		p.SetFont("DejaVu", "", 7.5)
+		p.SetTextColor(100, 100, 100)
+		p.CellFormat(95, 4, fmt.Sprintf("Periods: %s–%s", st.From.Format("02.01.2006"), st.To.Format("02.01.2006")), "", 0, "L", false, 0, "")
+		p.CellFormat(95, 4, fmt.Sprintf("Lapa %d/{nb}", p.PageNo()), "", 0, "R", false, 0, "")
+		p.SetTextColor(0, 0, 0)
+	})
+	p.AddPage()
+
+	drawDocumentHeader(p, provider, "KONTA IZRAKSTS", "", "", issuedAt)
+	drawProviderBlock(p, provider)
+	drawRecipientBlock(p, st.Recipient)
+	if len(st.StudentNames) > 1 {
+		infoTable(p, []struct {
+			label string
+			value string
+		}{
+			{"Audzēkņi", strings.Join(st.StudentNames, ", ")},
+		})
+		p.Ln(5)
+	}
+
+	sectionTitle(p, fmt.Sprintf("DARĪJUMI %s–%s", st.From.Format("02.01.2006"), st.To.Format("02.01.2006")))
+	drawStatementTable(p, st)
+	p.Ln(6)
+
+	closing := money.CentsToEuros(st.ClosingCents)
+	label := "Kopā apmaksai EUR:"
+	if st.ClosingCents < 0 {
+		label = "Priekšapmaksa EUR:"
+		closing = -closing
+	}
+	y := p.GetY()
+	p.SetDrawColor(70, 70, 70)
+	p.Rect(126, y, 74, 11, "D")
+	p.SetFont("DejaVu", "B", 9)
+	p.SetXY(128, y+3.5)
+	p.CellFormat(44, 4, label, "", 0, "L", false, 0, "")
+	p.CellFormat(24, 4, moneyNoCurrency(closing), "", 0, "R", false, 0, "")
+	p.SetY(y + 11 + 8)
+
+	p.SetFont("DejaVu", "", 7.5)
+	p.SetTextColor(90, 90, 90)
+	p.MultiCell(190, 4, "Atlikums ir apmaksājamā summa; negatīvs atlikums nozīmē priekšapmaksu. "+
+		"Izraksts ir sagatavots elektroniski un ir derīgs bez paraksta.", "", "C", false)
+	p.SetTextColor(0, 0, 0)
+
+	var buf bytes.Buffer
+	if err := p.Output(&buf); err != nil {
+		return nil, "", fmt.Errorf("render statement pdf: %w", err)
+	}
+	return buf.Bytes(), AccountStatementFileName(subjectName, st.From, st.To), nil
+}
+
+var statementColumnWidths = []float64{22, 30, 66, 24, 24, 24}
+
+func drawStatementTable(p *fpdf.Fpdf, st AccountStatement) {
+	w := statementColumnWidths
+	p.SetFont("DejaVu", "B", 7.5)
+	p.SetFillColor(242, 244, 247)
+	p.SetDrawColor(70, 70, 70)
+	tableHeaderCell(p, w[0], 7, "Datums", "C")
+	tableHeaderCell(p, w[1], 7, "Dokuments", "C")
+	tableHeaderCell(p, w[2], 7, "Apraksts", "C")
+	tableHeaderCell(p, w[3], 7, "Aprēķināts", "C")
+	tableHeaderCell(p, w[4], 7, "Samaksāts", "C")
+	tableHeaderCell(p, w[5], 7, "Atlikums", "C")
+	p.Ln(-1)
+
+	drawStatementRow(p, st.From.Format("02.01.2006"), "", "Sākuma atlikums", "", "", moneyNoCurrency(money.CentsToEuros(st.OpeningCents)), true)
+	for _, e := range st.Entries {
+		charge, paid := "", ""
+		if e.ChargeCents != 0 {
+			charge = moneyNoCurrency(money.CentsToEuros(e.ChargeCents))
+		}
+		if e.PaidCents != 0 {
+			paid = moneyNoCurrency(money.CentsToEuros(e.PaidCents))
+		}
+		drawStatementRow(p, e.Date.Format("02.01.2006"), e.Document, e.Description, charge, paid, moneyNoCurrency(money.CentsToEuros(e.BalanceCents)), false)
+	}
+	drawStatementRow(p, st.To.Format("02.01.2006"), "", "Beigu atlikums", "", "", moneyNoCurrency(money.CentsToEuros(st.ClosingCents)), true)
+}
+
+func drawStatementRow(p *fpdf.Fpdf, date, document, description, charge, paid, balance string, bold bool) {
+	w := statementColumnWidths
+	style := ""
+	if bold {
+		style = "B"
+	}
+	p.SetFont("DejaVu", style, 7.5)
+	descLines := wrapPDFText(p, description, w[2]-3)
+	rowH := math.Max(6, float64(len(descLines))*3.8+2.4)
+
+	x0 := 10.0
+	y0 := p.GetY()
+	if y0+rowH > 268 {
+		p.AddPage()
+		y0 = p.GetY()
+	}
+	x := x0
+	for _, cw := range w {
+		cellRect(p, x, y0, cw, rowH)
+		x += cw
+	}
+
+	midY := y0 + rowH/2 - 2
+	p.SetXY(x0, midY)
+	p.CellFormat(w[0], 4, date, "", 0, "C", false, 0, "")
+	p.CellFormat(w[1], 4, document, "", 0, "C", false, 0, "")
+	for i, line := range descLines {
+		p.SetXY(x0+w[0]+w[1]+1.5, y0+1.2+float64(i)*3.8)
+		p.CellFormat(w[2]-3, 3.8, line, "", 0, "L", false, 0, "")
+	}
+	x = x0 + w[0] + w[1] + w[2]
+	for i, value := range []string{charge, paid, balance} {
+		p.SetXY(x, midY)
+		p.CellFormat(w[3+i]-1.5, 4, value, "", 0, "R", false, 0, "")
+		x += w[3+i]
+	}
+	p.SetY(y0 + rowH)
+}
@@ -0,0 +1,156 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"langschool/ent"
+	"langschool/internal/app/recipient"
+	"langschool/internal/money"
+)
+
+// CashReceipt confirms cash received from a payer.
+type CashReceipt struct {
+	Number      string
+	PaidAt      time.Time
+	Recipient   recipient.Info // the payer handing over the cash
+	StudentName string
+	AmountCents int64
+	ReceivedBy  string
+	Note        string
+	Allocations []CashReceiptAllocation
+	CreditCents int64 // part of the amount kept as prepayment
+	VoidedAt    *time.Time
+}
+
+// CashReceiptAllocation is the part of a receipt applied to one invoice.
+type CashReceiptAllocation struct {
+	InvoiceNumber string
+	Period        string
+	AmountCents   int64
+}
+
+// CashReceiptFileName returns the file name used for a receipt, named after
+// the student like invoice files.
+func CashReceiptFileName(number, subjectName string) string {
+	return invoiceFileStem("Kvīts "+number, subjectName) + ".pdf"
+}
+
+// GenerateCashReceiptPDF renders a printable cash receipt. Voided receipts
+// keep their number and are marked as such.
+func GenerateCashReceiptPDF(ctx context.Context, db *ent.Client, receipt CashReceipt, opt Options) ([]byte, string, error) {
+	provider := resolveProvider(ctx, db, opt)
+	fontsDir, err := normalizePath(opt.FontsDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("FontsDir: %w", err)
+	}
+	payerName := strings.TrimSpace(receipt.Recipient.RecipientName)
+	subjectName := receipt.Recipient.InvoiceSubjectName()
+
+	p := fpdf.New("P", "mm", "A4", fontsDir)
+	p.SetTitle(fmt.Sprintf("Kvīts %s - %s", receipt.Number, payerName), true)
+	p.SetAuthor(provider.DisplayName, false)
+	p.SetMargins(10, 10, 10)
+	p.SetAutoPageBreak(true, 18)
+	if err := addArtLabFonts(p, fontsDir); err != nil {
+		return nil, "", err
+	}
+	p.AddPage()
+
+	drawDocumentHeader(p, provider, "KVĪTS", "Kvīts Nr.", receipt.Number, receipt.PaidAt)
+	if receipt.VoidedAt != nil {
+		p.SetFont("DejaVu", "B", 11)
+		p.SetTextColor(180, 30, 30)
+		p.CellFormat(190, 7, fmt.Sprintf("ANULĒTA %s", receipt.VoidedAt.Format("02.01.2006")), "", 1, "C", false, 0, "")
+		p.SetTextColor(0, 0, 0)
+		p.Ln(2)
+	}
+	drawProviderBlock(p, provider)
+
+	sectionTitle(p, "MAKSĀTĀJS")
+	rows := []struct {
+		label string
+		value string
+	}{
+		{"Maksātājs", payerName},
+	}
+	if receipt.StudentName != "" && receipt.StudentName != payerName {
+		rows = append(rows, struct {
+			label string
+			value string
+		}{"Audzēknis", receipt.StudentName})
+	}
+	if receipt.Note != "" {
+		rows = append(rows, struct {
+			label string
+			value string
+		}{"Piezīme", receipt.Note})
+	}
+	infoTable(p, rows)
+	p.Ln(5)
+
+	sectionTitle(p, "SAŅEMTS SKAIDRĀ NAUDĀ")
+	drawCashReceiptTable(p, provider.Currency, receipt)
+	p.Ln(4)
+
+	p.SetFont("DejaVu", "I", 8)
+	p.CellFormat(54, 5, "Summa vārdiem:", "", 0, "R", false, 0, "")
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(136, 5, amountInWords(money.CentsToEuros(receipt.AmountCents)), "B", 1, "C", false, 0, "")
+	p.Ln(12)
+
+	p.SetFont("DejaVu", "", 8.5)
+	p.CellFormat(40, 5, "Naudu pieņēma:", "", 0, "L", false, 0, "")
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(70, 5, receipt.ReceivedBy, "B", 1, "L", false, 0, "")
+	p.Ln(8)
+
+	p.SetFont("DejaVu", "", 8.5)
+	p.CellFormat(40, 5, "Paraksts:", "", 0, "L", false, 0, "")
+	p.CellFormat(70, 5, "", "B", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := p.Output(&buf); err != nil {
+		return nil, "", fmt.Errorf("render cash receipt pdf: %w", err)
+	}
+	return buf.Bytes(), CashReceiptFileName(receipt.Number, subjectName), nil
+}
+
+func drawCashReceiptTable(p *fpdf.Fpdf, currency string, receipt CashReceipt) {
+	const rowH = 6.0
+	widths := []float64{12, 70, 66, 42}
+
+	p.SetFont("DejaVu", "B", 8)
+	p.SetFillColor(240, 240, 240)
+	p.SetDrawColor(70, 70, 70)
+	tableHeaderCell(p, widths[0], rowH, "Nr.", "C")
+	tableHeaderCell(p, widths[1], rowH, "Rēķins", "L")
+	tableHeaderCell(p, widths[2], rowH, "Periods", "L")
+	tableHeaderCell(p, widths[3], rowH, fmt.Sprintf("Summa, %s", currency), "R")
+	p.Ln(rowH)
+
+	p.SetFont("DejaVu", "", 8)
+	no := 0
+	for _, a := range receipt.Allocations {
+		no++
+		p.CellFormat(widths[0], rowH, fmt.Sprintf("%d", no), "1", 0, "C", false, 0, "")
+		p.CellFormat(widths[1], rowH, a.InvoiceNumber, "1", 0, "L", false, 0, "")
+		p.CellFormat(widths[2], rowH, a.Period, "1", 0, "L", false, 0, "")
+		p.CellFormat(widths[3], rowH, moneyNoCurrency(money.CentsToEuros(a.AmountCents)), "1", 1, "R", false, 0, "")
+	}
+	if receipt.CreditCents > 0 {
+		no++
+		p.CellFormat(widths[0], rowH, fmt.Sprintf("%d", no), "1", 0, "C", false, 0, "")
+		p.CellFormat(widths[1]+widths[2], rowH, "Priekšapmaksa", "1", 0, "L", false, 0, "")
+		p.CellFormat(widths[3], rowH, moneyNoCurrency(money.CentsToEuros(receipt.CreditCents)), "1", 1, "R", false, 0, "")
+	}
+
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(widths[0]+widths[1]+widths[2], rowH, "Kopā saņemts:", "1", 0, "R", false, 0, "")
+	p.CellFormat(widths[3], rowH, moneyNoCurrency(money.CentsToEuros(receipt.AmountCents)), "1", 1, "R", false, 0, "")
+}
@@ -0,0 +1,207 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"langschool/ent"
+	"langschool/internal/money"
+)
+
+// CashSessionReport is the end-of-day Z-report of a cash session.
+type CashSessionReport struct {
+	SessionID        int
+	OpenedAt         time.Time
+	OpenedBy         string
+	ClosedAt         *time.Time
+	ClosedBy         string
+	OpeningCents     int64
+	ReceivedCents    int64
+	WithdrawalCents  int64
+	BankDepositCents int64
+	RefundCents      int64
+	ExpectedCents    int64
+	CountedCents     *int64 // nil while the session is open
+	ByUser           []CashSessionUserTotal
+	Movements        []CashSessionMovement
+	Receipts         []CashSessionReceipt
+}
+
+// CashSessionUserTotal is the cash one user took during a session.
+type CashSessionUserTotal struct {
+	Name  string
+	Count int
+	Cents int64
+}
+
+// CashSessionMovement is cash taken out of the drawer.
+type CashSessionMovement struct {
+	Time  time.Time
+	Kind  string
+	Note  string
+	By    string
+	Cents int64
+}
+
+// CashSessionReceipt is a cash receipt of a session.
+type CashSessionReceipt struct {
+	Number  string
+	Time    time.Time
+	Student string
+	By      string
+	Cents   int64
+	Voided  bool
+}
+
+// CashSessionReportFileName returns the file name used for a Z-report.
+func CashSessionReportFileName(sessionID int, openedAt time.Time) string {
+	return safeFileName(fmt.Sprintf("Z-atskaite %d %s", sessionID, openedAt.Format("2006-01-02"))) + ".pdf"
+}
+
+// GenerateCashSessionReportPDF renders the Z-report of a cash session. An
+// open session is printed as an interim report without the counted amount.
+func GenerateCashSessionReportPDF(ctx context.Context, db *ent.Client, report CashSessionReport, opt Options) ([]byte, string, error) {
+	provider := resolveProvider(ctx, db, opt)
+	fontsDir, err := normalizePath(opt.FontsDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("FontsDir: %w", err)
+	}
+
+	p := fpdf.New("P", "mm", "A4", fontsDir)
+	p.SetTitle(fmt.Sprintf("Z-atskaite %d", report.SessionID), true)
+	p.SetAuthor(provider.DisplayName, false)
+	p.SetMargins(10, 10, 10)
+	p.SetAutoPageBreak(true, 18)
+	if err := addArtLabFonts(p, fontsDir); err != nil {
+		return nil, "", err
+	}
+	p.AddPage()
+
+	title := "Z-ATSKAITE"
+	date := report.OpenedAt
+	if report.ClosedAt != nil {
+		date = *report.ClosedAt
+	} else {
+		title = "X-ATSKAITE"
+	}
+	drawDocumentHeader(p, provider, title, "Maiņa Nr.", strconv.Itoa(report.SessionID), date)
+
+	sectionTitle(p, "KASES MAIŅA")
+	rows := []struct {
+		label string
+		value string
+	}{
+		{"Atvērta", fmt.Sprintf("%s, %s", report.OpenedAt.Format("02.01.2006 15:04"), report.OpenedBy)},
+	}
+	if report.ClosedAt != nil {
+		rows = append(rows, struct {
+			label string
+			value string
+		}{"Slēgta", fmt.Sprintf("%s, %s", report.ClosedAt.Format("02.01.2006 15:04"), report.ClosedBy)})
+	}
+	infoTable(p, rows)
+	p.Ln(5)
+
+	sectionTitle(p, "KOPSAVILKUMS")
+	drawCashSessionTotals(p, provider.Currency, report)
+	p.Ln(5)
+
+	if len(report.ByUser) > 0 {
+		sectionTitle(p, "PIEŅEMTS PA LIETOTĀJIEM")
+		drawCashSessionRows(p, []float64{120, 25, 45}, []string{"Lietotājs", "Kvītis", fmt.Sprintf("Summa, %s", provider.Currency)}, func(add func(cells ...string)) {
+			for _, u := range report.ByUser {
+				add(u.Name, strconv.Itoa(u.Count), moneyNoCurrency(money.CentsToEuros(u.Cents)))
+			}
+		})
+		p.Ln(5)
+	}
+	if len(report.Movements) > 0 {
+		sectionTitle(p, "IZŅEMTA NAUDA")
+		drawCashSessionRows(p, []float64{25, 40, 60, 30, 35}, []string{"Laiks", "Veids", "Piezīme", "Lietotājs", fmt.Sprintf("Summa, %s", provider.Currency)}, func(add func(cells ...string)) {
+			for _, m := range report.Movements {
+				add(m.Time.Local().Format("15:04"), m.Kind, m.Note, m.By, moneyNoCurrency(money.CentsToEuros(m.Cents)))
+			}
+		})
+		p.Ln(5)
+	}
+	if len(report.Receipts) > 0 {
+		sectionTitle(p, "KVĪTIS")
+		drawCashSessionRows(p, []float64{30, 20, 75, 30, 35}, []string{"Kvīts Nr.", "Laiks", "Audzēknis", "Lietotājs", fmt.Sprintf("Summa, %s", provider.Currency)}, func(add func(cells ...string)) {
+			for _, r := range report.Receipts {
+				amount := moneyNoCurrency(money.CentsToEuros(r.Cents))
+				if r.Voided {
+					amount = "anulēta"
+				}
+				add(r.Number, r.Time.Local().Format("15:04"), r.Student, r.By, amount)
+			}
+		})
+		p.Ln(8)
+	}
+
+	p.SetFont("DejaVu", "", 8.5)
+	p.CellFormat(40, 5, "Paraksts:", "", 0, "L", false, 0, "")
+	p.CellFormat(70, 5, "", "B", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := p.Output(&buf); err != nil {
+		return nil, "", fmt.Errorf("render cash session report pdf: %w", err)
+	}
+	return buf.Bytes(), CashSessionReportFileName(report.SessionID, report.OpenedAt), nil
+}
+
+func drawCashSessionTotals(p *fpdf.Fpdf, currency string, report CashSessionReport) {
+	const rowH = 6.0
+	line := func(label string, cents int64, bold bool) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		p.SetFont("DejaVu", style, 8.5)
+		p.CellFormat(145, rowH, label, "1", 0, "L", false, 0, "")
+		p.CellFormat(45, rowH, fmt.Sprintf("%s %s", moneyNoCurrency(money.CentsToEuros(cents)), currency), "1", 1, "R", false, 0, "")
+	}
+	p.SetDrawColor(70, 70, 70)
+	line("Sākuma atlikums", report.OpeningCents, false)
+	line("Pieņemts skaidrā naudā", report.ReceivedCents, false)
+	line("Izņemts no kases", -report.WithdrawalCents, false)
+	line("Iemaksāts bankā", -report.BankDepositCents, false)
+	if report.RefundCents != 0 {
+		line("Atmaksāts maksātājiem", -report.RefundCents, false)
+	}
+	line("Aprēķinātais atlikums", report.ExpectedCents, true)
+	if report.CountedCents != nil {
+		line("Saskaitītā nauda", *report.CountedCents, false)
+		line("Starpība", *report.CountedCents-report.ExpectedCents, true)
+	}
+}
+
+func drawCashSessionRows(p *fpdf.Fpdf, widths []float64, headers []string, fill func(add func(cells ...string))) {
+	const rowH = 6.0
+	p.SetFont("DejaVu", "B", 8)
+	p.SetFillColor(240, 240, 240)
+	p.SetDrawColor(70, 70, 70)
+	for i, header := range headers {
+		align := "L"
+		if i == len(headers)-1 {
+			align = "R"
+		}
+		tableHeaderCell(p, widths[i], rowH, header, align)
+	}
+	p.Ln(rowH)
+
+	p.SetFont("DejaVu", "", 8)
+	fill(func(cells ...string) {
+		for i, cell := range cells {
+			align, ln := "L", 0
+			if i == len(cells)-1 {
+				align, ln = "R", 1
+			}
+			p.CellFormat(widths[i], rowH, truncateRunes(cell, int(widths[i]/1.6)), "1", ln, align, false, 0, "")
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"langschool/ent"
+	"langschool/internal/money"
+)
+
+// EducationCertificate confirms the education expenses a private person paid
+// during a calendar year. Latvian residents attach it to the annual income
+// declaration to claim the expenses back.
+type EducationCertificate struct {
+	Year              int
+	PayerName         string
+	PayerPersonalCode string // may be empty; a blank line is printed to fill in by hand
+	Students          []EducationCertificateStudent
+	IssuedAt          time.Time
+}
+
+// EducationCertificateStudent is the amount paid for one student.
+type EducationCertificateStudent struct {
+	Name         string
+	PersonalCode string
+	AmountCents  int64
+}
+
+// TotalCents returns the amount paid for all students.
+func (c EducationCertificate) TotalCents() int64 {
+	var total int64
+	for _, st := range c.Students {
+		total += st.AmountCents
+	}
+	return total
+}
+
+// EducationCertificateFileName returns the file name used for a payer's
+// certificate.
+func EducationCertificateFileName(year int, payerName string) string {
+	return safeFileName(fmt.Sprintf("Izziņa %d - %s", year, payerName)) + ".pdf"
+}
+
+// GenerateEducationCertificatePDF renders a certificate with the
+// organisation's registration details and a signature block.
+func GenerateEducationCertificatePDF(ctx context.Context, db *ent.Client, cert EducationCertificate, opt Options) ([]byte, string, error) {
+	provider := resolveProvider(ctx, db, opt)
+	fontsDir, err := normalizePath(opt.FontsDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("FontsDir: %w", err)
+	}
+	issuedAt := cert.IssuedAt
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+
+	p := fpdf.New("P", "mm", "A4", fontsDir)
+	p.SetTitle(fmt.Sprintf("Izziņa par izglītības izdevumiem %d - %s", cert.Year, cert.PayerName), true)
+	p.SetAuthor(provider.DisplayName, false)
+	p.SetMargins(10, 10, 10)
+	p.SetAutoPageBreak(true, 18)
+	if err := addArtLabFonts(p, fontsDir); err != nil {
+		return nil, "", err
+	}
+	p.AddPage()
+
+	drawDocumentHeader(p, provider, "IZZIŅA", "", "", issuedAt)
+	drawProviderBlock(p, provider)
+
+	sectionTitle(p, "MAKSĀTĀJS")
+	infoTable(p, []struct {
+		label string
+		value string
+	}{
+		{"Vārds, uzvārds", cert.PayerName},
+		{"Personas kods", cert.PayerPersonalCode},
+	})
+	p.Ln(6)
+
+	p.SetFont("DejaVu", "", 9)
+	p.MultiCell(190, 5, fmt.Sprintf(
+		"Apliecinām, ka %d. gadā no maksātāja par izglītības pakalpojumiem ir saņemti šādi maksājumi:",
+		cert.Year), "", "L", false)
+	p.Ln(2)
+
+	drawEducationCertificateTable(p, provider.Currency, cert)
+	p.Ln(4)
+
+	total := money.CentsToEuros(cert.TotalCents())
+	p.SetFont("DejaVu", "I", 8)
+	p.CellFormat(54, 5, "Summa vārdiem:", "", 0, "R", false, 0, "")
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(136, 5, amountInWords(total), "B", 1, "C", false, 0, "")
+	p.Ln(6)
+
+	p.SetFont("DejaVu", "", 8)
+	p.SetTextColor(90, 90, 90)
+	p.MultiCell(190, 4, "Izziņa izsniegta iesniegšanai Valsts ieņēmumu dienestā attaisnoto izdevumu par izglītību "+
+		"norādīšanai gada ienākumu deklarācijā. Summās iekļauti tikai gada laikā faktiski saņemtie maksājumi.", "", "L", false)
+	p.SetTextColor(0, 0, 0)
+	p.Ln(14)
+
+	drawSignatureBlock(p, provider, issuedAt)
+
+	var buf bytes.Buffer
+	if err := p.Output(&buf); err != nil {
+		return nil, "", fmt.Errorf("render certificate pdf: %w", err)
+	}
+	return buf.Bytes(), EducationCertificateFileName(cert.Year, cert.PayerName), nil
+}
+
+func drawEducationCertificateTable(p *fpdf.Fpdf, currency string, cert EducationCertificate) {
+	const rowH = 6.0
+	widths := []float64{12, 92, 44, 42}
+
+	p.SetFont("DejaVu", "B", 8)
+	p.SetFillColor(240, 240, 240)
+	p.SetDrawColor(70, 70, 70)
+	tableHeaderCell(p, widths[0], rowH, "Nr.", "C")
+	tableHeaderCell(p, widths[1], rowH, "Izglītojamais", "L")
+	tableHeaderCell(p, widths[2], rowH, "Personas kods", "C")
+	tableHeaderCell(p, widths[3], rowH, fmt.Sprintf("Samaksāts, %s", currency), "R")
+	p.Ln(rowH)
+
+	p.SetFont("DejaVu", "", 8)
+	for i, st := range cert.Students {
+		p.CellFormat(widths[0], rowH, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
+		p.CellFormat(widths[1], rowH, st.Name, "1", 0, "L", false, 0, "")
+		p.CellFormat(widths[2], rowH, st.PersonalCode, "1", 0, "C", false, 0, "")
+		p.CellFormat(widths[3], rowH, moneyNoCurrency(money.CentsToEuros(st.AmountCents)), "1", 1, "R", false, 0, "")
+	}
+
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(widths[0]+widths[1]+widths[2], rowH, "Kopā:", "1", 0, "R", false, 0, "")
+	p.CellFormat(widths[3], rowH, moneyNoCurrency(money.CentsToEuros(cert.TotalCents())), "1", 1, "R", false, 0, "")
+}
+
+// drawSignatureBlock leaves room for the responsible person's signature.
+func drawSignatureBlock(p *fpdf.Fpdf, provider artlabProvider, date time.Time) {
+	p.SetFont("DejaVu", "", 8.5)
+	p.CellFormat(40, 5, "Izsniedza:", "", 0, "L", false, 0, "")
+	p.SetFont("DejaVu", "B", 8.5)
+	p.CellFormat(70, 5, provider.ContactPerson, "B", 1, "L", false, 0, "")
+	p.Ln(8)
+
+	p.SetFont("DejaVu", "", 8.5)
+	p.CellFormat(40, 5, "Paraksts:", "", 0, "L", false, 0, "")
+	p.CellFormat(70, 5, "", "B", 1, "L", false, 0, "")
+	p.Ln(4)
+
+	p.CellFormat(40, 5, "Datums:", "", 0, "L", false, 0, "")
+	p.CellFormat(70, 5, date.Format("02.01.2006"), "B", 1, "L", false, 0, "")
+}
@@ -0,0 +1,225 @@
+package pdf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBuildEPCPayload(t *testing.T) {
+	payload, err := BuildEPCPayload(EPCPayment{
+		BeneficiaryName: "Biedrība ARTLAB",
+		IBAN:            "lv92 unla 0050 0215 2116 7",
+		BIC:             "unlalv2x",
+		AmountCents:     4550,
+		Remittance:      "LS-202503-0042",
+	})
+	if err != nil {
+		t.Fatalf("BuildEPCPayload() error = %v", err)
+	}
+	want := strings.Join([]string{
+		"BCD", "002", "1", "SCT", "UNLALV2X", "Biedrība ARTLAB",
+		"LV92UNLA0050021521167", "EUR45.50", "", "", "LS-202503-0042",
+	}, "\n")
+	if payload != want {
+		t.Fatalf("payload = %q, want %q", payload, want)
+	}
+}
+
+func TestBuildEPCPayloadPrefersStructuredReference(t *testing.T) {
+	payload, err := BuildEPCPayload(EPCPayment{
+		BeneficiaryName: "ArtLab",
+		IBAN:            "LV92UNLA0050021521167",
+		AmountCents:     1000,
+		Reference:       "RF18 5390 0754 7034",
+		Remittance:      "LS-202503-0042",
+	})
+	if err != nil {
+		t.Fatalf("BuildEPCPayload() error = %v", err)
+	}
+	lines := strings.Split(payload, "\n")
+	if len(lines) != 11 || lines[9] != "RF18539007547034" || lines[10] != "" {
+		t.Fatalf("payload lines = %q", lines)
+	}
+}
+
+func TestBuildEPCPayloadFormatsAmountFromCents(t *testing.T) {
+	for cents, want := range map[int64]string{
+		1:           "EUR0.01",
+		1005:        "EUR10.05",
+		99999999999: "EUR999999999.99",
+	} {
+		payload, err := BuildEPCPayload(EPCPayment{BeneficiaryName: "ArtLab", IBAN: "LV92UNLA0050021521167", AmountCents: cents})
+		if err != nil {
+			t.Fatalf("BuildEPCPayload(%d) error = %v", cents, err)
+		}
+		if lines := strings.Split(payload, "\n"); lines[7] != want {
+			t.Fatalf("amount for %d cents = %q, want %q", cents, lines[7], want)
+		}
+	}
+}
+
+func TestBuildEPCPayloadRejectsInvalidInput(t *testing.T) {
+	valid := EPCPayment{BeneficiaryName: "ArtLab", IBAN: "LV92UNLA0050021521167", AmountCents: 100}
+	cases := map[string]func(p *EPCPayment){
+		"missing name": func(p *EPCPayment) { p.BeneficiaryName = " " },
+		"missing iban": func(p *EPCPayment) { p.IBAN = "" },
+		"zero amount":  func(p *EPCPayment) { p.AmountCents = 0 },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := valid
+			mutate(&p)
+			if _, err := BuildEPCPayload(p); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestEncodeQRBytesRoundTrip(t *testing.T) {
+	for _, data := range []string{
+		"LS-202503-0042",
+		"BCD\n002\n1\nSCT\nUNLALV2X\nBiedrība „Kultūras, mākslas un izglītības centrs ARTLAB”\nLV92UNLA0050021521167\nEUR123.45\n\n\nLS-202503-0042",
+		strings.Repeat("0123456789", 30),
+	} {
+		qr, err := encodeQRBytes([]byte(data))
+		if err != nil {
+			t.Fatalf("encodeQRBytes() error = %v", err)
+		}
+		if qr.size != qr.version*4+17 {
+			t.Fatalf("size = %d for version %d", qr.size, qr.version)
+		}
+		if got := decodeQRForTest(t, qr); got != data {
+			t.Fatalf("decoded %q, want %q", got, data)
+		}
+	}
+}
+
+// decodeQRForTest reads a symbol back: format bits, unmasking, codeword
+// placement, de-interleaving, Reed-Solomon check and the byte segment.
+func decodeQRForTest(t *testing.T, qr *qrCode) string {
+	t.Helper()
+	format := 0
+	for i := 14; i >= 9; i-- {
+		format = format<<1 | boolBit(qr.modules[8][14-i])
+	}
+	format = format<<1 | boolBit(qr.modules[8][7])
+	format = format<<1 | boolBit(qr.modules[8][8])
+	format = format<<1 | boolBit(qr.modules[7][8])
+	for i := 5; i >= 0; i-- {
+		format = format<<1 | boolBit(qr.modules[i][8])
+	}
+	format ^= 0x5412
+	if level := format >> 13; level != 0 {
+		t.Fatalf("error correction level bits = %d, want M (0)", level)
+	}
+	mask := (format >> 10) & 7
+	if mask != qr.mask {
+		t.Fatalf("format mask = %d, want %d", mask, qr.mask)
+	}
+
+	reference := newQRCode(qr.version)
+	isFunction := reference.drawFunctionPatterns()
+	for y := range isFunction {
+		for x := range isFunction[y] {
+			if isFunction[y][x] && y != 8 && x != 8 && qr.modules[y][x] != reference.modules[y][x] {
+				t.Fatalf("function module (%d,%d) differs", x, y)
+			}
+		}
+	}
+	unmasked := newQRCode(qr.version)
+	for y := range qr.modules {
+		copy(unmasked.modules[y], qr.modules[y])
+	}
+	unmasked.applyMask(mask, isFunction)
+
+	raw := make([]byte, qrNumRawDataModules(qr.version)/8)
+	i := 0
+	for right := qr.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < qr.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = qr.size - 1 - vert
+				}
+				if isFunction[y][x] || i >= len(raw)*8 {
+					continue
+				}
+				if unmasked.modules[y][x] {
+					raw[i>>3] |= 1 << (7 - uint(i&7))
+				}
+				i++
+			}
+		}
+	}
+
+	numBlocks := qrNumECCBlocksM[qr.version]
+	eccLen := qrECCCodewordsPerBlockM[qr.version]
+	numShort := numBlocks - len(raw)%numBlocks
+	shortLen := len(raw) / numBlocks
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for pos := 0; pos <= shortLen; pos++ {
+		for b := 0; b < numBlocks; b++ {
+			if pos == shortLen-eccLen && b < numShort {
+				blocks[b] = append(blocks[b], 0)
+				continue
+			}
+			blocks[b] = append(blocks[b], raw[k])
+			k++
+		}
+	}
+	divisor := qrReedSolomonDivisor(eccLen)
+	var data []byte
+	for b, block := range blocks {
+		dataLen := shortLen - eccLen
+		if b >= numShort {
+			dataLen++
+		}
+		blockData := block[:dataLen]
+		ecc := block[len(block)-eccLen:]
+		if !bytes.Equal(qrReedSolomonRemainder(blockData, divisor), ecc) {
+			t.Fatalf("block %d: error correction codewords do not match", b)
+		}
+		data = append(data, blockData...)
+	}
+
+	bitAt := func(n int) int { return int(data[n>>3]>>(7-uint(n&7))) & 1 }
+	readBits := func(offset, length int) int {
+		v := 0
+		for n := 0; n < length; n++ {
+			v = v<<1 | bitAt(offset+n)
+		}
+		return v
+	}
+	if modeBits := readBits(0, 4); modeBits != 0x4 {
+		t.Fatalf("mode = %b, want byte mode", modeBits)
+	}
+	countBits := qrByteCountBits(qr.version)
+	count := readBits(4, countBits)
+	out := make([]byte, count)
+	for n := range out {
+		out[n] = byte(readBits(4+countBits+n*8, 8))
+	}
+	return string(out)
+}
+
+func boolBit(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func TestQRReedSolomonKnownVector(t *testing.T) {
+	// "HELLO WORLD" as version 1-M, from the ISO/IEC 18004 worked example.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := qrReedSolomonRemainder(data, qrReedSolomonDivisor(len(want))); !bytes.Equal(got, want) {
+		t.Fatalf("ecc = %v, want %v", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -17,9 +18,12 @@ import (
 	"langschool/ent"
 	"langschool/ent/invoice"
 	"langschool/ent/invoiceline"
+	"langschool/ent/payment"
 	"langschool/ent/settings"
 	"langschool/internal/app"
 	"langschool/internal/app/recipient"
+	"langschool/internal/app/reference"
+	"langschool/internal/app/vat"
 	"langschool/internal/money"
 )
 
@@ -32,11 +36,14 @@ type artlabProvider struct {
 	StructuralUnitReg string
 	Phone             string
 	ContactPerson     string
+	Beneficiary       string // account holder shown for payments; defaults to LegalName
 	Bank              string
 	Swift             string
 	IBAN              string
+	VATNumber         string // set only for registered VAT payers
 	Currency          string
 	Locale            string
+	PaymentQR         bool
 }
 
 func artlabProviderDefaults() artlabProvider {
@@ -54,21 +61,13 @@ func artlabProviderDefaults() artlabProvider {
 		IBAN:              "LV92UNLA0050021521167",
 		Currency:          "EUR",
 		Locale:            "lv-LV",
+		PaymentQR:         true,
 	}
 }
 
-func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoiceID int, opt Options) (string, error) {
-	iv, err := db.Invoice.Query().
-		Where(invoice.IDEQ(invoiceID)).
-		WithStudent().
-		Only(ctx)
-	if err != nil {
-		return "", err
-	}
-	if iv.Number == nil || strings.TrimSpace(*iv.Number) == "" {
-		return "", fmt.Errorf("invoice %d has no number (issue it first)", invoiceID)
-	}
-
+// resolveProvider merges the organisation settings and per-call options over
+// the built-in provider details.
+func resolveProvider(ctx context.Context, db *ent.Client, opt Options) artlabProvider {
 	provider := artlabProviderDefaults()
 
 	st, _ := db.Settings.Query().Where(settings.SingletonIDEQ(app.SettingsSingletonID)).Only(ctx)
@@ -85,6 +84,22 @@ func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoice
 		if strings.TrimSpace(st.Locale) != "" {
 			provider.Locale = st.Locale
 		}
+		if strings.TrimSpace(st.BankBeneficiaryName) != "" {
+			provider.Beneficiary = st.BankBeneficiaryName
+		}
+		if strings.TrimSpace(st.BankName) != "" {
+			provider.Bank = st.BankName
+		}
+		if strings.TrimSpace(st.BankBic) != "" {
+			provider.Swift = st.BankBic
+		}
+		if strings.TrimSpace(st.BankIban) != "" {
+			provider.IBAN = st.BankIban
+		}
+		provider.PaymentQR = st.InvoicePaymentQrEnabled
+		if st.VatEnabled {
+			provider.VATNumber = strings.TrimSpace(st.VatNumber)
+		}
 	}
 	if strings.TrimSpace(opt.Currency) != "" {
 		provider.Currency = opt.Currency
@@ -92,6 +107,26 @@ func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoice
 	if strings.TrimSpace(opt.Locale) != "" {
 		provider.Locale = opt.Locale
 	}
+	if strings.TrimSpace(provider.Beneficiary) == "" {
+		provider.Beneficiary = provider.LegalName
+	}
+	return provider
+}
+
+func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoiceID int, opt Options) (string, error) {
+	iv, err := db.Invoice.Query().
+		Where(invoice.IDEQ(invoiceID)).
+		WithStudent().
+		WithBillingTerm().
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	if iv.Number == nil || strings.TrimSpace(*iv.Number) == "" {
+		return "", fmt.Errorf("invoice %d has no number (issue it first)", invoiceID)
+	}
+
+	provider := resolveProvider(ctx, db, opt)
 
 	outBase, err := normalizePath(opt.OutBaseDir)
 	if err != nil {
@@ -109,6 +144,11 @@ func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoice
 		return "", err
 	}
 
+	outstandingCents, err := invoiceOutstandingCents(ctx, db, iv)
+	if err != nil {
+		return "", err
+	}
+
 	year, month := iv.PeriodYear, iv.PeriodMonth
 	dir := filepath.Join(outBase, fmt.Sprintf("%04d", year), fmt.Sprintf("%02d", month))
 	if err := os.MkdirAll(dir, app.DirPermission); err != nil {
@@ -123,10 +163,9 @@ func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoice
 	outPath := filepath.Join(dir, fmt.Sprintf("%s.pdf", invoiceFileStem(*iv.Number, subjectName)))
 
 	invoiceDate := time.Now()
-	dueDate := invoiceDate.AddDate(0, 0, 14)
+	dueDate := invoiceDate.AddDate(0, 0, app.InvoicePaymentTermDays)
 
-	periodStart := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
-	periodEnd := periodStart.AddDate(0, 1, -1)
+	periodStart, periodEnd := invoiceServicePeriod(iv)
 
 	p := fpdf.New("P", "mm", "A4", fontsDir)
 	p.SetTitle(fmt.Sprintf("Rēķins %s - %s", *iv.Number, subjectName), true)
@@ -158,7 +197,13 @@ func GenerateInvoicePDFProfessional(ctx context.Context, db *ent.Client, invoice
 	drawProviderBlock(p, provider)
 	drawRecipientBlock(p, recipientInfo)
 	drawServiceTable(p, provider.Currency, lines, periodStart, periodEnd)
-	drawTotalAndPayment(p, provider, money.CentsToEuros(iv.TotalAmountCents), dueDate, *iv.Number)
+	var vatGroups []vat.Group
+	if lineVAT := vat.FromInvoiceLines(lines); vat.Applies(lineVAT) {
+		vatGroups = vat.Breakdown(lineVAT)
+	}
+	if err := drawTotalAndPayment(p, provider, money.CentsToEuros(iv.TotalAmountCents), vatGroups, outstandingCents, dueDate, *iv.Number, reference.ForInvoice(iv.ID)); err != nil {
+		return "", err
+	}
 
 	if err := p.OutputFileAndClose(outPath); err != nil {
 		return "", fmt.Errorf("write pdf %s: %w", outPath, err)
@@ -206,6 +251,13 @@ func addArtLabFonts(p *fpdf.Fpdf, fontsDir string) error {
 }
 
 func drawHeader(p *fpdf.Fpdf, provider artlabProvider, number string, invoiceDate time.Time) {
+	drawDocumentHeader(p, provider, "RĒĶINS", "Rēķins Nr.", number, invoiceDate)
+}
+
+// drawDocumentHeader draws the organisation name, the document title and the
+// number/date box shared by all generated documents. An empty numberLabel
+// leaves out the number row.
+func drawDocumentHeader(p *fpdf.Fpdf, provider artlabProvider, title, numberLabel, number string, date time.Time) {
 	left := 10.0
 	right := 200.0
 
@@ -215,23 +267,27 @@ func drawHeader(p *fpdf.Fpdf, provider artlabProvider, number string, invoiceDat
 	p.CellFormat(110, 10, provider.DisplayName, "", 0, "L", false, 0, "")
 
 	p.SetFont("DejaVu", "B", 15)
-	p.CellFormat(80, 10, "RĒĶINS", "", 1, "R", false, 0, "")
+	p.CellFormat(80, 10, title, "", 1, "R", false, 0, "")
 
 	p.SetFont("DejaVu", "I", 8.5)
 	p.SetX(left + 17)
 	p.CellFormat(95, 5, "Kultūras, mākslas un izglītības centrs", "", 0, "L", false, 0, "")
 
-	p.SetFont("DejaVu", "", 9)
-	p.SetX(130)
-	p.CellFormat(28, 5, "Rēķins Nr.", "", 0, "L", false, 0, "")
-	p.SetFont("DejaVu", "B", 9)
-	p.CellFormat(42, 5, number, "B", 1, "C", false, 0, "")
+	if numberLabel != "" {
+		p.SetFont("DejaVu", "", 9)
+		p.SetX(130)
+		p.CellFormat(28, 5, numberLabel, "", 0, "L", false, 0, "")
+		p.SetFont("DejaVu", "B", 9)
+		p.CellFormat(42, 5, number, "B", 1, "C", false, 0, "")
+	} else {
+		p.Ln(5)
+	}
 
 	p.SetFont("DejaVu", "", 9)
 	p.SetX(130)
 	p.CellFormat(28, 5, "Datums", "", 0, "L", false, 0, "")
 	p.SetFont("DejaVu", "B", 9)
-	p.CellFormat(42, 5, invoiceDate.Format("02.01.2006"), "B", 1, "C", false, 0, "")
+	p.CellFormat(42, 5, date.Format("02.01.2006"), "B", 1, "C", false, 0, "")
 
 	p.Ln(10)
 
@@ -251,12 +307,23 @@ func drawProviderBlock(p *fpdf.Fpdf, provider artlabProvider) {
 	}{
 		{"Nosaukums", provider.LegalName},
 		{"Reģ. Nr.", provider.RegistrationNo},
+	}
+	if provider.VATNumber != "" {
+		rows = append(rows, struct {
+			label string
+			value string
+		}{"PVN reģ. Nr.", provider.VATNumber})
+	}
+	rows = append(rows, []struct {
+		label string
+		value string
+	}{
 		{"Juridiskā adrese", provider.LegalAddress},
 		{"Struktūrvienība", fmt.Sprintf("%s    Reģ. Nr. %s", provider.StructuralUnit, provider.StructuralUnitReg)},
 		{"Tālrunis", fmt.Sprintf("%s %s", provider.ContactPerson, provider.Phone)},
 		{"Banka", fmt.Sprintf("%s, %s", provider.Bank, provider.Swift)},
 		{"Konts", provider.IBAN},
-	}
+	}...)
 
 	infoTable(p, rows)
 	p.Ln(5)
@@ -265,6 +332,22 @@ func drawProviderBlock(p *fpdf.Fpdf, provider artlabProvider) {
 func drawRecipientBlock(p *fpdf.Fpdf, recipient recipient.Info) {
 	sectionTitle(p, "PAKALPOJUMA SAŅĒMĒJS")
 
+	if recipient.IsCompany {
+		infoTable(p, []struct {
+			label string
+			value string
+		}{
+			{"Nosaukums", recipient.RecipientName},
+			{"Reģ. Nr.", recipient.CompanyRegNo},
+			{"PVN reģ. Nr.", recipient.CompanyVATNumber},
+			{"Juridiskā adrese", recipient.CompanyAddress},
+			{"E-pasts", recipient.RecipientEmail},
+			{"Audzēknis", recipient.ChildName},
+		})
+		p.Ln(5)
+		return
+	}
+
 	rows := []struct {
 		label string
 		value string
@@ -308,6 +391,18 @@ func filterNonEmptyStrings(values ...string) []string {
 	return out
 }
 
+// invoiceServicePeriod returns the first and last day billed: the invoice's
+// month, or every month of the term a term invoice bills. The invoice's
+// billing term edge must be loaded.
+func invoiceServicePeriod(iv *ent.Invoice) (time.Time, time.Time) {
+	start := time.Date(iv.PeriodYear, time.Month(iv.PeriodMonth), 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, -1)
+	if t := iv.Edges.BillingTerm; t != nil {
+		end = time.Date(t.EndYear, time.Month(t.EndMonth)+1, 0, 0, 0, 0, 0, time.Local)
+	}
+	return start, end
+}
+
 func drawServiceTable(p *fpdf.Fpdf, currency string, lines []*ent.InvoiceLine, periodStart, periodEnd time.Time) {
 	sectionTitle(p, "PAKALPOJUMA RAKSTUROJUMS")
 
@@ -508,26 +603,83 @@ func formatInvoiceQty(qty float64) string {
 	return fmt.Sprintf("%.2f", qty)
 }
 
-func drawTotalAndPayment(p *fpdf.Fpdf, provider artlabProvider, total float64, dueDate time.Time, invoiceNumber string) {
+// invoiceOutstandingCents returns the invoice total minus payments already
+// allocated to it.
+func invoiceOutstandingCents(ctx context.Context, db *ent.Client, iv *ent.Invoice) (int64, error) {
+	payments, err := db.Payment.Query().
+		Where(payment.InvoiceIDEQ(iv.ID)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	outstanding := iv.TotalAmountCents
+	for _, item := range payments {
+		outstanding -= item.AmountCents
+	}
+	if outstanding < 0 {
+		return 0, nil
+	}
+	return outstanding, nil
+}
+
+// drawTotalAndPayment prints the totals box and payment details. vatGroups is
+// nil for non-VAT payers, whose invoices show a single total.
+func drawTotalAndPayment(p *fpdf.Fpdf, provider artlabProvider, total float64, vatGroups []vat.Group, outstandingCents int64, dueDate time.Time, invoiceNumber, paymentReference string) error {
 	p.Ln(0)
 
 	totalBoxW := 54.0
 	totalBoxX := 146.0
 	y := p.GetY()
 
+	type totalRow struct {
+		label string
+		value float64
+	}
+	var rows []totalRow
+	var exemptNotes []string
+	if len(vatGroups) > 0 {
+		net, _ := vat.Totals(vatGroups)
+		rows = append(rows, totalRow{"Summa bez PVN:", money.CentsToEuros(net)})
+		for _, g := range vatGroups {
+			rows = append(rows, totalRow{fmt.Sprintf("PVN %s%%:", formatVATRate(g.RatePct)), money.CentsToEuros(g.VATCents)})
+			if g.ExemptNote != "" {
+				exemptNotes = append(exemptNotes, g.ExemptNote)
+			}
+		}
+	}
+	rows = append(rows, totalRow{"Kopā EUR:", total})
+
+	const rowH = 7.0
+	totalBoxH := rowH*float64(len(rows)) + 4
 	p.SetDrawColor(70, 70, 70)
-	p.Rect(totalBoxX, y, totalBoxW, 11, "D")
-	p.Line(totalBoxX+31, y, totalBoxX+31, y+11)
+	p.Rect(totalBoxX, y, totalBoxW, totalBoxH, "D")
+	p.Line(totalBoxX+31, y, totalBoxX+31, y+totalBoxH)
+
+	for i, row := range rows {
+		rowY := y + 3.2 + float64(i)*rowH
+		last := i == len(rows)-1
+		if last {
+			p.SetFont("DejaVu", "B", 8)
+		} else {
+			p.SetFont("DejaVu", "", 8)
+		}
+		p.SetXY(totalBoxX+2, rowY)
+		p.CellFormat(28, 4, row.label, "", 0, "L", false, 0, "")
 
-	p.SetFont("DejaVu", "B", 8)
-	p.SetXY(totalBoxX+2, y+3.2)
-	p.CellFormat(28, 4, "Kopā EUR:", "", 0, "L", false, 0, "")
+		if last {
+			p.SetFont("DejaVu", "B", 9)
+		}
+		p.SetXY(totalBoxX+32, rowY)
+		p.CellFormat(20, 4, moneyNoCurrency(row.value), "", 0, "R", false, 0, "")
+	}
 
-	p.SetFont("DejaVu", "B", 9)
-	p.SetXY(totalBoxX+32, y+3.2)
-	p.CellFormat(20, 4, moneyNoCurrency(total), "", 0, "R", false, 0, "")
+	if len(exemptNotes) > 0 {
+		p.SetFont("DejaVu", "I", 7.5)
+		p.SetXY(10, y+1)
+		p.MultiCell(130, 4, strings.Join(exemptNotes, "\n"), "", "L", false)
+	}
 
-	p.SetY(y + 19)
+	p.SetY(y + totalBoxH + 8)
 
 	p.SetFont("DejaVu", "I", 8)
 	p.CellFormat(54, 5, "Samaksas veids un kārtība:", "", 0, "R", false, 0, "")
@@ -538,45 +690,68 @@ func drawTotalAndPayment(p *fpdf.Fpdf, provider artlabProvider, total float64, d
 
 	p.Ln(3)
 
-	euro, cents := splitEUR(total)
-	words := fmt.Sprintf("%s eiro un %02d centi", capitalizeFirst(latvianNumberWords(euro)), cents)
-
 	p.SetFont("DejaVu", "I", 8)
 	p.CellFormat(54, 5, "Summa vārdiem:", "", 0, "R", false, 0, "")
 	p.SetFont("DejaVu", "B", 8.5)
-	p.CellFormat(136, 5, words, "B", 1, "C", false, 0, "")
+	p.CellFormat(136, 5, amountInWords(total), "B", 1, "C", false, 0, "")
 
 	p.Ln(7)
 
+	// The QR code is skipped when disabled, when nothing is left to pay or
+	// when the bank details are incomplete.
+	showQR := provider.PaymentQR && outstandingCents > 0 && normalizeIBAN(provider.IBAN) != ""
+	boxH := 32.0
+	if showQR {
+		boxH = 34.0
+	}
+	boxY := p.GetY()
 	p.SetFillColor(248, 249, 251)
 	p.SetDrawColor(210, 210, 210)
-	p.Rect(10, p.GetY(), 190, 28, "DF")
+	p.Rect(10, boxY, 190, boxH, "DF")
+	if showQR {
+		if err := drawEPCQRCode(p, EPCPayment{
+			BeneficiaryName: provider.Beneficiary,
+			IBAN:            provider.IBAN,
+			BIC:             provider.Swift,
+			AmountCents:     outstandingCents,
+			Reference:       paymentReference,
+			Remittance:      invoiceNumber,
+		}, 168, boxY+2, 30); err != nil {
+			return err
+		}
+	}
 
-	p.SetXY(13, p.GetY()+3)
+	p.SetXY(13, boxY+3)
 	p.SetFont("DejaVu", "B", 9)
 	p.CellFormat(184, 5, "Maksājuma informācija", "", 1, "L", false, 0, "")
 
 	p.SetFont("DejaVu", "", 8)
 	p.SetX(13)
 	p.CellFormat(36, 4.5, "Saņēmējs:", "", 0, "L", false, 0, "")
-	p.CellFormat(145, 4.5, provider.LegalName, "", 1, "L", false, 0, "")
+	p.CellFormat(118, 4.5, provider.Beneficiary, "", 1, "L", false, 0, "")
 
 	p.SetX(13)
 	p.CellFormat(36, 4.5, "Banka:", "", 0, "L", false, 0, "")
-	p.CellFormat(145, 4.5, fmt.Sprintf("%s, SWIFT: %s", provider.Bank, provider.Swift), "", 1, "L", false, 0, "")
+	p.CellFormat(118, 4.5, fmt.Sprintf("%s, SWIFT: %s", provider.Bank, provider.Swift), "", 1, "L", false, 0, "")
 
 	p.SetX(13)
 	p.CellFormat(36, 4.5, "Konts:", "", 0, "L", false, 0, "")
 	p.SetFont("DejaVu", "B", 8)
-	p.CellFormat(145, 4.5, provider.IBAN, "", 1, "L", false, 0, "")
+	p.CellFormat(118, 4.5, provider.IBAN, "", 1, "L", false, 0, "")
 
 	p.SetFont("DejaVu", "", 8)
 	p.SetX(13)
 	p.CellFormat(36, 4.5, "Maksājuma mērķis:", "", 0, "L", false, 0, "")
 	p.SetFont("DejaVu", "B", 8)
-	p.CellFormat(145, 4.5, invoiceNumber, "", 1, "L", false, 0, "")
+	p.CellFormat(118, 4.5, invoiceNumber, "", 1, "L", false, 0, "")
+
+	p.SetFont("DejaVu", "", 8)
+	p.SetX(13)
+	p.CellFormat(36, 4.5, "Maksājuma atsauce:", "", 0, "L", false, 0, "")
+	p.SetFont("DejaVu", "B", 8)
+	p.CellFormat(118, 4.5, reference.Format(paymentReference), "", 1, "L", false, 0, "")
 
-	p.Ln(9)
+	p.SetY(boxY + boxH + 3)
 	p.SetFont("DejaVu", "B", 8.5)
 	p.CellFormat(190, 5, "Ja maksājums netiek veikts līdz norādītajam termiņam, rēķins var tikt anulēts.", "", 1, "C", false, 0, "")
 
@@ -585,6 +760,11 @@ func drawTotalAndPayment(p *fpdf.Fpdf, provider artlabProvider, total float64, d
 	p.SetTextColor(90, 90, 90)
 	p.MultiCell(190, 4, "Rēķins ir sagatavots elektroniski un ir derīgs bez paraksta.", "", "C", false)
 	p.SetTextColor(0, 0, 0)
+	return nil
+}
+
+func formatVATRate(rate float64) string {
+	return strings.ReplaceAll(strconv.FormatFloat(rate, 'f', -1, 64), ".", ",")
 }
 
 func sectionTitle(p *fpdf.Fpdf, title string) {
@@ -667,6 +847,11 @@ func moneyNoCurrency(v float64) string {
 	return strings.ReplaceAll(fmt.Sprintf("%.2f", v), ".", ",")
 }
 
+func amountInWords(v float64) string {
+	euro, cents := splitEUR(v)
+	return fmt.Sprintf("%s eiro un %02d centi", capitalizeFirst(latvianNumberWords(euro)), cents)
+}
+
 func splitEUR(v float64) (int, int) {
 	totalCents := int(math.Round(v * 100))
 	return totalCents / 100, totalCents % 100
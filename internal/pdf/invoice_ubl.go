@@ -0,0 +1,443 @@
+// internal/pdf/invoice_ubl.go
+
+package pdf
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"langschool/ent"
+	"langschool/ent/invoice"
+	"langschool/ent/invoiceline"
+	"langschool/internal/app"
+	"langschool/internal/app/recipient"
+	"langschool/internal/app/reference"
+	"langschool/internal/app/vat"
+	"langschool/internal/money"
+)
+
+const (
+	ublInvoiceNamespace = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	ublCACNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	ublCBCNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+
+	PeppolCustomizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	PeppolProfileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+
+	ublInvoiceTypeCommercial = "380"
+	ublPaymentMeansSEPA      = "58"
+	ublUnitCodeOne           = "C62"
+	ublSchemeLatvianRegister = "0218"
+	ublSchemeEmail           = "EM"
+
+	// Organizations that are not VAT payers issue invoices "not subject to
+	// VAT" (category O); VAT payers use standard rated (S) and exempt (E) lines.
+	ublTaxCategoryNotSubject = "O"
+	ublTaxCategoryStandard   = "S"
+	ublTaxCategoryExempt     = "E"
+	ublTaxExemptionCode      = "VATEX-EU-O"
+	ublTaxExemptionReason    = "Nav PVN objekts"
+)
+
+// ErrUBLBuyerEndpoint means the payer has no e-mail address, which Peppol
+// needs as the buyer's electronic address (PEPPOL-EN16931-R010). Such payers
+// can only get the PDF invoice.
+var ErrUBLBuyerEndpoint = errors.New("buyer electronic address (e-mail) is required for an e-invoice")
+
+// The structs below mirror the UBL 2.1 element order required by the XSD;
+// encoding/xml writes fields in declaration order, so do not reorder them.
+
+type ublInvoice struct {
+	XMLName                 xml.Name         `xml:"Invoice"`
+	Xmlns                   string           `xml:"xmlns,attr"`
+	XmlnsCAC                string           `xml:"xmlns:cac,attr"`
+	XmlnsCBC                string           `xml:"xmlns:cbc,attr"`
+	CustomizationID         string           `xml:"cbc:CustomizationID"`
+	ProfileID               string           `xml:"cbc:ProfileID"`
+	ID                      string           `xml:"cbc:ID"`
+	IssueDate               string           `xml:"cbc:IssueDate"`
+	DueDate                 string           `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode         string           `xml:"cbc:InvoiceTypeCode"`
+	Note                    string           `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode    string           `xml:"cbc:DocumentCurrencyCode"`
+	BuyerReference          string           `xml:"cbc:BuyerReference"`
+	InvoicePeriod           ublPeriod        `xml:"cac:InvoicePeriod"`
+	AccountingSupplierParty ublPartyWrapper  `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty ublPartyWrapper  `xml:"cac:AccountingCustomerParty"`
+	PaymentMeans            ublPaymentMeans  `xml:"cac:PaymentMeans"`
+	TaxTotal                ublTaxTotal      `xml:"cac:TaxTotal"`
+	LegalMonetaryTotal      ublMonetaryTotal `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLines            []ublInvoiceLine `xml:"cac:InvoiceLine"`
+}
+
+type ublPeriod struct {
+	StartDate string `xml:"cbc:StartDate"`
+	EndDate   string `xml:"cbc:EndDate"`
+}
+
+type ublPartyWrapper struct {
+	Party ublParty `xml:"cac:Party"`
+}
+
+type ublParty struct {
+	EndpointID       ublIdentifier       `xml:"cbc:EndpointID"`
+	PartyName        *ublPartyName       `xml:"cac:PartyName,omitempty"`
+	PostalAddress    ublAddress          `xml:"cac:PostalAddress"`
+	PartyTaxScheme   *ublPartyTaxScheme  `xml:"cac:PartyTaxScheme,omitempty"`
+	PartyLegalEntity ublPartyLegalEntity `xml:"cac:PartyLegalEntity"`
+	Contact          *ublContact         `xml:"cac:Contact,omitempty"`
+}
+
+type ublIdentifier struct {
+	SchemeID string `xml:"schemeID,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+type ublPartyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+type ublAddress struct {
+	StreetName string     `xml:"cbc:StreetName,omitempty"`
+	CityName   string     `xml:"cbc:CityName,omitempty"`
+	PostalZone string     `xml:"cbc:PostalZone,omitempty"`
+	Country    ublCountry `xml:"cac:Country"`
+}
+
+type ublCountry struct {
+	IdentificationCode string `xml:"cbc:IdentificationCode"`
+}
+
+type ublPartyTaxScheme struct {
+	CompanyID string       `xml:"cbc:CompanyID"`
+	TaxScheme ublTaxScheme `xml:"cac:TaxScheme"`
+}
+
+type ublPartyLegalEntity struct {
+	RegistrationName string         `xml:"cbc:RegistrationName"`
+	CompanyID        *ublIdentifier `xml:"cbc:CompanyID,omitempty"`
+}
+
+type ublContact struct {
+	Name      string `xml:"cbc:Name,omitempty"`
+	Telephone string `xml:"cbc:Telephone,omitempty"`
+	Email     string `xml:"cbc:ElectronicMail,omitempty"`
+}
+
+type ublPaymentMeans struct {
+	PaymentMeansCode      string            `xml:"cbc:PaymentMeansCode"`
+	PaymentID             string            `xml:"cbc:PaymentID,omitempty"`
+	PayeeFinancialAccount *ublFinancialAcct `xml:"cac:PayeeFinancialAccount,omitempty"`
+}
+
+type ublFinancialAcct struct {
+	ID                         string       `xml:"cbc:ID"`
+	Name                       string       `xml:"cbc:Name,omitempty"`
+	FinancialInstitutionBranch *ublBranchID `xml:"cac:FinancialInstitutionBranch,omitempty"`
+}
+
+type ublBranchID struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type ublAmount struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type ublTaxTotal struct {
+	TaxAmount   ublAmount        `xml:"cbc:TaxAmount"`
+	TaxSubtotal []ublTaxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+type ublTaxSubtotal struct {
+	TaxableAmount ublAmount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     ublAmount      `xml:"cbc:TaxAmount"`
+	TaxCategory   ublTaxCategory `xml:"cac:TaxCategory"`
+}
+
+type ublTaxCategory struct {
+	ID                     string       `xml:"cbc:ID"`
+	Percent                string       `xml:"cbc:Percent,omitempty"`
+	TaxExemptionReasonCode string       `xml:"cbc:TaxExemptionReasonCode,omitempty"`
+	TaxExemptionReason     string       `xml:"cbc:TaxExemptionReason,omitempty"`
+	TaxScheme              ublTaxScheme `xml:"cac:TaxScheme"`
+}
+
+type ublTaxScheme struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type ublMonetaryTotal struct {
+	LineExtensionAmount ublAmount  `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount  ublAmount  `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount  ublAmount  `xml:"cbc:TaxInclusiveAmount"`
+	PrepaidAmount       *ublAmount `xml:"cbc:PrepaidAmount,omitempty"`
+	PayableAmount       ublAmount  `xml:"cbc:PayableAmount"`
+}
+
+type ublInvoiceLine struct {
+	ID                  string      `xml:"cbc:ID"`
+	InvoicedQuantity    ublQuantity `xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount ublAmount   `xml:"cbc:LineExtensionAmount"`
+	Item                ublItem     `xml:"cac:Item"`
+	Price               ublPrice    `xml:"cac:Price"`
+}
+
+type ublQuantity struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type ublItem struct {
+	Name                  string         `xml:"cbc:Name"`
+	ClassifiedTaxCategory ublTaxCategory `xml:"cac:ClassifiedTaxCategory"`
+}
+
+type ublPrice struct {
+	PriceAmount ublAmount `xml:"cbc:PriceAmount"`
+}
+
+// GenerateInvoiceUBL renders an issued invoice as a Peppol BIS Billing 3.0
+// UBL document and returns it together with a suggested file name. A payer
+// without an e-mail address fails with ErrUBLBuyerEndpoint.
+func GenerateInvoiceUBL(ctx context.Context, db *ent.Client, invoiceID int, opt Options) ([]byte, string, error) {
+	iv, err := db.Invoice.Query().
+		Where(invoice.IDEQ(invoiceID)).
+		WithStudent().
+		WithBillingTerm().
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if iv.Number == nil || strings.TrimSpace(*iv.Number) == "" {
+		return nil, "", fmt.Errorf("invoice %d has no number (issue it first)", invoiceID)
+	}
+
+	provider := resolveProvider(ctx, db, opt)
+	lines, err := db.InvoiceLine.Query().
+		Where(invoiceline.InvoiceIDEQ(iv.ID)).
+		Order(ent.Asc(invoiceline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	outstandingCents, err := invoiceOutstandingCents(ctx, db, iv)
+	if err != nil {
+		return nil, "", err
+	}
+	recipientInfo, err := recipient.ResolveInvoiceRecipient(ctx, db, iv.StudentID)
+	if err != nil {
+		return nil, "", err
+	}
+	if strings.TrimSpace(recipientInfo.RecipientEmail) == "" {
+		return nil, "", fmt.Errorf("invoice %s: %w", *iv.Number, ErrUBLBuyerEndpoint)
+	}
+
+	// The PDF prints the date it was rendered, so the e-invoice follows it.
+	issueDate := time.Now()
+	if iv.PdfGeneratedAt != nil {
+		issueDate = *iv.PdfGeneratedAt
+	}
+	doc := buildUBLInvoice(provider, iv, lines, recipientInfo, outstandingCents, issueDate)
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, "", err
+	}
+	data := append([]byte(xml.Header), out...)
+	data = append(data, '\n')
+	return data, invoiceFileStem(*iv.Number, recipientInfo.InvoiceSubjectName()) + ".xml", nil
+}
+
+func buildUBLInvoice(provider artlabProvider, iv *ent.Invoice, lines []*ent.InvoiceLine, buyer recipient.Info, outstandingCents int64, issueDate time.Time) ublInvoice {
+	currency := strings.ToUpper(strings.TrimSpace(provider.Currency))
+	amount := func(cents int64) ublAmount {
+		return ublAmount{CurrencyID: currency, Value: ublMoney(cents)}
+	}
+	lineVAT := vat.FromInvoiceLines(lines)
+	vatPayer := vat.Applies(lineVAT)
+
+	periodStart, periodEnd := invoiceServicePeriod(iv)
+
+	ublLines := make([]ublInvoiceLine, 0, len(lines))
+	for i, ln := range lines {
+		lineCategory := ublVATCategory(vatPayer, ln.VatRatePct, "")
+		lineCategory.TaxExemptionReasonCode = ""
+		lineCategory.TaxExemptionReason = ""
+		ublLines = append(ublLines, ublInvoiceLine{
+			ID:                  strconv.Itoa(i + 1),
+			InvoicedQuantity:    ublQuantity{UnitCode: ublUnitCodeOne, Value: strconv.FormatFloat(ln.Qty, 'f', -1, 64)},
+			LineExtensionAmount: amount(ln.AmountCents),
+			Item: ublItem{
+				Name:                  normalizeInvoiceDescription(ln.Description, periodStart),
+				ClassifiedTaxCategory: lineCategory,
+			},
+			Price: ublPrice{PriceAmount: amount(ln.UnitPriceCents)},
+		})
+	}
+
+	var subtotals []ublTaxSubtotal
+	if vatPayer {
+		for _, g := range vat.Breakdown(lineVAT) {
+			subtotals = append(subtotals, ublTaxSubtotal{
+				TaxableAmount: amount(g.NetCents),
+				TaxAmount:     amount(g.VATCents),
+				TaxCategory:   ublVATCategory(true, g.RatePct, g.ExemptNote),
+			})
+		}
+	} else {
+		net, _ := vat.Totals(vat.Breakdown(lineVAT))
+		subtotals = []ublTaxSubtotal{{
+			TaxableAmount: amount(net),
+			TaxAmount:     amount(0),
+			TaxCategory:   ublVATCategory(false, 0, ""),
+		}}
+	}
+	lineTotal, vatTotal := vat.Totals(vat.Breakdown(lineVAT))
+	grossTotal := lineTotal + vatTotal
+
+	prepaidCents := grossTotal - outstandingCents
+	if prepaidCents < 0 {
+		prepaidCents = 0
+	}
+	var prepaid *ublAmount
+	if prepaidCents > 0 {
+		v := amount(prepaidCents)
+		prepaid = &v
+	}
+
+	street, city, postal := splitPostalAddress(provider.LegalAddress)
+	doc := ublInvoice{
+		Xmlns:                ublInvoiceNamespace,
+		XmlnsCAC:             ublCACNamespace,
+		XmlnsCBC:             ublCBCNamespace,
+		CustomizationID:      PeppolCustomizationID,
+		ProfileID:            PeppolProfileID,
+		ID:                   *iv.Number,
+		IssueDate:            issueDate.Format(time.DateOnly),
+		DueDate:              issueDate.AddDate(0, 0, app.InvoicePaymentTermDays).Format(time.DateOnly),
+		InvoiceTypeCode:      ublInvoiceTypeCommercial,
+		DocumentCurrencyCode: currency,
+		// Private payers have no purchase order; the student name is what
+		// the payer recognises the invoice by.
+		BuyerReference: buyer.ChildName,
+		InvoicePeriod: ublPeriod{
+			StartDate: periodStart.Format(time.DateOnly),
+			EndDate:   periodEnd.Format(time.DateOnly),
+		},
+		AccountingSupplierParty: ublPartyWrapper{Party: ublParty{
+			EndpointID: ublIdentifier{SchemeID: ublSchemeLatvianRegister, Value: provider.RegistrationNo},
+			PartyName:  &ublPartyName{Name: provider.DisplayName},
+			PostalAddress: ublAddress{
+				StreetName: street,
+				CityName:   city,
+				PostalZone: postal,
+				Country:    ublCountry{IdentificationCode: "LV"},
+			},
+			PartyLegalEntity: ublPartyLegalEntity{
+				RegistrationName: provider.LegalName,
+				CompanyID:        &ublIdentifier{SchemeID: ublSchemeLatvianRegister, Value: provider.RegistrationNo},
+			},
+			Contact: &ublContact{Name: provider.ContactPerson, Telephone: provider.Phone},
+		}},
+		AccountingCustomerParty: ublPartyWrapper{Party: ublParty{
+			EndpointID:       ublIdentifier{SchemeID: ublSchemeEmail, Value: strings.TrimSpace(buyer.RecipientEmail)},
+			PostalAddress:    ublAddress{Country: ublCountry{IdentificationCode: "LV"}},
+			PartyLegalEntity: ublPartyLegalEntity{RegistrationName: buyer.RecipientName},
+			Contact: &ublContact{
+				Telephone: strings.TrimSpace(buyer.RecipientPhone),
+				Email:     strings.TrimSpace(buyer.RecipientEmail),
+			},
+		}},
+		PaymentMeans: ublPaymentMeans{
+			PaymentMeansCode: ublPaymentMeansSEPA,
+			PaymentID:        reference.ForInvoice(iv.ID),
+			PayeeFinancialAccount: &ublFinancialAcct{
+				ID:   normalizeIBAN(provider.IBAN),
+				Name: provider.Beneficiary,
+			},
+		},
+		TaxTotal: ublTaxTotal{
+			TaxAmount:   amount(vatTotal),
+			TaxSubtotal: subtotals,
+		},
+		LegalMonetaryTotal: ublMonetaryTotal{
+			LineExtensionAmount: amount(lineTotal),
+			TaxExclusiveAmount:  amount(lineTotal),
+			TaxInclusiveAmount:  amount(grossTotal),
+			PrepaidAmount:       prepaid,
+			PayableAmount:       amount(grossTotal - prepaidCents),
+		},
+		InvoiceLines: ublLines,
+	}
+	if bic := strings.ToUpper(strings.TrimSpace(provider.Swift)); bic != "" {
+		doc.PaymentMeans.PayeeFinancialAccount.FinancialInstitutionBranch = &ublBranchID{ID: bic}
+	}
+	if vatPayer {
+		doc.AccountingSupplierParty.Party.PartyTaxScheme = ublVATScheme(provider.VATNumber)
+	}
+	if buyer.IsCompany {
+		party := &doc.AccountingCustomerParty.Party
+		street, city, postal := splitPostalAddress(buyer.CompanyAddress)
+		party.PostalAddress.StreetName = street
+		party.PostalAddress.CityName = city
+		party.PostalAddress.PostalZone = postal
+		party.PartyTaxScheme = ublVATScheme(buyer.CompanyVATNumber)
+		if regNo := strings.TrimSpace(buyer.CompanyRegNo); regNo != "" {
+			party.PartyLegalEntity.CompanyID = &ublIdentifier{Value: regNo}
+		}
+	}
+	return doc
+}
+
+// ublVATCategory maps a line's VAT rate to its EN 16931 category. The
+// exemption code is only used in the VAT breakdown, never on lines.
+func ublVATCategory(vatPayer bool, ratePct float64, exemptNote string) ublTaxCategory {
+	category := ublTaxCategory{TaxScheme: ublTaxScheme{ID: "VAT"}}
+	switch {
+	case !vatPayer:
+		category.ID = ublTaxCategoryNotSubject
+		category.TaxExemptionReasonCode = ublTaxExemptionCode
+		category.TaxExemptionReason = ublTaxExemptionReason
+	case ratePct > 0:
+		category.ID = ublTaxCategoryStandard
+		category.Percent = strconv.FormatFloat(ratePct, 'f', -1, 64)
+	default:
+		category.ID = ublTaxCategoryExempt
+		category.Percent = "0"
+		category.TaxExemptionReason = exemptNote
+	}
+	return category
+}
+
+func ublVATScheme(vatNumber string) *ublPartyTaxScheme {
+	vatNumber = strings.ToUpper(strings.Join(strings.Fields(vatNumber), ""))
+	if vatNumber == "" {
+		return nil
+	}
+	return &ublPartyTaxScheme{CompanyID: vatNumber, TaxScheme: ublTaxScheme{ID: "VAT"}}
+}
+
+// splitPostalAddress splits "Street 1, City, LV-1000" into its parts. Anything
+// that does not follow that shape is kept as the street line.
+func splitPostalAddress(address string) (street, city, postal string) {
+	parts := strings.Split(address, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	if len(parts) < 3 {
+		return strings.TrimSpace(address), "", ""
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-2], ", "), parts[n-2], parts[n-1]
+}
+
+func ublMoney(cents int64) string {
+	return fmt.Sprintf("%.2f", money.CentsToEuros(cents))
+}
@@ -0,0 +1,239 @@
+package pdf
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"langschool/ent"
+	entcourse "langschool/ent/course"
+	entenrollment "langschool/ent/enrollment"
+	"langschool/ent/enttest"
+	entinvoice "langschool/ent/invoice"
+	entinvoiceline "langschool/ent/invoiceline"
+	entpayment "langschool/ent/payment"
+	entstudent "langschool/ent/student"
+	"langschool/internal/app"
+	"langschool/internal/app/reference"
+)
+
+func TestGenerateInvoiceUBLProducesPeppolInvoice(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	iv := createUBLTestInvoice(t, ctx, client, "payer@example.com")
+	if _, err := client.Payment.Create().
+		SetStudentID(iv.StudentID).
+		SetInvoiceID(iv.ID).
+		SetAmountCents(1000).
+		SetMethod(entpayment.MethodCash).
+		SetPaidAt(time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)).
+		Save(ctx); err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+
+	data, name, err := GenerateInvoiceUBL(ctx, client, iv.ID, Options{})
+	if err != nil {
+		t.Fatalf("GenerateInvoiceUBL() error = %v", err)
+	}
+	if name != "LS-202603-0001 - Anna Ozola.xml" {
+		t.Fatalf("file name = %q", name)
+	}
+
+	doc := string(data)
+	for _, want := range []string{
+		`<cbc:CustomizationID>` + PeppolCustomizationID + `</cbc:CustomizationID>`,
+		`<cbc:ID>LS-202603-0001</cbc:ID>`,
+		`<cbc:IssueDate>2026-04-01</cbc:IssueDate>`,
+		`<cbc:DueDate>2026-04-15</cbc:DueDate>`,
+		`<cbc:EndpointID schemeID="0218">40008216321</cbc:EndpointID>`,
+		`<cbc:EndpointID schemeID="EM">payer@example.com</cbc:EndpointID>`,
+		`<cbc:PaymentID>` + reference.ForInvoice(iv.ID) + `</cbc:PaymentID>`,
+		`<cbc:ID>LV92UNLA0050021521167</cbc:ID>`,
+		`<cbc:InvoicedQuantity unitCode="C62">4</cbc:InvoicedQuantity>`,
+		`<cbc:LineExtensionAmount currencyID="EUR">45.00</cbc:LineExtensionAmount>`,
+		`<cbc:PrepaidAmount currencyID="EUR">10.00</cbc:PrepaidAmount>`,
+		`<cbc:PayableAmount currencyID="EUR">35.00</cbc:PayableAmount>`,
+		`<cbc:TaxExemptionReasonCode>VATEX-EU-O</cbc:TaxExemptionReasonCode>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("document does not contain %s", want)
+		}
+	}
+}
+
+func TestGenerateInvoiceUBLRequiresBuyerEndpoint(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	iv := createUBLTestInvoice(t, ctx, client, "")
+	_, _, err := GenerateInvoiceUBL(ctx, client, iv.ID, Options{})
+	if !errors.Is(err, ErrUBLBuyerEndpoint) {
+		t.Fatalf("error = %v, want ErrUBLBuyerEndpoint", err)
+	}
+}
+
+func TestGenerateInvoiceUBLForVATPayerAndCompanyBuyer(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	iv := createVATUBLTestInvoice(t, ctx, client)
+	data, _, err := GenerateInvoiceUBL(ctx, client, iv.ID, Options{})
+	if err != nil {
+		t.Fatalf("GenerateInvoiceUBL() error = %v", err)
+	}
+	doc := string(data)
+	for _, want := range []string{
+		`<cbc:CompanyID>LV40008216321</cbc:CompanyID>`,
+		`<cbc:CompanyID>LV40003000000</cbc:CompanyID>`,
+		`<cbc:EndpointID schemeID="EM">invoices@piemers.lv</cbc:EndpointID>`,
+		`<cbc:RegistrationName>SIA Piemērs</cbc:RegistrationName>`,
+		`<cbc:StreetName>Brīvības iela 1</cbc:StreetName>`,
+		`<cbc:Percent>21</cbc:Percent>`,
+		`<cbc:TaxAmount currencyID="EUR">8.40</cbc:TaxAmount>`,
+		`<cbc:TaxExemptionReason>PVN likuma 52. panta pirmās daļas 12. punkts</cbc:TaxExemptionReason>`,
+		`<cbc:TaxInclusiveAmount currencyID="EUR">53.40</cbc:TaxInclusiveAmount>`,
+		`<cbc:PayableAmount currencyID="EUR">53.40</cbc:PayableAmount>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("document does not contain %s", want)
+		}
+	}
+
+}
+
+func TestGenerateInvoiceUBLUsesBeneficiaryOnlyForPayeeAccount(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetBankBeneficiaryName("ArtLab kase").
+		Save(ctx); err != nil {
+		t.Fatalf("create settings: %v", err)
+	}
+	iv := createUBLTestInvoice(t, ctx, client, "payer@example.com")
+	data, _, err := GenerateInvoiceUBL(ctx, client, iv.ID, Options{})
+	if err != nil {
+		t.Fatalf("GenerateInvoiceUBL() error = %v", err)
+	}
+	doc := string(data)
+	for _, want := range []string{
+		`<cbc:RegistrationName>` + artlabProviderDefaults().LegalName + `</cbc:RegistrationName>`,
+		`<cbc:Name>ArtLab kase</cbc:Name>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("document does not contain %s", want)
+		}
+	}
+}
+
+// createVATUBLTestInvoice creates the invoice of createUBLTestInvoice for a
+// company payer, issued by a VAT payer: tuition at 21 %, materials exempt.
+func createVATUBLTestInvoice(t *testing.T, ctx context.Context, client *ent.Client) *ent.Invoice {
+	t.Helper()
+	if _, err := client.Settings.Create().
+		SetSingletonID(app.SettingsSingletonID).
+		SetVatEnabled(true).
+		SetVatNumber("LV40008216321").
+		Save(ctx); err != nil {
+		t.Fatalf("create settings: %v", err)
+	}
+	iv := createUBLTestInvoice(t, ctx, client, "employee@example.com")
+	if _, err := client.Student.UpdateOneID(iv.StudentID).
+		SetPayerType(entstudent.PayerTypeCompany).
+		SetPayerCompanyName("SIA Piemērs").
+		SetPayerRegNo("40003000000").
+		SetPayerVatNumber("LV40003000000").
+		SetPayerLegalAddress("Brīvības iela 1, Rīga, LV-1010").
+		SetPayerBillingEmail("invoices@piemers.lv").
+		Save(ctx); err != nil {
+		t.Fatalf("update student: %v", err)
+	}
+	// 40.00 + 8.40 VAT + 5.00 = 53.40.
+	if _, err := client.InvoiceLine.Update().
+		Where(entinvoiceline.InvoiceIDEQ(iv.ID), entinvoiceline.UnitPriceCentsEQ(1000)).
+		SetVatRatePct(21).
+		Save(ctx); err != nil {
+		t.Fatalf("update line: %v", err)
+	}
+	if _, err := client.InvoiceLine.Update().
+		Where(entinvoiceline.InvoiceIDEQ(iv.ID), entinvoiceline.UnitPriceCentsEQ(500)).
+		SetVatExemptNote("PVN likuma 52. panta pirmās daļas 12. punkts").
+		Save(ctx); err != nil {
+		t.Fatalf("update line: %v", err)
+	}
+	if _, err := client.Invoice.UpdateOneID(iv.ID).SetTotalAmountCents(5340).SetVatAmountCents(840).Save(ctx); err != nil {
+		t.Fatalf("update invoice: %v", err)
+	}
+
+	return iv
+}
+
+// createUBLTestInvoice creates an issued invoice with a per-lesson line
+// (4 × 10.00) and a materials line (5.00).
+func createUBLTestInvoice(t *testing.T, ctx context.Context, client *ent.Client, email string) *ent.Invoice {
+	t.Helper()
+	st, err := client.Student.Create().SetFullName("Anna Ozola").SetEmail(email).Save(ctx)
+	if err != nil {
+		t.Fatalf("create student: %v", err)
+	}
+	course, err := client.Course.Create().
+		SetName("English B1").
+		SetType(entcourse.TypeGroup).
+		SetLessonPriceCents(1000).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create course: %v", err)
+	}
+	en, err := client.Enrollment.Create().
+		SetStudentID(st.ID).
+		SetCourseID(course.ID).
+		SetBillingMode(entenrollment.BillingModePerLesson).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create enrollment: %v", err)
+	}
+	generatedAt := time.Date(2026, 4, 1, 10, 0, 0, 0, time.Local)
+	iv, err := client.Invoice.Create().
+		SetStudentID(st.ID).
+		SetPeriodYear(2026).
+		SetPeriodMonth(3).
+		SetTotalAmountCents(4500).
+		SetStatus(entinvoice.StatusIssued).
+		SetNumber("LS-202603-0001").
+		SetPdfGeneratedAt(generatedAt).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create invoice: %v", err)
+	}
+	for _, ln := range []struct {
+		description string
+		qty         float64
+		price       int64
+	}{
+		{"English B1 — marts", 4, 1000},
+		{"Mācību materiāli", 1, 500},
+	} {
+		if _, err := client.InvoiceLine.Create().
+			SetInvoiceID(iv.ID).
+			SetEnrollmentID(en.ID).
+			SetDescription(ln.description).
+			SetQty(ln.qty).
+			SetUnitPriceCents(ln.price).
+			SetAmountCents(int64(ln.qty) * ln.price).
+			Save(ctx); err != nil {
+			t.Fatalf("create invoice line: %v", err)
+		}
+	}
+	return iv
+}
@@ -0,0 +1,460 @@
+// internal/pdf/qrcode.go
+
+package pdf
+
+import (
+	"fmt"
+	"math"
+)
+
+// qrCode is a QR Code symbol (ISO/IEC 18004) encoded in byte mode with error
+// correction level M, which is what the EPC069-12 guideline mandates for
+// payment codes. Modules are addressed as modules[y][x]; true means dark.
+type qrCode struct {
+	version int
+	size    int
+	mask    int
+	modules [][]bool
+}
+
+const (
+	qrMinVersion = 1
+	qrMaxVersion = 40
+)
+
+// Error correction level M: codewords per block and number of blocks, indexed
+// by version (index 0 is unused).
+var qrECCCodewordsPerBlockM = [qrMaxVersion + 1]int{
+	-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+	26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+}
+
+var qrNumECCBlocksM = [qrMaxVersion + 1]int{
+	-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+	17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49,
+}
+
+// encodeQRBytes encodes data into the smallest QR Code version that fits.
+func encodeQRBytes(data []byte) (*qrCode, error) {
+	version := 0
+	for v := qrMinVersion; v <= qrMaxVersion; v++ {
+		if qrByteSegmentBits(v, len(data)) <= qrNumDataCodewords(v)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("qr: data too long (%d bytes)", len(data))
+	}
+
+	capacityBits := qrNumDataCodewords(version) * 8
+	bits := make([]bool, 0, capacityBits)
+	appendBits := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>i)&1 != 0)
+		}
+	}
+	appendBits(0x4, 4)
+	appendBits(len(data), qrByteCountBits(version))
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+	appendBits(0, min(4, capacityBits-len(bits)))
+	appendBits(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacityBits; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i>>3] |= 1 << (7 - uint(i&7))
+		}
+	}
+
+	qr := newQRCode(version)
+	isFunction := qr.drawFunctionPatterns()
+	qr.drawCodewords(qrAddECCAndInterleave(version, codewords), isFunction)
+
+	bestMask, bestPenalty := 0, math.MaxInt
+	for mask := 0; mask < 8; mask++ {
+		qr.applyMask(mask, isFunction)
+		qr.drawFormatBits(mask)
+		if penalty := qr.penaltyScore(); penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		qr.applyMask(mask, isFunction)
+	}
+	qr.mask = bestMask
+	qr.applyMask(bestMask, isFunction)
+	qr.drawFormatBits(bestMask)
+	return qr, nil
+}
+
+func newQRCode(version int) *qrCode {
+	size := version*4 + 17
+	modules := make([][]bool, size)
+	for y := range modules {
+		modules[y] = make([]bool, size)
+	}
+	return &qrCode{version: version, size: size, modules: modules}
+}
+
+func qrByteCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+func qrByteSegmentBits(version, n int) int {
+	return 4 + qrByteCountBits(version) + n*8
+}
+
+// qrNumRawDataModules returns the number of modules available for data and
+// error correction codewords once all function patterns are placed.
+func qrNumRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func qrNumDataCodewords(version int) int {
+	return qrNumRawDataModules(version)/8 - qrECCCodewordsPerBlockM[version]*qrNumECCBlocksM[version]
+}
+
+func qrAlignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, pos := numAlign-1, version*4+17-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+func (qr *qrCode) drawFunctionPatterns() [][]bool {
+	isFunction := make([][]bool, qr.size)
+	for y := range isFunction {
+		isFunction[y] = make([]bool, qr.size)
+	}
+	set := func(x, y int, dark bool) {
+		qr.modules[y][x] = dark
+		isFunction[y][x] = true
+	}
+
+	for i := 0; i < qr.size; i++ {
+		set(6, i, i%2 == 0)
+		set(i, 6, i%2 == 0)
+	}
+
+	for _, center := range [][2]int{{3, 3}, {qr.size - 4, 3}, {3, qr.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := center[0]+dx, center[1]+dy
+				if x < 0 || x >= qr.size || y < 0 || y >= qr.size {
+					continue
+				}
+				dist := max(absInt(dx), absInt(dy))
+				set(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	positions := qrAlignmentPatternPositions(qr.version)
+	last := len(positions) - 1
+	for i := range positions {
+		for j := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					set(positions[i]+dx, positions[j]+dy, max(absInt(dx), absInt(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve the format areas; the real bits are written once the mask is chosen.
+	for i := 0; i <= 8; i++ {
+		isFunction[8][i] = true
+		isFunction[i][8] = true
+	}
+	for i := 0; i < 8; i++ {
+		isFunction[8][qr.size-1-i] = true
+		isFunction[qr.size-1-i][8] = true
+	}
+	set(8, qr.size-8, true)
+
+	if qr.version >= 7 {
+		rem := qr.version
+		for i := 0; i < 12; i++ {
+			rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+		}
+		bits := qr.version<<12 | rem
+		for i := 0; i < 18; i++ {
+			dark := (bits>>i)&1 != 0
+			a, b := qr.size-11+i%3, i/3
+			set(a, b, dark)
+			set(b, a, dark)
+		}
+	}
+	return isFunction
+}
+
+// drawFormatBits writes both copies of the 15-bit format information for
+// error correction level M and the given mask.
+func (qr *qrCode) drawFormatBits(mask int) {
+	const eccLevelMFormatBits = 0
+	data := eccLevelMFormatBits<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return (bits>>i)&1 != 0 }
+
+	for i := 0; i <= 5; i++ {
+		qr.modules[i][8] = bit(i)
+	}
+	qr.modules[7][8] = bit(6)
+	qr.modules[8][8] = bit(7)
+	qr.modules[8][7] = bit(8)
+	for i := 9; i < 15; i++ {
+		qr.modules[8][14-i] = bit(i)
+	}
+
+	for i := 0; i < 8; i++ {
+		qr.modules[8][qr.size-1-i] = bit(i)
+	}
+	for i := 8; i < 15; i++ {
+		qr.modules[qr.size-15+i][8] = bit(i)
+	}
+	qr.modules[qr.size-8][8] = true
+}
+
+func (qr *qrCode) drawCodewords(data []byte, isFunction [][]bool) {
+	i := 0
+	for right := qr.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < qr.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = qr.size - 1 - vert
+				}
+				if isFunction[y][x] || i >= len(data)*8 {
+					continue
+				}
+				qr.modules[y][x] = (data[i>>3]>>(7-uint(i&7)))&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+func qrMaskApplies(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask XORs the data modules with the mask pattern; applying the same
+// mask twice restores the original modules.
+func (qr *qrCode) applyMask(mask int, isFunction [][]bool) {
+	for y := 0; y < qr.size; y++ {
+		for x := 0; x < qr.size; x++ {
+			if !isFunction[y][x] && qrMaskApplies(mask, x, y) {
+				qr.modules[y][x] = !qr.modules[y][x]
+			}
+		}
+	}
+}
+
+// penaltyScore implements the four mask evaluation rules of ISO/IEC 18004.
+func (qr *qrCode) penaltyScore() int {
+	score := 0
+	at := func(x, y int, transposed bool) bool {
+		if transposed {
+			return qr.modules[x][y]
+		}
+		return qr.modules[y][x]
+	}
+
+	for _, transposed := range []bool{false, true} {
+		for y := 0; y < qr.size; y++ {
+			run := 1
+			for x := 1; x < qr.size; x++ {
+				if at(x, y, transposed) == at(x-1, y, transposed) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+			if run >= 5 {
+				score += run - 2
+			}
+
+			for x := 0; x+10 < qr.size; x++ {
+				if qrFinderLike(func(i int) bool { return at(x+i, y, transposed) }) {
+					score += 40
+				}
+			}
+		}
+	}
+
+	dark := 0
+	for y := 0; y < qr.size; y++ {
+		for x := 0; x < qr.size; x++ {
+			if qr.modules[y][x] {
+				dark++
+			}
+			if x+1 < qr.size && y+1 < qr.size {
+				c := qr.modules[y][x]
+				if c == qr.modules[y][x+1] && c == qr.modules[y+1][x] && c == qr.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+	total := qr.size * qr.size
+	k := (absInt(dark*20-total*10)+total-1)/total - 1
+	score += k * 10
+	return score
+}
+
+// qrFinderLike reports whether the 11 modules starting at the probe form a
+// 1:1:3:1:1 finder-like pattern with four light modules on either side.
+func qrFinderLike(module func(i int) bool) bool {
+	patterns := [2][11]bool{
+		{true, false, true, true, true, false, true, false, false, false, false},
+		{false, false, false, false, true, false, true, true, true, false, true},
+	}
+	for _, pattern := range patterns {
+		matched := true
+		for i, want := range pattern {
+			if module(i) != want {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// qrAddECCAndInterleave splits the data codewords into blocks, appends
+// Reed-Solomon error correction to each block and interleaves the result.
+func qrAddECCAndInterleave(version int, data []byte) []byte {
+	numBlocks := qrNumECCBlocksM[version]
+	blockECCLen := qrECCCodewordsPerBlockM[version]
+	rawCodewords := qrNumRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := qrReedSolomonDivisor(blockECCLen)
+	blocks := make([][]byte, 0, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		dataLen := shortBlockLen - blockECCLen
+		if i >= numShortBlocks {
+			dataLen++
+		}
+		block := append([]byte(nil), data[k:k+dataLen]...)
+		k += dataLen
+		ecc := qrReedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0)
+		}
+		blocks = append(blocks, append(block, ecc...))
+	}
+
+	out := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLen-blockECCLen || j >= numShortBlocks {
+				out = append(out, block[i])
+			}
+		}
+	}
+	return out
+}
+
+func qrReedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = qrGFMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = qrGFMultiply(root, 0x02)
+	}
+	return result
+}
+
+func qrReedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= qrGFMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+// qrGFMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func qrGFMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -14,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"langschool/ent"
+	"langschool/internal/app/dataexport"
 	"langschool/internal/paths"
 
 	_ "github.com/ncruces/go-sqlite3/driver"
@@ -303,6 +305,68 @@ func FullBackupNow(dbPath, invoicesDir, backupsDir string) (string, error) {
 	return finalPath, nil
 }
 
+// DataExportNow writes the open-format data export of the database to
+// exportsDir. It reads a snapshot of the database, so the live file is only
+// read once and never migrated.
+func DataExportNow(dbPath, exportsDir string) (string, error) {
+	if dbPath == "" {
+		return "", fmt.Errorf("db path is empty")
+	}
+	if exportsDir == "" {
+		return "", fmt.Errorf("exports dir is empty")
+	}
+	if err := os.MkdirAll(exportsDir, 0o755); err != nil {
+		return "", err
+	}
+
+	exportedAt := time.Now()
+	stageDir, err := os.MkdirTemp(exportsDir, "export-stage-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(stageDir)
+	snapshotPath := filepath.Join(stageDir, "app.sqlite")
+	if err := snapshotSQLite(dbPath, snapshotPath); err != nil {
+		return "", err
+	}
+	client, err := ent.Open("sqlite3", buildBackupDSN(snapshotPath))
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	export, err := dataexport.Build(ctx, client, exportedAt)
+	if err != nil {
+		return "", err
+	}
+
+	tmpArchive, err := os.CreateTemp(exportsDir, "export-*.zip")
+	if err != nil {
+		return "", err
+	}
+	tmpArchivePath := tmpArchive.Name()
+	if err := dataexport.WriteZIP(tmpArchive, export); err != nil {
+		_ = tmpArchive.Close()
+		_ = os.Remove(tmpArchivePath)
+		return "", err
+	}
+	if err := tmpArchive.Close(); err != nil {
+		_ = os.Remove(tmpArchivePath)
+		return "", err
+	}
+	finalPath := filepath.Join(exportsDir, dataexport.FileName(exportedAt))
+	if err := os.Rename(tmpArchivePath, finalPath); err != nil {
+		_ = os.Remove(tmpArchivePath)
+		return "", err
+	}
+	if err := os.Chmod(finalPath, 0o644); err != nil {
+		return "", err
+	}
+	return finalPath, nil
+}
+
 func RestoreFullBackup(archivePath, dbPath, invoicesDir, backupsDir string) (string, error) {
 	if archivePath == "" {
 		return "", fmt.Errorf("archive path is empty")
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"archive/tar"
+	"archive/zip"
 	"compress/gzip"
 	"context"
 	"io"
@@ -45,6 +46,40 @@ func TestFullBackupNowCreatesArchiveWithManifestAndInvoices(t *testing.T) {
 	assertContains(t, entries, "manifest.json")
 }
 
+func TestDataExportNowWritesArchiveFromSnapshot(t *testing.T) {
+	root := t.TempDir()
+	dbPath := filepath.Join(root, "app.sqlite")
+	exportsDir := filepath.Join(root, "exports")
+	mustCreateSQLiteDB(t, dbPath)
+
+	archivePath, err := DataExportNow(dbPath, exportsDir)
+	if err != nil {
+		t.Fatalf("DataExportNow returned error: %v", err)
+	}
+	if !strings.HasPrefix(filepath.Base(archivePath), "langschool-export-") || !strings.HasSuffix(archivePath, ".zip") {
+		t.Fatalf("archive path = %q", archivePath)
+	}
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+	var entries []string
+	for _, f := range zr.File {
+		entries = append(entries, f.Name)
+	}
+	assertContains(t, entries, "students.csv")
+	assertContains(t, entries, "export.json")
+
+	left, err := os.ReadDir(exportsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 {
+		t.Fatalf("exports dir has %d entries, want only the archive", len(left))
+	}
+}
+
 func TestCleanupOldFullBackupsKeepsNewestOnly(t *testing.T) {
 	backupsDir := t.TempDir()
 	for i := 0; i < 9; i++ {
@@ -11,8 +11,12 @@ import (
 	sharedapp "langschool/internal/app"
 	"langschool/internal/app/attendance"
 	"langschool/internal/app/audit"
+	"langschool/internal/app/cashdesk"
+	"langschool/internal/app/idempotency"
 	invsvc "langschool/internal/app/invoice"
 	paysvc "langschool/internal/app/payment"
+	"langschool/internal/app/statement"
+	"langschool/internal/app/taxcert"
 	"langschool/internal/auth"
 	"langschool/internal/infra"
 	"langschool/internal/money"
@@ -24,7 +28,11 @@ const (
 	DefaultSchoolAddress               = "Latgales iela 260, Rīga, Latvija"
 	PreMigrationBackupLimit            = 30
 	DefaultInvoiceEmailSubjectTemplate = "Rēķins {invoice_number} par {month_name} {year}"
-	DefaultInvoiceEmailBodyTemplate    = "Labdien!\n\nPielikumā nosūtām rēķinu {invoice_number} par {month_name} {year} summā {amount} EUR.\n\nJa ir jautājumi, lūdzu, sazinieties ar mums.\n\nAr cieņu,\n{org_name}"
+	DefaultInvoiceEmailBodyTemplate    = "Labdien!\n\nPielikumā nosūtām rēķinu {invoice_number} par {month_name} {year} summā {amount} EUR.\nMaksājot, lūdzu, norādiet maksājuma atsauci {payment_reference}.\n\nJa ir jautājumi, lūdzu, sazinieties ar mums.\n\nAr cieņu,\n{org_name}"
+
+	// legacyInvoiceEmailBodyTemplate is the default body used before payment
+	// references were added; untouched copies are upgraded on startup.
+	legacyInvoiceEmailBodyTemplate = "Labdien!\n\nPielikumā nosūtām rēķinu {invoice_number} par {month_name} {year} summā {amount} EUR.\n\nJa ir jautājumi, lūdzu, sazinieties ar mums.\n\nAr cieņu,\n{org_name}"
 )
 
 type Runtime struct {
@@ -33,11 +41,15 @@ type Runtime struct {
 	DB        *infra.DB
 	AppDBPath string
 
-	Attendance *attendance.Service
-	Audit      *audit.Service
-	Invoice    *invsvc.Service
-	Payment    *paysvc.Service
-	Auth       *auth.Service
+	Attendance  *attendance.Service
+	Audit       *audit.Service
+	CashDesk    *cashdesk.Service
+	Idempotency *idempotency.Service
+	Invoice     *invsvc.Service
+	Payment     *paysvc.Service
+	Statement   *statement.Service
+	TaxCert     *taxcert.Service
+	Auth        *auth.Service
 }
 
 func Start(ctx context.Context, cfg Config) (*Runtime, error) {
@@ -84,15 +96,19 @@ func Start(ctx context.Context, cfg Config) (*Runtime, error) {
 	}
 
 	return &Runtime{
-		Config:     cfg,
-		Dirs:       dirs,
-		DB:         db,
-		AppDBPath:  dbPath,
-		Attendance: attendance.NewWithInvoicesDir(db.Ent, dirs.Invoices),
-		Audit:      audit.New(db.Ent),
-		Invoice:    invsvc.NewWithInvoicesDir(db.Ent, dirs.Invoices),
-		Payment:    paysvc.New(db.Ent),
-		Auth:       authService,
+		Config:      cfg,
+		Dirs:        dirs,
+		DB:          db,
+		AppDBPath:   dbPath,
+		Attendance:  attendance.NewWithInvoicesDir(db.Ent, dirs.Invoices),
+		Audit:       audit.New(db.Ent),
+		CashDesk:    cashdesk.New(db.Ent),
+		Idempotency: idempotency.New(db.Ent),
+		Invoice:     invsvc.NewWithInvoicesDir(db.Ent, dirs.Invoices),
+		Payment:     paysvc.New(db.Ent),
+		Statement:   statement.New(db.Ent),
+		TaxCert:     taxcert.New(db.Ent),
+		Auth:        authService,
 	}, nil
 }
 
@@ -154,7 +170,7 @@ func ensureSettings(ctx context.Context, client *ent.Client) error {
 	if strings.TrimSpace(st.InvoiceEmailSubjectTemplate) == "" {
 		upd.SetInvoiceEmailSubjectTemplate(DefaultInvoiceEmailSubjectTemplate)
 	}
-	if strings.TrimSpace(st.InvoiceEmailBodyTemplate) == "" {
+	if body := strings.TrimSpace(st.InvoiceEmailBodyTemplate); body == "" || body == legacyInvoiceEmailBodyTemplate {
 		upd.SetInvoiceEmailBodyTemplate(DefaultInvoiceEmailBodyTemplate)
 	}
 
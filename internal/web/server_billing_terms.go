@@ -0,0 +1,76 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleBillingTermsList(w http.ResponseWriter, r *http.Request) {
+	courseID, err := parseOptionalInt(r.URL.Query().Get("courseId"))
+	if err != nil {
+		writeBadRequest(w, "invalid courseId")
+		return
+	}
+	items, err := s.svc.BillingTermList(r.Context(), courseID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleBillingTermsCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.BillingTermInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.BillingTermCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleBillingTermsGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.BillingTermGet(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleBillingTermsUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req backend.BillingTermInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.BillingTermUpdate(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleBillingTermsDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := s.svc.BillingTermDelete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,150 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type cashSessionOpenRequest struct {
+	OpeningFloat float64 `json:"openingFloat"`
+	Note         string  `json:"note"`
+}
+
+type cashMovementRequest struct {
+	Kind   string  `json:"kind"`
+	Amount float64 `json:"amount"`
+	Note   string  `json:"note"`
+}
+
+type cashSessionCloseRequest struct {
+	Counted float64 `json:"counted"`
+	Note    string  `json:"note"`
+}
+
+func (s *Server) handleCashSessionsList(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseQueryIntDefault(r.URL.Query().Get("limit"), 50)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	items, err := s.svc.CashSessionList(r.Context(), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleCashSessionsCurrent(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.CashSessionCurrent(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCashSessionsOpen(w http.ResponseWriter, r *http.Request) {
+	var req cashSessionOpenRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CashSessionOpen(r.Context(), req.OpeningFloat, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleCashSessionsGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.CashSessionReport(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCashSessionsAddMovement(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req cashMovementRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CashSessionAddMovement(r.Context(), id, req.Kind, req.Amount, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleCashSessionsClose(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req cashSessionCloseRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CashSessionClose(r.Context(), id, req.Counted, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCashSessionsReopen(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.CashSessionReopen(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCashSessionsReportPDF(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.CashSessionReportPDF(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) handleCashSessionsReportCSV(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.CashSessionReportCSV(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -50,7 +50,18 @@ func (s *Server) routes() {
 	s.registerSettingsRoutes()
 	s.registerUserRoutes()
 	s.registerPaymentRoutes()
+	s.registerCashDeskRoutes()
+	s.registerPaymentPlanRoutes()
+	s.registerLateFeeRoutes()
+	s.registerStudentChargeRoutes()
+	s.registerLessonPackageRoutes()
+	s.registerBillingTermRoutes()
+	s.registerExcusedAbsenceRoutes()
+	s.registerWaitlistRoutes()
+	s.registerLeadRoutes()
+	s.registerScheduleRoutes()
 	s.registerDashboardRoutes()
+	s.registerReportRoutes()
 }
 
 func (s *Server) registerAuthRoutes() {
@@ -64,18 +75,26 @@ func (s *Server) registerMetaRoutes() {
 	s.mux.HandleFunc("GET /api/meta", s.handleMeta)
 	s.mux.HandleFunc("GET /api/audit-logs", s.handleAuditLogsList)
 	s.mux.HandleFunc("POST /api/backups", s.handleBackupsCreate)
+	s.mux.HandleFunc("GET /api/exports/full", s.handleDataExport)
 }
 
 func (s *Server) registerStudentRoutes() {
 	s.mux.HandleFunc("GET /api/students", s.handleStudentsList)
 	s.mux.HandleFunc("POST /api/students", s.handleStudentsCreate)
 	s.mux.HandleFunc("POST /api/students/onboard", s.handleStudentsOnboard)
+	s.mux.HandleFunc("POST /api/students/import/preview", s.handleStudentsImportPreview)
+	s.mux.HandleFunc("POST /api/students/import", s.handleStudentsImport)
 	s.mux.HandleFunc("POST /api/students/duplicate-check", s.handleStudentsDuplicateCheck)
 	s.mux.HandleFunc("GET /api/students/{id}", s.handleStudentsGet)
 	s.mux.HandleFunc("PUT /api/students/{id}", s.handleStudentsUpdate)
 	s.mux.HandleFunc("DELETE /api/students/{id}", s.handleStudentsDelete)
 	s.mux.HandleFunc("POST /api/students/{id}/active", s.handleStudentsActive)
+	s.mux.HandleFunc("PUT /api/students/{id}/payer", s.handleStudentsSetPayer)
 	s.mux.HandleFunc("GET /api/students/{id}/debt-details", s.handleStudentDebtDetails)
+	s.mux.HandleFunc("GET /api/students/{id}/statement", s.handleStudentStatement)
+	s.mux.HandleFunc("GET /api/students/{id}/statement/pdf", s.handleStudentStatementPDF)
+	s.mux.HandleFunc("POST /api/students/{id}/statement/email-preview", s.handleStudentStatementEmailPreview)
+	s.mux.HandleFunc("POST /api/students/{id}/statement/send-email", s.handleStudentStatementSendEmail)
 	s.mux.HandleFunc("GET /api/teachers", s.handleTeachersList)
 	s.mux.HandleFunc("POST /api/teachers", s.handleTeachersCreate)
 }
@@ -86,6 +105,12 @@ func (s *Server) registerCourseRoutes() {
 	s.mux.HandleFunc("GET /api/courses/{id}", s.handleCoursesGet)
 	s.mux.HandleFunc("PUT /api/courses/{id}", s.handleCoursesUpdate)
 	s.mux.HandleFunc("DELETE /api/courses/{id}", s.handleCoursesDelete)
+	s.mux.HandleFunc("PUT /api/courses/{id}/vat", s.handleCoursesSetVAT)
+	s.mux.HandleFunc("PUT /api/courses/{id}/billing-period", s.handleCoursesSetBillingPeriod)
+	s.mux.HandleFunc("PUT /api/courses/{id}/capacity", s.handleCoursesSetCapacity)
+	s.mux.HandleFunc("GET /api/courses/{id}/prices", s.handleCoursePricesList)
+	s.mux.HandleFunc("POST /api/courses/{id}/prices", s.handleCoursePricesSchedule)
+	s.mux.HandleFunc("DELETE /api/courses/{id}/prices/{priceId}", s.handleCoursePricesDelete)
 }
 
 func (s *Server) registerEnrollmentRoutes() {
@@ -93,6 +118,10 @@ func (s *Server) registerEnrollmentRoutes() {
 	s.mux.HandleFunc("POST /api/enrollments", s.handleEnrollmentsCreate)
 	s.mux.HandleFunc("POST /api/enrollments/bulk", s.handleEnrollmentsBulkCreate)
 	s.mux.HandleFunc("PUT /api/enrollments/{id}", s.handleEnrollmentsUpdate)
+	s.mux.HandleFunc("PUT /api/enrollments/{id}/dates", s.handleEnrollmentsSetDates)
+	s.mux.HandleFunc("GET /api/enrollments/{id}/pauses", s.handleEnrollmentPausesList)
+	s.mux.HandleFunc("POST /api/enrollments/{id}/pauses", s.handleEnrollmentPausesCreate)
+	s.mux.HandleFunc("DELETE /api/enrollments/{id}/pauses/{pauseId}", s.handleEnrollmentPausesDelete)
 	s.mux.HandleFunc("DELETE /api/enrollments/{id}", s.handleEnrollmentsDelete)
 }
 
@@ -121,6 +150,7 @@ func (s *Server) registerInvoiceRoutes() {
 	s.mux.HandleFunc("GET /api/invoices/{id}/pdf-status", s.handleInvoicesPDFStatus)
 	s.mux.HandleFunc("POST /api/invoices/{id}/pdf", s.handleInvoicesEnsurePDF)
 	s.mux.HandleFunc("GET /api/invoices/{id}/pdf", s.handleInvoicesDownloadPDF)
+	s.mux.HandleFunc("GET /api/invoices/{id}/ubl", s.handleInvoicesDownloadUBL)
 	s.mux.HandleFunc("POST /api/invoices/{id}/email-preview", s.handleInvoicesEmailPreview)
 	s.mux.HandleFunc("POST /api/invoices/{id}/send-email", s.handleInvoicesSendEmail)
 	s.mux.HandleFunc("GET /api/invoices/{id}/payment-summary", s.handleInvoicePaymentSummary)
@@ -133,6 +163,16 @@ func (s *Server) registerSettingsRoutes() {
 	s.mux.HandleFunc("POST /api/settings/locale", s.handleSettingsSetLocale)
 	s.mux.HandleFunc("GET /api/settings/invoice-email", s.handleSettingsGetInvoiceEmail)
 	s.mux.HandleFunc("POST /api/settings/invoice-email", s.handleSettingsSetInvoiceEmail)
+	s.mux.HandleFunc("GET /api/settings/payment-details", s.handleSettingsGetPaymentDetails)
+	s.mux.HandleFunc("POST /api/settings/payment-details", s.handleSettingsSetPaymentDetails)
+	s.mux.HandleFunc("GET /api/settings/vat", s.handleSettingsGetVAT)
+	s.mux.HandleFunc("POST /api/settings/vat", s.handleSettingsSetVAT)
+	s.mux.HandleFunc("GET /api/settings/late-fees", s.handleSettingsGetLateFees)
+	s.mux.HandleFunc("POST /api/settings/late-fees", s.handleSettingsSetLateFees)
+	s.mux.HandleFunc("GET /api/settings/excused-absences", s.handleSettingsGetExcusedAbsences)
+	s.mux.HandleFunc("POST /api/settings/excused-absences", s.handleSettingsSetExcusedAbsences)
+	s.mux.HandleFunc("GET /api/settings/waitlist", s.handleSettingsGetWaitlist)
+	s.mux.HandleFunc("POST /api/settings/waitlist", s.handleSettingsSetWaitlist)
 }
 
 func (s *Server) registerUserRoutes() {
@@ -148,9 +188,108 @@ func (s *Server) registerPaymentRoutes() {
 	s.mux.HandleFunc("POST /api/payments", s.handlePaymentsCreate)
 	s.mux.HandleFunc("DELETE /api/payments/{id}", s.handlePaymentsDelete)
 	s.mux.HandleFunc("POST /api/payments/quick-cash", s.handlePaymentsQuickCash)
+	s.mux.HandleFunc("POST /api/payments/{id}/refund", s.handlePaymentsRefund)
+	s.mux.HandleFunc("POST /api/payments/{id}/reallocate", s.handlePaymentsReallocate)
+	s.mux.HandleFunc("POST /api/payments/credit-transfers", s.handlePaymentsCreditTransfer)
+	s.mux.HandleFunc("POST /api/payments/bank/match", s.handlePaymentsMatchBank)
+	s.mux.HandleFunc("POST /api/payments/bank", s.handlePaymentsRecordBank)
 	s.mux.HandleFunc("GET /api/payments/student/{studentId}", s.handlePaymentsListForStudent)
 	s.mux.HandleFunc("GET /api/payments/student/{studentId}/balance", s.handleStudentBalance)
+	s.mux.HandleFunc("GET /api/payments/student/{studentId}/credit-ledger", s.handleStudentCreditLedger)
 	s.mux.HandleFunc("GET /api/debtors", s.handleDebtorsList)
+	s.mux.HandleFunc("GET /api/cash-receipts", s.handleCashReceiptsList)
+	s.mux.HandleFunc("GET /api/cash-receipts/{id}", s.handleCashReceiptsGet)
+	s.mux.HandleFunc("GET /api/cash-receipts/{id}/pdf", s.handleCashReceiptsPDF)
+}
+
+func (s *Server) registerCashDeskRoutes() {
+	s.mux.HandleFunc("GET /api/cash-sessions", s.handleCashSessionsList)
+	s.mux.HandleFunc("POST /api/cash-sessions", s.handleCashSessionsOpen)
+	s.mux.HandleFunc("GET /api/cash-sessions/current", s.handleCashSessionsCurrent)
+	s.mux.HandleFunc("GET /api/cash-sessions/{id}", s.handleCashSessionsGet)
+	s.mux.HandleFunc("POST /api/cash-sessions/{id}/movements", s.handleCashSessionsAddMovement)
+	s.mux.HandleFunc("POST /api/cash-sessions/{id}/close", s.handleCashSessionsClose)
+	s.mux.HandleFunc("POST /api/cash-sessions/{id}/reopen", s.handleCashSessionsReopen)
+	s.mux.HandleFunc("GET /api/cash-sessions/{id}/z-report/pdf", s.handleCashSessionsReportPDF)
+	s.mux.HandleFunc("GET /api/cash-sessions/{id}/z-report/csv", s.handleCashSessionsReportCSV)
+}
+
+func (s *Server) registerPaymentPlanRoutes() {
+	s.mux.HandleFunc("GET /api/payment-plans", s.handlePaymentPlansList)
+	s.mux.HandleFunc("POST /api/payment-plans", s.handlePaymentPlansCreate)
+	s.mux.HandleFunc("GET /api/payment-plans/{id}", s.handlePaymentPlansGet)
+	s.mux.HandleFunc("POST /api/payment-plans/{id}/cancel", s.handlePaymentPlansCancel)
+}
+
+func (s *Server) registerLateFeeRoutes() {
+	s.mux.HandleFunc("GET /api/late-fees", s.handleLateFeesList)
+	s.mux.HandleFunc("POST /api/late-fees/{id}/waive", s.handleLateFeesWaive)
+}
+
+func (s *Server) registerStudentChargeRoutes() {
+	s.mux.HandleFunc("GET /api/student-charges", s.handleStudentChargesList)
+	s.mux.HandleFunc("POST /api/student-charges", s.handleStudentChargesCreate)
+	s.mux.HandleFunc("GET /api/student-charges/{id}", s.handleStudentChargesGet)
+	s.mux.HandleFunc("POST /api/student-charges/{id}/cancel", s.handleStudentChargesCancel)
+}
+
+func (s *Server) registerLessonPackageRoutes() {
+	s.mux.HandleFunc("GET /api/lesson-packages", s.handleLessonPackagesList)
+	s.mux.HandleFunc("POST /api/lesson-packages", s.handleLessonPackagesSell)
+	s.mux.HandleFunc("GET /api/lesson-packages/warnings", s.handleLessonPackagesWarnings)
+	s.mux.HandleFunc("GET /api/lesson-packages/{id}", s.handleLessonPackagesGet)
+	s.mux.HandleFunc("POST /api/lesson-packages/{id}/carry-over", s.handleLessonPackagesCarryOver)
+	s.mux.HandleFunc("POST /api/lesson-packages/{id}/refund", s.handleLessonPackagesRefund)
+}
+
+func (s *Server) registerBillingTermRoutes() {
+	s.mux.HandleFunc("GET /api/billing-terms", s.handleBillingTermsList)
+	s.mux.HandleFunc("POST /api/billing-terms", s.handleBillingTermsCreate)
+	s.mux.HandleFunc("GET /api/billing-terms/{id}", s.handleBillingTermsGet)
+	s.mux.HandleFunc("PUT /api/billing-terms/{id}", s.handleBillingTermsUpdate)
+	s.mux.HandleFunc("DELETE /api/billing-terms/{id}", s.handleBillingTermsDelete)
+}
+
+func (s *Server) registerExcusedAbsenceRoutes() {
+	s.mux.HandleFunc("GET /api/excused-absences", s.handleExcusedAbsencesList)
+	s.mux.HandleFunc("POST /api/excused-absences", s.handleExcusedAbsencesCreate)
+	s.mux.HandleFunc("POST /api/excused-absences/{id}/makeup", s.handleExcusedAbsencesMakeup)
+	s.mux.HandleFunc("DELETE /api/excused-absences/{id}", s.handleExcusedAbsencesDelete)
+}
+
+func (s *Server) registerWaitlistRoutes() {
+	s.mux.HandleFunc("GET /api/waitlist", s.handleWaitlistList)
+	s.mux.HandleFunc("POST /api/waitlist", s.handleWaitlistAdd)
+	s.mux.HandleFunc("PUT /api/waitlist/{id}/rank", s.handleWaitlistMove)
+	s.mux.HandleFunc("POST /api/waitlist/{id}/accept", s.handleWaitlistAccept)
+	s.mux.HandleFunc("POST /api/waitlist/{id}/decline", s.handleWaitlistDecline)
+	s.mux.HandleFunc("DELETE /api/waitlist/{id}", s.handleWaitlistRemove)
+	s.mux.HandleFunc("POST /api/courses/{id}/waitlist/promote", s.handleWaitlistPromote)
+}
+
+func (s *Server) registerLeadRoutes() {
+	s.mux.HandleFunc("GET /api/leads", s.handleLeadsList)
+	s.mux.HandleFunc("POST /api/leads", s.handleLeadsCreate)
+	s.mux.HandleFunc("GET /api/leads/{id}", s.handleLeadsGet)
+	s.mux.HandleFunc("PUT /api/leads/{id}", s.handleLeadsUpdate)
+	s.mux.HandleFunc("DELETE /api/leads/{id}", s.handleLeadsDelete)
+	s.mux.HandleFunc("POST /api/leads/{id}/trial", s.handleLeadsScheduleTrial)
+	s.mux.HandleFunc("POST /api/leads/{id}/outcome", s.handleLeadsRecordOutcome)
+	s.mux.HandleFunc("POST /api/leads/{id}/lost", s.handleLeadsMarkLost)
+	s.mux.HandleFunc("POST /api/leads/{id}/convert", s.handleLeadsConvert)
+}
+
+func (s *Server) registerScheduleRoutes() {
+	s.mux.HandleFunc("GET /api/rooms", s.handleRoomsList)
+	s.mux.HandleFunc("POST /api/rooms", s.handleRoomsCreate)
+	s.mux.HandleFunc("PUT /api/rooms/{id}", s.handleRoomsUpdate)
+	s.mux.HandleFunc("DELETE /api/rooms/{id}", s.handleRoomsDelete)
+	s.mux.HandleFunc("GET /api/rooms/{id}/occupancy", s.handleRoomsOccupancy)
+	s.mux.HandleFunc("GET /api/schedule-slots", s.handleScheduleSlotsList)
+	s.mux.HandleFunc("POST /api/schedule-slots", s.handleScheduleSlotsCreate)
+	s.mux.HandleFunc("GET /api/schedule-slots/conflicts", s.handleScheduleSlotsConflicts)
+	s.mux.HandleFunc("PUT /api/schedule-slots/{id}", s.handleScheduleSlotsUpdate)
+	s.mux.HandleFunc("DELETE /api/schedule-slots/{id}", s.handleScheduleSlotsDelete)
 }
 
 func (s *Server) registerDashboardRoutes() {
@@ -158,6 +297,15 @@ func (s *Server) registerDashboardRoutes() {
 	s.mux.HandleFunc("GET /api/dashboard/recent-payments", s.handleDashboardRecentPayments)
 }
 
+func (s *Server) registerReportRoutes() {
+	s.mux.HandleFunc("GET /api/reports/vat-summary", s.handleReportsVATSummary)
+	s.mux.HandleFunc("GET /api/reports/lead-funnel", s.handleReportsLeadFunnel)
+	s.mux.HandleFunc("GET /api/reports/education-expenses", s.handleReportsEducationExpenses)
+	s.mux.HandleFunc("GET /api/reports/education-expenses/{year}/zip", s.handleReportsEducationCertificatesZIP)
+	s.mux.HandleFunc("GET /api/reports/education-expenses/{year}/{key}/pdf", s.handleReportsEducationCertificatePDF)
+	s.mux.HandleFunc("POST /api/reports/education-expenses/{year}/send-email", s.handleReportsEducationCertificatesSendEmail)
+}
+
 func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
 	if r.URL.Path == "/healthz" {
 		s.mux.ServeHTTP(w, r)
@@ -206,6 +354,10 @@ func (s *Server) serveAPI(w http.ResponseWriter, r *http.Request) {
 
 	ctx := withCurrentUser(r.Context(), currentUser)
 	ctx = backend.WithActor(ctx, currentUser)
+	if r.Method == http.MethodPost && strings.TrimSpace(r.Header.Get(idempotencyKeyHeader)) != "" {
+		s.serveIdempotent(w, r.WithContext(ctx), currentUser)
+		return
+	}
 	s.mux.ServeHTTP(w, r.WithContext(ctx))
 }
 
@@ -238,6 +390,8 @@ type enrollmentCreateRequest struct {
 	LessonPriceOverride     float64 `json:"lessonPriceOverride"`
 	SubscriptionLessonPrice float64 `json:"subscriptionLessonPrice"`
 	Note                    string  `json:"note"`
+	StartsOn                string  `json:"startsOn"`
+	EndsOn                  string  `json:"endsOn"`
 }
 
 type enrollmentUpdateRequest struct {
@@ -249,6 +403,58 @@ type enrollmentUpdateRequest struct {
 	Note                    string  `json:"note"`
 }
 
+type enrollmentDatesRequest struct {
+	Version  int    `json:"version"`
+	StartsOn string `json:"startsOn"`
+	EndsOn   string `json:"endsOn"`
+}
+
+type enrollmentPauseRequest struct {
+	StartsOn string `json:"startsOn"`
+	EndsOn   string `json:"endsOn"`
+	Reason   string `json:"reason"`
+}
+
+type excusedAbsenceMakeupRequest struct {
+	CourseID int    `json:"courseId"`
+	Date     string `json:"date"` // YYYY-MM-DD
+}
+
+type waitlistRankRequest struct {
+	Rank int `json:"rank"`
+}
+
+type leadUpdateRequest struct {
+	Version int `json:"version"`
+	backend.LeadInput
+}
+
+type leadOutcomeRequest struct {
+	Outcome string `json:"outcome"` // attended or no_show
+	Note    string `json:"note"`
+}
+
+type leadLostRequest struct {
+	Reason string `json:"reason"`
+}
+
+type leadConvertRequest struct {
+	Student          *studentUpsertRequest     `json:"student"` // defaults to the lead's details
+	Enrollments      []enrollmentCreateRequest `json:"enrollments"`
+	StudentID        int                       `json:"studentId"` // convert into an existing student
+	IgnoreDuplicates bool                      `json:"ignoreDuplicates"`
+}
+
+type roomUpdateRequest struct {
+	Version int `json:"version"`
+	backend.RoomInput
+}
+
+type scheduleSlotUpdateRequest struct {
+	Version int `json:"version"`
+	backend.ScheduleSlotInput
+}
+
 type studentOnboardRequest struct {
 	Student     studentUpsertRequest      `json:"student"`
 	Enrollment  *enrollmentCreateRequest  `json:"enrollment"`
@@ -463,10 +669,22 @@ func requiredCapabilityForAPIPath(method, path string) string {
 	switch {
 	case method == http.MethodPost && path == "/api/backups":
 		return backend.CapabilityBackups
+	case method == http.MethodGet && path == "/api/exports/full":
+		return backend.CapabilityBackups
 	case method == http.MethodPost && path == "/api/settings/locale":
 		return backend.CapabilityManageSettings
 	case (method == http.MethodGet || method == http.MethodPost) && path == "/api/settings/invoice-email":
 		return backend.CapabilityManageSettings
+	case method == http.MethodPost && path == "/api/settings/payment-details":
+		return backend.CapabilityManageSettings
+	case method == http.MethodPost && path == "/api/settings/vat":
+		return backend.CapabilityManageSettings
+	case method == http.MethodPost && path == "/api/settings/late-fees":
+		return backend.CapabilityManageSettings
+	case method == http.MethodPost && path == "/api/settings/excused-absences":
+		return backend.CapabilityManageSettings
+	case method == http.MethodPost && path == "/api/settings/waitlist":
+		return backend.CapabilityManageSettings
 	case method == http.MethodGet && path == "/api/audit-logs":
 		return backend.CapabilityViewAuditLog
 	case path == "/api/users" || strings.HasPrefix(path, "/api/users/"):
@@ -475,8 +693,12 @@ func requiredCapabilityForAPIPath(method, path string) string {
 		return backend.CapabilityDeletePayments
 	case method == http.MethodDelete && strings.HasPrefix(path, "/api/students/"):
 		return backend.CapabilityDeleteStudents
+	case method == http.MethodDelete && strings.HasPrefix(path, "/api/courses/") && strings.Contains(path, "/prices/"):
+		return ""
 	case method == http.MethodDelete && strings.HasPrefix(path, "/api/courses/"):
 		return backend.CapabilityDeleteCourses
+	case method == http.MethodPost && strings.HasPrefix(path, "/api/cash-sessions/") && strings.HasSuffix(path, "/reopen"):
+		return backend.CapabilityReopenCashSessions
 	default:
 		return ""
 	}
@@ -1,6 +1,10 @@
 package web
 
-import "net/http"
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
 
 func (s *Server) handleCoursesList(w http.ResponseWriter, r *http.Request) {
 	items, err := s.svc.CourseList(r.Context(), r.URL.Query().Get("q"))
@@ -70,3 +74,111 @@ func (s *Server) handleCoursesDelete(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (s *Server) handleCoursesSetVAT(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Version       int     `json:"version"`
+		VATRate       float64 `json:"vatRate"`
+		VATExemptNote string  `json:"vatExemptNote"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CourseSetVAT(r.Context(), id, req.Version, req.VATRate, req.VATExemptNote)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCoursesSetBillingPeriod(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Version       int    `json:"version"`
+		BillingPeriod string `json:"billingPeriod"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CourseSetBillingPeriod(r.Context(), id, req.Version, req.BillingPeriod)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCoursesSetCapacity(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Version     int `json:"version"`
+		MaxStudents int `json:"maxStudents"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CourseSetCapacity(r.Context(), id, req.Version, req.MaxStudents)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCoursePricesList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	items, err := s.svc.CoursePriceHistory(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleCoursePricesSchedule(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req backend.CoursePriceInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	items, err := s.svc.CoursePriceSchedule(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleCoursePricesDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	priceID, ok := pathInt(w, r, "priceId")
+	if !ok {
+		return
+	}
+	items, err := s.svc.CoursePriceDelete(r.Context(), id, priceID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
@@ -17,7 +17,26 @@ func (s *Server) handleEnrollmentsList(w http.ResponseWriter, r *http.Request) {
 		writeBadRequest(w, err.Error())
 		return
 	}
-	items, err := s.svc.EnrollmentList(r.Context(), studentID, courseID)
+	year, err := parseOptionalInt(r.URL.Query().Get("year"))
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	month, err := parseOptionalInt(r.URL.Query().Get("month"))
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	if (year == nil) != (month == nil) {
+		writeBadRequest(w, "year and month must be given together")
+		return
+	}
+	var items []backend.EnrollmentDTO
+	if year != nil {
+		items, err = s.svc.EnrollmentListInMonth(r.Context(), studentID, courseID, *year, *month)
+	} else {
+		items, err = s.svc.EnrollmentList(r.Context(), studentID, courseID)
+	}
 	if err != nil {
 		writeError(w, err)
 		return
@@ -30,7 +49,7 @@ func (s *Server) handleEnrollmentsCreate(w http.ResponseWriter, r *http.Request)
 	if !decodeJSON(w, r, &req) {
 		return
 	}
-	item, err := s.svc.EnrollmentCreate(r.Context(), req.StudentID, req.CourseID, req.BillingMode, req.ChargeMaterials, req.LessonPriceOverride, req.SubscriptionLessonPrice, req.Note)
+	item, err := s.svc.EnrollmentCreateWithDates(r.Context(), req.StudentID, toEnrollmentCreateInput(req))
 	if err != nil {
 		writeError(w, err)
 		return
@@ -72,6 +91,23 @@ func (s *Server) handleEnrollmentsUpdate(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusOK, item)
 }
 
+func (s *Server) handleEnrollmentsSetDates(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req enrollmentDatesRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.EnrollmentSetDates(r.Context(), id, req.Version, req.StartsOn, req.EndsOn)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
 func (s *Server) handleEnrollmentsDelete(w http.ResponseWriter, r *http.Request) {
 	id, ok := pathInt(w, r, "id")
 	if !ok {
@@ -88,3 +124,53 @@ func (s *Server) handleEnrollmentsDelete(w http.ResponseWriter, r *http.Request)
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (s *Server) handleEnrollmentPausesList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	items, err := s.svc.EnrollmentPauseList(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleEnrollmentPausesCreate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req enrollmentPauseRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.EnrollmentPauseCreate(r.Context(), id, backend.EnrollmentPauseInput{
+		StartsOn: req.StartsOn,
+		EndsOn:   req.EndsOn,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleEnrollmentPausesDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	pauseID, ok := pathInt(w, r, "pauseId")
+	if !ok {
+		return
+	}
+	if err := s.svc.EnrollmentPauseDelete(r.Context(), id, pauseID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,68 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleExcusedAbsencesList(w http.ResponseWriter, r *http.Request) {
+	studentID, err := parseQueryIntDefault(r.URL.Query().Get("studentId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid studentId")
+		return
+	}
+	courseID, err := parseQueryIntDefault(r.URL.Query().Get("courseId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid courseId")
+		return
+	}
+	items, err := s.svc.ExcusedAbsenceList(r.Context(), studentID, courseID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleExcusedAbsencesCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.ExcusedAbsenceInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.ExcusedAbsenceCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleExcusedAbsencesMakeup(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req excusedAbsenceMakeupRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.ExcusedAbsenceMakeup(r.Context(), id, req.CourseID, req.Date)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleExcusedAbsencesDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := s.svc.ExcusedAbsenceDelete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
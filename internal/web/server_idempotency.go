@@ -0,0 +1,123 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"langschool/internal/app/idempotency"
+	"langschool/internal/auth"
+	"langschool/internal/backend"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// serveIdempotent handles a POST sent with an Idempotency-Key header. The
+// first request runs normally and its JSON response is stored; a retry with
+// the same key and body gets that response back without running again.
+func (s *Server) serveIdempotent(w http.ResponseWriter, r *http.Request, currentUser *auth.UserInfo) {
+	key := strings.TrimSpace(r.Header.Get(idempotencyKeyHeader))
+	if len(key) > idempotency.MaxKeyLength {
+		writeBadRequest(w, "Idempotency-Key is too long")
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	id, stored, err := s.svc.IdempotencyBegin(r.Context(), backend.IdempotentRequest{
+		UserID: currentUser.ID,
+		Key:    key,
+		Method: r.Method,
+		Path:   r.URL.RequestURI(),
+		Body:   body,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if stored != nil {
+		if stored.ContentType != "" {
+			w.Header().Set("Content-Type", stored.ContentType)
+		}
+		w.Header().Set(idempotentReplayedHeader, "true")
+		w.WriteHeader(stored.StatusCode)
+		_, _ = w.Write(stored.Body)
+		return
+	}
+
+	rec := &responseRecorder{ResponseWriter: w}
+	s.mux.ServeHTTP(rec, r)
+
+	// The client may be gone by now; that is exactly when the retry comes.
+	ctx := context.WithoutCancel(r.Context())
+	if resp, ok := rec.storable(); ok {
+		err = s.svc.IdempotencyComplete(ctx, id, resp)
+	} else {
+		err = s.svc.IdempotencyRelease(ctx, id)
+	}
+	if err != nil {
+		log.Printf("idempotency key %q: %v", key, err)
+	}
+}
+
+// responseRecorder passes a response through while keeping a copy of it.
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	if !r.overflow {
+		if r.body.Len()+len(p) > idempotency.MaxStoredBody {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(p)
+		}
+	}
+	return r.ResponseWriter.Write(p)
+}
+
+// storable reports whether the response can be replayed. Server errors are
+// not stored so the request can be retried; neither are file downloads,
+// whose headers are not kept.
+func (r *responseRecorder) storable() (backend.IdempotentResponse, bool) {
+	status := r.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	contentType := r.Header().Get("Content-Type")
+	if status >= http.StatusInternalServerError || r.overflow {
+		return backend.IdempotentResponse{}, false
+	}
+	if r.body.Len() > 0 && !strings.HasPrefix(contentType, "application/json") {
+		return backend.IdempotentResponse{}, false
+	}
+	return backend.IdempotentResponse{
+		StatusCode:  status,
+		ContentType: contentType,
+		Body:        bytes.Clone(r.body.Bytes()),
+	}, true
+}
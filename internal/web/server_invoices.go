@@ -194,6 +194,21 @@ func (s *Server) handleInvoicesDownloadPDF(w http.ResponseWriter, r *http.Reques
 	http.ServeFile(w, r, path)
 }
 
+func (s *Server) handleInvoicesDownloadUBL(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.InvoiceUBL(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	_, _ = w.Write(data)
+}
+
 func (s *Server) handleInvoiceArchiveList(w http.ResponseWriter, r *http.Request) {
 	item, err := s.svc.InvoiceArchiveList(r.Context())
 	if err != nil {
@@ -233,6 +248,13 @@ func (s *Server) handleInvoiceArchiveZip(w http.ResponseWriter, r *http.Request)
 			writeError(w, err)
 			return
 		}
+		if entry.Data != nil {
+			if _, err := fileWriter.Write(entry.Data); err != nil {
+				writeError(w, err)
+				return
+			}
+			continue
+		}
 		file, err := os.Open(entry.Path)
 		if err != nil {
 			writeError(w, err)
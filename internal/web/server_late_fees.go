@@ -0,0 +1,38 @@
+package web
+
+import (
+	"net/http"
+)
+
+func (s *Server) handleLateFeesList(w http.ResponseWriter, r *http.Request) {
+	studentID, err := parseQueryIntDefault(r.URL.Query().Get("studentId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid studentId")
+		return
+	}
+	items, err := s.svc.LateFeeList(r.Context(), studentID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleLateFeesWaive(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LateFeeWaive(r.Context(), id, req.Reason)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
@@ -0,0 +1,164 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleLeadsList(w http.ResponseWriter, r *http.Request) {
+	courseID, err := parseQueryIntDefault(r.URL.Query().Get("courseId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid courseId")
+		return
+	}
+	items, err := s.svc.LeadList(r.Context(), r.URL.Query().Get("status"), courseID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleLeadsGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.LeadGet(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLeadsCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.LeadInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LeadCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleLeadsUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req leadUpdateRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LeadUpdate(r.Context(), id, req.Version, req.LeadInput)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLeadsDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := s.svc.LeadDelete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleLeadsScheduleTrial(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req backend.LeadTrialInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LeadScheduleTrial(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLeadsRecordOutcome(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req leadOutcomeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LeadRecordOutcome(r.Context(), id, req.Outcome, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLeadsMarkLost(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req leadLostRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LeadMarkLost(r.Context(), id, req.Reason)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLeadsConvert(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req leadConvertRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	input := backend.LeadConvertInput{
+		Enrollments:      make([]backend.EnrollmentCreateInput, 0, len(req.Enrollments)),
+		StudentID:        req.StudentID,
+		IgnoreDuplicates: req.IgnoreDuplicates,
+	}
+	if req.Student != nil {
+		input.Student = backend.StudentCreateInput{
+			FullName:     req.Student.FullName,
+			PersonalCode: req.Student.PersonalCode,
+			Phone:        req.Student.Phone,
+			Email:        req.Student.Email,
+			Note:         req.Student.Note,
+			IsMinor:      req.Student.IsMinor,
+			PayerName:    req.Student.PayerName,
+			PayerRole:    req.Student.PayerRole,
+		}
+	}
+	for _, enrollment := range req.Enrollments {
+		input.Enrollments = append(input.Enrollments, toEnrollmentCreateInput(enrollment))
+	}
+	result, err := s.svc.LeadConvert(r.Context(), id, input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
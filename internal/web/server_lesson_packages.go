@@ -0,0 +1,95 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleLessonPackagesList(w http.ResponseWriter, r *http.Request) {
+	studentID, err := parseQueryIntDefault(r.URL.Query().Get("studentId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid studentId")
+		return
+	}
+	items, err := s.svc.LessonPackageList(r.Context(), studentID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleLessonPackagesWarnings(w http.ResponseWriter, r *http.Request) {
+	items, err := s.svc.LessonPackageWarnings(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleLessonPackagesSell(w http.ResponseWriter, r *http.Request) {
+	var req backend.LessonPackageInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LessonPackageSell(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleLessonPackagesGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.LessonPackageGet(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleLessonPackagesCarryOver(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		ExpiresOn string `json:"expiresOn"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LessonPackageCarryOver(r.Context(), id, req.ExpiresOn)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleLessonPackagesRefund(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Method string `json:"method"`
+		Note   string `json:"note"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.LessonPackageRefund(r.Context(), id, req.Method, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
@@ -1,10 +1,13 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"langschool/internal/app/dataexport"
 	"langschool/internal/backend"
 )
 
@@ -46,3 +49,19 @@ func (s *Server) handleBackupsCreate(w http.ResponseWriter, r *http.Request) {
 		"filename": filepath.Base(path),
 	})
 }
+
+// handleDataExport downloads every record as CSV files and a JSON document
+// in one ZIP archive.
+func (s *Server) handleDataExport(w http.ResponseWriter, r *http.Request) {
+	export, err := s.svc.DataExport(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	exportedAt, _ := time.Parse(time.RFC3339, export.ExportedAt)
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", dataexport.FileName(exportedAt.Local())))
+	if err := dataexport.WriteZIP(w, export); err != nil {
+		writeError(w, err)
+	}
+}
@@ -0,0 +1,65 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handlePaymentPlansList(w http.ResponseWriter, r *http.Request) {
+	studentID, err := parseQueryIntDefault(r.URL.Query().Get("studentId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid studentId")
+		return
+	}
+	activeOnly, err := parseBoolDefault(r.URL.Query().Get("active"), false)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	items, err := s.svc.PaymentPlanList(r.Context(), studentID, activeOnly)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handlePaymentPlansCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.PaymentPlanInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.PaymentPlanCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handlePaymentPlansGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.PaymentPlanGet(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handlePaymentPlansCancel(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.PaymentPlanCancel(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
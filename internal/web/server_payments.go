@@ -1,6 +1,9 @@
 package web
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 func (s *Server) handlePaymentsCreate(w http.ResponseWriter, r *http.Request) {
 	var req struct {
@@ -22,6 +25,39 @@ func (s *Server) handlePaymentsCreate(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, item)
 }
 
+func (s *Server) handlePaymentsMatchBank(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Remittance string `json:"remittance"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.PaymentMatchBank(r.Context(), req.Remittance)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handlePaymentsRecordBank(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Remittance string  `json:"remittance"`
+		Amount     float64 `json:"amount"`
+		PaidAt     string  `json:"paidAt"`
+		Note       string  `json:"note"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.PaymentRecordBank(r.Context(), req.Remittance, req.Amount, req.PaidAt, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
 func (s *Server) handlePaymentsDelete(w http.ResponseWriter, r *http.Request) {
 	id, ok := pathInt(w, r, "id")
 	if !ok {
@@ -51,6 +87,83 @@ func (s *Server) handlePaymentsQuickCash(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, item)
 }
 
+func (s *Server) handlePaymentsRefund(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Amount     float64 `json:"amount"`
+		Method     string  `json:"method"`
+		RefundedAt string  `json:"refundedAt"`
+		Note       string  `json:"note"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.PaymentRefund(r.Context(), id, req.Amount, req.Method, req.RefundedAt, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handlePaymentsReallocate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		ToInvoiceID int     `json:"toInvoiceId"`
+		Amount      float64 `json:"amount"` // 0 moves the whole payment
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if req.ToInvoiceID <= 0 {
+		writeBadRequest(w, "toInvoiceId is required")
+		return
+	}
+	item, err := s.svc.PaymentReallocate(r.Context(), id, req.ToInvoiceID, req.Amount)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handlePaymentsCreditTransfer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		FromStudentID int     `json:"fromStudentId"`
+		ToStudentID   int     `json:"toStudentId"`
+		Amount        float64 `json:"amount"`
+		Note          string  `json:"note"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.CreditTransfer(r.Context(), req.FromStudentID, req.ToStudentID, req.Amount, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleStudentCreditLedger(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "studentId")
+	if !ok {
+		return
+	}
+	item, err := s.svc.CreditLedger(r.Context(), id, r.URL.Query().Get("scope"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
 func (s *Server) handlePaymentsListForStudent(w http.ResponseWriter, r *http.Request) {
 	id, ok := pathInt(w, r, "studentId")
 	if !ok {
@@ -85,3 +198,46 @@ func (s *Server) handleDebtorsList(w http.ResponseWriter, r *http.Request) {
 	}
 	writeJSON(w, http.StatusOK, items)
 }
+
+func (s *Server) handleCashReceiptsList(w http.ResponseWriter, r *http.Request) {
+	studentID, err := parseQueryIntDefault(r.URL.Query().Get("studentId"), 0)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	items, err := s.svc.CashReceiptList(r.Context(), studentID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleCashReceiptsGet(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.CashReceiptGet(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleCashReceiptsPDF(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.CashReceiptPDF(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,126 @@
+package web
+
+import (
+	"archive/zip"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleReportsVATSummary(w http.ResponseWriter, r *http.Request) {
+	year, err := parseRequiredQueryInt(r, "year")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	month, err := parseRequiredQueryInt(r, "month")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	item, err := s.svc.VATSummary(r.Context(), year, month)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleReportsLeadFunnel(w http.ResponseWriter, r *http.Request) {
+	year, err := parseRequiredQueryInt(r, "year")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	item, err := s.svc.LeadFunnel(r.Context(), year)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleReportsEducationExpenses(w http.ResponseWriter, r *http.Request) {
+	year, err := parseRequiredQueryInt(r, "year")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	items, err := s.svc.EducationExpenses(r.Context(), year)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleReportsEducationCertificatePDF(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.EducationCertificatePDF(r.Context(), year, r.PathValue("key"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) handleReportsEducationCertificatesZIP(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+	files, filename, err := s.svc.EducationCertificatesZIP(r.Context(), year)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeReportZIP(w, filename, files)
+}
+
+func (s *Server) handleReportsEducationCertificatesSendEmail(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+	var req struct {
+		Keys []string `json:"keys"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.EducationCertificatesSendEmail(r.Context(), year, req.Keys)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func writeReportZIP(w http.ResponseWriter, filename string, files []backend.ReportFile) {
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		fileWriter, err := zw.Create(strings.TrimSpace(file.Name))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if _, err := fileWriter.Write(file.Data); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if err := zw.Close(); err != nil {
+		writeError(w, err)
+	}
+}
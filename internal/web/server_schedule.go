@@ -0,0 +1,147 @@
+package web
+
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
+
+func (s *Server) handleRoomsList(w http.ResponseWriter, r *http.Request) {
+	items, err := s.svc.RoomList(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleRoomsCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.RoomInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.RoomCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleRoomsUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req roomUpdateRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.RoomUpdate(r.Context(), id, req.Version, req.RoomInput)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleRoomsDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := s.svc.RoomDelete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleRoomsOccupancy(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.RoomOccupancy(r.Context(), id, r.URL.Query().Get("week"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleScheduleSlotsList(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	courseID, err := parseQueryIntDefault(query.Get("courseId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid courseId")
+		return
+	}
+	roomID, err := parseQueryIntDefault(query.Get("roomId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid roomId")
+		return
+	}
+	teacherID, err := parseQueryIntDefault(query.Get("teacherId"), 0)
+	if err != nil {
+		writeBadRequest(w, "invalid teacherId")
+		return
+	}
+	items, err := s.svc.ScheduleSlotList(r.Context(), courseID, roomID, teacherID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) handleScheduleSlotsCreate(w http.ResponseWriter, r *http.Request) {
+	var req backend.ScheduleSlotInput
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.ScheduleSlotCreate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (s *Server) handleScheduleSlotsUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req scheduleSlotUpdateRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.ScheduleSlotUpdate(r.Context(), id, req.Version, req.ScheduleSlotInput)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleScheduleSlotsDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := s.svc.ScheduleSlotDelete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleScheduleSlotsConflicts(w http.ResponseWriter, r *http.Request) {
+	items, err := s.svc.ScheduleConflicts(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
@@ -1,6 +1,10 @@
 package web
 
-import "net/http"
+import (
+	"net/http"
+
+	"langschool/internal/backend"
+)
 
 func (s *Server) handleSettingsGetLocale(w http.ResponseWriter, r *http.Request) {
 	locale, err := s.svc.SettingsGetLocale(r.Context())
@@ -51,6 +55,28 @@ func (s *Server) handleSettingsSetInvoiceEmail(w http.ResponseWriter, r *http.Re
 	writeJSON(w, http.StatusOK, item)
 }
 
+func (s *Server) handleSettingsGetPaymentDetails(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.SettingsGetPaymentDetails(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsSetPaymentDetails(w http.ResponseWriter, r *http.Request) {
+	var req backend.PaymentDetailsSettingsDTO
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.SettingsSetPaymentDetails(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
 func (s *Server) handleCurrentUserGetLocale(w http.ResponseWriter, r *http.Request) {
 	currentUser := currentUserFromContext(r.Context())
 	if currentUser == nil {
@@ -84,3 +110,91 @@ func (s *Server) handleCurrentUserSetLocale(w http.ResponseWriter, r *http.Reque
 	}
 	writeJSON(w, http.StatusOK, map[string]string{"locale": locale})
 }
+
+func (s *Server) handleSettingsGetVAT(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.SettingsGetVAT(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsSetVAT(w http.ResponseWriter, r *http.Request) {
+	var req backend.VATSettingsDTO
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.SettingsSetVAT(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsGetLateFees(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.SettingsGetLateFees(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsSetLateFees(w http.ResponseWriter, r *http.Request) {
+	var req backend.LateFeeSettingsDTO
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.SettingsSetLateFees(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsGetExcusedAbsences(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.SettingsGetExcusedAbsences(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsSetExcusedAbsences(w http.ResponseWriter, r *http.Request) {
+	var req backend.ExcusedAbsenceSettingsDTO
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.SettingsSetExcusedAbsences(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsGetWaitlist(w http.ResponseWriter, r *http.Request) {
+	item, err := s.svc.SettingsGetWaitlist(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleSettingsSetWaitlist(w http.ResponseWriter, r *http.Request) {
+	var req backend.WaitlistSettingsDTO
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.SettingsSetWaitlist(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
@@ -1,7 +1,12 @@
 package web
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
+	"strings"
 
 	"langschool/internal/backend"
 )
@@ -82,6 +87,65 @@ func (s *Server) handleStudentsOnboard(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// maxStudentImportUpload caps the size of an uploaded registration sheet.
+const maxStudentImportUpload = 10 << 20
+
+func (s *Server) handleStudentsImportPreview(w http.ResponseWriter, r *http.Request) {
+	fileName, data, mapping, ok := readStudentImportUpload(w, r)
+	if !ok {
+		return
+	}
+	preview, err := s.svc.StudentImportPreview(r.Context(), fileName, data, mapping)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, preview)
+}
+
+func (s *Server) handleStudentsImport(w http.ResponseWriter, r *http.Request) {
+	fileName, data, mapping, ok := readStudentImportUpload(w, r)
+	if !ok {
+		return
+	}
+	result, err := s.svc.StudentImport(r.Context(), fileName, data, mapping)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, result)
+}
+
+// readStudentImportUpload reads a multipart form with the sheet in "file"
+// and an optional JSON column-to-field object in "mapping".
+func readStudentImportUpload(w http.ResponseWriter, r *http.Request) (string, []byte, map[string]string, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxStudentImportUpload)
+	if err := r.ParseMultipartForm(maxStudentImportUpload); err != nil {
+		writeBadRequest(w, "invalid upload: expected a multipart form of at most 10 MB")
+		return "", nil, nil, false
+	}
+	defer r.MultipartForm.RemoveAll()
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeBadRequest(w, "file is required")
+		return "", nil, nil, false
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		writeBadRequest(w, "invalid upload")
+		return "", nil, nil, false
+	}
+	var mapping map[string]string
+	if raw := strings.TrimSpace(r.FormValue("mapping")); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			writeBadRequest(w, "mapping must be a JSON object of column names to fields")
+			return "", nil, nil, false
+		}
+	}
+	return filepath.Base(header.Filename), data, mapping, true
+}
+
 func toEnrollmentCreateInput(req enrollmentCreateRequest) backend.EnrollmentCreateInput {
 	return backend.EnrollmentCreateInput{
 		CourseID:                req.CourseID,
@@ -90,6 +154,8 @@ func toEnrollmentCreateInput(req enrollmentCreateRequest) backend.EnrollmentCrea
 		LessonPriceOverride:     req.LessonPriceOverride,
 		SubscriptionLessonPrice: req.SubscriptionLessonPrice,
 		Note:                    req.Note,
+		StartsOn:                req.StartsOn,
+		EndsOn:                  req.EndsOn,
 	}
 }
 
@@ -172,6 +238,70 @@ func (s *Server) handleStudentDebtDetails(w http.ResponseWriter, r *http.Request
 	writeJSON(w, http.StatusOK, items)
 }
 
+func statementQuery(r *http.Request) backend.StatementQuery {
+	query := r.URL.Query()
+	return backend.StatementQuery{Scope: query.Get("scope"), From: query.Get("from"), To: query.Get("to")}
+}
+
+func (s *Server) handleStudentStatement(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.StudentStatement(r.Context(), id, statementQuery(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleStudentStatementPDF(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	data, filename, err := s.svc.StudentStatementPDF(r.Context(), id, statementQuery(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) handleStudentStatementEmailPreview(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	item, err := s.svc.StudentStatementEmailPreview(r.Context(), id, statementQuery(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+func (s *Server) handleStudentStatementSendEmail(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req backend.InvoiceEmailRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	item, err := s.svc.StudentStatementSendEmail(r.Context(), id, statementQuery(r), req.To, req.Subject, req.Body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
 func (s *Server) handleTeachersList(w http.ResponseWriter, r *http.Request) {
 	items, err := s.svc.TeacherList(r.Context(), r.URL.Query().Get("q"))
 	if err != nil {
@@ -195,3 +325,29 @@ func (s *Server) handleTeachersCreate(w http.ResponseWriter, r *http.Request) {
 	}
 	writeJSON(w, http.StatusCreated, item)
 }
+
+func (s *Server) handleStudentsSetPayer(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	var req struct {
+		Version           int                      `json:"version"`
+		PayerType         string                   `json:"payerType"`
+		PayerPersonalCode string                   `json:"payerPersonalCode"`
+		CompanyPayer      *backend.CompanyPayerDTO `json:"companyPayer"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	var company backend.CompanyPayerDTO
+	if req.CompanyPayer != nil {
+		company = *req.CompanyPayer
+	}
+	item, err := s.svc.StudentSetPayer(r.Context(), id, req.Version, req.PayerType, req.PayerPersonalCode, company)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
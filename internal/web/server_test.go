@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/http/httptest"
@@ -2127,6 +2129,41 @@ func TestInvoiceEmailSettingsDefaultsAndReset(t *testing.T) {
 	}
 }
 
+func TestPaymentDetailsSettings(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	settings := getJSON[backend.PaymentDetailsSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/payment-details")
+	if !settings.PaymentQR {
+		t.Fatal("payment QR should be enabled by default")
+	}
+	if settings.IBAN != "" || settings.BIC != "" {
+		t.Fatalf("default bank details = %+v, want empty", settings)
+	}
+
+	updated := postJSON[backend.PaymentDetailsSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/payment-details", map[string]any{
+		"beneficiaryName": "ArtLab",
+		"bankName":        "A/S SEB banka",
+		"bic":             "unlalv2x",
+		"iban":            "lv92 unla 0050 0215 2116 7",
+		"paymentQr":       false,
+	})
+	if updated.IBAN != "LV92UNLA0050021521167" || updated.BIC != "UNLALV2X" {
+		t.Fatalf("normalized bank details = %+v", updated)
+	}
+	if updated.PaymentQR {
+		t.Fatal("payment QR should be disabled after update")
+	}
+
+	resp, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/settings/payment-details", bytes.NewReader(mustJSON(t, map[string]any{
+		"iban":      "LV00UNLA0050021521167",
+		"paymentQr": true,
+	})))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("invalid iban status = %d body=%s, want 400", resp.StatusCode, body)
+	}
+}
+
 func TestInvoiceEmailSendRequiresRecipient(t *testing.T) {
 	env := newTestServer(t)
 	defer env.Close()
@@ -2819,6 +2856,69 @@ func TestInvoicePaymentDeleteThenReopenWorkflow(t *testing.T) {
 	}
 }
 
+func TestBankPaymentMatchesByCreditorReference(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Reference Student"})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Reference Course",
+		"type":              "group",
+		"lessonPrice":       25,
+		"subscriptionPrice": 100,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":   st.ID,
+		"courseId":    course.ID,
+		"billingMode": "per_lesson",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      2026,
+		"month":     9,
+		"hours":     2.0,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2026, "month": 9})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2026&month=9&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+		"version": invoices[0].Version,
+	})
+
+	details := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID))
+	if !strings.HasPrefix(details.PaymentReference, "RF") {
+		t.Fatalf("payment reference = %q, want RF reference", details.PaymentReference)
+	}
+
+	match := postJSON[backend.BankMatchDTO](t, env.Client, env.Server.URL, "/api/payments/bank/match", map[string]any{
+		"remittance": "Maksājums " + strings.ToLower(details.PaymentReference),
+	})
+	if match.MatchedBy != "reference" || match.InvoiceID == nil || *match.InvoiceID != details.ID {
+		t.Fatalf("bank match = %+v", match)
+	}
+
+	payment := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments/bank", map[string]any{
+		"remittance": details.PaymentReference,
+		"amount":     details.Total,
+		"paidAt":     "2026-09-20",
+	})
+	if payment.InvoiceID == nil || *payment.InvoiceID != details.ID || payment.Method != "bank" {
+		t.Fatalf("bank payment = %+v", payment)
+	}
+
+	resp, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/payments/bank", bytes.NewReader(mustJSON(t, map[string]any{
+		"remittance": "unknown",
+		"amount":     10,
+		"paidAt":     "2026-09-20",
+	})))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unmatched bank payment status = %d body=%s, want 400", resp.StatusCode, body)
+	}
+}
+
 func TestStaticServingWithDist(t *testing.T) {
 	distDir := writeTestDist(t)
 	env := newTestServerWithDist(t, distDir)
@@ -3102,3 +3202,1808 @@ func mustJSON(t *testing.T, payload any) []byte {
 	}
 	return data
 }
+
+func TestInvoiceUBLDownloadAndArchiveZIP(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "UBL Student",
+		"email":    "ubl.student@example.com",
+	})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "UBL Course",
+		"type":              "group",
+		"lessonPrice":       25,
+		"subscriptionPrice": 80,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":           st.ID,
+		"courseId":            course.ID,
+		"billingMode":         "per_lesson",
+		"chargeMaterials":     true,
+		"lessonPriceOverride": 0,
+		"note":                "",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      2026,
+		"month":     7,
+		"hours":     3,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{
+		"year":  2026,
+		"month": 7,
+	})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2026&month=7&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	ublURL := env.Server.URL + "/api/invoices/" + strconv.Itoa(invoices[0].ID) + "/ubl"
+
+	res, body := rawRequest(t, env.Client, http.MethodGet, ublURL, nil)
+	if res.StatusCode == http.StatusOK {
+		t.Fatalf("draft ubl status = %d, want error", res.StatusCode)
+	}
+
+	postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+		"version": invoices[0].Version,
+	})
+
+	res, body = rawRequest(t, env.Client, http.MethodGet, ublURL, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("ubl status = %d body=%s, want 200", res.StatusCode, string(body))
+	}
+	if got := res.Header.Get("Content-Type"); !strings.HasPrefix(got, "application/xml") {
+		t.Fatalf("content type = %q, want application/xml", got)
+	}
+	if got := res.Header.Get("Content-Disposition"); !strings.Contains(got, ".xml") {
+		t.Fatalf("content disposition = %q, want xml attachment", got)
+	}
+	// The document itself is checked against the Peppol rules in the pdf
+	// package; here it only has to be the invoice.
+	number := ublInvoiceNumber(t, body)
+	if number == "" {
+		t.Fatalf("downloaded ubl has no invoice number: %s", body)
+	}
+	if !strings.Contains(string(body), `<cbc:EndpointID schemeID="EM">ubl.student@example.com</cbc:EndpointID>`) {
+		t.Fatalf("ubl does not address the payer: %s", string(body))
+	}
+
+	res, body = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/invoice-archive/2026/07/zip", nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("zip status = %d body=%s, want 200", res.StatusCode, string(body))
+	}
+	reader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("zip reader: %v", err)
+	}
+	if len(reader.File) != 2 {
+		t.Fatalf("zip entries = %d, want pdf and xml", len(reader.File))
+	}
+	entry := reader.File[1]
+	if !strings.HasSuffix(entry.Name, ".xml") {
+		t.Fatalf("zip entry name = %q, want xml", entry.Name)
+	}
+	rc, err := entry.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xmlData, err := io.ReadAll(rc)
+	_ = rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ublInvoiceNumber(t, xmlData); got != number {
+		t.Fatalf("archived ubl invoice number = %q, want %q", got, number)
+	}
+}
+
+// ublInvoiceNumber parses a UBL invoice and returns its number.
+func ublInvoiceNumber(t *testing.T, data []byte) string {
+	t.Helper()
+	var doc struct {
+		XMLName xml.Name
+		ID      string `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 ID"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("parse ubl: %v", err)
+	}
+	if doc.XMLName.Local != "Invoice" {
+		t.Fatalf("ubl root = %s, want Invoice", doc.XMLName.Local)
+	}
+	return doc.ID
+}
+
+func TestCompanyPayerAndVATInvoiceFlow(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	res, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/settings/vat", bytes.NewReader(mustJSON(t, map[string]any{
+		"enabled": true,
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("enable vat without number status = %d body=%s, want 400", res.StatusCode, string(body))
+	}
+	settingsVAT := postJSON[backend.VATSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/vat", map[string]any{
+		"enabled":   true,
+		"vatNumber": "lv 40003000000",
+	})
+	if !settingsVAT.Enabled || settingsVAT.VATNumber != "LV40003000000" {
+		t.Fatalf("vat settings = %+v", settingsVAT)
+	}
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Employee Student",
+		"email":    "employee@example.com",
+	})
+	res, body = rawRequest(t, env.Client, http.MethodPut, env.Server.URL+"/api/students/"+strconv.Itoa(st.ID)+"/payer", bytes.NewReader(mustJSON(t, map[string]any{
+		"version":      st.Version,
+		"payerType":    "company",
+		"companyPayer": map[string]any{"companyName": "Employer SIA"},
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("company payer without reg no status = %d body=%s, want 400", res.StatusCode, string(body))
+	}
+	st = putJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students/"+strconv.Itoa(st.ID)+"/payer", map[string]any{
+		"version":   st.Version,
+		"payerType": "company",
+		"companyPayer": map[string]any{
+			"companyName":  "Employer SIA",
+			"regNo":        "40003123456",
+			"vatNumber":    "LV40003123456",
+			"legalAddress": "Brīvības iela 1, Rīga, LV-1010",
+			"billingEmail": "invoices@employer.example.com",
+		},
+	})
+	if st.PayerType != "company" || st.CompanyPayer == nil || st.CompanyPayer.RegNo != "40003123456" {
+		t.Fatalf("student payer = %+v", st)
+	}
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Business English",
+		"type":              "group",
+		"lessonPrice":       25,
+		"subscriptionPrice": 80,
+	})
+	course = putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID)+"/vat", map[string]any{
+		"version": course.Version,
+		"vatRate": 21,
+	})
+	if course.VATRate != 21 {
+		t.Fatalf("course vat rate = %v, want 21", course.VATRate)
+	}
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":           st.ID,
+		"courseId":            course.ID,
+		"billingMode":         "per_lesson",
+		"chargeMaterials":     false,
+		"lessonPriceOverride": 0,
+		"note":                "",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      2026,
+		"month":     8,
+		"hours":     3,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{
+		"year":  2026,
+		"month": 8,
+	})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2026&month=8&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	if invoices[0].Total != 90.75 {
+		t.Fatalf("draft total = %v, want 90.75 gross", invoices[0].Total)
+	}
+
+	iv := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID))
+	if !iv.IsCompany || iv.RecipientName != "Employer SIA" || iv.RecipientEmail != "invoices@employer.example.com" || iv.RecipientRegNo != "40003123456" {
+		t.Fatalf("invoice recipient = %+v", iv)
+	}
+	if iv.Net != 75 || iv.VAT != 15.75 || iv.Total != 90.75 {
+		t.Fatalf("invoice totals net=%v vat=%v total=%v", iv.Net, iv.VAT, iv.Total)
+	}
+	if len(iv.VATBreakdown) != 1 || iv.VATBreakdown[0].Rate != 21 || iv.VATBreakdown[0].VAT != 15.75 {
+		t.Fatalf("vat breakdown = %+v", iv.VATBreakdown)
+	}
+
+	summary := getJSON[backend.VATSummaryDTO](t, env.Client, env.Server.URL, "/api/reports/vat-summary?year=2026&month=8")
+	if summary.InvoiceCount != 0 || len(summary.Groups) != 0 {
+		t.Fatalf("summary before issue = %+v, want drafts excluded", summary)
+	}
+	postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(iv.ID)+"/issue", map[string]any{
+		"version": iv.Version,
+	})
+	summary = getJSON[backend.VATSummaryDTO](t, env.Client, env.Server.URL, "/api/reports/vat-summary?year=2026&month=8")
+	if summary.InvoiceCount != 1 || summary.Net != 75 || summary.VAT != 15.75 || summary.Gross != 90.75 {
+		t.Fatalf("vat summary = %+v", summary)
+	}
+	if len(summary.Groups) != 1 || summary.Groups[0].Rate != 21 {
+		t.Fatalf("vat summary groups = %+v", summary.Groups)
+	}
+}
+
+func TestEducationExpenseCertificates(t *testing.T) {
+	sender := &stubEmailSender{}
+	env := newTestServerWithEmailSender(t, sender)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName":  "Certificate Child",
+		"email":     "parent@example.com",
+		"isMinor":   true,
+		"payerName": "Certificate Parent",
+		"payerRole": "mother",
+	})
+	st = putJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students/"+strconv.Itoa(st.ID)+"/payer", map[string]any{
+		"version":           st.Version,
+		"payerType":         "person",
+		"payerPersonalCode": "010180-12345",
+	})
+	if st.PayerPersonalCode != "010180-12345" {
+		t.Fatalf("payer personal code = %q", st.PayerPersonalCode)
+	}
+	for _, paidAt := range []string{"2025-02-10", "2025-11-05", "2026-01-03"} {
+		postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+			"studentId": st.ID,
+			"amount":    40,
+			"method":    "bank",
+			"paidAt":    paidAt,
+		})
+	}
+
+	payers := getJSON[[]backend.EducationExpensePayerDTO](t, env.Client, env.Server.URL, "/api/reports/education-expenses?year=2025")
+	if len(payers) != 1 || payers[0].Name != "Certificate Parent" || payers[0].Total != 80 || payers[0].Key != "010180-12345" {
+		t.Fatalf("payers = %+v", payers)
+	}
+
+	res, body := rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/reports/education-expenses/2025/010180-12345/pdf", nil)
+	if res.StatusCode != http.StatusOK || !bytes.HasPrefix(body, []byte("%PDF")) {
+		t.Fatalf("certificate pdf status = %d, want pdf", res.StatusCode)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/reports/education-expenses/2025/unknown/pdf", nil)
+	if res.StatusCode != http.StatusNotFound {
+		t.Fatalf("unknown payer status = %d, want 404", res.StatusCode)
+	}
+
+	res, body = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/reports/education-expenses/2025/zip", nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("zip status = %d body=%s", res.StatusCode, string(body))
+	}
+	reader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("zip reader: %v", err)
+	}
+	if len(reader.File) != 1 || reader.File[0].Name != "Izziņa 2025 - Certificate Parent.pdf" {
+		t.Fatalf("zip entries = %+v", reader.File)
+	}
+
+	result := postJSON[backend.EducationCertificateEmailResult](t, env.Client, env.Server.URL, "/api/reports/education-expenses/2025/send-email", map[string]any{})
+	if len(result.Sent) != 1 || result.Sent[0].To != "parent@example.com" {
+		t.Fatalf("send result = %+v", result)
+	}
+	if sender.lastMessage.To != "parent@example.com" || !strings.Contains(sender.lastMessage.Subject, "2025") || len(sender.lastMessage.AttachmentData) == 0 {
+		t.Fatalf("sent message = %+v", sender.lastMessage)
+	}
+}
+
+func TestStudentStatementOfAccount(t *testing.T) {
+	sender := &stubEmailSender{}
+	env := newTestServerWithEmailSender(t, sender)
+	defer env.Close()
+
+	newChild := func(name string) backend.StudentDTO {
+		st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+			"fullName":  name,
+			"email":     "parent@example.com",
+			"isMinor":   true,
+			"payerName": "Statement Parent",
+			"payerRole": "mother",
+		})
+		return putJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students/"+strconv.Itoa(st.ID)+"/payer", map[string]any{
+			"version":           st.Version,
+			"payerType":         "person",
+			"payerPersonalCode": "010180-54321",
+		})
+	}
+	childA := newChild("Statement Child A")
+	childB := newChild("Statement Child B")
+
+	postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": childB.ID,
+		"amount":    10,
+		"method":    "cash",
+		"paidAt":    "2024-12-20",
+	})
+	postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": childA.ID,
+		"amount":    30,
+		"method":    "bank",
+		"paidAt":    "2025-05-01",
+	})
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Statement English",
+		"type":              "group",
+		"lessonPrice":       25,
+		"subscriptionPrice": 80,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":           childA.ID,
+		"courseId":            course.ID,
+		"billingMode":         "per_lesson",
+		"chargeMaterials":     false,
+		"lessonPriceOverride": 0,
+		"note":                "",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": childA.ID,
+		"courseId":  course.ID,
+		"year":      2025,
+		"month":     6,
+		"hours":     3,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{
+		"year":  2025,
+		"month": 6,
+	})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2025&month=6&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	postJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+		"version": invoices[0].Version,
+	})
+
+	base := "/api/students/" + strconv.Itoa(childA.ID) + "/statement"
+	own := getJSON[backend.StatementDTO](t, env.Client, env.Server.URL, base+"?from=2025-01-01&to=2099-12-31")
+	if own.Opening != 0 || own.Charged != 75 || own.Paid != 30 || own.Closing != 45 || len(own.Entries) != 2 {
+		t.Fatalf("student statement = %+v", own)
+	}
+	if own.Entries[0].PaymentID == nil || own.Entries[0].Method != "bank" || own.Entries[1].Kind != "invoice" || own.Entries[1].Balance != 45 {
+		t.Fatalf("student statement entries = %+v", own.Entries)
+	}
+
+	family := getJSON[backend.StatementDTO](t, env.Client, env.Server.URL, base+"?scope=payer&from=2025-01-01&to=2099-12-31")
+	if len(family.StudentIDs) != 2 || family.Opening != -10 || family.Closing != 35 || family.RecipientName != "Statement Parent" {
+		t.Fatalf("payer statement = %+v", family)
+	}
+
+	res, body := rawRequest(t, env.Client, http.MethodGet, env.Server.URL+base+"?scope=family", nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("invalid scope status = %d body=%s, want 400", res.StatusCode, string(body))
+	}
+	res, body = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+base+"/pdf?scope=payer&from=2025-01-01&to=2099-12-31", nil)
+	if res.StatusCode != http.StatusOK || !bytes.HasPrefix(body, []byte("%PDF")) {
+		t.Fatalf("statement pdf status = %d, want pdf", res.StatusCode)
+	}
+
+	preview := postJSON[backend.InvoiceEmailPreviewResult](t, env.Client, env.Server.URL, base+"/email-preview?from=2025-01-01&to=2099-12-31", map[string]any{})
+	if preview.To != "parent@example.com" || !strings.Contains(preview.Body, "45.00") || preview.AttachmentFilename == "" {
+		t.Fatalf("statement email preview = %+v", preview)
+	}
+	sent := postJSON[backend.InvoiceSendEmailResult](t, env.Client, env.Server.URL, base+"/send-email?from=2025-01-01&to=2099-12-31", map[string]any{
+		"to":      preview.To,
+		"subject": preview.Subject,
+		"body":    preview.Body,
+	})
+	if sent.AttachmentFilename != preview.AttachmentFilename || sender.lastMessage.To != "parent@example.com" || !bytes.HasPrefix(sender.lastMessage.AttachmentData, []byte("%PDF")) {
+		t.Fatalf("statement email = %+v, message = %+v", sent, sender.lastMessage)
+	}
+}
+
+func TestCashReceiptsForCashPayments(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName":  "Receipt Child",
+		"isMinor":   true,
+		"payerName": "Receipt Parent",
+		"payerRole": "father",
+	})
+	payment := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": st.ID,
+		"amount":    25.5,
+		"method":    "cash",
+		"paidAt":    "2026-09-01",
+	})
+	if payment.CashReceiptID == nil {
+		t.Fatalf("cash payment = %+v, want receipt", payment)
+	}
+	receiptURL := "/api/cash-receipts/" + strconv.Itoa(*payment.CashReceiptID)
+	receipt := getJSON[backend.CashReceiptDTO](t, env.Client, env.Server.URL, receiptURL)
+	if receipt.Number != "KI-000001" || receipt.ReceivedBy != env.AdminUsername || receipt.PayerName != "Receipt Parent" || receipt.Credit != 25.5 {
+		t.Fatalf("receipt = %+v", receipt)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodGet, env.Server.URL+receiptURL+"/pdf", nil)
+	if res.StatusCode != http.StatusOK || !bytes.HasPrefix(body, []byte("%PDF")) {
+		t.Fatalf("receipt pdf status = %d, want pdf", res.StatusCode)
+	}
+
+	res, _ = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/payments/"+strconv.Itoa(payment.ID), nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete payment status = %d, want 204", res.StatusCode)
+	}
+	receipts := getJSON[[]backend.CashReceiptDTO](t, env.Client, env.Server.URL, "/api/cash-receipts?studentId="+strconv.Itoa(st.ID))
+	if len(receipts) != 1 || receipts[0].Status != "voided" || receipts[0].VoidedAt == nil {
+		t.Fatalf("receipts after delete = %+v", receipts)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?action=cash_receipt.void&page=1&pageSize=20")
+	if audit.Total != 1 || audit.Items[0].EntityID == nil || *audit.Items[0].EntityID != receipt.ID {
+		t.Fatalf("void audit = %+v", audit)
+	}
+}
+
+func TestCashSessionZReportAndClosedSessionLock(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	session := postJSON[backend.CashSessionDTO](t, env.Client, env.Server.URL, "/api/cash-sessions", map[string]any{
+		"openingFloat": 50,
+	})
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/cash-sessions", bytes.NewReader(mustJSON(t, map[string]any{"openingFloat": 10})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("second open status = %d, want 409", res.StatusCode)
+	}
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Drawer Student"})
+	payment := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": st.ID,
+		"amount":    40,
+		"method":    "cash",
+		"paidAt":    "2026-09-01",
+	})
+	sessionURL := "/api/cash-sessions/" + strconv.Itoa(session.ID)
+	postJSON[backend.CashMovementDTO](t, env.Client, env.Server.URL, sessionURL+"/movements", map[string]any{
+		"kind":   "bank_deposit",
+		"amount": 30,
+		"note":   "Swedbank",
+	})
+	current := getJSON[backend.CashSessionDTO](t, env.Client, env.Server.URL, "/api/cash-sessions/current")
+	if current.ID != session.ID || current.CashReceived != 40 || current.Expected != 60 {
+		t.Fatalf("current session = %+v", current)
+	}
+
+	report := postJSON[backend.CashSessionReportDTO](t, env.Client, env.Server.URL, sessionURL+"/close", map[string]any{"counted": 58.5})
+	if report.Session.Status != "closed" || report.Session.Discrepancy == nil || *report.Session.Discrepancy != -1.5 {
+		t.Fatalf("closed session = %+v", report.Session)
+	}
+	if len(report.ByUser) != 1 || report.ByUser[0].ReceivedBy != env.AdminUsername || report.ByUser[0].Amount != 40 || len(report.Receipts) != 1 {
+		t.Fatalf("z-report = %+v", report)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodGet, env.Server.URL+sessionURL+"/z-report/pdf", nil)
+	if res.StatusCode != http.StatusOK || !bytes.HasPrefix(body, []byte("%PDF")) {
+		t.Fatalf("z-report pdf status = %d, want pdf", res.StatusCode)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+sessionURL+"/z-report/csv", nil)
+	if res.StatusCode != http.StatusOK || !strings.Contains(string(body), "discrepancy,,,Counted minus expected,,-1.50") {
+		t.Fatalf("z-report csv status = %d, body = %s", res.StatusCode, body)
+	}
+
+	paymentURL := env.Server.URL + "/api/payments/" + strconv.Itoa(payment.ID)
+	res, _ = rawRequest(t, env.Client, http.MethodDelete, paymentURL, nil)
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("delete payment in closed session status = %d, want 409", res.StatusCode)
+	}
+
+	postJSON[backend.UserDTO](t, env.Client, env.Server.URL, "/api/users", map[string]any{
+		"username": "cashier",
+		"password": "cashier-pass-123",
+		"role":     "staff",
+	})
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staffClient := &http.Client{Jar: jar}
+	postJSON[backend.SessionDTO](t, staffClient, env.Server.URL, "/api/auth/login", map[string]any{
+		"username": "cashier",
+		"password": "cashier-pass-123",
+	})
+	res, _ = rawRequest(t, staffClient, http.MethodPost, env.Server.URL+sessionURL+"/reopen", nil)
+	if res.StatusCode != http.StatusForbidden {
+		t.Fatalf("staff reopen status = %d, want 403", res.StatusCode)
+	}
+
+	reopened := postJSON[backend.CashSessionDTO](t, env.Client, env.Server.URL, sessionURL+"/reopen", map[string]any{})
+	if reopened.Status != "open" || reopened.ReopenedBy != env.AdminUsername {
+		t.Fatalf("reopened session = %+v", reopened)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodDelete, paymentURL, nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete payment after reopen status = %d, want 204", res.StatusCode)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?action=cash_session.reopen&page=1&pageSize=20")
+	if audit.Total != 1 {
+		t.Fatalf("reopen audit = %+v", audit)
+	}
+}
+
+func TestRefundsCreditTransfersAndLedger(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	postJSON[backend.CashSessionDTO](t, env.Client, env.Server.URL, "/api/cash-sessions", map[string]any{"openingFloat": 20})
+	anna := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Anna Credit"})
+	boris := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Boris Credit"})
+	payment := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": anna.ID,
+		"amount":    50,
+		"method":    "cash",
+		"paidAt":    "2026-09-01",
+	})
+
+	refundURL := env.Server.URL + "/api/payments/" + strconv.Itoa(payment.ID) + "/refund"
+	res, _ := rawRequest(t, env.Client, http.MethodPost, refundURL, bytes.NewReader(mustJSON(t, map[string]any{"amount": 80, "method": "cash"})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("oversized refund status = %d, want 400", res.StatusCode)
+	}
+	refund := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments/"+strconv.Itoa(payment.ID)+"/refund", map[string]any{
+		"amount": 15,
+		"method": "cash",
+		"note":   "course dropped",
+	})
+	if refund.Kind != "refund" || refund.Amount != -15 || refund.RelatedPaymentID == nil || *refund.RelatedPaymentID != payment.ID {
+		t.Fatalf("refund = %+v", refund)
+	}
+	current := getJSON[backend.CashSessionDTO](t, env.Client, env.Server.URL, "/api/cash-sessions/current")
+	if current.Refunds != 15 || current.Expected != 55 {
+		t.Fatalf("cash session after refund = %+v", current)
+	}
+
+	transfer := postJSON[backend.CreditTransferDTO](t, env.Client, env.Server.URL, "/api/payments/credit-transfers", map[string]any{
+		"fromStudentId": anna.ID,
+		"toStudentId":   boris.ID,
+		"amount":        10,
+	})
+	if transfer.Out.Amount != -10 || transfer.In.Amount != 10 || transfer.In.StudentID != boris.ID {
+		t.Fatalf("transfer = %+v", transfer)
+	}
+
+	balance := getJSON[backend.BalanceDTO](t, env.Client, env.Server.URL, "/api/payments/student/"+strconv.Itoa(anna.ID)+"/balance")
+	if balance.TotalPaid != 50 || balance.Refunded != 15 || balance.Transferred != -10 || balance.Credit != 25 {
+		t.Fatalf("balance = %+v", balance)
+	}
+	ledger := getJSON[backend.CreditLedgerDTO](t, env.Client, env.Server.URL, "/api/payments/student/"+strconv.Itoa(anna.ID)+"/credit-ledger")
+	if ledger.Credit != 25 || ledger.Refunded != 15 || len(ledger.Entries) != 3 || ledger.Entries[2].CounterpartName != "Boris Credit" {
+		t.Fatalf("ledger = %+v", ledger)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/payments/student/"+strconv.Itoa(anna.ID)+"/credit-ledger?scope=family", nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("bad scope status = %d, want 400", res.StatusCode)
+	}
+
+	res, _ = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/payments/"+strconv.Itoa(payment.ID), nil)
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("delete refunded payment status = %d, want 409", res.StatusCode)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?action=payment.refund&page=1&pageSize=20")
+	if audit.Total != 1 {
+		t.Fatalf("refund audit = %+v", audit)
+	}
+}
+
+func TestPaymentReallocateBetweenInvoices(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Realloc Student"})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Realloc Course",
+		"type":              "group",
+		"lessonPrice":       30,
+		"subscriptionPrice": 90,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":   st.ID,
+		"courseId":    course.ID,
+		"billingMode": "per_lesson",
+	})
+	invoiceIDs := make([]int, 0, 2)
+	for _, month := range []int{7, 8} {
+		putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+			"studentId": st.ID,
+			"courseId":  course.ID,
+			"year":      2026,
+			"month":     month,
+			"hours":     1.0,
+		})
+		postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2026, "month": month})
+		invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2026&month="+strconv.Itoa(month)+"&status=all")
+		if len(invoices) != 1 {
+			t.Fatalf("month %d invoice count = %d, want 1", month, len(invoices))
+		}
+		postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+			"version": invoices[0].Version,
+		})
+		invoiceIDs = append(invoiceIDs, invoices[0].ID)
+	}
+	july, august := invoiceIDs[0], invoiceIDs[1]
+
+	payment := postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": st.ID,
+		"invoiceId": july,
+		"amount":    30,
+		"method":    "bank",
+		"paidAt":    "2026-07-02",
+	})
+
+	reallocateURL := env.Server.URL + "/api/payments/" + strconv.Itoa(payment.ID) + "/reallocate"
+	res, _ := rawRequest(t, env.Client, http.MethodPost, reallocateURL, bytes.NewReader(mustJSON(t, map[string]any{"toInvoiceId": july})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("same invoice status = %d, want 409", res.StatusCode)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodPost, reallocateURL, bytes.NewReader(mustJSON(t, map[string]any{})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("missing target status = %d, want 400", res.StatusCode)
+	}
+
+	result := postJSON[backend.ReallocationDTO](t, env.Client, env.Server.URL, "/api/payments/"+strconv.Itoa(payment.ID)+"/reallocate", map[string]any{
+		"toInvoiceId": august,
+		"amount":      10,
+	})
+	if result.Moved.Amount != 10 || result.Remaining == nil || result.Remaining.Amount != 20 {
+		t.Fatalf("partial reallocation = %+v", result)
+	}
+	result = postJSON[backend.ReallocationDTO](t, env.Client, env.Server.URL, "/api/payments/"+strconv.Itoa(payment.ID)+"/reallocate", map[string]any{
+		"toInvoiceId": august,
+	})
+	if result.Remaining != nil || result.ToInvoice.Remaining != 0 || result.FromInvoice == nil || result.FromInvoice.Paid != 0 {
+		t.Fatalf("full reallocation = %+v", result)
+	}
+
+	julyInvoice := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(july))
+	augustInvoice := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(august))
+	if strings.HasPrefix(julyInvoice.Status, "paid") || !strings.HasPrefix(augustInvoice.Status, "paid") {
+		t.Fatalf("statuses after reallocation: july=%s august=%s", julyInvoice.Status, augustInvoice.Status)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?action=payment.reallocate&page=1&pageSize=10")
+	if audit.Total != 2 {
+		t.Fatalf("reallocate audit total = %d, want 2", audit.Total)
+	}
+	for _, item := range audit.Items {
+		if item.InvoiceID == nil || *item.InvoiceID != august || !strings.Contains(item.BeforeJSON, "\"allocation\"") || !strings.Contains(item.AfterJSON, "\"moved\"") {
+			t.Fatalf("reallocate audit entry = %+v", item)
+		}
+	}
+}
+
+func TestIdempotencyKeyReplaysPaymentPosts(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Retry Student"})
+	send := func(key string, payload map[string]any) (*http.Response, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, env.Server.URL+"/api/payments/quick-cash", bytes.NewReader(mustJSON(t, payload)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		res, err := env.Client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res, body
+	}
+
+	payload := map[string]any{"studentId": st.ID, "amount": 25, "note": "double click"}
+	first, firstBody := send("pay-1", payload)
+	if first.StatusCode != http.StatusCreated || first.Header.Get("Idempotent-Replayed") != "" {
+		t.Fatalf("first status = %d, replayed = %q", first.StatusCode, first.Header.Get("Idempotent-Replayed"))
+	}
+	retry, retryBody := send("pay-1", payload)
+	if retry.StatusCode != http.StatusCreated || retry.Header.Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("retry status = %d, replayed = %q", retry.StatusCode, retry.Header.Get("Idempotent-Replayed"))
+	}
+	if !bytes.Equal(firstBody, retryBody) {
+		t.Fatalf("retry body = %s, want %s", retryBody, firstBody)
+	}
+
+	conflict, _ := send("pay-1", map[string]any{"studentId": st.ID, "amount": 30})
+	if conflict.StatusCode != http.StatusConflict {
+		t.Fatalf("reused key status = %d, want 409", conflict.StatusCode)
+	}
+
+	payments := getJSON[[]backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments/student/"+strconv.Itoa(st.ID))
+	if len(payments) != 1 {
+		t.Fatalf("payments after retry = %d, want 1", len(payments))
+	}
+
+	if res, _ := send("", payload); res.StatusCode != http.StatusCreated {
+		t.Fatalf("unkeyed status = %d", res.StatusCode)
+	}
+	payments = getJSON[[]backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments/student/"+strconv.Itoa(st.ID))
+	if len(payments) != 2 {
+		t.Fatalf("payments without key = %d, want 2", len(payments))
+	}
+}
+
+func TestPaymentPlansAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Plan Student"})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Plan Course",
+		"type":              "group",
+		"lessonPrice":       30,
+		"subscriptionPrice": 90,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":   st.ID,
+		"courseId":    course.ID,
+		"billingMode": "per_lesson",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      2025,
+		"month":     5,
+		"hours":     2.0,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2025, "month": 5})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2025&month=5&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+		"version": invoices[0].Version,
+	})
+
+	findDebtor := func() backend.DebtorDTO {
+		t.Helper()
+		for _, d := range getJSON[[]backend.DebtorDTO](t, env.Client, env.Server.URL, "/api/debtors") {
+			if d.StudentID == st.ID {
+				return d
+			}
+		}
+		t.Fatal("student missing from debtors")
+		return backend.DebtorDTO{}
+	}
+	if d := findDebtor(); !d.Overdue {
+		t.Fatalf("debtor before plan = %+v, want overdue", d)
+	}
+
+	firstDue := time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+	plan := postJSON[backend.PaymentPlanDTO](t, env.Client, env.Server.URL, "/api/payment-plans", map[string]any{
+		"studentId":  st.ID,
+		"invoiceIds": []int{invoices[0].ID},
+		"count":      2,
+		"firstDueOn": firstDue,
+		"note":       "agreed by phone",
+	})
+	if plan.Status != "on_track" || len(plan.Instalments) != 2 || plan.Instalments[0].Amount != 30 || plan.CreatedBy != env.AdminUsername {
+		t.Fatalf("plan = %+v", plan)
+	}
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/payment-plans", bytes.NewReader(mustJSON(t, map[string]any{
+		"studentId":  st.ID,
+		"invoiceIds": []int{invoices[0].ID},
+		"count":      1,
+		"firstDueOn": firstDue,
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("second plan status = %d, want 409", res.StatusCode)
+	}
+	if d := findDebtor(); d.Overdue || d.PaymentPlanID == nil || *d.PaymentPlanID != plan.ID {
+		t.Fatalf("debtor on plan = %+v", d)
+	}
+
+	active := getJSON[[]backend.PaymentPlanDTO](t, env.Client, env.Server.URL, "/api/payment-plans?studentId="+strconv.Itoa(st.ID)+"&active=true")
+	if len(active) != 1 || active[0].ID != plan.ID {
+		t.Fatalf("active plans = %+v", active)
+	}
+	canceled := postJSON[backend.PaymentPlanDTO](t, env.Client, env.Server.URL, "/api/payment-plans/"+strconv.Itoa(plan.ID)+"/cancel", map[string]any{})
+	if canceled.Status != "canceled" || canceled.CanceledAt == nil {
+		t.Fatalf("canceled plan = %+v", canceled)
+	}
+	if d := findDebtor(); !d.Overdue {
+		t.Fatalf("debtor after cancel = %+v, want overdue", d)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=payment_plan&page=1&pageSize=10")
+	if audit.Total != 2 {
+		t.Fatalf("payment plan audit entries = %d, want 2", audit.Total)
+	}
+}
+
+func TestLateFeesAreBilledAndWaived(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/settings/late-fees", bytes.NewReader(mustJSON(t, map[string]any{
+		"mode": "flat",
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("flat policy without fee status = %d, want 400", res.StatusCode)
+	}
+	policy := postJSON[backend.LateFeeSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/late-fees", map[string]any{
+		"mode":      "flat",
+		"flatFee":   7.5,
+		"graceDays": 3,
+	})
+	if policy.Mode != "flat" || policy.FlatFee != 7.5 || policy.GraceDays != 3 {
+		t.Fatalf("late fee policy = %+v", policy)
+	}
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Late Student"})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name":              "Late Course",
+		"type":              "group",
+		"lessonPrice":       30,
+		"subscriptionPrice": 90,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":   st.ID,
+		"courseId":    course.ID,
+		"billingMode": "per_lesson",
+	})
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      2025,
+		"month":     5,
+		"hours":     2.0,
+	})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2025, "month": 5})
+	invoices := getJSON[[]backend.InvoiceListItem](t, env.Client, env.Server.URL, "/api/invoices?year=2025&month=5&status=all")
+	if len(invoices) != 1 {
+		t.Fatalf("invoice count = %d, want 1", len(invoices))
+	}
+	issued := postJSON[backend.IssueResult](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(invoices[0].ID)+"/issue", map[string]any{
+		"version": invoices[0].Version,
+	})
+	if _, err := env.Runtime.DB.Ent.Invoice.UpdateOneID(invoices[0].ID).
+		SetIssuedAt(time.Date(2025, 6, 1, 9, 0, 0, 0, time.Local)).
+		Save(context.Background()); err != nil {
+		t.Fatalf("backdate issue: %v", err)
+	}
+
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2025, "month": 6})
+	fees := getJSON[[]backend.LateFeeDTO](t, env.Client, env.Server.URL, "/api/late-fees?studentId="+strconv.Itoa(st.ID))
+	if len(fees) != 1 || fees[0].Amount != 7.5 || fees[0].OverdueInvoiceNo != issued.Number || fees[0].BilledInvoiceID == nil {
+		t.Fatalf("late fees = %+v", fees)
+	}
+	draft := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(*fees[0].BilledInvoiceID))
+	feeLines := 0
+	for _, line := range draft.Lines {
+		if line.EnrollmentID == 0 && line.Amount == 7.5 {
+			feeLines++
+		}
+	}
+	if feeLines != 1 || draft.Total != 7.5 {
+		t.Fatalf("draft with late fee = %+v", draft)
+	}
+
+	waiveURL := env.Server.URL + "/api/late-fees/" + strconv.Itoa(fees[0].ID) + "/waive"
+	res, _ = rawRequest(t, env.Client, http.MethodPost, waiveURL, bytes.NewReader(mustJSON(t, map[string]any{"reason": ""})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("waive without reason status = %d, want 400", res.StatusCode)
+	}
+	waived := postJSON[backend.LateFeeDTO](t, env.Client, env.Server.URL, "/api/late-fees/"+strconv.Itoa(fees[0].ID)+"/waive", map[string]any{
+		"reason": "bank delay",
+	})
+	if waived.Status != "waived" || waived.WaivedBy != env.AdminUsername || waived.WaivedReason != "bank delay" {
+		t.Fatalf("waived fee = %+v", waived)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodPost, waiveURL, bytes.NewReader(mustJSON(t, map[string]any{"reason": "again"})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("second waive status = %d, want 409", res.StatusCode)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=late_fee&page=1&pageSize=10")
+	if audit.Total != 1 {
+		t.Fatalf("late fee audit entries = %d, want 1", audit.Total)
+	}
+}
+
+func TestStudentChargesAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Charge Student"})
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/student-charges", bytes.NewReader(mustJSON(t, map[string]any{
+		"studentId":   st.ID,
+		"kind":        "weekly",
+		"description": "Biedra nauda",
+		"amount":      30,
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unknown kind status = %d, want 400", res.StatusCode)
+	}
+	charge := postJSON[backend.StudentChargeDTO](t, env.Client, env.Server.URL, "/api/student-charges", map[string]any{
+		"studentId":   st.ID,
+		"kind":        "yearly",
+		"description": "Biedra nauda",
+		"amount":      30,
+		"dueYear":     2025,
+		"dueMonth":    9,
+	})
+	if charge.Kind != "yearly" || charge.CreatedBy != env.AdminUsername || len(charge.Billed) != 0 {
+		t.Fatalf("charge = %+v", charge)
+	}
+
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2025, "month": 9})
+	postJSON[map[string]any](t, env.Client, env.Server.URL, "/api/invoices/generate-drafts", map[string]any{"year": 2025, "month": 9})
+	charges := getJSON[[]backend.StudentChargeDTO](t, env.Client, env.Server.URL, "/api/student-charges?studentId="+strconv.Itoa(st.ID))
+	if len(charges) != 1 || len(charges[0].Billed) != 1 || charges[0].Billed[0].Year != 2025 {
+		t.Fatalf("charges = %+v", charges)
+	}
+	draft := getJSON[backend.InvoiceDTO](t, env.Client, env.Server.URL, "/api/invoices/"+strconv.Itoa(charges[0].Billed[0].InvoiceID))
+	if len(draft.Lines) != 1 || draft.Lines[0].ChargeID != charge.ID || draft.Total != 30 {
+		t.Fatalf("draft = %+v", draft)
+	}
+
+	canceled := postJSON[backend.StudentChargeDTO](t, env.Client, env.Server.URL, "/api/student-charges/"+strconv.Itoa(charge.ID)+"/cancel", map[string]any{})
+	if canceled.CanceledAt == nil || len(canceled.Billed) != 0 {
+		t.Fatalf("canceled charge = %+v", canceled)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=student_charge&page=1&pageSize=10")
+	if audit.Total != 2 {
+		t.Fatalf("student charge audit entries = %d, want 2", audit.Total)
+	}
+}
+
+func TestLessonPackagesAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{"fullName": "Package Student"})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Private German", "type": "individual", "lessonPrice": 30, "subscriptionPrice": 0,
+	})
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId":       st.ID,
+		"courseId":        course.ID,
+		"billingMode":     "package",
+		"chargeMaterials": false,
+	})
+
+	pkg := postJSON[backend.LessonPackageDTO](t, env.Client, env.Server.URL, "/api/lesson-packages", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"lessons":   10,
+		"price":     270,
+	})
+	if pkg.InvoiceID == nil || pkg.InvoiceNumber == nil || pkg.Price != 270 || pkg.CreatedBy != env.AdminUsername {
+		t.Fatalf("package = %+v", pkg)
+	}
+
+	now := time.Now()
+	putJSON[map[string]bool](t, env.Client, env.Server.URL, "/api/attendance", map[string]any{
+		"studentId": st.ID,
+		"courseId":  course.ID,
+		"year":      now.Year(),
+		"month":     int(now.Month()),
+		"hours":     8.0,
+	})
+	warnings := getJSON[[]backend.LessonPackageDTO](t, env.Client, env.Server.URL, "/api/lesson-packages/warnings")
+	if len(warnings) != 1 || warnings[0].ID != pkg.ID || !warnings[0].LowBalance || warnings[0].Remaining != 2 {
+		t.Fatalf("warnings = %+v", warnings)
+	}
+
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/lesson-packages/"+strconv.Itoa(pkg.ID)+"/refund", bytes.NewReader(mustJSON(t, map[string]any{"method": "bank"})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("refund of unpaid package status = %d, want 409", res.StatusCode)
+	}
+	postJSON[backend.PaymentDTO](t, env.Client, env.Server.URL, "/api/payments", map[string]any{
+		"studentId": st.ID,
+		"invoiceId": *pkg.InvoiceID,
+		"amount":    270,
+		"method":    "bank",
+		"paidAt":    now.Format("2006-01-02"),
+	})
+	refunded := postJSON[backend.LessonPackageDTO](t, env.Client, env.Server.URL, "/api/lesson-packages/"+strconv.Itoa(pkg.ID)+"/refund", map[string]any{"method": "bank"})
+	if refunded.Status != "refunded" || refunded.Refund != 54 {
+		t.Fatalf("refunded package = %+v", refunded)
+	}
+	packages := getJSON[[]backend.LessonPackageDTO](t, env.Client, env.Server.URL, "/api/lesson-packages?studentId="+strconv.Itoa(st.ID))
+	if len(packages) != 1 || packages[0].Remaining != 0 {
+		t.Fatalf("packages = %+v", packages)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=lesson_package&page=1&pageSize=10")
+	if audit.Total != 2 {
+		t.Fatalf("lesson package audit entries = %d, want 2", audit.Total)
+	}
+}
+
+func TestCoursePriceHistoryAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Price History", "type": "group", "lessonPrice": 20, "subscriptionPrice": 60,
+	})
+	updated := putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID), map[string]any{
+		"version": course.Version, "name": "Price History", "type": "group", "lessonPrice": 22, "subscriptionPrice": 60,
+	})
+	if updated.LessonPrice != 22 || len(updated.UpcomingPrices) != 0 {
+		t.Fatalf("updated course = %+v", updated)
+	}
+
+	now := time.Now()
+	next := now.AddDate(0, 1, 0)
+	path := "/api/courses/" + strconv.Itoa(course.ID) + "/prices"
+	history := postJSON[[]backend.CoursePriceDTO](t, env.Client, env.Server.URL, path, map[string]any{
+		"year": next.Year(), "month": int(next.Month()), "lessonPrice": 25, "subscriptionPrice": 70,
+	})
+	if len(history) != 3 ||
+		history[0].Status != "past" || history[0].LessonPrice != 20 || history[0].EffectiveYear != 0 ||
+		history[1].Status != "current" || history[1].LessonPrice != 22 || history[1].CreatedBy != env.AdminUsername ||
+		history[2].Status != "upcoming" || history[2].LessonPrice != 25 || history[2].SubscriptionPrice != 70 {
+		t.Fatalf("history = %+v", history)
+	}
+	got := getJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID))
+	if got.LessonPrice != 22 || len(got.UpcomingPrices) != 1 || got.UpcomingPrices[0].EffectiveMonth != int(next.Month()) {
+		t.Fatalf("course = %+v", got)
+	}
+	// The course row holds the prices in force, not the scheduled ones.
+	row, err := env.Runtime.DB.Ent.Course.Get(context.Background(), course.ID)
+	if err != nil {
+		t.Fatalf("Course.Get: %v", err)
+	}
+	if row.LessonPriceCents != 2200 || row.SubscriptionPriceCents != 6000 {
+		t.Fatalf("course row prices = %d/%d, want 2200/6000", row.LessonPriceCents, row.SubscriptionPriceCents)
+	}
+
+	prev := now.AddDate(0, -1, 0)
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+path, bytes.NewReader(mustJSON(t, map[string]any{
+		"year": prev.Year(), "month": int(prev.Month()), "lessonPrice": 30, "subscriptionPrice": 70,
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("past month price status = %d, want 409", res.StatusCode)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+path+"/"+strconv.Itoa(history[1].ID), nil)
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("cancel of current price status = %d, want 409", res.StatusCode)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+path+"/"+strconv.Itoa(history[2].ID), nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("cancel of upcoming price status = %d: %s", res.StatusCode, body)
+	}
+	history = getJSON[[]backend.CoursePriceDTO](t, env.Client, env.Server.URL, path)
+	if len(history) != 2 || history[1].Status != "current" {
+		t.Fatalf("history after cancel = %+v", history)
+	}
+	if row, err = env.Runtime.DB.Ent.Course.Get(context.Background(), course.ID); err != nil {
+		t.Fatalf("Course.Get: %v", err)
+	}
+	if row.LessonPriceCents != 2200 || row.SubscriptionPriceCents != 6000 {
+		t.Fatalf("course row prices after cancel = %d/%d, want 2200/6000", row.LessonPriceCents, row.SubscriptionPriceCents)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=course&page=1&pageSize=10")
+	if audit.Total != 3 {
+		t.Fatalf("course audit entries = %d, want 3", audit.Total)
+	}
+}
+
+func TestBillingTermsAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Intensive French", "type": "group", "lessonPrice": 20, "subscriptionPrice": 0,
+	})
+	if course.BillingPeriod != "monthly" {
+		t.Fatalf("new course billing period = %q, want monthly", course.BillingPeriod)
+	}
+	termInput := map[string]any{
+		"name": "Summer intensive", "startYear": 2027, "startMonth": 6, "endYear": 2027, "endMonth": 7, "lessons": 20, "courseId": course.ID,
+	}
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/billing-terms", bytes.NewReader(mustJSON(t, termInput)))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("custom term of a monthly course status = %d, want 400", res.StatusCode)
+	}
+	course = putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID)+"/billing-period", map[string]any{
+		"version": course.Version, "billingPeriod": "custom",
+	})
+	if course.BillingPeriod != "custom" {
+		t.Fatalf("course billing period = %q, want custom", course.BillingPeriod)
+	}
+
+	term := postJSON[backend.BillingTermDTO](t, env.Client, env.Server.URL, "/api/billing-terms", termInput)
+	if term.CourseName != "Intensive French" || term.Lessons != 20 || term.CreatedBy != env.AdminUsername {
+		t.Fatalf("term = %+v", term)
+	}
+	termInput["lessons"] = 0
+	res, _ = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/billing-terms", bytes.NewReader(mustJSON(t, termInput)))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("term without lessons status = %d, want 400", res.StatusCode)
+	}
+	termInput["lessons"] = 24
+	updated := putJSON[backend.BillingTermDTO](t, env.Client, env.Server.URL, "/api/billing-terms/"+strconv.Itoa(term.ID), termInput)
+	if updated.Lessons != 24 {
+		t.Fatalf("updated term = %+v", updated)
+	}
+	terms := getJSON[[]backend.BillingTermDTO](t, env.Client, env.Server.URL, "/api/billing-terms?courseId="+strconv.Itoa(course.ID))
+	if len(terms) != 1 || terms[0].ID != term.ID {
+		t.Fatalf("terms = %+v", terms)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/billing-terms/"+strconv.Itoa(term.ID), nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete term status = %d: %s", res.StatusCode, body)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=billing_term&page=1&pageSize=10")
+	if audit.Total != 3 {
+		t.Fatalf("billing term audit entries = %d, want 3", audit.Total)
+	}
+}
+
+func TestEnrollmentDatesAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Dated Student",
+	})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Drawing", "type": "group", "lessonPrice": 20, "subscriptionPrice": 80,
+	})
+	input := map[string]any{
+		"studentId": st.ID, "courseId": course.ID, "billingMode": "per_lesson",
+		"startsOn": "2027-03-10", "endsOn": "2027-03-01",
+	}
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/enrollments", bytes.NewReader(mustJSON(t, input)))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("end before start status = %d, want 400", res.StatusCode)
+	}
+	input["endsOn"] = ""
+	enrollment := postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", input)
+	if enrollment.StartsOn != "2027-03-10" || enrollment.EndsOn != "" {
+		t.Fatalf("enrollment = %+v, want start date only", enrollment)
+	}
+
+	listURL := "/api/enrollments?studentId=" + strconv.Itoa(st.ID)
+	if items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, listURL+"&year=2027&month=2"); len(items) != 0 {
+		t.Fatalf("February enrollments = %+v, want none before the start", items)
+	}
+	if items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, listURL+"&year=2027&month=3"); len(items) != 1 {
+		t.Fatalf("March enrollments = %+v, want one", items)
+	}
+
+	enrollment = putJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments/"+strconv.Itoa(enrollment.ID)+"/dates", map[string]any{
+		"version": enrollment.Version, "startsOn": "2027-01-15", "endsOn": "2027-02-28",
+	})
+	if enrollment.StartsOn != "2027-01-15" || enrollment.EndsOn != "2027-02-28" {
+		t.Fatalf("updated enrollment = %+v", enrollment)
+	}
+	if items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, listURL+"&year=2027&month=3"); len(items) != 0 {
+		t.Fatalf("March enrollments = %+v, want none after the end", items)
+	}
+	if items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, listURL); len(items) != 1 {
+		t.Fatalf("all enrollments = %+v, want one", items)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodPut, env.Server.URL+"/api/enrollments/"+strconv.Itoa(enrollment.ID)+"/dates", bytes.NewReader(mustJSON(t, map[string]any{
+		"version": enrollment.Version - 1, "startsOn": "", "endsOn": "",
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("stale dates update status = %d, want 409", res.StatusCode)
+	}
+}
+
+func TestEnrollmentPausesAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Paused Student",
+	})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Drawing", "type": "group", "lessonPrice": 20, "subscriptionPrice": 80,
+	})
+	enrollment := postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId": st.ID, "courseId": course.ID, "billingMode": "subscription", "subscriptionLessonPrice": 20,
+	})
+	pausesURL := "/api/enrollments/" + strconv.Itoa(enrollment.ID) + "/pauses"
+
+	pause := postJSON[backend.EnrollmentPauseDTO](t, env.Client, env.Server.URL, pausesURL, map[string]any{
+		"startsOn": "2027-07-01", "endsOn": "2027-08-31", "reason": "Summer holidays",
+	})
+	if pause.Reason != "Summer holidays" || pause.CreatedBy != env.AdminUsername {
+		t.Fatalf("pause = %+v", pause)
+	}
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+pausesURL, bytes.NewReader(mustJSON(t, map[string]any{
+		"startsOn": "2027-08-15", "endsOn": "2027-09-15",
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("overlapping pause status = %d, want 409", res.StatusCode)
+	}
+	res, _ = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+pausesURL, bytes.NewReader(mustJSON(t, map[string]any{
+		"startsOn": "2027-10-01",
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("open-ended pause status = %d, want 400", res.StatusCode)
+	}
+
+	items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments?studentId="+strconv.Itoa(st.ID))
+	if len(items) != 1 || len(items[0].Pauses) != 1 || items[0].Pauses[0].ID != pause.ID {
+		t.Fatalf("enrollments = %+v, want the pause in the student's enrollment", items)
+	}
+	if items := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments?studentId="+strconv.Itoa(st.ID)+"&year=2027&month=7"); len(items) != 0 {
+		t.Fatalf("July enrollments = %+v, want the paused one hidden", items)
+	}
+	overview := getJSON[backend.MonthOverviewDTO](t, env.Client, env.Server.URL, "/api/dashboard/month-overview?year=2027&month=8")
+	if overview.Enrollments != 0 || overview.PausedEnrollments != 1 {
+		t.Fatalf("August overview enrollments = %d, paused = %d; want 0 and 1", overview.Enrollments, overview.PausedEnrollments)
+	}
+
+	res, body := rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+pausesURL+"/"+strconv.Itoa(pause.ID), nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete pause status = %d: %s", res.StatusCode, body)
+	}
+	if pauses := getJSON[[]backend.EnrollmentPauseDTO](t, env.Client, env.Server.URL, pausesURL); len(pauses) != 0 {
+		t.Fatalf("pauses = %+v, want none", pauses)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=enrollment&page=1&pageSize=10")
+	if audit.Total != 2 {
+		t.Fatalf("enrollment audit entries = %d, want 2", audit.Total)
+	}
+}
+
+func TestExcusedAbsencesAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	policy := getJSON[backend.ExcusedAbsenceSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/excused-absences")
+	if policy.Policy != "credit" || policy.MakeupWeeks != 4 {
+		t.Fatalf("default policy = %+v", policy)
+	}
+	res, _ := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/settings/excused-absences", bytes.NewReader(mustJSON(t, map[string]any{
+		"policy": "makeup", "makeupWeeks": 0,
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("invalid policy status = %d, want 400", res.StatusCode)
+	}
+	policy = postJSON[backend.ExcusedAbsenceSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/excused-absences", map[string]any{
+		"policy": "makeup", "makeupWeeks": 3,
+	})
+	if policy.Policy != "makeup" || policy.MakeupWeeks != 3 {
+		t.Fatalf("policy = %+v", policy)
+	}
+
+	st := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Excused Student",
+	})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Drawing", "type": "group", "lessonPrice": 20, "subscriptionPrice": 80,
+	})
+	otherGroup := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Drawing (Tuesday)", "type": "group", "lessonPrice": 20, "subscriptionPrice": 80,
+	})
+	input := map[string]any{"studentId": st.ID, "courseId": course.ID, "lessonDate": "2027-03-02", "reason": "Flu"}
+	res, _ = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/excused-absences", bytes.NewReader(mustJSON(t, input)))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("absence without a subscription status = %d, want 400", res.StatusCode)
+	}
+	postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId": st.ID, "courseId": course.ID, "billingMode": "subscription", "subscriptionLessonPrice": 20,
+	})
+	absence := postJSON[backend.ExcusedAbsenceDTO](t, env.Client, env.Server.URL, "/api/excused-absences", input)
+	if absence.Resolution != "makeup" || absence.MakeupDueOn != "2027-03-23" || absence.CreatedBy != env.AdminUsername {
+		t.Fatalf("absence = %+v", absence)
+	}
+	absence = postJSON[backend.ExcusedAbsenceDTO](t, env.Client, env.Server.URL, "/api/excused-absences/"+strconv.Itoa(absence.ID)+"/makeup", map[string]any{
+		"courseId": otherGroup.ID, "date": "2027-03-09",
+	})
+	if absence.Status != "made_up" || absence.MakeupCourseName != "Drawing (Tuesday)" {
+		t.Fatalf("made-up absence = %+v", absence)
+	}
+	items := getJSON[[]backend.ExcusedAbsenceDTO](t, env.Client, env.Server.URL, "/api/excused-absences?studentId="+strconv.Itoa(st.ID))
+	if len(items) != 1 || items[0].ID != absence.ID {
+		t.Fatalf("absences = %+v", items)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/excused-absences/"+strconv.Itoa(absence.ID), nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete absence status = %d: %s", res.StatusCode, body)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=excused_absence&page=1&pageSize=10")
+	if audit.Total != 3 {
+		t.Fatalf("excused absence audit entries = %d, want 3", audit.Total)
+	}
+}
+
+func TestCourseWaitlistAPI(t *testing.T) {
+	sender := &stubEmailSender{}
+	env := newTestServerWithEmailSender(t, sender)
+	defer env.Close()
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Ceramics", "type": "group", "lessonPrice": 15, "subscriptionPrice": 60,
+	})
+	course = putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID)+"/capacity", map[string]any{
+		"version": course.Version, "maxStudents": 1,
+	})
+	if course.MaxStudents != 1 {
+		t.Fatalf("course = %+v, want one seat", course)
+	}
+	settings := postJSON[backend.WaitlistSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/waitlist", map[string]any{"offerDays": 3})
+	if settings.OfferDays != 3 {
+		t.Fatalf("waitlist settings = %+v", settings)
+	}
+
+	var students []backend.StudentDTO
+	for _, name := range []string{"Seated Student", "Second Student", "Third Student"} {
+		students = append(students, postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+			"fullName": name, "email": strings.ToLower(strings.ReplaceAll(name, " ", ".")) + "@example.com",
+		}))
+	}
+	seated := postJSON[backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments", map[string]any{
+		"studentId": students[0].ID, "courseId": course.ID, "billingMode": "per_lesson",
+	})
+	res, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/enrollments", bytes.NewReader(mustJSON(t, map[string]any{
+		"studentId": students[1].ID, "courseId": course.ID, "billingMode": "per_lesson",
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("enrollment in a full course status = %d: %s", res.StatusCode, body)
+	}
+
+	second := postJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist", map[string]any{
+		"courseId": course.ID, "studentId": students[1].ID,
+	})
+	third := postJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist", map[string]any{
+		"courseId": course.ID, "studentId": students[2].ID, "billingMode": "subscription",
+	})
+	if second.Rank != 1 || third.Rank != 2 {
+		t.Fatalf("ranks = %d, %d; want 1, 2", second.Rank, third.Rank)
+	}
+	third = putJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist/"+strconv.Itoa(third.ID)+"/rank", map[string]any{"rank": 1})
+	if third.Rank != 1 {
+		t.Fatalf("moved entry = %+v, want first", third)
+	}
+
+	res, body = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/enrollments/"+strconv.Itoa(seated.ID)+"?version="+strconv.Itoa(seated.Version), nil)
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete enrollment status = %d: %s", res.StatusCode, body)
+	}
+	items := getJSON[[]backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist?courseId="+strconv.Itoa(course.ID))
+	if len(items) != 2 || items[0].ID != second.ID || items[0].Rank != 1 ||
+		items[1].ID != third.ID || items[1].Status != "offered" || items[1].OfferExpiresAt == "" || items[1].OfferEmailedTo != "third.student@example.com" {
+		t.Fatalf("waitlist after a freed seat = %+v", items)
+	}
+	if sender.lastMessage.To != "third.student@example.com" || !strings.Contains(sender.lastMessage.Subject, "Ceramics") {
+		t.Fatalf("offer email = %+v", sender.lastMessage)
+	}
+
+	// The offered seat is held until the offer is answered.
+	res, body = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/enrollments", bytes.NewReader(mustJSON(t, map[string]any{
+		"studentId": students[0].ID, "courseId": course.ID, "billingMode": "per_lesson",
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("enrollment over an offered seat status = %d: %s", res.StatusCode, body)
+	}
+	postJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist/"+strconv.Itoa(third.ID)+"/decline", nil)
+	second = postJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist/"+strconv.Itoa(second.ID)+"/accept", nil)
+	if second.Status != "enrolled" || second.EnrollmentID == nil {
+		t.Fatalf("accepted entry = %+v", second)
+	}
+	enrollments := getJSON[[]backend.EnrollmentDTO](t, env.Client, env.Server.URL, "/api/enrollments?studentId="+strconv.Itoa(students[1].ID))
+	if len(enrollments) != 1 || enrollments[0].ID != *second.EnrollmentID {
+		t.Fatalf("enrollments = %+v", enrollments)
+	}
+
+	// Without offers a new seat goes straight to the next student.
+	postJSON[backend.WaitlistSettingsDTO](t, env.Client, env.Server.URL, "/api/settings/waitlist", map[string]any{"offerDays": 0})
+	first := postJSON[backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist", map[string]any{
+		"courseId": course.ID, "studentId": students[0].ID,
+	})
+	course = getJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID))
+	putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID)+"/capacity", map[string]any{
+		"version": course.Version, "maxStudents": 2,
+	})
+	items = getJSON[[]backend.WaitlistEntryDTO](t, env.Client, env.Server.URL, "/api/waitlist?studentId="+strconv.Itoa(students[0].ID))
+	if len(items) != 1 || items[0].ID != first.ID || items[0].Status != "enrolled" {
+		t.Fatalf("waitlist after adding a seat = %+v", items)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=waitlist_entry&page=1&pageSize=20")
+	if audit.Total != 9 {
+		t.Fatalf("waitlist audit entries = %d, want 9", audit.Total)
+	}
+}
+
+func TestLeadsAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Guitar", "type": "individual", "lessonPrice": 25, "subscriptionPrice": 90,
+	})
+	existing := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Returning Student", "email": "returning@example.com",
+	})
+
+	paid := postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads", map[string]any{
+		"fullName": "Trial Student", "email": "trial@example.com", "source": "website", "courseId": course.ID,
+	})
+	if paid.Status != "new" || paid.CourseName != "Guitar" || paid.CreatedBy != env.AdminUsername {
+		t.Fatalf("lead = %+v", paid)
+	}
+	res, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/leads/"+strconv.Itoa(paid.ID)+"/outcome", bytes.NewReader(mustJSON(t, map[string]any{
+		"outcome": "attended",
+	})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("outcome without a trial status = %d: %s", res.StatusCode, body)
+	}
+	paid = postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads/"+strconv.Itoa(paid.ID)+"/trial", map[string]any{
+		"courseId": course.ID, "trialAt": "2027-02-10T17:30", "trialPrice": 10,
+	})
+	if paid.Status != "trial_scheduled" || paid.TrialAt != "2027-02-10T17:30" || paid.TrialPrice != 10 {
+		t.Fatalf("lead with a trial = %+v", paid)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/leads/"+strconv.Itoa(paid.ID)+"/outcome", bytes.NewReader(mustJSON(t, map[string]any{
+		"outcome": "maybe",
+	})))
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("invalid outcome status = %d: %s", res.StatusCode, body)
+	}
+	paid = postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads/"+strconv.Itoa(paid.ID)+"/outcome", map[string]any{
+		"outcome": "attended", "note": "Liked it",
+	})
+	if paid.Status != "trial_done" || paid.TrialOutcome != "attended" {
+		t.Fatalf("lead after the trial = %+v", paid)
+	}
+	converted := postJSON[backend.LeadConversionResult](t, env.Client, env.Server.URL, "/api/leads/"+strconv.Itoa(paid.ID)+"/convert", map[string]any{
+		"enrollments": []map[string]any{{"courseId": course.ID, "billingMode": "per_lesson"}},
+	})
+	if converted.Lead.Status != "converted" || converted.Lead.StudentID == nil || *converted.Lead.StudentID != converted.Student.ID ||
+		converted.Student.FullName != "Trial Student" || converted.Student.Email != "trial@example.com" || len(converted.Enrollments) != 1 {
+		t.Fatalf("conversion = %+v", converted)
+	}
+	if converted.TrialCharge == nil || converted.TrialCharge.Amount != 10 || converted.TrialCharge.DueYear != 2027 || converted.TrialCharge.DueMonth != 2 ||
+		converted.TrialCharge.Description != "Izmēģinājuma nodarbība 10.02.2027 (Guitar)" {
+		t.Fatalf("trial charge = %+v", converted.TrialCharge)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/leads/"+strconv.Itoa(paid.ID)+"/convert", bytes.NewReader(mustJSON(t, map[string]any{})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("second conversion status = %d: %s", res.StatusCode, body)
+	}
+
+	// A lead matching an existing student is caught by the duplicate check.
+	returning := postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads", map[string]any{
+		"fullName": "Returning Student", "email": "returning@example.com", "courseId": course.ID,
+	})
+	res, body = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/leads/"+strconv.Itoa(returning.ID)+"/convert", bytes.NewReader(mustJSON(t, map[string]any{})))
+	if res.StatusCode != http.StatusConflict || !strings.Contains(string(body), "possible duplicate") {
+		t.Fatalf("duplicate conversion status = %d: %s", res.StatusCode, body)
+	}
+	converted = postJSON[backend.LeadConversionResult](t, env.Client, env.Server.URL, "/api/leads/"+strconv.Itoa(returning.ID)+"/convert", map[string]any{
+		"studentId": existing.ID,
+	})
+	if converted.Student.ID != existing.ID || converted.TrialCharge != nil || converted.Lead.StudentName != "Returning Student" {
+		t.Fatalf("conversion into an existing student = %+v", converted)
+	}
+
+	lost := postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads", map[string]any{"fullName": "Lost Lead"})
+	lost = postJSON[backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads/"+strconv.Itoa(lost.ID)+"/lost", map[string]any{"reason": "Too expensive"})
+	if lost.Status != "lost" || lost.LostReason != "Too expensive" {
+		t.Fatalf("lost lead = %+v", lost)
+	}
+	if items := getJSON[[]backend.LeadDTO](t, env.Client, env.Server.URL, "/api/leads?status=converted"); len(items) != 2 {
+		t.Fatalf("converted leads = %+v", items)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/leads/"+strconv.Itoa(paid.ID), nil)
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("delete converted lead status = %d: %s", res.StatusCode, body)
+	}
+
+	year := time.Now().Year()
+	funnel := getJSON[backend.LeadFunnelDTO](t, env.Client, env.Server.URL, "/api/reports/lead-funnel?year="+strconv.Itoa(year))
+	if funnel.Total.Leads != 3 || funnel.Total.Trials != 1 || funnel.Total.Attended != 1 || funnel.Total.Converted != 2 ||
+		funnel.Total.Lost != 1 || funnel.Total.ConversionRate != 66.7 {
+		t.Fatalf("funnel total = %+v", funnel.Total)
+	}
+	if len(funnel.ByCourse) != 2 || funnel.ByCourse[0].CourseName != "Guitar" || funnel.ByCourse[0].ConversionRate != 100 ||
+		funnel.ByCourse[1].CourseID != nil || funnel.ByCourse[1].Lost != 1 {
+		t.Fatalf("funnel by course = %+v", funnel.ByCourse)
+	}
+	if len(funnel.ByMonth) != 1 || funnel.ByMonth[0].Month != int(time.Now().Month()) || funnel.ByMonth[0].Leads != 3 {
+		t.Fatalf("funnel by month = %+v", funnel.ByMonth)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=lead&page=1&pageSize=20")
+	if audit.Total != 8 {
+		t.Fatalf("lead audit entries = %d, want 8", audit.Total)
+	}
+}
+
+func TestScheduleRoomsAndConflictsAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	anna := postJSON[backend.TeacherDTO](t, env.Client, env.Server.URL, "/api/teachers", map[string]any{"fullName": "Anna Teacher"})
+	boris := postJSON[backend.TeacherDTO](t, env.Client, env.Server.URL, "/api/teachers", map[string]any{"fullName": "Boris Teacher"})
+	piano := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Piano", "teacherId": anna.ID, "type": "individual", "lessonPrice": 30, "subscriptionPrice": 0,
+	})
+	violin := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Violin", "teacherId": boris.ID, "type": "individual", "lessonPrice": 30, "subscriptionPrice": 0,
+	})
+	blue := postJSON[backend.RoomDTO](t, env.Client, env.Server.URL, "/api/rooms", map[string]any{"name": "Blue room", "capacity": 8})
+	green := postJSON[backend.RoomDTO](t, env.Client, env.Server.URL, "/api/rooms", map[string]any{"name": "Green room"})
+	res, body := rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/rooms", bytes.NewReader(mustJSON(t, map[string]any{"name": "Blue room"})))
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("duplicate room status = %d: %s", res.StatusCode, body)
+	}
+
+	slot := func(courseID, roomID int, startsAt, endsAt string) map[string]any {
+		return map[string]any{
+			"courseId": courseID, "roomId": roomID, "weekday": 1,
+			"startsAt": startsAt, "endsAt": endsAt, "validFrom": "2030-01-07",
+		}
+	}
+	first := postJSON[backend.ScheduleSlotResult](t, env.Client, env.Server.URL, "/api/schedule-slots", slot(piano.ID, blue.ID, "16:00", "17:00"))
+	if first.Slot.TeacherName != "Anna Teacher" || first.Slot.TeacherOverride || first.Slot.RoomName != "Blue room" || len(first.Conflicts) != 0 {
+		t.Fatalf("first slot = %+v", first)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodPost, env.Server.URL+"/api/schedule-slots", bytes.NewReader(mustJSON(t, slot(violin.ID, blue.ID, "16:30", "17:30"))))
+	if res.StatusCode != http.StatusConflict || !strings.Contains(string(body), "room Blue room is already booked by Piano") {
+		t.Fatalf("room double-booking status = %d: %s", res.StatusCode, body)
+	}
+	second := postJSON[backend.ScheduleSlotResult](t, env.Client, env.Server.URL, "/api/schedule-slots", slot(violin.ID, green.ID, "16:30", "17:30"))
+	if len(second.Conflicts) != 0 {
+		t.Fatalf("second slot conflicts = %+v", second.Conflicts)
+	}
+	postJSON[backend.ScheduleSlotResult](t, env.Client, env.Server.URL, "/api/schedule-slots", slot(violin.ID, blue.ID, "17:30", "18:30"))
+
+	// Boris takes over Piano, so its Monday lesson now clashes with his Violin lesson.
+	piano = putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(piano.ID), map[string]any{
+		"version": piano.Version, "name": "Piano", "teacherId": boris.ID, "type": "individual", "lessonPrice": 30, "subscriptionPrice": 0,
+	})
+	if len(piano.ScheduleConflicts) != 1 || piano.ScheduleConflicts[0].Kind != "teacher" || piano.ScheduleConflicts[0].Resource != "Boris Teacher" ||
+		piano.ScheduleConflicts[0].OtherSlotID != second.Slot.ID {
+		t.Fatalf("conflicts after the teacher change = %+v", piano.ScheduleConflicts)
+	}
+	if conflicts := getJSON[[]backend.ScheduleConflictDTO](t, env.Client, env.Server.URL, "/api/schedule-slots/conflicts"); len(conflicts) != 1 {
+		t.Fatalf("schedule conflicts = %+v", conflicts)
+	}
+	moved := putJSON[backend.ScheduleSlotResult](t, env.Client, env.Server.URL, "/api/schedule-slots/"+strconv.Itoa(first.Slot.ID), map[string]any{
+		"version": first.Slot.Version, "courseId": piano.ID, "roomId": blue.ID, "teacherId": anna.ID, "weekday": 1,
+		"startsAt": "16:00", "endsAt": "17:00", "validFrom": "2030-01-07",
+	})
+	if !moved.Slot.TeacherOverride || moved.Slot.TeacherName != "Anna Teacher" {
+		t.Fatalf("slot with a substitute = %+v", moved.Slot)
+	}
+	if conflicts := getJSON[[]backend.ScheduleConflictDTO](t, env.Client, env.Server.URL, "/api/schedule-slots/conflicts"); len(conflicts) != 0 {
+		t.Fatalf("schedule conflicts after the substitute = %+v", conflicts)
+	}
+
+	occupancy := getJSON[backend.RoomOccupancyDTO](t, env.Client, env.Server.URL, "/api/rooms/"+strconv.Itoa(blue.ID)+"/occupancy?week=2030-01-09")
+	if occupancy.WeekStart != "2030-01-07" || len(occupancy.Days) != 7 || len(occupancy.Days[0].Slots) != 2 ||
+		occupancy.Days[0].Slots[0].CourseName != "Piano" || occupancy.BookedMinutes != 120 {
+		t.Fatalf("occupancy = %+v", occupancy)
+	}
+	if before := getJSON[backend.RoomOccupancyDTO](t, env.Client, env.Server.URL, "/api/rooms/"+strconv.Itoa(blue.ID)+"/occupancy?week=2029-12-31"); before.BookedMinutes != 0 {
+		t.Fatalf("occupancy before the schedule starts = %+v", before)
+	}
+	res, body = rawRequest(t, env.Client, http.MethodDelete, env.Server.URL+"/api/rooms/"+strconv.Itoa(blue.ID), nil)
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("delete used room status = %d: %s", res.StatusCode, body)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=schedule_slot&page=1&pageSize=10")
+	if audit.Total != 4 {
+		t.Fatalf("schedule slot audit entries = %d, want 4", audit.Total)
+	}
+}
+
+func TestStudentImportAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	english := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "English A1", "type": "group", "lessonPrice": 15, "subscriptionPrice": 60,
+	})
+	piano := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Piano", "type": "individual", "lessonPrice": 30, "subscriptionPrice": 0,
+	})
+	putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(piano.ID)+"/capacity", map[string]any{
+		"version": piano.Version, "maxStudents": 1,
+	})
+	existing := postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Existing Kid", "personalCode": "010101-11111",
+	})
+
+	sheet := "Vārds, uzvārds;Personas kods;Tālrunis;E-pasts;Nepilngadīgs;Maksātājs;Maksātāja loma;Kurss;Sākuma datums\n" +
+		"Anna Bērziņa;150512-21234;+371 20000000;anna@example.com;jā;Ilze Bērziņa;Mother;English A1;01.09.2030\n" +
+		"Existing Kid;010101-11111;;;nē;;;;\n" +
+		";;;;;;;;\n" +
+		"Bad Mail;;;not-an-email;jā;;;Nope;\n"
+	preview := postStudentImport[backend.StudentImportPreviewDTO](t, env, "/api/students/import/preview", "registration.csv", sheet, "", http.StatusOK)
+	if preview.ValidRows != 1 || preview.InvalidRows != 2 || len(preview.Rows) != 3 {
+		t.Fatalf("preview = %+v", preview)
+	}
+	if preview.Mapping["Vārds, uzvārds"] != "fullName" || preview.Mapping["Kurss"] != "course" || preview.Mapping["Sākuma datums"] != "startsOn" {
+		t.Fatalf("auto mapping = %+v", preview.Mapping)
+	}
+	anna := preview.Rows[0]
+	if anna.Line != 2 || anna.PayerRole != "mother" || !anna.IsMinor || anna.StartsOn != "2030-09-01" || len(anna.Courses) != 1 || len(anna.Errors) != 0 {
+		t.Fatalf("anna row = %+v", anna)
+	}
+	if dup := preview.Rows[1]; len(dup.Errors) != 1 || !strings.Contains(dup.Errors[0], "already exists: Existing Kid (#"+strconv.Itoa(existing.ID)+")") {
+		t.Fatalf("duplicate row = %+v", dup)
+	}
+	bad := strings.Join(preview.Rows[2].Errors, "|")
+	if preview.Rows[2].Line != 5 || !strings.Contains(bad, "email is invalid") || !strings.Contains(bad, "payerName is required") || !strings.Contains(bad, `course "Nope" does not exist`) {
+		t.Fatalf("bad row = %+v", preview.Rows[2])
+	}
+	res, body := postStudentImportRaw(t, env, "/api/students/import", "registration.csv", sheet, "")
+	if res.StatusCode != http.StatusBadRequest || !strings.Contains(string(body), "2 of 3 rows have errors") {
+		t.Fatalf("invalid import status = %d: %s", res.StatusCode, body)
+	}
+	if students := getJSON[[]backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students"); len(students) != 1 {
+		t.Fatalf("students after refused import = %d, want 1", len(students))
+	}
+
+	unmapped := postStudentImport[backend.StudentImportPreviewDTO](t, env, "/api/students/import/preview", "sheet.csv", "Bērns,Kods\nJānis Ozols,160613-21234\n", "", http.StatusOK)
+	if unmapped.MappingError == "" || len(unmapped.Columns) != 2 || unmapped.Columns[0] != "Bērns" {
+		t.Fatalf("unmapped preview = %+v", unmapped)
+	}
+
+	mapping := `{"Bērns":"fullName","Kods":"personalCode","Kurss 1":"course","Kurss 2":"course","Piezīmes":""}`
+	groups := "Bērns;Kods;Kurss 1;Kurss 2;Piezīmes\n" +
+		"Jānis Ozols;160613-21234;English A1;Piano;brings own piano book\n" +
+		"Pēteris Kalns;;Piano;;\n"
+	preview = postStudentImport[backend.StudentImportPreviewDTO](t, env, "/api/students/import/preview", "groups.csv", groups, mapping, http.StatusOK)
+	if preview.InvalidRows != 1 || len(preview.Rows[1].Errors) != 1 || !strings.Contains(preview.Rows[1].Errors[0], "course Piano is full") {
+		t.Fatalf("capacity preview = %+v", preview)
+	}
+	res, body = postStudentImportRaw(t, env, "/api/students/import", "groups.csv", groups, `{"Bērns":"unknown"}`)
+	if res.StatusCode != http.StatusBadRequest || !strings.Contains(string(body), "unknown field") {
+		t.Fatalf("bad mapping status = %d: %s", res.StatusCode, body)
+	}
+
+	groups = strings.TrimSuffix(groups, "Pēteris Kalns;;Piano;;\n")
+	result := postStudentImport[backend.StudentImportResult](t, env, "/api/students/import", "groups.csv", groups, mapping, http.StatusCreated)
+	if len(result.Students) != 1 || result.Enrollments != 2 || result.Students[0].Student.FullName != "Jānis Ozols" || result.Students[0].Student.Note != "" {
+		t.Fatalf("import result = %+v", result)
+	}
+	if result.Students[0].Enrollments[0].CourseID != english.ID || result.Students[0].Enrollments[1].CourseID != piano.ID {
+		t.Fatalf("imported enrollments = %+v", result.Students[0].Enrollments)
+	}
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=student&page=1&pageSize=10")
+	if audit.Total != 1 || audit.Items[0].Action != "student.import" {
+		t.Fatalf("student import audit = %+v", audit)
+	}
+}
+
+func postStudentImport[T any](t *testing.T, env *testServerEnv, path, fileName, content, mapping string, wantStatus int) T {
+	t.Helper()
+	res, body := postStudentImportRaw(t, env, path, fileName, content, mapping)
+	if res.StatusCode != wantStatus {
+		t.Fatalf("POST %s status = %d body=%s", path, res.StatusCode, body)
+	}
+	var out T
+	if err := json.Unmarshal(body, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func postStudentImportRaw(t *testing.T, env *testServerEnv, path, fileName, content, mapping string) (*http.Response, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := part.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if mapping != "" {
+		if err := form.WriteField("mapping", mapping); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, env.Server.URL+path, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	res, err := env.Client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, body
+}
+
+func TestDataExportAPI(t *testing.T) {
+	env := newTestServer(t)
+	defer env.Close()
+
+	postJSON[backend.StudentDTO](t, env.Client, env.Server.URL, "/api/students", map[string]any{
+		"fullName": "Export Student", "email": "export@example.com",
+	})
+	course := postJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses", map[string]any{
+		"name": "Export Course", "type": "group", "lessonPrice": 12.5, "subscriptionPrice": 0,
+	})
+	// The edited price is in force now; the scheduled one is not yet.
+	putJSON[backend.CourseDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID), map[string]any{
+		"version": course.Version, "name": "Export Course", "type": "group", "lessonPrice": 14, "subscriptionPrice": 0,
+	})
+	next := time.Now().AddDate(0, 1, 0)
+	postJSON[[]backend.CoursePriceDTO](t, env.Client, env.Server.URL, "/api/courses/"+strconv.Itoa(course.ID)+"/prices", map[string]any{
+		"year": next.Year(), "month": int(next.Month()), "lessonPrice": 16, "subscriptionPrice": 0,
+	})
+
+	res, body := rawRequest(t, env.Client, http.MethodGet, env.Server.URL+"/api/exports/full", nil)
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "application/zip" {
+		t.Fatalf("export status = %d type=%s body=%s", res.StatusCode, res.Header.Get("Content-Type"), body)
+	}
+	if !strings.Contains(res.Header.Get("Content-Disposition"), "langschool-export-") {
+		t.Fatalf("export disposition = %q", res.Header.Get("Content-Disposition"))
+	}
+	reader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("zip reader: %v", err)
+	}
+	files := map[string]string{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(data)
+	}
+	if len(files) != 12 {
+		t.Fatalf("export files = %d, want 11 CSV files and export.json", len(files))
+	}
+	if !strings.Contains(files["students.csv"], "Export Student") || !strings.Contains(files["payers.csv"], "export@example.com") {
+		t.Fatalf("students.csv = %s\npayers.csv = %s", files["students.csv"], files["payers.csv"])
+	}
+	if !strings.Contains(files["courses.csv"], "Export Course,group,monthly,,,1400,14.00") {
+		t.Fatalf("courses.csv = %s", files["courses.csv"])
+	}
+	if prices := strings.Split(strings.TrimSpace(files["course_prices.csv"]), "\n"); len(prices) != 4 ||
+		!strings.Contains(prices[1], ",,,1250,12.50,") || !strings.Contains(prices[3], ",1600,16.00,") {
+		t.Fatalf("course_prices.csv = %s", files["course_prices.csv"])
+	}
+	var doc struct {
+		FormatVersion int    `json:"formatVersion"`
+		ExportedAt    string `json:"exportedAt"`
+		Students      []any  `json:"students"`
+	}
+	if err := json.Unmarshal([]byte(files["export.json"]), &doc); err != nil {
+		t.Fatalf("export.json: %v", err)
+	}
+	if doc.FormatVersion != 1 || doc.ExportedAt == "" || len(doc.Students) != 1 {
+		t.Fatalf("export.json = %+v", doc)
+	}
+
+	audit := getJSON[backend.AuditLogListResult](t, env.Client, env.Server.URL, "/api/audit-logs?entityType=export&page=1&pageSize=10")
+	if audit.Total != 1 || audit.Items[0].Action != "data.export" {
+		t.Fatalf("export audit = %+v", audit)
+	}
+}